@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// localOrigins are always allowed so the local frontend works against any
+// backend. NOTE: Frontend runs on port 1234, not 3000
+var localOrigins = []string{
+	"http://localhost:1234", // Local frontend
+	"http://127.0.0.1:1234", // Alternative local
+}
+
+// defaultRemoteOrigins are the deployed frontends allowed when
+// ALLOWED_ORIGINS is unset.
+var defaultRemoteOrigins = []string{
+	"https://pfinance.dev",           // Production custom domain
+	"https://www.pfinance.dev",       // Production www subdomain
+	"https://preview.pfinance.dev",   // Preview custom domain
+	"https://*.preview.pfinance.dev", // PR preview custom domains (pr-123.preview.pfinance.dev)
+	"https://pfinance-app-1748773335.web.app",
+	"https://pfinance-app-1748773335.firebaseapp.com",
+	"https://pfinance-*.vercel.app", // Vercel preview deployments (project-scoped)
+}
+
+// allowedOrigins returns the CORS origins for a comma-separated
+// ALLOWED_ORIGINS value: the local origins plus the listed ones, or plus
+// defaultRemoteOrigins when the value is empty. Every listed origin must be
+// an http(s) scheme and host with no path.
+func allowedOrigins(env string) ([]string, error) {
+	origins := slices.Clone(localOrigins)
+	if strings.TrimSpace(env) == "" {
+		return append(origins, defaultRemoteOrigins...), nil
+	}
+
+	for _, origin := range strings.Split(env, ",") {
+		origin = strings.TrimSuffix(strings.TrimSpace(origin), "/")
+		if origin == "" {
+			continue
+		}
+		if err := validateOrigin(origin); err != nil {
+			return nil, err
+		}
+		if !slices.Contains(origins, origin) {
+			origins = append(origins, origin)
+		}
+	}
+	return origins, nil
+}
+
+// validateOrigin checks that origin is a well-formed http(s) origin. A "*" in
+// the host is allowed for wildcard subdomains.
+func validateOrigin(origin string) error {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return fmt.Errorf("invalid CORS origin %q: %w", origin, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid CORS origin %q: scheme must be http or https", origin)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid CORS origin %q: missing host", origin)
+	}
+	if u.Path != "" || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return fmt.Errorf("invalid CORS origin %q: must be scheme and host only", origin)
+	}
+	if strings.Count(u.Host, "*") > 1 {
+		return fmt.Errorf("invalid CORS origin %q: at most one wildcard allowed", origin)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAllowedOrigins(t *testing.T) {
+	t.Run("unset falls back to the default list", func(t *testing.T) {
+		got, err := allowedOrigins("")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !slices.Contains(got, "https://pfinance.dev") || !slices.Contains(got, "http://localhost:1234") {
+			t.Errorf("origins = %v, want the defaults", got)
+		}
+	})
+
+	t.Run("env origins are merged with local ones", func(t *testing.T) {
+		got, err := allowedOrigins(" https://app.example.com/, https://*.example.com,,http://localhost:1234")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []string{"http://localhost:1234", "http://127.0.0.1:1234", "https://app.example.com", "https://*.example.com"}
+		if !slices.Equal(got, want) {
+			t.Errorf("origins = %v, want %v", got, want)
+		}
+	})
+
+	for _, bad := range []string{
+		"app.example.com",
+		"ftp://example.com",
+		"https://",
+		"https://example.com/app",
+		"https://example.com?x=1",
+		"https://*.*.example.com",
+	} {
+		t.Run("rejects "+bad, func(t *testing.T) {
+			if _, err := allowedOrigins("https://ok.example.com," + bad); err == nil {
+				t.Errorf("expected an error for %q", bad)
+			}
+		})
+	}
+}
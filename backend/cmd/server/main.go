@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/firestore"
@@ -44,6 +45,7 @@ func main() {
 
 	var storeImpl store.Store
 	var firebaseAuth *auth.FirebaseAuth
+	readinessChecks := make(map[string]readinessCheck)
 
 	if useMemoryStore {
 		log.Println("Using in-memory store for local development")
@@ -78,6 +80,7 @@ func main() {
 		}
 
 		storeImpl = store.NewFirestoreStore(firestoreClient)
+		readinessChecks["firestore"] = firestoreCheck(firestoreClient)
 	}
 
 	// Initialize extraction service if ML service URL is configured
@@ -102,6 +105,7 @@ func main() {
 	extractionSvc.SetStatementStore(storeImpl)
 
 	service.SetExtractionService(extractionSvc)
+	readinessChecks["extraction"] = extractionSvc.HealthCheck
 	log.Printf("✅ Document extraction enabled (ML service: %s)", mlServiceURL)
 
 	// Keep the Modal ML container warm with a periodic health-check ping.
@@ -159,6 +163,11 @@ func main() {
 	// Create the finance service
 	financeService := service.NewFinanceService(storeImpl, stripeClient, firebaseAuth)
 	financeService.SetTaxClassificationPipeline(taxPipeline)
+	if secret := os.Getenv("CLEAR_DATA_TOKEN_SECRET"); secret != "" {
+		financeService.SetTokenSecret(secret)
+	} else {
+		log.Println("CLEAR_DATA_TOKEN_SECRET not set, data-clear tokens are only valid on the instance that issued them")
+	}
 	if algoliaClient != nil {
 		financeService.SetAlgoliaClient(algoliaClient)
 	}
@@ -189,12 +198,15 @@ func main() {
 	mux := http.NewServeMux()
 	mux.Handle(path, handler)
 
-	// Add health check endpoint
+	// Liveness probe: the process is up and serving
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	})
 
+	// Readiness probe: Firestore and the ML service are reachable
+	mux.HandleFunc("/ready", readinessHandler(readinessChecks, 10*time.Second))
+
 	// Set up Stripe webhook handler if configured
 	stripeSecret := os.Getenv("STRIPE_WEBHOOK_SECRET")
 	if stripeSecret != "" {
@@ -205,20 +217,16 @@ func main() {
 		log.Println("STRIPE_WEBHOOK_SECRET not set, Stripe webhooks disabled")
 	}
 
-	// Set up CORS
-	// NOTE: Frontend runs on port 1234, not 3000
+	// Set up CORS from ALLOWED_ORIGINS (comma-separated), defaulting to the
+	// deployed frontends
+	origins, err := allowedOrigins(os.Getenv("ALLOWED_ORIGINS"))
+	if err != nil {
+		log.Fatalf("Failed to configure CORS: %v", err)
+	}
+	log.Printf("CORS allowed origins: %s", strings.Join(origins, ", "))
+
 	c := cors.New(cors.Options{
-		AllowedOrigins: []string{
-			"http://localhost:1234",          // Local frontend
-			"http://127.0.0.1:1234",          // Alternative local
-			"https://pfinance.dev",           // Production custom domain
-			"https://www.pfinance.dev",       // Production www subdomain
-			"https://preview.pfinance.dev",   // Preview custom domain
-			"https://*.preview.pfinance.dev", // PR preview custom domains (pr-123.preview.pfinance.dev)
-			"https://pfinance-app-1748773335.web.app",
-			"https://pfinance-app-1748773335.firebaseapp.com",
-			"https://pfinance-*.vercel.app", // Vercel preview deployments (project-scoped)
-		},
+		AllowedOrigins: origins,
 		AllowedMethods: []string{
 			http.MethodGet,
 			http.MethodPost,
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/firestore"
+)
+
+// readinessCheck probes one dependency and returns an error if it is unhealthy.
+type readinessCheck func(ctx context.Context) error
+
+// readinessResponse is the body returned by the /ready endpoint.
+type readinessResponse struct {
+	Status     string            `json:"status"`
+	Components map[string]string `json:"components"`
+}
+
+// readinessHandler runs every check concurrently, each bounded by timeout,
+// and responds 200 when all pass or 503 with the failing components.
+func readinessHandler(checks map[string]readinessCheck, timeout time.Duration) http.HandlerFunc {
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		errs := make([]error, len(names))
+		var wg sync.WaitGroup
+		for i, name := range names {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = checks[name](ctx)
+			}()
+		}
+		wg.Wait()
+
+		resp := readinessResponse{Status: "ok", Components: make(map[string]string, len(names))}
+		code := http.StatusOK
+		for i, name := range names {
+			if errs[i] != nil {
+				resp.Components[name] = errs[i].Error()
+				resp.Status = "unavailable"
+				code = http.StatusServiceUnavailable
+				continue
+			}
+			resp.Components[name] = "ok"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// firestoreCheck does a single-document read, which fails fast when the
+// client cannot reach Firestore or lacks permission.
+func firestoreCheck(client *firestore.Client) readinessCheck {
+	return func(ctx context.Context) error {
+		_, err := client.Collection("users").Limit(1).Documents(ctx).GetAll()
+		return err
+	}
+}
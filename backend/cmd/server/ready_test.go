@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestReadinessHandler(t *testing.T) {
+	healthy := func(context.Context) error { return nil }
+	broken := func(context.Context) error { return errors.New("connection refused") }
+	slow := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	tests := []struct {
+		name       string
+		checks     map[string]readinessCheck
+		wantCode   int
+		wantStatus string
+		wantFailed string
+	}{
+		{"all healthy", map[string]readinessCheck{"firestore": healthy, "extraction": healthy}, http.StatusOK, "ok", ""},
+		{"no checks", map[string]readinessCheck{}, http.StatusOK, "ok", ""},
+		{"one broken", map[string]readinessCheck{"firestore": broken, "extraction": healthy}, http.StatusServiceUnavailable, "unavailable", "firestore"},
+		{"timeout", map[string]readinessCheck{"firestore": healthy, "extraction": slow}, http.StatusServiceUnavailable, "unavailable", "extraction"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			readinessHandler(tt.checks, 50*time.Millisecond)(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("code = %d, want %d", rec.Code, tt.wantCode)
+			}
+			var resp readinessResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if resp.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", resp.Status, tt.wantStatus)
+			}
+			for name, state := range resp.Components {
+				if failed := state != "ok"; failed != (name == tt.wantFailed) {
+					t.Errorf("component %s = %q", name, state)
+				}
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log"
+	"net/http"
 	"sync"
 	"time"
 
@@ -14,6 +15,13 @@ import (
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
 )
 
+// DefaultApiTokenRateLimit is the requests-per-minute limit for tokens that
+// do not set their own.
+const DefaultApiTokenRateLimit = 120
+
+// MaxApiTokenRateLimit is the highest per-token limit a user may choose.
+const MaxApiTokenRateLimit = 1000
+
 // ApiTokenStore defines the minimal store interface needed by the API token interceptor.
 type ApiTokenStore interface {
 	GetApiTokenByHash(ctx context.Context, hash string) (*pfinancev1.ApiToken, error)
@@ -42,11 +50,12 @@ func HashApiToken(raw string) string {
 
 // cachedClaims stores resolved claims from an API token lookup.
 type cachedClaims struct {
-	claims  *UserClaims
-	subInfo *SubscriptionInfo
-	tokenID string
-	prefix  string
-	expires time.Time
+	claims    *UserClaims
+	subInfo   *SubscriptionInfo
+	tokenID   string
+	prefix    string
+	rateLimit int32
+	expires   time.Time
 }
 
 // apiTokenCache provides a TTL-based cache for API token lookups.
@@ -80,13 +89,58 @@ func (c *apiTokenCache) set(tokenHash string, entry *cachedClaims) {
 	c.entries[tokenHash] = entry
 }
 
+// apiTokenLimiter counts requests per token in fixed windows. All counters
+// reset together when a window ends, so memory stays bounded by the tokens
+// seen in one window.
+type apiTokenLimiter struct {
+	mu          sync.Mutex
+	window      time.Duration
+	windowStart time.Time
+	counts      map[string]int32
+	now         func() time.Time
+}
+
+func newApiTokenLimiter(window time.Duration) *apiTokenLimiter {
+	return &apiTokenLimiter{
+		window: window,
+		counts: make(map[string]int32),
+		now:    time.Now,
+	}
+}
+
+// allow records a request for tokenID and reports whether it is within limit
+// for the current window. A limit of 0 uses DefaultApiTokenRateLimit.
+func (l *apiTokenLimiter) allow(tokenID string, limit int32) bool {
+	if limit <= 0 {
+		limit = DefaultApiTokenRateLimit
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now := l.now(); now.Sub(l.windowStart) >= l.window {
+		clear(l.counts)
+		l.windowStart = now
+	}
+	if l.counts[tokenID] >= limit {
+		return false
+	}
+	l.counts[tokenID]++
+	return true
+}
+
 // ApiTokenInterceptor checks the X-API-Key header, validates against the store,
 // and sets UserClaims + SubscriptionInfo in context.
 // If no X-API-Key header is present, the request falls through to the next interceptor.
 // firebaseAuth is optional — when provided, it's used to look up subscription claims
 // from Firebase custom claims if no user doc exists in the store.
 // Includes a 5-minute TTL cache to avoid repeated Firestore reads for the same token.
-func ApiTokenInterceptor(store ApiTokenStore, firebaseAuth ...*FirebaseAuth) connect.UnaryInterceptorFunc {
+// Each token is limited to its RateLimit requests per minute; requests over the
+// limit fail with CodeResourceExhausted.
+func ApiTokenInterceptor(store ApiTokenStore, firebaseAuth ...*FirebaseAuth) connect.Interceptor {
+	return apiTokenInterceptor(store, newApiTokenLimiter(time.Minute), firebaseAuth...)
+}
+
+func apiTokenInterceptor(store ApiTokenStore, limiter *apiTokenLimiter, firebaseAuth ...*FirebaseAuth) connect.Interceptor {
 	var fbAuth *FirebaseAuth
 	if len(firebaseAuth) > 0 {
 		fbAuth = firebaseAuth[0]
@@ -94,109 +148,115 @@ func ApiTokenInterceptor(store ApiTokenStore, firebaseAuth ...*FirebaseAuth) con
 
 	cache := newApiTokenCache(5 * time.Minute)
 
-	return func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			// Skip for public endpoints
-			if isPublicEndpoint(req.Spec().Procedure) {
-				return next(ctx, req)
-			}
+	return authInterceptor{authenticate: func(ctx context.Context, procedure string, header http.Header) (context.Context, error) {
+		// Skip for public endpoints
+		if isPublicEndpoint(procedure) {
+			return ctx, nil
+		}
 
-			apiKey := req.Header().Get("X-API-Key")
-			if apiKey == "" {
-				// No API key — fall through to normal auth
-				return next(ctx, req)
+		apiKey := header.Get("X-API-Key")
+		if apiKey == "" {
+			// No API key — fall through to normal auth
+			return ctx, nil
+		}
+
+		// Hash the raw token
+		tokenHash := HashApiToken(apiKey)
+
+		// Check cache first
+		if cached, ok := cache.get(tokenHash); ok {
+			if !limiter.allow(cached.tokenID, cached.rateLimit) {
+				return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("API token rate limit exceeded"))
 			}
+			ctx = withUserClaims(ctx, cached.claims)
+			ctx = WithSubscription(ctx, cached.subInfo)
 
-			// Hash the raw token
-			tokenHash := HashApiToken(apiKey)
+			// Fire-and-forget: update last_used_at
+			go func() {
+				bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				if err := store.UpdateApiTokenLastUsed(bgCtx, cached.tokenID, time.Now()); err != nil {
+					log.Printf("[API Token] Failed to update last_used_at for token %s: %v", cached.prefix, err)
+				}
+			}()
 
-			// Check cache first
-			if cached, ok := cache.get(tokenHash); ok {
-				ctx = withUserClaims(ctx, cached.claims)
-				ctx = WithSubscription(ctx, cached.subInfo)
+			return ctx, nil
+		}
 
-				// Fire-and-forget: update last_used_at
-				go func() {
-					bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-					defer cancel()
-					if err := store.UpdateApiTokenLastUsed(bgCtx, cached.tokenID, time.Now()); err != nil {
-						log.Printf("[API Token] Failed to update last_used_at for token %s: %v", cached.prefix, err)
-					}
-				}()
+		// Cache miss — look up the token
+		apiToken, err := store.GetApiTokenByHash(ctx, tokenHash)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid API token"))
+		}
 
-				return next(ctx, req)
+		// Check expiry
+		if apiToken.ExpiresAt != nil && apiToken.ExpiresAt.IsValid() {
+			if apiToken.ExpiresAt.AsTime().Before(time.Now()) {
+				return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("API token has expired"))
 			}
+		}
 
-			// Cache miss — look up the token
-			apiToken, err := store.GetApiTokenByHash(ctx, tokenHash)
-			if err != nil {
-				return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid API token"))
-			}
+		if !limiter.allow(apiToken.Id, apiToken.RateLimit) {
+			return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("API token rate limit exceeded"))
+		}
 
-			// Check expiry
-			if apiToken.ExpiresAt != nil && apiToken.ExpiresAt.IsValid() {
-				if apiToken.ExpiresAt.AsTime().Before(time.Now()) {
-					return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("API token has expired"))
-				}
-			}
+		// Look up user for current subscription status and profile info
+		user, userErr := store.GetUser(ctx, apiToken.UserId)
 
-			// Look up user for current subscription status and profile info
-			user, userErr := store.GetUser(ctx, apiToken.UserId)
+		// Set UserClaims in context — even if user doc doesn't exist, we know the UID from the token
+		claims := &UserClaims{
+			UID:      apiToken.UserId,
+			Verified: true,
+		}
+		subInfo := &SubscriptionInfo{}
 
-			// Set UserClaims in context — even if user doc doesn't exist, we know the UID from the token
-			claims := &UserClaims{
-				UID:      apiToken.UserId,
-				Verified: true,
-			}
-			subInfo := &SubscriptionInfo{}
-
-			if userErr == nil && user != nil {
-				// User doc found — use it for profile and subscription info
-				claims.Email = user.Email
-				claims.DisplayName = user.DisplayName
-				subInfo.Tier = user.SubscriptionTier
-				subInfo.Status = user.SubscriptionStatus
-			} else if fbAuth != nil {
-				// User doc not found — fall back to Firebase custom claims
-				log.Printf("[API Token] User doc not found for %s, checking Firebase custom claims", apiToken.UserId)
-				fbUser, fbErr := fbAuth.client.GetUser(ctx, apiToken.UserId)
-				if fbErr == nil && fbUser != nil {
-					claims.Email = fbUser.Email
-					claims.DisplayName = fbUser.DisplayName
-					if fbUser.CustomClaims != nil {
-						subInfo = GetSubscriptionClaimsFromToken(fbUser.CustomClaims)
-					}
-					log.Printf("[API Token] Got Firebase claims for %s: tier=%v status=%v", apiToken.UserId, subInfo.Tier, subInfo.Status)
-				} else {
-					log.Printf("[API Token] Firebase lookup also failed for %s: %v", apiToken.UserId, fbErr)
+		if userErr == nil && user != nil {
+			// User doc found — use it for profile and subscription info
+			claims.Email = user.Email
+			claims.DisplayName = user.DisplayName
+			subInfo.Tier = user.SubscriptionTier
+			subInfo.Status = user.SubscriptionStatus
+		} else if fbAuth != nil {
+			// User doc not found — fall back to Firebase custom claims
+			log.Printf("[API Token] User doc not found for %s, checking Firebase custom claims", apiToken.UserId)
+			fbUser, fbErr := fbAuth.client.GetUser(ctx, apiToken.UserId)
+			if fbErr == nil && fbUser != nil {
+				claims.Email = fbUser.Email
+				claims.DisplayName = fbUser.DisplayName
+				if fbUser.CustomClaims != nil {
+					subInfo = GetSubscriptionClaimsFromToken(fbUser.CustomClaims)
 				}
+				log.Printf("[API Token] Got Firebase claims for %s: tier=%v status=%v", apiToken.UserId, subInfo.Tier, subInfo.Status)
 			} else {
-				log.Printf("[API Token] User doc not found for %s, no Firebase Auth available: %v", apiToken.UserId, userErr)
+				log.Printf("[API Token] Firebase lookup also failed for %s: %v", apiToken.UserId, fbErr)
 			}
+		} else {
+			log.Printf("[API Token] User doc not found for %s, no Firebase Auth available: %v", apiToken.UserId, userErr)
+		}
 
-			// Cache the resolved claims
-			cache.set(tokenHash, &cachedClaims{
-				claims:  claims,
-				subInfo: subInfo,
-				tokenID: apiToken.Id,
-				prefix:  apiToken.TokenPrefix,
-			})
+		// Cache the resolved claims
+		cache.set(tokenHash, &cachedClaims{
+			claims:    claims,
+			subInfo:   subInfo,
+			tokenID:   apiToken.Id,
+			prefix:    apiToken.TokenPrefix,
+			rateLimit: apiToken.RateLimit,
+		})
 
-			ctx = withUserClaims(ctx, claims)
-			ctx = WithSubscription(ctx, subInfo)
+		ctx = withUserClaims(ctx, claims)
+		ctx = WithSubscription(ctx, subInfo)
 
-			log.Printf("[API Token] Authenticated user %s via token %s (tier=%v)", claims.UID, apiToken.TokenPrefix, subInfo.Tier)
+		log.Printf("[API Token] Authenticated user %s via token %s (tier=%v)", claims.UID, apiToken.TokenPrefix, subInfo.Tier)
 
-			// Fire-and-forget: update last_used_at
-			go func() {
-				bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-				defer cancel()
-				if err := store.UpdateApiTokenLastUsed(bgCtx, apiToken.Id, time.Now()); err != nil {
-					log.Printf("[API Token] Failed to update last_used_at for token %s: %v", apiToken.Id, err)
-				}
-			}()
+		// Fire-and-forget: update last_used_at
+		go func() {
+			bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := store.UpdateApiTokenLastUsed(bgCtx, apiToken.Id, time.Now()); err != nil {
+				log.Printf("[API Token] Failed to update last_used_at for token %s: %v", apiToken.Id, err)
+			}
+		}()
 
-			return next(ctx, req)
-		}
-	}
+		return ctx, nil
+	}}
 }
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeApiTokenStore serves a fixed set of tokens keyed by hash.
+type fakeApiTokenStore struct {
+	tokens map[string]*pfinancev1.ApiToken
+}
+
+func (f *fakeApiTokenStore) GetApiTokenByHash(ctx context.Context, hash string) (*pfinancev1.ApiToken, error) {
+	if token, ok := f.tokens[hash]; ok {
+		return token, nil
+	}
+	return nil, fmt.Errorf("token not found")
+}
+
+func (f *fakeApiTokenStore) GetUser(ctx context.Context, userID string) (*pfinancev1.User, error) {
+	return &pfinancev1.User{Id: userID}, nil
+}
+
+func (f *fakeApiTokenStore) UpdateApiTokenLastUsed(ctx context.Context, tokenID string, lastUsed time.Time) error {
+	return nil
+}
+
+func TestApiTokenInterceptor_RateLimit(t *testing.T) {
+	raw, hash, prefix, err := GenerateApiToken()
+	require.NoError(t, err)
+	store := &fakeApiTokenStore{tokens: map[string]*pfinancev1.ApiToken{
+		hash: {Id: "token-1", UserId: "user-123", TokenPrefix: prefix, TokenHash: hash, RateLimit: 2},
+	}}
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newApiTokenLimiter(time.Minute)
+	limiter.now = func() time.Time { return now }
+	interceptor := apiTokenInterceptor(store, limiter).(authInterceptor)
+
+	header := http.Header{}
+	header.Set("X-API-Key", raw)
+	call := func() error {
+		_, err := interceptor.authenticate(t.Context(), "/pfinance.v1.FinanceService/ListExpenses", header)
+		return err
+	}
+
+	// The first request is a cache miss and the second a cache hit; both count.
+	require.NoError(t, call())
+	require.NoError(t, call())
+	err = call()
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+
+	now = now.Add(time.Minute)
+	assert.NoError(t, call(), "limit should reset after the window")
+}
+
+func TestApiTokenLimiter_DefaultLimit(t *testing.T) {
+	limiter := newApiTokenLimiter(time.Minute)
+	for i := 0; i < DefaultApiTokenRateLimit; i++ {
+		require.True(t, limiter.allow("token-1", 0), "request %d", i)
+	}
+	assert.False(t, limiter.allow("token-1", 0))
+	assert.True(t, limiter.allow("token-2", 0), "tokens are limited independently")
+}
@@ -3,78 +3,109 @@ package auth
 import (
 	"context"
 	"log"
+	"net/http"
 
 	"connectrpc.com/connect"
 )
 
-// AuthInterceptor creates a Connect interceptor for Firebase authentication
-func AuthInterceptor(firebaseAuth *FirebaseAuth) connect.UnaryInterceptorFunc {
-	return func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			// Skip auth for health checks or other public endpoints
-			if isPublicEndpoint(req.Spec().Procedure) {
-				return next(ctx, req)
-			}
+// authenticateFunc resolves the caller for a procedure from the request headers
+// and returns a context carrying their claims.
+type authenticateFunc func(ctx context.Context, procedure string, header http.Header) (context.Context, error)
 
-			// Skip if already authenticated (e.g., by API token interceptor)
-			if _, ok := GetUserClaims(ctx); ok {
-				return next(ctx, req)
-			}
+// authInterceptor applies an authenticateFunc to both unary and streaming handlers,
+// so server-streaming RPCs get the same auth as unary ones.
+type authInterceptor struct {
+	authenticate authenticateFunc
+}
 
-			// Extract token from Authorization header
-			authHeader := req.Header().Get("Authorization")
-			if authHeader == "" {
-				return nil, connect.NewError(connect.CodeUnauthenticated, nil)
-			}
+func (i authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := i.authenticate(ctx, req.Spec().Procedure, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
 
-			token, err := ExtractTokenFromHeader(authHeader)
-			if err != nil {
-				return nil, connect.NewError(connect.CodeUnauthenticated, err)
-			}
+func (i authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
 
-			// Verify the token
-			claims, rawClaims, err := firebaseAuth.VerifyToken(ctx, token)
-			if err != nil {
-				return nil, connect.NewError(connect.CodeUnauthenticated, err)
-			}
+func (i authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authenticate(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
 
-			// Add user claims to context
-			ctx = withUserClaims(ctx, claims)
+// AuthInterceptor creates a Connect interceptor for Firebase authentication
+func AuthInterceptor(firebaseAuth *FirebaseAuth) connect.Interceptor {
+	return authInterceptor{authenticate: func(ctx context.Context, procedure string, header http.Header) (context.Context, error) {
+		// Skip auth for health checks or other public endpoints
+		if isPublicEndpoint(procedure) {
+			return ctx, nil
+		}
 
-			// Extract subscription info from Firebase custom claims
-			if rawClaims != nil {
-				subInfo := GetSubscriptionClaimsFromToken(rawClaims)
-				ctx = WithSubscription(ctx, subInfo)
-				log.Printf("[Auth] User %s: tier=%v status=%v (from token claims)", claims.UID, subInfo.Tier, subInfo.Status)
-			} else {
-				log.Printf("[Auth] User %s: no raw claims in token", claims.UID)
-			}
+		// Skip if already authenticated (e.g., by API token interceptor)
+		if _, ok := GetUserClaims(ctx); ok {
+			return ctx, nil
+		}
 
-			return next(ctx, req)
+		// Extract token from Authorization header
+		authHeader := header.Get("Authorization")
+		if authHeader == "" {
+			return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 		}
-	}
+
+		token, err := ExtractTokenFromHeader(authHeader)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+
+		// Verify the token
+		claims, rawClaims, err := firebaseAuth.VerifyToken(ctx, token)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+
+		// Add user claims to context
+		ctx = withUserClaims(ctx, claims)
+
+		// Extract subscription info from Firebase custom claims
+		if rawClaims != nil {
+			subInfo := GetSubscriptionClaimsFromToken(rawClaims)
+			ctx = WithSubscription(ctx, subInfo)
+			log.Printf("[Auth] User %s: tier=%v status=%v (from token claims)", claims.UID, subInfo.Tier, subInfo.Status)
+		} else {
+			log.Printf("[Auth] User %s: no raw claims in token", claims.UID)
+		}
+
+		return ctx, nil
+	}}
 }
 
 // DebugAuthInterceptor creates an interceptor that allows impersonation via header
 // ONLY use this in development - never in production!
-func DebugAuthInterceptor(skipAuth bool) connect.UnaryInterceptorFunc {
-	return func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			// Only allow impersonation when auth is skipped (dev mode)
-			if skipAuth {
-				impersonateUser := req.Header().Get("X-Debug-Impersonate-User")
-				if impersonateUser != "" {
-					// Create fake claims for the impersonated user
-					claims := &UserClaims{
-						UID:   impersonateUser,
-						Email: impersonateUser + "@debug.local",
-					}
-					ctx = withUserClaims(ctx, claims)
+func DebugAuthInterceptor(skipAuth bool) connect.Interceptor {
+	return authInterceptor{authenticate: func(ctx context.Context, procedure string, header http.Header) (context.Context, error) {
+		// Only allow impersonation when auth is skipped (dev mode)
+		if skipAuth {
+			impersonateUser := header.Get("X-Debug-Impersonate-User")
+			if impersonateUser != "" {
+				// Create fake claims for the impersonated user
+				claims := &UserClaims{
+					UID:   impersonateUser,
+					Email: impersonateUser + "@debug.local",
 				}
+				ctx = withUserClaims(ctx, claims)
 			}
-			return next(ctx, req)
 		}
-	}
+		return ctx, nil
+	}}
 }
 
 // isPublicEndpoint checks if an endpoint should be accessible without authentication
@@ -247,6 +247,7 @@ func BenchmarkExtractPipeline_EndToEnd(b *testing.B) {
 					pfinancev1.DocumentType_DOCUMENT_TYPE_BANK_STATEMENT,
 					false,
 					pfinancev1.ExtractionMethod_EXTRACTION_METHOD_SELF_HOSTED,
+					ConfidenceAutoReject,
 				)
 				if err != nil {
 					b.Fatalf("extraction failed: %v", err)
@@ -0,0 +1,190 @@
+package extraction
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+)
+
+// defaultCSVColumns maps common CSV export headers to fields, for files read
+// without an import profile's column mappings.
+var defaultCSVColumns = map[string]pfinancev1.ImportField{
+	"date":        pfinancev1.ImportField_IMPORT_FIELD_DATE,
+	"description": pfinancev1.ImportField_IMPORT_FIELD_DESCRIPTION,
+	"amount":      pfinancev1.ImportField_IMPORT_FIELD_AMOUNT,
+	"debit":       pfinancev1.ImportField_IMPORT_FIELD_DEBIT,
+	"credit":      pfinancev1.ImportField_IMPORT_FIELD_CREDIT,
+	"balance":     pfinancev1.ImportField_IMPORT_FIELD_BALANCE,
+	"reference":   pfinancev1.ImportField_IMPORT_FIELD_REFERENCE,
+}
+
+// defaultCSVDateFormats are tried in order when no date format is given.
+// Day-first comes before month-first, as Australian banks export it.
+var defaultCSVDateFormats = []string{
+	"2006-01-02",
+	"02/01/2006",
+	"2/1/2006",
+	"02 Jan 2006",
+	"2 Jan 2006",
+	"01/02/2006",
+}
+
+// ParseCSVStatement reads a bank's CSV export into a statement result. The
+// header row is matched case-insensitively against mappings, or against
+// defaultCSVColumns when there are none; dates are parsed with dateFormat (a
+// Go reference layout) when set. Rows that cannot be read are skipped with a
+// warning rather than failing the whole file.
+func ParseCSVStatement(data []byte, mappings []*pfinancev1.ImportColumnMapping, dateFormat string) (*pfinancev1.BankStatementResult, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read CSV header: %w", err)
+	}
+
+	fields := defaultCSVColumns
+	if len(mappings) > 0 {
+		fields = make(map[string]pfinancev1.ImportField, len(mappings))
+		for _, m := range mappings {
+			fields[strings.ToLower(strings.TrimSpace(m.Column))] = m.Field
+		}
+	}
+	columns := make(map[pfinancev1.ImportField]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if field, ok := fields[name]; ok {
+			if _, seen := columns[field]; !seen {
+				columns[field] = i
+			}
+		}
+	}
+	_, hasDate := columns[pfinancev1.ImportField_IMPORT_FIELD_DATE]
+	_, hasDescription := columns[pfinancev1.ImportField_IMPORT_FIELD_DESCRIPTION]
+	_, hasAmount := columns[pfinancev1.ImportField_IMPORT_FIELD_AMOUNT]
+	_, hasDebit := columns[pfinancev1.ImportField_IMPORT_FIELD_DEBIT]
+	_, hasCredit := columns[pfinancev1.ImportField_IMPORT_FIELD_CREDIT]
+	if !hasDate || !hasDescription || !(hasAmount || hasDebit || hasCredit) {
+		return nil, errors.New("CSV needs date, description and amount (or debit/credit) columns")
+	}
+
+	dateFormats := defaultCSVDateFormats
+	if dateFormat != "" {
+		dateFormats = []string{dateFormat}
+	}
+
+	result := &pfinancev1.BankStatementResult{Confidence: 1}
+	for line := 2; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read CSV line %d: %w", line, err)
+		}
+		cell := func(field pfinancev1.ImportField) string {
+			i, ok := columns[field]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		date, err := parseCSVDate(cell(pfinancev1.ImportField_IMPORT_FIELD_DATE), dateFormats)
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+		cents, isDebit, err := csvRowAmount(cell)
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+		tx := &pfinancev1.BankTransaction{
+			Id:          fmt.Sprintf("csv-%d", line),
+			Date:        date.Format("2006-01-02"),
+			Description: cell(pfinancev1.ImportField_IMPORT_FIELD_DESCRIPTION),
+			Amount:      float64(cents) / 100,
+			AmountCents: cents,
+			IsDebit:     isDebit,
+			Confidence:  1,
+		}
+		if balance, err := parseCSVCents(cell(pfinancev1.ImportField_IMPORT_FIELD_BALANCE)); err == nil {
+			tx.Balance = float64(balance) / 100
+		}
+		result.Transactions = append(result.Transactions, tx)
+	}
+	return result, nil
+}
+
+// csvRowAmount returns a row's unsigned amount in cents and whether it is a
+// debit, from a signed amount column or from debit/credit columns.
+func csvRowAmount(cell func(pfinancev1.ImportField) string) (int64, bool, error) {
+	if s := cell(pfinancev1.ImportField_IMPORT_FIELD_AMOUNT); s != "" {
+		cents, err := parseCSVCents(s)
+		if err != nil {
+			return 0, false, err
+		}
+		if cents < 0 {
+			return -cents, true, nil
+		}
+		return cents, false, nil
+	}
+	if s := cell(pfinancev1.ImportField_IMPORT_FIELD_DEBIT); s != "" {
+		cents, err := parseCSVCents(s)
+		if err != nil {
+			return 0, false, err
+		}
+		return max(cents, -cents), true, nil
+	}
+	if s := cell(pfinancev1.ImportField_IMPORT_FIELD_CREDIT); s != "" {
+		cents, err := parseCSVCents(s)
+		if err != nil {
+			return 0, false, err
+		}
+		return max(cents, -cents), false, nil
+	}
+	return 0, false, errors.New("no amount")
+}
+
+// parseCSVCents parses an amount such as "-1,234.50", "$12.00" or "(5.00)"
+// into signed cents.
+func parseCSVCents(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, errors.New("empty amount")
+	}
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative = true
+		s = s[1 : len(s)-1]
+	}
+	s = strings.NewReplacer("$", "", ",", "", " ", "").Replace(s)
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	if negative {
+		v = -v
+	}
+	return int64(math.Round(v * 100)), nil
+}
+
+// parseCSVDate parses s with the first of formats that matches.
+func parseCSVDate(s string, formats []string) (time.Time, error) {
+	for _, layout := range formats {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", s)
+}
@@ -0,0 +1,73 @@
+package extraction
+
+import (
+	"testing"
+
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+)
+
+func TestParseCSVStatement(t *testing.T) {
+	t.Run("mapped columns and custom date format", func(t *testing.T) {
+		data := []byte("Txn Date,Narrative,Value,Running Total\n" +
+			"05-03-2025,WOOLWORTHS 1234,-45.20,954.80\n" +
+			"06-03-2025,\"SALARY ACME, INC\",\"2,000.00\",2954.80\n" +
+			"2025-03-07,BAD DATE,-1.00,2953.80\n")
+		mappings := []*pfinancev1.ImportColumnMapping{
+			{Column: "txn date", Field: pfinancev1.ImportField_IMPORT_FIELD_DATE},
+			{Column: "narrative", Field: pfinancev1.ImportField_IMPORT_FIELD_DESCRIPTION},
+			{Column: "value", Field: pfinancev1.ImportField_IMPORT_FIELD_AMOUNT},
+			{Column: "running total", Field: pfinancev1.ImportField_IMPORT_FIELD_BALANCE},
+		}
+
+		result, err := ParseCSVStatement(data, mappings, "02-01-2006")
+		if err != nil {
+			t.Fatalf("ParseCSVStatement: %v", err)
+		}
+		if len(result.Transactions) != 2 {
+			t.Fatalf("got %d transactions, want 2", len(result.Transactions))
+		}
+		if len(result.Warnings) != 1 {
+			t.Errorf("warnings = %v, want one for the row that doesn't match the date format", result.Warnings)
+		}
+
+		debit := result.Transactions[0]
+		if debit.Date != "2025-03-05" || debit.Description != "WOOLWORTHS 1234" {
+			t.Errorf("debit = (%q, %q), want the day-first date and mapped narrative", debit.Date, debit.Description)
+		}
+		if !debit.IsDebit || debit.AmountCents != 4520 || debit.Balance != 954.80 {
+			t.Errorf("debit = (debit %v, %d cents, balance %v), want (true, 4520, 954.80)", debit.IsDebit, debit.AmountCents, debit.Balance)
+		}
+
+		credit := result.Transactions[1]
+		if credit.IsDebit || credit.AmountCents != 200000 || credit.Date != "2025-03-06" {
+			t.Errorf("credit = (debit %v, %d cents, %q), want (false, 200000, 2025-03-06)", credit.IsDebit, credit.AmountCents, credit.Date)
+		}
+	})
+
+	t.Run("default headers with debit and credit columns", func(t *testing.T) {
+		data := []byte("Date,Description,Debit,Credit\n" +
+			"15/04/2025,NETFLIX,15.99,\n" +
+			"16/04/2025,REFUND,,(20.00)\n")
+
+		result, err := ParseCSVStatement(data, nil, "")
+		if err != nil {
+			t.Fatalf("ParseCSVStatement: %v", err)
+		}
+		if len(result.Transactions) != 2 {
+			t.Fatalf("got %d transactions, want 2", len(result.Transactions))
+		}
+		if tx := result.Transactions[0]; !tx.IsDebit || tx.AmountCents != 1599 || tx.Date != "2025-04-15" {
+			t.Errorf("debit = (debit %v, %d cents, %q), want (true, 1599, 2025-04-15)", tx.IsDebit, tx.AmountCents, tx.Date)
+		}
+		if tx := result.Transactions[1]; tx.IsDebit || tx.AmountCents != 2000 {
+			t.Errorf("credit = (debit %v, %d cents), want (false, 2000)", tx.IsDebit, tx.AmountCents)
+		}
+	})
+
+	t.Run("unmapped headers are rejected", func(t *testing.T) {
+		data := []byte("Txn Date,Narrative,Value\n05-03-2025,X,-1.00\n")
+		if _, err := ParseCSVStatement(data, nil, ""); err == nil {
+			t.Error("expected an error when no date/description/amount columns match")
+		}
+	})
+}
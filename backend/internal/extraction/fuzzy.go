@@ -1,9 +1,11 @@
 package extraction
 
 import (
+	"regexp"
 	"strings"
 	"sync"
 	"time"
+	"unicode"
 
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
 )
@@ -229,11 +231,11 @@ func NormalizeMerchantCached(rawMerchant string, cache *MerchantCache) MerchantI
 	}
 
 	// 6. Keyword fallback
-	for keyword, category := range categoryKeywords {
+	for _, keyword := range categoryKeywordOrder {
 		if strings.Contains(cleaned, keyword) {
 			info := MerchantInfo{
 				Name:       formatCleaned(cleaned),
-				Category:   category,
+				Category:   categoryKeywords[keyword],
 				Confidence: 0.6,
 			}
 			cacheResult(cache, lower, &info)
@@ -256,3 +258,162 @@ func cacheResult(cache *MerchantCache, key string, info *MerchantInfo) {
 		cache.Put(key, info)
 	}
 }
+
+// ─── Noisy bank descriptions ────────────────────────────────────────────────
+
+var (
+	// processorPrefix matches payment-processor tags such as "SQ *", "PAYPAL *"
+	// and "ZLR*" that precede the real merchant name.
+	processorPrefix = regexp.MustCompile(`(?i)^(sq|sqr|paypal|pp|sp|sumup|zlr|tst|lsp|ezi|wpy)\s*\*\s*`)
+	// referenceSuffix matches trailing receipt/reference markers and whatever follows them.
+	referenceSuffix = regexp.MustCompile(`(?i)\s+(ref|receipt|rcpt|inv|txn)\b.*$`)
+	// stateSuffix matches a trailing Australian state or country code.
+	stateSuffix = regexp.MustCompile(`(?i)\s+(nsw|vic|qld|wa|sa|tas|act|nt|aus|au)$`)
+)
+
+// stripMerchantNoise lowercases a bank description and removes processor
+// prefixes, reference suffixes and the store-number/location tail, e.g.
+// "SQ *COFFEE SHOP 123 SYDNE" becomes "coffee shop".
+func stripMerchantNoise(raw string) string {
+	s := strings.ToLower(strings.TrimSpace(raw))
+	s = processorPrefix.ReplaceAllString(s, "")
+	s = referenceSuffix.ReplaceAllString(s, "")
+
+	// Everything from the first store number onwards is location noise,
+	// which banks often truncate mid-word ("sydne").
+	fields := strings.Fields(s)
+	for i, f := range fields {
+		if i > 0 && isStoreNumber(f) {
+			fields = fields[:i]
+			break
+		}
+	}
+	s = strings.Join(fields, " ")
+
+	for {
+		trimmed := stateSuffix.ReplaceAllString(s, "")
+		if trimmed == s {
+			break
+		}
+		s = trimmed
+	}
+	return strings.TrimSpace(s)
+}
+
+// isStoreNumber reports whether a token is a store, terminal or masked card
+// number rather than part of a name.
+func isStoreNumber(token string) bool {
+	token = strings.Trim(token, "#*")
+	if len(token) < 2 {
+		return false
+	}
+	for _, r := range token {
+		if !unicode.IsDigit(r) && r != 'x' {
+			return false
+		}
+	}
+	return unicode.IsDigit(rune(token[len(token)-1]))
+}
+
+// merchantTokenEntry is a dictionary merchant with its key pre-split into tokens.
+type merchantTokenEntry struct {
+	info   MerchantInfo
+	tokens []string
+}
+
+var merchantTokenEntries = func() []merchantTokenEntry {
+	entries := make([]merchantTokenEntry, 0, len(merchantMappings))
+	for key, info := range merchantMappings {
+		entries = append(entries, merchantTokenEntry{info: info, tokens: merchantTokens(key)})
+	}
+	return entries
+}()
+
+// merchantTokens splits s into lowercase alphanumeric tokens.
+func merchantTokens(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// tokenSimilarity scores how well the input tokens cover a merchant's key
+// tokens: the mean, over key tokens, of the best edit-distance similarity to
+// any input token. Key tokens under 4 characters must match exactly.
+func tokenSimilarity(input, key []string) float64 {
+	if len(key) == 0 {
+		return 0
+	}
+	var total float64
+	for _, k := range key {
+		var best float64
+		for _, in := range input {
+			if in == k {
+				best = 1
+				break
+			}
+			if len(k) < 4 || len(in) < 4 {
+				continue
+			}
+			sim := 1 - float64(levenshtein(in, k))/float64(max(len(in), len(k)))
+			best = max(best, sim)
+		}
+		total += best
+	}
+	return total / float64(len(key))
+}
+
+// minTokenSimilarity is the lowest token similarity accepted as a match.
+const minTokenSimilarity = 0.8
+
+// tokenMerchantMatch matches a cleaned description against the known-merchant
+// dictionary token by token, so a misspelt brand is found even when it is
+// surrounded by other words. Ties between different merchants are rejected.
+func tokenMerchantMatch(cleaned string) *MerchantInfo {
+	tokens := merchantTokens(cleaned)
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	var best, runnerUp float64
+	var bestInfo MerchantInfo
+	for _, e := range merchantTokenEntries {
+		score := tokenSimilarity(tokens, e.tokens)
+		switch {
+		case score > best:
+			if e.info.Name != bestInfo.Name {
+				runnerUp = best
+			}
+			best = score
+			bestInfo = e.info
+		case score > runnerUp && e.info.Name != bestInfo.Name:
+			runnerUp = score
+		}
+	}
+
+	if best < minTokenSimilarity || best-runnerUp < 0.1 {
+		return nil
+	}
+	return &MerchantInfo{
+		Name:       bestInfo.Name,
+		Category:   bestInfo.Category,
+		Confidence: 0.9 * best,
+	}
+}
+
+// NormalizeMerchantFuzzy normalizes a noisy bank description. It strips
+// processor prefixes and location/reference suffixes, runs the cached
+// normalizer on what remains, and falls back to token similarity against the
+// known-merchant dictionary when that finds nothing better. The returned
+// Name is the canonical merchant, or the cleaned description when unmatched.
+func NormalizeMerchantFuzzy(rawMerchant string, cache *MerchantCache) MerchantInfo {
+	stripped := stripMerchantNoise(rawMerchant)
+	if stripped == "" {
+		return NormalizeMerchantCached(rawMerchant, cache)
+	}
+
+	info := NormalizeMerchantCached(stripped, cache)
+	if tokenInfo := tokenMerchantMatch(stripped); tokenInfo != nil && tokenInfo.Confidence > info.Confidence {
+		return *tokenInfo
+	}
+	return info
+}
@@ -2,10 +2,12 @@ package extraction
 
 import (
 	"context"
+	"math"
 	"testing"
 	"time"
 
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ─── Levenshtein tests ──────────────────────────────────────────────────────
@@ -257,6 +259,66 @@ func TestStoreMerchantLookup_CachesResults(t *testing.T) {
 	}
 }
 
+func TestDecayedConfidence(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		lastUsed *timestamppb.Timestamp
+		want     float64
+	}{
+		{"no last used", nil, 0.8},
+		{"just used", timestamppb.New(now), 0.8},
+		{"one half-life", timestamppb.New(now.Add(-mappingHalfLife)), 0.4},
+		{"two half-lives", timestamppb.New(now.Add(-2 * mappingHalfLife)), 0.2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &pfinancev1.MerchantMapping{Confidence: 0.8, LastUsed: tt.lastUsed}
+			if got := decayedConfidence(m, now); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("decayedConfidence() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostProcessResult_MappingDecay(t *testing.T) {
+	tests := []struct {
+		name     string
+		lastUsed time.Time
+		want     string
+	}{
+		{"fresh mapping wins", time.Now().Add(-24 * time.Hour), "Woolies Metro"},
+		{"stale mapping loses", time.Now().Add(-2 * 365 * 24 * time.Hour), "Woolworths"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mockMerchantStore{
+				mappings: []*pfinancev1.MerchantMapping{
+					{
+						RawPattern:     "woolworths",
+						NormalizedName: "Woolies Metro",
+						Category:       pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD,
+						Confidence:     0.99,
+						LastUsed:       timestamppb.New(tt.lastUsed),
+					},
+				},
+			}
+			svc := &ExtractionService{merchantLookup: NewStoreMerchantLookup(store)}
+			result := &pfinancev1.ExtractionResult{
+				Transactions: []*pfinancev1.ExtractedTransaction{
+					{Description: "WOOLWORTHS 1234 SYDNEY", Amount: 42.5, Confidence: 0.9},
+				},
+			}
+
+			svc.postProcessResultWithUser(context.Background(), "user1", result, ConfidenceAutoReject)
+
+			if got := result.Transactions[0].NormalizedMerchant; got != tt.want {
+				t.Errorf("NormalizedMerchant = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 type countingMerchantStore struct {
 	mappings  []*pfinancev1.MerchantMapping
 	callCount *int
@@ -307,3 +369,64 @@ func BenchmarkNormalizeMerchantCached(b *testing.B) {
 		NormalizeMerchantCached(inputs[i%len(inputs)], cache)
 	}
 }
+
+// ─── Noisy description tests ────────────────────────────────────────────────
+
+func TestStripMerchantNoise(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"SQ *COFFEE SHOP 123 SYDNE", "coffee shop"},
+		{"SQ*BLUE BOTTLE", "blue bottle"},
+		{"PAYPAL *NETFLIX", "netflix"},
+		{"ZLR*THE BAKEHOUSE", "the bakehouse"},
+		{"WOOLWORTHS 1234 BONDI NSW", "woolworths"},
+		{"KMART #0456 CHADSTONE VIC AU", "kmart"},
+		{"CHEMIST WAREHOUSE REF 99812", "chemist warehouse"},
+		{"SPOTIFY XXXX1234", "spotify"},
+		{"BONDI ICEBERGS NSW", "bondi icebergs"},
+		{"7 ELEVEN 2041 MELBOURNE", "7 eleven"},
+		{"  ", ""},
+	}
+
+	for _, tt := range tests {
+		if got := stripMerchantNoise(tt.input); got != tt.want {
+			t.Errorf("stripMerchantNoise(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeMerchantFuzzy(t *testing.T) {
+	tests := []struct {
+		input        string
+		wantName     string
+		wantCategory pfinancev1.ExpenseCategory
+		minConf      float64
+	}{
+		// Dictionary hit once the processor prefix is gone
+		{"PAYPAL *NETFLIX 8821 AU", "Netflix", pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT, 0.9},
+		// Misspelt multi-word brand surrounded by location noise
+		{"CHEMIST WAREHOUS 0412 PARRAMATTA", "Chemist Warehouse", pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_HEALTHCARE, 0.72},
+		// Unknown merchant falls back to the cleaned name
+		{"SQ *COFFEE SHOP 123 SYDNE", "Coffee Shop", pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD, 0.5},
+	}
+
+	for _, tt := range tests {
+		got := NormalizeMerchantFuzzy(tt.input, nil)
+		if got.Name != tt.wantName || got.Category != tt.wantCategory {
+			t.Errorf("NormalizeMerchantFuzzy(%q) = (%q, %v), want (%q, %v)", tt.input, got.Name, got.Category, tt.wantName, tt.wantCategory)
+		}
+		if got.Confidence < tt.minConf {
+			t.Errorf("NormalizeMerchantFuzzy(%q) confidence = %.2f, want >= %.2f", tt.input, got.Confidence, tt.minConf)
+		}
+	}
+}
+
+func TestTokenMerchantMatch_RejectsWeakMatches(t *testing.T) {
+	for _, input := range []string{"corner deli", "xyz", ""} {
+		if got := tokenMerchantMatch(input); got != nil {
+			t.Errorf("tokenMerchantMatch(%q) = %+v, want nil", input, got)
+		}
+	}
+}
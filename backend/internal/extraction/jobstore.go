@@ -6,10 +6,13 @@ import (
 	"time"
 
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // JobStore manages in-memory async extraction jobs.
+// Jobs are copied on the way in and out, so a background worker can keep
+// mutating its own job while pollers read consistent snapshots.
 type JobStore struct {
 	mu   sync.RWMutex
 	jobs map[string]*pfinancev1.ExtractionJob
@@ -35,7 +38,7 @@ func (js *JobStore) Create(job *pfinancev1.ExtractionJob) error {
 	}
 	js.mu.Lock()
 	defer js.mu.Unlock()
-	js.jobs[job.Id] = job
+	js.jobs[job.Id] = proto.Clone(job).(*pfinancev1.ExtractionJob)
 	return nil
 }
 
@@ -47,7 +50,7 @@ func (js *JobStore) Get(id string) (*pfinancev1.ExtractionJob, error) {
 	if !ok {
 		return nil, fmt.Errorf("job not found: %s", id)
 	}
-	return job, nil
+	return proto.Clone(job).(*pfinancev1.ExtractionJob), nil
 }
 
 // Update modifies an existing job.
@@ -57,10 +60,54 @@ func (js *JobStore) Update(job *pfinancev1.ExtractionJob) error {
 	if _, ok := js.jobs[job.Id]; !ok {
 		return fmt.Errorf("job not found: %s", job.Id)
 	}
-	js.jobs[job.Id] = job
+	js.jobs[job.Id] = proto.Clone(job).(*pfinancev1.ExtractionJob)
 	return nil
 }
 
+// RecoverRejected moves the completed job's rejected transactions with the
+// given IDs into its accepted transactions, marking them user-confirmed so
+// import keeps them despite their confidence. It returns copies of the
+// recovered transactions and the IDs that weren't among the rejected ones.
+func (js *JobStore) RecoverRejected(id string, transactionIDs []string) ([]*pfinancev1.ExtractedTransaction, []string, error) {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	job, ok := js.jobs[id]
+	if !ok {
+		return nil, nil, fmt.Errorf("job not found: %s", id)
+	}
+	if job.Status != pfinancev1.ExtractionStatus_EXTRACTION_STATUS_COMPLETED || job.Result == nil {
+		return nil, nil, fmt.Errorf("job %s has not completed", id)
+	}
+
+	wanted := make(map[string]bool, len(transactionIDs))
+	for _, txID := range transactionIDs {
+		wanted[txID] = true
+	}
+
+	var recovered []*pfinancev1.ExtractedTransaction
+	remaining := job.Result.RejectedTransactions[:0]
+	for _, tx := range job.Result.RejectedTransactions {
+		if !wanted[tx.Id] {
+			remaining = append(remaining, tx)
+			continue
+		}
+		delete(wanted, tx.Id)
+		tx.UserConfirmed = true
+		job.Result.Transactions = append(job.Result.Transactions, tx)
+		recovered = append(recovered, proto.Clone(tx).(*pfinancev1.ExtractedTransaction))
+	}
+	job.Result.RejectedTransactions = remaining
+
+	var notFound []string
+	for _, txID := range transactionIDs {
+		if wanted[txID] {
+			notFound = append(notFound, txID)
+			delete(wanted, txID)
+		}
+	}
+	return recovered, notFound, nil
+}
+
 // Stop signals the background cleanup goroutine to exit.
 func (js *JobStore) Stop() {
 	close(js.done)
@@ -0,0 +1,132 @@
+package extraction
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+)
+
+func TestJobStore_ReturnsSnapshots(t *testing.T) {
+	js := NewJobStore(time.Hour)
+	defer js.Stop()
+
+	job := NewExtractionJobProto("job-1", "user-1", pfinancev1.DocumentType_DOCUMENT_TYPE_BANK_STATEMENT, "statement.pdf", pfinancev1.ExtractionMethod_EXTRACTION_METHOD_UNSPECIFIED)
+	if err := js.Create(job); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	// Mutating the worker's copy must not leak into the store until Update
+	job.ProcessedPages = 2
+	got, err := js.Get("job-1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.ProcessedPages != 0 {
+		t.Errorf("expected stored ProcessedPages=0 before Update, got %d", got.ProcessedPages)
+	}
+
+	if err := js.Update(job); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	got, _ = js.Get("job-1")
+	if got.ProcessedPages != 2 {
+		t.Errorf("expected ProcessedPages=2 after Update, got %d", got.ProcessedPages)
+	}
+}
+
+func TestJobStore_RecoverRejected(t *testing.T) {
+	js := NewJobStore(time.Hour)
+	defer js.Stop()
+
+	job := NewExtractionJobProto("job-1", "user-1", pfinancev1.DocumentType_DOCUMENT_TYPE_BANK_STATEMENT, "statement.pdf", pfinancev1.ExtractionMethod_EXTRACTION_METHOD_UNSPECIFIED)
+	if err := js.Create(job); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, _, err := js.RecoverRejected("job-1", []string{"r1"}); err == nil {
+		t.Error("expected an error recovering from a job that hasn't completed")
+	}
+
+	job.Status = pfinancev1.ExtractionStatus_EXTRACTION_STATUS_COMPLETED
+	job.Result = &pfinancev1.ExtractionResult{
+		Transactions: []*pfinancev1.ExtractedTransaction{{Id: "t1", Confidence: 0.9, IsDebit: true}},
+		RejectedTransactions: []*pfinancev1.ExtractedTransaction{
+			{Id: "r1", Confidence: 0.1, IsDebit: true},
+			{Id: "r2", Confidence: 0.2, IsDebit: true},
+		},
+	}
+	if err := js.Update(job); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	recovered, notFound, err := js.RecoverRejected("job-1", []string{"r2", "t1", "missing"})
+	if err != nil {
+		t.Fatalf("RecoverRejected: %v", err)
+	}
+	if len(recovered) != 1 || recovered[0].Id != "r2" || !recovered[0].UserConfirmed {
+		t.Fatalf("recovered = %v, want r2 marked user-confirmed", recovered)
+	}
+	if strings.Join(notFound, ",") != "t1,missing" {
+		t.Errorf("notFound = %v, want [t1 missing]", notFound)
+	}
+	if disposition, _ := ImportDispositionFor(recovered[0], ConfidenceAutoReject); disposition != pfinancev1.ImportDispositionType_IMPORT_DISPOSITION_TYPE_CREATE {
+		t.Errorf("disposition = %v, want CREATE for a recovered transaction", disposition)
+	}
+
+	got, _ := js.Get("job-1")
+	if len(got.Result.Transactions) != 2 || len(got.Result.RejectedTransactions) != 1 || got.Result.RejectedTransactions[0].Id != "r1" {
+		t.Errorf("job result = %v, want r2 moved to transactions and r1 still rejected", got.Result)
+	}
+}
+
+func TestProcessAsyncExtraction_PageByPage(t *testing.T) {
+	svc := NewExtractionService(Config{})
+	defer svc.jobStore.Stop()
+
+	filler := "Account 123456 Opening balance carried forward from previous statement period"
+	data := buildTextPDF([][]string{
+		{"Bank statement for July 2025", filler, filler, filler},
+		{"01/07/2025 WOOLWORTHS SYDNEY 45.20", "02/07/2025 UBER TRIP SYDNEY 12.50", filler, filler},
+		{"03/07/2025 NETFLIX SUBSCRIPTION 16.99", filler, filler, filler},
+	})
+
+	job := NewExtractionJobProto("job-pages", "user-1", pfinancev1.DocumentType_DOCUMENT_TYPE_BANK_STATEMENT, "statement.pdf", pfinancev1.ExtractionMethod_EXTRACTION_METHOD_UNSPECIFIED)
+	job.TotalPages = int32(CountPDFPagesAccurate(data))
+	job.Status = pfinancev1.ExtractionStatus_EXTRACTION_STATUS_PROCESSING
+	if err := svc.jobStore.Create(job); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	svc.processAsyncExtraction(context.Background(), job, data, "statement.pdf", pfinancev1.DocumentType_DOCUMENT_TYPE_BANK_STATEMENT, pfinancev1.ExtractionMethod_EXTRACTION_METHOD_UNSPECIFIED, ConfidenceAutoReject)
+
+	got, err := svc.GetJob("job-pages")
+	if err != nil {
+		t.Fatalf("GetJob: %v", err)
+	}
+	if got.Status != pfinancev1.ExtractionStatus_EXTRACTION_STATUS_COMPLETED {
+		t.Fatalf("expected COMPLETED, got %v (%s)", got.Status, got.ErrorMessage)
+	}
+	if got.TotalPages != 3 || got.ProcessedPages != 3 || got.CurrentPage != 3 {
+		t.Errorf("expected 3/3 pages processed, got total=%d processed=%d current=%d", got.TotalPages, got.ProcessedPages, got.CurrentPage)
+	}
+	if got.ProgressPercent != 100 {
+		t.Errorf("expected ProgressPercent=100, got %v", got.ProgressPercent)
+	}
+
+	all := append(got.Result.Transactions, got.Result.RejectedTransactions...)
+	if len(all) != 3 {
+		t.Fatalf("expected 3 transactions, got %d", len(all))
+	}
+	ids := map[string]bool{}
+	for _, tx := range all {
+		if ids[tx.Id] {
+			t.Errorf("duplicate transaction ID %q across pages", tx.Id)
+		}
+		ids[tx.Id] = true
+		if !strings.HasPrefix(tx.Id, "text-p") {
+			t.Errorf("expected page-scoped transaction ID, got %q", tx.Id)
+		}
+	}
+}
@@ -15,6 +15,24 @@ func overrideConfidence(count int32) float64 {
 	return math.Min(0.99, 0.8+0.05*float64(count))
 }
 
+// mappingHalfLife is how long it takes an unused merchant mapping to lose half
+// of its confidence, so stale user corrections gradually yield to the static
+// normalizer.
+const mappingHalfLife = 180 * 24 * time.Hour
+
+// decayedConfidence returns the mapping's confidence decayed by the time since
+// it was last used. Mappings without a LastUsed timestamp are not decayed.
+func decayedConfidence(m *pfinancev1.MerchantMapping, now time.Time) float64 {
+	if m.LastUsed == nil {
+		return m.Confidence
+	}
+	age := now.Sub(m.LastUsed.AsTime())
+	if age <= 0 {
+		return m.Confidence
+	}
+	return m.Confidence * math.Pow(0.5, float64(age)/float64(mappingHalfLife))
+}
+
 // MerchantMappingStore is the subset of the store interface needed for merchant lookups.
 type MerchantMappingStore interface {
 	GetMerchantMappings(ctx context.Context, userID string) ([]*pfinancev1.MerchantMapping, error)
@@ -47,7 +65,8 @@ func (l *StoreMerchantLookup) SetCategoryOverrideStore(store CategoryOverrideSto
 }
 
 // LookupMerchant checks user-specific merchant mappings with exact, substring,
-// and fuzzy matching. Results are cached per user+merchant pair.
+// and fuzzy matching. Mapping confidence decays with time since last use.
+// Results are cached per user+merchant pair.
 func (l *StoreMerchantLookup) LookupMerchant(ctx context.Context, userID string, rawMerchant string) (*MerchantInfo, error) {
 	lower := strings.ToLower(strings.TrimSpace(rawMerchant))
 	cacheKey := fmt.Sprintf("%s:%s", userID, lower)
@@ -83,6 +102,7 @@ func (l *StoreMerchantLookup) LookupMerchant(ctx context.Context, userID string,
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now()
 
 	// Pass 1: exact/substring match (high confidence)
 	for _, m := range mappings {
@@ -91,7 +111,7 @@ func (l *StoreMerchantLookup) LookupMerchant(ctx context.Context, userID string,
 			info := &MerchantInfo{
 				Name:       m.NormalizedName,
 				Category:   m.Category,
-				Confidence: m.Confidence,
+				Confidence: decayedConfidence(m, now),
 			}
 			l.cacheResult(cacheKey, info)
 			return info, nil
@@ -117,6 +137,9 @@ func (l *StoreMerchantLookup) LookupMerchant(ctx context.Context, userID string,
 		if confidence < 0.5 {
 			confidence = 0.5
 		}
+		if bestMapping.Confidence > 0 {
+			confidence *= decayedConfidence(bestMapping, now) / bestMapping.Confidence
+		}
 		info := &MerchantInfo{
 			Name:       bestMapping.NormalizedName,
 			Category:   bestMapping.Category,
@@ -3,6 +3,7 @@ package extraction
 
 import (
 	"regexp"
+	"sort"
 	"strings"
 
 	"golang.org/x/text/cases"
@@ -181,7 +182,24 @@ type merchantWordEntry struct {
 
 var merchantWordPatterns []merchantWordEntry
 
+// categoryKeywordOrder lists categoryKeywords longest first, so a description
+// matching several keywords ("coffee shop") takes the most specific one
+// rather than whichever map iteration reaches first.
+var categoryKeywordOrder []string
+
 func init() {
+	categoryKeywordOrder = make([]string, 0, len(categoryKeywords))
+	for keyword := range categoryKeywords {
+		categoryKeywordOrder = append(categoryKeywordOrder, keyword)
+	}
+	sort.Slice(categoryKeywordOrder, func(i, j int) bool {
+		a, b := categoryKeywordOrder[i], categoryKeywordOrder[j]
+		if len(a) != len(b) {
+			return len(a) > len(b)
+		}
+		return a < b
+	})
+
 	merchantWordPatterns = make([]merchantWordEntry, 0, len(merchantMappings))
 	for key, info := range merchantMappings {
 		var longWords []string
@@ -230,11 +248,11 @@ func NormalizeMerchant(rawMerchant string) MerchantInfo {
 
 	// Fall back to keyword-based categorization.
 	// Pass already-cleaned string to avoid re-running the 4 regexes.
-	for keyword, category := range categoryKeywords {
+	for _, keyword := range categoryKeywordOrder {
 		if strings.Contains(cleaned, keyword) {
 			return MerchantInfo{
 				Name:       formatCleaned(cleaned),
-				Category:   category,
+				Category:   categoryKeywords[keyword],
 				Confidence: 0.6,
 			}
 		}
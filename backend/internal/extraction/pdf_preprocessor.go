@@ -107,6 +107,64 @@ func AnalyzePDF(data []byte) (result *PDFAnalysis) {
 	return result
 }
 
+// AnalyzePDFPages runs the same analysis as AnalyzePDF on each page separately,
+// so callers can extract and report progress page by page.
+// Like AnalyzePDF it never panics; on any error it returns nil and the error.
+func AnalyzePDFPages(data []byte) (pages []*PDFAnalysis, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[pdf-preprocessor] recovered from panic: %v", r)
+			pages = nil
+			err = fmt.Errorf("panic during PDF page analysis: %v", r)
+		}
+	}()
+
+	reader, err := pdf.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("open PDF reader: %w", err)
+	}
+
+	fonts := make(map[string]*pdf.Font)
+	for i := 1; i <= reader.NumPage(); i++ {
+		page := reader.Page(i)
+		if page.V.IsNull() {
+			// Keep page indexes aligned with the document
+			pages = append(pages, &PDFAnalysis{PageCount: 1, IsScanned: true, MaxOutputTokens: defaultMaxTokens})
+			continue
+		}
+		for _, name := range page.Fonts() {
+			if _, ok := fonts[name]; !ok {
+				f := page.Font(name)
+				fonts[name] = &f
+			}
+		}
+		text, err := page.GetPlainText(fonts)
+		if err != nil {
+			return nil, fmt.Errorf("extract plain text from page %d: %w", i, err)
+		}
+		if len(text) > maxTextBytes {
+			text = text[:maxTextBytes]
+		}
+
+		analysis := &PDFAnalysis{
+			PageCount:     1,
+			ExtractedText: text,
+			IsScanned:     isLikelyScanned(text, 1),
+		}
+		for _, line := range strings.Split(text, "\n") {
+			trimmed := strings.TrimSpace(line)
+			if trimmed != "" {
+				analysis.TextLines = append(analysis.TextLines, trimmed)
+			}
+		}
+		analysis.EstimatedTxCount = countTransactionLines(analysis.TextLines)
+		analysis.MaxOutputTokens = estimateOutputTokens(analysis.EstimatedTxCount)
+		pages = append(pages, analysis)
+	}
+
+	return pages, nil
+}
+
 // countTransactionLines counts lines that look like financial transactions
 // (contain both a date-like pattern and a monetary amount).
 func countTransactionLines(lines []string) int {
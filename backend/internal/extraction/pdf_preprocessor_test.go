@@ -1,6 +1,9 @@
 package extraction
 
 import (
+	"bytes"
+	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -169,6 +172,77 @@ func TestAnalyzePDF_EmptyData(t *testing.T) {
 	}
 }
 
+// buildTextPDF builds a minimal text PDF with one Helvetica text line per entry.
+func buildTextPDF(pages [][]string) []byte {
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 4+2*i)
+	}
+	objs := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+	}
+	for i, lines := range pages {
+		var content strings.Builder
+		content.WriteString("BT /F1 10 Tf 12 TL 50 780 Td\n")
+		for _, line := range lines {
+			fmt.Fprintf(&content, "(%s) Tj T*\n", line)
+		}
+		content.WriteString("ET")
+		objs = append(objs,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", 5+2*i),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+		)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objs))
+	for i, obj := range objs {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objs)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objs)+1, xref)
+	return buf.Bytes()
+}
+
+func TestAnalyzePDFPages(t *testing.T) {
+	data := buildTextPDF([][]string{
+		{"Statement summary"},
+		{"01/07/2025 WOOLWORTHS SYDNEY 45.20", "02/07/2025 UBER TRIP 12.50"},
+	})
+
+	pages, err := AnalyzePDFPages(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pages) != 2 {
+		t.Fatalf("expected 2 pages, got %d", len(pages))
+	}
+	if pages[0].EstimatedTxCount != 0 {
+		t.Errorf("page 1: expected 0 transaction lines, got %d", pages[0].EstimatedTxCount)
+	}
+	if pages[1].EstimatedTxCount != 2 {
+		t.Errorf("page 2: expected 2 transaction lines, got %d", pages[1].EstimatedTxCount)
+	}
+}
+
+func TestAnalyzePDFPages_InvalidData(t *testing.T) {
+	pages, err := AnalyzePDFPages([]byte("not a pdf"))
+	if err == nil {
+		t.Fatal("expected error for invalid PDF data")
+	}
+	if pages != nil {
+		t.Fatalf("expected nil pages, got %d", len(pages))
+	}
+}
+
 func TestEstimateOutputTokens_AlwaysRoundsUp(t *testing.T) {
 	// Edge case: exactly on boundary
 	for txCount := 1; txCount <= 300; txCount++ {
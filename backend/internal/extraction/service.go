@@ -14,7 +14,9 @@ import (
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
 )
 
-// Confidence threshold constants.
+// Confidence threshold constants. The auto-reject, low-warning and
+// pre-deselect thresholds are defaults that a user's ExtractionPreferences
+// can override.
 const (
 	ConfidenceAutoReject  = 0.3 // Backend: transaction placed in rejected_transactions
 	ConfidenceLowWarning  = 0.6 // Frontend: yellow badge, "please verify" warning
@@ -25,14 +27,15 @@ const (
 
 // Extractor is the interface for document extraction operations.
 type Extractor interface {
-	ExtractDocumentWithMethod(ctx context.Context, data []byte, filename string, docType pfinancev1.DocumentType, validateWithAPI bool, method pfinancev1.ExtractionMethod) (*pfinancev1.ExtractionResult, error)
+	ExtractDocumentWithMethod(ctx context.Context, data []byte, filename string, docType pfinancev1.DocumentType, validateWithAPI bool, method pfinancev1.ExtractionMethod, autoRejectThreshold float64) (*pfinancev1.ExtractionResult, error)
 	IsGeminiAvailable() bool
 	IsEnabled() bool
 	ParseExpenseText(ctx context.Context, text string) (*pfinancev1.ParseExpenseTextResponse, error)
 	ParseBankStatement(ctx context.Context, pdfData []byte, bankHint string, method pfinancev1.ExtractionMethod) (*pfinancev1.BankStatementResult, error)
-	ImportTransactions(ctx context.Context, userID string, groupID string, transactions []*pfinancev1.ExtractedTransaction, skipDuplicates bool, defaultFrequency pfinancev1.ExpenseFrequency) ([]*pfinancev1.Expense, int, []string, error)
+	ImportTransactions(ctx context.Context, userID string, groupID string, transactions []*pfinancev1.ExtractedTransaction, skipDuplicates bool, defaultFrequency pfinancev1.ExpenseFrequency, autoRejectThreshold float64) ([]*pfinancev1.Expense, int, []string, error)
 	GetJob(id string) (*pfinancev1.ExtractionJob, error)
-	StartAsyncExtraction(ctx context.Context, userID string, data []byte, filename string, docType pfinancev1.DocumentType, method pfinancev1.ExtractionMethod) (string, error)
+	RecoverRejectedTransactions(jobID string, transactionIDs []string) ([]*pfinancev1.ExtractedTransaction, []string, error)
+	StartAsyncExtraction(ctx context.Context, userID string, data []byte, filename string, docType pfinancev1.DocumentType, method pfinancev1.ExtractionMethod, autoRejectThreshold float64) (string, error)
 	ExtractMetadataOnly(ctx context.Context, data []byte) (*pfinancev1.StatementMetadata, error)
 	CheckStatementDuplicate(ctx context.Context, userID string, metadata *pfinancev1.StatementMetadata) (bool, []string, error)
 	RecordProcessedStatement(ctx context.Context, userID string, metadata *pfinancev1.StatementMetadata, filename string, importedCount int32) error
@@ -225,7 +228,7 @@ func (s *ExtractionService) ExtractDocument(
 	docType pfinancev1.DocumentType,
 	validateWithAPI bool,
 ) (*pfinancev1.ExtractionResult, error) {
-	return s.ExtractDocumentWithMethod(ctx, data, filename, docType, validateWithAPI, pfinancev1.ExtractionMethod_EXTRACTION_METHOD_UNSPECIFIED)
+	return s.ExtractDocumentWithMethod(ctx, data, filename, docType, validateWithAPI, pfinancev1.ExtractionMethod_EXTRACTION_METHOD_UNSPECIFIED, ConfidenceAutoReject)
 }
 
 // buildFallbackChain returns an ordered list of methods to try.
@@ -335,6 +338,8 @@ func (s *ExtractionService) tryExtract(
 }
 
 // ExtractDocumentWithMethod extracts transactions using the specified method with fallback chain.
+// Transactions below autoRejectThreshold confidence are moved to RejectedTransactions;
+// a threshold of 0 keeps them all.
 func (s *ExtractionService) ExtractDocumentWithMethod(
 	ctx context.Context,
 	data []byte,
@@ -342,6 +347,7 @@ func (s *ExtractionService) ExtractDocumentWithMethod(
 	docType pfinancev1.DocumentType,
 	validateWithAPI bool,
 	method pfinancev1.ExtractionMethod,
+	autoRejectThreshold float64,
 ) (*pfinancev1.ExtractionResult, error) {
 	chain := s.buildFallbackChain(method)
 	var lastErr error
@@ -385,7 +391,7 @@ func (s *ExtractionService) ExtractDocumentWithMethod(
 	}
 
 	// Apply merchant normalization, confidence merging, and rejection
-	s.postProcessResult(protoResult)
+	s.postProcessResultWithUser(ctx, "", protoResult, autoRejectThreshold)
 
 	// Optionally validate with commercial API (only for self-hosted)
 	if validateWithAPI && s.validator != nil && protoResult.MethodUsed != pfinancev1.ExtractionMethod_EXTRACTION_METHOD_GEMINI {
@@ -401,13 +407,15 @@ func (s *ExtractionService) ExtractDocumentWithMethod(
 	return protoResult, nil
 }
 
-// postProcessResult applies normalization, confidence merging, and auto-rejection.
+// postProcessResult applies normalization, confidence merging, and auto-rejection
+// at the default ConfidenceAutoReject threshold.
 func (s *ExtractionService) postProcessResult(result *pfinancev1.ExtractionResult) {
-	s.postProcessResultWithUser(context.Background(), "", result)
+	s.postProcessResultWithUser(context.Background(), "", result, ConfidenceAutoReject)
 }
 
-// postProcessResultWithUser applies normalization with user-specific merchant lookups.
-func (s *ExtractionService) postProcessResultWithUser(ctx context.Context, userID string, result *pfinancev1.ExtractionResult) {
+// postProcessResultWithUser applies normalization with user-specific merchant lookups
+// and rejects transactions with confidence below autoRejectThreshold.
+func (s *ExtractionService) postProcessResultWithUser(ctx context.Context, userID string, result *pfinancev1.ExtractionResult, autoRejectThreshold float64) {
 	var accepted []*pfinancev1.ExtractedTransaction
 	var rejected []*pfinancev1.ExtractedTransaction
 
@@ -420,8 +428,8 @@ func (s *ExtractionService) postProcessResultWithUser(ctx context.Context, userI
 			}
 		}
 
-		// 2. Static normalizer with fuzzy matching + cache
-		info := NormalizeMerchantCached(tx.Description, s.merchantCache)
+		// 2. Static normalizer with noise stripping, fuzzy matching + cache
+		info := NormalizeMerchantFuzzy(tx.Description, s.merchantCache)
 
 		// Prefer user mapping over static
 		if userInfo != nil && userInfo.Confidence > info.Confidence {
@@ -453,7 +461,7 @@ func (s *ExtractionService) postProcessResultWithUser(ctx context.Context, userI
 		}
 
 		// Auto-reject low confidence transactions
-		if tx.Confidence < ConfidenceAutoReject {
+		if tx.Confidence < autoRejectThreshold {
 			rejected = append(rejected, tx)
 		} else {
 			accepted = append(accepted, tx)
@@ -580,7 +588,27 @@ func parseFrequency(frequency string) pfinancev1.ExpenseFrequency {
 	}
 }
 
-// ImportTransactions converts extracted transactions to expenses.
+// ImportDispositionFor reports whether ImportTransactions would convert tx into
+// an expense at autoRejectThreshold and, if not, the reason it would be skipped.
+func ImportDispositionFor(tx *pfinancev1.ExtractedTransaction, autoRejectThreshold float64) (pfinancev1.ImportDispositionType, string) {
+	// Skip if not a debit
+	if !tx.IsDebit {
+		return pfinancev1.ImportDispositionType_IMPORT_DISPOSITION_TYPE_SKIP_CREDIT,
+			fmt.Sprintf("Skipped credit transaction: %s", tx.Description)
+	}
+
+	// Skip if confidence is too low (use auto-reject threshold), unless the
+	// user recovered the transaction from the rejected list
+	if tx.Confidence < autoRejectThreshold && !tx.UserConfirmed {
+		return pfinancev1.ImportDispositionType_IMPORT_DISPOSITION_TYPE_SKIP_LOW_CONFIDENCE,
+			fmt.Sprintf("Low confidence (%.0f%%): %s", tx.Confidence*100, tx.Description)
+	}
+
+	return pfinancev1.ImportDispositionType_IMPORT_DISPOSITION_TYPE_CREATE, ""
+}
+
+// ImportTransactions converts extracted transactions to expenses, skipping
+// credits and unconfirmed transactions below autoRejectThreshold.
 func (s *ExtractionService) ImportTransactions(
 	ctx context.Context,
 	userID string,
@@ -588,6 +616,7 @@ func (s *ExtractionService) ImportTransactions(
 	transactions []*pfinancev1.ExtractedTransaction,
 	skipDuplicates bool,
 	defaultFrequency pfinancev1.ExpenseFrequency,
+	autoRejectThreshold float64,
 ) ([]*pfinancev1.Expense, int, []string, error) {
 	var expenses []*pfinancev1.Expense
 	var skippedReasons []string
@@ -599,17 +628,9 @@ func (s *ExtractionService) ImportTransactions(
 	}
 
 	for _, tx := range transactions {
-		// Skip if not a debit
-		if !tx.IsDebit {
+		if disposition, reason := ImportDispositionFor(tx, autoRejectThreshold); disposition != pfinancev1.ImportDispositionType_IMPORT_DISPOSITION_TYPE_CREATE {
 			skippedCount++
-			skippedReasons = append(skippedReasons, fmt.Sprintf("Skipped credit transaction: %s", tx.Description))
-			continue
-		}
-
-		// Skip if confidence is too low (use auto-reject threshold)
-		if tx.Confidence < ConfidenceAutoReject {
-			skippedCount++
-			skippedReasons = append(skippedReasons, fmt.Sprintf("Low confidence (%.0f%%): %s", tx.Confidence*100, tx.Description))
+			skippedReasons = append(skippedReasons, reason)
 			continue
 		}
 
@@ -656,6 +677,12 @@ func (s *ExtractionService) GetJob(id string) (*pfinancev1.ExtractionJob, error)
 	return s.jobStore.Get(id)
 }
 
+// RecoverRejectedTransactions promotes a completed job's rejected
+// transactions back into its results so they can be imported.
+func (s *ExtractionService) RecoverRejectedTransactions(jobID string, transactionIDs []string) ([]*pfinancev1.ExtractedTransaction, []string, error) {
+	return s.jobStore.RecoverRejected(jobID, transactionIDs)
+}
+
 // StartAsyncExtraction creates an async extraction job for multi-page PDFs.
 func (s *ExtractionService) StartAsyncExtraction(
 	ctx context.Context,
@@ -664,6 +691,7 @@ func (s *ExtractionService) StartAsyncExtraction(
 	filename string,
 	docType pfinancev1.DocumentType,
 	method pfinancev1.ExtractionMethod,
+	autoRejectThreshold float64,
 ) (string, error) {
 	jobID := fmt.Sprintf("extr_%s", uuid.New().String()[:8])
 
@@ -681,12 +709,14 @@ func (s *ExtractionService) StartAsyncExtraction(
 	}
 
 	// Process in background
-	go s.processAsyncExtraction(context.Background(), job, data, filename, docType, method)
+	go s.processAsyncExtraction(context.Background(), job, data, filename, docType, method, autoRejectThreshold)
 
 	return jobID, nil
 }
 
 // processAsyncExtraction processes extraction in the background, updating job progress.
+// Text-based PDFs are extracted page by page so pollers see progress and partial
+// results; everything else goes through the whole-document fallback chain.
 func (s *ExtractionService) processAsyncExtraction(
 	ctx context.Context,
 	job *pfinancev1.ExtractionJob,
@@ -694,8 +724,16 @@ func (s *ExtractionService) processAsyncExtraction(
 	filename string,
 	docType pfinancev1.DocumentType,
 	method pfinancev1.ExtractionMethod,
+	autoRejectThreshold float64,
 ) {
-	result, err := s.ExtractDocumentWithMethod(ctx, data, filename, docType, false, method)
+	if method != pfinancev1.ExtractionMethod_EXTRACTION_METHOD_SELF_HOSTED &&
+		s.textExtractor != nil && detectMimeType(data) == "application/pdf" {
+		if s.extractPagesAsync(ctx, job, data, docType, autoRejectThreshold) {
+			return
+		}
+	}
+
+	result, err := s.ExtractDocumentWithMethod(ctx, data, filename, docType, false, method, autoRejectThreshold)
 	if err != nil {
 		job.Status = pfinancev1.ExtractionStatus_EXTRACTION_STATUS_FAILED
 		job.ErrorMessage = err.Error()
@@ -716,6 +754,92 @@ func (s *ExtractionService) processAsyncExtraction(
 	}
 }
 
+// extractPagesAsync runs rule-based text extraction one page at a time, publishing
+// progress and the accumulated result to the job store after each page.
+// It returns false (with the job's progress reset) when the document isn't suited
+// to text extraction, so the caller can fall back to whole-document extraction.
+func (s *ExtractionService) extractPagesAsync(
+	ctx context.Context,
+	job *pfinancev1.ExtractionJob,
+	data []byte,
+	docType pfinancev1.DocumentType,
+	autoRejectThreshold float64,
+) bool {
+	pages, err := AnalyzePDFPages(data)
+	if err != nil || len(pages) < 2 {
+		return false
+	}
+	var totalText int
+	for _, page := range pages {
+		totalText += len(page.ExtractedText)
+	}
+	if totalText/len(pages) < scannedThreshold {
+		return false
+	}
+
+	start := time.Now()
+	job.TotalPages = int32(len(pages))
+	job.Result = &pfinancev1.ExtractionResult{
+		OverallConfidence: textExtractorConfidence,
+		ModelUsed:         "text-extraction",
+		DocumentType:      docType,
+		PageCount:         int32(len(pages)),
+		MethodUsed:        pfinancev1.ExtractionMethod_EXTRACTION_METHOD_GEMINI, // same reporting as the single-shot text path
+		Warnings:          []string{"Extracted using rule-based text parser (no AI model used)"},
+	}
+
+	for i, page := range pages {
+		job.CurrentPage = int32(i + 1)
+
+		// Pages without transaction lines (cover pages, summaries) just count as processed
+		if page.EstimatedTxCount > 0 {
+			pageResult, err := s.textExtractor.ExtractFromText(page, docType)
+			if err != nil {
+				log.Printf("[extraction] job %s: page %d text extraction failed, falling back to full document: %v", job.Id, i+1, err)
+				return s.resetJobProgress(job)
+			}
+			for _, tx := range pageResult.Transactions {
+				tx.Id = fmt.Sprintf("text-p%d-%s", i+1, strings.TrimPrefix(tx.Id, "text-"))
+			}
+			s.postProcessResultWithUser(ctx, "", pageResult, autoRejectThreshold)
+			job.Result.Transactions = append(job.Result.Transactions, pageResult.Transactions...)
+			job.Result.RejectedTransactions = append(job.Result.RejectedTransactions, pageResult.RejectedTransactions...)
+		}
+
+		job.ProcessedPages = int32(i + 1)
+		job.ProgressPercent = float64(i+1) / float64(len(pages)) * 100
+		job.Result.ProcessingTimeMs = int32(time.Since(start).Milliseconds())
+		if updateErr := s.jobStore.Update(job); updateErr != nil {
+			log.Printf("failed to update job %s: %v", job.Id, updateErr)
+		}
+	}
+
+	if len(job.Result.Transactions) == 0 && len(job.Result.RejectedTransactions) == 0 {
+		log.Printf("[extraction] job %s: no transactions found in page text, falling back to full document", job.Id)
+		return s.resetJobProgress(job)
+	}
+
+	job.Status = pfinancev1.ExtractionStatus_EXTRACTION_STATUS_COMPLETED
+	job.CompletedAt = timestamppb.Now()
+	if updateErr := s.jobStore.Update(job); updateErr != nil {
+		log.Printf("failed to update job %s: %v", job.Id, updateErr)
+	}
+	return true
+}
+
+// resetJobProgress clears partial page results before falling back to
+// whole-document extraction. It always returns false.
+func (s *ExtractionService) resetJobProgress(job *pfinancev1.ExtractionJob) bool {
+	job.Result = nil
+	job.CurrentPage = 0
+	job.ProcessedPages = 0
+	job.ProgressPercent = 0
+	if updateErr := s.jobStore.Update(job); updateErr != nil {
+		log.Printf("failed to update job %s: %v", job.Id, updateErr)
+	}
+	return false
+}
+
 // HealthCheck checks if the ML service is healthy.
 func (s *ExtractionService) HealthCheck(ctx context.Context) error {
 	if !s.mlEnabled {
@@ -0,0 +1,55 @@
+package extraction
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+)
+
+func TestPostProcessResult_AutoRejectThreshold(t *testing.T) {
+	newResult := func() *pfinancev1.ExtractionResult {
+		return &pfinancev1.ExtractionResult{
+			Transactions: []*pfinancev1.ExtractedTransaction{
+				{Id: "high", Description: "WOOLWORTHS 1234 SYDNEY", Confidence: 0.9},
+				{Id: "mid", Description: "SMUDGED LINE", Confidence: 0.45},
+				{Id: "low", Description: "???", Confidence: 0.1},
+			},
+		}
+	}
+	ids := func(txs []*pfinancev1.ExtractedTransaction) []string {
+		out := make([]string, 0, len(txs))
+		for _, tx := range txs {
+			out = append(out, tx.Id)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name         string
+		threshold    float64
+		wantAccepted []string
+		wantRejected []string
+	}{
+		{"default rejects only the lowest", ConfidenceAutoReject, []string{"high", "mid"}, []string{"low"}},
+		{"raised threshold rejects more", 0.5, []string{"high"}, []string{"mid", "low"}},
+		{"zero keeps everything", 0, []string{"high", "mid", "low"}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &ExtractionService{}
+			result := newResult()
+
+			svc.postProcessResultWithUser(context.Background(), "", result, tt.threshold)
+
+			if got := ids(result.Transactions); !slices.Equal(got, tt.wantAccepted) {
+				t.Errorf("accepted = %v, want %v", got, tt.wantAccepted)
+			}
+			if got := ids(result.RejectedTransactions); !slices.Equal(got, tt.wantRejected) {
+				t.Errorf("rejected = %v, want %v", got, tt.wantRejected)
+			}
+		})
+	}
+}
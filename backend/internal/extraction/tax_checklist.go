@@ -0,0 +1,119 @@
+package extraction
+
+import (
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+)
+
+// ChecklistEntry is a deduction category worth looking for, described in the
+// terms of an occupation.
+type ChecklistEntry struct {
+	Category           pfinancev1.TaxDeductionCategory
+	Title              string
+	Description        string
+	OccupationSpecific bool
+}
+
+// occupationDeductionChecklist lists the common work-related deductions for
+// each occupation group in occupationCategoryRelevance, following the ATO
+// occupation and industry guides. Entries are in the order they are shown.
+var occupationDeductionChecklist = map[string][]ChecklistEntry{
+	"nurse": {
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNIFORM, Title: "Uniforms and laundry",
+			Description: "Compulsory or registered uniforms, scrubs, non-slip nursing shoes and the cost of laundering them."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_SELF_EDUCATION, Title: "Professional development",
+			Description: "Courses, conferences and seminars that maintain or improve your current nursing skills."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_OTHER_WORK, Title: "Registration and equipment",
+			Description: "AHPRA registration, union fees, fob watches, stethoscopes and other equipment you buy for work."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_WORK_TRAVEL, Title: "Work travel",
+			Description: "Travel between work sites, such as community nursing visits, but not home-to-work travel."},
+	},
+	"teacher": {
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_SELF_EDUCATION, Title: "Professional development",
+			Description: "Courses, conferences and workshops related to your current teaching role."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_OTHER_WORK, Title: "Classroom supplies and registration",
+			Description: "Teacher registration, union fees, stationery, classroom resources and teaching aids you pay for."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_HOME_OFFICE, Title: "Working from home",
+			Description: "Running costs while marking or preparing lessons at home, using the fixed rate or actual costs."},
+	},
+	"engineer": {
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_HOME_OFFICE, Title: "Working from home",
+			Description: "Electricity, internet and office equipment for the hours you work from home."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_SELF_EDUCATION, Title: "Professional development",
+			Description: "Certifications, courses and conferences related to your current engineering work."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_OTHER_WORK, Title: "Memberships and equipment",
+			Description: "Professional association fees, technical publications, software and protective equipment."},
+	},
+	"developer": {
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_HOME_OFFICE, Title: "Working from home",
+			Description: "Electricity, internet, desks, monitors and chairs for the hours you work from home."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_SELF_EDUCATION, Title: "Professional development",
+			Description: "Courses, certifications, books and conferences related to your current development work."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_OTHER_WORK, Title: "Software and devices",
+			Description: "Software subscriptions, cloud services, and the work-use portion of laptops and phones."},
+	},
+	"tradesperson": {
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_VEHICLE, Title: "Vehicle expenses",
+			Description: "Driving between jobs or carrying bulky tools, using cents per kilometre or a logbook."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_OTHER_WORK, Title: "Tools and licences",
+			Description: "Tools and equipment, trade licences, tickets and union fees."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNIFORM, Title: "Protective clothing",
+			Description: "Steel-capped boots, high-visibility clothing, hard hats and the cost of laundering them."},
+	},
+	"sales": {
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_VEHICLE, Title: "Vehicle expenses",
+			Description: "Driving to clients and between sites, using cents per kilometre or a logbook."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_WORK_TRAVEL, Title: "Work travel",
+			Description: "Flights, accommodation and meals on overnight trips to see clients."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_OTHER_WORK, Title: "Phone and memberships",
+			Description: "The work-use portion of your phone, professional memberships and licences."},
+	},
+	"healthcare": {
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNIFORM, Title: "Uniforms and laundry",
+			Description: "Compulsory uniforms, scrubs, protective clothing and the cost of laundering them."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_SELF_EDUCATION, Title: "Professional development",
+			Description: "Continuing professional development, conferences and courses for your current role."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_OTHER_WORK, Title: "Registration and indemnity",
+			Description: "AHPRA registration, indemnity insurance, college fees and medical equipment."},
+	},
+	"accountant": {
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_SELF_EDUCATION, Title: "Professional development",
+			Description: "CPD hours, courses and seminars required to keep your accounting designation."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_OTHER_WORK, Title: "Memberships and software",
+			Description: "CPA or CA ANZ membership, tax agent registration and accounting software."},
+		{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_HOME_OFFICE, Title: "Working from home",
+			Description: "Running costs for the hours you work from home, using the fixed rate or actual costs."},
+	},
+}
+
+// commonDeductionChecklist lists deductions available to every taxpayer,
+// whatever their occupation.
+var commonDeductionChecklist = []ChecklistEntry{
+	{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_DONATIONS, Title: "Gifts and donations",
+		Description: "Donations of $2 or more to deductible gift recipient charities."},
+	{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_TAX_AFFAIRS, Title: "Cost of managing tax affairs",
+		Description: "Tax agent fees, tax software and travel to get tax advice."},
+	{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_INCOME_PROTECTION, Title: "Income protection insurance",
+		Description: "Premiums for income protection insurance held outside super."},
+}
+
+// DeductionChecklist returns the deductions worth checking for the given
+// occupation, with occupation-specific entries first, and the occupation group
+// they were chosen for. An unrecognized occupation gets only the common entries
+// and an empty group.
+func DeductionChecklist(occupation string) (string, []ChecklistEntry) {
+	canonical := resolveOccupation(occupation)
+
+	var entries []ChecklistEntry
+	seen := make(map[pfinancev1.TaxDeductionCategory]bool)
+	for _, e := range occupationDeductionChecklist[canonical] {
+		e.OccupationSpecific = true
+		entries = append(entries, e)
+		seen[e.Category] = true
+	}
+	for _, e := range commonDeductionChecklist {
+		if !seen[e.Category] {
+			entries = append(entries, e)
+		}
+	}
+	return canonical, entries
+}
@@ -0,0 +1,72 @@
+package extraction
+
+import (
+	"testing"
+
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+)
+
+func TestDeductionChecklist(t *testing.T) {
+	tests := []struct {
+		occupation   string
+		wantGroup    string
+		wantFirst    pfinancev1.TaxDeductionCategory
+		wantSpecific int
+		wantTotal    int
+	}{
+		{"Registered Nurse", "nurse", pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNIFORM, 4, 7},
+		{"electrician", "tradesperson", pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_VEHICLE, 3, 6},
+		{"Senior Software Engineer", "developer", pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_HOME_OFFICE, 3, 6},
+		{"astronaut", "", pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_DONATIONS, 0, 3},
+		{"", "", pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_DONATIONS, 0, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.occupation, func(t *testing.T) {
+			group, entries := DeductionChecklist(tt.occupation)
+			if group != tt.wantGroup {
+				t.Errorf("group = %q, want %q", group, tt.wantGroup)
+			}
+			if len(entries) != tt.wantTotal {
+				t.Fatalf("got %d entries, want %d", len(entries), tt.wantTotal)
+			}
+			if entries[0].Category != tt.wantFirst {
+				t.Errorf("first category = %v, want %v", entries[0].Category, tt.wantFirst)
+			}
+			specific := 0
+			for i, e := range entries {
+				if e.OccupationSpecific {
+					if i != specific {
+						t.Errorf("occupation-specific entry %v listed after a common one", e.Category)
+					}
+					specific++
+				}
+			}
+			if specific != tt.wantSpecific {
+				t.Errorf("occupation-specific entries = %d, want %d", specific, tt.wantSpecific)
+			}
+		})
+	}
+}
+
+func TestDeductionChecklistTable(t *testing.T) {
+	for group, entries := range occupationDeductionChecklist {
+		if _, ok := occupationCategoryRelevance[group]; !ok {
+			t.Errorf("%s: checklist group is not a known occupation", group)
+		}
+		seen := make(map[pfinancev1.TaxDeductionCategory]bool)
+		for _, e := range entries {
+			if e.Title == "" || e.Description == "" {
+				t.Errorf("%s: %v is missing a title or description", group, e.Category)
+			}
+			if seen[e.Category] {
+				t.Errorf("%s: %v is listed twice", group, e.Category)
+			}
+			seen[e.Category] = true
+		}
+	}
+	for group := range occupationCategoryRelevance {
+		if _, ok := occupationDeductionChecklist[group]; !ok {
+			t.Errorf("%s: occupation has no checklist", group)
+		}
+	}
+}
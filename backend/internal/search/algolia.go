@@ -26,9 +26,9 @@ type SearchParams struct {
 	UserID   string
 	GroupID  string
 	Category string
-	// Amount range (dollars)
-	AmountMin float64
-	AmountMax float64
+	// Amount range (dollars, inclusive); nil means unbounded
+	AmountMin *float64
+	AmountMax *float64
 	// Date range
 	StartDate *time.Time
 	EndDate   *time.Time
@@ -169,11 +169,11 @@ func buildFilters(params SearchParams) (string, error) {
 	}
 
 	// Amount range
-	if params.AmountMin > 0 {
-		parts = append(parts, fmt.Sprintf("Amount >= %f", params.AmountMin))
+	if params.AmountMin != nil {
+		parts = append(parts, fmt.Sprintf("Amount >= %f", *params.AmountMin))
 	}
-	if params.AmountMax > 0 {
-		parts = append(parts, fmt.Sprintf("Amount <= %f", params.AmountMax))
+	if params.AmountMax != nil {
+		parts = append(parts, fmt.Sprintf("Amount <= %f", *params.AmountMax))
 	}
 
 	// Date range (using DateUnix numeric field)
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/auth"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultActivityFeedDays is the window GetActivityFeed covers when no start
+// date is given.
+const defaultActivityFeedDays = 90
+
+// GetActivityFeed returns the expenses, incomes and goal contributions of a
+// user or group in one stream, newest first. Pages are cut at a
+// (timestamp, ID) cursor so items are neither skipped nor repeated when new
+// activity arrives between requests.
+func (s *FinanceService) GetActivityFeed(ctx context.Context, req *connect.Request[pfinancev1.GetActivityFeedRequest]) (*connect.Response[pfinancev1.GetActivityFeedResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GroupId == "" {
+		if req.Msg.UserId != "" && req.Msg.UserId != claims.UID {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("cannot view another user's activity"))
+		}
+	} else {
+		group, err := s.store.GetGroup(ctx, req.Msg.GroupId)
+		if err != nil {
+			return nil, auth.WrapStoreError("get group", err)
+		}
+		if !auth.IsGroupMember(claims.UID, group) {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("user is not a member of this group"))
+		}
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" && req.Msg.GroupId == "" {
+		userID = claims.UID
+	}
+
+	endDate := time.Now()
+	if req.Msg.EndDate != nil {
+		endDate = req.Msg.EndDate.AsTime()
+	}
+	startDate := endDate.AddDate(0, 0, -defaultActivityFeedDays)
+	if req.Msg.StartDate != nil {
+		startDate = req.Msg.StartDate.AsTime()
+	}
+	if startDate.After(endDate) {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("start_date must not be after end_date"))
+	}
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	cursorTime, cursorID, err := decodeActivityCursor(req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page token: %w", err))
+	}
+
+	items, err := s.collectActivity(ctx, userID, req.Msg.GroupId, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return activityKeyBefore(items[i].Timestamp.AsTime(), items[i].Id, items[j].Timestamp.AsTime(), items[j].Id)
+	})
+
+	if req.Msg.PageToken != "" {
+		start := sort.Search(len(items), func(i int) bool {
+			return activityKeyBefore(cursorTime, cursorID, items[i].Timestamp.AsTime(), items[i].Id)
+		})
+		items = items[start:]
+	}
+
+	var nextPageToken string
+	if len(items) > int(pageSize) {
+		items = items[:pageSize]
+		last := items[pageSize-1]
+		nextPageToken = encodeActivityCursor(last.Timestamp.AsTime(), last.Id)
+	}
+
+	return connect.NewResponse(&pfinancev1.GetActivityFeedResponse{
+		Items:         items,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// collectActivity gathers every feed item of the user or group dated within
+// [startDate, endDate].
+func (s *FinanceService) collectActivity(ctx context.Context, userID, groupID string, startDate, endDate time.Time) ([]*pfinancev1.ActivityItem, error) {
+	var items []*pfinancev1.ActivityItem
+
+	expenses, _, err := s.store.ListExpenses(ctx, userID, groupID, store.ExpenseQuery{StartDate: &startDate, EndDate: &endDate}, 10000, "")
+	if err != nil {
+		return nil, auth.WrapStoreError("list expenses", err)
+	}
+	for _, e := range expenses {
+		items = append(items, &pfinancev1.ActivityItem{
+			Id:          e.Id,
+			Kind:        pfinancev1.ActivityKind_ACTIVITY_KIND_EXPENSE,
+			Timestamp:   activityTimestamp(e.Date, e.CreatedAt),
+			UserId:      e.UserId,
+			Description: e.Description,
+			AmountCents: store.ToCents(effectiveDollars(e.AmountCents, e.Amount)),
+			Expense:     e,
+		})
+	}
+
+	incomes, _, err := s.store.ListIncomes(ctx, userID, groupID, &startDate, &endDate, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 10000, "")
+	if err != nil {
+		return nil, auth.WrapStoreError("list incomes", err)
+	}
+	for _, inc := range incomes {
+		items = append(items, &pfinancev1.ActivityItem{
+			Id:          inc.Id,
+			Kind:        pfinancev1.ActivityKind_ACTIVITY_KIND_INCOME,
+			Timestamp:   activityTimestamp(inc.Date, inc.CreatedAt),
+			UserId:      inc.UserId,
+			Description: inc.Source,
+			AmountCents: store.ToCents(effectiveDollars(inc.AmountCents, inc.Amount)),
+			Income:      inc,
+		})
+	}
+
+	goals, _, err := s.store.ListGoals(ctx, userID, groupID,
+		pfinancev1.GoalStatus_GOAL_STATUS_UNSPECIFIED, pfinancev1.GoalType_GOAL_TYPE_UNSPECIFIED, 1000, "")
+	if err != nil {
+		return nil, auth.WrapStoreError("list goals", err)
+	}
+	for _, goal := range goals {
+		if goal.GroupId != groupID {
+			continue
+		}
+		contributions, _, err := s.store.ListGoalContributions(ctx, goal.Id, 1000, "")
+		if err != nil {
+			return nil, auth.WrapStoreError("list goal contributions", err)
+		}
+		for _, c := range contributions {
+			if c.ContributedAt == nil {
+				continue
+			}
+			at := c.ContributedAt.AsTime()
+			if at.Before(startDate) || at.After(endDate) {
+				continue
+			}
+			items = append(items, &pfinancev1.ActivityItem{
+				Id:               c.Id,
+				Kind:             pfinancev1.ActivityKind_ACTIVITY_KIND_GOAL_CONTRIBUTION,
+				Timestamp:        c.ContributedAt,
+				UserId:           c.UserId,
+				Description:      goal.Name,
+				AmountCents:      store.ToCents(effectiveDollars(c.AmountCents, c.Amount)),
+				GoalContribution: c,
+			})
+		}
+	}
+	return items, nil
+}
+
+// activityTimestamp returns the date an item happened, falling back to when it
+// was recorded.
+func activityTimestamp(date, createdAt *timestamppb.Timestamp) *timestamppb.Timestamp {
+	if date != nil {
+		return date
+	}
+	if createdAt != nil {
+		return createdAt
+	}
+	return timestamppb.New(time.Time{})
+}
+
+// activityKeyBefore reports whether the key (aAt, aID) sorts before (bAt, bID)
+// in the feed's order: newest first, ties broken by descending ID.
+func activityKeyBefore(aAt time.Time, aID string, bAt time.Time, bID string) bool {
+	if !aAt.Equal(bAt) {
+		return aAt.After(bAt)
+	}
+	return aID > bID
+}
+
+// encodeActivityCursor encodes the (timestamp, ID) key of the last item on a page.
+func encodeActivityCursor(at time.Time, id string) string {
+	return store.EncodePageToken(at.UTC().Format(time.RFC3339Nano) + "|" + id)
+}
+
+// decodeActivityCursor decodes a token from encodeActivityCursor. An empty
+// token decodes to the zero key.
+func decodeActivityCursor(token string) (time.Time, string, error) {
+	if token == "" {
+		return time.Time{}, "", nil
+	}
+	decoded, err := store.DecodePageToken(token)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	ts, id, found := strings.Cut(decoded, "|")
+	if !found || id == "" {
+		return time.Time{}, "", fmt.Errorf("malformed cursor")
+	}
+	at, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("malformed cursor: %w", err)
+	}
+	return at, id, nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetActivityFeed(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	now := time.Now()
+	sameDay := now.AddDate(0, 0, -2)
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "exp-a", Description: "Groceries", AmountCents: 4500, Date: timestamppb.New(sameDay)},
+		{Id: "exp-b", Description: "Fuel", AmountCents: 7000, Date: timestamppb.New(sameDay)},
+		{Id: "exp-old", Description: "Ancient", AmountCents: 100, Date: timestamppb.New(now.AddDate(-1, 0, 0))},
+	} {
+		e.UserId = "user-123"
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+	if err := memStore.CreateIncome(t.Context(), &pfinancev1.Income{
+		Id: "inc-1", UserId: "user-123", Source: "Salary", AmountCents: 500000, Date: timestamppb.New(now.AddDate(0, 0, -5)),
+	}); err != nil {
+		t.Fatalf("CreateIncome: %v", err)
+	}
+	goal, err := svc.CreateGoal(ctx, connect.NewRequest(&pfinancev1.CreateGoalRequest{
+		UserId: "user-123", Name: "Holiday", GoalType: pfinancev1.GoalType_GOAL_TYPE_SAVINGS, TargetAmountCents: 100000,
+	}))
+	if err != nil {
+		t.Fatalf("CreateGoal: %v", err)
+	}
+	if _, err := svc.ContributeToGoal(ctx, connect.NewRequest(&pfinancev1.ContributeToGoalRequest{
+		GoalId: goal.Msg.Goal.Id, UserId: "user-123", AmountCents: 2500,
+	})); err != nil {
+		t.Fatalf("ContributeToGoal: %v", err)
+	}
+
+	var got []*pfinancev1.ActivityItem
+	pageToken := ""
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatal("pagination did not terminate")
+		}
+		resp, err := svc.GetActivityFeed(ctx, connect.NewRequest(&pfinancev1.GetActivityFeedRequest{
+			UserId:    "user-123",
+			PageSize:  2,
+			PageToken: pageToken,
+		}))
+		if err != nil {
+			t.Fatalf("GetActivityFeed: %v", err)
+		}
+		got = append(got, resp.Msg.Items...)
+		if pageToken = resp.Msg.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+
+	wantIDs := []string{"", "exp-b", "exp-a", "inc-1"}
+	wantKinds := []pfinancev1.ActivityKind{
+		pfinancev1.ActivityKind_ACTIVITY_KIND_GOAL_CONTRIBUTION,
+		pfinancev1.ActivityKind_ACTIVITY_KIND_EXPENSE,
+		pfinancev1.ActivityKind_ACTIVITY_KIND_EXPENSE,
+		pfinancev1.ActivityKind_ACTIVITY_KIND_INCOME,
+	}
+	if len(got) != len(wantKinds) {
+		t.Fatalf("got %d items, want %d (old expense excluded)", len(got), len(wantKinds))
+	}
+	for i, item := range got {
+		if item.Kind != wantKinds[i] || (wantIDs[i] != "" && item.Id != wantIDs[i]) {
+			t.Errorf("item %d = %v %s, want %v %s", i, item.Kind, item.Id, wantKinds[i], wantIDs[i])
+		}
+	}
+	if c := got[0]; c.GoalContribution == nil || c.AmountCents != 2500 || c.Description != "Holiday" {
+		t.Errorf("contribution item = %v", c)
+	}
+
+	t.Run("rejects a malformed page token", func(t *testing.T) {
+		_, err := svc.GetActivityFeed(ctx, connect.NewRequest(&pfinancev1.GetActivityFeedRequest{PageToken: "!!"}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("other users are rejected", func(t *testing.T) {
+		_, err := svc.GetActivityFeed(testContext("other-user"), connect.NewRequest(&pfinancev1.GetActivityFeedRequest{UserId: "user-123"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+	})
+}
@@ -1,16 +1,20 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log"
 	"math"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
 	"github.com/castlemilk/pfinance/backend/internal/auth"
+	"github.com/castlemilk/pfinance/backend/internal/store"
 	"github.com/google/uuid"
 )
 
@@ -32,6 +36,16 @@ func (s *FinanceService) requireProWithFallback(ctx context.Context, claims *aut
 	return nil
 }
 
+// calendarQuarter returns the first and last moments of the calendar quarter
+// containing t, and the quarter's number (1-4).
+func calendarQuarter(t time.Time) (time.Time, time.Time, int) {
+	q := (int(t.Month())-1)/3 + 1
+	start := time.Date(t.Year(), time.Month((q-1)*3+1), 1, 0, 0, 0, 0, t.Location())
+	end := start.AddDate(0, 3, -1)
+	end = time.Date(end.Year(), end.Month(), end.Day(), 23, 59, 59, 0, end.Location())
+	return start, end, q
+}
+
 // effectiveDollars returns the effective amount in dollars, preferring the cents field when available.
 func effectiveDollars(amountCents int64, amountDollars float64) float64 {
 	if amountCents != 0 {
@@ -90,25 +104,81 @@ func (s *FinanceService) GetDailyAggregates(ctx context.Context, req *connect.Re
 		return nil, auth.WrapStoreError("get daily aggregates", err)
 	}
 
-	// Compute max daily amount
-	var maxDailyAmount float64
-	var maxDailyAmountCents int64
-	for _, agg := range aggregates {
-		if agg.TotalAmount > maxDailyAmount {
-			maxDailyAmount = agg.TotalAmount
-		}
-		if agg.TotalAmountCents > maxDailyAmountCents {
-			maxDailyAmountCents = agg.TotalAmountCents
+	if req.Msg.IncludeIncome {
+		var pageToken string
+		for {
+			incomes, nextToken, err := s.store.ListIncomes(ctx, userID, req.Msg.GroupId, &startDate, &endDate, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 500, pageToken)
+			if err != nil {
+				return nil, auth.WrapStoreError("list incomes", err)
+			}
+			aggregates = addDailyIncome(aggregates, incomes)
+			if nextToken == "" {
+				break
+			}
+			pageToken = nextToken
 		}
 	}
 
+	// Compute max daily amounts; income has its own maximum so it never
+	// rescales the spending view
+	var maxDailyAmount, maxDailyIncomeAmount float64
+	var maxDailyAmountCents, maxDailyIncomeAmountCents int64
+	for _, agg := range aggregates {
+		maxDailyAmount = max(maxDailyAmount, agg.TotalAmount)
+		maxDailyAmountCents = max(maxDailyAmountCents, agg.TotalAmountCents)
+		maxDailyIncomeAmount = max(maxDailyIncomeAmount, agg.IncomeAmount)
+		maxDailyIncomeAmountCents = max(maxDailyIncomeAmountCents, agg.IncomeAmountCents)
+	}
+
 	return connect.NewResponse(&pfinancev1.GetDailyAggregatesResponse{
-		Aggregates:          aggregates,
-		MaxDailyAmount:      maxDailyAmount,
-		MaxDailyAmountCents: maxDailyAmountCents,
+		Aggregates:                aggregates,
+		MaxDailyAmount:            maxDailyAmount,
+		MaxDailyAmountCents:       maxDailyAmountCents,
+		MaxDailyIncomeAmount:      maxDailyIncomeAmount,
+		MaxDailyIncomeAmountCents: maxDailyIncomeAmountCents,
 	}), nil
 }
 
+// addDailyIncome adds each dated income to its day's aggregate, bucketed the
+// same way as expenses, creating aggregates for days with no spending. The
+// result stays sorted by date ascending.
+func addDailyIncome(aggregates []*pfinancev1.DailyAggregate, incomes []*pfinancev1.Income) []*pfinancev1.DailyAggregate {
+	byDate := make(map[string]*pfinancev1.DailyAggregate, len(aggregates))
+	for _, agg := range aggregates {
+		byDate[agg.Date] = agg
+	}
+
+	for _, inc := range incomes {
+		if inc.Date == nil {
+			continue
+		}
+		date := store.DailyAggregateDate(inc.Date)
+		agg, ok := byDate[date]
+		if !ok {
+			agg = &pfinancev1.DailyAggregate{Date: date}
+			byDate[date] = agg
+			aggregates = append(aggregates, agg)
+		}
+
+		cents := inc.AmountCents
+		if cents == 0 {
+			cents = store.ToCents(inc.Amount)
+		}
+		amount := inc.Amount
+		if amount == 0 {
+			amount = float64(cents) / 100
+		}
+		agg.IncomeAmount += amount
+		agg.IncomeAmountCents += cents
+		agg.IncomeCount++
+	}
+
+	slices.SortFunc(aggregates, func(a, b *pfinancev1.DailyAggregate) int {
+		return strings.Compare(a.Date, b.Date)
+	})
+	return aggregates
+}
+
 // GetSpendingTrends returns time-series spending/income data with trend analysis.
 func (s *FinanceService) GetSpendingTrends(ctx context.Context, req *connect.Request[pfinancev1.GetSpendingTrendsRequest]) (*connect.Response[pfinancev1.GetSpendingTrendsResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
@@ -144,6 +214,10 @@ func (s *FinanceService) GetSpendingTrends(ctx context.Context, req *connect.Req
 	if periods <= 0 {
 		periods = 6
 	}
+	if req.Msg.SmoothingWindow < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("smoothing_window must not be negative"))
+	}
 
 	now := time.Now()
 
@@ -154,92 +228,121 @@ func (s *FinanceService) GetSpendingTrends(ctx context.Context, req *connect.Req
 		label string
 	}
 	periodInfos := make([]periodInfo, periods)
+	var labelLayout string
 	for i := int32(0); i < periods; i++ {
 		offset := periods - 1 - i
 		var ps, pe time.Time
-		var label string
 		switch granularity {
 		case pfinancev1.Granularity_GRANULARITY_DAY:
 			ps = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, -int(offset))
 			pe = ps.Add(24*time.Hour - time.Second)
-			label = ps.Format("2006-01-02")
+			labelLayout = "2006-01-02"
 		case pfinancev1.Granularity_GRANULARITY_WEEK:
 			weekStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-			weekStart = weekStart.AddDate(0, 0, -int(weekStart.Weekday()))
+			weekStart = weekStart.AddDate(0, 0, -daysSinceWeekStart(weekStart.Weekday(), req.Msg.WeekStartsOn))
 			ps = weekStart.AddDate(0, 0, -int(offset)*7)
 			pe = ps.AddDate(0, 0, 6)
 			pe = time.Date(pe.Year(), pe.Month(), pe.Day(), 23, 59, 59, 0, pe.Location())
-			label = ps.Format("Jan 02")
+			labelLayout = "Jan 02"
+			if req.Msg.WeekStartsOn != pfinancev1.DayOfWeek_DAY_OF_WEEK_UNSPECIFIED {
+				labelLayout = "Mon Jan 02"
+			}
 		default:
 			ps = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, -int(offset), 0)
 			pe = ps.AddDate(0, 1, -1)
 			pe = time.Date(pe.Year(), pe.Month(), pe.Day(), 23, 59, 59, 0, pe.Location())
-			label = ps.Format("Jan 2006")
+			labelLayout = "Jan 2006"
 		}
-		periodInfos[i] = periodInfo{start: ps, end: pe, label: label}
+		periodInfos[i] = periodInfo{start: ps, end: pe, label: ps.Format(labelLayout)}
 	}
 
-	// Single fetch for the entire date range (oldest start → newest end) instead of N+1 queries
+	// The same calendar periods one year earlier. A month keeps its full
+	// length, so February of a leap year compares against all of February.
+	var priorInfos []periodInfo
+	if req.Msg.CompareYearOverYear {
+		priorInfos = make([]periodInfo, periods)
+		for i, pi := range periodInfos {
+			ps := pi.start.AddDate(-1, 0, 0)
+			pe := pi.end.AddDate(-1, 0, 0)
+			if granularity == pfinancev1.Granularity_GRANULARITY_MONTH {
+				pe = ps.AddDate(0, 1, -1)
+				pe = time.Date(pe.Year(), pe.Month(), pe.Day(), 23, 59, 59, 0, pe.Location())
+			}
+			priorInfos[i] = periodInfo{start: ps, end: pe, label: ps.Format(labelLayout)}
+		}
+	}
+
+	// Single fetch for the entire date range (oldest start → newest end) instead of N+1 queries,
+	// widened back to the oldest prior-year period when comparing year over year
 	overallStart := periodInfos[0].start
+	if len(priorInfos) > 0 {
+		overallStart = priorInfos[0].start
+	}
 	overallEnd := periodInfos[len(periodInfos)-1].end
-	allExpenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, &overallStart, &overallEnd, 10000, "")
+	allExpenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{StartDate: &overallStart, EndDate: &overallEnd}, 10000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list expenses", err)
 	}
-	allIncomes, _, err := s.store.ListIncomes(ctx, userID, req.Msg.GroupId, &overallStart, &overallEnd, 10000, "")
+	allIncomes, _, err := s.store.ListIncomes(ctx, userID, req.Msg.GroupId, &overallStart, &overallEnd, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 10000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list incomes", err)
 	}
 
-	// In-memory bucketing by period
-	expenseSeries := make([]*pfinancev1.TimeSeriesDataPoint, periods)
-	incomeSeries := make([]*pfinancev1.TimeSeriesDataPoint, periods)
-	expenseTotals := make([]float64, periods)
-	incomeTotals := make([]float64, periods)
+	// In-memory bucketing by period. Prior-year periods can overlap the
+	// current ones when more than a year of periods is requested, so each set
+	// of periods is bucketed separately.
+	bucket := func(infos []periodInfo) (expenseSeries, incomeSeries []*pfinancev1.TimeSeriesDataPoint) {
+		expenseTotals := make([]float64, len(infos))
+		incomeTotals := make([]float64, len(infos))
 
-	for _, e := range allExpenses {
-		if req.Msg.Category != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED && e.Category != req.Msg.Category {
-			continue
-		}
-		if e.Date == nil {
-			continue
-		}
-		t := e.Date.AsTime()
-		for i, pi := range periodInfos {
-			if !t.Before(pi.start) && !t.After(pi.end) {
-				expenseTotals[i] += effectiveDollars(e.AmountCents, e.Amount)
-				break
+		for _, e := range allExpenses {
+			if req.Msg.Category != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED && e.Category != req.Msg.Category {
+				continue
+			}
+			if e.Date == nil {
+				continue
+			}
+			t := e.Date.AsTime()
+			for i, pi := range infos {
+				if !t.Before(pi.start) && !t.After(pi.end) {
+					expenseTotals[i] += effectiveDollars(e.AmountCents, e.Amount)
+					break
+				}
 			}
 		}
-	}
 
-	for _, inc := range allIncomes {
-		if inc.Date == nil {
-			continue
-		}
-		t := inc.Date.AsTime()
-		for i, pi := range periodInfos {
-			if !t.Before(pi.start) && !t.After(pi.end) {
-				incomeTotals[i] += effectiveDollars(inc.AmountCents, inc.Amount)
-				break
+		for _, inc := range allIncomes {
+			if inc.Date == nil {
+				continue
+			}
+			t := inc.Date.AsTime()
+			for i, pi := range infos {
+				if !t.Before(pi.start) && !t.After(pi.end) {
+					incomeTotals[i] += effectiveDollars(inc.AmountCents, inc.Amount)
+					break
+				}
 			}
 		}
-	}
 
-	for i, pi := range periodInfos {
-		expenseSeries[i] = &pfinancev1.TimeSeriesDataPoint{
-			Date:       pi.start.Format("2006-01-02"),
-			Value:      expenseTotals[i],
-			ValueCents: int64(expenseTotals[i] * 100),
-			Label:      pi.label,
-		}
-		incomeSeries[i] = &pfinancev1.TimeSeriesDataPoint{
-			Date:       pi.start.Format("2006-01-02"),
-			Value:      incomeTotals[i],
-			ValueCents: int64(incomeTotals[i] * 100),
-			Label:      pi.label,
+		expenseSeries = make([]*pfinancev1.TimeSeriesDataPoint, len(infos))
+		incomeSeries = make([]*pfinancev1.TimeSeriesDataPoint, len(infos))
+		for i, pi := range infos {
+			expenseSeries[i] = &pfinancev1.TimeSeriesDataPoint{
+				Date:       pi.start.Format("2006-01-02"),
+				Value:      expenseTotals[i],
+				ValueCents: store.ToCents(expenseTotals[i]),
+				Label:      pi.label,
+			}
+			incomeSeries[i] = &pfinancev1.TimeSeriesDataPoint{
+				Date:       pi.start.Format("2006-01-02"),
+				Value:      incomeTotals[i],
+				ValueCents: store.ToCents(incomeTotals[i]),
+				Label:      pi.label,
+			}
 		}
+		return expenseSeries, incomeSeries
 	}
+	expenseSeries, incomeSeries := bucket(periodInfos)
 
 	// Compute linear regression on expense series
 	expenseValues := make([]float64, len(expenseSeries))
@@ -248,12 +351,87 @@ func (s *FinanceService) GetSpendingTrends(ctx context.Context, req *connect.Req
 	}
 	slope, rSquared := computeLinearRegression(expenseValues)
 
-	return connect.NewResponse(&pfinancev1.GetSpendingTrendsResponse{
+	resp := &pfinancev1.GetSpendingTrendsResponse{
 		ExpenseSeries: expenseSeries,
 		IncomeSeries:  incomeSeries,
 		TrendSlope:    slope,
 		TrendRSquared: rSquared,
-	}), nil
+	}
+	if window := int(min(req.Msg.SmoothingWindow, periods)); window > 1 {
+		resp.SmoothedExpenseSeries = smoothSeries(expenseSeries, window)
+		resp.SmoothedIncomeSeries = smoothSeries(incomeSeries, window)
+	}
+	if len(priorInfos) > 0 {
+		resp.PriorYearExpenseSeries, resp.PriorYearIncomeSeries = bucket(priorInfos)
+		resp.ExpenseYearOverYearChangePercent = seriesChangePercent(expenseSeries, resp.PriorYearExpenseSeries)
+		resp.IncomeYearOverYearChangePercent = seriesChangePercent(incomeSeries, resp.PriorYearIncomeSeries)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// seriesChangePercent returns the percentage change of each point in current
+// over the matching point in prior, or 0 where prior is zero.
+func seriesChangePercent(current, prior []*pfinancev1.TimeSeriesDataPoint) []float64 {
+	changes := make([]float64, len(current))
+	for i := range current {
+		changes[i] = categoryChangePercent(current[i].Value, prior[i].Value)
+	}
+	return changes
+}
+
+// smoothSeries applies a centered simple moving average over window points.
+// Windows are truncated at the ends of the series rather than padded, and an
+// even window leans one point towards the past.
+func smoothSeries(series []*pfinancev1.TimeSeriesDataPoint, window int) []*pfinancev1.TimeSeriesDataPoint {
+	half := window / 2
+	smoothed := make([]*pfinancev1.TimeSeriesDataPoint, len(series))
+	for i, pt := range series {
+		lo := max(0, i-half)
+		hi := min(len(series)-1, i+window-1-half)
+		var sum float64
+		for _, p := range series[lo : hi+1] {
+			sum += p.Value
+		}
+		avg := sum / float64(hi-lo+1)
+		smoothed[i] = &pfinancev1.TimeSeriesDataPoint{
+			Date:       pt.Date,
+			Value:      avg,
+			ValueCents: store.ToCents(avg),
+			Label:      pt.Label,
+		}
+	}
+	return smoothed
+}
+
+// fortnightStart returns midnight on the first day of the 14-day period
+// containing now, where periods repeat every 14 days from anchor in either
+// direction. A zero anchor defaults to the most recent Monday.
+func fortnightStart(now, anchor time.Time) time.Time {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if anchor.IsZero() {
+		return today.AddDate(0, 0, -daysSinceWeekStart(today.Weekday(), pfinancev1.DayOfWeek_DAY_OF_WEEK_MONDAY))
+	}
+
+	// Count calendar days in UTC so a DST change between the dates cannot
+	// shorten or lengthen a day.
+	anchorDay := time.Date(anchor.Year(), anchor.Month(), anchor.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).Sub(anchorDay).Hours() / 24)
+	offset := days % 14
+	if offset < 0 {
+		offset += 14
+	}
+	return today.AddDate(0, 0, -offset)
+}
+
+// daysSinceWeekStart returns how many days day is past the most recent
+// startsOn, treating an unspecified start as Sunday.
+func daysSinceWeekStart(day time.Weekday, startsOn pfinancev1.DayOfWeek) int {
+	start := time.Sunday
+	if startsOn != pfinancev1.DayOfWeek_DAY_OF_WEEK_UNSPECIFIED {
+		start = time.Weekday(startsOn - pfinancev1.DayOfWeek_DAY_OF_WEEK_SUNDAY)
+	}
+	return (int(day) - int(start) + 7) % 7
 }
 
 // GetCategoryComparison compares category spending between current and previous periods.
@@ -286,6 +464,11 @@ func (s *FinanceService) GetCategoryComparison(ctx context.Context, req *connect
 	if period == "" {
 		period = "month"
 	}
+	topK := int(req.Msg.IncludeTopTransactions)
+	if topK < 0 || topK > maxCategoryTopTransactions {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("include_top_transactions must be between 0 and %d", maxCategoryTopTransactions))
+	}
 
 	now := time.Now()
 	var currentStart, currentEnd, prevStart, prevEnd time.Time
@@ -299,12 +482,19 @@ func (s *FinanceService) GetCategoryComparison(ctx context.Context, req *connect
 		prevStart = currentStart.AddDate(0, 0, -7)
 		prevEnd = currentStart.AddDate(0, 0, -1)
 		prevEnd = time.Date(prevEnd.Year(), prevEnd.Month(), prevEnd.Day(), 23, 59, 59, 0, prevEnd.Location())
-	case "quarter":
-		month := now.Month()
-		quarterStartMonth := time.Month(((int(month)-1)/3)*3 + 1)
-		currentStart = time.Date(now.Year(), quarterStartMonth, 1, 0, 0, 0, 0, now.Location())
-		currentEnd = currentStart.AddDate(0, 3, -1)
+	case "fortnight":
+		var anchor time.Time
+		if req.Msg.FortnightAnchor != nil {
+			anchor = req.Msg.FortnightAnchor.AsTime().In(now.Location())
+		}
+		currentStart = fortnightStart(now, anchor)
+		currentEnd = currentStart.AddDate(0, 0, 13)
 		currentEnd = time.Date(currentEnd.Year(), currentEnd.Month(), currentEnd.Day(), 23, 59, 59, 0, currentEnd.Location())
+		prevStart = currentStart.AddDate(0, 0, -14)
+		prevEnd = currentStart.AddDate(0, 0, -1)
+		prevEnd = time.Date(prevEnd.Year(), prevEnd.Month(), prevEnd.Day(), 23, 59, 59, 0, prevEnd.Location())
+	case "quarter":
+		currentStart, currentEnd, _ = calendarQuarter(now)
 		prevStart = currentStart.AddDate(0, -3, 0)
 		prevEnd = currentStart.AddDate(0, 0, -1)
 		prevEnd = time.Date(prevEnd.Year(), prevEnd.Month(), prevEnd.Day(), 23, 59, 59, 0, prevEnd.Location())
@@ -318,26 +508,35 @@ func (s *FinanceService) GetCategoryComparison(ctx context.Context, req *connect
 	}
 
 	// Fetch current period expenses
-	currentExpenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, &currentStart, &currentEnd, 10000, "")
+	currentExpenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{StartDate: &currentStart, EndDate: &currentEnd}, 10000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list current expenses", err)
 	}
 
 	// Fetch previous period expenses
-	prevExpenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, &prevStart, &prevEnd, 10000, "")
+	prevExpenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{StartDate: &prevStart, EndDate: &prevEnd}, 10000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list previous expenses", err)
 	}
 
-	// Group by category
+	// Group by category. With totals enabled, unspecified and "other" roll up
+	// into a single Uncategorized bucket keyed by EXPENSE_CATEGORY_UNSPECIFIED.
+	includeTotals := req.Msg.IncludeTotalsRow
+	bucket := func(cat pfinancev1.ExpenseCategory) pfinancev1.ExpenseCategory {
+		if includeTotals && isUncategorized(cat) {
+			return pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED
+		}
+		return cat
+	}
+
 	currentByCategory := make(map[pfinancev1.ExpenseCategory]float64)
 	prevByCategory := make(map[pfinancev1.ExpenseCategory]float64)
 
 	for _, e := range currentExpenses {
-		currentByCategory[e.Category] += effectiveDollars(e.AmountCents, e.Amount)
+		currentByCategory[bucket(e.Category)] += effectiveDollars(e.AmountCents, e.Amount)
 	}
 	for _, e := range prevExpenses {
-		prevByCategory[e.Category] += effectiveDollars(e.AmountCents, e.Amount)
+		prevByCategory[bucket(e.Category)] += effectiveDollars(e.AmountCents, e.Amount)
 	}
 
 	// Collect all categories
@@ -349,59 +548,146 @@ func (s *FinanceService) GetCategoryComparison(ctx context.Context, req *connect
 		allCategories[cat] = true
 	}
 
-	// Optionally fetch budgets
-	var budgetByCategory map[pfinancev1.ExpenseCategory]float64
+	// Optionally fetch budgets. Each category row shows the full amount of
+	// every budget covering it; the totals row counts each budget once.
+	var budgetByCategory map[pfinancev1.ExpenseCategory]int64
+	var budgetTotalCents int64
 	if req.Msg.IncludeBudgets {
-		budgetByCategory = make(map[pfinancev1.ExpenseCategory]float64)
+		budgetByCategory = make(map[pfinancev1.ExpenseCategory]int64)
 		budgets, _, err := s.store.ListBudgets(ctx, userID, req.Msg.GroupId, false, 10000, "")
 		if err != nil {
 			return nil, auth.WrapStoreError("list budgets", err)
 		}
 		for _, b := range budgets {
+			cents := store.ToCents(effectiveDollars(b.AmountCents, b.Amount))
+			covered := make(map[pfinancev1.ExpenseCategory]bool)
+			shown := false
 			for _, catID := range b.CategoryIds {
-				budgetByCategory[catID] = effectiveDollars(b.AmountCents, b.Amount)
+				cat := bucket(catID)
+				if covered[cat] {
+					continue
+				}
+				covered[cat] = true
+				budgetByCategory[cat] += cents
+				shown = shown || allCategories[cat]
+			}
+			if shown {
+				budgetTotalCents += cents
 			}
 		}
 	}
 
+	var topByCategory map[pfinancev1.ExpenseCategory][]*pfinancev1.Expense
+	if topK > 0 {
+		topByCategory = topExpensesByCategory(currentExpenses, bucket, topK)
+	}
+
 	var categories []*pfinancev1.CategorySpending
 	for cat := range allCategories {
 		current := currentByCategory[cat]
 		previous := prevByCategory[cat]
-		var changePercent float64
-		if previous > 0 {
-			changePercent = ((current - previous) / previous) * 100
-		}
-
 		cs := &pfinancev1.CategorySpending{
 			Category:            cat,
 			CurrentAmount:       current,
-			CurrentAmountCents:  int64(current * 100),
+			CurrentAmountCents:  store.ToCents(current),
 			PreviousAmount:      previous,
-			PreviousAmountCents: int64(previous * 100),
-			ChangePercent:       changePercent,
+			PreviousAmountCents: store.ToCents(previous),
+			ChangePercent:       categoryChangePercent(current, previous),
+		}
+		if includeTotals && cat == pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED {
+			cs.Label = "Uncategorized"
 		}
+		cs.TopTransactions = topByCategory[cat]
 
 		if budgetByCategory != nil {
-			if budgetAmt, ok := budgetByCategory[cat]; ok {
-				cs.BudgetAmount = budgetAmt
-				cs.BudgetAmountCents = int64(budgetAmt * 100)
+			if budgetCents, ok := budgetByCategory[cat]; ok {
+				cs.BudgetAmount = float64(budgetCents) / 100
+				cs.BudgetAmountCents = budgetCents
 			}
 		}
 
 		categories = append(categories, cs)
 	}
 
-	// Sort by current amount descending
+	// Sort by current amount descending; Uncategorized always sorts last
 	sort.Slice(categories, func(i, j int) bool {
+		if includeTotals {
+			iu := categories[i].Category == pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED
+			ju := categories[j].Category == pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED
+			if iu != ju {
+				return ju
+			}
+		}
 		return categories[i].CurrentAmount > categories[j].CurrentAmount
 	})
 
+	if includeTotals {
+		categories = append(categories, categoryTotalsRow(categories, budgetTotalCents))
+	}
+
 	return connect.NewResponse(&pfinancev1.GetCategoryComparisonResponse{
 		Categories: categories,
 	}), nil
 }
 
+// isUncategorized reports whether a category belongs in the Uncategorized bucket.
+func isUncategorized(cat pfinancev1.ExpenseCategory) bool {
+	return cat == pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED ||
+		cat == pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_OTHER
+}
+
+// maxCategoryTopTransactions caps GetCategoryComparison's include_top_transactions.
+const maxCategoryTopTransactions = 50
+
+// topExpensesByCategory returns up to k of the largest expenses in each
+// category bucket, largest first with ties broken by ID.
+func topExpensesByCategory(expenses []*pfinancev1.Expense, bucket func(pfinancev1.ExpenseCategory) pfinancev1.ExpenseCategory, k int) map[pfinancev1.ExpenseCategory][]*pfinancev1.Expense {
+	byCategory := make(map[pfinancev1.ExpenseCategory][]*pfinancev1.Expense)
+	for _, e := range expenses {
+		cat := bucket(e.Category)
+		byCategory[cat] = append(byCategory[cat], e)
+	}
+	for cat, list := range byCategory {
+		slices.SortFunc(list, func(a, b *pfinancev1.Expense) int {
+			if c := cmp.Compare(effectiveDollars(b.AmountCents, b.Amount), effectiveDollars(a.AmountCents, a.Amount)); c != 0 {
+				return c
+			}
+			return strings.Compare(a.Id, b.Id)
+		})
+		byCategory[cat] = list[:min(k, len(list))]
+	}
+	return byCategory
+}
+
+// categoryChangePercent returns the period-over-period change, or 0 when there
+// was no previous spending to compare against.
+func categoryChangePercent(current, previous float64) float64 {
+	if previous > 0 {
+		return ((current - previous) / previous) * 100
+	}
+	return 0
+}
+
+// categoryTotalsRow sums the category rows into a synthetic total row. The
+// budget is passed in rather than summed, since one budget can cover several
+// rows.
+func categoryTotalsRow(categories []*pfinancev1.CategorySpending, budgetCents int64) *pfinancev1.CategorySpending {
+	total := &pfinancev1.CategorySpending{
+		Label:             "Total",
+		IsTotal:           true,
+		BudgetAmount:      float64(budgetCents) / 100,
+		BudgetAmountCents: budgetCents,
+	}
+	for _, cs := range categories {
+		total.CurrentAmount += cs.CurrentAmount
+		total.CurrentAmountCents += cs.CurrentAmountCents
+		total.PreviousAmount += cs.PreviousAmount
+		total.PreviousAmountCents += cs.PreviousAmountCents
+	}
+	total.ChangePercent = categoryChangePercent(total.CurrentAmount, total.PreviousAmount)
+	return total
+}
+
 // DetectAnomalies detects unusual spending patterns using z-score analysis.
 func (s *FinanceService) DetectAnomalies(ctx context.Context, req *connect.Request[pfinancev1.DetectAnomaliesRequest]) (*connect.Response[pfinancev1.DetectAnomaliesResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
@@ -434,25 +720,27 @@ func (s *FinanceService) DetectAnomalies(ctx context.Context, req *connect.Reque
 	}
 	sensitivity := req.Msg.Sensitivity
 	if sensitivity <= 0 {
-		sensitivity = 0.5
+		sensitivity = defaultAnomalySensitivity
 	}
-	// Threshold: sensitivity=0 → 3.0, sensitivity=0.5 → 2.0, sensitivity=1.0 → 1.0
-	threshold := 3.0 - (sensitivity * 2.0)
+	threshold := anomalyZThreshold(sensitivity)
 
 	now := time.Now()
 	startDate := now.AddDate(0, 0, -int(lookbackDays))
 	endDate := now
 
 	// Fetch expenses for lookback period
-	expenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, &startDate, &endDate, 10000, "")
+	expenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{StartDate: &startDate, EndDate: &endDate}, 10000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list expenses", err)
 	}
 
-	// Group by category: collect amounts
+	// Group by category: collect amounts, and the hour of each timestamped
+	// expense when time outliers are requested
 	type categoryStats struct {
 		amounts  []float64
 		expenses []*pfinancev1.Expense
+		hours    [24]int
+		timed    []*pfinancev1.Expense
 	}
 	byCat := make(map[pfinancev1.ExpenseCategory]*categoryStats)
 	merchantFirstSeen := make(map[string]time.Time)
@@ -465,6 +753,10 @@ func (s *FinanceService) DetectAnomalies(ctx context.Context, req *connect.Reque
 		}
 		cs.amounts = append(cs.amounts, effectiveDollars(e.AmountCents, e.Amount))
 		cs.expenses = append(cs.expenses, e)
+		if req.Msg.IncludeTimeOutliers && hasTimeOfDay(e) {
+			cs.hours[e.Date.AsTime().Hour()]++
+			cs.timed = append(cs.timed, e)
+		}
 
 		// Track merchant first occurrence
 		desc := e.Description
@@ -488,28 +780,33 @@ func (s *FinanceService) DetectAnomalies(ctx context.Context, req *connect.Reque
 		}
 	}
 
-	var anomalies []*pfinancev1.SpendingAnomaly
-
-	// Z-score anomaly detection per category
-	for cat, cs := range byCat {
-		if len(cs.amounts) < 10 {
-			continue
+	// Stored baselines are per user, so they only apply to personal requests
+	useBaselines := req.Msg.UseStoredBaselines && userID != ""
+	baselines := make(map[pfinancev1.ExpenseCategory]*pfinancev1.CategoryBaseline)
+	if useBaselines {
+		stored, err := s.store.GetCategoryBaselines(ctx, userID)
+		if err != nil {
+			return nil, auth.WrapStoreError("get category baselines", err)
 		}
-		// Compute mean and stddev
-		var sum float64
-		for _, a := range cs.amounts {
-			sum += a
+		for _, b := range stored {
+			baselines[b.Category] = b
 		}
-		mean := sum / float64(len(cs.amounts))
+	}
 
-		var varianceSum float64
-		for _, a := range cs.amounts {
-			diff := a - mean
-			varianceSum += diff * diff
-		}
-		stddev := math.Sqrt(varianceSum / float64(len(cs.amounts)))
+	var anomalies []*pfinancev1.SpendingAnomaly
+
+	// Z-score anomaly detection per category, against the stored median/MAD
+	// baseline when one is usable and the in-window mean/stddev otherwise
+	for cat, cs := range byCat {
+		mean, stddev := baselineCenterSpread(baselines[cat])
 		if stddev == 0 {
-			continue
+			if len(cs.amounts) < minAnomalySamples {
+				continue
+			}
+			mean, stddev = meanStdDev(cs.amounts)
+			if stddev == 0 {
+				continue
+			}
 		}
 
 		for _, e := range cs.expenses {
@@ -532,19 +829,85 @@ func (s *FinanceService) DetectAnomalies(ctx context.Context, req *connect.Reque
 					ExpenseId:           e.Id,
 					Description:         e.Description,
 					Amount:              amt,
-					AmountCents:         int64(amt * 100),
+					AmountCents:         store.ToCents(amt),
 					Category:            cat,
 					Date:                e.Date,
 					ZScore:              zScore,
 					ExpectedAmount:      mean,
-					ExpectedAmountCents: int64(mean * 100),
+					ExpectedAmountCents: store.ToCents(mean),
 					AnomalyType:         pfinancev1.AnomalyType_ANOMALY_TYPE_AMOUNT_OUTLIER,
 					Severity:            severity,
+					Explanation:         explainAmountOutlier(e.Description, cat, amt, mean, zScore),
+					Comparison: &pfinancev1.AnomalyComparison{
+						TypicalAmount:      mean,
+						TypicalAmountCents: store.ToCents(mean),
+						Amount:             amt,
+						AmountCents:        store.ToCents(amt),
+						Percentile:         percentileRank(cs.amounts, amt),
+						StdDevs:            zScore,
+						Multiple:           amountMultiple(amt, mean),
+					},
 				})
 			}
 		}
 	}
 
+	// Time-of-day outliers per category. Only expenses with a time as well as
+	// a date count, so categories entered date-only are skipped entirely.
+	for cat, cs := range byCat {
+		if len(cs.timed) < minAnomalySamples {
+			continue
+		}
+		for _, e := range cs.timed {
+			hour := e.Date.AsTime().Hour()
+			// Other expenses in the category made within the window of this one
+			nearby := -1
+			for h, n := range cs.hours {
+				if circularHourDistance(h, hour) <= timeOutlierWindowHours {
+					nearby += n
+				}
+			}
+			share := float64(nearby) / float64(len(cs.timed)-1)
+			if share >= timeOutlierMaxShare {
+				continue
+			}
+			severity := pfinancev1.AnomalySeverity_ANOMALY_SEVERITY_LOW
+			if nearby == 0 {
+				severity = pfinancev1.AnomalySeverity_ANOMALY_SEVERITY_MEDIUM
+			}
+			amt := effectiveDollars(e.AmountCents, e.Amount)
+			anomalies = append(anomalies, &pfinancev1.SpendingAnomaly{
+				Id:          uuid.New().String(),
+				ExpenseId:   e.Id,
+				Description: e.Description,
+				Amount:      amt,
+				AmountCents: store.ToCents(amt),
+				Category:    cat,
+				Date:        e.Date,
+				AnomalyType: pfinancev1.AnomalyType_ANOMALY_TYPE_TIME_OUTLIER,
+				Severity:    severity,
+				Explanation: explainTimeOutlier(e, cat, nearby, len(cs.timed)-1),
+			})
+		}
+	}
+
+	// Fold this window's new expenses into the stored baselines after scoring,
+	// so they were judged against history rather than against themselves
+	if useBaselines {
+		for cat, cs := range byCat {
+			b, ok := baselines[cat]
+			if !ok {
+				b = &pfinancev1.CategoryBaseline{UserId: userID, Category: cat}
+			}
+			if !foldIntoBaseline(b, cs.expenses) {
+				continue
+			}
+			if err := s.store.UpsertCategoryBaseline(ctx, b); err != nil {
+				log.Printf("[Analytics] Failed to update %s baseline for user %s: %v", cat, userID, err)
+			}
+		}
+	}
+
 	// Pre-compute merchant counts in a single O(n) pass
 	merchantCount := make(map[string]int)
 	for _, e := range expenses {
@@ -561,17 +924,29 @@ func (s *FinanceService) DetectAnomalies(ctx context.Context, req *connect.Reque
 		firstSeen := merchantFirstSeen[e.Description]
 		// If the merchant was first seen in the lookback and appears only once
 		if merchantCount[e.Description] == 1 && !firstSeen.Before(startDate) {
+			// A merchant seen before the lookback is recurring, not new
+			if req.Msg.MerchantHistoryDays > 0 {
+				historyStart := startDate.AddDate(0, 0, -int(req.Msg.MerchantHistoryDays))
+				seen, err := s.store.HasMerchantExpense(ctx, userID, req.Msg.GroupId, e.Description, historyStart, startDate)
+				if err != nil {
+					return nil, auth.WrapStoreError("check merchant history", err)
+				}
+				if seen {
+					continue
+				}
+			}
 			amt := effectiveDollars(e.AmountCents, e.Amount)
 			anomalies = append(anomalies, &pfinancev1.SpendingAnomaly{
 				Id:          uuid.New().String(),
 				ExpenseId:   e.Id,
 				Description: fmt.Sprintf("New merchant: %s", e.Description),
 				Amount:      amt,
-				AmountCents: int64(amt * 100),
+				AmountCents: store.ToCents(amt),
 				Category:    e.Category,
 				Date:        e.Date,
 				AnomalyType: pfinancev1.AnomalyType_ANOMALY_TYPE_NEW_MERCHANT,
 				Severity:    pfinancev1.AnomalySeverity_ANOMALY_SEVERITY_LOW,
+				Explanation: fmt.Sprintf("First expense at %s in the last %d days.", e.Description, lookbackDays+req.Msg.MerchantHistoryDays),
 			})
 		}
 	}
@@ -604,11 +979,125 @@ func (s *FinanceService) DetectAnomalies(ctx context.Context, req *connect.Reque
 		Anomalies:                anomalies,
 		TotalAnomalies:           int32(len(anomalies)),
 		AnomalousSpendTotal:      anomalousTotal,
-		AnomalousSpendTotalCents: int64(anomalousTotal * 100),
+		AnomalousSpendTotalCents: store.ToCents(anomalousTotal),
 		TopAnomalyCategory:       topCategory,
 	}), nil
 }
 
+// defaultAnomalySensitivity is the sensitivity DetectAnomalies uses when none is requested.
+const defaultAnomalySensitivity = 0.5
+
+// minAnomalySamples is the fewest expenses a category needs before z-scores are meaningful.
+const minAnomalySamples = 10
+
+// anomalyZThreshold maps a 0-1 sensitivity to a z-score threshold:
+// sensitivity=0 → 3.0, sensitivity=0.5 → 2.0, sensitivity=1.0 → 1.0.
+func anomalyZThreshold(sensitivity float64) float64 {
+	return 3.0 - (sensitivity * 2.0)
+}
+
+// timeOutlierWindowHours is how many hours either side of an expense count as
+// the same time of day.
+const timeOutlierWindowHours = 2
+
+// timeOutlierMaxShare is the share of a category's other timestamped expenses
+// below which an expense's time of day is flagged as an outlier.
+const timeOutlierMaxShare = 0.05
+
+// hasTimeOfDay reports whether an expense's date carries a time of day.
+// Date-only expenses are stored at midnight UTC, so they carry none.
+func hasTimeOfDay(e *pfinancev1.Expense) bool {
+	if e.Date == nil {
+		return false
+	}
+	t := e.Date.AsTime()
+	return t.Hour() != 0 || t.Minute() != 0 || t.Second() != 0 || t.Nanosecond() != 0
+}
+
+// circularHourDistance returns the hours between a and b on a 24-hour clock,
+// so 23:00 and 01:00 are two hours apart.
+func circularHourDistance(a, b int) int {
+	d := a - b
+	if d < 0 {
+		d = -d
+	}
+	return min(d, 24-d)
+}
+
+// explainTimeOutlier describes a time-of-day outlier relative to the
+// category's other timestamped expenses. The clock time is left out: the
+// server only has it in UTC, and the client shows e.Date in the user's zone.
+func explainTimeOutlier(e *pfinancev1.Expense, category pfinancev1.ExpenseCategory, nearby, others int) string {
+	amt := effectiveDollars(e.AmountCents, e.Amount)
+	subject := fmt.Sprintf("This $%.2f expense", amt)
+	if e.Description != "" {
+		subject = fmt.Sprintf("This $%.2f %s", amt, e.Description)
+	}
+	return fmt.Sprintf("%s is outside your usual %s hours: %d of your other %d %s expenses were within %d hours of it.",
+		subject, expenseCategoryLabel(category), nearby, others, expenseCategoryLabel(category), timeOutlierWindowHours)
+}
+
+// explainAmountOutlier describes an amount outlier relative to the typical
+// amount for its category.
+func explainAmountOutlier(description string, category pfinancev1.ExpenseCategory, amount, typical, zScore float64) string {
+	subject := fmt.Sprintf("This $%.2f expense", amount)
+	if description != "" {
+		subject = fmt.Sprintf("This $%.2f %s", amount, description)
+	}
+	label := expenseCategoryLabel(category)
+	if amount > typical && typical > 0 {
+		return fmt.Sprintf("%s is %.1f× your typical $%.2f %s spend.", subject, amount/typical, typical, label)
+	}
+	return fmt.Sprintf("%s is %.1f standard deviations from your typical $%.2f %s spend.", subject, math.Abs(zScore), typical, label)
+}
+
+// expenseCategoryLabel returns a lower-case label for a category, e.g. "food".
+func expenseCategoryLabel(category pfinancev1.ExpenseCategory) string {
+	label := strings.TrimPrefix(category.String(), "EXPENSE_CATEGORY_")
+	return strings.ToLower(strings.ReplaceAll(label, "_", " "))
+}
+
+// percentileRank returns the percentage of values at or below v.
+func percentileRank(values []float64, v float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var atOrBelow int
+	for _, x := range values {
+		if x <= v {
+			atOrBelow++
+		}
+	}
+	return float64(atOrBelow) / float64(len(values)) * 100
+}
+
+// amountMultiple returns amount as a multiple of typical, or 0 when typical is not positive.
+func amountMultiple(amount, typical float64) float64 {
+	if typical <= 0 {
+		return 0
+	}
+	return amount / typical
+}
+
+// meanStdDev returns the mean and population standard deviation of values.
+func meanStdDev(values []float64) (mean, stddev float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean = sum / float64(len(values))
+
+	var varianceSum float64
+	for _, v := range values {
+		diff := v - mean
+		varianceSum += diff * diff
+	}
+	return mean, math.Sqrt(varianceSum / float64(len(values)))
+}
+
 // GetCashFlowForecast forecasts future cash flow using historical data and recurring transactions.
 func (s *FinanceService) GetCashFlowForecast(ctx context.Context, req *connect.Request[pfinancev1.GetCashFlowForecastRequest]) (*connect.Response[pfinancev1.GetCashFlowForecastResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
@@ -639,17 +1128,28 @@ func (s *FinanceService) GetCashFlowForecast(ctx context.Context, req *connect.R
 	if forecastDays <= 0 {
 		forecastDays = 30
 	}
+	confidenceLevel, z := forecastConfidence(req.Msg.ConfidenceLevel)
+
+	historyDays := req.Msg.HistoryDays
+	if historyDays < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("history_days must not be negative"))
+	}
+	if historyDays == 0 {
+		historyDays = defaultForecastHistoryDays
+	}
+	historyDays = min(historyDays, maxForecastHistoryDays)
 
 	now := time.Now()
-	historyStart := now.AddDate(0, 0, -90)
+	historyStart := now.AddDate(0, 0, -int(historyDays))
 	historyEnd := now
 
 	// Fetch historical expenses and incomes
-	expenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, &historyStart, &historyEnd, 10000, "")
+	expenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{StartDate: &historyStart, EndDate: &historyEnd}, 10000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list expenses", err)
 	}
-	incomes, _, err := s.store.ListIncomes(ctx, userID, req.Msg.GroupId, &historyStart, &historyEnd, 10000, "")
+	incomes, _, err := s.store.ListIncomes(ctx, userID, req.Msg.GroupId, &historyStart, &historyEnd, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 10000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list incomes", err)
 	}
@@ -687,12 +1187,12 @@ func (s *FinanceService) GetCashFlowForecast(ctx context.Context, req *connect.R
 		expenseHistory = append(expenseHistory, &pfinancev1.TimeSeriesDataPoint{
 			Date:       dayStr,
 			Value:      expAmt,
-			ValueCents: int64(expAmt * 100),
+			ValueCents: store.ToCents(expAmt),
 		})
 		incomeHistory = append(incomeHistory, &pfinancev1.TimeSeriesDataPoint{
 			Date:       dayStr,
 			Value:      incAmt,
-			ValueCents: int64(incAmt * 100),
+			ValueCents: store.ToCents(incAmt),
 		})
 	}
 
@@ -732,9 +1232,8 @@ func (s *FinanceService) GetCashFlowForecast(ctx context.Context, req *connect.R
 	}
 
 	// Build recurring amounts by date for forecast period
-	recurringExpenseByDay := make(map[string]float64)
-	recurringIncomeByDay := make(map[string]float64)
-	recurringDays := make(map[string]bool)
+	recurringExpenseByDay := make(map[string]*recurringDayAmount)
+	recurringIncomeByDay := make(map[string]*recurringDayAmount)
 
 	for _, rt := range recurringTxns {
 		// Project forward for each recurring transaction
@@ -745,17 +1244,37 @@ func (s *FinanceService) GetCashFlowForecast(ctx context.Context, req *connect.R
 			current = rt.StartDate.AsTime()
 		}
 
+		skippedDays := make(map[string]bool, len(rt.SkippedOccurrences))
+		for _, skipped := range rt.SkippedOccurrences {
+			skippedDays[skipped.AsTime().Format("2006-01-02")] = true
+		}
+
+		rtAmt := effectiveDollars(rt.AmountCents, rt.Amount)
+		rtLower, rtUpper := rtAmt, rtAmt
+		variable := rt.MinAmountCents > 0 && rt.MaxAmountCents > 0
+		if variable {
+			rtLower = float64(rt.MinAmountCents) / 100
+			rtUpper = float64(rt.MaxAmountCents) / 100
+		}
+
+		byDay := recurringIncomeByDay
+		if rt.IsExpense {
+			byDay = recurringExpenseByDay
+		}
+
 		forecastEnd := now.AddDate(0, 0, int(forecastDays))
 		for !current.After(forecastEnd) {
-			if current.After(now) {
-				dayStr := current.Format("2006-01-02")
-				rtAmt := effectiveDollars(rt.AmountCents, rt.Amount)
-				if rt.IsExpense {
-					recurringExpenseByDay[dayStr] += rtAmt
-				} else {
-					recurringIncomeByDay[dayStr] += rtAmt
+			dayStr := current.Format("2006-01-02")
+			if current.After(now) && !skippedDays[dayStr] {
+				day, ok := byDay[dayStr]
+				if !ok {
+					day = &recurringDayAmount{}
+					byDay[dayStr] = day
 				}
-				recurringDays[dayStr] = true
+				day.predicted += rtAmt
+				day.lower += rtLower
+				day.upper += rtUpper
+				day.variable = day.variable || variable
 			}
 			current = nextOccurrence(current, rt.Frequency)
 		}
@@ -772,29 +1291,19 @@ func (s *FinanceService) GetCashFlowForecast(ctx context.Context, req *connect.R
 
 		// Expense prediction
 		predictedExpense := avgDailyExpense
-		isRecurringExpense := false
-		if recurringAmt, ok := recurringExpenseByDay[dayStr]; ok {
-			predictedExpense = recurringAmt
-			isRecurringExpense = true
-		}
-		expenseLower := predictedExpense - 1.645*expenseStddev
-		if expenseLower < 0 {
-			expenseLower = 0
+		recurringExpense, isRecurringExpense := recurringExpenseByDay[dayStr]
+		if isRecurringExpense {
+			predictedExpense = recurringExpense.predicted
 		}
-		expenseUpper := predictedExpense + 1.645*expenseStddev
+		expenseLower, expenseUpper := forecastBounds(predictedExpense, z*expenseStddev, recurringExpense)
 
 		// Income prediction
 		predictedIncome := avgDailyIncome
-		isRecurringIncome := false
-		if recurringAmt, ok := recurringIncomeByDay[dayStr]; ok {
-			predictedIncome = recurringAmt
-			isRecurringIncome = true
+		recurringIncome, isRecurringIncome := recurringIncomeByDay[dayStr]
+		if isRecurringIncome {
+			predictedIncome = recurringIncome.predicted
 		}
-		incomeLower := predictedIncome - 1.645*incomeStddev
-		if incomeLower < 0 {
-			incomeLower = 0
-		}
-		incomeUpper := predictedIncome + 1.645*incomeStddev
+		incomeLower, incomeUpper := forecastBounds(predictedIncome, z*incomeStddev, recurringIncome)
 
 		// Net
 		predictedNet := predictedIncome - predictedExpense
@@ -802,41 +1311,124 @@ func (s *FinanceService) GetCashFlowForecast(ctx context.Context, req *connect.R
 		expenseForecast = append(expenseForecast, &pfinancev1.ForecastPoint{
 			Date:            dayStr,
 			Predicted:       predictedExpense,
-			PredictedCents:  int64(predictedExpense * 100),
+			PredictedCents:  store.ToCents(predictedExpense),
 			LowerBound:      expenseLower,
-			LowerBoundCents: int64(expenseLower * 100),
+			LowerBoundCents: store.ToCents(expenseLower),
 			UpperBound:      expenseUpper,
-			UpperBoundCents: int64(expenseUpper * 100),
+			UpperBoundCents: store.ToCents(expenseUpper),
 			IsRecurring:     isRecurringExpense,
 		})
 
 		incomeForecast = append(incomeForecast, &pfinancev1.ForecastPoint{
 			Date:            dayStr,
 			Predicted:       predictedIncome,
-			PredictedCents:  int64(predictedIncome * 100),
+			PredictedCents:  store.ToCents(predictedIncome),
 			LowerBound:      incomeLower,
-			LowerBoundCents: int64(incomeLower * 100),
+			LowerBoundCents: store.ToCents(incomeLower),
 			UpperBound:      incomeUpper,
-			UpperBoundCents: int64(incomeUpper * 100),
+			UpperBoundCents: store.ToCents(incomeUpper),
 			IsRecurring:     isRecurringIncome,
 		})
 
 		netForecast = append(netForecast, &pfinancev1.ForecastPoint{
 			Date:           dayStr,
 			Predicted:      predictedNet,
-			PredictedCents: int64(predictedNet * 100),
+			PredictedCents: store.ToCents(predictedNet),
 		})
 	}
 
+	balanceForecast, minBalanceCents, minBalanceDate := projectBalance(req.Msg.StartingBalanceCents, netForecast)
+
 	return connect.NewResponse(&pfinancev1.GetCashFlowForecastResponse{
 		IncomeForecast:  incomeForecast,
 		ExpenseForecast: expenseForecast,
 		NetForecast:     netForecast,
 		IncomeHistory:   incomeHistory,
 		ExpenseHistory:  expenseHistory,
+		ConfidenceLevel: confidenceLevel,
+		BalanceForecast: balanceForecast,
+		MinBalance:      float64(minBalanceCents) / 100,
+		MinBalanceCents: minBalanceCents,
+		MinBalanceDate:  minBalanceDate,
+		GoesNegative:    minBalanceCents < 0,
+		HistoryDays:     historyDays,
 	}), nil
 }
 
+const (
+	// defaultForecastHistoryDays is how far back GetCashFlowForecast looks
+	// for its historical averages when no history_days is requested.
+	defaultForecastHistoryDays = 90
+	maxForecastHistoryDays     = 730
+)
+
+// recurringDayAmount is what the recurring transactions falling on one forecast
+// day are expected to total, and the range that total may fall in. variable is
+// set when any of them has an amount range rather than a fixed amount.
+type recurringDayAmount struct {
+	predicted, lower, upper float64
+	variable                bool
+}
+
+// forecastBounds returns the band around a day's prediction. A day with a
+// variable recurring transaction uses the recurring range; any other day uses
+// margin either side of predicted, floored at zero.
+func forecastBounds(predicted, margin float64, recurring *recurringDayAmount) (float64, float64) {
+	if recurring != nil && recurring.variable {
+		return recurring.lower, recurring.upper
+	}
+	return max(0, predicted-margin), predicted + margin
+}
+
+// projectBalance accumulates a daily net forecast onto a starting balance,
+// returning the running balance series and the first day it is at its lowest.
+// Balances are summed in cents so long horizons do not drift.
+func projectBalance(startCents int64, netForecast []*pfinancev1.ForecastPoint) ([]*pfinancev1.TimeSeriesDataPoint, int64, string) {
+	series := make([]*pfinancev1.TimeSeriesDataPoint, 0, len(netForecast))
+	balance := startCents
+	minCents, minDate := startCents, ""
+	for i, point := range netForecast {
+		balance += point.PredictedCents
+		if i == 0 || balance < minCents {
+			minCents, minDate = balance, point.Date
+		}
+		series = append(series, &pfinancev1.TimeSeriesDataPoint{
+			Date:       point.Date,
+			Value:      float64(balance) / 100,
+			ValueCents: balance,
+		})
+	}
+	return series, minCents, minDate
+}
+
+// forecastConfidenceLevels maps the supported forecast confidence levels to
+// their two-sided normal z-multipliers.
+var forecastConfidenceLevels = []struct {
+	level float64
+	z     float64
+}{
+	{0.80, 1.282},
+	{0.90, 1.645},
+	{0.95, 1.960},
+	{0.99, 2.576},
+}
+
+// forecastConfidence resolves a requested confidence level to the nearest
+// supported level and its z-multiplier. Unset or non-positive values default
+// to 90%.
+func forecastConfidence(requested float64) (level, z float64) {
+	if requested <= 0 {
+		return 0.90, 1.645
+	}
+	best := forecastConfidenceLevels[0]
+	for _, c := range forecastConfidenceLevels[1:] {
+		if math.Abs(c.level-requested) < math.Abs(best.level-requested) {
+			best = c
+		}
+	}
+	return best.level, best.z
+}
+
 // GetWaterfallData returns waterfall chart data showing income to savings flow.
 func (s *FinanceService) GetWaterfallData(ctx context.Context, req *connect.Request[pfinancev1.GetWaterfallDataRequest]) (*connect.Response[pfinancev1.GetWaterfallDataResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
@@ -846,9 +1438,16 @@ func (s *FinanceService) GetWaterfallData(ctx context.Context, req *connect.Requ
 	if err := s.requireProWithFallback(ctx, claims); err != nil {
 		return nil, err
 	}
+	switch req.Msg.GroupBy {
+	case "", "category", "member":
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("group_by must be \"category\" or \"member\", got %q", req.Msg.GroupBy))
+	}
 
+	var group *pfinancev1.FinanceGroup
 	if req.Msg.GroupId != "" {
-		group, err := s.store.GetGroup(ctx, req.Msg.GroupId)
+		group, err = s.store.GetGroup(ctx, req.Msg.GroupId)
 		if err != nil {
 			return nil, auth.WrapStoreError("get group", err)
 		}
@@ -868,42 +1467,18 @@ func (s *FinanceService) GetWaterfallData(ctx context.Context, req *connect.Requ
 		period = "month"
 	}
 
-	now := time.Now()
-	var startDate, endDate time.Time
-	var periodLabel string
+	// Member attribution only makes sense for shared group data
+	byMember := req.Msg.GroupBy == "member" && group != nil
 
-	switch period {
-	case "week":
-		daysFromSunday := int(now.Weekday())
-		startDate = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, -daysFromSunday)
-		endDate = startDate.AddDate(0, 0, 6)
-		endDate = time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 23, 59, 59, 0, endDate.Location())
-		periodLabel = fmt.Sprintf("Week of %s", startDate.Format("Jan 02, 2006"))
-	case "quarter":
-		month := now.Month()
-		quarterStartMonth := time.Month(((int(month)-1)/3)*3 + 1)
-		startDate = time.Date(now.Year(), quarterStartMonth, 1, 0, 0, 0, 0, now.Location())
-		endDate = startDate.AddDate(0, 3, -1)
-		endDate = time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 23, 59, 59, 0, endDate.Location())
-		q := ((int(month) - 1) / 3) + 1
-		periodLabel = fmt.Sprintf("Q%d %d", q, now.Year())
-	case "year":
-		startDate = time.Date(now.Year(), 1, 1, 0, 0, 0, 0, now.Location())
-		endDate = time.Date(now.Year(), 12, 31, 23, 59, 59, 0, now.Location())
-		periodLabel = fmt.Sprintf("%d", now.Year())
-	default: // "month"
-		startDate = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
-		endDate = startDate.AddDate(0, 1, -1)
-		endDate = time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 23, 59, 59, 0, endDate.Location())
-		periodLabel = now.Format("January 2006")
-	}
+	now := time.Now()
+	startDate, endDate, periodLabel := waterfallPeriod(period, now)
 
 	// Fetch incomes and expenses
-	incomesList, _, err := s.store.ListIncomes(ctx, userID, req.Msg.GroupId, &startDate, &endDate, 10000, "")
+	incomesList, _, err := s.store.ListIncomes(ctx, userID, req.Msg.GroupId, &startDate, &endDate, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 10000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list incomes", err)
 	}
-	expensesList, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, &startDate, &endDate, 10000, "")
+	expensesList, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{StartDate: &startDate, EndDate: &endDate}, 10000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list expenses", err)
 	}
@@ -914,12 +1489,17 @@ func (s *FinanceService) GetWaterfallData(ctx context.Context, req *connect.Requ
 		totalIncome += effectiveDollars(inc.AmountCents, inc.Amount)
 	}
 
-	// Group expenses by category
+	// Group expenses by category, or by paying member when requested
 	expenseByCategory := make(map[pfinancev1.ExpenseCategory]float64)
+	expenseByMember := make(map[string]float64)
 	var totalExpenses float64
 	for _, e := range expensesList {
 		amt := effectiveDollars(e.AmountCents, e.Amount)
-		expenseByCategory[e.Category] += amt
+		if byMember {
+			expenseByMember[e.UserId] += amt
+		} else {
+			expenseByCategory[e.Category] += amt
+		}
 		totalExpenses += amt
 	}
 
@@ -931,10 +1511,10 @@ func (s *FinanceService) GetWaterfallData(ctx context.Context, req *connect.Requ
 	entries = append(entries, &pfinancev1.WaterfallEntry{
 		Label:             "Gross Income",
 		Amount:            totalIncome,
-		AmountCents:       int64(totalIncome * 100),
+		AmountCents:       store.ToCents(totalIncome),
 		EntryType:         pfinancev1.WaterfallEntryType_WATERFALL_ENTRY_TYPE_INCOME,
 		RunningTotal:      runningTotal,
-		RunningTotalCents: int64(runningTotal * 100),
+		RunningTotalCents: store.ToCents(runningTotal),
 	})
 
 	// 2. Tax (use user's configured rate, fallback to 25%)
@@ -948,58 +1528,442 @@ func (s *FinanceService) GetWaterfallData(ctx context.Context, req *connect.Requ
 	entries = append(entries, &pfinancev1.WaterfallEntry{
 		Label:             "Tax",
 		Amount:            estimatedTax,
-		AmountCents:       int64(estimatedTax * 100),
+		AmountCents:       store.ToCents(estimatedTax),
 		EntryType:         pfinancev1.WaterfallEntryType_WATERFALL_ENTRY_TYPE_TAX,
 		RunningTotal:      runningTotal,
-		RunningTotalCents: int64(runningTotal * 100),
+		RunningTotalCents: store.ToCents(runningTotal),
 	})
 
-	// 3. Expense categories sorted by amount desc
-	type catAmount struct {
-		category pfinancev1.ExpenseCategory
-		amount   float64
+	// 3. Expense categories (or members) sorted by amount desc
+	type labelAmount struct {
+		label        string
+		memberUserID string
+		amount       float64
 	}
-	var sortedCategories []catAmount
+	var sortedExpenses []labelAmount
 	for cat, amt := range expenseByCategory {
-		sortedCategories = append(sortedCategories, catAmount{category: cat, amount: amt})
+		sortedExpenses = append(sortedExpenses, labelAmount{label: cat.String(), amount: amt})
 	}
-	sort.Slice(sortedCategories, func(i, j int) bool {
-		return sortedCategories[i].amount > sortedCategories[j].amount
+	for memberID, amt := range expenseByMember {
+		sortedExpenses = append(sortedExpenses, labelAmount{
+			label:        groupMemberLabel(group, memberID),
+			memberUserID: memberID,
+			amount:       amt,
+		})
+	}
+	sort.Slice(sortedExpenses, func(i, j int) bool {
+		return sortedExpenses[i].amount > sortedExpenses[j].amount
 	})
 
-	for _, ca := range sortedCategories {
-		runningTotal -= ca.amount
+	for _, la := range sortedExpenses {
+		runningTotal -= la.amount
+		entries = append(entries, &pfinancev1.WaterfallEntry{
+			Label:             la.label,
+			Amount:            la.amount,
+			AmountCents:       store.ToCents(la.amount),
+			EntryType:         pfinancev1.WaterfallEntryType_WATERFALL_ENTRY_TYPE_EXPENSE,
+			RunningTotal:      runningTotal,
+			RunningTotalCents: store.ToCents(runningTotal),
+			MemberUserId:      la.memberUserID,
+		})
+	}
+
+	// 4. Projected spend for the rest of an in-progress period
+	var projectedRemaining float64
+	if req.Msg.ProjectToEndOfPeriod {
+		projectedRemaining = projectRemainingSpend(totalExpenses, startDate, endDate, now)
+	}
+	if projectedRemaining > 0 {
+		runningTotal -= projectedRemaining
 		entries = append(entries, &pfinancev1.WaterfallEntry{
-			Label:             ca.category.String(),
-			Amount:            ca.amount,
-			AmountCents:       int64(ca.amount * 100),
+			Label:             "Projected remaining spend",
+			Amount:            projectedRemaining,
+			AmountCents:       store.ToCents(projectedRemaining),
 			EntryType:         pfinancev1.WaterfallEntryType_WATERFALL_ENTRY_TYPE_EXPENSE,
 			RunningTotal:      runningTotal,
-			RunningTotalCents: int64(runningTotal * 100),
+			RunningTotalCents: store.ToCents(runningTotal),
+			IsProjected:       true,
 		})
 	}
 
-	// 4. Net Savings
-	netSavings := totalIncome - totalExpenses - estimatedTax
+	// 5. Net Savings
+	netSavings := totalIncome - totalExpenses - projectedRemaining - estimatedTax
 	entries = append(entries, &pfinancev1.WaterfallEntry{
 		Label:             "Net Savings",
 		Amount:            netSavings,
-		AmountCents:       int64(netSavings * 100),
+		AmountCents:       store.ToCents(netSavings),
 		EntryType:         pfinancev1.WaterfallEntryType_WATERFALL_ENTRY_TYPE_SAVINGS,
 		RunningTotal:      netSavings,
-		RunningTotalCents: int64(netSavings * 100),
+		RunningTotalCents: store.ToCents(netSavings),
 	})
 
-	return connect.NewResponse(&pfinancev1.GetWaterfallDataResponse{
+	resp := &pfinancev1.GetWaterfallDataResponse{
 		Entries:     entries,
 		PeriodLabel: periodLabel,
+		SavingsRate: savingsRate(netSavings, totalIncome),
+	}
+
+	// The previous period's savings rate, at the same tax rate. That period
+	// has ended, so nothing is projected.
+	if req.Msg.IncludePreviousPeriod {
+		prevStart, prevEnd, _ := waterfallPeriod(period, startDate.Add(-time.Nanosecond))
+		prevIncomes, _, err := s.store.ListIncomes(ctx, userID, req.Msg.GroupId, &prevStart, &prevEnd, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 10000, "")
+		if err != nil {
+			return nil, auth.WrapStoreError("list previous incomes", err)
+		}
+		prevExpenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{StartDate: &prevStart, EndDate: &prevEnd}, 10000, "")
+		if err != nil {
+			return nil, auth.WrapStoreError("list previous expenses", err)
+		}
+		var prevIncome, prevSpent float64
+		for _, inc := range prevIncomes {
+			prevIncome += effectiveDollars(inc.AmountCents, inc.Amount)
+		}
+		for _, e := range prevExpenses {
+			prevSpent += effectiveDollars(e.AmountCents, e.Amount)
+		}
+		prevRate := savingsRate(prevIncome-prevSpent-prevIncome*estimatedTaxRate, prevIncome)
+		resp.PreviousSavingsRate = &prevRate
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// waterfallPeriod returns the bounds and label of the "week", "quarter",
+// "year" or (by default) "month" period containing t.
+func waterfallPeriod(period string, t time.Time) (startDate, endDate time.Time, label string) {
+	switch period {
+	case "week":
+		daysFromSunday := int(t.Weekday())
+		startDate = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).AddDate(0, 0, -daysFromSunday)
+		endDate = startDate.AddDate(0, 0, 6)
+		endDate = time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 23, 59, 59, 0, endDate.Location())
+		label = fmt.Sprintf("Week of %s", startDate.Format("Jan 02, 2006"))
+	case "quarter":
+		var q int
+		startDate, endDate, q = calendarQuarter(t)
+		label = fmt.Sprintf("Q%d %d", q, t.Year())
+	case "year":
+		startDate = time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
+		endDate = time.Date(t.Year(), 12, 31, 23, 59, 59, 0, t.Location())
+		label = fmt.Sprintf("%d", t.Year())
+	default: // "month"
+		startDate = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+		endDate = startDate.AddDate(0, 1, -1)
+		endDate = time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 23, 59, 59, 0, endDate.Location())
+		label = t.Format("January 2006")
+	}
+	return startDate, endDate, label
+}
+
+// savingsRate returns net savings as a fraction of gross income, or 0 when
+// there is no income to save from.
+func savingsRate(netSavings, grossIncome float64) float64 {
+	if grossIncome <= 0 {
+		return 0
+	}
+	return netSavings / grossIncome
+}
+
+// projectRemainingSpend extrapolates spending so far across the rest of the
+// period at the current daily run-rate. Today counts as an elapsed day, and a
+// period that has not started or has already ended projects nothing.
+func projectRemainingSpend(spentSoFar float64, startDate, endDate, now time.Time) float64 {
+	if spentSoFar <= 0 {
+		return 0
+	}
+	elapsed := periodElapsedFraction(startDate, endDate, now)
+	if elapsed <= 0 || elapsed >= 1 {
+		return 0
+	}
+	return spentSoFar * (1 - elapsed) / elapsed
+}
+
+// periodElapsedFraction returns the share of the period's whole days elapsed
+// at now, counting today as elapsed: 0 before the period starts and 1 on its
+// last day or later.
+func periodElapsedFraction(startDate, endDate, now time.Time) float64 {
+	if now.Before(startDate) {
+		return 0
+	}
+	totalDays := math.Round(endDate.Sub(startDate).Hours() / 24)
+	elapsedDays := math.Floor(now.Sub(startDate).Hours()/24) + 1
+	if elapsedDays >= totalDays {
+		return 1
+	}
+	return elapsedDays / totalDays
+}
+
+const (
+	defaultRecommendationMonths = 6
+	maxRecommendationMonths     = 24
+	// minRecommendationMonths is how many months must contain spending in a
+	// category before it gets a recommendation (capped at the lookback).
+	minRecommendationMonths = 3
+	// recommendationRoundingCents rounds suggestions up to a whole $10.
+	recommendationRoundingCents = 1000
+)
+
+// RecommendBudgets suggests a monthly budget per expense category from the
+// trimmed mean of recent complete months of spending.
+func (s *FinanceService) RecommendBudgets(ctx context.Context, req *connect.Request[pfinancev1.RecommendBudgetsRequest]) (*connect.Response[pfinancev1.RecommendBudgetsResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireProWithFallback(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GroupId != "" {
+		group, err := s.store.GetGroup(ctx, req.Msg.GroupId)
+		if err != nil {
+			return nil, auth.WrapStoreError("get group", err)
+		}
+		if !auth.IsGroupMember(claims.UID, group) {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("user is not a member of this group"))
+		}
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" && req.Msg.GroupId == "" {
+		userID = claims.UID
+	}
+
+	months := int(req.Msg.LookbackMonths)
+	if months < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("lookback_months must not be negative"))
+	}
+	if months == 0 {
+		months = defaultRecommendationMonths
+	}
+	months = min(months, maxRecommendationMonths)
+
+	// Only complete months are analyzed so a partial current month does not
+	// drag the averages down.
+	now := time.Now().UTC()
+	endDate := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	startDate := endDate.AddDate(0, -months, 0)
+	lastInstant := endDate.Add(-time.Nanosecond)
+
+	expenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{StartDate: &startDate, EndDate: &lastInstant}, 10000, "")
+	if err != nil {
+		return nil, auth.WrapStoreError("list expenses", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.RecommendBudgetsResponse{
+		Recommendations: buildBudgetRecommendations(expenses, months),
+		LookbackMonths:  int32(months),
 	}), nil
 }
 
+// buildBudgetRecommendations computes per-category suggestions from expenses
+// spanning the given number of months. Within each category, expenses that
+// DetectAnomalies would flag at its default sensitivity are excluded before
+// averaging, and the remaining total is spread over every month in the window.
+func buildBudgetRecommendations(expenses []*pfinancev1.Expense, months int) []*pfinancev1.BudgetRecommendation {
+	if months <= 0 {
+		return nil
+	}
+
+	type categorySpend struct {
+		amounts []float64
+		months  []string
+	}
+	byCat := make(map[pfinancev1.ExpenseCategory]*categorySpend)
+	for _, e := range expenses {
+		date := e.CreatedAt.AsTime()
+		if e.Date != nil {
+			date = e.Date.AsTime()
+		}
+		cs, ok := byCat[e.Category]
+		if !ok {
+			cs = &categorySpend{}
+			byCat[e.Category] = cs
+		}
+		cs.amounts = append(cs.amounts, effectiveDollars(e.AmountCents, e.Amount))
+		cs.months = append(cs.months, date.Format("2006-01"))
+	}
+
+	threshold := anomalyZThreshold(defaultAnomalySensitivity)
+	minMonths := min(minRecommendationMonths, months)
+
+	var recs []*pfinancev1.BudgetRecommendation
+	for cat, cs := range byCat {
+		var mean, stddev float64
+		if len(cs.amounts) >= minAnomalySamples {
+			mean, stddev = meanStdDev(cs.amounts)
+		}
+
+		var total float64
+		excluded := 0
+		activeMonths := make(map[string]bool)
+		for i, amt := range cs.amounts {
+			if stddev > 0 && math.Abs((amt-mean)/stddev) > threshold {
+				excluded++
+				continue
+			}
+			total += amt
+			activeMonths[cs.months[i]] = true
+		}
+		if len(activeMonths) < minMonths {
+			continue
+		}
+
+		avgCents := store.ToCents(total / float64(months))
+		suggestedCents := (avgCents + recommendationRoundingCents - 1) / recommendationRoundingCents * recommendationRoundingCents
+		if suggestedCents == 0 {
+			continue
+		}
+
+		rationale := fmt.Sprintf("Averaged $%.2f/month over the last %d months (spending in %d)",
+			float64(avgCents)/100, months, len(activeMonths))
+		if excluded == 1 {
+			rationale += ", excluding 1 one-off spike"
+		} else if excluded > 1 {
+			rationale += fmt.Sprintf(", excluding %d one-off spikes", excluded)
+		}
+		rationale += fmt.Sprintf("; rounded up to $%d.", suggestedCents/100)
+
+		recs = append(recs, &pfinancev1.BudgetRecommendation{
+			Category:                  cat,
+			SuggestedAmount:           float64(suggestedCents) / 100,
+			SuggestedAmountCents:      suggestedCents,
+			AverageMonthlyAmount:      float64(avgCents) / 100,
+			AverageMonthlyAmountCents: avgCents,
+			MonthsWithSpending:        int32(len(activeMonths)),
+			ExcludedOutliers:          int32(excluded),
+			Rationale:                 rationale,
+		})
+	}
+
+	sort.Slice(recs, func(i, j int) bool {
+		if recs[i].SuggestedAmountCents != recs[j].SuggestedAmountCents {
+			return recs[i].SuggestedAmountCents > recs[j].SuggestedAmountCents
+		}
+		return recs[i].Category < recs[j].Category
+	})
+	return recs
+}
+
+// GetSpendingByTag totals expenses per tag over a date range. Untagged
+// expenses are reported separately so the split adds up to overall spending.
+func (s *FinanceService) GetSpendingByTag(ctx context.Context, req *connect.Request[pfinancev1.GetSpendingByTagRequest]) (*connect.Response[pfinancev1.GetSpendingByTagResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireProWithFallback(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GroupId != "" {
+		group, err := s.store.GetGroup(ctx, req.Msg.GroupId)
+		if err != nil {
+			return nil, auth.WrapStoreError("get group", err)
+		}
+		if !auth.IsGroupMember(claims.UID, group) {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("user is not a member of this group"))
+		}
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" && req.Msg.GroupId == "" {
+		userID = claims.UID
+	}
+
+	if req.Msg.StartDate == nil || req.Msg.EndDate == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("start_date and end_date are required"))
+	}
+	startDate := req.Msg.StartDate.AsTime()
+	endDate := req.Msg.EndDate.AsTime()
+	if endDate.Before(startDate) {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("end_date must not be before start_date"))
+	}
+	if endDate.Sub(startDate).Hours()/24 > 366 {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("date range must not exceed 366 days"))
+	}
+
+	expenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{StartDate: &startDate, EndDate: &endDate}, 10000, "")
+	if err != nil {
+		return nil, auth.WrapStoreError("list expenses", err)
+	}
+
+	resp := buildTagSpending(expenses, normalizeTags(req.Msg.Tags))
+	return connect.NewResponse(resp), nil
+}
+
+// buildTagSpending sums expenses per tag, highest total first. When only is
+// non-empty, other tags are left out of the breakdown; untagged totals always
+// cover every expense without tags.
+func buildTagSpending(expenses []*pfinancev1.Expense, only []string) *pfinancev1.GetSpendingByTagResponse {
+	resp := &pfinancev1.GetSpendingByTagResponse{}
+	byTag := make(map[string]*pfinancev1.TagSpending)
+	for _, e := range expenses {
+		cents := store.ToCents(effectiveDollars(e.AmountCents, e.Amount))
+		// Older expenses may predate tag normalization on write.
+		tags := normalizeTags(e.Tags)
+		if len(tags) == 0 {
+			resp.UntaggedAmountCents += cents
+			resp.UntaggedCount++
+			continue
+		}
+		for _, tag := range tags {
+			if len(only) > 0 && !slices.Contains(only, tag) {
+				continue
+			}
+			ts, ok := byTag[tag]
+			if !ok {
+				ts = &pfinancev1.TagSpending{Tag: tag}
+				byTag[tag] = ts
+			}
+			ts.AmountCents += cents
+			ts.ExpenseCount++
+		}
+	}
+	resp.UntaggedAmount = float64(resp.UntaggedAmountCents) / 100
+
+	for _, ts := range byTag {
+		ts.Amount = float64(ts.AmountCents) / 100
+		resp.Tags = append(resp.Tags, ts)
+	}
+	sort.Slice(resp.Tags, func(i, j int) bool {
+		if resp.Tags[i].AmountCents != resp.Tags[j].AmountCents {
+			return resp.Tags[i].AmountCents > resp.Tags[j].AmountCents
+		}
+		return resp.Tags[i].Tag < resp.Tags[j].Tag
+	})
+	return resp
+}
+
 // ============================================================================
 // Analytics Helpers
 // ============================================================================
 
+// groupMemberLabel returns a display label for a group member, falling back to
+// email and then user ID when the member has no display name (or has left).
+func groupMemberLabel(group *pfinancev1.FinanceGroup, userID string) string {
+	for _, m := range group.GetMembers() {
+		if m.UserId != userID {
+			continue
+		}
+		if m.DisplayName != "" {
+			return m.DisplayName
+		}
+		if m.Email != "" {
+			return m.Email
+		}
+		break
+	}
+	return userID
+}
+
 // computeLinearRegression computes slope and R-squared for a series of y-values
 // where x = 0, 1, 2, ... (the index).
 func computeLinearRegression(points []float64) (slope, rSquared float64) {
@@ -3,6 +3,10 @@ package service
 import (
 	"context"
 	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -95,6 +99,64 @@ func TestAnalyticsGetDailyAggregates(t *testing.T) {
 		}
 	})
 
+	t.Run("include income adds a parallel income total", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		endDate := time.Date(2025, 1, 3, 23, 59, 59, 0, time.UTC)
+
+		mockStore.EXPECT().
+			GetDailyAggregates(gomock.Any(), userID, "", startDate, endDate).
+			Return([]*pfinancev1.DailyAggregate{
+				{Date: "2025-01-01", TotalAmount: 50.00, TotalAmountCents: 5000, TransactionCount: 3},
+				{Date: "2025-01-03", TotalAmount: 30.00, TotalAmountCents: 3000, TransactionCount: 1},
+			}, nil)
+		mockStore.EXPECT().
+			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", gomock.Any(), gomock.Any(), int32(500), "").
+			Return([]*pfinancev1.Income{
+				{Id: "salary", Amount: 4000, AmountCents: 400000, Date: timestamppb.New(time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC))},
+				{Id: "refund", AmountCents: 2500, Date: timestamppb.New(time.Date(2025, 1, 3, 12, 0, 0, 0, time.UTC))},
+				{Id: "legacy", Amount: 0.29, Date: timestamppb.New(time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC))},
+				{Id: "undated", Amount: 10},
+			}, "", nil)
+
+		resp, err := service.GetDailyAggregates(ctx, connect.NewRequest(&pfinancev1.GetDailyAggregatesRequest{
+			UserId:        userID,
+			StartDate:     timestamppb.New(startDate),
+			EndDate:       timestamppb.New(endDate),
+			IncludeIncome: true,
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var dates []string
+		for _, agg := range resp.Msg.Aggregates {
+			dates = append(dates, agg.Date)
+		}
+		if !slices.Equal(dates, []string{"2025-01-01", "2025-01-02", "2025-01-03"}) {
+			t.Fatalf("dates = %v, want the income-only day inserted in order", dates)
+		}
+		if legacyDay := resp.Msg.Aggregates[0]; legacyDay.IncomeAmountCents != 29 {
+			t.Errorf("legacy income cents = %d, want 29 rounded from 0.29", legacyDay.IncomeAmountCents)
+		}
+		incomeDay := resp.Msg.Aggregates[1]
+		if incomeDay.IncomeAmountCents != 400000 || incomeDay.IncomeCount != 1 || incomeDay.TotalAmountCents != 0 {
+			t.Errorf("income-only day = %v", incomeDay)
+		}
+		mixedDay := resp.Msg.Aggregates[2]
+		if mixedDay.IncomeAmount != 25 || mixedDay.IncomeAmountCents != 2500 || mixedDay.TotalAmountCents != 3000 {
+			t.Errorf("mixed day = %v", mixedDay)
+		}
+		if resp.Msg.MaxDailyAmountCents != 5000 {
+			t.Errorf("max daily amount cents = %d, want the expense-only 5000", resp.Msg.MaxDailyAmountCents)
+		}
+		if resp.Msg.MaxDailyIncomeAmountCents != 400000 || resp.Msg.MaxDailyIncomeAmount != 4000 {
+			t.Errorf("max daily income = %v / %d, want 4000 / 400000",
+				resp.Msg.MaxDailyIncomeAmount, resp.Msg.MaxDailyIncomeAmountCents)
+		}
+	})
+
 	t.Run("requires missing dates returns error", func(t *testing.T) {
 		ctx := testProContext(userID)
 
@@ -217,11 +279,11 @@ func TestAnalyticsGetSpendingTrends(t *testing.T) {
 
 		// Single call for the entire date range
 		mockStore.EXPECT().
-			ListExpenses(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), int32(10000), "").
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
 			Return(allExpenses, "", nil)
 
 		mockStore.EXPECT().
-			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), int32(10000), "").
+			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
 			Return(allIncomes, "", nil)
 
 		resp, err := service.GetSpendingTrends(ctx, connect.NewRequest(&pfinancev1.GetSpendingTrendsRequest{
@@ -252,6 +314,190 @@ func TestAnalyticsGetSpendingTrends(t *testing.T) {
 		}
 	})
 
+	t.Run("weekly buckets start on the requested day", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+
+		expenses := []*pfinancev1.Expense{
+			{Id: "this-monday", UserId: userID, Amount: 40, Date: timestamppb.New(monday.Add(time.Hour))},
+			{Id: "last-sunday", UserId: userID, Amount: 15, Date: timestamppb.New(monday.Add(-time.Hour))},
+		}
+
+		var fetchedStart, fetchedEnd time.Time
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			DoAndReturn(func(_ context.Context, _, _ string, filter store.ExpenseQuery, _ int32, _ string) ([]*pfinancev1.Expense, string, error) {
+				fetchedStart, fetchedEnd = *filter.StartDate, *filter.EndDate
+				return expenses, "", nil
+			})
+		mockStore.EXPECT().
+			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
+			Return(nil, "", nil)
+
+		resp, err := service.GetSpendingTrends(ctx, connect.NewRequest(&pfinancev1.GetSpendingTrendsRequest{
+			UserId:       userID,
+			Granularity:  pfinancev1.Granularity_GRANULARITY_WEEK,
+			Periods:      3,
+			WeekStartsOn: pfinancev1.DayOfWeek_DAY_OF_WEEK_MONDAY,
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !fetchedStart.Equal(monday.AddDate(0, 0, -14)) {
+			t.Errorf("fetch started %v, want Monday two weeks back %v", fetchedStart, monday.AddDate(0, 0, -14))
+		}
+		if fetchedEnd.Weekday() != time.Sunday || fetchedEnd.Before(now) {
+			t.Errorf("fetch ended %v, want the Sunday closing the current week", fetchedEnd)
+		}
+
+		series := resp.Msg.ExpenseSeries
+		if series[2].Value != 40 || series[1].Value != 15 {
+			t.Errorf("weekly values = %v/%v, want Monday spend in the current week and Sunday spend in the previous one",
+				series[1].Value, series[2].Value)
+		}
+		if want := monday.Format("Mon Jan 02"); series[2].Label != want {
+			t.Errorf("label = %q, want %q", series[2].Label, want)
+		}
+	})
+
+	t.Run("smoothing window adds moving-average series", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		now := time.Now()
+		expenses := []*pfinancev1.Expense{
+			{Id: "e1", UserId: userID, Amount: 100, Date: timestamppb.New(now.AddDate(0, -2, 0))},
+			{Id: "e2", UserId: userID, Amount: 200, Date: timestamppb.New(now.AddDate(0, -1, 0))},
+			{Id: "e3", UserId: userID, Amount: 300, Date: timestamppb.New(now)},
+		}
+
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return(expenses, "", nil)
+		mockStore.EXPECT().
+			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
+			Return(nil, "", nil)
+
+		resp, err := service.GetSpendingTrends(ctx, connect.NewRequest(&pfinancev1.GetSpendingTrendsRequest{
+			UserId:          userID,
+			Granularity:     pfinancev1.Granularity_GRANULARITY_MONTH,
+			Periods:         3,
+			SmoothingWindow: 3,
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if resp.Msg.ExpenseSeries[0].Value != 100 {
+			t.Errorf("raw series should be unchanged, got first value %v", resp.Msg.ExpenseSeries[0].Value)
+		}
+		smoothed := resp.Msg.SmoothedExpenseSeries
+		if len(smoothed) != 3 {
+			t.Fatalf("expected 3 smoothed points, got %d", len(smoothed))
+		}
+		if smoothed[1].Value != 200 || smoothed[0].Value != 150 {
+			t.Errorf("smoothed values = %v/%v, want 150/200", smoothed[0].Value, smoothed[1].Value)
+		}
+		if len(resp.Msg.SmoothedIncomeSeries) != 3 {
+			t.Errorf("expected 3 smoothed income points, got %d", len(resp.Msg.SmoothedIncomeSeries))
+		}
+	})
+
+	t.Run("year over year adds prior-year series", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		now := time.Now()
+		thisMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		lastMonth := thisMonth.AddDate(0, -1, 0)
+		expenses := []*pfinancev1.Expense{
+			{Id: "now", UserId: userID, Amount: 150, Date: timestamppb.New(thisMonth.AddDate(0, 0, 9))},
+			{Id: "last-month", UserId: userID, Amount: 80, Date: timestamppb.New(lastMonth.AddDate(0, 0, 9))},
+			{Id: "last-year", UserId: userID, Amount: 100, Date: timestamppb.New(thisMonth.AddDate(-1, 0, 9))},
+		}
+
+		var fetchedStart time.Time
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			DoAndReturn(func(_ context.Context, _, _ string, filter store.ExpenseQuery, _ int32, _ string) ([]*pfinancev1.Expense, string, error) {
+				fetchedStart = *filter.StartDate
+				return expenses, "", nil
+			})
+		mockStore.EXPECT().
+			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
+			Return(nil, "", nil)
+
+		resp, err := service.GetSpendingTrends(ctx, connect.NewRequest(&pfinancev1.GetSpendingTrendsRequest{
+			UserId:              userID,
+			Granularity:         pfinancev1.Granularity_GRANULARITY_MONTH,
+			Periods:             2,
+			CompareYearOverYear: true,
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if want := lastMonth.AddDate(-1, 0, 0); !fetchedStart.Equal(want) {
+			t.Errorf("fetch started %v, want %v", fetchedStart, want)
+		}
+		if resp.Msg.ExpenseSeries[1].Value != 150 {
+			t.Errorf("current series should exclude the prior year, got %v", resp.Msg.ExpenseSeries[1].Value)
+		}
+		prior := resp.Msg.PriorYearExpenseSeries
+		if len(prior) != 2 {
+			t.Fatalf("expected 2 prior-year points, got %d", len(prior))
+		}
+		if prior[0].Value != 0 || prior[1].Value != 100 {
+			t.Errorf("prior-year values = %v/%v, want 0/100", prior[0].Value, prior[1].Value)
+		}
+		if want := thisMonth.AddDate(-1, 0, 0).Format("Jan 2006"); prior[1].Label != want {
+			t.Errorf("prior-year label = %q, want %q", prior[1].Label, want)
+		}
+		if got := resp.Msg.ExpenseYearOverYearChangePercent; !slices.Equal(got, []float64{0, 50}) {
+			t.Errorf("expense change percent = %v, want [0 50]", got)
+		}
+		if len(resp.Msg.PriorYearIncomeSeries) != 2 || len(resp.Msg.IncomeYearOverYearChangePercent) != 2 {
+			t.Errorf("expected prior-year income series and deltas for both periods")
+		}
+	})
+
+	t.Run("year over year is off by default", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return(nil, "", nil)
+		mockStore.EXPECT().
+			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
+			Return(nil, "", nil)
+
+		resp, err := service.GetSpendingTrends(ctx, connect.NewRequest(&pfinancev1.GetSpendingTrendsRequest{
+			UserId:  userID,
+			Periods: 2,
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Msg.PriorYearExpenseSeries != nil || resp.Msg.ExpenseYearOverYearChangePercent != nil {
+			t.Error("prior-year fields should be unset without compare_year_over_year")
+		}
+	})
+
+	t.Run("rejects negative smoothing window", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		_, err := service.GetSpendingTrends(ctx, connect.NewRequest(&pfinancev1.GetSpendingTrendsRequest{
+			UserId:          userID,
+			Periods:         3,
+			SmoothingWindow: -1,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("expected CodeInvalidArgument, got %v", connect.CodeOf(err))
+		}
+	})
+
 	t.Run("requires pro tier", func(t *testing.T) {
 		ctx := testContextWithUser(userID)
 
@@ -285,6 +531,49 @@ func TestAnalyticsGetSpendingTrends(t *testing.T) {
 	})
 }
 
+func TestDaysSinceWeekStart(t *testing.T) {
+	tests := []struct {
+		day      time.Weekday
+		startsOn pfinancev1.DayOfWeek
+		want     int
+	}{
+		{time.Wednesday, pfinancev1.DayOfWeek_DAY_OF_WEEK_UNSPECIFIED, 3},
+		{time.Sunday, pfinancev1.DayOfWeek_DAY_OF_WEEK_SUNDAY, 0},
+		{time.Sunday, pfinancev1.DayOfWeek_DAY_OF_WEEK_MONDAY, 6},
+		{time.Monday, pfinancev1.DayOfWeek_DAY_OF_WEEK_MONDAY, 0},
+		{time.Friday, pfinancev1.DayOfWeek_DAY_OF_WEEK_SATURDAY, 6},
+	}
+	for _, tt := range tests {
+		if got := daysSinceWeekStart(tt.day, tt.startsOn); got != tt.want {
+			t.Errorf("daysSinceWeekStart(%v, %v) = %d, want %d", tt.day, tt.startsOn, got, tt.want)
+		}
+	}
+}
+
+func TestFortnightStart(t *testing.T) {
+	loc := time.UTC
+	wed := time.Date(2025, 3, 12, 15, 0, 0, 0, loc) // a Wednesday
+	tests := []struct {
+		name   string
+		anchor time.Time
+		want   time.Time
+	}{
+		{"defaults to the most recent Monday", time.Time{}, time.Date(2025, 3, 10, 0, 0, 0, 0, loc)},
+		{"anchor today", time.Date(2025, 3, 12, 9, 0, 0, 0, loc), time.Date(2025, 3, 12, 0, 0, 0, 0, loc)},
+		{"anchor 13 days back", time.Date(2025, 2, 27, 0, 0, 0, 0, loc), time.Date(2025, 2, 27, 0, 0, 0, 0, loc)},
+		{"anchor 14 days back starts a new period", time.Date(2025, 2, 26, 0, 0, 0, 0, loc), time.Date(2025, 3, 12, 0, 0, 0, 0, loc)},
+		{"anchor months back", time.Date(2024, 12, 20, 0, 0, 0, 0, loc), time.Date(2025, 2, 28, 0, 0, 0, 0, loc)},
+		{"anchor in the future", time.Date(2025, 3, 20, 0, 0, 0, 0, loc), time.Date(2025, 3, 6, 0, 0, 0, 0, loc)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fortnightStart(wed, tt.anchor); !got.Equal(tt.want) {
+				t.Errorf("fortnightStart = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // --------------------------------------------------------------------------
 // TestAnalyticsGetCategoryComparison
 // --------------------------------------------------------------------------
@@ -344,12 +633,12 @@ func TestAnalyticsGetCategoryComparison(t *testing.T) {
 
 		// Current period ListExpenses
 		mockStore.EXPECT().
-			ListExpenses(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), int32(10000), "").
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
 			Return(currentExpenses, "", nil)
 
 		// Previous period ListExpenses
 		mockStore.EXPECT().
-			ListExpenses(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), int32(10000), "").
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
 			Return(prevExpenses, "", nil)
 
 		// ListBudgets (IncludeBudgets=true)
@@ -392,6 +681,247 @@ func TestAnalyticsGetCategoryComparison(t *testing.T) {
 		}
 	})
 
+	t.Run("multi-category budget counts once in the totals row", func(t *testing.T) {
+		currentExpenses := []*pfinancev1.Expense{
+			{Id: "exp-1", UserId: userID, Amount: 50.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+			{Id: "exp-2", UserId: userID, Amount: 20.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT},
+			{Id: "exp-3", UserId: userID, Amount: 10.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_OTHER},
+			{Id: "exp-4", UserId: userID, Amount: 5.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED},
+		}
+		budgets := []*pfinancev1.Budget{
+			{Id: "fun", UserId: userID, AmountCents: 30001, CategoryIds: []pfinancev1.ExpenseCategory{
+				pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD,
+				pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT,
+			}},
+			{Id: "misc", UserId: userID, AmountCents: 4000, CategoryIds: []pfinancev1.ExpenseCategory{pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_OTHER}},
+			{Id: "none", UserId: userID, AmountCents: 1000, CategoryIds: []pfinancev1.ExpenseCategory{pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED}},
+			{Id: "odds", UserId: userID, AmountCents: 2000, CategoryIds: []pfinancev1.ExpenseCategory{
+				pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_OTHER,
+				pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED,
+			}},
+		}
+
+		tests := []struct {
+			name      string
+			totalsRow bool
+			want      map[pfinancev1.ExpenseCategory]int64
+			wantTotal int64
+		}{
+			{
+				name: "without totals row",
+				want: map[pfinancev1.ExpenseCategory]int64{
+					pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD:          30001,
+					pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT: 30001,
+					pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_OTHER:         6000,
+					pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED:   3000,
+				},
+			},
+			{
+				// Other and unspecified roll up into Uncategorized, where a
+				// budget covering both still counts once
+				name:      "with totals row",
+				totalsRow: true,
+				want: map[pfinancev1.ExpenseCategory]int64{
+					pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD:          30001,
+					pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT: 30001,
+					pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED:   7000,
+				},
+				wantTotal: 37001,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockStore.EXPECT().
+					ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+					Return(currentExpenses, "", nil)
+				mockStore.EXPECT().
+					ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+					Return(nil, "", nil)
+				mockStore.EXPECT().
+					ListBudgets(gomock.Any(), userID, "", false, int32(10000), "").
+					Return(budgets, "", nil)
+
+				resp, err := service.GetCategoryComparison(testProContext(userID), connect.NewRequest(&pfinancev1.GetCategoryComparisonRequest{
+					UserId:           userID,
+					CurrentPeriod:    "month",
+					IncludeBudgets:   true,
+					IncludeTotalsRow: tt.totalsRow,
+				}))
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				budgetCents := make(map[pfinancev1.ExpenseCategory]int64)
+				var total *pfinancev1.CategorySpending
+				for _, cs := range resp.Msg.Categories {
+					if cs.IsTotal {
+						total = cs
+						continue
+					}
+					budgetCents[cs.Category] = cs.BudgetAmountCents
+				}
+				if !maps.Equal(budgetCents, tt.want) {
+					t.Errorf("budget cents by category = %v, want %v", budgetCents, tt.want)
+				}
+				if !tt.totalsRow {
+					if total != nil {
+						t.Errorf("unexpected totals row %v", total)
+					}
+					return
+				}
+				if total == nil || total.BudgetAmountCents != tt.wantTotal {
+					t.Errorf("totals row = %v, want a budget of %d cents", total, tt.wantTotal)
+				}
+			})
+		}
+	})
+
+	t.Run("include totals row rolls up uncategorized", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		currentExpenses := []*pfinancev1.Expense{
+			{Id: "exp-1", UserId: userID, Amount: 50.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+			{Id: "exp-2", UserId: userID, Amount: 300.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED},
+			{Id: "exp-3", UserId: userID, Amount: 100.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_OTHER},
+		}
+		prevExpenses := []*pfinancev1.Expense{
+			{Id: "exp-prev-1", UserId: userID, Amount: 100.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+			{Id: "exp-prev-2", UserId: userID, Amount: 200.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_OTHER},
+		}
+
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return(currentExpenses, "", nil)
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return(prevExpenses, "", nil)
+
+		resp, err := service.GetCategoryComparison(ctx, connect.NewRequest(&pfinancev1.GetCategoryComparisonRequest{
+			UserId:           userID,
+			CurrentPeriod:    "month",
+			IncludeTotalsRow: true,
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// Food, Uncategorized (last despite being largest), Total
+		cats := resp.Msg.Categories
+		if len(cats) != 3 {
+			t.Fatalf("expected 3 rows, got %d", len(cats))
+		}
+		if cats[0].Category != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD {
+			t.Errorf("expected Food first, got %v", cats[0].Category)
+		}
+		uncategorized := cats[1]
+		if uncategorized.Label != "Uncategorized" || uncategorized.CurrentAmount != 400.00 || uncategorized.PreviousAmount != 200.00 {
+			t.Errorf("unexpected uncategorized row: %+v", uncategorized)
+		}
+		total := cats[2]
+		if !total.IsTotal || total.Label != "Total" {
+			t.Errorf("expected total row last, got %+v", total)
+		}
+		if total.CurrentAmount != 450.00 || total.PreviousAmount != 300.00 {
+			t.Errorf("expected totals 450/300, got %f/%f", total.CurrentAmount, total.PreviousAmount)
+		}
+		if total.ChangePercent != 50.0 {
+			t.Errorf("expected total change 50%%, got %f", total.ChangePercent)
+		}
+	})
+
+	t.Run("fortnight compares against the prior 14 days from the anchor", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		// Anchor three fortnights and three days back: the current period began 3 days ago
+		anchor := today.AddDate(0, 0, -45)
+
+		var starts, ends []time.Time
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			DoAndReturn(func(_ context.Context, _, _ string, filter store.ExpenseQuery, _ int32, _ string) ([]*pfinancev1.Expense, string, error) {
+				starts, ends = append(starts, *filter.StartDate), append(ends, *filter.EndDate)
+				return nil, "", nil
+			}).Times(2)
+
+		_, err := service.GetCategoryComparison(ctx, connect.NewRequest(&pfinancev1.GetCategoryComparisonRequest{
+			UserId:          userID,
+			CurrentPeriod:   "fortnight",
+			FortnightAnchor: timestamppb.New(anchor),
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		wantStart := today.AddDate(0, 0, -3)
+		if !starts[0].Equal(wantStart) || ends[0].Format("2006-01-02") != wantStart.AddDate(0, 0, 13).Format("2006-01-02") {
+			t.Errorf("current period = %v to %v, want 14 days from %v", starts[0], ends[0], wantStart)
+		}
+		if !starts[1].Equal(wantStart.AddDate(0, 0, -14)) || ends[1].Format("2006-01-02") != wantStart.AddDate(0, 0, -1).Format("2006-01-02") {
+			t.Errorf("previous period = %v to %v, want the 14 days before %v", starts[1], ends[1], wantStart)
+		}
+	})
+
+	t.Run("include top transactions attaches the largest expenses per category", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		currentExpenses := []*pfinancev1.Expense{
+			{Id: "exp-1", UserId: userID, Amount: 20.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+			{Id: "exp-2", UserId: userID, AmountCents: 9000, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+			{Id: "exp-3", UserId: userID, Amount: 45.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+			{Id: "exp-0", UserId: userID, Amount: 45.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+			{Id: "exp-4", UserId: userID, Amount: 60.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_TRANSPORTATION},
+		}
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return(currentExpenses, "", nil)
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return(nil, "", nil)
+
+		resp, err := service.GetCategoryComparison(ctx, connect.NewRequest(&pfinancev1.GetCategoryComparisonRequest{
+			UserId:                 userID,
+			CurrentPeriod:          "month",
+			IncludeTopTransactions: 3,
+			IncludeTotalsRow:       true,
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		tops := map[pfinancev1.ExpenseCategory][]string{}
+		for _, c := range resp.Msg.Categories {
+			if c.IsTotal {
+				if len(c.TopTransactions) != 0 {
+					t.Errorf("expected no top transactions on the totals row, got %d", len(c.TopTransactions))
+				}
+				continue
+			}
+			for _, e := range c.TopTransactions {
+				tops[c.Category] = append(tops[c.Category], e.Id)
+			}
+		}
+		// Largest first, equal amounts ordered by ID, capped at 3
+		if got := tops[pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD]; !slices.Equal(got, []string{"exp-2", "exp-0", "exp-3"}) {
+			t.Errorf("food top transactions = %v, want [exp-2 exp-0 exp-3]", got)
+		}
+		if got := tops[pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_TRANSPORTATION]; !slices.Equal(got, []string{"exp-4"}) {
+			t.Errorf("transportation top transactions = %v, want [exp-4]", got)
+		}
+	})
+
+	t.Run("rejects too many top transactions", func(t *testing.T) {
+		_, err := service.GetCategoryComparison(testProContext(userID), connect.NewRequest(&pfinancev1.GetCategoryComparisonRequest{
+			UserId:                 userID,
+			IncludeTopTransactions: maxCategoryTopTransactions + 1,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("expected CodeInvalidArgument, got %v", connect.CodeOf(err))
+		}
+	})
+
 	t.Run("requires pro tier", func(t *testing.T) {
 		ctx := testContextWithUser(userID)
 
@@ -481,7 +1011,7 @@ func TestAnalyticsDetectAnomalies(t *testing.T) {
 		})
 
 		mockStore.EXPECT().
-			ListExpenses(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), int32(10000), "").
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
 			Return(expenses, "", nil)
 
 		resp, err := service.DetectAnomalies(ctx, connect.NewRequest(&pfinancev1.DetectAnomaliesRequest{
@@ -503,9 +1033,22 @@ func TestAnalyticsDetectAnomalies(t *testing.T) {
 		for _, a := range resp.Msg.Anomalies {
 			if a.AnomalyType == pfinancev1.AnomalyType_ANOMALY_TYPE_AMOUNT_OUTLIER {
 				foundAmountOutlier = true
+				c := a.Comparison
+				if c == nil {
+					t.Fatal("expected a comparison on the amount outlier")
+				}
+				if c.AmountCents != 50000 || c.Percentile != 100 || c.StdDevs != a.ZScore || c.Multiple <= 10 {
+					t.Errorf("comparison = %v, want the top amount at over 10x typical", c)
+				}
+				if !strings.Contains(a.Explanation, "Expensive Restaurant") || !strings.Contains(a.Explanation, "typical") {
+					t.Errorf("explanation = %q", a.Explanation)
+				}
 			}
 			if a.AnomalyType == pfinancev1.AnomalyType_ANOMALY_TYPE_NEW_MERCHANT {
 				foundNewMerchant = true
+				if a.Explanation == "" {
+					t.Error("expected an explanation on the new merchant anomaly")
+				}
 			}
 		}
 		if !foundAmountOutlier {
@@ -521,33 +1064,123 @@ func TestAnalyticsDetectAnomalies(t *testing.T) {
 		}
 	})
 
-	t.Run("requires pro tier", func(t *testing.T) {
-		ctx := testContextWithUser(userID)
+	t.Run("merchant seen before the lookback is not new", func(t *testing.T) {
+		ctx := testProContext(userID)
+		now := time.Now()
 
-		_, err := service.DetectAnomalies(ctx, connect.NewRequest(&pfinancev1.DetectAnomaliesRequest{
-			UserId: userID,
-		}))
+		expenses := []*pfinancev1.Expense{
+			{Id: "exp-netflix", UserId: userID, Description: "Netflix", Amount: 20.00,
+				Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT, Date: timestamppb.New(now.AddDate(0, 0, -3))},
+			{Id: "exp-new", UserId: userID, Description: "BrandNewShop", Amount: 25.00,
+				Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_SHOPPING, Date: timestamppb.New(now.AddDate(0, 0, -2))},
+		}
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return(expenses, "", nil)
+		mockStore.EXPECT().
+			HasMerchantExpense(gomock.Any(), userID, "", "Netflix", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _, _ string, since, before time.Time) (bool, error) {
+				if got := before.Sub(since); got < 364*24*time.Hour || got > 366*24*time.Hour {
+					t.Errorf("history window = %v, want 365 days", got)
+				}
+				return true, nil
+			})
+		mockStore.EXPECT().
+			HasMerchantExpense(gomock.Any(), userID, "", "BrandNewShop", gomock.Any(), gomock.Any()).
+			Return(false, nil)
 
-		if err == nil {
-			t.Fatal("expected permission denied error for non-Pro user, got nil")
+		resp, err := service.DetectAnomalies(ctx, connect.NewRequest(&pfinancev1.DetectAnomaliesRequest{
+			UserId:              userID,
+			LookbackDays:        30,
+			MerchantHistoryDays: 365,
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
 		}
-		if connect.CodeOf(err) != connect.CodePermissionDenied {
-			t.Errorf("expected CodePermissionDenied, got %v", connect.CodeOf(err))
+		if len(resp.Msg.Anomalies) != 1 || resp.Msg.Anomalies[0].ExpenseId != "exp-new" {
+			t.Errorf("anomalies = %v, want only BrandNewShop flagged as new", resp.Msg.Anomalies)
 		}
 	})
 
-	t.Run("requires auth", func(t *testing.T) {
-		ctx := context.Background()
+	t.Run("flags expenses far outside the category's usual hours when asked", func(t *testing.T) {
+		ctx := testProContext(userID)
+		today := time.Now().UTC().Truncate(24 * time.Hour)
 
-		_, err := service.DetectAnomalies(ctx, connect.NewRequest(&pfinancev1.DetectAnomaliesRequest{
-			UserId: userID,
-		}))
+		var expenses []*pfinancev1.Expense
+		for i := 1; i <= 12; i++ {
+			day := today.AddDate(0, 0, -i)
+			expenses = append(expenses,
+				&pfinancev1.Expense{Id: fmt.Sprintf("exp-coffee-%d", i), UserId: userID, Description: "Coffee", Amount: 5.00,
+					Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD, Date: timestamppb.New(day.Add(8*time.Hour + time.Duration(i)*time.Minute))},
+				// Date-only expenses carry no time of day and are never time outliers
+				&pfinancev1.Expense{Id: fmt.Sprintf("exp-rent-%d", i), UserId: userID, Description: "Rent", Amount: 5.00,
+					Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_HOUSING, Date: timestamppb.New(day)},
+			)
+		}
+		expenses = append(expenses, &pfinancev1.Expense{Id: "exp-late", UserId: userID, Description: "Coffee", Amount: 5.00,
+			Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD, Date: timestamppb.New(today.AddDate(0, 0, -3).Add(3 * time.Hour))})
 
-		if err == nil {
-			t.Fatal("expected unauthenticated error, got nil")
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return(expenses, "", nil).Times(2)
+
+		resp, err := service.DetectAnomalies(ctx, connect.NewRequest(&pfinancev1.DetectAnomaliesRequest{UserId: userID}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
 		}
-		if connect.CodeOf(err) != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connect.CodeOf(err))
+		if len(resp.Msg.Anomalies) != 0 {
+			t.Errorf("anomalies = %v, want none without include_time_outliers", resp.Msg.Anomalies)
+		}
+
+		resp, err = service.DetectAnomalies(ctx, connect.NewRequest(&pfinancev1.DetectAnomaliesRequest{
+			UserId:              userID,
+			IncludeTimeOutliers: true,
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Msg.Anomalies) != 1 {
+			t.Fatalf("anomalies = %v, want only the 03:00 coffee", resp.Msg.Anomalies)
+		}
+		a := resp.Msg.Anomalies[0]
+		if a.ExpenseId != "exp-late" || a.AnomalyType != pfinancev1.AnomalyType_ANOMALY_TYPE_TIME_OUTLIER {
+			t.Errorf("anomaly = %s (%v), want exp-late as a time outlier", a.ExpenseId, a.AnomalyType)
+		}
+		if a.Severity != pfinancev1.AnomalySeverity_ANOMALY_SEVERITY_MEDIUM {
+			t.Errorf("severity = %v, want MEDIUM with no nearby expenses", a.Severity)
+		}
+		if !strings.Contains(a.Explanation, "outside your usual") || strings.Contains(a.Explanation, "03:00") {
+			t.Errorf("explanation = %q, want the outlier described without a UTC clock time", a.Explanation)
+		}
+	})
+
+	t.Run("requires pro tier", func(t *testing.T) {
+		ctx := testContextWithUser(userID)
+
+		_, err := service.DetectAnomalies(ctx, connect.NewRequest(&pfinancev1.DetectAnomaliesRequest{
+			UserId: userID,
+		}))
+
+		if err == nil {
+			t.Fatal("expected permission denied error for non-Pro user, got nil")
+		}
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("expected CodePermissionDenied, got %v", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("requires auth", func(t *testing.T) {
+		ctx := context.Background()
+
+		_, err := service.DetectAnomalies(ctx, connect.NewRequest(&pfinancev1.DetectAnomaliesRequest{
+			UserId: userID,
+		}))
+
+		if err == nil {
+			t.Fatal("expected unauthenticated error, got nil")
+		}
+		if connect.CodeOf(err) != connect.CodeUnauthenticated {
+			t.Errorf("expected CodeUnauthenticated, got %v", connect.CodeOf(err))
 		}
 	})
 }
@@ -625,11 +1258,11 @@ func TestAnalyticsGetCashFlowForecast(t *testing.T) {
 		}
 
 		mockStore.EXPECT().
-			ListExpenses(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), int32(10000), "").
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
 			Return(expenses, "", nil)
 
 		mockStore.EXPECT().
-			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), int32(10000), "").
+			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
 			Return(incomes, "", nil)
 
 		mockStore.EXPECT().
@@ -639,8 +1272,9 @@ func TestAnalyticsGetCashFlowForecast(t *testing.T) {
 			Return(recurringTxns, "", nil)
 
 		resp, err := service.GetCashFlowForecast(ctx, connect.NewRequest(&pfinancev1.GetCashFlowForecastRequest{
-			UserId:       userID,
-			ForecastDays: 30,
+			UserId:               userID,
+			ForecastDays:         30,
+			StartingBalanceCents: 100000,
 		}))
 
 		if err != nil {
@@ -681,6 +1315,202 @@ func TestAnalyticsGetCashFlowForecast(t *testing.T) {
 		if !foundRecurring {
 			t.Error("expected at least one recurring expense forecast point")
 		}
+
+		// $1000 does not cover the $1500 rent, so the balance bottoms out on rent day
+		if len(resp.Msg.BalanceForecast) != 30 {
+			t.Fatalf("expected 30 balance forecast points, got %d", len(resp.Msg.BalanceForecast))
+		}
+		wantBalance := int64(100000)
+		for _, np := range resp.Msg.NetForecast {
+			wantBalance += np.PredictedCents
+		}
+		if got := resp.Msg.BalanceForecast[29].ValueCents; got != wantBalance {
+			t.Errorf("final balance = %d cents, want %d", got, wantBalance)
+		}
+		if !resp.Msg.GoesNegative {
+			t.Errorf("expected GoesNegative, min balance %d cents", resp.Msg.MinBalanceCents)
+		}
+		if want := now.AddDate(0, 0, 5).Format("2006-01-02"); resp.Msg.MinBalanceDate != want {
+			t.Errorf("min balance date = %s, want rent day %s", resp.Msg.MinBalanceDate, want)
+		}
+	})
+
+	t.Run("confidence level scales bound width", func(t *testing.T) {
+		ctx := testProContext(userID)
+		now := time.Now()
+
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return([]*pfinancev1.Expense{
+				{Id: "exp-1", UserId: userID, Amount: 80.00, Date: timestamppb.New(now.AddDate(0, 0, -3))},
+				{Id: "exp-2", UserId: userID, Amount: 20.00, Date: timestamppb.New(now.AddDate(0, 0, -12))},
+			}, "", nil).Times(3)
+		mockStore.EXPECT().
+			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
+			Return([]*pfinancev1.Income{
+				{Id: "inc-1", UserId: userID, Amount: 2000.00, Date: timestamppb.New(now.AddDate(0, 0, -14))},
+			}, "", nil).Times(3)
+		mockStore.EXPECT().
+			ListRecurringTransactions(gomock.Any(), userID, "",
+				pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+				false, false, int32(10000), "").
+			Return(nil, "", nil).Times(3)
+
+		// Upper bound minus prediction, since lower bounds can clamp at zero.
+		halfWidths := func(level float64) (float64, float64, float64) {
+			resp, err := service.GetCashFlowForecast(ctx, connect.NewRequest(&pfinancev1.GetCashFlowForecastRequest{
+				UserId:          userID,
+				ForecastDays:    7,
+				ConfidenceLevel: level,
+			}))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			exp := resp.Msg.ExpenseForecast[0]
+			inc := resp.Msg.IncomeForecast[0]
+			return exp.UpperBound - exp.Predicted, inc.UpperBound - inc.Predicted, resp.Msg.ConfidenceLevel
+		}
+
+		defExp, defInc, defLevel := halfWidths(0)
+		wideExp, wideInc, wideLevel := halfWidths(0.95)
+		narrowExp, narrowInc, narrowLevel := halfWidths(0.8)
+
+		if defLevel != 0.90 || wideLevel != 0.95 || narrowLevel != 0.80 {
+			t.Errorf("applied levels = %v/%v/%v, want 0.90/0.95/0.80", defLevel, wideLevel, narrowLevel)
+		}
+		if defExp <= 0 || defInc <= 0 {
+			t.Fatalf("expected non-zero default bands, got expense %f income %f", defExp, defInc)
+		}
+		// Both series must use the same multiplier, so their ratios match.
+		for _, c := range []struct {
+			name     string
+			exp, inc float64
+			wantZ    float64
+		}{
+			{"95%", wideExp, wideInc, 1.960},
+			{"80%", narrowExp, narrowInc, 1.282},
+		} {
+			wantRatio := c.wantZ / 1.645
+			if math.Abs(c.exp/defExp-wantRatio) > 1e-9 || math.Abs(c.inc/defInc-wantRatio) > 1e-9 {
+				t.Errorf("%s: expense ratio %f, income ratio %f, want %f", c.name, c.exp/defExp, c.inc/defInc, wantRatio)
+			}
+		}
+	})
+
+	t.Run("variable recurring amounts set the band", func(t *testing.T) {
+		ctx := testProContext(userID)
+		now := time.Now()
+
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return([]*pfinancev1.Expense{
+				{Id: "exp-1", UserId: userID, Amount: 80.00, Date: timestamppb.New(now.AddDate(0, 0, -3))},
+				{Id: "exp-2", UserId: userID, Amount: 20.00, Date: timestamppb.New(now.AddDate(0, 0, -12))},
+			}, "", nil)
+		mockStore.EXPECT().
+			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
+			Return(nil, "", nil)
+		mockStore.EXPECT().
+			ListRecurringTransactions(gomock.Any(), userID, "",
+				pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+				false, false, int32(10000), "").
+			Return([]*pfinancev1.RecurringTransaction{
+				{
+					Id: "power", UserId: userID, Description: "Electricity", IsExpense: true,
+					AmountCents: 18000, MinAmountCents: 12000, MaxAmountCents: 26000,
+					Frequency:      pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_QUARTERLY,
+					NextOccurrence: timestamppb.New(now.AddDate(0, 0, 2)),
+				},
+				{
+					Id: "rent", UserId: userID, Description: "Rent", IsExpense: true,
+					AmountCents:    150000,
+					Frequency:      pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY,
+					NextOccurrence: timestamppb.New(now.AddDate(0, 0, 4)),
+				},
+			}, "", nil)
+
+		resp, err := service.GetCashFlowForecast(ctx, connect.NewRequest(&pfinancev1.GetCashFlowForecastRequest{
+			UserId:       userID,
+			ForecastDays: 7,
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		power := resp.Msg.ExpenseForecast[1]
+		if !power.IsRecurring || power.PredictedCents != 18000 || power.LowerBoundCents != 12000 || power.UpperBoundCents != 26000 {
+			t.Errorf("variable bill day = %d (%d-%d), want 18000 (12000-26000)", power.PredictedCents, power.LowerBoundCents, power.UpperBoundCents)
+		}
+		// A fixed recurring amount keeps the historical band, as on a plain day
+		rent, plain := resp.Msg.ExpenseForecast[3], resp.Msg.ExpenseForecast[0]
+		if rent.PredictedCents != 150000 || math.Abs((rent.UpperBound-rent.Predicted)-(plain.UpperBound-plain.Predicted)) > 1e-9 {
+			t.Errorf("fixed recurring day = %v (%v-%v), want the historical band around 1500", rent.Predicted, rent.LowerBound, rent.UpperBound)
+		}
+	})
+
+	t.Run("history days sets the lookback", func(t *testing.T) {
+		ctx := testProContext(userID)
+		now := time.Now()
+
+		var historyStart time.Time
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			DoAndReturn(func(_ context.Context, _, _ string, filter store.ExpenseQuery, _ int32, _ string) ([]*pfinancev1.Expense, string, error) {
+				historyStart = *filter.StartDate
+				return []*pfinancev1.Expense{
+					{Id: "annual", UserId: userID, Amount: 365.00, Date: timestamppb.New(now.AddDate(0, 0, -200))},
+				}, "", nil
+			}).Times(3)
+		mockStore.EXPECT().
+			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
+			Return(nil, "", nil).Times(3)
+		mockStore.EXPECT().
+			ListRecurringTransactions(gomock.Any(), userID, "",
+				pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+				false, false, int32(10000), "").
+			Return(nil, "", nil).Times(3)
+
+		for _, c := range []struct {
+			requested, want int32
+		}{
+			{0, 90},
+			{365, 365},
+			{5000, 730},
+		} {
+			resp, err := service.GetCashFlowForecast(ctx, connect.NewRequest(&pfinancev1.GetCashFlowForecastRequest{
+				UserId:       userID,
+				ForecastDays: 7,
+				HistoryDays:  c.requested,
+			}))
+			if err != nil {
+				t.Fatalf("history_days %d: unexpected error: %v", c.requested, err)
+			}
+			if resp.Msg.HistoryDays != c.want {
+				t.Errorf("history_days %d: applied %d, want %d", c.requested, resp.Msg.HistoryDays, c.want)
+			}
+			wantStart := now.AddDate(0, 0, -int(c.want)).Format("2006-01-02")
+			if got := historyStart.Format("2006-01-02"); got != wantStart {
+				t.Errorf("history_days %d: queried from %s, want %s", c.requested, got, wantStart)
+			}
+			history := resp.Msg.ExpenseHistory
+			if len(history) != int(c.want)+1 || history[0].Date != wantStart {
+				t.Errorf("history_days %d: %d history points from %s, want %d from %s",
+					c.requested, len(history), history[0].Date, c.want+1, wantStart)
+			}
+			// The expense 200 days ago only counts once the window reaches it
+			predicted := resp.Msg.ExpenseForecast[0].Predicted
+			if inWindow := c.want >= 200; inWindow != (predicted > 0) {
+				t.Errorf("history_days %d: predicted daily expense %f", c.requested, predicted)
+			}
+		}
+
+		_, err := service.GetCashFlowForecast(ctx, connect.NewRequest(&pfinancev1.GetCashFlowForecastRequest{
+			UserId:      userID,
+			HistoryDays: -1,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("negative history_days: code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
 	})
 
 	t.Run("requires pro tier", func(t *testing.T) {
@@ -714,6 +1544,57 @@ func TestAnalyticsGetCashFlowForecast(t *testing.T) {
 	})
 }
 
+func TestProjectBalance(t *testing.T) {
+	net := []*pfinancev1.ForecastPoint{
+		{Date: "2025-01-01", PredictedCents: -3000},
+		{Date: "2025-01-02", PredictedCents: 1000},
+		{Date: "2025-01-03", PredictedCents: -2000},
+		{Date: "2025-01-04", PredictedCents: 5000},
+	}
+
+	series, minCents, minDate := projectBalance(4000, net)
+	want := []int64{1000, 2000, 0, 5000}
+	for i, point := range series {
+		if point.ValueCents != want[i] || point.Value != float64(want[i])/100 {
+			t.Errorf("day %d balance = %d (%v), want %d", i, point.ValueCents, point.Value, want[i])
+		}
+	}
+	if minCents != 0 || minDate != "2025-01-03" {
+		t.Errorf("min = %d on %s, want 0 on 2025-01-03", minCents, minDate)
+	}
+
+	// A tie keeps the first day at the minimum
+	_, minCents, minDate = projectBalance(0, []*pfinancev1.ForecastPoint{
+		{Date: "2025-01-01", PredictedCents: -500},
+		{Date: "2025-01-02", PredictedCents: 0},
+	})
+	if minCents != -500 || minDate != "2025-01-01" {
+		t.Errorf("min = %d on %s, want -500 on 2025-01-01", minCents, minDate)
+	}
+}
+
+func TestForecastConfidence(t *testing.T) {
+	tests := []struct {
+		requested float64
+		wantLevel float64
+		wantZ     float64
+	}{
+		{0, 0.90, 1.645},
+		{-1, 0.90, 1.645},
+		{0.80, 0.80, 1.282},
+		{0.95, 0.95, 1.960},
+		{0.5, 0.80, 1.282},
+		{0.93, 0.95, 1.960},
+		{1.5, 0.99, 2.576},
+	}
+	for _, tt := range tests {
+		level, z := forecastConfidence(tt.requested)
+		if level != tt.wantLevel || z != tt.wantZ {
+			t.Errorf("forecastConfidence(%v) = %v, %v; want %v, %v", tt.requested, level, z, tt.wantLevel, tt.wantZ)
+		}
+	}
+}
+
 // --------------------------------------------------------------------------
 // TestAnalyticsGetWaterfallData
 // --------------------------------------------------------------------------
@@ -768,12 +1649,12 @@ func TestAnalyticsGetWaterfallData(t *testing.T) {
 
 		// ListIncomes for the current period
 		mockStore.EXPECT().
-			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), int32(10000), "").
+			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
 			Return(incomes, "", nil)
 
 		// ListExpenses for the current period
 		mockStore.EXPECT().
-			ListExpenses(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), int32(10000), "").
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
 			Return(expenses, "", nil)
 
 		// GetTaxConfig for tax rate (returns error → falls back to 25%)
@@ -848,6 +1729,70 @@ func TestAnalyticsGetWaterfallData(t *testing.T) {
 		}
 	})
 
+	t.Run("group by member labels entries with member names", func(t *testing.T) {
+		ctx := testProContext(userID)
+		groupID := "group-1"
+
+		mockStore.EXPECT().GetGroup(gomock.Any(), groupID).Return(&pfinancev1.FinanceGroup{
+			Id:        groupID,
+			MemberIds: []string{userID, "user-456"},
+			Members: []*pfinancev1.GroupMember{
+				{UserId: userID, DisplayName: "Alice"},
+				{UserId: "user-456", DisplayName: "Bob"},
+			},
+		}, nil)
+		mockStore.EXPECT().
+			ListIncomes(gomock.Any(), "", groupID, gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
+			Return([]*pfinancev1.Income{{Id: "inc-1", UserId: userID, Amount: 4000.00}}, "", nil)
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), "", groupID, gomock.Any(), int32(10000), "").
+			Return([]*pfinancev1.Expense{
+				{Id: "exp-1", UserId: userID, Amount: 300.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+				{Id: "exp-2", UserId: "user-456", Amount: 900.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_HOUSING},
+				{Id: "exp-3", UserId: userID, Amount: 200.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_HOUSING},
+			}, "", nil)
+		mockStore.EXPECT().
+			GetTaxConfig(gomock.Any(), "", groupID).
+			Return(nil, fmt.Errorf("not found"))
+
+		resp, err := service.GetWaterfallData(ctx, connect.NewRequest(&pfinancev1.GetWaterfallDataRequest{
+			GroupId: groupID,
+			Period:  "month",
+			GroupBy: "member",
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// Gross Income, Tax, Bob, Alice, Net Savings
+		if len(resp.Msg.Entries) != 5 {
+			t.Fatalf("expected 5 entries, got %d", len(resp.Msg.Entries))
+		}
+		bob, alice := resp.Msg.Entries[2], resp.Msg.Entries[3]
+		if bob.Label != "Bob" || bob.Amount != 900.00 || bob.MemberUserId != "user-456" {
+			t.Errorf("unexpected first member entry: %+v", bob)
+		}
+		if alice.Label != "Alice" || alice.Amount != 500.00 || alice.MemberUserId != userID {
+			t.Errorf("unexpected second member entry: %+v", alice)
+		}
+		savings := resp.Msg.Entries[4]
+		if savings.EntryType != pfinancev1.WaterfallEntryType_WATERFALL_ENTRY_TYPE_SAVINGS || savings.Amount != 4000.00-1400.00-1000.00 {
+			t.Errorf("unexpected savings entry: %+v", savings)
+		}
+	})
+
+	t.Run("rejects an unknown group_by", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		_, err := service.GetWaterfallData(ctx, connect.NewRequest(&pfinancev1.GetWaterfallDataRequest{
+			UserId:  userID,
+			GroupBy: "merchant",
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("expected CodeInvalidArgument, got %v", connect.CodeOf(err))
+		}
+	})
+
 	t.Run("requires pro tier", func(t *testing.T) {
 		ctx := testContextWithUser(userID)
 
@@ -877,4 +1822,497 @@ func TestAnalyticsGetWaterfallData(t *testing.T) {
 			t.Errorf("expected CodeUnauthenticated, got %v", connect.CodeOf(err))
 		}
 	})
+
+	t.Run("projects remaining spend before net savings when requested", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		mockStore.EXPECT().
+			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
+			Return([]*pfinancev1.Income{{Id: "inc-1", UserId: userID, Amount: 5000.00, Date: timestamppb.Now()}}, "", nil)
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return([]*pfinancev1.Expense{{Id: "exp-1", UserId: userID, Amount: 600.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD, Date: timestamppb.Now()}}, "", nil)
+		mockStore.EXPECT().
+			GetTaxConfig(gomock.Any(), userID, "").
+			Return(nil, fmt.Errorf("not found"))
+
+		resp, err := service.GetWaterfallData(ctx, connect.NewRequest(&pfinancev1.GetWaterfallDataRequest{
+			UserId:               userID,
+			Period:               "year",
+			ProjectToEndOfPeriod: true,
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		entries := resp.Msg.Entries
+		netSavings := entries[len(entries)-1]
+		var projected float64
+		for i, e := range entries {
+			if !e.IsProjected {
+				continue
+			}
+			if i != len(entries)-2 {
+				t.Errorf("projected entry at index %d, want just before Net Savings", i)
+			}
+			projected = e.Amount
+		}
+		// The test can run on any day of the year, so only the last day has nothing left to project
+		now := time.Now()
+		if projected == 0 && !(now.Month() == time.December && now.Day() == 31) {
+			t.Errorf("expected a projected remaining spend entry mid-year")
+		}
+		expectedSavings := 5000.00 - 600.00 - 1250.00 - projected
+		if netSavings.Amount < expectedSavings-0.01 || netSavings.Amount > expectedSavings+0.01 {
+			t.Errorf("expected net savings ~%.2f, got %f", expectedSavings, netSavings.Amount)
+		}
+	})
+
+	t.Run("reports savings rate against the previous period", func(t *testing.T) {
+		ctx := testProContext(userID)
+		now := time.Now()
+		monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+		gomock.InOrder(
+			mockStore.EXPECT().
+				ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
+				Return([]*pfinancev1.Income{{Id: "inc-1", UserId: userID, Amount: 4000.00}}, "", nil),
+			mockStore.EXPECT().
+				ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
+				DoAndReturn(func(_ context.Context, _, _ string, start, end *time.Time, _ string, _ pfinancev1.SortField, _ pfinancev1.SortDirection, _ int32, _ string) ([]*pfinancev1.Income, string, error) {
+					if want := monthStart.AddDate(0, -1, 0); !start.Equal(want) || !end.Before(monthStart) {
+						t.Errorf("previous period = %v to %v, want the month starting %v", start, end, want)
+					}
+					return []*pfinancev1.Income{{Id: "inc-0", UserId: userID, Amount: 2000.00}}, "", nil
+				}),
+		)
+		gomock.InOrder(
+			mockStore.EXPECT().
+				ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+				Return([]*pfinancev1.Expense{{Id: "exp-1", UserId: userID, Amount: 1000.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD}}, "", nil),
+			mockStore.EXPECT().
+				ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+				Return([]*pfinancev1.Expense{{Id: "exp-0", UserId: userID, Amount: 1600.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD}}, "", nil),
+		)
+		mockStore.EXPECT().
+			GetTaxConfig(gomock.Any(), userID, "").
+			Return(&pfinancev1.TaxConfig{TaxRate: 20}, nil)
+
+		resp, err := service.GetWaterfallData(ctx, connect.NewRequest(&pfinancev1.GetWaterfallDataRequest{
+			UserId:                userID,
+			Period:                "month",
+			IncludePreviousPeriod: true,
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// (4000 - 800 tax - 1000) / 4000
+		if got := resp.Msg.SavingsRate; math.Abs(got-0.55) > 1e-9 {
+			t.Errorf("savings_rate = %v, want 0.55", got)
+		}
+		// (2000 - 400 tax - 1600) / 2000
+		if resp.Msg.PreviousSavingsRate == nil || math.Abs(resp.Msg.GetPreviousSavingsRate()) > 1e-9 {
+			t.Errorf("previous_savings_rate = %v, want 0", resp.Msg.PreviousSavingsRate)
+		}
+	})
+
+	t.Run("savings rate is zero without income", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		mockStore.EXPECT().
+			ListIncomes(gomock.Any(), userID, "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10000), "").
+			Return(nil, "", nil)
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return([]*pfinancev1.Expense{{Id: "exp-1", UserId: userID, Amount: 50.00, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD}}, "", nil)
+		mockStore.EXPECT().
+			GetTaxConfig(gomock.Any(), userID, "").
+			Return(nil, fmt.Errorf("not found"))
+
+		resp, err := service.GetWaterfallData(ctx, connect.NewRequest(&pfinancev1.GetWaterfallDataRequest{UserId: userID}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Msg.SavingsRate != 0 {
+			t.Errorf("savings_rate = %v, want 0 without income", resp.Msg.SavingsRate)
+		}
+		if resp.Msg.PreviousSavingsRate != nil {
+			t.Errorf("previous_savings_rate = %v, want unset when not requested", resp.Msg.GetPreviousSavingsRate())
+		}
+	})
+}
+
+func TestProjectRemainingSpend(t *testing.T) {
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 30, 23, 59, 59, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		spent float64
+		now   time.Time
+		want  float64
+	}{
+		{"first day", 100, time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC), 2900},
+		{"mid period", 300, time.Date(2025, 6, 10, 8, 0, 0, 0, time.UTC), 600},
+		{"last day", 300, time.Date(2025, 6, 30, 9, 0, 0, 0, time.UTC), 0},
+		{"period ended", 300, time.Date(2025, 7, 2, 0, 0, 0, 0, time.UTC), 0},
+		{"nothing spent", 0, time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := projectRemainingSpend(tt.spent, start, end, tt.now)
+			if math.Abs(got-tt.want) > 0.001 {
+				t.Errorf("projectRemainingSpend() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------
+// TestAnalyticsRecommendBudgets
+// --------------------------------------------------------------------------
+
+func TestAnalyticsRecommendBudgets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := store.NewMockStore(ctrl)
+	service := NewFinanceService(mockStore, nil, nil)
+	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+
+	userID := "user-123"
+	base := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	expense := func(cat pfinancev1.ExpenseCategory, cents int64, monthOffset int) *pfinancev1.Expense {
+		return &pfinancev1.Expense{
+			UserId:      userID,
+			AmountCents: cents,
+			Category:    cat,
+			Date:        timestamppb.New(base.AddDate(0, monthOffset, 0)),
+		}
+	}
+
+	var expenses []*pfinancev1.Expense
+	for m := 0; m < 6; m++ {
+		// Two steady grocery shops per month
+		expenses = append(expenses,
+			expense(pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD, 10000, m),
+			expense(pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD, 10000, m))
+	}
+	// A one-off catering bill that should not inflate the food budget
+	expenses = append(expenses, expense(pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD, 200000, 2))
+	for m := 0; m < 4; m++ {
+		expenses = append(expenses, expense(pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_TRANSPORTATION, 4700, m))
+	}
+	// Only one month of shopping: not enough history
+	expenses = append(expenses, expense(pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_SHOPPING, 30000, 5))
+
+	t.Run("trims outliers and skips thin history", func(t *testing.T) {
+		recs := buildBudgetRecommendations(expenses, 6)
+		if len(recs) != 2 {
+			t.Fatalf("expected 2 recommendations, got %d: %v", len(recs), recs)
+		}
+
+		food := recs[0]
+		if food.Category != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD {
+			t.Fatalf("expected food first, got %v", food.Category)
+		}
+		if food.ExcludedOutliers != 1 {
+			t.Errorf("expected 1 excluded outlier, got %d", food.ExcludedOutliers)
+		}
+		if food.AverageMonthlyAmountCents != 20000 || food.SuggestedAmountCents != 20000 {
+			t.Errorf("food average/suggested = %d/%d cents, want 20000/20000",
+				food.AverageMonthlyAmountCents, food.SuggestedAmountCents)
+		}
+		if food.MonthsWithSpending != 6 {
+			t.Errorf("expected 6 months with spending, got %d", food.MonthsWithSpending)
+		}
+		wantRationale := "Averaged $200.00/month over the last 6 months (spending in 6), excluding 1 one-off spike; rounded up to $200."
+		if food.Rationale != wantRationale {
+			t.Errorf("rationale = %q, want %q", food.Rationale, wantRationale)
+		}
+
+		transport := recs[1]
+		// 4 x $47 spread over 6 months = $31.33, rounded up to $40
+		if transport.AverageMonthlyAmountCents != 3133 || transport.SuggestedAmountCents != 4000 {
+			t.Errorf("transport average/suggested = %d/%d cents, want 3133/4000",
+				transport.AverageMonthlyAmountCents, transport.SuggestedAmountCents)
+		}
+		if transport.ExcludedOutliers != 0 {
+			t.Errorf("expected no excluded outliers for transport, got %d", transport.ExcludedOutliers)
+		}
+	})
+
+	t.Run("defaults lookback months", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return(expenses, "", nil)
+
+		resp, err := service.RecommendBudgets(ctx, connect.NewRequest(&pfinancev1.RecommendBudgetsRequest{
+			UserId: userID,
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Msg.LookbackMonths != 6 {
+			t.Errorf("expected default lookback of 6 months, got %d", resp.Msg.LookbackMonths)
+		}
+		if len(resp.Msg.Recommendations) != 2 {
+			t.Errorf("expected 2 recommendations, got %d", len(resp.Msg.Recommendations))
+		}
+	})
+
+	t.Run("rejects negative lookback", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		_, err := service.RecommendBudgets(ctx, connect.NewRequest(&pfinancev1.RecommendBudgetsRequest{
+			UserId:         userID,
+			LookbackMonths: -1,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("expected CodeInvalidArgument, got %v", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("requires pro tier", func(t *testing.T) {
+		ctx := testContextWithUser(userID)
+
+		_, err := service.RecommendBudgets(ctx, connect.NewRequest(&pfinancev1.RecommendBudgetsRequest{
+			UserId: userID,
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("expected CodePermissionDenied, got %v", connect.CodeOf(err))
+		}
+	})
+}
+
+// --------------------------------------------------------------------------
+// TestAnalyticsGetSpendingByTag
+// --------------------------------------------------------------------------
+
+func TestAnalyticsGetSpendingByTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := store.NewMockStore(ctrl)
+	service := NewFinanceService(mockStore, nil, nil)
+	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+
+	userID := "user-123"
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	expenses := []*pfinancev1.Expense{
+		{Id: "e1", UserId: userID, AmountCents: 12000, Tags: []string{"vacation-2025", "reimbursable"}},
+		{Id: "e2", UserId: userID, AmountCents: 3000, Tags: []string{"reimbursable"}},
+		{Id: "e3", UserId: userID, Amount: 45.50, Tags: []string{"Vacation-2025"}}, // legacy casing, dollars only
+		{Id: "e4", UserId: userID, AmountCents: 999},
+	}
+
+	t.Run("sums per tag and untagged", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return(expenses, "", nil)
+
+		resp, err := service.GetSpendingByTag(ctx, connect.NewRequest(&pfinancev1.GetSpendingByTagRequest{
+			UserId:    userID,
+			StartDate: timestamppb.New(startDate),
+			EndDate:   timestamppb.New(endDate),
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		tags := resp.Msg.Tags
+		if len(tags) != 2 {
+			t.Fatalf("expected 2 tags, got %d", len(tags))
+		}
+		if tags[0].Tag != "vacation-2025" || tags[0].AmountCents != 16550 || tags[0].ExpenseCount != 2 {
+			t.Errorf("tags[0] = %s %d cents x%d, want vacation-2025 16550 cents x2",
+				tags[0].Tag, tags[0].AmountCents, tags[0].ExpenseCount)
+		}
+		if tags[1].Tag != "reimbursable" || tags[1].AmountCents != 15000 || tags[1].ExpenseCount != 2 {
+			t.Errorf("tags[1] = %s %d cents x%d, want reimbursable 15000 cents x2",
+				tags[1].Tag, tags[1].AmountCents, tags[1].ExpenseCount)
+		}
+		if resp.Msg.UntaggedAmountCents != 999 || resp.Msg.UntaggedCount != 1 {
+			t.Errorf("untagged = %d cents x%d, want 999 cents x1",
+				resp.Msg.UntaggedAmountCents, resp.Msg.UntaggedCount)
+		}
+	})
+
+	t.Run("restricts breakdown to requested tags", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		mockStore.EXPECT().
+			ListExpenses(gomock.Any(), userID, "", gomock.Any(), int32(10000), "").
+			Return(expenses, "", nil)
+
+		resp, err := service.GetSpendingByTag(ctx, connect.NewRequest(&pfinancev1.GetSpendingByTagRequest{
+			UserId:    userID,
+			StartDate: timestamppb.New(startDate),
+			EndDate:   timestamppb.New(endDate),
+			Tags:      []string{" Reimbursable "},
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Msg.Tags) != 1 || resp.Msg.Tags[0].Tag != "reimbursable" {
+			t.Errorf("expected only reimbursable, got %v", resp.Msg.Tags)
+		}
+	})
+
+	t.Run("requires date range", func(t *testing.T) {
+		ctx := testProContext(userID)
+
+		_, err := service.GetSpendingByTag(ctx, connect.NewRequest(&pfinancev1.GetSpendingByTagRequest{
+			UserId: userID,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("expected CodeInvalidArgument, got %v", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("requires pro tier", func(t *testing.T) {
+		ctx := testContextWithUser(userID)
+
+		_, err := service.GetSpendingByTag(ctx, connect.NewRequest(&pfinancev1.GetSpendingByTagRequest{
+			UserId:    userID,
+			StartDate: timestamppb.New(startDate),
+			EndDate:   timestamppb.New(endDate),
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("expected CodePermissionDenied, got %v", connect.CodeOf(err))
+		}
+	})
+}
+
+func TestToCents(t *testing.T) {
+	tests := []struct {
+		dollars float64
+		want    int64
+	}{
+		{0, 0},
+		{0.005, 1},
+		{0.004, 0},
+		{1.005, 101},
+		{10.999, 1100},
+		{19.99, 1999},
+		{-0.005, -1},
+		{-10.999, -1100},
+		{-19.99, -1999},
+		{1234567.891, 123456789},
+	}
+	for _, tt := range tests {
+		if got := store.ToCents(tt.dollars); got != tt.want {
+			t.Errorf("store.ToCents(%v) = %d, want %d", tt.dollars, got, tt.want)
+		}
+	}
+}
+
+func TestSmoothSeries(t *testing.T) {
+	series := []*pfinancev1.TimeSeriesDataPoint{
+		{Value: 100}, {Value: 200}, {Value: 300}, {Value: 600},
+	}
+
+	got := smoothSeries(series, 3)
+	want := []float64{150, 200, 366.6666666666667, 450}
+	for i, pt := range got {
+		if math.Abs(pt.Value-want[i]) > 1e-9 {
+			t.Errorf("point %d = %v, want %v", i, pt.Value, want[i])
+		}
+	}
+	if got[2].ValueCents != 36667 {
+		t.Errorf("point 2 cents = %d, want 36667", got[2].ValueCents)
+	}
+	if series[0].Value != 100 {
+		t.Error("smoothSeries must not modify its input")
+	}
+}
+
+func TestAnalyticsNilDatedExpenses(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	userID := "user-123"
+	ctx := testProContext(userID)
+
+	now := time.Now()
+	// A budget without a start date used to cover the Unix epoch, which is
+	// where an undated expense would have landed.
+	if err := memStore.CreateBudget(t.Context(), &pfinancev1.Budget{
+		Id: "budget-1", UserId: userID, Amount: 1000, IsActive: true, EndDate: timestamppb.New(now.AddDate(1, 0, 0)),
+	}); err != nil {
+		t.Fatalf("CreateBudget: %v", err)
+	}
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "exp-dated", UserId: userID, Amount: 40, AmountCents: 4000, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD, Date: timestamppb.New(now)},
+		{Id: "exp-undated", UserId: userID, Amount: 999, AmountCents: 99900, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+
+	t.Run("budget progress", func(t *testing.T) {
+		resp, err := service.GetBudgetProgress(ctx, connect.NewRequest(&pfinancev1.GetBudgetProgressRequest{BudgetId: "budget-1"}))
+		if err != nil {
+			t.Fatalf("GetBudgetProgress: %v", err)
+		}
+		if got := resp.Msg.Progress.SpentAmount; got != 40 {
+			t.Errorf("spent = %v, want 40 (undated expense excluded)", got)
+		}
+	})
+
+	t.Run("daily aggregates", func(t *testing.T) {
+		resp, err := service.GetDailyAggregates(ctx, connect.NewRequest(&pfinancev1.GetDailyAggregatesRequest{
+			UserId:    userID,
+			StartDate: timestamppb.New(now.AddDate(0, 0, -30)),
+			EndDate:   timestamppb.New(now.Add(time.Hour)),
+		}))
+		if err != nil {
+			t.Fatalf("GetDailyAggregates: %v", err)
+		}
+		if len(resp.Msg.Aggregates) != 1 || resp.Msg.Aggregates[0].TotalAmountCents != 4000 {
+			t.Errorf("aggregates = %v, want a single day of 4000 cents", resp.Msg.Aggregates)
+		}
+	})
+
+	t.Run("waterfall", func(t *testing.T) {
+		resp, err := service.GetWaterfallData(ctx, connect.NewRequest(&pfinancev1.GetWaterfallDataRequest{UserId: userID}))
+		if err != nil {
+			t.Fatalf("GetWaterfallData: %v", err)
+		}
+		var expenses float64
+		for _, e := range resp.Msg.Entries {
+			if e.EntryType == pfinancev1.WaterfallEntryType_WATERFALL_ENTRY_TYPE_EXPENSE {
+				expenses += e.Amount
+			}
+		}
+		if expenses != 40 {
+			t.Errorf("expense entries total %v, want 40 (undated expense excluded)", expenses)
+		}
+	})
+}
+
+func TestExplainAmountOutlier(t *testing.T) {
+	food := pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD
+	tests := []struct {
+		name                   string
+		description            string
+		amount, typical, score float64
+		want                   string
+	}{
+		{"above typical", "dinner", 500, 40, 4.2, "This $500.00 dinner is 12.5× your typical $40.00 food spend."},
+		{"below typical", "", 1, 40, -3.1, "This $1.00 expense is 3.1 standard deviations from your typical $40.00 food spend."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := explainAmountOutlier(tt.description, food, tt.amount, tt.typical, tt.score); got != tt.want {
+				t.Errorf("explainAmountOutlier = %q, want %q", got, tt.want)
+			}
+		})
+	}
 }
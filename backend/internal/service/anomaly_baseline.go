@@ -0,0 +1,125 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxBaselineSamples bounds how many recent amounts a stored baseline keeps,
+	// so old spending patterns age out and the document stays small.
+	maxBaselineSamples = 200
+
+	// madToStdDev scales a median absolute deviation to the standard deviation
+	// it estimates for normally distributed data.
+	madToStdDev = 1.4826
+	// meanADToStdDev does the same for a mean absolute deviation, used when more
+	// than half the samples are identical and the MAD collapses to zero.
+	meanADToStdDev = 1.2533
+)
+
+// medianMAD returns the median of values and their median absolute deviation from it.
+func medianMAD(values []float64) (median, mad float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	median = medianOf(values)
+	deviations := make([]float64, len(values))
+	for i, v := range values {
+		deviations[i] = math.Abs(v - median)
+	}
+	return median, medianOf(deviations)
+}
+
+// medianOf returns the median of values without modifying the slice.
+func medianOf(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// baselineCenterSpread returns the expected amount and the standard-deviation
+// equivalent spread to score against. The spread is zero when the baseline is
+// missing, has too few samples, or has no variation, in which case callers
+// should fall back to in-window statistics.
+func baselineCenterSpread(b *pfinancev1.CategoryBaseline) (center, spread float64) {
+	if b == nil || b.SampleCount < minAnomalySamples {
+		return 0, 0
+	}
+	if b.MedianAbsoluteDeviation > 0 {
+		return b.Median, madToStdDev * b.MedianAbsoluteDeviation
+	}
+	var sum float64
+	for _, cents := range b.RecentAmountsCents {
+		sum += math.Abs(float64(cents)/100 - b.Median)
+	}
+	if len(b.RecentAmountsCents) == 0 || sum == 0 {
+		return 0, 0
+	}
+	return b.Median, meanADToStdDev * sum / float64(len(b.RecentAmountsCents))
+}
+
+// foldIntoBaseline adds expenses created after the baseline's watermark to its
+// sample window and recomputes the median and MAD. Outliers are folded in too:
+// the robust estimator keeps a one-off spike from moving the baseline, while a
+// sustained change in spending eventually shifts it. Reports whether the
+// baseline changed.
+func foldIntoBaseline(b *pfinancev1.CategoryBaseline, expenses []*pfinancev1.Expense) bool {
+	var watermark time.Time
+	if b.LastExpenseCreatedAt != nil {
+		watermark = b.LastExpenseCreatedAt.AsTime()
+	}
+
+	type sample struct {
+		cents     int64
+		createdAt time.Time
+	}
+	var fresh []sample
+	for _, e := range expenses {
+		createdAt := expenseCreatedAt(e)
+		if b.LastExpenseCreatedAt != nil && !createdAt.After(watermark) {
+			continue
+		}
+		fresh = append(fresh, sample{
+			cents:     int64(math.Round(effectiveDollars(e.AmountCents, e.Amount) * 100)),
+			createdAt: createdAt,
+		})
+	}
+	if len(fresh) == 0 {
+		return false
+	}
+	sort.Slice(fresh, func(i, j int) bool { return fresh[i].createdAt.Before(fresh[j].createdAt) })
+
+	for _, s := range fresh {
+		b.RecentAmountsCents = append(b.RecentAmountsCents, s.cents)
+	}
+	if extra := len(b.RecentAmountsCents) - maxBaselineSamples; extra > 0 {
+		b.RecentAmountsCents = b.RecentAmountsCents[extra:]
+	}
+
+	amounts := make([]float64, len(b.RecentAmountsCents))
+	for i, cents := range b.RecentAmountsCents {
+		amounts[i] = float64(cents) / 100
+	}
+	b.Median, b.MedianAbsoluteDeviation = medianMAD(amounts)
+	b.SampleCount = int32(len(amounts))
+	b.LastExpenseCreatedAt = timestamppb.New(fresh[len(fresh)-1].createdAt)
+	b.UpdatedAt = timestamppb.Now()
+	return true
+}
+
+// expenseCreatedAt returns when an expense was recorded, falling back to its date.
+func expenseCreatedAt(e *pfinancev1.Expense) time.Time {
+	if e.CreatedAt != nil {
+		return e.CreatedAt.AsTime()
+	}
+	return e.Date.AsTime()
+}
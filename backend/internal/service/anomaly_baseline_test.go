@@ -0,0 +1,170 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMedianMAD(t *testing.T) {
+	median, mad := medianMAD([]float64{18, 19, 20, 21, 22, 1000})
+	if median != 20.5 {
+		t.Errorf("median = %v, want 20.5", median)
+	}
+	if mad != 1.5 {
+		t.Errorf("mad = %v, want 1.5", mad)
+	}
+
+	if m, d := medianMAD(nil); m != 0 || d != 0 {
+		t.Errorf("medianMAD(nil) = %v/%v, want 0/0", m, d)
+	}
+}
+
+func TestFoldIntoBaseline(t *testing.T) {
+	base := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	expense := func(cents int64, minutes int) *pfinancev1.Expense {
+		return &pfinancev1.Expense{AmountCents: cents, CreatedAt: timestamppb.New(base.Add(time.Duration(minutes) * time.Minute))}
+	}
+
+	b := &pfinancev1.CategoryBaseline{}
+	if !foldIntoBaseline(b, []*pfinancev1.Expense{expense(2000, 2), expense(1000, 1), expense(3000, 3)}) {
+		t.Fatal("expected first fold to change the baseline")
+	}
+	if b.SampleCount != 3 || b.Median != 20 || b.MedianAbsoluteDeviation != 10 {
+		t.Errorf("baseline = %d samples, median %v, MAD %v; want 3, 20, 10", b.SampleCount, b.Median, b.MedianAbsoluteDeviation)
+	}
+	if got := b.RecentAmountsCents; len(got) != 3 || got[0] != 1000 || got[2] != 3000 {
+		t.Errorf("samples = %v, want oldest first", got)
+	}
+
+	// Re-folding the same window is a no-op thanks to the watermark
+	if foldIntoBaseline(b, []*pfinancev1.Expense{expense(2000, 2), expense(3000, 3)}) {
+		t.Error("expected already-folded expenses to be skipped")
+	}
+
+	// The sample window is bounded, dropping the oldest amounts first
+	var many []*pfinancev1.Expense
+	for i := 0; i < maxBaselineSamples; i++ {
+		many = append(many, expense(500, 10+i))
+	}
+	foldIntoBaseline(b, many)
+	if b.SampleCount != maxBaselineSamples || b.Median != 5 {
+		t.Errorf("after overflow: %d samples, median %v; want %d, 5", b.SampleCount, b.Median, maxBaselineSamples)
+	}
+}
+
+func TestBaselineCenterSpread(t *testing.T) {
+	if _, spread := baselineCenterSpread(nil); spread != 0 {
+		t.Error("expected nil baseline to be unusable")
+	}
+	if _, spread := baselineCenterSpread(&pfinancev1.CategoryBaseline{SampleCount: 3, Median: 10, MedianAbsoluteDeviation: 1}); spread != 0 {
+		t.Error("expected a thin baseline to be unusable")
+	}
+
+	center, spread := baselineCenterSpread(&pfinancev1.CategoryBaseline{SampleCount: 20, Median: 10, MedianAbsoluteDeviation: 2})
+	if center != 10 || spread != 2*madToStdDev {
+		t.Errorf("center/spread = %v/%v, want 10/%v", center, spread, 2*madToStdDev)
+	}
+
+	// Mostly identical samples collapse the MAD; the mean absolute deviation takes over
+	samples := make([]int64, 10)
+	for i := range samples {
+		samples[i] = 500
+	}
+	samples[9] = 1500
+	center, spread = baselineCenterSpread(&pfinancev1.CategoryBaseline{SampleCount: 10, Median: 5, RecentAmountsCents: samples})
+	if center != 5 || spread != meanADToStdDev {
+		t.Errorf("center/spread = %v/%v, want 5/%v", center, spread, meanADToStdDev)
+	}
+}
+
+func TestAnalyticsDetectAnomalies_StoredBaselines(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	userID := "user-123"
+	ctx := testProContext(userID)
+	now := time.Now()
+
+	addExpense := func(id string, cents int64, daysAgo int, createdAt time.Time) {
+		t.Helper()
+		if err := memStore.CreateExpense(t.Context(), &pfinancev1.Expense{
+			Id:          id,
+			UserId:      userID,
+			Description: "Groceries",
+			AmountCents: cents,
+			Category:    pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD,
+			Date:        timestamppb.New(now.AddDate(0, 0, -daysAgo)),
+			CreatedAt:   timestamppb.New(createdAt),
+		}); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+	detect := func(useBaselines bool) *pfinancev1.DetectAnomaliesResponse {
+		t.Helper()
+		resp, err := svc.DetectAnomalies(ctx, connect.NewRequest(&pfinancev1.DetectAnomaliesRequest{
+			UserId:             userID,
+			UseStoredBaselines: useBaselines,
+		}))
+		if err != nil {
+			t.Fatalf("DetectAnomalies: %v", err)
+		}
+		return resp.Msg
+	}
+	flagged := func(resp *pfinancev1.DetectAnomaliesResponse, expenseID string) bool {
+		for _, a := range resp.Anomalies {
+			if a.ExpenseId == expenseID && a.AnomalyType == pfinancev1.AnomalyType_ANOMALY_TYPE_AMOUNT_OUTLIER {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Twenty steady shops between $18 and $22 seed the baseline
+	for i := 0; i < 20; i++ {
+		addExpense(string(rune('a'+i)), int64(1800+100*(i%5)), 30-i, now.Add(-time.Duration(40-i)*time.Hour))
+	}
+	detect(true)
+
+	baselines, err := memStore.GetCategoryBaselines(t.Context(), userID)
+	if err != nil || len(baselines) != 1 {
+		t.Fatalf("expected one stored baseline, got %v (err %v)", baselines, err)
+	}
+	if baselines[0].Median != 20 || baselines[0].MedianAbsoluteDeviation != 1 || baselines[0].SampleCount != 20 {
+		t.Errorf("seeded baseline = median %v, MAD %v, %d samples; want 20, 1, 20",
+			baselines[0].Median, baselines[0].MedianAbsoluteDeviation, baselines[0].SampleCount)
+	}
+
+	// A huge one-off inflates the in-window stddev enough to hide a $60 shop
+	addExpense("spike", 100000, 2, now.Add(-2*time.Hour))
+	addExpense("moderate", 6000, 1, now.Add(-time.Hour))
+
+	if inWindow := detect(false); flagged(inWindow, "moderate") || !flagged(inWindow, "spike") {
+		t.Error("expected in-window scoring to flag only the spike")
+	}
+
+	withBaseline := detect(true)
+	if !flagged(withBaseline, "spike") || !flagged(withBaseline, "moderate") {
+		t.Error("expected stored baseline scoring to flag both the spike and the moderate outlier")
+	}
+	for _, a := range withBaseline.Anomalies {
+		if a.ExpenseId == "moderate" && a.ExpectedAmount != 20 {
+			t.Errorf("expected amount = %v, want baseline median 20", a.ExpectedAmount)
+		}
+	}
+
+	baselines, _ = memStore.GetCategoryBaselines(t.Context(), userID)
+	if baselines[0].SampleCount != 22 || baselines[0].Median != 20 {
+		t.Errorf("updated baseline = %d samples, median %v; want 22, 20", baselines[0].SampleCount, baselines[0].Median)
+	}
+
+	// Repeating the call does not double-count the window
+	detect(true)
+	baselines, _ = memStore.GetCategoryBaselines(t.Context(), userID)
+	if baselines[0].SampleCount != 22 {
+		t.Errorf("expected baseline to stay at 22 samples, got %d", baselines[0].SampleCount)
+	}
+}
@@ -30,6 +30,10 @@ func (s *FinanceService) CreateApiToken(ctx context.Context, req *connect.Reques
 	if req.Msg.Name == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("token name is required"))
 	}
+	if req.Msg.RateLimit < 0 || req.Msg.RateLimit > auth.MaxApiTokenRateLimit {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("rate_limit must be between 0 and %d requests per minute", auth.MaxApiTokenRateLimit))
+	}
 
 	// Enforce max tokens per user
 	count, err := s.store.CountActiveApiTokens(ctx, claims.UID)
@@ -54,6 +58,7 @@ func (s *FinanceService) CreateApiToken(ctx context.Context, req *connect.Reques
 		TokenHash:   hash,
 		CreatedAt:   timestamppb.New(time.Now()),
 		IsRevoked:   false,
+		RateLimit:   req.Msg.RateLimit,
 	}
 
 	if err := s.store.CreateApiToken(ctx, apiToken); err != nil {
@@ -125,6 +125,229 @@ func TestGetBudgetProgress(t *testing.T) {
 	assert.Equal(t, testProgress.PercentageUsed, resp.Msg.Progress.PercentageUsed)
 }
 
+func TestGetAllBudgetProgress(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+
+	userID := "user123"
+	ctx := testContextWithUser(userID)
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 30, 23, 59, 59, 0, time.UTC)
+
+	budgets := []*pfinancev1.Budget{
+		{Id: "budget-food", UserId: userID, Amount: 500, IsActive: true, StartDate: timestamppb.New(start), EndDate: timestamppb.New(end),
+			CategoryIds: []pfinancev1.ExpenseCategory{pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD}},
+		{Id: "budget-all", UserId: userID, Amount: 1000, IsActive: true, StartDate: timestamppb.New(start), EndDate: timestamppb.New(end)},
+		{Id: "budget-inactive", UserId: userID, Amount: 100, IsActive: false, StartDate: timestamppb.New(start), EndDate: timestamppb.New(end)},
+		{Id: "budget-other", UserId: "someone-else", Amount: 100, IsActive: true, StartDate: timestamppb.New(start), EndDate: timestamppb.New(end)},
+	}
+	for _, b := range budgets {
+		require.NoError(t, memStore.CreateBudget(t.Context(), b))
+	}
+	expenses := []*pfinancev1.Expense{
+		{Id: "e1", UserId: userID, Amount: 120, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD, Date: timestamppb.New(start.AddDate(0, 0, 3))},
+		{Id: "e2", UserId: userID, Amount: 80, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_TRANSPORTATION, Date: timestamppb.New(start.AddDate(0, 0, 10))},
+		{Id: "e3", UserId: userID, Amount: 40, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD, Date: timestamppb.New(start.AddDate(0, -1, 0))},
+		{Id: "e4", UserId: "someone-else", Amount: 99, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD, Date: timestamppb.New(start.AddDate(0, 0, 3))},
+	}
+	for _, e := range expenses {
+		require.NoError(t, memStore.CreateExpense(t.Context(), e))
+	}
+
+	resp, err := service.GetAllBudgetProgress(ctx, connect.NewRequest(&pfinancev1.GetAllBudgetProgressRequest{}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Progress, 2)
+	assert.Equal(t, "budget-all", resp.Msg.Progress[0].BudgetId)
+	assert.Equal(t, "budget-food", resp.Msg.Progress[1].BudgetId)
+	assert.Equal(t, 200.0, resp.Msg.Progress[0].SpentAmount)
+	assert.Equal(t, 120.0, resp.Msg.Progress[1].SpentAmount)
+
+	// Bulk results must match the per-budget endpoint.
+	for _, p := range resp.Msg.Progress {
+		single, err := service.GetBudgetProgress(ctx, connect.NewRequest(&pfinancev1.GetBudgetProgressRequest{BudgetId: p.BudgetId}))
+		require.NoError(t, err)
+		assert.Equal(t, single.Msg.Progress.SpentAmount, p.SpentAmount, p.BudgetId)
+		assert.Equal(t, single.Msg.Progress.RemainingAmount, p.RemainingAmount, p.BudgetId)
+		assert.Equal(t, single.Msg.Progress.PercentageUsed, p.PercentageUsed, p.BudgetId)
+	}
+
+	_, err = service.GetAllBudgetProgress(ctx, connect.NewRequest(&pfinancev1.GetAllBudgetProgressRequest{UserId: "someone-else"}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+}
+
+func TestGroupBudgetProgress(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 30, 23, 59, 59, 0, time.UTC)
+	food := pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD
+
+	require.NoError(t, memStore.CreateGroup(t.Context(), &pfinancev1.FinanceGroup{
+		Id: "house", OwnerId: "alice", MemberIds: []string{"alice", "bob"},
+	}))
+
+	created, err := service.CreateBudget(testContextWithUser("alice"), connect.NewRequest(&pfinancev1.CreateBudgetRequest{
+		GroupId:     "house",
+		Name:        "Groceries",
+		Amount:      600,
+		Period:      pfinancev1.BudgetPeriod_BUDGET_PERIOD_MONTHLY,
+		CategoryIds: []pfinancev1.ExpenseCategory{food},
+		StartDate:   timestamppb.New(start),
+		EndDate:     timestamppb.New(end),
+	}))
+	require.NoError(t, err)
+	groupBudget := created.Msg.Budget
+	assert.Equal(t, "alice", groupBudget.UserId)
+
+	personal := &pfinancev1.Budget{Id: "alice-food", UserId: "alice", Amount: 300, IsActive: true,
+		CategoryIds: []pfinancev1.ExpenseCategory{food}, StartDate: timestamppb.New(start), EndDate: timestamppb.New(end)}
+	require.NoError(t, memStore.CreateBudget(t.Context(), personal))
+
+	day := timestamppb.New(start.AddDate(0, 0, 5))
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "alice-shop", UserId: "alice", GroupId: "house", Amount: 120, Category: food, Date: day},
+		{Id: "bob-shop", UserId: "bob", GroupId: "house", Amount: 80, Category: food, Date: day},
+		{Id: "bob-fuel", UserId: "bob", GroupId: "house", Amount: 60, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_TRANSPORTATION, Date: day},
+		{Id: "alice-lunch", UserId: "alice", Amount: 25, Category: food, Date: day},
+	} {
+		require.NoError(t, memStore.CreateExpense(t.Context(), e))
+	}
+
+	// Both members' group food spending counts, whoever asks
+	for _, member := range []string{"alice", "bob"} {
+		resp, err := service.GetBudgetProgress(testContextWithUser(member), connect.NewRequest(&pfinancev1.GetBudgetProgressRequest{
+			BudgetId: groupBudget.Id,
+		}))
+		require.NoError(t, err, member)
+		assert.Equal(t, 200.0, resp.Msg.Progress.SpentAmount, member)
+	}
+
+	all, err := service.GetAllBudgetProgress(testContextWithUser("bob"), connect.NewRequest(&pfinancev1.GetAllBudgetProgressRequest{
+		UserId:  "bob",
+		GroupId: "house",
+	}))
+	require.NoError(t, err)
+	require.Len(t, all.Msg.Progress, 1)
+	assert.Equal(t, groupBudget.Id, all.Msg.Progress[0].BudgetId)
+	assert.Equal(t, 200.0, all.Msg.Progress[0].SpentAmount)
+
+	// The personal budget only counts Alice's personal spending
+	own, err := service.GetBudgetProgress(testContextWithUser("alice"), connect.NewRequest(&pfinancev1.GetBudgetProgressRequest{
+		BudgetId: personal.Id,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, 25.0, own.Msg.Progress.SpentAmount)
+
+	t.Run("non-members are rejected", func(t *testing.T) {
+		outsider := testContextWithUser("mallory")
+		_, err := service.GetBudgetProgress(outsider, connect.NewRequest(&pfinancev1.GetBudgetProgressRequest{BudgetId: groupBudget.Id}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		_, err = service.CreateBudget(outsider, connect.NewRequest(&pfinancev1.CreateBudgetRequest{GroupId: "house", Name: "Sneaky", Amount: 10}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		_, err = service.CreateBudget(testContextWithUser("bob"), connect.NewRequest(&pfinancev1.CreateBudgetRequest{
+			UserId: "alice", GroupId: "house", Name: "On behalf", Amount: 10,
+		}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+}
+
+func TestBudgetCategoryCaps(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+
+	userID := "user123"
+	ctx := testContextWithUser(userID)
+	groceries := pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD
+	dining := pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	created, err := service.CreateBudget(ctx, connect.NewRequest(&pfinancev1.CreateBudgetRequest{
+		UserId:      userID,
+		Name:        "Food",
+		AmountCents: 70000,
+		Period:      pfinancev1.BudgetPeriod_BUDGET_PERIOD_MONTHLY,
+		CategoryIds: []pfinancev1.ExpenseCategory{groceries, dining},
+		StartDate:   timestamppb.New(start),
+		EndDate:     timestamppb.New(start.AddDate(0, 1, 0)),
+		CategoryCaps: []*pfinancev1.CategoryCap{
+			{Category: groceries, CapCents: 50000},
+			{Category: dining, CapCents: 20000},
+		},
+	}))
+	require.NoError(t, err)
+	budgetID := created.Msg.Budget.Id
+
+	for _, e := range []*pfinancev1.Expense{
+		{UserId: userID, AmountCents: 30000, Amount: 300, Category: groceries, Date: timestamppb.New(start.AddDate(0, 0, 2))},
+		{UserId: userID, AmountCents: 25000, Amount: 250, Category: dining, Date: timestamppb.New(start.AddDate(0, 0, 5))},
+	} {
+		require.NoError(t, memStore.CreateExpense(t.Context(), e))
+	}
+
+	resp, err := service.GetBudgetProgress(ctx, connect.NewRequest(&pfinancev1.GetBudgetProgressRequest{BudgetId: budgetID}))
+	require.NoError(t, err)
+	assert.Equal(t, 550.0, resp.Msg.Progress.SpentAmount)
+	require.Len(t, resp.Msg.Progress.CategoryCaps, 2)
+	assert.Equal(t, int64(30000), resp.Msg.Progress.CategoryCaps[0].SpentCents)
+	assert.Equal(t, int64(20000), resp.Msg.Progress.CategoryCaps[0].RemainingCents)
+	assert.Equal(t, int64(25000), resp.Msg.Progress.CategoryCaps[1].SpentCents)
+	assert.Equal(t, int64(-5000), resp.Msg.Progress.CategoryCaps[1].RemainingCents)
+	assert.Equal(t, 125.0, resp.Msg.Progress.CategoryCaps[1].PercentageUsed)
+
+	invalid := map[string][]*pfinancev1.CategoryCap{
+		"caps exceed the total":   {{Category: groceries, CapCents: 50000}, {Category: dining, CapCents: 30000}},
+		"duplicate category":      {{Category: groceries, CapCents: 100}, {Category: groceries, CapCents: 100}},
+		"category not in budget":  {{Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_HOUSING, CapCents: 100}},
+		"non-positive cap amount": {{Category: groceries, CapCents: 0}},
+	}
+	for name, caps := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := service.UpdateBudget(ctx, connect.NewRequest(&pfinancev1.UpdateBudgetRequest{
+				BudgetId:     budgetID,
+				Name:         "Food",
+				AmountCents:  70000,
+				CategoryIds:  []pfinancev1.ExpenseCategory{groceries, dining},
+				IsActive:     true,
+				CategoryCaps: caps,
+			}))
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		})
+	}
+
+	t.Run("an update without caps keeps them", func(t *testing.T) {
+		_, err := service.UpdateBudget(ctx, connect.NewRequest(&pfinancev1.UpdateBudgetRequest{
+			BudgetId:    budgetID,
+			Name:        "Food and dining",
+			AmountCents: 70000,
+			CategoryIds: []pfinancev1.ExpenseCategory{groceries, dining},
+			IsActive:    true,
+		}))
+		require.NoError(t, err)
+		resp, err := service.GetBudgetProgress(ctx, connect.NewRequest(&pfinancev1.GetBudgetProgressRequest{BudgetId: budgetID}))
+		require.NoError(t, err)
+		assert.Len(t, resp.Msg.Progress.CategoryCaps, 2)
+	})
+
+	t.Run("removing caps keeps the overall total", func(t *testing.T) {
+		_, err := service.UpdateBudget(ctx, connect.NewRequest(&pfinancev1.UpdateBudgetRequest{
+			BudgetId:          budgetID,
+			Name:              "Food",
+			AmountCents:       70000,
+			CategoryIds:       []pfinancev1.ExpenseCategory{groceries, dining},
+			IsActive:          true,
+			ClearCategoryCaps: true,
+		}))
+		require.NoError(t, err)
+		resp, err := service.GetBudgetProgress(ctx, connect.NewRequest(&pfinancev1.GetBudgetProgressRequest{BudgetId: budgetID}))
+		require.NoError(t, err)
+		assert.Empty(t, resp.Msg.Progress.CategoryCaps)
+		assert.Equal(t, 550.0, resp.Msg.Progress.SpentAmount)
+	})
+}
+
 func TestListBudgets(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/auth"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateBudgetTemplate saves a recurring budget template and creates the
+// budget for the current period from it.
+func (s *FinanceService) CreateBudgetTemplate(ctx context.Context, req *connect.Request[pfinancev1.CreateBudgetTemplateRequest]) (*connect.Response[pfinancev1.CreateBudgetTemplateResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBudgetScope(ctx, claims.UID, req.Msg.UserId, req.Msg.GroupId); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Msg.Name)
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+	if req.Msg.AmountCents <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("amount_cents must be positive"))
+	}
+	if req.Msg.Period == pfinancev1.BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("period is required"))
+	}
+
+	now := time.Now()
+	startDate := req.Msg.StartDate
+	if startDate == nil {
+		startDate = timestamppb.New(now)
+	}
+
+	template := &pfinancev1.RecurringBudget{
+		Id:          uuid.New().String(),
+		UserId:      req.Msg.UserId,
+		GroupId:     req.Msg.GroupId,
+		Name:        name,
+		Description: req.Msg.Description,
+		AmountCents: req.Msg.AmountCents,
+		Period:      req.Msg.Period,
+		CategoryIds: req.Msg.CategoryIds,
+		IsActive:    true,
+		StartDate:   startDate,
+		CreatedAt:   timestamppb.New(now),
+		UpdatedAt:   timestamppb.New(now),
+	}
+	if err := s.store.CreateBudgetTemplate(ctx, template); err != nil {
+		return nil, auth.WrapStoreError("create budget template", err)
+	}
+
+	budget, err := s.rollBudgetTemplate(ctx, template, now)
+	if err != nil {
+		return nil, auth.WrapStoreError("create budget from template", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.CreateBudgetTemplateResponse{
+		Template: template,
+		Budget:   budget,
+	}), nil
+}
+
+// ListBudgetTemplates returns the recurring budget templates of a user or group.
+func (s *FinanceService) ListBudgetTemplates(ctx context.Context, req *connect.Request[pfinancev1.ListBudgetTemplatesRequest]) (*connect.Response[pfinancev1.ListBudgetTemplatesResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBudgetScope(ctx, claims.UID, req.Msg.UserId, req.Msg.GroupId); err != nil {
+		return nil, err
+	}
+
+	templates, err := s.store.ListBudgetTemplates(ctx, req.Msg.UserId, req.Msg.GroupId)
+	if err != nil {
+		return nil, auth.WrapStoreError("list budget templates", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.ListBudgetTemplatesResponse{
+		Templates: templates,
+	}), nil
+}
+
+// UpdateBudgetTemplate changes the fields set on the request. Budgets already
+// rolled from the template keep their settings; changes apply from the next
+// period, and a deactivated template stops rolling.
+func (s *FinanceService) UpdateBudgetTemplate(ctx context.Context, req *connect.Request[pfinancev1.UpdateBudgetTemplateRequest]) (*connect.Response[pfinancev1.UpdateBudgetTemplateResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	template, err := s.getBudgetTemplate(ctx, claims.UID, req.Msg.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Name != nil {
+		name := strings.TrimSpace(req.Msg.GetName())
+		if name == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+		}
+		template.Name = name
+	}
+	if req.Msg.Description != nil {
+		template.Description = req.Msg.GetDescription()
+	}
+	if req.Msg.AmountCents != nil {
+		if req.Msg.GetAmountCents() <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("amount_cents must be positive"))
+		}
+		template.AmountCents = req.Msg.GetAmountCents()
+	}
+	if req.Msg.Period != nil {
+		if req.Msg.GetPeriod() == pfinancev1.BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("period is required"))
+		}
+		template.Period = req.Msg.GetPeriod()
+	}
+	if len(req.Msg.CategoryIds) > 0 {
+		template.CategoryIds = req.Msg.CategoryIds
+	}
+	if req.Msg.IsActive != nil {
+		template.IsActive = req.Msg.GetIsActive()
+	}
+	template.UpdatedAt = timestamppb.Now()
+
+	if err := s.store.UpdateBudgetTemplate(ctx, template); err != nil {
+		return nil, auth.WrapStoreError("update budget template", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.UpdateBudgetTemplateResponse{
+		Template: template,
+	}), nil
+}
+
+// DeleteBudgetTemplate deletes a template so no further budgets roll from it.
+// Budgets already created from it are kept.
+func (s *FinanceService) DeleteBudgetTemplate(ctx context.Context, req *connect.Request[pfinancev1.DeleteBudgetTemplateRequest]) (*connect.Response[emptypb.Empty], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.getBudgetTemplate(ctx, claims.UID, req.Msg.TemplateId); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.DeleteBudgetTemplate(ctx, req.Msg.TemplateId); err != nil {
+		return nil, auth.WrapStoreError("delete budget template", err)
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// getBudgetTemplate loads a template that uid may change.
+func (s *FinanceService) getBudgetTemplate(ctx context.Context, uid, templateID string) (*pfinancev1.RecurringBudget, error) {
+	template, err := s.store.GetBudgetTemplate(ctx, templateID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("budget template not found"))
+	}
+	if err := s.checkBudgetScope(ctx, uid, template.UserId, template.GroupId); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// checkBudgetScope checks that uid may act on the personal budgets of userID,
+// or on the group's budgets when groupID is set.
+func (s *FinanceService) checkBudgetScope(ctx context.Context, uid, userID, groupID string) error {
+	if groupID == "" {
+		if userID != uid {
+			return connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("cannot access another user's budgets"))
+		}
+		return nil
+	}
+	group, err := s.store.GetGroup(ctx, groupID)
+	if err != nil {
+		return auth.WrapStoreError("get group", err)
+	}
+	if !auth.IsGroupMember(uid, group) {
+		return connect.NewError(connect.CodePermissionDenied,
+			fmt.Errorf("user is not a member of this group"))
+	}
+	return nil
+}
+
+// RollActiveBudgets creates the budget for the period containing asOf from
+// every active template whose latest budget has ended, and deactivates the
+// ended budget. It returns the number of budgets created; a template that
+// fails to roll is logged and retried on the next run.
+func (s *FinanceService) RollActiveBudgets(ctx context.Context, asOf time.Time) (int, error) {
+	templates, err := s.store.ListBudgetTemplates(ctx, "", "")
+	if err != nil {
+		return 0, fmt.Errorf("failed to list budget templates: %w", err)
+	}
+
+	created := 0
+	for _, template := range templates {
+		if !template.IsActive {
+			continue
+		}
+		budget, err := s.rollBudgetTemplate(ctx, template, asOf)
+		if err != nil {
+			log.Printf("[BudgetRoller] error rolling template %s (user %s): %v", template.Id, template.UserId, err)
+			continue
+		}
+		if budget != nil {
+			created++
+		}
+	}
+	return created, nil
+}
+
+// rollBudgetTemplate creates the template's budget for the period containing
+// asOf unless its latest budget is still running. Returns the created budget,
+// or nil when there was nothing to roll.
+func (s *FinanceService) rollBudgetTemplate(ctx context.Context, template *pfinancev1.RecurringBudget, asOf time.Time) (*pfinancev1.Budget, error) {
+	latest, err := s.latestTemplateBudget(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+	if latest != nil && latest.EndDate != nil && !latest.EndDate.AsTime().Before(asOf) {
+		return nil, nil
+	}
+
+	start, end := store.BudgetPeriodBounds(&pfinancev1.Budget{
+		Period:    template.Period,
+		StartDate: template.StartDate,
+	}, asOf)
+	id := budgetTemplatePeriodID(template.Id, start)
+	if existing, err := s.store.GetBudget(ctx, id); err == nil && existing != nil {
+		return nil, nil
+	}
+
+	budget := &pfinancev1.Budget{
+		Id:          id,
+		UserId:      template.UserId,
+		GroupId:     template.GroupId,
+		Name:        template.Name,
+		Description: template.Description,
+		Amount:      float64(template.AmountCents) / 100.0,
+		AmountCents: template.AmountCents,
+		Period:      template.Period,
+		CategoryIds: template.CategoryIds,
+		IsActive:    true,
+		StartDate:   timestamppb.New(start),
+		EndDate:     timestamppb.New(end),
+		CreatedAt:   timestamppb.Now(),
+		UpdatedAt:   timestamppb.Now(),
+		TemplateId:  template.Id,
+	}
+	if err := s.store.CreateBudget(ctx, budget); err != nil {
+		return nil, fmt.Errorf("failed to create budget: %w", err)
+	}
+
+	if latest != nil && latest.IsActive {
+		latest.IsActive = false
+		latest.UpdatedAt = timestamppb.Now()
+		if err := s.store.UpdateBudget(ctx, latest); err != nil {
+			return budget, fmt.Errorf("failed to deactivate ended budget: %w", err)
+		}
+	}
+	return budget, nil
+}
+
+// latestTemplateBudget returns the template's budget with the latest start
+// date, or nil if none has been created yet.
+func (s *FinanceService) latestTemplateBudget(ctx context.Context, template *pfinancev1.RecurringBudget) (*pfinancev1.Budget, error) {
+	userID := template.UserId
+	if template.GroupId != "" {
+		userID = ""
+	}
+
+	var latest *pfinancev1.Budget
+	pageToken := ""
+	for {
+		budgets, nextToken, err := s.store.ListBudgets(ctx, userID, template.GroupId, true, 1000, pageToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list budgets: %w", err)
+		}
+		for _, b := range budgets {
+			if b.TemplateId != template.Id {
+				continue
+			}
+			if latest == nil || b.StartDate.AsTime().After(latest.StartDate.AsTime()) {
+				latest = b
+			}
+		}
+		if nextToken == "" {
+			return latest, nil
+		}
+		pageToken = nextToken
+	}
+}
+
+// budgetTemplatePeriodID derives a stable budget ID for one period of a
+// template, so a re-run never creates the same period twice.
+func budgetTemplatePeriodID(templateID string, periodStart time.Time) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte("budget-template:"+templateID+"/"+periodStart.UTC().Format(time.RFC3339))).String()
+}
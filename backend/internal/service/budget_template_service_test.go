@@ -0,0 +1,164 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestBudgetTemplates(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	created, err := svc.CreateBudgetTemplate(ctx, connect.NewRequest(&pfinancev1.CreateBudgetTemplateRequest{
+		UserId:      "user-123",
+		Name:        " Groceries ",
+		AmountCents: 60000,
+		Period:      pfinancev1.BudgetPeriod_BUDGET_PERIOD_MONTHLY,
+		CategoryIds: []pfinancev1.ExpenseCategory{pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+	}))
+	if err != nil {
+		t.Fatalf("CreateBudgetTemplate: %v", err)
+	}
+	template := created.Msg.Template
+	first := created.Msg.Budget
+	if template.Name != "Groceries" {
+		t.Errorf("template name = %q, want trimmed", template.Name)
+	}
+	if first == nil || first.TemplateId != template.Id || !first.IsActive || first.AmountCents != 60000 {
+		t.Fatalf("first budget = %v, want an active budget linked to the template", first)
+	}
+	if got := first.StartDate.AsTime(); got.Day() != 1 || got.Month() != time.Now().Month() {
+		t.Errorf("first budget starts %v, want the first of the current month", got)
+	}
+
+	t.Run("nothing to roll while the period runs", func(t *testing.T) {
+		rolled, err := svc.RollActiveBudgets(t.Context(), time.Now())
+		if err != nil || rolled != 0 {
+			t.Errorf("RollActiveBudgets = %d, %v; want 0", rolled, err)
+		}
+	})
+
+	t.Run("ended period rolls into the next", func(t *testing.T) {
+		asOf := first.EndDate.AsTime().Add(time.Hour)
+		rolled, err := svc.RollActiveBudgets(t.Context(), asOf)
+		if err != nil || rolled != 1 {
+			t.Fatalf("RollActiveBudgets = %d, %v; want 1", rolled, err)
+		}
+
+		old, _ := memStore.GetBudget(t.Context(), first.Id)
+		if old.IsActive {
+			t.Error("ended budget is still active")
+		}
+		budgets, _, err := memStore.ListBudgets(t.Context(), "user-123", "", false, 0, "")
+		if err != nil || len(budgets) != 1 {
+			t.Fatalf("active budgets = %v, %v; want one", budgets, err)
+		}
+		next := budgets[0]
+		if next.TemplateId != template.Id || !next.StartDate.AsTime().After(first.EndDate.AsTime()) {
+			t.Errorf("next budget = %v, want the following period of the template", next)
+		}
+
+		rolled, err = svc.RollActiveBudgets(t.Context(), asOf)
+		if err != nil || rolled != 0 {
+			t.Errorf("re-run = %d, %v; want 0", rolled, err)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		list, err := svc.ListBudgetTemplates(ctx, connect.NewRequest(&pfinancev1.ListBudgetTemplatesRequest{UserId: "user-123"}))
+		if err != nil || len(list.Msg.Templates) != 1 {
+			t.Errorf("ListBudgetTemplates = %v, %v; want one template", list, err)
+		}
+		_, err = svc.ListBudgetTemplates(testContext("other-user"), connect.NewRequest(&pfinancev1.ListBudgetTemplatesRequest{UserId: "user-123"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("inactive templates stop rolling", func(t *testing.T) {
+		_, err := svc.UpdateBudgetTemplate(testContext("other-user"), connect.NewRequest(&pfinancev1.UpdateBudgetTemplateRequest{
+			TemplateId: template.Id,
+			IsActive:   proto.Bool(false),
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+
+		updated, err := svc.UpdateBudgetTemplate(ctx, connect.NewRequest(&pfinancev1.UpdateBudgetTemplateRequest{
+			TemplateId: template.Id,
+			IsActive:   proto.Bool(false),
+		}))
+		if err != nil {
+			t.Fatalf("UpdateBudgetTemplate: %v", err)
+		}
+		if got := updated.Msg.Template; got.IsActive || got.Name != "Groceries" || got.AmountCents != 60000 {
+			t.Errorf("template = %v, want only is_active changed", got)
+		}
+
+		asOf := first.EndDate.AsTime().AddDate(0, 2, 0)
+		rolled, err := svc.RollActiveBudgets(t.Context(), asOf)
+		if err != nil || rolled != 0 {
+			t.Errorf("RollActiveBudgets = %d, %v; want 0 for an inactive template", rolled, err)
+		}
+
+		_, err = svc.UpdateBudgetTemplate(ctx, connect.NewRequest(&pfinancev1.UpdateBudgetTemplateRequest{
+			TemplateId:  template.Id,
+			AmountCents: proto.Int64(75000),
+			IsActive:    proto.Bool(true),
+		}))
+		if err != nil {
+			t.Fatalf("UpdateBudgetTemplate: %v", err)
+		}
+		rolled, err = svc.RollActiveBudgets(t.Context(), asOf)
+		if err != nil || rolled != 1 {
+			t.Fatalf("RollActiveBudgets = %d, %v; want 1 once reactivated", rolled, err)
+		}
+		budgets, _, _ := memStore.ListBudgets(t.Context(), "user-123", "", false, 0, "")
+		if len(budgets) != 1 || budgets[0].AmountCents != 75000 {
+			t.Errorf("active budgets = %v, want one rolled with the new amount", budgets)
+		}
+	})
+
+	t.Run("rejects an update without an amount", func(t *testing.T) {
+		_, err := svc.UpdateBudgetTemplate(ctx, connect.NewRequest(&pfinancev1.UpdateBudgetTemplateRequest{
+			TemplateId:  template.Id,
+			AmountCents: proto.Int64(0),
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("rejects a template without an amount", func(t *testing.T) {
+		_, err := svc.CreateBudgetTemplate(ctx, connect.NewRequest(&pfinancev1.CreateBudgetTemplateRequest{
+			UserId: "user-123",
+			Name:   "Empty",
+			Period: pfinancev1.BudgetPeriod_BUDGET_PERIOD_WEEKLY,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+	t.Run("delete keeps rolled budgets", func(t *testing.T) {
+		if _, err := svc.DeleteBudgetTemplate(ctx, connect.NewRequest(&pfinancev1.DeleteBudgetTemplateRequest{TemplateId: template.Id})); err != nil {
+			t.Fatalf("DeleteBudgetTemplate: %v", err)
+		}
+		list, err := svc.ListBudgetTemplates(ctx, connect.NewRequest(&pfinancev1.ListBudgetTemplatesRequest{UserId: "user-123"}))
+		if err != nil || len(list.Msg.Templates) != 0 {
+			t.Errorf("ListBudgetTemplates = %v, %v; want none", list, err)
+		}
+		if _, err := memStore.GetBudget(t.Context(), first.Id); err != nil {
+			t.Errorf("rolled budget was deleted: %v", err)
+		}
+		_, err = svc.DeleteBudgetTemplate(ctx, connect.NewRequest(&pfinancev1.DeleteBudgetTemplateRequest{TemplateId: template.Id}))
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("code = %v, want NotFound", connect.CodeOf(err))
+		}
+	})
+}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/auth"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// dataArchiveSchemaVersion identifies the layout of ExportAllData archives.
+// Bump it whenever the layout changes in a way readers must know about.
+const dataArchiveSchemaVersion = 1
+
+// ExportAllData streams everything held for the user as a single JSON archive.
+// Collections are read from the store a page at a time and each page is sent
+// as soon as it is serialized, so at most one page is held in memory. API
+// tokens are exported without their hashes, as ListApiTokens returns them.
+func (s *FinanceService) ExportAllData(ctx context.Context, req *connect.Request[pfinancev1.ExportAllDataRequest], stream *connect.ServerStream[pfinancev1.ExportAllDataResponse]) error {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" {
+		userID = claims.UID
+	}
+	if userID != claims.UID {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot export another user's data"))
+	}
+
+	batchSize := req.Msg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > 1000 {
+		batchSize = 1000
+	}
+
+	now := time.Now()
+	w := &dataArchiveWriter{
+		stream: stream,
+		first: &pfinancev1.ExportAllDataResponse{
+			Filename:    fmt.Sprintf("pfinance-archive-%s.json", now.Format("2006-01-02")),
+			ContentType: "application/json",
+		},
+	}
+	if err := w.begin(userID, now); err != nil {
+		return err
+	}
+
+	if err := writeArchivePages(w, "expenses", func(pageToken string) ([]*pfinancev1.Expense, string, error) {
+		expenses, next, err := s.store.ListExpenses(ctx, userID, "", store.ExpenseQuery{}, batchSize, pageToken)
+		return expenses, next, auth.WrapStoreError("list expenses", err)
+	}); err != nil {
+		return err
+	}
+	if err := writeArchivePages(w, "incomes", func(pageToken string) ([]*pfinancev1.Income, string, error) {
+		incomes, next, err := s.store.ListIncomes(ctx, userID, "", nil, nil, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, batchSize, pageToken)
+		return incomes, next, auth.WrapStoreError("list incomes", err)
+	}); err != nil {
+		return err
+	}
+	if err := writeArchivePages(w, "budgets", func(pageToken string) ([]*pfinancev1.Budget, string, error) {
+		budgets, next, err := s.store.ListBudgets(ctx, userID, "", true, batchSize, pageToken)
+		return budgets, next, auth.WrapStoreError("list budgets", err)
+	}); err != nil {
+		return err
+	}
+
+	// Goal contributions are listed per goal, so remember the goal IDs.
+	var goalIDs []string
+	if err := writeArchivePages(w, "goals", func(pageToken string) ([]*pfinancev1.FinancialGoal, string, error) {
+		goals, next, err := s.store.ListGoals(ctx, userID, "", pfinancev1.GoalStatus_GOAL_STATUS_UNSPECIFIED, pfinancev1.GoalType_GOAL_TYPE_UNSPECIFIED, batchSize, pageToken)
+		for _, g := range goals {
+			goalIDs = append(goalIDs, g.Id)
+		}
+		return goals, next, auth.WrapStoreError("list goals", err)
+	}); err != nil {
+		return err
+	}
+	w.beginCollection("goal_contributions")
+	for _, goalID := range goalIDs {
+		var pageToken string
+		for {
+			contributions, next, err := s.store.ListGoalContributions(ctx, goalID, batchSize, pageToken)
+			if err != nil {
+				return auth.WrapStoreError("list goal contributions", err)
+			}
+			if err := writeArchiveRecords(w, contributions); err != nil {
+				return err
+			}
+			if next == "" {
+				break
+			}
+			pageToken = next
+		}
+	}
+	w.endCollection()
+
+	if err := writeArchivePages(w, "expense_contributions", func(pageToken string) ([]*pfinancev1.ExpenseContribution, string, error) {
+		contributions, next, err := s.store.ListContributions(ctx, "", userID, batchSize, pageToken)
+		return contributions, next, auth.WrapStoreError("list contributions", err)
+	}); err != nil {
+		return err
+	}
+	if err := writeArchivePages(w, "income_contributions", func(pageToken string) ([]*pfinancev1.IncomeContribution, string, error) {
+		contributions, next, err := s.store.ListIncomeContributions(ctx, "", userID, batchSize, pageToken)
+		return contributions, next, auth.WrapStoreError("list income contributions", err)
+	}); err != nil {
+		return err
+	}
+	if err := writeArchivePages(w, "recurring_transactions", func(pageToken string) ([]*pfinancev1.RecurringTransaction, string, error) {
+		recurring, next, err := s.store.ListRecurringTransactions(ctx, userID, "", pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_UNSPECIFIED, false, false, batchSize, pageToken)
+		return recurring, next, auth.WrapStoreError("list recurring transactions", err)
+	}); err != nil {
+		return err
+	}
+	if err := writeArchivePages(w, "notifications", func(pageToken string) ([]*pfinancev1.Notification, string, error) {
+		notifications, next, err := s.store.ListNotifications(ctx, userID, false, pfinancev1.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED, batchSize, pageToken)
+		return notifications, next, auth.WrapStoreError("list notifications", err)
+	}); err != nil {
+		return err
+	}
+	if err := writeArchivePages(w, "merchant_mappings", func(pageToken string) ([]*pfinancev1.MerchantMapping, string, error) {
+		mappings, next, err := s.store.ListMerchantMappings(ctx, userID, "", pfinancev1.MerchantMappingSort_MERCHANT_MAPPING_SORT_UNSPECIFIED, batchSize, pageToken)
+		return mappings, next, auth.WrapStoreError("list merchant mappings", err)
+	}); err != nil {
+		return err
+	}
+	if err := writeArchivePages(w, "tax_deductibility_mappings", func(string) ([]*pfinancev1.TaxDeductibilityMapping, string, error) {
+		mappings, err := s.store.GetTaxDeductibilityMappings(ctx, userID)
+		return mappings, "", auth.WrapStoreError("list tax deductibility mappings", err)
+	}); err != nil {
+		return err
+	}
+	if err := writeArchivePages(w, "tax_configs", func(string) ([]*pfinancev1.TaxConfig, string, error) {
+		config, err := s.store.GetTaxConfig(ctx, userID, "")
+		if err != nil {
+			return nil, "", auth.WrapStoreError("get tax config", err)
+		}
+		return []*pfinancev1.TaxConfig{config}, "", nil
+	}); err != nil {
+		return err
+	}
+	if err := writeArchivePages(w, "api_tokens", func(string) ([]*pfinancev1.ApiToken, string, error) {
+		tokens, err := s.store.ListApiTokens(ctx, userID)
+		if err != nil {
+			return nil, "", auth.WrapStoreError("list api tokens", err)
+		}
+		redacted := make([]*pfinancev1.ApiToken, len(tokens))
+		for i, t := range tokens {
+			redacted[i] = proto.Clone(t).(*pfinancev1.ApiToken)
+			redacted[i].TokenHash = ""
+		}
+		return redacted, "", nil
+	}); err != nil {
+		return err
+	}
+
+	return w.finish()
+}
+
+// dataArchiveManifestEntry records how many records an archive collection holds.
+type dataArchiveManifestEntry struct {
+	Name        string `json:"name"`
+	RecordCount int    `json:"record_count"`
+}
+
+// dataArchiveWriter writes an ExportAllData archive to its stream. Records are
+// buffered until writeArchiveRecords sends them, along with any framing
+// written since the previous message.
+type dataArchiveWriter struct {
+	stream     *connect.ServerStream[pfinancev1.ExportAllDataResponse]
+	first      *pfinancev1.ExportAllDataResponse // Sent with the first chunk, then nil
+	buf        bytes.Buffer
+	collection string
+	count      int
+	manifest   []dataArchiveManifestEntry
+}
+
+// begin writes the archive header and opens the collections object.
+func (w *dataArchiveWriter) begin(userID string, exportedAt time.Time) error {
+	header, err := json.Marshal(map[string]any{
+		"schema_version": dataArchiveSchemaVersion,
+		"exported_at":    exportedAt.UTC().Format(time.RFC3339),
+		"user_id":        userID,
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("marshal archive header: %w", err))
+	}
+	// Reopen the header object to append the collections to it.
+	w.buf.Write(header[:len(header)-1])
+	w.buf.WriteString(`,"collections":{`)
+	return nil
+}
+
+// beginCollection opens the named array in the collections object.
+func (w *dataArchiveWriter) beginCollection(name string) {
+	if len(w.manifest) > 0 {
+		w.buf.WriteByte(',')
+	}
+	fmt.Fprintf(&w.buf, "%q:[", name)
+	w.collection = name
+	w.count = 0
+}
+
+// endCollection closes the current collection and records it in the manifest.
+func (w *dataArchiveWriter) endCollection() {
+	w.buf.WriteByte(']')
+	w.manifest = append(w.manifest, dataArchiveManifestEntry{Name: w.collection, RecordCount: w.count})
+}
+
+// finish writes the manifest, closes the archive and sends the last chunk.
+func (w *dataArchiveWriter) finish() error {
+	manifest, err := json.Marshal(map[string]any{
+		"schema_version": dataArchiveSchemaVersion,
+		"collections":    w.manifest,
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("marshal archive manifest: %w", err))
+	}
+	w.buf.WriteString(`},"manifest":`)
+	w.buf.Write(manifest)
+	w.buf.WriteByte('}')
+	w.collection = ""
+	return w.send(0)
+}
+
+// send streams everything buffered so far as one message.
+func (w *dataArchiveWriter) send(records int) error {
+	msg := &pfinancev1.ExportAllDataResponse{}
+	if w.first != nil {
+		msg = w.first
+		w.first = nil
+	}
+	msg.Data = append([]byte(nil), w.buf.Bytes()...)
+	msg.Collection = w.collection
+	msg.RecordCount = int32(records)
+	w.buf.Reset()
+	return w.stream.Send(msg)
+}
+
+// writeArchiveRecords appends records to the current collection and sends
+// them. An empty batch sends nothing.
+func writeArchiveRecords[T proto.Message](w *dataArchiveWriter, records []T) error {
+	if len(records) == 0 {
+		return nil
+	}
+	marshal := protojson.MarshalOptions{UseProtoNames: true}
+	for _, r := range records {
+		data, err := marshal.Marshal(r)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("marshal %s record: %w", w.collection, err))
+		}
+		if w.count > 0 {
+			w.buf.WriteByte(',')
+		}
+		w.buf.Write(data)
+		w.count++
+	}
+	return w.send(len(records))
+}
+
+// writeArchivePages writes a whole collection, calling list with each page
+// token in turn until it returns an empty next token.
+func writeArchivePages[T proto.Message](w *dataArchiveWriter, name string, list func(pageToken string) ([]T, string, error)) error {
+	w.beginCollection(name)
+	var pageToken string
+	for {
+		records, next, err := list(pageToken)
+		if err != nil {
+			return err
+		}
+		if err := writeArchiveRecords(w, records); err != nil {
+			return err
+		}
+		if next == "" {
+			break
+		}
+		pageToken = next
+	}
+	w.endCollection()
+	return nil
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/auth"
+)
+
+// ListRecordsMissingCents lists the user's expenses and incomes that have a
+// dollar amount but no cents value, so a dashboard can track what the cents
+// backfill has yet to reach or spot clients still writing dollars only. It is
+// read-only and scoped to the caller like every other RPC.
+func (s *FinanceService) ListRecordsMissingCents(ctx context.Context, req *connect.Request[pfinancev1.ListRecordsMissingCentsRequest]) (*connect.Response[pfinancev1.ListRecordsMissingCentsResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" {
+		userID = claims.UID
+	}
+	if userID != claims.UID {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot list another user's records"))
+	}
+
+	expenses, err := s.store.ListExpensesMissingCents(ctx, userID)
+	if err != nil {
+		return nil, auth.WrapStoreError("list expenses missing cents", err)
+	}
+	incomes, err := s.store.ListIncomesMissingCents(ctx, userID)
+	if err != nil {
+		return nil, auth.WrapStoreError("list incomes missing cents", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.ListRecordsMissingCentsResponse{
+		Expenses: expenses,
+		Incomes:  incomes,
+	}), nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+)
+
+func TestListRecordsMissingCents(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-1")
+
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "e-dollars", UserId: "user-1", Amount: 12.5},
+		{Id: "e-both", UserId: "user-1", Amount: 12.5, AmountCents: 1250},
+		{Id: "e-cents", UserId: "user-1", AmountCents: 990},
+		{Id: "e-zero", UserId: "user-1"},
+		{Id: "e-group", UserId: "user-1", GroupId: "group-1", Amount: -3},
+		{Id: "e-other", UserId: "user-2", Amount: 40},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+	for _, inc := range []*pfinancev1.Income{
+		{Id: "i-dollars", UserId: "user-1", Amount: 5000},
+		{Id: "i-cents", UserId: "user-1", AmountCents: 500000},
+		{Id: "i-other", UserId: "user-2", Amount: 100},
+	} {
+		if err := memStore.CreateIncome(t.Context(), inc); err != nil {
+			t.Fatalf("CreateIncome: %v", err)
+		}
+	}
+
+	resp, err := svc.ListRecordsMissingCents(ctx, connect.NewRequest(&pfinancev1.ListRecordsMissingCentsRequest{}))
+	if err != nil {
+		t.Fatalf("ListRecordsMissingCents: %v", err)
+	}
+	var expenseIDs, incomeIDs []string
+	for _, e := range resp.Msg.Expenses {
+		expenseIDs = append(expenseIDs, e.Id)
+	}
+	for _, inc := range resp.Msg.Incomes {
+		incomeIDs = append(incomeIDs, inc.Id)
+	}
+	if want := []string{"e-dollars", "e-group"}; !slices.Equal(expenseIDs, want) {
+		t.Errorf("expenses = %v, want %v", expenseIDs, want)
+	}
+	if want := []string{"i-dollars"}; !slices.Equal(incomeIDs, want) {
+		t.Errorf("incomes = %v, want %v", incomeIDs, want)
+	}
+
+	t.Run("store scans every user without a user ID", func(t *testing.T) {
+		expenses, err := memStore.ListExpensesMissingCents(t.Context(), "")
+		if err != nil {
+			t.Fatalf("ListExpensesMissingCents: %v", err)
+		}
+		if len(expenses) != 3 {
+			t.Errorf("got %d expenses, want 3 across users", len(expenses))
+		}
+	})
+
+	t.Run("cannot list another user's records", func(t *testing.T) {
+		_, err := svc.ListRecordsMissingCents(ctx, connect.NewRequest(&pfinancev1.ListRecordsMissingCentsRequest{UserId: "user-2"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/auth"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MergeExpenses folds a duplicate (secondary) expense into the one the user
+// keeps (primary), deletes the secondary and records what changed as a
+// CorrectionRecord. Both expenses must belong to the caller, or to the same
+// group with the caller as creator or admin.
+func (s *FinanceService) MergeExpenses(ctx context.Context, req *connect.Request[pfinancev1.MergeExpensesRequest]) (*connect.Response[pfinancev1.MergeExpensesResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.PrimaryExpenseId == "" || req.Msg.SecondaryExpenseId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("primary_expense_id and secondary_expense_id are required"))
+	}
+	if req.Msg.PrimaryExpenseId == req.Msg.SecondaryExpenseId {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("cannot merge an expense with itself"))
+	}
+
+	primary, err := s.store.GetExpense(ctx, req.Msg.PrimaryExpenseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("primary expense not found"))
+	}
+	secondary, err := s.store.GetExpense(ctx, req.Msg.SecondaryExpenseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("secondary expense not found"))
+	}
+
+	if primary.GroupId != secondary.GroupId {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("cannot merge expenses from different groups"))
+	}
+	if primary.GroupId == "" {
+		if primary.UserId != claims.UID || secondary.UserId != claims.UID {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("cannot merge another user's expense"))
+		}
+	} else {
+		group, err := s.store.GetGroup(ctx, primary.GroupId)
+		if err != nil {
+			return nil, auth.WrapStoreError("get group", err)
+		}
+		isAdmin := auth.IsGroupAdminOrOwner(claims.UID, group)
+		for _, e := range []*pfinancev1.Expense{primary, secondary} {
+			isCreator := e.UserId == claims.UID || e.PaidByUserId == claims.UID
+			if !isCreator && !isAdmin {
+				return nil, connect.NewError(connect.CodePermissionDenied,
+					fmt.Errorf("only expense creator or group admin can merge this expense"))
+			}
+		}
+	}
+
+	for _, e := range []*pfinancev1.Expense{primary, secondary} {
+		if err := checkExpenseUnlocked(e); err != nil {
+			return nil, err
+		}
+	}
+
+	primaryCents := store.ToCents(effectiveDollars(primary.AmountCents, primary.Amount))
+	secondaryCents := store.ToCents(effectiveDollars(secondary.AmountCents, secondary.Amount))
+	if primaryCents != 0 && primaryCents != secondaryCents && !req.Msg.KeepPrimaryAmount {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("amounts differ (%d vs %d cents); set keep_primary_amount to merge anyway", primaryCents, secondaryCents))
+	}
+
+	merged, corrections := mergeExpenseFields(primary, secondary)
+	merged.UpdatedAt = timestamppb.Now()
+	if err := s.store.UpdateExpense(ctx, merged); err != nil {
+		return nil, auth.WrapStoreError("update expense", err)
+	}
+	if err := s.store.DeleteExpense(ctx, secondary.Id); err != nil {
+		return nil, auth.WrapStoreError("delete expense", err)
+	}
+
+	record := &pfinancev1.CorrectionRecord{
+		Id:                uuid.New().String(),
+		UserId:            claims.UID,
+		TransactionId:     merged.Id,
+		Corrections:       corrections,
+		OriginalMerchant:  secondary.Description,
+		CorrectedMerchant: merged.Description,
+		OriginalCategory:  secondary.Category,
+		CorrectedCategory: merged.Category,
+		CreatedAt:         timestamppb.Now(),
+		ExtractionMethod:  secondary.ExtractionMethod,
+	}
+	// The merge has already happened; a missing audit record is not worth failing it.
+	if err := s.store.CreateCorrectionRecord(ctx, record); err != nil {
+		log.Printf("Failed to store merge correction record for expense %s: %v", merged.Id, err)
+	}
+
+	return connect.NewResponse(&pfinancev1.MergeExpensesResponse{
+		Expense:    merged,
+		Correction: record,
+	}), nil
+}
+
+// mergeExpenseFields returns the primary expense with the secondary folded in,
+// leaving both inputs unchanged. The merge policy is:
+//
+//   - Description, category, date, amount, receipt, tax treatment, GST and
+//     extraction method come from the primary, and from the secondary only
+//     where the primary has none.
+//   - Tags and attachments are the union of both; a secondary receipt the
+//     primary does not use is kept as an attachment.
+//   - Notes from both are kept, primary first.
+//
+// It also returns a FieldCorrection for each description, category, date or
+// amount of the secondary that the merged expense replaces.
+func mergeExpenseFields(primary, secondary *pfinancev1.Expense) (*pfinancev1.Expense, []*pfinancev1.FieldCorrection) {
+	merged := proto.Clone(primary).(*pfinancev1.Expense)
+
+	if merged.Description == "" {
+		merged.Description = secondary.Description
+	}
+	if merged.Category == pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED {
+		merged.Category = secondary.Category
+	}
+	if merged.Date == nil {
+		merged.Date = secondary.Date
+	}
+	if merged.AmountCents == 0 && merged.Amount == 0 {
+		merged.AmountCents = secondary.AmountCents
+		merged.Amount = secondary.Amount
+	}
+	if !merged.IsTaxDeductible && secondary.IsTaxDeductible {
+		merged.IsTaxDeductible = true
+		merged.TaxDeductionCategory = secondary.TaxDeductionCategory
+		merged.TaxDeductionNote = secondary.TaxDeductionNote
+		merged.TaxDeductiblePercent = secondary.TaxDeductiblePercent
+	}
+	if merged.GstCents == 0 {
+		merged.GstCents = secondary.GstCents
+	}
+	if merged.ExtractionMethod == pfinancev1.ExtractionMethod_EXTRACTION_METHOD_UNSPECIFIED {
+		merged.ExtractionMethod = secondary.ExtractionMethod
+	}
+
+	merged.Tags = normalizeTags(append(slices.Clone(merged.Tags), secondary.Tags...))
+
+	hasAttachment := func(path string) bool {
+		return path == merged.ReceiptStoragePath || slices.ContainsFunc(merged.Attachments, func(a *pfinancev1.AttachmentRef) bool {
+			return a.StoragePath == path
+		})
+	}
+	switch {
+	case merged.ReceiptStoragePath == "" && merged.ReceiptUrl == "":
+		merged.ReceiptStoragePath = secondary.ReceiptStoragePath
+		merged.ReceiptUrl = secondary.ReceiptUrl
+	case secondary.ReceiptStoragePath != "" && !hasAttachment(secondary.ReceiptStoragePath):
+		merged.Attachments = append(merged.Attachments, &pfinancev1.AttachmentRef{StoragePath: secondary.ReceiptStoragePath})
+	}
+	for _, a := range secondary.Attachments {
+		if !hasAttachment(a.StoragePath) {
+			merged.Attachments = append(merged.Attachments, proto.Clone(a).(*pfinancev1.AttachmentRef))
+		}
+	}
+
+	switch {
+	case merged.Note == "":
+		merged.Note = secondary.Note
+	case secondary.Note != "" && secondary.Note != merged.Note:
+		merged.Note += "\n" + secondary.Note
+	}
+
+	var corrections []*pfinancev1.FieldCorrection
+	addCorrection := func(field pfinancev1.CorrectionFieldType, original, corrected string) {
+		if original != corrected {
+			corrections = append(corrections, &pfinancev1.FieldCorrection{
+				Field:          field,
+				OriginalValue:  original,
+				CorrectedValue: corrected,
+			})
+		}
+	}
+	addCorrection(pfinancev1.CorrectionFieldType_CORRECTION_FIELD_TYPE_DESCRIPTION, secondary.Description, merged.Description)
+	addCorrection(pfinancev1.CorrectionFieldType_CORRECTION_FIELD_TYPE_CATEGORY, secondary.Category.String(), merged.Category.String())
+	addCorrection(pfinancev1.CorrectionFieldType_CORRECTION_FIELD_TYPE_DATE, formatExpenseDate(secondary.Date), formatExpenseDate(merged.Date))
+	addCorrection(pfinancev1.CorrectionFieldType_CORRECTION_FIELD_TYPE_AMOUNT,
+		strconv.FormatInt(store.ToCents(effectiveDollars(secondary.AmountCents, secondary.Amount)), 10),
+		strconv.FormatInt(store.ToCents(effectiveDollars(merged.AmountCents, merged.Amount)), 10))
+	return merged, corrections
+}
+
+// formatExpenseDate formats an expense date as YYYY-MM-DD, or "" when unset.
+func formatExpenseDate(date *timestamppb.Timestamp) string {
+	if date == nil {
+		return ""
+	}
+	return date.AsTime().Format("2006-01-02")
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMergeExpenseFields(t *testing.T) {
+	date := timestamppb.New(time.Date(2025, 6, 3, 0, 0, 0, 0, time.UTC))
+	primary := &pfinancev1.Expense{
+		Id:                 "manual",
+		Description:        "Dinner with Sam",
+		AmountCents:        8450,
+		Category:           pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD,
+		Tags:               []string{"social"},
+		Note:               "birthday",
+		ReceiptStoragePath: "receipts/u/manual/receipt.jpg",
+	}
+	secondary := &pfinancev1.Expense{
+		Id:                 "imported",
+		Description:        "SQ *TRATTORIA",
+		AmountCents:        8450,
+		Category:           pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_OTHER,
+		Date:               date,
+		Tags:               []string{"Social", "imported"},
+		Note:               "card ending 1234",
+		ReceiptStoragePath: "receipts/u/imported/receipt.pdf",
+		Attachments:        []*pfinancev1.AttachmentRef{{StoragePath: "receipts/u/imported/statement.pdf"}},
+		GstCents:           768,
+	}
+
+	merged, corrections := mergeExpenseFields(primary, secondary)
+
+	if merged.Id != "manual" || merged.Description != "Dinner with Sam" || merged.Category != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD {
+		t.Errorf("primary fields did not win: %v", merged)
+	}
+	if merged.Date != date || merged.GstCents != 768 {
+		t.Errorf("missing primary fields not filled from secondary: date=%v gst=%d", merged.Date, merged.GstCents)
+	}
+	if !slices.Equal(merged.Tags, []string{"social", "imported"}) {
+		t.Errorf("tags = %v, want the normalized union", merged.Tags)
+	}
+	if merged.Note != "birthday\ncard ending 1234" {
+		t.Errorf("note = %q, want both notes", merged.Note)
+	}
+	var paths []string
+	for _, a := range merged.Attachments {
+		paths = append(paths, a.StoragePath)
+	}
+	if merged.ReceiptStoragePath != "receipts/u/manual/receipt.jpg" ||
+		!slices.Equal(paths, []string{"receipts/u/imported/receipt.pdf", "receipts/u/imported/statement.pdf"}) {
+		t.Errorf("receipt = %q, attachments = %v", merged.ReceiptStoragePath, paths)
+	}
+	if primary.Note != "birthday" || len(primary.Attachments) != 0 {
+		t.Error("mergeExpenseFields must not modify the primary")
+	}
+
+	var fields []pfinancev1.CorrectionFieldType
+	for _, c := range corrections {
+		fields = append(fields, c.Field)
+	}
+	want := []pfinancev1.CorrectionFieldType{
+		pfinancev1.CorrectionFieldType_CORRECTION_FIELD_TYPE_DESCRIPTION,
+		pfinancev1.CorrectionFieldType_CORRECTION_FIELD_TYPE_CATEGORY,
+	}
+	if !slices.Equal(fields, want) {
+		t.Errorf("corrections = %v, want %v", fields, want)
+	}
+}
+
+func TestMergeExpenses(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "manual", UserId: "user-123", Description: "Groceries", AmountCents: 4520, Tags: []string{"weekly"}},
+		{Id: "imported", UserId: "user-123", Description: "WOOLWORTHS 1234", AmountCents: 4520, Tags: []string{"bank"}},
+		{Id: "different", UserId: "user-123", Description: "Coles", AmountCents: 1000},
+		{Id: "theirs", UserId: "other-user", Description: "Groceries", AmountCents: 4520},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+
+	resp, err := svc.MergeExpenses(ctx, connect.NewRequest(&pfinancev1.MergeExpensesRequest{
+		PrimaryExpenseId:   "manual",
+		SecondaryExpenseId: "imported",
+	}))
+	if err != nil {
+		t.Fatalf("MergeExpenses: %v", err)
+	}
+	if got := resp.Msg.Expense.Tags; !slices.Equal(got, []string{"weekly", "bank"}) {
+		t.Errorf("merged tags = %v", got)
+	}
+	if _, err := memStore.GetExpense(t.Context(), "imported"); err == nil {
+		t.Error("secondary expense still exists")
+	}
+	records, err := memStore.ListCorrectionRecords(t.Context(), "user-123", 0)
+	if err != nil || len(records) != 1 || records[0].TransactionId != "manual" || records[0].OriginalMerchant != "WOOLWORTHS 1234" {
+		t.Errorf("correction records = %v, %v", records, err)
+	}
+
+	t.Run("differing amounts need keep_primary_amount", func(t *testing.T) {
+		_, err := svc.MergeExpenses(ctx, connect.NewRequest(&pfinancev1.MergeExpensesRequest{
+			PrimaryExpenseId:   "manual",
+			SecondaryExpenseId: "different",
+		}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Fatalf("code = %v, want FailedPrecondition", connect.CodeOf(err))
+		}
+		resp, err := svc.MergeExpenses(ctx, connect.NewRequest(&pfinancev1.MergeExpensesRequest{
+			PrimaryExpenseId:   "manual",
+			SecondaryExpenseId: "different",
+			KeepPrimaryAmount:  true,
+		}))
+		if err != nil {
+			t.Fatalf("MergeExpenses: %v", err)
+		}
+		if resp.Msg.Expense.AmountCents != 4520 {
+			t.Errorf("amount = %d, want the primary's 4520", resp.Msg.Expense.AmountCents)
+		}
+	})
+
+	t.Run("another user's expense is rejected", func(t *testing.T) {
+		_, err := svc.MergeExpenses(ctx, connect.NewRequest(&pfinancev1.MergeExpensesRequest{
+			PrimaryExpenseId:   "manual",
+			SecondaryExpenseId: "theirs",
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/castlemilk/pfinance/backend/internal/auth"
 	"github.com/castlemilk/pfinance/backend/internal/extraction"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -61,6 +62,15 @@ func (s *FinanceService) ExtractDocument(ctx context.Context, req *connect.Reque
 	}
 
 	method := req.Msg.ExtractionMethod
+	prefs := s.loadExtractionPreferences(ctx, userID.UID)
+	autoRejectThreshold := prefs.GetAutoRejectThreshold()
+	if req.Msg.AutoRejectThreshold != nil {
+		autoRejectThreshold = req.Msg.GetAutoRejectThreshold()
+		if autoRejectThreshold < 0 || autoRejectThreshold > 1 {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("auto_reject_threshold must be between 0 and 1"))
+		}
+	}
 	fileSizeKB := len(req.Msg.DocumentData) / 1024
 	log.Printf("[extract] start file=%q size=%dKB method=%v async=%v",
 		req.Msg.Filename, fileSizeKB, method, req.Msg.AsyncProcessing)
@@ -74,6 +84,7 @@ func (s *FinanceService) ExtractDocument(ctx context.Context, req *connect.Reque
 			req.Msg.Filename,
 			req.Msg.DocumentType,
 			method,
+			autoRejectThreshold,
 		)
 		if err != nil {
 			return nil, mapExtractionError(err)
@@ -94,12 +105,14 @@ func (s *FinanceService) ExtractDocument(ctx context.Context, req *connect.Reque
 		req.Msg.DocumentType,
 		req.Msg.ValidateWithApi,
 		method,
+		autoRejectThreshold,
 	)
 	extractMs := time.Since(extractStart).Milliseconds()
 	if err != nil {
 		log.Printf("[extract] FAILED file=%q extractMs=%d err=%v", req.Msg.Filename, extractMs, err)
 		return nil, mapExtractionError(err)
 	}
+	applyReviewThresholds(result, prefs)
 	log.Printf("[extract] done file=%q method=%v txCount=%d confidence=%.2f extractMs=%d totalMs=%d",
 		req.Msg.Filename, result.MethodUsed, len(result.Transactions),
 		result.OverallConfidence, extractMs, time.Since(rpcStart).Milliseconds())
@@ -167,12 +180,91 @@ func (s *FinanceService) GetExtractionJob(ctx context.Context, req *connect.Requ
 		return nil, connect.NewError(connect.CodeNotFound,
 			fmt.Errorf("extraction job not found: %s", req.Msg.JobId))
 	}
+	if job.Result != nil {
+		job = proto.Clone(job).(*pfinancev1.ExtractionJob)
+		applyReviewThresholds(job.Result, s.loadExtractionPreferences(ctx, job.UserId))
+	}
 
 	return connect.NewResponse(&pfinancev1.GetExtractionJobResponse{
 		Job: job,
 	}), nil
 }
 
+// GetJobRejectedTransactions returns the transactions an async extraction job
+// rejected for low confidence.
+func (s *FinanceService) GetJobRejectedTransactions(ctx context.Context, req *connect.Request[pfinancev1.GetJobRejectedTransactionsRequest]) (*connect.Response[pfinancev1.GetJobRejectedTransactionsResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := getOwnedExtractionJob(claims.UID, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pfinancev1.GetJobRejectedTransactionsResponse{
+		Transactions: job.GetResult().GetRejectedTransactions(),
+		Status:       job.Status,
+	}), nil
+}
+
+// RecoverRejectedTransactions promotes selected rejected transactions of a
+// completed job so ImportExtractedTransactions imports them despite their
+// confidence.
+func (s *FinanceService) RecoverRejectedTransactions(ctx context.Context, req *connect.Request[pfinancev1.RecoverRejectedTransactionsRequest]) (*connect.Response[pfinancev1.RecoverRejectedTransactionsResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Msg.TransactionIds) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("transaction_ids is required"))
+	}
+
+	job, err := getOwnedExtractionJob(claims.UID, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != pfinancev1.ExtractionStatus_EXTRACTION_STATUS_COMPLETED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("extraction job %s has not completed", job.Id))
+	}
+
+	recovered, notFound, err := extractionService.RecoverRejectedTransactions(job.Id, req.Msg.TransactionIds)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("recover rejected transactions: %w", err))
+	}
+
+	return connect.NewResponse(&pfinancev1.RecoverRejectedTransactionsResponse{
+		Transactions: recovered,
+		NotFoundIds:  notFound,
+	}), nil
+}
+
+// getOwnedExtractionJob loads an extraction job and checks that userID started it.
+func getOwnedExtractionJob(userID, jobID string) (*pfinancev1.ExtractionJob, error) {
+	if extractionService == nil {
+		return nil, connect.NewError(connect.CodeUnavailable,
+			fmt.Errorf("extraction service is not available"))
+	}
+	if jobID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("job_id is required"))
+	}
+
+	job, err := extractionService.GetJob(jobID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("extraction job not found: %s", jobID))
+	}
+	if job.UserId != userID {
+		return nil, connect.NewError(connect.CodePermissionDenied,
+			fmt.Errorf("cannot access another user's extraction job"))
+	}
+	return job, nil
+}
+
 // ImportExtractedTransactions imports extracted transactions as expenses.
 func (s *FinanceService) ImportExtractedTransactions(ctx context.Context, req *connect.Request[pfinancev1.ImportExtractedTransactionsRequest]) (*connect.Response[pfinancev1.ImportExtractedTransactionsResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
@@ -203,29 +295,53 @@ func (s *FinanceService) ImportExtractedTransactions(ctx context.Context, req *c
 			fmt.Errorf("extraction service is not available"))
 	}
 
-	// Filter out duplicates before importing if skip_duplicates is set
+	// Filter out duplicates before importing if skip_duplicates is set.
+	// Dry runs always look for duplicates so the preview can flag them.
 	transactions := req.Msg.Transactions
+	dryRun := req.Msg.DryRun
 	var dupSkippedCount int
 	var dupSkippedReasons []string
-	if req.Msg.SkipDuplicates && len(transactions) > 0 {
+	var dupDispositions []*pfinancev1.ImportDisposition
+	dupFlagged := make(map[*pfinancev1.ExtractedTransaction]*pfinancev1.DuplicateCandidate)
+	if (req.Msg.SkipDuplicates || dryRun) && len(transactions) > 0 {
 		var filtered []*pfinancev1.ExtractedTransaction
 		for _, tx := range transactions {
 			candidates := s.findDuplicatesForTransaction(ctx, claims.UID, req.Msg.GroupId, tx)
-			if len(candidates) > 0 {
-				dupSkippedCount++
-				desc := tx.Description
-				if desc == "" {
-					desc = tx.NormalizedMerchant
-				}
-				dupSkippedReasons = append(dupSkippedReasons, fmt.Sprintf("Duplicate of existing expense: %s (score: %.0f%%)", desc, candidates[0].MatchScore*100))
-			} else {
+			if len(candidates) == 0 {
 				filtered = append(filtered, tx)
+				continue
 			}
+			if !req.Msg.SkipDuplicates {
+				// Dry run without skipping: the transaction is still imported,
+				// but the preview records which expense it collides with.
+				filtered = append(filtered, tx)
+				dupFlagged[tx] = candidates[0]
+				continue
+			}
+			desc := tx.Description
+			if desc == "" {
+				desc = tx.NormalizedMerchant
+			}
+			reason := fmt.Sprintf("Duplicate of existing expense: %s (score: %.0f%%)", desc, candidates[0].MatchScore*100)
+			if dryRun {
+				reason = fmt.Sprintf("Would duplicate existing expense %s: %s (score: %.0f%%)",
+					candidates[0].ExistingExpenseId, desc, candidates[0].MatchScore*100)
+			}
+			dupSkippedCount++
+			dupSkippedReasons = append(dupSkippedReasons, reason)
+			dupDispositions = append(dupDispositions, &pfinancev1.ImportDisposition{
+				TransactionId:      tx.Id,
+				Description:        desc,
+				Disposition:        pfinancev1.ImportDispositionType_IMPORT_DISPOSITION_TYPE_SKIP_DUPLICATE,
+				Reason:             reason,
+				DuplicateExpenseId: candidates[0].ExistingExpenseId,
+			})
 		}
 		transactions = filtered
 	}
 
 	// Convert transactions to expenses
+	autoRejectThreshold := s.loadExtractionPreferences(ctx, claims.UID).GetAutoRejectThreshold()
 	expenses, skippedCount, skippedReasons, err := extractionService.ImportTransactions(
 		ctx,
 		req.Msg.UserId,
@@ -233,6 +349,7 @@ func (s *FinanceService) ImportExtractedTransactions(ctx context.Context, req *c
 		transactions,
 		req.Msg.SkipDuplicates,
 		req.Msg.DefaultFrequency,
+		autoRejectThreshold,
 	)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("import failed: %w", err))
@@ -254,6 +371,27 @@ func (s *FinanceService) ImportExtractedTransactions(ctx context.Context, req *c
 		}
 	}
 
+	// Link every expense back to the statement it was imported from
+	if src := req.Msg.SourceStatement; src != nil && src.StoragePath != "" {
+		if src.UploadedAt == nil {
+			src.UploadedAt = timestamppb.Now()
+		}
+		for _, expense := range expenses {
+			expense.Attachments = append(expense.Attachments, proto.Clone(src).(*pfinancev1.AttachmentRef))
+		}
+	}
+
+	if dryRun {
+		return connect.NewResponse(&pfinancev1.ImportExtractedTransactionsResponse{
+			CreatedExpenses: expenses,
+			ImportedCount:   int32(len(expenses)),
+			SkippedCount:    int32(skippedCount),
+			SkippedReasons:  skippedReasons,
+			DryRun:          true,
+			Dispositions:    buildImportDispositions(transactions, expenses, dupDispositions, dupFlagged, autoRejectThreshold),
+		}), nil
+	}
+
 	// Batch store the expenses in a single call
 	if err := s.store.BatchCreateExpenses(ctx, expenses); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("batch create expenses: %w", err))
@@ -284,40 +422,108 @@ func (s *FinanceService) ImportExtractedTransactions(ctx context.Context, req *c
 	}), nil
 }
 
-// ParseBankStatement parses a bank statement PDF using LayoutLMv3 with Gemini fallback.
+// buildImportDispositions combines the duplicates skipped before conversion with
+// the outcome of every transaction that reached conversion, judged at
+// autoRejectThreshold. Converted transactions are paired with expenses in
+// order, matching how ImportTransactions appends them.
+func buildImportDispositions(
+	transactions []*pfinancev1.ExtractedTransaction,
+	expenses []*pfinancev1.Expense,
+	skippedDuplicates []*pfinancev1.ImportDisposition,
+	flagged map[*pfinancev1.ExtractedTransaction]*pfinancev1.DuplicateCandidate,
+	autoRejectThreshold float64,
+) []*pfinancev1.ImportDisposition {
+	dispositions := append([]*pfinancev1.ImportDisposition(nil), skippedDuplicates...)
+	next := 0
+	for _, tx := range transactions {
+		disposition, reason := extraction.ImportDispositionFor(tx, autoRejectThreshold)
+		d := &pfinancev1.ImportDisposition{
+			TransactionId: tx.Id,
+			Description:   tx.Description,
+			Disposition:   disposition,
+			Reason:        reason,
+		}
+		if dup, ok := flagged[tx]; ok {
+			d.DuplicateExpenseId = dup.ExistingExpenseId
+			if d.Reason == "" {
+				d.Reason = fmt.Sprintf("Would duplicate existing expense %s (score: %.0f%%)",
+					dup.ExistingExpenseId, dup.MatchScore*100)
+			}
+		}
+		if disposition == pfinancev1.ImportDispositionType_IMPORT_DISPOSITION_TYPE_CREATE && next < len(expenses) {
+			d.ExpenseId = expenses[next].Id
+			next++
+		}
+		dispositions = append(dispositions, d)
+	}
+	return dispositions
+}
+
+// ParseBankStatement parses a bank statement PDF using LayoutLMv3 with Gemini fallback,
+// or a CSV export using the import profile's column mappings and date format.
 func (s *FinanceService) ParseBankStatement(ctx context.Context, req *connect.Request[pfinancev1.ParseBankStatementRequest]) (*connect.Response[pfinancev1.ParseBankStatementResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if extractionService == nil {
+	isCSV := len(req.Msg.CsvData) > 0
+	if !isCSV && extractionService == nil {
 		return nil, connect.NewError(connect.CodeUnavailable,
 			fmt.Errorf("extraction service is not available"))
 	}
 
-	if len(req.Msg.PdfData) == 0 {
+	if !isCSV && len(req.Msg.PdfData) == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument,
-			fmt.Errorf("pdf_data is required"))
+			fmt.Errorf("pdf_data or csv_data is required"))
+	}
+
+	// A saved import profile supplies the bank hint, CSV layout and category rules
+	bankHint := req.Msg.BankHint
+	var profile *pfinancev1.ImportProfile
+	if req.Msg.ImportProfileId != "" {
+		profile, err = s.getOwnedImportProfile(ctx, claims.UID, req.Msg.ImportProfileId)
+		if err != nil {
+			return nil, err
+		}
+		if bankHint == "" {
+			bankHint = profile.BankName
+		}
 	}
 
 	rpcStart := time.Now()
-	log.Printf("[statement] start file=%q size=%dKB method=%v bank_hint=%q",
-		req.Msg.Filename, len(req.Msg.PdfData)/1024, req.Msg.ExtractionMethod, req.Msg.BankHint)
+	var result *pfinancev1.BankStatementResult
+	if isCSV {
+		log.Printf("[statement] start csv file=%q size=%dKB bank_hint=%q",
+			req.Msg.Filename, len(req.Msg.CsvData)/1024, bankHint)
 
-	result, err := extractionService.ParseBankStatement(ctx, req.Msg.PdfData, req.Msg.BankHint, req.Msg.ExtractionMethod)
-	if err != nil {
-		log.Printf("[statement] FAILED file=%q elapsed=%dms err=%v", req.Msg.Filename, time.Since(rpcStart).Milliseconds(), err)
-		return nil, mapExtractionError(err)
+		result, err = extraction.ParseCSVStatement(req.Msg.CsvData, profile.GetColumnMappings(), profile.GetDateFormat())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse CSV: %w", err))
+		}
+		result.BankDetected = bankHint
+	} else {
+		log.Printf("[statement] start file=%q size=%dKB method=%v bank_hint=%q",
+			req.Msg.Filename, len(req.Msg.PdfData)/1024, req.Msg.ExtractionMethod, bankHint)
+
+		result, err = extractionService.ParseBankStatement(ctx, req.Msg.PdfData, bankHint, req.Msg.ExtractionMethod)
+		if err != nil {
+			log.Printf("[statement] FAILED file=%q elapsed=%dms err=%v", req.Msg.Filename, time.Since(rpcStart).Milliseconds(), err)
+			return nil, mapExtractionError(err)
+		}
 	}
 
 	log.Printf("[statement] done file=%q bank=%s txCount=%d confidence=%.2f elapsed=%dms",
 		req.Msg.Filename, result.BankDetected, len(result.Transactions),
 		result.Confidence, time.Since(rpcStart).Milliseconds())
 
+	if profile != nil {
+		applyImportCategoryRules(result.Transactions, profile.CategoryRules)
+	}
+
 	// Check for statement duplicates
 	var duplicateWarnings []string
-	if result.StatementMetadata != nil {
+	if result.StatementMetadata != nil && extractionService != nil {
 		isDup, warnings, _ := extractionService.CheckStatementDuplicate(ctx, claims.UID, result.StatementMetadata)
 		if isDup {
 			duplicateWarnings = warnings
@@ -3,7 +3,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
@@ -11,6 +13,8 @@ import (
 	"github.com/castlemilk/pfinance/backend/internal/extraction"
 	"github.com/castlemilk/pfinance/backend/internal/store"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // mockExtractor is a simple mock for the Extractor interface.
@@ -29,9 +33,17 @@ type mockExtractor struct {
 	getJobErr        error
 	asyncJobID       string
 	asyncErr         error
+	statementResult  *pfinancev1.BankStatementResult
+	recovered        []*pfinancev1.ExtractedTransaction
+	recoverNotFound  []string
+
+	lastAutoRejectThreshold float64  // Threshold passed to the most recent extraction call
+	lastBankHint            string   // Bank hint passed to the most recent statement parse
+	lastRecoveredIDs        []string // Transaction IDs passed to the most recent recovery
 }
 
-func (m *mockExtractor) ExtractDocumentWithMethod(ctx context.Context, data []byte, filename string, docType pfinancev1.DocumentType, validateWithAPI bool, method pfinancev1.ExtractionMethod) (*pfinancev1.ExtractionResult, error) {
+func (m *mockExtractor) ExtractDocumentWithMethod(ctx context.Context, data []byte, filename string, docType pfinancev1.DocumentType, validateWithAPI bool, method pfinancev1.ExtractionMethod, autoRejectThreshold float64) (*pfinancev1.ExtractionResult, error) {
+	m.lastAutoRejectThreshold = autoRejectThreshold
 	return m.extractDocResult, m.extractDocErr
 }
 
@@ -47,7 +59,8 @@ func (m *mockExtractor) ParseExpenseText(ctx context.Context, text string) (*pfi
 	return m.parseResult, m.parseErr
 }
 
-func (m *mockExtractor) ImportTransactions(ctx context.Context, userID string, groupID string, transactions []*pfinancev1.ExtractedTransaction, skipDuplicates bool, defaultFrequency pfinancev1.ExpenseFrequency) ([]*pfinancev1.Expense, int, []string, error) {
+func (m *mockExtractor) ImportTransactions(ctx context.Context, userID string, groupID string, transactions []*pfinancev1.ExtractedTransaction, skipDuplicates bool, defaultFrequency pfinancev1.ExpenseFrequency, autoRejectThreshold float64) ([]*pfinancev1.Expense, int, []string, error) {
+	m.lastAutoRejectThreshold = autoRejectThreshold
 	return m.importExpenses, m.importSkipped, m.importReasons, m.importErr
 }
 
@@ -55,7 +68,13 @@ func (m *mockExtractor) GetJob(id string) (*pfinancev1.ExtractionJob, error) {
 	return m.getJobResult, m.getJobErr
 }
 
-func (m *mockExtractor) StartAsyncExtraction(ctx context.Context, userID string, data []byte, filename string, docType pfinancev1.DocumentType, method pfinancev1.ExtractionMethod) (string, error) {
+func (m *mockExtractor) RecoverRejectedTransactions(jobID string, transactionIDs []string) ([]*pfinancev1.ExtractedTransaction, []string, error) {
+	m.lastRecoveredIDs = transactionIDs
+	return m.recovered, m.recoverNotFound, nil
+}
+
+func (m *mockExtractor) StartAsyncExtraction(ctx context.Context, userID string, data []byte, filename string, docType pfinancev1.DocumentType, method pfinancev1.ExtractionMethod, autoRejectThreshold float64) (string, error) {
+	m.lastAutoRejectThreshold = autoRejectThreshold
 	return m.asyncJobID, m.asyncErr
 }
 
@@ -72,7 +91,8 @@ func (m *mockExtractor) RecordProcessedStatement(ctx context.Context, userID str
 }
 
 func (m *mockExtractor) ParseBankStatement(ctx context.Context, pdfData []byte, bankHint string, method pfinancev1.ExtractionMethod) (*pfinancev1.BankStatementResult, error) {
-	return nil, nil
+	m.lastBankHint = bankHint
+	return m.statementResult, nil
 }
 
 func (m *mockExtractor) SetStatementStore(store extraction.StatementStore) {
@@ -121,6 +141,57 @@ func TestExtractDocument_Success(t *testing.T) {
 	}
 }
 
+func TestExtractDocument_AutoRejectThreshold(t *testing.T) {
+	mock := &mockExtractor{
+		extractDocResult: &pfinancev1.ExtractionResult{OverallConfidence: 0.9},
+		asyncJobID:       "extr_threshold",
+	}
+	SetExtractionService(mock)
+	defer SetExtractionService(nil)
+
+	svc := NewFinanceService(nil, nil, nil)
+	ctx := authedCtx("user-1")
+
+	tests := []struct {
+		name      string
+		threshold *float64
+		async     bool
+		want      float64
+		wantCode  connect.Code
+	}{
+		{name: "defaults when unset", want: extraction.ConfidenceAutoReject},
+		{name: "zero keeps everything", threshold: proto.Float64(0), want: 0},
+		{name: "passed to async jobs", threshold: proto.Float64(0.6), async: true, want: 0.6},
+		{name: "rejects out of range", threshold: proto.Float64(1.5), wantCode: connect.CodeInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.lastAutoRejectThreshold = -1
+
+			_, err := svc.ExtractDocument(ctx, connect.NewRequest(&pfinancev1.ExtractDocumentRequest{
+				DocumentData:        []byte("fake-image-data"),
+				Filename:            "receipt.jpg",
+				AsyncProcessing:     tt.async,
+				AutoRejectThreshold: tt.threshold,
+			}))
+
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Fatalf("expected %v, got %v", tt.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if mock.lastAutoRejectThreshold != tt.want {
+				t.Errorf("threshold passed to extractor = %v, want %v", mock.lastAutoRejectThreshold, tt.want)
+			}
+		})
+	}
+}
+
 func TestExtractDocument_ServiceNil(t *testing.T) {
 	SetExtractionService(nil)
 
@@ -253,6 +324,91 @@ func TestGetExtractionJob_Success(t *testing.T) {
 	}
 }
 
+func TestRejectedTransactionRecovery(t *testing.T) {
+	job := &pfinancev1.ExtractionJob{
+		Id:     "extr_abc123",
+		UserId: "user-1",
+		Status: pfinancev1.ExtractionStatus_EXTRACTION_STATUS_COMPLETED,
+		Result: &pfinancev1.ExtractionResult{
+			RejectedTransactions: []*pfinancev1.ExtractedTransaction{{Id: "r1", Confidence: 0.1}},
+		},
+	}
+	mock := &mockExtractor{
+		getJobResult:    job,
+		recovered:       []*pfinancev1.ExtractedTransaction{{Id: "r1", Confidence: 0.1, UserConfirmed: true}},
+		recoverNotFound: []string{"r9"},
+	}
+	SetExtractionService(mock)
+	defer SetExtractionService(nil)
+
+	svc := NewFinanceService(nil, nil, nil)
+	ctx := authedCtx("user-1")
+
+	t.Run("lists rejected transactions", func(t *testing.T) {
+		resp, err := svc.GetJobRejectedTransactions(ctx, connect.NewRequest(&pfinancev1.GetJobRejectedTransactionsRequest{JobId: job.Id}))
+		if err != nil {
+			t.Fatalf("GetJobRejectedTransactions: %v", err)
+		}
+		if len(resp.Msg.Transactions) != 1 || resp.Msg.Status != pfinancev1.ExtractionStatus_EXTRACTION_STATUS_COMPLETED {
+			t.Errorf("response = %v", resp.Msg)
+		}
+	})
+
+	t.Run("recovers selected transactions", func(t *testing.T) {
+		resp, err := svc.RecoverRejectedTransactions(ctx, connect.NewRequest(&pfinancev1.RecoverRejectedTransactionsRequest{
+			JobId:          job.Id,
+			TransactionIds: []string{"r1", "r9"},
+		}))
+		if err != nil {
+			t.Fatalf("RecoverRejectedTransactions: %v", err)
+		}
+		if len(resp.Msg.Transactions) != 1 || !resp.Msg.Transactions[0].UserConfirmed {
+			t.Errorf("transactions = %v, want r1 user-confirmed", resp.Msg.Transactions)
+		}
+		if len(resp.Msg.NotFoundIds) != 1 || resp.Msg.NotFoundIds[0] != "r9" {
+			t.Errorf("not_found_ids = %v, want [r9]", resp.Msg.NotFoundIds)
+		}
+	})
+
+	t.Run("another user's job is denied", func(t *testing.T) {
+		other := authedCtx("user-2")
+		_, err := svc.GetJobRejectedTransactions(other, connect.NewRequest(&pfinancev1.GetJobRejectedTransactionsRequest{JobId: job.Id}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("get code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+		mock.lastRecoveredIDs = nil
+		_, err = svc.RecoverRejectedTransactions(other, connect.NewRequest(&pfinancev1.RecoverRejectedTransactionsRequest{
+			JobId:          job.Id,
+			TransactionIds: []string{"r1"},
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("recover code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+		if mock.lastRecoveredIDs != nil {
+			t.Error("recovery ran for another user's job")
+		}
+	})
+
+	t.Run("requires a completed job", func(t *testing.T) {
+		job.Status = pfinancev1.ExtractionStatus_EXTRACTION_STATUS_PROCESSING
+		defer func() { job.Status = pfinancev1.ExtractionStatus_EXTRACTION_STATUS_COMPLETED }()
+		_, err := svc.RecoverRejectedTransactions(ctx, connect.NewRequest(&pfinancev1.RecoverRejectedTransactionsRequest{
+			JobId:          job.Id,
+			TransactionIds: []string{"r1"},
+		}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("code = %v, want FailedPrecondition", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("requires transaction IDs", func(t *testing.T) {
+		_, err := svc.RecoverRejectedTransactions(ctx, connect.NewRequest(&pfinancev1.RecoverRejectedTransactionsRequest{JobId: job.Id}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+}
+
 func TestGetExtractionJob_NotFound(t *testing.T) {
 	mock := &mockExtractor{
 		getJobErr: fmt.Errorf("job not found"),
@@ -403,9 +559,10 @@ func TestImportExtractedTransactions_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockStore := store.NewMockStore(ctrl)
+	mockStore.EXPECT().GetExtractionPreferences(gomock.Any(), "user-1").Return(&pfinancev1.ExtractionPreferences{UserId: "user-1"}, nil)
 	mockStore.EXPECT().BatchCreateExpenses(gomock.Any(), gomock.Any()).Return(nil)
 	// Notification trigger calls (fire-and-forget)
-	mockStore.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockStore.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 	mock := &mockExtractor{
 		importExpenses: []*pfinancev1.Expense{
@@ -436,6 +593,63 @@ func TestImportExtractedTransactions_Success(t *testing.T) {
 	}
 }
 
+func TestImportExtractedTransactions_SourceStatement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var stored []*pfinancev1.Expense
+	mockStore := store.NewMockStore(ctrl)
+	mockStore.EXPECT().GetExtractionPreferences(gomock.Any(), "user-1").Return(&pfinancev1.ExtractionPreferences{UserId: "user-1"}, nil)
+	mockStore.EXPECT().BatchCreateExpenses(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, expenses []*pfinancev1.Expense) error {
+			stored = expenses
+			return nil
+		})
+	mockStore.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	mock := &mockExtractor{
+		importExpenses: []*pfinancev1.Expense{
+			{Id: "exp-1", UserId: "user-1", Description: "Coffee", Amount: 5.50},
+			{Id: "exp-2", UserId: "user-1", Description: "Lunch", Amount: 12.00},
+		},
+	}
+	SetExtractionService(mock)
+	defer SetExtractionService(nil)
+
+	svc := NewFinanceService(mockStore, nil, nil)
+	ctx := authedCtx("user-1")
+
+	_, err := svc.ImportExtractedTransactions(ctx, connect.NewRequest(&pfinancev1.ImportExtractedTransactionsRequest{
+		UserId: "user-1",
+		Transactions: []*pfinancev1.ExtractedTransaction{
+			{Id: "1", Description: "Coffee", Amount: 5.50, IsDebit: true, Confidence: 0.9},
+			{Id: "2", Description: "Lunch", Amount: 12.00, IsDebit: true, Confidence: 0.9},
+		},
+		SourceStatement: &pfinancev1.AttachmentRef{
+			StoragePath: "statements/user-1/march.pdf",
+			ContentType: "application/pdf",
+		},
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stored) != 2 {
+		t.Fatalf("expected 2 stored expenses, got %d", len(stored))
+	}
+	for _, e := range stored {
+		if len(e.Attachments) != 1 || e.Attachments[0].StoragePath != "statements/user-1/march.pdf" {
+			t.Errorf("expense %s: expected statement attachment, got %v", e.Id, e.Attachments)
+			continue
+		}
+		if e.Attachments[0].UploadedAt == nil {
+			t.Errorf("expense %s: expected uploaded_at to default", e.Id)
+		}
+	}
+	if stored[0].Attachments[0] == stored[1].Attachments[0] {
+		t.Error("expected each expense to hold its own attachment copy")
+	}
+}
+
 func TestImportExtractedTransactions_PermissionDenied(t *testing.T) {
 	mock := &mockExtractor{}
 	SetExtractionService(mock)
@@ -466,6 +680,7 @@ func TestImportExtractedTransactions_BatchFailure(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockStore := store.NewMockStore(ctrl)
+	mockStore.EXPECT().GetExtractionPreferences(gomock.Any(), "user-1").Return(&pfinancev1.ExtractionPreferences{UserId: "user-1"}, nil)
 	mockStore.EXPECT().BatchCreateExpenses(gomock.Any(), gomock.Any()).Return(fmt.Errorf("db error"))
 
 	mock := &mockExtractor{
@@ -494,18 +709,97 @@ func TestImportExtractedTransactions_BatchFailure(t *testing.T) {
 	}
 }
 
+func TestImportExtractedTransactions_DryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// No BatchCreateExpenses or CreateNotification expectations: a dry run
+	// must not persist anything.
+	mockStore := store.NewMockStore(ctrl)
+	mockStore.EXPECT().GetExtractionPreferences(gomock.Any(), "user-1").Return(&pfinancev1.ExtractionPreferences{UserId: "user-1"}, nil)
+	mockStore.EXPECT().ListExpenses(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]*pfinancev1.Expense{
+			{Id: "existing-1", UserId: "user-1", Description: "Coffee", Amount: 5.50, Date: timestamppb.New(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))},
+		}, "", nil).AnyTimes()
+
+	mock := &mockExtractor{
+		importExpenses: []*pfinancev1.Expense{
+			{Id: "exp-lunch", UserId: "user-1", Description: "Lunch", Amount: 12.00},
+		},
+		importSkipped: 1,
+		importReasons: []string{"Skipped credit transaction: Refund"},
+	}
+	SetExtractionService(mock)
+	defer SetExtractionService(nil)
+
+	svc := NewFinanceService(mockStore, nil, nil)
+	ctx := authedCtx("user-1")
+
+	resp, err := svc.ImportExtractedTransactions(ctx, connect.NewRequest(&pfinancev1.ImportExtractedTransactionsRequest{
+		UserId:         "user-1",
+		SkipDuplicates: true,
+		DryRun:         true,
+		Transactions: []*pfinancev1.ExtractedTransaction{
+			{Id: "1", Description: "Coffee", Amount: 5.50, Date: "2025-03-01", IsDebit: true, Confidence: 0.9},
+			{Id: "2", Description: "Lunch", Amount: 12.00, Date: "2025-03-05", IsDebit: true, Confidence: 0.9},
+			{Id: "3", Description: "Refund", Amount: 20.00, IsDebit: false, Confidence: 0.9},
+		},
+	}))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Msg.DryRun {
+		t.Error("expected DryRun to be set on response")
+	}
+	if resp.Msg.ImportedCount != 1 || len(resp.Msg.CreatedExpenses) != 1 {
+		t.Fatalf("expected 1 would-be expense, got %d", resp.Msg.ImportedCount)
+	}
+	if resp.Msg.SkippedCount != 2 {
+		t.Errorf("expected 2 skipped, got %d", resp.Msg.SkippedCount)
+	}
+	if len(resp.Msg.SkippedReasons) == 0 || !strings.Contains(resp.Msg.SkippedReasons[0], "Would duplicate existing expense existing-1") {
+		t.Errorf("expected duplicate skip reason first, got %v", resp.Msg.SkippedReasons)
+	}
+
+	want := map[string]pfinancev1.ImportDispositionType{
+		"1": pfinancev1.ImportDispositionType_IMPORT_DISPOSITION_TYPE_SKIP_DUPLICATE,
+		"2": pfinancev1.ImportDispositionType_IMPORT_DISPOSITION_TYPE_CREATE,
+		"3": pfinancev1.ImportDispositionType_IMPORT_DISPOSITION_TYPE_SKIP_CREDIT,
+	}
+	if len(resp.Msg.Dispositions) != len(want) {
+		t.Fatalf("expected %d dispositions, got %d", len(want), len(resp.Msg.Dispositions))
+	}
+	for _, d := range resp.Msg.Dispositions {
+		if d.Disposition != want[d.TransactionId] {
+			t.Errorf("transaction %s: disposition = %v, want %v", d.TransactionId, d.Disposition, want[d.TransactionId])
+		}
+		switch d.TransactionId {
+		case "1":
+			if d.DuplicateExpenseId != "existing-1" {
+				t.Errorf("expected duplicate of existing-1, got %q", d.DuplicateExpenseId)
+			}
+		case "2":
+			if d.ExpenseId != "exp-lunch" {
+				t.Errorf("expected would-be expense exp-lunch, got %q", d.ExpenseId)
+			}
+		}
+	}
+}
+
 func TestImportExtractedTransactions_GroupCheck(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStore := store.NewMockStore(ctrl)
+	mockStore.EXPECT().GetExtractionPreferences(gomock.Any(), "user-1").Return(&pfinancev1.ExtractionPreferences{UserId: "user-1"}, nil)
 	mockStore.EXPECT().GetGroup(gomock.Any(), "group-1").Return(&pfinancev1.FinanceGroup{
 		Id:        "group-1",
 		MemberIds: []string{"user-1"},
 	}, nil)
 	mockStore.EXPECT().BatchCreateExpenses(gomock.Any(), gomock.Any()).Return(nil)
 	// Notification trigger calls (fire-and-forget)
-	mockStore.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockStore.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 	mock := &mockExtractor{
 		importExpenses: []*pfinancev1.Expense{
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/auth"
+	"github.com/castlemilk/pfinance/backend/internal/extraction"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetExtractionPreferences returns the authenticated user's extraction
+// thresholds, with defaults filled in for any they have not set.
+func (s *FinanceService) GetExtractionPreferences(ctx context.Context, req *connect.Request[pfinancev1.GetExtractionPreferencesRequest]) (*connect.Response[pfinancev1.GetExtractionPreferencesResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prefs, err := s.store.GetExtractionPreferences(ctx, claims.UID)
+	if err != nil {
+		return nil, auth.WrapStoreError("get extraction preferences", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.GetExtractionPreferencesResponse{
+		Preferences: extractionPreferencesWithDefaults(prefs),
+	}), nil
+}
+
+// UpdateExtractionPreferences replaces the authenticated user's extraction
+// thresholds. Thresholds left unset fall back to the defaults.
+func (s *FinanceService) UpdateExtractionPreferences(ctx context.Context, req *connect.Request[pfinancev1.UpdateExtractionPreferencesRequest]) (*connect.Response[pfinancev1.UpdateExtractionPreferencesResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Preferences == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("preferences is required"))
+	}
+
+	prefs := &pfinancev1.ExtractionPreferences{
+		UserId:               claims.UID,
+		AutoRejectThreshold:  req.Msg.Preferences.AutoRejectThreshold,
+		LowWarningThreshold:  req.Msg.Preferences.LowWarningThreshold,
+		PreDeselectThreshold: req.Msg.Preferences.PreDeselectThreshold,
+		UpdatedAt:            timestamppb.Now(),
+	}
+	if err := validateExtractionPreferences(prefs); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.UpdateExtractionPreferences(ctx, prefs); err != nil {
+		return nil, auth.WrapStoreError("update extraction preferences", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.UpdateExtractionPreferencesResponse{
+		Preferences: extractionPreferencesWithDefaults(prefs),
+	}), nil
+}
+
+// DeleteExtractionPreferences resets the authenticated user's extraction
+// thresholds to the defaults.
+func (s *FinanceService) DeleteExtractionPreferences(ctx context.Context, req *connect.Request[pfinancev1.DeleteExtractionPreferencesRequest]) (*connect.Response[emptypb.Empty], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.DeleteExtractionPreferences(ctx, claims.UID); err != nil {
+		return nil, auth.WrapStoreError("delete extraction preferences", err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// validateExtractionPreferences checks that every set threshold is within 0-1.
+func validateExtractionPreferences(prefs *pfinancev1.ExtractionPreferences) error {
+	for _, t := range []struct {
+		name  string
+		value *float64
+	}{
+		{"auto_reject_threshold", prefs.AutoRejectThreshold},
+		{"low_warning_threshold", prefs.LowWarningThreshold},
+		{"pre_deselect_threshold", prefs.PreDeselectThreshold},
+	} {
+		if t.value != nil && (*t.value < 0 || *t.value > 1) {
+			return connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("%s must be between 0 and 1", t.name))
+		}
+	}
+	return nil
+}
+
+// extractionPreferencesWithDefaults returns a copy of prefs with every unset
+// threshold set to the extraction package default.
+func extractionPreferencesWithDefaults(prefs *pfinancev1.ExtractionPreferences) *pfinancev1.ExtractionPreferences {
+	out := proto.Clone(prefs).(*pfinancev1.ExtractionPreferences)
+	if out.AutoRejectThreshold == nil {
+		out.AutoRejectThreshold = proto.Float64(extraction.ConfidenceAutoReject)
+	}
+	if out.LowWarningThreshold == nil {
+		out.LowWarningThreshold = proto.Float64(extraction.ConfidenceLowWarning)
+	}
+	if out.PreDeselectThreshold == nil {
+		out.PreDeselectThreshold = proto.Float64(extraction.ConfidencePreDeselect)
+	}
+	return out
+}
+
+// loadExtractionPreferences returns userID's extraction preferences with
+// defaults filled in. Extraction falls back to the defaults when there is no
+// store or the lookup fails rather than failing the request.
+func (s *FinanceService) loadExtractionPreferences(ctx context.Context, userID string) *pfinancev1.ExtractionPreferences {
+	prefs := &pfinancev1.ExtractionPreferences{UserId: userID}
+	if s.store != nil {
+		stored, err := s.store.GetExtractionPreferences(ctx, userID)
+		if err != nil {
+			log.Printf("Failed to load extraction preferences for user %s, using defaults: %v", userID, err)
+		} else {
+			prefs = stored
+		}
+	}
+	return extractionPreferencesWithDefaults(prefs)
+}
+
+// applyReviewThresholds copies the thresholds the review UI applies onto an
+// extraction result, so it warns about and deselects transactions by the
+// user's preferences rather than the defaults.
+func applyReviewThresholds(result *pfinancev1.ExtractionResult, prefs *pfinancev1.ExtractionPreferences) {
+	if result == nil {
+		return
+	}
+	result.LowWarningThreshold = proto.Float64(prefs.GetLowWarningThreshold())
+	result.PreDeselectThreshold = proto.Float64(prefs.GetPreDeselectThreshold())
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/extraction"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestExtractionPreferencesCRUD(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	get := func() *pfinancev1.ExtractionPreferences {
+		t.Helper()
+		resp, err := svc.GetExtractionPreferences(ctx, connect.NewRequest(&pfinancev1.GetExtractionPreferencesRequest{}))
+		if err != nil {
+			t.Fatalf("GetExtractionPreferences: %v", err)
+		}
+		return resp.Msg.Preferences
+	}
+
+	prefs := get()
+	if prefs.GetAutoRejectThreshold() != extraction.ConfidenceAutoReject ||
+		prefs.GetLowWarningThreshold() != extraction.ConfidenceLowWarning ||
+		prefs.GetPreDeselectThreshold() != extraction.ConfidencePreDeselect {
+		t.Errorf("defaults = %v, want the extraction constants", prefs)
+	}
+
+	_, err := svc.UpdateExtractionPreferences(ctx, connect.NewRequest(&pfinancev1.UpdateExtractionPreferencesRequest{
+		Preferences: &pfinancev1.ExtractionPreferences{
+			UserId:              "someone-else",
+			AutoRejectThreshold: proto.Float64(0.55),
+			LowWarningThreshold: proto.Float64(0.85),
+		},
+	}))
+	if err != nil {
+		t.Fatalf("UpdateExtractionPreferences: %v", err)
+	}
+	prefs = get()
+	if prefs.UserId != "user-123" || prefs.GetAutoRejectThreshold() != 0.55 || prefs.GetLowWarningThreshold() != 0.85 {
+		t.Errorf("updated preferences = %v", prefs)
+	}
+	if prefs.GetPreDeselectThreshold() != extraction.ConfidencePreDeselect {
+		t.Errorf("unset pre_deselect_threshold = %v, want the default", prefs.GetPreDeselectThreshold())
+	}
+
+	if _, err := svc.DeleteExtractionPreferences(ctx, connect.NewRequest(&pfinancev1.DeleteExtractionPreferencesRequest{})); err != nil {
+		t.Fatalf("DeleteExtractionPreferences: %v", err)
+	}
+	if got := get().GetAutoRejectThreshold(); got != extraction.ConfidenceAutoReject {
+		t.Errorf("auto_reject_threshold after reset = %v, want the default", got)
+	}
+
+	t.Run("invalid thresholds", func(t *testing.T) {
+		for name, p := range map[string]*pfinancev1.ExtractionPreferences{
+			"nil":       nil,
+			"above one": {LowWarningThreshold: proto.Float64(1.2)},
+			"negative":  {PreDeselectThreshold: proto.Float64(-0.1)},
+		} {
+			_, err := svc.UpdateExtractionPreferences(ctx, connect.NewRequest(&pfinancev1.UpdateExtractionPreferencesRequest{Preferences: p}))
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("%s: code = %v, want InvalidArgument", name, connect.CodeOf(err))
+			}
+		}
+	})
+}
+
+func TestExtractionPreferencesApplied(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	mock := &mockExtractor{extractDocResult: &pfinancev1.ExtractionResult{}}
+	SetExtractionService(mock)
+	defer SetExtractionService(nil)
+
+	if err := memStore.UpdateExtractionPreferences(t.Context(), &pfinancev1.ExtractionPreferences{
+		UserId:              "user-123",
+		AutoRejectThreshold: proto.Float64(0.5),
+		LowWarningThreshold: proto.Float64(0.75),
+	}); err != nil {
+		t.Fatalf("UpdateExtractionPreferences: %v", err)
+	}
+
+	extracted, err := svc.ExtractDocument(ctx, connect.NewRequest(&pfinancev1.ExtractDocumentRequest{
+		DocumentData: []byte("fake-image-data"),
+		Filename:     "receipt.jpg",
+	}))
+	if err != nil {
+		t.Fatalf("ExtractDocument: %v", err)
+	}
+	if mock.lastAutoRejectThreshold != 0.5 {
+		t.Errorf("extraction threshold = %v, want the user's 0.5", mock.lastAutoRejectThreshold)
+	}
+	if r := extracted.Msg.Result; r.GetLowWarningThreshold() != 0.75 || r.GetPreDeselectThreshold() != extraction.ConfidencePreDeselect {
+		t.Errorf("review thresholds = (%v, %v), want the user's 0.75 and the default pre-deselect",
+			r.GetLowWarningThreshold(), r.GetPreDeselectThreshold())
+	}
+
+	mock.getJobResult = &pfinancev1.ExtractionJob{
+		Id:     "extr_1",
+		UserId: "user-123",
+		Status: pfinancev1.ExtractionStatus_EXTRACTION_STATUS_COMPLETED,
+		Result: &pfinancev1.ExtractionResult{},
+	}
+	job, err := svc.GetExtractionJob(ctx, connect.NewRequest(&pfinancev1.GetExtractionJobRequest{JobId: "extr_1"}))
+	if err != nil {
+		t.Fatalf("GetExtractionJob: %v", err)
+	}
+	if got := job.Msg.Job.Result.GetLowWarningThreshold(); got != 0.75 {
+		t.Errorf("job low_warning_threshold = %v, want the user's 0.75", got)
+	}
+	if mock.getJobResult.Result.LowWarningThreshold != nil {
+		t.Error("GetExtractionJob modified the stored job")
+	}
+
+	if _, err := svc.ExtractDocument(ctx, connect.NewRequest(&pfinancev1.ExtractDocumentRequest{
+		DocumentData:        []byte("fake-image-data"),
+		Filename:            "receipt.jpg",
+		AutoRejectThreshold: proto.Float64(0.1),
+	})); err != nil {
+		t.Fatalf("ExtractDocument: %v", err)
+	}
+	if mock.lastAutoRejectThreshold != 0.1 {
+		t.Errorf("extraction threshold = %v, want the request's 0.1", mock.lastAutoRejectThreshold)
+	}
+
+	resp, err := svc.ImportExtractedTransactions(ctx, connect.NewRequest(&pfinancev1.ImportExtractedTransactionsRequest{
+		UserId: "user-123",
+		DryRun: true,
+		Transactions: []*pfinancev1.ExtractedTransaction{
+			{Id: "1", Description: "Coffee", Amount: 5.50, IsDebit: true, Confidence: 0.4},
+		},
+	}))
+	if err != nil {
+		t.Fatalf("ImportExtractedTransactions: %v", err)
+	}
+	if mock.lastAutoRejectThreshold != 0.5 {
+		t.Errorf("import threshold = %v, want the user's 0.5", mock.lastAutoRejectThreshold)
+	}
+	if len(resp.Msg.Dispositions) != 1 ||
+		resp.Msg.Dispositions[0].Disposition != pfinancev1.ImportDispositionType_IMPORT_DISPOSITION_TYPE_SKIP_LOW_CONFIDENCE {
+		t.Errorf("dispositions = %v, want the 0.4 transaction skipped at 0.5", resp.Msg.Dispositions)
+	}
+}
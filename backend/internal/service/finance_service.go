@@ -2,10 +2,19 @@ package service
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"math"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +29,7 @@ import (
 	"github.com/castlemilk/pfinance/backend/internal/store"
 	"github.com/google/uuid"
 	"github.com/stripe/stripe-go/v82"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -33,6 +43,15 @@ type FinanceService struct {
 	algolia       *search.AlgoliaClient                 // nil if Algolia is not configured
 	storageBucket *gcsstorage.BucketHandle              // nil if GCS is not configured
 	fcmClient     *fcmmessaging.Client                  // nil if FCM is not configured
+	pagination    PaginationDefaults
+	tokenKey      []byte // HMAC key for ClearUserData confirmation tokens
+}
+
+// SetTokenSecret sets the key that signs ClearUserData confirmation tokens.
+// Instances sharing a secret accept each other's tokens; without one, each
+// service signs with a random key of its own.
+func (s *FinanceService) SetTokenSecret(secret string) {
+	s.tokenKey = []byte(secret)
 }
 
 // SetAlgoliaClient sets the Algolia search client for full-text search.
@@ -45,6 +64,8 @@ func NewFinanceService(store store.Store, stripe *StripeClient, firebaseAuth *au
 		store:        store,
 		stripe:       stripe,
 		firebaseAuth: firebaseAuth,
+		pagination:   DefaultPaginationDefaults(),
+		tokenKey:     []byte(rand.Text()),
 	}
 }
 
@@ -94,6 +115,11 @@ func (s *FinanceService) CreateExpense(ctx context.Context, req *connect.Request
 		amountCents = int64(amount * 100)
 	}
 
+	gstCents, err := resolveGstCents(req.Msg.GstCents, req.Msg.IsGstRegistered, amountCents)
+	if err != nil {
+		return nil, err
+	}
+
 	expense := &pfinancev1.Expense{
 		Id:                   uuid.New().String(),
 		UserId:               req.Msg.UserId,
@@ -108,7 +134,7 @@ func (s *FinanceService) CreateExpense(ctx context.Context, req *connect.Request
 		UpdatedAt:            timestamppb.Now(),
 		PaidByUserId:         paidByUserId,
 		SplitType:            req.Msg.SplitType,
-		Tags:                 req.Msg.Tags,
+		Tags:                 normalizeTags(req.Msg.Tags),
 		IsSettled:            false,
 		IsTaxDeductible:      req.Msg.IsTaxDeductible,
 		TaxDeductionCategory: req.Msg.TaxDeductionCategory,
@@ -116,6 +142,8 @@ func (s *FinanceService) CreateExpense(ctx context.Context, req *connect.Request
 		TaxDeductiblePercent: taxDeductiblePercent,
 		ReceiptUrl:           req.Msg.ReceiptUrl,
 		ReceiptStoragePath:   req.Msg.ReceiptStoragePath,
+		Note:                 strings.TrimSpace(req.Msg.Note),
+		GstCents:             gstCents,
 	}
 
 	// Calculate allocations based on split type
@@ -131,19 +159,23 @@ func (s *FinanceService) CreateExpense(ctx context.Context, req *connect.Request
 		return nil, auth.WrapStoreError("create expense", err)
 	}
 
-	// Fire-and-forget: check budget thresholds for personal expenses
+	// Fire-and-forget: check budget thresholds and the monthly spend cap for personal expenses
 	if expense.GroupId == "" {
 		s.checkBudgetThresholdsForExpense(ctx, expense.UserId, expense.Category)
+		s.checkMonthlySpendCapForExpense(ctx, expense.UserId)
 	} else {
 		// Notify group members about new expense
 		s.notifyGroupExpenseAdded(ctx, claims.UID, expense)
 	}
 
-	// Fire-and-forget: check monthly tax savings notification
+	// Fire-and-forget: check monthly tax savings and substantiation notifications
 	if expense.IsTaxDeductible {
 		func() {
 			trigger := NewNotificationTrigger(s.store)
 			trigger.CheckMonthlyTaxSavings(ctx, claims.UID, expense)
+			if expense.GroupId == "" {
+				trigger.CheckDeductionSubstantiation(ctx, claims.UID, expense)
+			}
 		}()
 	}
 
@@ -209,7 +241,51 @@ func (s *FinanceService) checkBudgetThresholdsForExpense(ctx context.Context, us
 		trigger.CheckBudgetThreshold(ctx, userID, budget, spentCents, 50)
 		trigger.CheckBudgetThreshold(ctx, userID, budget, spentCents, 80)
 		trigger.CheckBudgetThreshold(ctx, userID, budget, spentCents, 100)
+		trigger.CheckBudgetPace(ctx, userID, budget, progress, time.Now())
+	}
+}
+
+// checkMonthlySpendCapForExpense totals the user's personal spending for the current
+// month and checks it against their monthly spend cap.
+// This is fire-and-forget: errors are logged but never returned to the caller.
+func (s *FinanceService) checkMonthlySpendCapForExpense(ctx context.Context, userID string) {
+	// Skip the month scan entirely when no cap is set
+	prefs, err := s.store.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		log.Printf("[NotificationTrigger] Failed to get notification preferences for user %s: %v", userID, err)
+		return
+	}
+	if prefs.MonthlySpendCapCents <= 0 {
+		return
+	}
+
+	now := time.Now()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := monthStart.AddDate(0, 1, 0).Add(-time.Nanosecond)
+
+	var spentCents int64
+	var pageToken string
+	for {
+		expenses, nextToken, err := s.store.ListExpenses(ctx, userID, "", store.ExpenseQuery{StartDate: &monthStart, EndDate: &monthEnd}, 500, pageToken)
+		if err != nil {
+			log.Printf("[NotificationTrigger] Failed to list expenses for spend cap: %v", err)
+			return
+		}
+		for _, e := range expenses {
+			cents := e.AmountCents
+			if cents == 0 {
+				cents = int64(e.Amount * 100)
+			}
+			spentCents += cents
+		}
+		if nextToken == "" {
+			break
+		}
+		pageToken = nextToken
 	}
+
+	trigger := NewNotificationTrigger(s.store)
+	trigger.CheckMonthlySpendCap(ctx, userID, spentCents)
 }
 
 // notifyGroupExpenseAdded sends group activity notifications for a new expense.
@@ -265,9 +341,16 @@ func (s *FinanceService) GetExpense(ctx context.Context, req *connect.Request[pf
 		}
 	}
 
-	return connect.NewResponse(&pfinancev1.GetExpenseResponse{
+	resp := &pfinancev1.GetExpenseResponse{
 		Expense: expense,
-	}), nil
+	}
+	if req.Msg.IncludeContributions {
+		resp.Contributions, err = s.store.ListContributionsForExpense(ctx, expense.Id)
+		if err != nil {
+			return nil, auth.WrapStoreError("list expense contributions", err)
+		}
+	}
+	return connect.NewResponse(resp), nil
 }
 
 // ListExpenses lists expenses for a user or group
@@ -296,7 +379,19 @@ func (s *FinanceService) ListExpenses(ctx context.Context, req *connect.Request[
 	}
 
 	startTime, endTime := auth.ConvertDateRange(req.Msg.StartDate, req.Msg.EndDate)
-	pageSize := auth.NormalizePageSize(req.Msg.PageSize)
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var tagFilter *store.TagFilter
+	if tags := normalizeTags(req.Msg.Tags); len(tags) > 0 {
+		if len(tags) > maxTagFilterValues {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("cannot filter by more than %d tags", maxTagFilterValues))
+		}
+		tagFilter = &store.TagFilter{Tags: tags, MatchAll: req.Msg.MatchAllTags}
+	}
 
 	// Use authenticated user ID if not specified
 	userID := req.Msg.UserId
@@ -304,17 +399,154 @@ func (s *FinanceService) ListExpenses(ctx context.Context, req *connect.Request[
 		userID = claims.UID
 	}
 
-	expenses, nextPageToken, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, startTime, endTime, pageSize, req.Msg.PageToken)
+	fingerprint := store.QueryFingerprint(userID, req.Msg.GroupId, startTime, endTime, req.Msg.Category, req.Msg.IsTaxDeductible, tagFilter, req.Msg.HasAttachment)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	expenses, nextPageToken, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{
+		StartDate:       startTime,
+		EndDate:         endTime,
+		Category:        req.Msg.Category,
+		IsTaxDeductible: req.Msg.IsTaxDeductible,
+		Tags:            tagFilter,
+		HasAttachment:   req.Msg.HasAttachment,
+	}, pageSize, pageToken)
 	if err != nil {
 		return nil, auth.WrapStoreError("list expenses", err)
 	}
 
 	return connect.NewResponse(&pfinancev1.ListExpensesResponse{
 		Expenses:      expenses,
-		NextPageToken: nextPageToken,
+		NextPageToken: store.EncodeQueryPageToken(nextPageToken, fingerprint),
+	}), nil
+}
+
+// GetTransactionCounts returns expense and income totals without fetching rows
+func (s *FinanceService) GetTransactionCounts(ctx context.Context, req *connect.Request[pfinancev1.GetTransactionCountsRequest]) (*connect.Response[pfinancev1.GetTransactionCountsResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GroupId == "" {
+		if req.Msg.UserId != "" && req.Msg.UserId != claims.UID {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("cannot count another user's transactions"))
+		}
+	} else {
+		group, err := s.store.GetGroup(ctx, req.Msg.GroupId)
+		if err != nil {
+			return nil, auth.WrapStoreError("get group", err)
+		}
+		if !auth.IsGroupMember(claims.UID, group) {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("user is not a member of this group"))
+		}
+	}
+
+	startTime, endTime := auth.ConvertDateRange(req.Msg.StartDate, req.Msg.EndDate)
+
+	userID := req.Msg.UserId
+	if userID == "" && req.Msg.GroupId == "" {
+		userID = claims.UID
+	}
+
+	expenseCount, err := s.store.CountExpenses(ctx, userID, req.Msg.GroupId, startTime, endTime)
+	if err != nil {
+		return nil, auth.WrapStoreError("count expenses", err)
+	}
+	incomeCount, err := s.store.CountIncomes(ctx, userID, req.Msg.GroupId, startTime, endTime)
+	if err != nil {
+		return nil, auth.WrapStoreError("count incomes", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.GetTransactionCountsResponse{
+		ExpenseCount: expenseCount,
+		IncomeCount:  incomeCount,
+	}), nil
+}
+
+const (
+	defaultTopExpensesLimit = 10
+	maxTopExpensesLimit     = 100
+)
+
+// GetTopExpenses returns the largest expenses in a date range, biggest first
+func (s *FinanceService) GetTopExpenses(ctx context.Context, req *connect.Request[pfinancev1.GetTopExpensesRequest]) (*connect.Response[pfinancev1.GetTopExpensesResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GroupId == "" {
+		if req.Msg.UserId != "" && req.Msg.UserId != claims.UID {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("cannot list another user's expenses"))
+		}
+	} else {
+		group, err := s.store.GetGroup(ctx, req.Msg.GroupId)
+		if err != nil {
+			return nil, auth.WrapStoreError("get group", err)
+		}
+		if !auth.IsGroupMember(claims.UID, group) {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("user is not a member of this group"))
+		}
+	}
+
+	startTime, endTime := auth.ConvertDateRange(req.Msg.StartDate, req.Msg.EndDate)
+
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultTopExpensesLimit
+	}
+	limit = min(limit, maxTopExpensesLimit)
+
+	userID := req.Msg.UserId
+	if userID == "" && req.Msg.GroupId == "" {
+		userID = claims.UID
+	}
+
+	expenses, err := s.store.ListTopExpenses(ctx, userID, req.Msg.GroupId, startTime, endTime, req.Msg.Category, limit)
+	if err != nil {
+		return nil, auth.WrapStoreError("list top expenses", err)
+	}
+
+	var totalCents int64
+	for _, expense := range expenses {
+		if expense.AmountCents == 0 {
+			expense.AmountCents = store.ToCents(expense.Amount)
+		}
+		totalCents += expense.AmountCents
+	}
+
+	return connect.NewResponse(&pfinancev1.GetTopExpensesResponse{
+		Expenses:   expenses,
+		TotalCents: totalCents,
 	}), nil
 }
 
+// maxTagFilterValues matches Firestore's limit on array-contains-any values.
+const maxTagFilterValues = 30
+
+// normalizeTags lowercases and trims tags, dropping empty ones and duplicates
+// while keeping first-seen order.
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || slices.Contains(normalized, tag) {
+			continue
+		}
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
 // CreateGroup creates a new finance group
 func (s *FinanceService) CreateGroup(ctx context.Context, req *connect.Request[pfinancev1.CreateGroupRequest]) (*connect.Response[pfinancev1.CreateGroupResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
@@ -490,7 +722,34 @@ func (s *FinanceService) DeleteUser(ctx context.Context, req *connect.Request[pf
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
-// ClearUserData deletes all financial data for a user but keeps the account
+// clearDataTokenTTL is how long a PrepareClearUserData token stays valid.
+const clearDataTokenTTL = 10 * time.Minute
+
+// PrepareClearUserData reports how much data ClearUserData would delete and
+// issues the confirmation token it requires.
+func (s *FinanceService) PrepareClearUserData(ctx context.Context, req *connect.Request[pfinancev1.PrepareClearUserDataRequest]) (*connect.Response[pfinancev1.PrepareClearUserDataResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.UserId != claims.UID {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot clear another user's data"))
+	}
+
+	preview, err := s.countClearableData(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	issuedAt := time.Now()
+	preview.ConfirmationToken = s.clearDataToken(req.Msg.UserId, issuedAt, preview)
+	preview.ExpiresAt = timestamppb.New(issuedAt.Add(clearDataTokenTTL))
+
+	return connect.NewResponse(preview), nil
+}
+
+// ClearUserData deletes all financial data for a user but keeps the account.
+// It requires a fresh token from PrepareClearUserData and leaves an audit record.
 func (s *FinanceService) ClearUserData(ctx context.Context, req *connect.Request[pfinancev1.ClearUserDataRequest]) (*connect.Response[emptypb.Empty], error) {
 	claims, err := auth.RequireAuth(ctx)
 	if err != nil {
@@ -504,14 +763,105 @@ func (s *FinanceService) ClearUserData(ctx context.Context, req *connect.Request
 	if !req.Msg.Confirm {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("must confirm data clearing"))
 	}
+	if req.Msg.ConfirmationToken == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("confirmation_token is required; call PrepareClearUserData first"))
+	}
+
+	issuedAt, ok := parseClearDataTokenTime(req.Msg.ConfirmationToken)
+	now := time.Now()
+	if !ok || now.Before(issuedAt.Add(-time.Minute)) || now.After(issuedAt.Add(clearDataTokenTTL)) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("confirmation token is invalid or expired"))
+	}
+
+	// Recounting ties the token to what the user was shown: if anything was
+	// added or removed since, they must review the new counts.
+	counts, err := s.countClearableData(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	expected := s.clearDataToken(req.Msg.UserId, issuedAt, counts)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(req.Msg.ConfirmationToken)) != 1 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("data changed since the clear was prepared; prepare again"))
+	}
 
 	if err := s.store.ClearUserData(ctx, req.Msg.UserId); err != nil {
 		return nil, auth.WrapStoreError("clear user data", err)
 	}
 
+	// The data is already gone, so a failed audit write is logged rather than
+	// reported as a failed clear.
+	record := &pfinancev1.DataClearRecord{
+		Id:                        uuid.New().String(),
+		UserId:                    req.Msg.UserId,
+		ClearedAt:                 timestamppb.New(now),
+		ExpenseCount:              counts.ExpenseCount,
+		IncomeCount:               counts.IncomeCount,
+		BudgetCount:               counts.BudgetCount,
+		GoalCount:                 counts.GoalCount,
+		RecurringTransactionCount: counts.RecurringTransactionCount,
+	}
+	if err := s.store.CreateDataClearRecord(ctx, record); err != nil {
+		log.Printf("[ClearUserData] failed to write audit record for user %s: %v", req.Msg.UserId, err)
+	}
+
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+// countClearableData counts the records ClearUserData deletes for a user.
+func (s *FinanceService) countClearableData(ctx context.Context, userID string) (*pfinancev1.PrepareClearUserDataResponse, error) {
+	expenseCount, err := s.store.CountExpenses(ctx, userID, "", nil, nil)
+	if err != nil {
+		return nil, auth.WrapStoreError("count expenses", err)
+	}
+	incomeCount, err := s.store.CountIncomes(ctx, userID, "", nil, nil)
+	if err != nil {
+		return nil, auth.WrapStoreError("count incomes", err)
+	}
+	budgets, _, err := s.store.ListBudgets(ctx, userID, "", true, 10000, "")
+	if err != nil {
+		return nil, auth.WrapStoreError("list budgets", err)
+	}
+	goals, _, err := s.store.ListGoals(ctx, userID, "", pfinancev1.GoalStatus_GOAL_STATUS_UNSPECIFIED, pfinancev1.GoalType_GOAL_TYPE_UNSPECIFIED, 10000, "")
+	if err != nil {
+		return nil, auth.WrapStoreError("list goals", err)
+	}
+	recurring, _, err := s.store.ListRecurringTransactions(ctx, userID, "", pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_UNSPECIFIED, false, false, 10000, "")
+	if err != nil {
+		return nil, auth.WrapStoreError("list recurring transactions", err)
+	}
+
+	return &pfinancev1.PrepareClearUserDataResponse{
+		ExpenseCount:              expenseCount,
+		IncomeCount:               incomeCount,
+		BudgetCount:               int64(len(budgets)),
+		GoalCount:                 int64(len(goals)),
+		RecurringTransactionCount: int64(len(recurring)),
+	}, nil
+}
+
+// clearDataToken binds a confirmation token to the user, the issue time and
+// the counts shown. It is signed with the server's token key, so a client
+// can't mint one without calling PrepareClearUserData.
+func (s *FinanceService) clearDataToken(userID string, issuedAt time.Time, counts *pfinancev1.PrepareClearUserDataResponse) string {
+	mac := hmac.New(sha256.New, s.tokenKey)
+	fmt.Fprintf(mac, "%s|%d|%d|%d|%d|%d|%d", userID, issuedAt.Unix(),
+		counts.ExpenseCount, counts.IncomeCount, counts.BudgetCount, counts.GoalCount, counts.RecurringTransactionCount)
+	return fmt.Sprintf("%d.%s", issuedAt.Unix(), hex.EncodeToString(mac.Sum(nil)))
+}
+
+// parseClearDataTokenTime extracts the issue time from a confirmation token.
+func parseClearDataTokenTime(token string) (time.Time, bool) {
+	issued, _, ok := strings.Cut(token, ".")
+	if !ok {
+		return time.Time{}, false
+	}
+	unix, err := strconv.ParseInt(issued, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(unix, 0), true
+}
+
 // ExportUserData exports all user data as JSON
 func (s *FinanceService) ExportUserData(ctx context.Context, req *connect.Request[pfinancev1.ExportUserDataRequest]) (*connect.Response[pfinancev1.ExportUserDataResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
@@ -524,8 +874,8 @@ func (s *FinanceService) ExportUserData(ctx context.Context, req *connect.Reques
 	}
 
 	// Fetch all user data
-	expenses, _, _ := s.store.ListExpenses(ctx, req.Msg.UserId, "", nil, nil, 10000, "")
-	incomes, _, _ := s.store.ListIncomes(ctx, req.Msg.UserId, "", nil, nil, 10000, "")
+	expenses, _, _ := s.store.ListExpenses(ctx, req.Msg.UserId, "", store.ExpenseQuery{}, 10000, "")
+	incomes, _, _ := s.store.ListIncomes(ctx, req.Msg.UserId, "", nil, nil, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 10000, "")
 	budgets, _, _ := s.store.ListBudgets(ctx, req.Msg.UserId, "", true, 10000, "")
 	goals, _, _ := s.store.ListGoals(ctx, req.Msg.UserId, "", 0, 0, 10000, "")
 	user, _ := s.store.GetUser(ctx, req.Msg.UserId)
@@ -608,58 +958,63 @@ func (s *FinanceService) AcceptInvitation(ctx context.Context, req *connect.Requ
 			fmt.Errorf("cannot accept invitation as another user"))
 	}
 
-	// Get the invitation
-	invitation, err := s.store.GetInvitation(ctx, req.Msg.InvitationId)
-	if err != nil {
-		return nil, auth.WrapStoreError("get invitation", err)
-	}
+	// Add the member and mark the invitation accepted together so a failure
+	// can't leave a member with a still-pending invitation or vice versa.
+	var group *pfinancev1.FinanceGroup
+	err = s.store.RunInTransaction(ctx, func(tx store.Store) error {
+		invitation, err := tx.GetInvitation(ctx, req.Msg.InvitationId)
+		if err != nil {
+			return auth.WrapStoreError("get invitation", err)
+		}
 
-	// Verify user's email matches invitation
-	if claims.Email != invitation.InviteeEmail {
-		return nil, connect.NewError(connect.CodePermissionDenied,
-			fmt.Errorf("invitation is for a different email address"))
-	}
+		// Verify user's email matches invitation
+		if claims.Email != invitation.InviteeEmail {
+			return connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("invitation is for a different email address"))
+		}
 
-	// Check if invitation is still pending
-	if invitation.Status != pfinancev1.InvitationStatus_INVITATION_STATUS_PENDING {
-		return nil, connect.NewError(connect.CodeFailedPrecondition,
-			fmt.Errorf("invitation is no longer pending"))
-	}
+		// Check if invitation is still pending
+		if invitation.Status != pfinancev1.InvitationStatus_INVITATION_STATUS_PENDING {
+			return connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("invitation is no longer pending"))
+		}
 
-	// Check if invitation is expired
-	if invitation.ExpiresAt.AsTime().Before(timestamppb.Now().AsTime()) {
-		return nil, connect.NewError(connect.CodeFailedPrecondition,
-			fmt.Errorf("invitation has expired"))
-	}
+		// Check if invitation is expired
+		if invitation.ExpiresAt.AsTime().Before(timestamppb.Now().AsTime()) {
+			return connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("invitation has expired"))
+		}
 
-	// Get the group
-	group, err := s.store.GetGroup(ctx, invitation.GroupId)
-	if err != nil {
-		return nil, auth.WrapStoreError("get group", err)
-	}
+		group, err = tx.GetGroup(ctx, invitation.GroupId)
+		if err != nil {
+			return auth.WrapStoreError("get group", err)
+		}
 
-	// Add user to group
-	newMember := &pfinancev1.GroupMember{
-		UserId:      req.Msg.UserId,
-		Email:       claims.Email,
-		DisplayName: claims.DisplayName,
-		Role:        invitation.Role,
-		JoinedAt:    timestamppb.Now(),
-	}
+		// Add user to group
+		newMember := &pfinancev1.GroupMember{
+			UserId:      req.Msg.UserId,
+			Email:       claims.Email,
+			DisplayName: claims.DisplayName,
+			Role:        invitation.Role,
+			JoinedAt:    timestamppb.Now(),
+		}
 
-	group.MemberIds = append(group.MemberIds, req.Msg.UserId)
-	group.Members = append(group.Members, newMember)
-	group.UpdatedAt = timestamppb.Now()
+		group.MemberIds = append(group.MemberIds, req.Msg.UserId)
+		group.Members = append(group.Members, newMember)
+		group.UpdatedAt = timestamppb.Now()
 
-	// Update group
-	if err := s.store.UpdateGroup(ctx, group); err != nil {
-		return nil, auth.WrapStoreError("update group", err)
-	}
+		if err := tx.UpdateGroup(ctx, group); err != nil {
+			return auth.WrapStoreError("update group", err)
+		}
 
-	// Update invitation status
-	invitation.Status = pfinancev1.InvitationStatus_INVITATION_STATUS_ACCEPTED
-	if err := s.store.UpdateInvitation(ctx, invitation); err != nil {
-		return nil, auth.WrapStoreError("update invitation", err)
+		invitation.Status = pfinancev1.InvitationStatus_INVITATION_STATUS_ACCEPTED
+		if err := tx.UpdateInvitation(ctx, invitation); err != nil {
+			return auth.WrapStoreError("update invitation", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return connect.NewResponse(&pfinancev1.AcceptInvitationResponse{
@@ -698,7 +1053,15 @@ func (s *FinanceService) CreateIncome(ctx context.Context, req *connect.Request[
 	if incAmountCents != 0 && incAmount == 0 {
 		incAmount = float64(incAmountCents) / 100.0
 	} else if incAmount != 0 && incAmountCents == 0 {
-		incAmountCents = int64(incAmount * 100)
+		incAmountCents = store.ToCents(incAmount)
+	}
+
+	if err := normalizeIncomeDeductions(req.Msg.Deductions, incAmountCents); err != nil {
+		return nil, err
+	}
+	gstCents, err := resolveGstCents(req.Msg.GstCents, req.Msg.IsGstRegistered, incAmountCents)
+	if err != nil {
+		return nil, err
 	}
 
 	income := &pfinancev1.Income{
@@ -714,6 +1077,7 @@ func (s *FinanceService) CreateIncome(ctx context.Context, req *connect.Request[
 		Date:        req.Msg.Date,
 		CreatedAt:   timestamppb.Now(),
 		UpdatedAt:   timestamppb.Now(),
+		GstCents:    gstCents,
 	}
 
 	if err := s.store.CreateIncome(ctx, income); err != nil {
@@ -731,6 +1095,34 @@ func (s *FinanceService) CreateIncome(ctx context.Context, req *connect.Request[
 	}), nil
 }
 
+// normalizeIncomeDeductions dual-writes each deduction's amount and cents, then
+// checks none is negative and together they don't exceed the income, since
+// deductions feed the withheld totals in tax calculations.
+func normalizeIncomeDeductions(deductions []*pfinancev1.Deduction, incomeCents int64) error {
+	var totalCents int64
+	for _, d := range deductions {
+		if d == nil {
+			return connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("deduction must not be empty"))
+		}
+		if d.AmountCents == 0 && d.Amount != 0 {
+			d.AmountCents = store.ToCents(d.Amount)
+		} else if d.Amount == 0 && d.AmountCents != 0 {
+			d.Amount = float64(d.AmountCents) / 100.0
+		}
+		if d.AmountCents < 0 {
+			return connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("deduction %q amount must not be negative", d.Name))
+		}
+		totalCents += d.AmountCents
+	}
+	if totalCents > incomeCents {
+		return connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("deductions total %d cents exceeds income amount %d cents", totalCents, incomeCents))
+	}
+	return nil
+}
+
 // GetIncome retrieves a single income by ID
 func (s *FinanceService) GetIncome(ctx context.Context, req *connect.Request[pfinancev1.GetIncomeRequest]) (*connect.Response[pfinancev1.GetIncomeResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
@@ -762,9 +1154,16 @@ func (s *FinanceService) GetIncome(ctx context.Context, req *connect.Request[pfi
 		}
 	}
 
-	return connect.NewResponse(&pfinancev1.GetIncomeResponse{
+	resp := &pfinancev1.GetIncomeResponse{
 		Income: income,
-	}), nil
+	}
+	if req.Msg.IncludeContributions {
+		resp.Contributions, err = s.store.ListIncomeContributionsForIncome(ctx, income.Id)
+		if err != nil {
+			return nil, auth.WrapStoreError("list income contributions", err)
+		}
+	}
+	return connect.NewResponse(resp), nil
 }
 
 // GetTaxConfig gets tax configuration for a user or group
@@ -818,16 +1217,24 @@ func (s *FinanceService) ListGroups(ctx context.Context, req *connect.Request[pf
 			fmt.Errorf("cannot list another user's groups"))
 	}
 
-	pageSize := auth.NormalizePageSize(req.Msg.PageSize)
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
 
-	groups, nextPageToken, err := s.store.ListGroups(ctx, userID, pageSize, req.Msg.PageToken)
+	fingerprint := store.QueryFingerprint(userID)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	groups, nextPageToken, err := s.store.ListGroups(ctx, userID, pageSize, pageToken)
 	if err != nil {
 		return nil, auth.WrapStoreError("list groups", err)
 	}
 
 	return connect.NewResponse(&pfinancev1.ListGroupsResponse{
 		Groups:        groups,
-		NextPageToken: nextPageToken,
+		NextPageToken: store.EncodeQueryPageToken(nextPageToken, fingerprint),
 	}), nil
 }
 
@@ -847,21 +1254,29 @@ func (s *FinanceService) ListInvitations(ctx context.Context, req *connect.Reque
 			fmt.Errorf("cannot list invitations for another email address"))
 	}
 
-	pageSize := auth.NormalizePageSize(req.Msg.PageSize)
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
 
 	var status *pfinancev1.InvitationStatus
 	if req.Msg.Status != pfinancev1.InvitationStatus_INVITATION_STATUS_UNSPECIFIED {
 		status = &req.Msg.Status
 	}
 
-	invitations, nextPageToken, err := s.store.ListInvitations(ctx, userEmail, status, pageSize, req.Msg.PageToken)
+	fingerprint := store.QueryFingerprint(userEmail, status)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	invitations, nextPageToken, err := s.store.ListInvitations(ctx, userEmail, status, pageSize, pageToken)
 	if err != nil {
 		return nil, auth.WrapStoreError("list invitations", err)
 	}
 
 	return connect.NewResponse(&pfinancev1.ListInvitationsResponse{
 		Invitations:   invitations,
-		NextPageToken: nextPageToken,
+		NextPageToken: store.EncodeQueryPageToken(nextPageToken, fingerprint),
 	}), nil
 }
 
@@ -898,6 +1313,14 @@ func (s *FinanceService) UpdateExpense(ctx context.Context, req *connect.Request
 		}
 	}
 
+	if err := checkExpenseUnlocked(expense); err != nil {
+		return nil, err
+	}
+	previousCents := expense.AmountCents
+	if previousCents == 0 {
+		previousCents = store.ToCents(expense.Amount)
+	}
+
 	// Update fields
 	if req.Msg.Description != "" {
 		expense.Description = req.Msg.Description
@@ -926,7 +1349,7 @@ func (s *FinanceService) UpdateExpense(ctx context.Context, req *connect.Request
 		expense.SplitType = req.Msg.SplitType
 	}
 	if len(req.Msg.Tags) > 0 {
-		expense.Tags = req.Msg.Tags
+		expense.Tags = normalizeTags(req.Msg.Tags)
 	}
 
 	// Update tax deduction fields (always apply — false/0 are valid values for clearing)
@@ -940,6 +1363,7 @@ func (s *FinanceService) UpdateExpense(ctx context.Context, req *connect.Request
 	} else {
 		expense.TaxDeductiblePercent = 0
 	}
+	expense.TaxNeedsReview = false
 
 	// Update receipt fields (conditional — only if provided)
 	if req.Msg.ReceiptUrl != "" {
@@ -948,8 +1372,14 @@ func (s *FinanceService) UpdateExpense(ctx context.Context, req *connect.Request
 	if req.Msg.ReceiptStoragePath != "" {
 		expense.ReceiptStoragePath = req.Msg.ReceiptStoragePath
 	}
-
-	// Recalculate allocations if split type or amount changed
+	if req.Msg.Note != nil {
+		expense.Note = strings.TrimSpace(*req.Msg.Note)
+	}
+	if expense.GstCents, err = updatedGstCents(expense.GstCents, previousCents, req.Msg.GstCents, req.Msg.IsGstRegistered, expense.AmountCents); err != nil {
+		return nil, err
+	}
+
+	// Recalculate allocations if split type or amount changed
 	if req.Msg.SplitType != pfinancev1.SplitType_SPLIT_TYPE_UNSPECIFIED || req.Msg.Amount > 0 {
 		createReq := &pfinancev1.CreateExpenseRequest{
 			UserId:           expense.UserId,
@@ -1011,6 +1441,10 @@ func (s *FinanceService) DeleteExpense(ctx context.Context, req *connect.Request
 		}
 	}
 
+	if err := checkExpenseUnlocked(expense); err != nil {
+		return nil, err
+	}
+
 	if err := s.store.DeleteExpense(ctx, req.Msg.ExpenseId); err != nil {
 		return nil, auth.WrapStoreError("delete expense", err)
 	}
@@ -1031,7 +1465,8 @@ func (s *FinanceService) BatchDeleteExpenses(ctx context.Context, req *connect.R
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max 100 expenses per batch"))
 	}
 
-	// Verify ownership of all expenses before deleting
+	// Verify ownership of all expenses before deleting; expenses in a locked
+	// financial year are reported as failed rather than deleted
 	var verifiedIDs []string
 	var failedIDs []string
 	for _, expenseID := range req.Msg.ExpenseIds {
@@ -1040,7 +1475,7 @@ func (s *FinanceService) BatchDeleteExpenses(ctx context.Context, req *connect.R
 			failedIDs = append(failedIDs, expenseID)
 			continue
 		}
-		if expense.UserId != claims.UID {
+		if expense.UserId != claims.UID || expense.Locked {
 			failedIDs = append(failedIDs, expenseID)
 			continue
 		}
@@ -1059,6 +1494,131 @@ func (s *FinanceService) BatchDeleteExpenses(ctx context.Context, req *connect.R
 	}), nil
 }
 
+// maxExpenseAttachments bounds the attachment list so expense documents stay small.
+const maxExpenseAttachments = 20
+
+// AddExpenseAttachment attaches a stored file reference to an expense.
+func (s *FinanceService) AddExpenseAttachment(ctx context.Context, req *connect.Request[pfinancev1.AddExpenseAttachmentRequest]) (*connect.Response[pfinancev1.AddExpenseAttachmentResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := req.Msg.Attachment
+	if attachment == nil || attachment.StoragePath == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("attachment storage_path is required"))
+	}
+
+	expense, err := s.getEditableExpense(ctx, claims.UID, req.Msg.ExpenseId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range expense.Attachments {
+		if a.StoragePath == attachment.StoragePath {
+			return nil, connect.NewError(connect.CodeAlreadyExists,
+				fmt.Errorf("attachment %s already exists on this expense", attachment.StoragePath))
+		}
+	}
+	if len(expense.Attachments) >= maxExpenseAttachments {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("expense already has the maximum of %d attachments", maxExpenseAttachments))
+	}
+
+	if attachment.UploadedAt == nil {
+		attachment.UploadedAt = timestamppb.Now()
+	}
+	expense.Attachments = append(expense.Attachments, attachment)
+	expense.UpdatedAt = timestamppb.Now()
+
+	if err := s.store.UpdateExpense(ctx, expense); err != nil {
+		return nil, auth.WrapStoreError("update expense", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.AddExpenseAttachmentResponse{
+		Expense: expense,
+	}), nil
+}
+
+// RemoveExpenseAttachment detaches a file reference from an expense. The
+// stored file itself is left in place.
+func (s *FinanceService) RemoveExpenseAttachment(ctx context.Context, req *connect.Request[pfinancev1.RemoveExpenseAttachmentRequest]) (*connect.Response[pfinancev1.RemoveExpenseAttachmentResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.StoragePath == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("storage_path is required"))
+	}
+
+	expense, err := s.getEditableExpense(ctx, claims.UID, req.Msg.ExpenseId)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := -1
+	for i, a := range expense.Attachments {
+		if a.StoragePath == req.Msg.StoragePath {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return nil, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("attachment %s not found on this expense", req.Msg.StoragePath))
+	}
+
+	expense.Attachments = append(expense.Attachments[:idx], expense.Attachments[idx+1:]...)
+	expense.UpdatedAt = timestamppb.Now()
+
+	if err := s.store.UpdateExpense(ctx, expense); err != nil {
+		return nil, auth.WrapStoreError("update expense", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.RemoveExpenseAttachmentResponse{
+		Expense: expense,
+	}), nil
+}
+
+// getEditableExpense loads an expense and checks the caller may modify it,
+// using the same rules as UpdateExpense: personal expenses require ownership,
+// group expenses require group membership, and locked expenses are rejected.
+func (s *FinanceService) getEditableExpense(ctx context.Context, userID, expenseID string) (*pfinancev1.Expense, error) {
+	if expenseID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("expense_id is required"))
+	}
+
+	expense, err := s.store.GetExpense(ctx, expenseID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("expense not found"))
+	}
+
+	if expense.GroupId == "" {
+		if expense.UserId != userID {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("cannot modify another user's expense"))
+		}
+	} else {
+		group, err := s.store.GetGroup(ctx, expense.GroupId)
+		if err != nil {
+			return nil, auth.WrapStoreError("get group", err)
+		}
+		if !auth.IsGroupMember(userID, group) {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("user is not a member of this group"))
+		}
+	}
+	if err := checkExpenseUnlocked(expense); err != nil {
+		return nil, err
+	}
+	return expense, nil
+}
+
 func (s *FinanceService) BatchCreateExpenses(ctx context.Context, req *connect.Request[pfinancev1.BatchCreateExpensesRequest]) (*connect.Response[pfinancev1.BatchCreateExpensesResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
 	if err != nil {
@@ -1114,7 +1674,7 @@ func (s *FinanceService) BatchCreateExpenses(ctx context.Context, req *connect.R
 			UpdatedAt:    timestamppb.Now(),
 			PaidByUserId: paidByUserId,
 			SplitType:    expReq.SplitType,
-			Tags:         expReq.Tags,
+			Tags:         normalizeTags(expReq.Tags),
 			IsSettled:    false,
 		}
 
@@ -1139,6 +1699,81 @@ func (s *FinanceService) BatchCreateExpenses(ctx context.Context, req *connect.R
 	}), nil
 }
 
+// maxRepeatingExpenseDays caps the number of days, counting both ends, that
+// QuickAddRepeatingExpense spans.
+const maxRepeatingExpenseDays = 366
+
+// QuickAddRepeatingExpense creates the template expense once for each day in
+// the range that falls on one of the requested weekdays, through
+// BatchCreateExpenses.
+func (s *FinanceService) QuickAddRepeatingExpense(ctx context.Context, req *connect.Request[pfinancev1.QuickAddRepeatingExpenseRequest]) (*connect.Response[pfinancev1.QuickAddRepeatingExpenseResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	template := req.Msg.Template
+	if template == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("template is required"))
+	}
+	if req.Msg.StartDate == nil || req.Msg.EndDate == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("start_date and end_date are required"))
+	}
+	start := req.Msg.StartDate.AsTime()
+	end := req.Msg.EndDate.AsTime()
+	if end.Before(start) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("end_date must not be before start_date"))
+	}
+	// The range includes both ends, so the last allowed day is one short
+	if end.After(start.AddDate(0, 0, maxRepeatingExpenseDays-1)) {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("date range cannot span more than %d days", maxRepeatingExpenseDays))
+	}
+
+	days := make(map[time.Weekday]bool, len(req.Msg.DaysOfWeek))
+	for _, d := range req.Msg.DaysOfWeek {
+		if d < pfinancev1.DayOfWeek_DAY_OF_WEEK_SUNDAY || d > pfinancev1.DayOfWeek_DAY_OF_WEEK_SATURDAY {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid day of week %v", d))
+		}
+		days[time.Weekday(d-pfinancev1.DayOfWeek_DAY_OF_WEEK_SUNDAY)] = true
+	}
+
+	userID := template.UserId
+	if userID == "" {
+		userID = claims.UID
+	}
+	var expenseReqs []*pfinancev1.CreateExpenseRequest
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		if len(days) > 0 && !days[day.Weekday()] {
+			continue
+		}
+		expReq := proto.Clone(template).(*pfinancev1.CreateExpenseRequest)
+		expReq.UserId = userID
+		expReq.Date = timestamppb.New(day)
+		expenseReqs = append(expenseReqs, expReq)
+	}
+	if len(expenseReqs) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no days in the range match days_of_week"))
+	}
+
+	created, err := s.BatchCreateExpenses(ctx, connect.NewRequest(&pfinancev1.BatchCreateExpensesRequest{
+		UserId:   userID,
+		GroupId:  template.GroupId,
+		Expenses: expenseReqs,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(created.Msg.Expenses))
+	for i, e := range created.Msg.Expenses {
+		ids[i] = e.Id
+	}
+	return connect.NewResponse(&pfinancev1.QuickAddRepeatingExpenseResponse{
+		ExpenseIds: ids,
+	}), nil
+}
+
 func (s *FinanceService) UpdateIncome(ctx context.Context, req *connect.Request[pfinancev1.UpdateIncomeRequest]) (*connect.Response[pfinancev1.UpdateIncomeResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
 	if err != nil {
@@ -1170,6 +1805,10 @@ func (s *FinanceService) UpdateIncome(ctx context.Context, req *connect.Request[
 		}
 	}
 
+	previousCents := income.AmountCents
+	if previousCents == 0 {
+		previousCents = store.ToCents(income.Amount)
+	}
 	if req.Msg.Source != "" {
 		income.Source = req.Msg.Source
 	}
@@ -1179,10 +1818,13 @@ func (s *FinanceService) UpdateIncome(ctx context.Context, req *connect.Request[
 		if uIncAmtCents != 0 && uIncAmt == 0 {
 			uIncAmt = float64(uIncAmtCents) / 100.0
 		} else if uIncAmt != 0 && uIncAmtCents == 0 {
-			uIncAmtCents = int64(uIncAmt * 100)
+			uIncAmtCents = store.ToCents(uIncAmt)
 		}
 		income.Amount = uIncAmt
 		income.AmountCents = uIncAmtCents
+	} else if income.AmountCents == 0 && income.Amount != 0 {
+		// Back-fill legacy dollars-only rows so their deductions validate
+		income.AmountCents = store.ToCents(income.Amount)
 	}
 	if req.Msg.Frequency != pfinancev1.IncomeFrequency_INCOME_FREQUENCY_UNSPECIFIED {
 		income.Frequency = req.Msg.Frequency
@@ -1193,6 +1835,14 @@ func (s *FinanceService) UpdateIncome(ctx context.Context, req *connect.Request[
 	if len(req.Msg.Deductions) > 0 {
 		income.Deductions = req.Msg.Deductions
 	}
+	// Validate the merged result so a lowered amount can't fall below
+	// deductions that were stored earlier
+	if err := normalizeIncomeDeductions(income.Deductions, income.AmountCents); err != nil {
+		return nil, err
+	}
+	if income.GstCents, err = updatedGstCents(income.GstCents, previousCents, req.Msg.GstCents, req.Msg.IsGstRegistered, income.AmountCents); err != nil {
+		return nil, err
+	}
 	income.UpdatedAt = timestamppb.Now()
 
 	if err := s.store.UpdateIncome(ctx, income); err != nil {
@@ -1242,6 +1892,48 @@ func (s *FinanceService) DeleteIncome(ctx context.Context, req *connect.Request[
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+// BatchDeleteIncomes deletes multiple incomes with ownership verification.
+func (s *FinanceService) BatchDeleteIncomes(ctx context.Context, req *connect.Request[pfinancev1.BatchDeleteIncomesRequest]) (*connect.Response[pfinancev1.BatchDeleteIncomesResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Msg.IncomeIds) == 0 {
+		return connect.NewResponse(&pfinancev1.BatchDeleteIncomesResponse{}), nil
+	}
+	if len(req.Msg.IncomeIds) > 100 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max 100 incomes per batch"))
+	}
+
+	// Verify ownership of all incomes before deleting
+	var verifiedIDs []string
+	var failedIDs []string
+	for _, incomeID := range req.Msg.IncomeIds {
+		income, err := s.store.GetIncome(ctx, incomeID)
+		if err != nil {
+			failedIDs = append(failedIDs, incomeID)
+			continue
+		}
+		if income.UserId != claims.UID {
+			failedIDs = append(failedIDs, incomeID)
+			continue
+		}
+		verifiedIDs = append(verifiedIDs, incomeID)
+	}
+
+	if len(verifiedIDs) > 0 {
+		if err := s.store.BatchDeleteIncomes(ctx, verifiedIDs); err != nil {
+			return nil, auth.WrapStoreError("batch delete incomes", err)
+		}
+	}
+
+	return connect.NewResponse(&pfinancev1.BatchDeleteIncomesResponse{
+		DeletedCount:    int32(len(verifiedIDs)),
+		FailedIncomeIds: failedIDs,
+	}), nil
+}
+
 func (s *FinanceService) ListIncomes(ctx context.Context, req *connect.Request[pfinancev1.ListIncomesRequest]) (*connect.Response[pfinancev1.ListIncomesResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
 	if err != nil {
@@ -1267,7 +1959,10 @@ func (s *FinanceService) ListIncomes(ctx context.Context, req *connect.Request[p
 	}
 
 	startTime, endTime := auth.ConvertDateRange(req.Msg.StartDate, req.Msg.EndDate)
-	pageSize := auth.NormalizePageSize(req.Msg.PageSize)
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
 
 	// Use authenticated user ID if not specified
 	userID := req.Msg.UserId
@@ -1275,14 +1970,19 @@ func (s *FinanceService) ListIncomes(ctx context.Context, req *connect.Request[p
 		userID = claims.UID
 	}
 
-	incomes, nextPageToken, err := s.store.ListIncomes(ctx, userID, req.Msg.GroupId, startTime, endTime, pageSize, req.Msg.PageToken)
+	fingerprint := store.QueryFingerprint(userID, req.Msg.GroupId, startTime, endTime, req.Msg.Source, req.Msg.SortField, req.Msg.SortDirection)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	incomes, nextPageToken, err := s.store.ListIncomes(ctx, userID, req.Msg.GroupId, startTime, endTime, req.Msg.Source, req.Msg.SortField, req.Msg.SortDirection, pageSize, pageToken)
 	if err != nil {
 		return nil, auth.WrapStoreError("list incomes", err)
 	}
 
 	return connect.NewResponse(&pfinancev1.ListIncomesResponse{
 		Incomes:       incomes,
-		NextPageToken: nextPageToken,
+		NextPageToken: store.EncodeQueryPageToken(nextPageToken, fingerprint),
 	}), nil
 }
 
@@ -1310,6 +2010,11 @@ func (s *FinanceService) UpdateTaxConfig(ctx context.Context, req *connect.Reque
 		}
 	}
 
+	if month := req.Msg.TaxConfig.GetFiscalYearStartMonth(); month < 0 || month > 12 {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("fiscal_year_start_month must be between 1 and 12, or 0 for July"))
+	}
+
 	if err := s.store.UpdateTaxConfig(ctx, req.Msg.UserId, req.Msg.GroupId, req.Msg.TaxConfig); err != nil {
 		return nil, auth.WrapStoreError("update tax config", err)
 	}
@@ -1329,13 +2034,20 @@ func (s *FinanceService) CreateBudget(ctx context.Context, req *connect.Request[
 	}
 
 	// For personal budget, verify ownership
+	userID := req.Msg.UserId
 	if req.Msg.GroupId == "" {
-		if req.Msg.UserId != claims.UID {
+		if userID != claims.UID {
 			return nil, connect.NewError(connect.CodePermissionDenied,
 				fmt.Errorf("cannot create budget for another user"))
 		}
 	} else {
-		// For group budget, verify group membership
+		// For group budget, record the caller as creator and verify group membership
+		if userID == "" {
+			userID = claims.UID
+		} else if userID != claims.UID {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("cannot create budget for another user"))
+		}
 		group, err := s.store.GetGroup(ctx, req.Msg.GroupId)
 		if err != nil {
 			return nil, auth.WrapStoreError("get group", err)
@@ -1354,22 +2066,26 @@ func (s *FinanceService) CreateBudget(ctx context.Context, req *connect.Request[
 	} else if budgetAmt != 0 && budgetAmtCents == 0 {
 		budgetAmtCents = int64(budgetAmt * 100)
 	}
+	if err := validateCategoryCaps(req.Msg.CategoryCaps, req.Msg.CategoryIds, budgetAmtCents); err != nil {
+		return nil, err
+	}
 
 	budget := &pfinancev1.Budget{
-		Id:          uuid.New().String(),
-		UserId:      req.Msg.UserId,
-		GroupId:     req.Msg.GroupId,
-		Name:        req.Msg.Name,
-		Description: req.Msg.Description,
-		Amount:      budgetAmt,
-		AmountCents: budgetAmtCents,
-		Period:      req.Msg.Period,
-		CategoryIds: req.Msg.CategoryIds,
-		IsActive:    true,
-		StartDate:   req.Msg.StartDate,
-		EndDate:     req.Msg.EndDate,
-		CreatedAt:   timestamppb.Now(),
-		UpdatedAt:   timestamppb.Now(),
+		Id:           uuid.New().String(),
+		UserId:       userID,
+		GroupId:      req.Msg.GroupId,
+		Name:         req.Msg.Name,
+		Description:  req.Msg.Description,
+		Amount:       budgetAmt,
+		AmountCents:  budgetAmtCents,
+		Period:       req.Msg.Period,
+		CategoryIds:  req.Msg.CategoryIds,
+		CategoryCaps: req.Msg.CategoryCaps,
+		IsActive:     true,
+		StartDate:    req.Msg.StartDate,
+		EndDate:      req.Msg.EndDate,
+		CreatedAt:    timestamppb.Now(),
+		UpdatedAt:    timestamppb.Now(),
 	}
 
 	if err := s.store.CreateBudget(ctx, budget); err != nil {
@@ -1461,10 +2177,22 @@ func (s *FinanceService) UpdateBudget(ctx context.Context, req *connect.Request[
 	} else if uBudgetAmt != 0 && uBudgetAmtCents == 0 {
 		uBudgetAmtCents = int64(uBudgetAmt * 100)
 	}
+	// Caps are kept unless the request replaces or clears them, so clients
+	// that don't know about caps can't wipe them
+	caps := existing.CategoryCaps
+	if req.Msg.ClearCategoryCaps {
+		caps = nil
+	} else if len(req.Msg.CategoryCaps) > 0 {
+		caps = req.Msg.CategoryCaps
+	}
+	if err := validateCategoryCaps(caps, req.Msg.CategoryIds, uBudgetAmtCents); err != nil {
+		return nil, err
+	}
 	existing.Amount = uBudgetAmt
 	existing.AmountCents = uBudgetAmtCents
 	existing.Period = req.Msg.Period
 	existing.CategoryIds = req.Msg.CategoryIds
+	existing.CategoryCaps = caps
 	existing.IsActive = req.Msg.IsActive
 	if req.Msg.EndDate != nil {
 		existing.EndDate = req.Msg.EndDate
@@ -1480,6 +2208,35 @@ func (s *FinanceService) UpdateBudget(ctx context.Context, req *connect.Request[
 	}), nil
 }
 
+// validateCategoryCaps checks a budget's category caps: each names a distinct
+// category the budget covers, is positive, and together they fit within the
+// budget's total.
+func validateCategoryCaps(caps []*pfinancev1.CategoryCap, categoryIDs []pfinancev1.ExpenseCategory, totalCents int64) error {
+	seen := make(map[pfinancev1.ExpenseCategory]bool, len(caps))
+	var sum int64
+	for _, c := range caps {
+		if c.CapCents <= 0 {
+			return connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("cap for %s must be positive", c.Category))
+		}
+		if seen[c.Category] {
+			return connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("duplicate cap for %s", c.Category))
+		}
+		seen[c.Category] = true
+		if len(categoryIDs) > 0 && !slices.Contains(categoryIDs, c.Category) {
+			return connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("cap for %s is outside the budget's categories", c.Category))
+		}
+		sum += c.CapCents
+	}
+	if sum > totalCents {
+		return connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("category caps total %d cents, more than the budget's %d", sum, totalCents))
+	}
+	return nil
+}
+
 // DeleteBudget deletes a budget
 func (s *FinanceService) DeleteBudget(ctx context.Context, req *connect.Request[pfinancev1.DeleteBudgetRequest]) (*connect.Response[emptypb.Empty], error) {
 	claims, err := auth.RequireAuth(ctx)
@@ -1544,7 +2301,10 @@ func (s *FinanceService) ListBudgets(ctx context.Context, req *connect.Request[p
 		}
 	}
 
-	pageSize := auth.NormalizePageSize(req.Msg.PageSize)
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
 
 	// Use authenticated user ID if not specified
 	userID := req.Msg.UserId
@@ -1552,14 +2312,19 @@ func (s *FinanceService) ListBudgets(ctx context.Context, req *connect.Request[p
 		userID = claims.UID
 	}
 
-	budgets, nextPageToken, err := s.store.ListBudgets(ctx, userID, req.Msg.GroupId, req.Msg.IncludeInactive, pageSize, req.Msg.PageToken)
+	fingerprint := store.QueryFingerprint(userID, req.Msg.GroupId, req.Msg.IncludeInactive)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	budgets, nextPageToken, err := s.store.ListBudgets(ctx, userID, req.Msg.GroupId, req.Msg.IncludeInactive, pageSize, pageToken)
 	if err != nil {
 		return nil, auth.WrapStoreError("list budgets", err)
 	}
 
 	return connect.NewResponse(&pfinancev1.ListBudgetsResponse{
 		Budgets:       budgets,
-		NextPageToken: nextPageToken,
+		NextPageToken: store.EncodeQueryPageToken(nextPageToken, fingerprint),
 	}), nil
 }
 
@@ -1610,6 +2375,52 @@ func (s *FinanceService) GetBudgetProgress(ctx context.Context, req *connect.Req
 	}), nil
 }
 
+// GetAllBudgetProgress gets the current progress of every active budget for a
+// user or group in one call.
+func (s *FinanceService) GetAllBudgetProgress(ctx context.Context, req *connect.Request[pfinancev1.GetAllBudgetProgressRequest]) (*connect.Response[pfinancev1.GetAllBudgetProgressResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// For personal budgets, verify ownership
+	if req.Msg.GroupId == "" {
+		if req.Msg.UserId != "" && req.Msg.UserId != claims.UID {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("cannot access another user's budget progress"))
+		}
+	} else {
+		// For group budgets, verify group membership
+		group, err := s.store.GetGroup(ctx, req.Msg.GroupId)
+		if err != nil {
+			return nil, auth.WrapStoreError("get group", err)
+		}
+		if !auth.IsGroupMember(claims.UID, group) {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("user is not a member of this group"))
+		}
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" && req.Msg.GroupId == "" {
+		userID = claims.UID
+	}
+
+	asOfDate := time.Now()
+	if req.Msg.AsOfDate != nil {
+		asOfDate = req.Msg.AsOfDate.AsTime()
+	}
+
+	progress, err := s.store.GetAllBudgetProgress(ctx, userID, req.Msg.GroupId, asOfDate)
+	if err != nil {
+		return nil, auth.WrapStoreError("get all budget progress", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.GetAllBudgetProgressResponse{
+		Progress: progress,
+	}), nil
+}
+
 func (s *FinanceService) GetGroup(ctx context.Context, req *connect.Request[pfinancev1.GetGroupRequest]) (*connect.Response[pfinancev1.GetGroupResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
 	if err != nil {
@@ -1893,7 +2704,7 @@ func (s *FinanceService) GetMemberBalances(ctx context.Context, req *connect.Req
 
 	startTime, endTime := auth.ConvertDateRange(req.Msg.StartDate, req.Msg.EndDate)
 
-	expenses, _, err := s.store.ListExpenses(ctx, "", req.Msg.GroupId, startTime, endTime, 1000, "")
+	expenses, _, err := s.store.ListExpenses(ctx, "", req.Msg.GroupId, store.ExpenseQuery{StartDate: startTime, EndDate: endTime}, 1000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list expenses", err)
 	}
@@ -1940,6 +2751,10 @@ func (s *FinanceService) SettleExpense(ctx context.Context, req *connect.Request
 		}
 	}
 
+	if err := checkExpenseUnlocked(expense); err != nil {
+		return nil, err
+	}
+
 	var updatedAllocation *pfinancev1.ExpenseAllocation
 	allSettled := true
 
@@ -1992,12 +2807,12 @@ func (s *FinanceService) GetGroupSummary(ctx context.Context, req *connect.Reque
 
 	startTime, endTime := auth.ConvertDateRange(req.Msg.StartDate, req.Msg.EndDate)
 
-	expenses, _, err := s.store.ListExpenses(ctx, "", req.Msg.GroupId, startTime, endTime, 1000, "")
+	expenses, _, err := s.store.ListExpenses(ctx, "", req.Msg.GroupId, store.ExpenseQuery{StartDate: startTime, EndDate: endTime}, 1000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list expenses", err)
 	}
 
-	incomes, _, err := s.store.ListIncomes(ctx, "", req.Msg.GroupId, startTime, endTime, 1000, "")
+	incomes, _, err := s.store.ListIncomes(ctx, "", req.Msg.GroupId, startTime, endTime, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 1000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list incomes", err)
 	}
@@ -2054,26 +2869,140 @@ func (s *FinanceService) GetGroupSummary(ctx context.Context, req *connect.Reque
 	}), nil
 }
 
-// computeMemberBalancesFromExpenses calculates member balances from a pre-fetched list of expenses.
-// Used by both GetGroupSummary (reuses already-fetched data) and GetMemberBalances.
-func computeMemberBalancesFromExpenses(expenses []*pfinancev1.Expense, groupID string) []*pfinancev1.MemberBalance {
-	userPaid := make(map[string]float64)
-	userOwed := make(map[string]float64)
-
-	for _, expense := range expenses {
-		userPaid[expense.PaidByUserId] += expense.Amount
-		for _, alloc := range expense.Allocations {
-			if !alloc.IsPaid {
-				userOwed[alloc.UserId] += alloc.Amount
-			}
-		}
+// GetGroupSettlement computes who owes whom in a group. Each member's
+// contributions are compared with their fair share of the group total, split
+// equally or by the requested weights, and the resulting balances are settled
+// with as few transfers as the greedy matching finds.
+func (s *FinanceService) GetGroupSettlement(ctx context.Context, req *connect.Request[pfinancev1.GetGroupSettlementRequest]) (*connect.Response[pfinancev1.GetGroupSettlementResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	balances := make([]*pfinancev1.MemberBalance, 0)
-	for userID := range userPaid {
-		paid := userPaid[userID]
-		owed := userOwed[userID]
-		balances = append(balances, &pfinancev1.MemberBalance{
+	group, err := s.store.GetGroup(ctx, req.Msg.GroupId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("group not found"))
+	}
+	if !auth.IsGroupMember(claims.UID, group) {
+		return nil, connect.NewError(connect.CodePermissionDenied,
+			fmt.Errorf("user is not a member of this group"))
+	}
+
+	weights := req.Msg.SplitWeights
+	if len(weights) == 0 {
+		weights = make(map[string]float64)
+		for _, memberID := range group.MemberIds {
+			weights[memberID] = 1
+		}
+		if group.OwnerId != "" {
+			weights[group.OwnerId] = 1
+		}
+	} else {
+		var totalWeight float64
+		for userID, w := range weights {
+			if !auth.IsGroupMember(userID, group) {
+				return nil, connect.NewError(connect.CodeInvalidArgument,
+					fmt.Errorf("split weight given for non-member %s", userID))
+			}
+			if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+				return nil, connect.NewError(connect.CodeInvalidArgument,
+					fmt.Errorf("split weight for %s must be a non-negative number", userID))
+			}
+			totalWeight += w
+		}
+		if totalWeight <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("split weights must not all be zero"))
+		}
+	}
+
+	startTime, endTime := auth.ConvertDateRange(req.Msg.StartDate, req.Msg.EndDate)
+
+	paidCents := make(map[string]int64)
+	var totalCents int64
+	var pageToken string
+	for {
+		contributions, nextToken, err := s.store.ListContributions(ctx, req.Msg.GroupId, "", 500, pageToken)
+		if err != nil {
+			return nil, auth.WrapStoreError("list contributions", err)
+		}
+		for _, c := range contributions {
+			if c.ContributedAt != nil {
+				at := c.ContributedAt.AsTime()
+				if (startTime != nil && at.Before(*startTime)) || (endTime != nil && at.After(*endTime)) {
+					continue
+				}
+			}
+			cents := c.AmountCents
+			if cents == 0 {
+				cents = int64(math.Round(c.Amount * 100))
+			}
+			paidCents[c.ContributedBy] += cents
+			totalCents += cents
+		}
+		if nextToken == "" {
+			break
+		}
+		pageToken = nextToken
+	}
+
+	shareCents := splitCentsByWeight(totalCents, weights)
+
+	netCents := make(map[string]int64)
+	for userID := range weights {
+		netCents[userID] = 0
+	}
+	for userID, cents := range paidCents {
+		netCents[userID] += cents
+	}
+	for userID, cents := range shareCents {
+		netCents[userID] -= cents
+	}
+
+	balances := make([]*pfinancev1.MemberBalance, 0, len(netCents))
+	for userID, net := range netCents {
+		balances = append(balances, &pfinancev1.MemberBalance{
+			UserId:         userID,
+			GroupId:        req.Msg.GroupId,
+			TotalPaid:      float64(paidCents[userID]) / 100,
+			TotalPaidCents: paidCents[userID],
+			TotalOwed:      float64(shareCents[userID]) / 100,
+			TotalOwedCents: shareCents[userID],
+			Balance:        float64(net) / 100,
+			BalanceCents:   net,
+		})
+	}
+	sort.Slice(balances, func(i, j int) bool { return balances[i].UserId < balances[j].UserId })
+
+	return connect.NewResponse(&pfinancev1.GetGroupSettlementResponse{
+		Balances:              balances,
+		Transfers:             computeSettlementTransfers(netCents),
+		TotalContributed:      float64(totalCents) / 100,
+		TotalContributedCents: totalCents,
+	}), nil
+}
+
+// computeMemberBalancesFromExpenses calculates member balances from a pre-fetched list of expenses.
+// Used by both GetGroupSummary (reuses already-fetched data) and GetMemberBalances.
+func computeMemberBalancesFromExpenses(expenses []*pfinancev1.Expense, groupID string) []*pfinancev1.MemberBalance {
+	userPaid := make(map[string]float64)
+	userOwed := make(map[string]float64)
+
+	for _, expense := range expenses {
+		userPaid[expense.PaidByUserId] += expense.Amount
+		for _, alloc := range expense.Allocations {
+			if !alloc.IsPaid {
+				userOwed[alloc.UserId] += alloc.Amount
+			}
+		}
+	}
+
+	balances := make([]*pfinancev1.MemberBalance, 0)
+	for userID := range userPaid {
+		paid := userPaid[userID]
+		owed := userOwed[userID]
+		balances = append(balances, &pfinancev1.MemberBalance{
 			UserId:    userID,
 			GroupId:   groupID,
 			TotalPaid: paid,
@@ -2098,6 +3027,92 @@ func computeMemberBalancesFromExpenses(expenses []*pfinancev1.Expense, groupID s
 	return balances
 }
 
+// splitCentsByWeight divides totalCents among users in proportion to their
+// weights. Leftover cents from rounding go to the largest fractional shares,
+// ties broken by user ID, so the shares always sum to totalCents.
+func splitCentsByWeight(totalCents int64, weights map[string]float64) map[string]int64 {
+	var totalWeight float64
+	userIDs := make([]string, 0, len(weights))
+	for userID, w := range weights {
+		totalWeight += w
+		userIDs = append(userIDs, userID)
+	}
+	shares := make(map[string]int64, len(weights))
+	if totalWeight <= 0 {
+		return shares
+	}
+	sort.Strings(userIDs)
+
+	fractions := make(map[string]float64, len(weights))
+	allocated := int64(0)
+	for _, userID := range userIDs {
+		exact := float64(totalCents) * weights[userID] / totalWeight
+		floor := math.Floor(exact)
+		shares[userID] = int64(floor)
+		fractions[userID] = exact - floor
+		allocated += int64(floor)
+	}
+
+	sort.SliceStable(userIDs, func(i, j int) bool { return fractions[userIDs[i]] > fractions[userIDs[j]] })
+	for i := 0; allocated < totalCents && len(userIDs) > 0; i++ {
+		shares[userIDs[i%len(userIDs)]]++
+		allocated++
+	}
+	return shares
+}
+
+// computeSettlementTransfers returns transfers that bring every balance to zero.
+// Positive balances are owed money and negative balances owe it. The largest
+// debtor repeatedly pays the largest creditor, which needs at most one fewer
+// transfer than there are non-zero balances. Balances must sum to zero.
+func computeSettlementTransfers(balances map[string]int64) []*pfinancev1.SettlementTransfer {
+	type party struct {
+		userID string
+		cents  int64
+	}
+	var creditors, debtors []*party
+	for userID, cents := range balances {
+		if cents > 0 {
+			creditors = append(creditors, &party{userID, cents})
+		} else if cents < 0 {
+			debtors = append(debtors, &party{userID, -cents})
+		}
+	}
+	byAmount := func(parties []*party) {
+		sort.Slice(parties, func(i, j int) bool {
+			if parties[i].cents != parties[j].cents {
+				return parties[i].cents > parties[j].cents
+			}
+			return parties[i].userID < parties[j].userID
+		})
+	}
+
+	var transfers []*pfinancev1.SettlementTransfer
+	for len(creditors) > 0 && len(debtors) > 0 {
+		byAmount(creditors)
+		byAmount(debtors)
+		creditor, debtor := creditors[0], debtors[0]
+
+		amount := min(creditor.cents, debtor.cents)
+		transfers = append(transfers, &pfinancev1.SettlementTransfer{
+			FromUserId:  debtor.userID,
+			ToUserId:    creditor.userID,
+			Amount:      float64(amount) / 100,
+			AmountCents: amount,
+		})
+
+		creditor.cents -= amount
+		debtor.cents -= amount
+		if creditor.cents == 0 {
+			creditors = creditors[1:]
+		}
+		if debtor.cents == 0 {
+			debtors = debtors[1:]
+		}
+	}
+	return transfers
+}
+
 // Invite link operations
 
 // generateInviteCode generates a short random invite code
@@ -2177,18 +3192,9 @@ func (s *FinanceService) GetInviteLinkByCode(ctx context.Context, req *connect.R
 			fmt.Errorf("invite link not found"))
 	}
 
-	// Check if link is active and not expired
-	if !link.IsActive {
-		return nil, connect.NewError(connect.CodeFailedPrecondition,
-			fmt.Errorf("invite link is no longer active"))
-	}
-	if link.ExpiresAt != nil && link.ExpiresAt.AsTime().Before(time.Now()) {
-		return nil, connect.NewError(connect.CodeFailedPrecondition,
-			fmt.Errorf("invite link has expired"))
-	}
-	if link.MaxUses > 0 && link.CurrentUses >= link.MaxUses {
-		return nil, connect.NewError(connect.CodeFailedPrecondition,
-			fmt.Errorf("invite link has reached maximum uses"))
+	// Check if link is active, not expired and not exhausted
+	if err := store.ValidateInviteLink(link, time.Now()); err != nil {
+		return nil, inviteLinkError(err)
 	}
 
 	group, err := s.store.GetGroup(ctx, link.GroupId)
@@ -2215,64 +3221,81 @@ func (s *FinanceService) JoinGroupByLink(ctx context.Context, req *connect.Reque
 			fmt.Errorf("cannot join group as another user"))
 	}
 
-	link, err := s.store.GetInviteLinkByCode(ctx, req.Msg.Code)
+	// Consume the link use and add the member together so a failed group write
+	// doesn't burn a use of the link.
+	var group *pfinancev1.FinanceGroup
+	err = s.store.RunInTransaction(ctx, func(tx store.Store) error {
+		link, g, err := redeemInviteLink(ctx, tx, req.Msg.Code, claims.UID)
+		if err != nil {
+			return err
+		}
+
+		// Add user to group using authenticated claims
+		newMember := &pfinancev1.GroupMember{
+			UserId:       claims.UID,
+			Email:        claims.Email,
+			DisplayName:  claims.DisplayName,
+			Role:         link.DefaultRole,
+			JoinedAt:     timestamppb.Now(),
+			InviteLinkId: link.Id,
+		}
+
+		g.MemberIds = append(g.MemberIds, claims.UID)
+		g.Members = append(g.Members, newMember)
+		g.UpdatedAt = timestamppb.Now()
+
+		if err := tx.UpdateGroup(ctx, g); err != nil {
+			return auth.WrapStoreError("update group", err)
+		}
+		group = g
+		return nil
+	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeNotFound,
-			fmt.Errorf("invite link not found"))
+		return nil, err
 	}
 
-	// Validate link
-	if !link.IsActive {
-		return nil, connect.NewError(connect.CodeFailedPrecondition,
-			fmt.Errorf("invite link is no longer active"))
-	}
-	if link.ExpiresAt != nil && link.ExpiresAt.AsTime().Before(time.Now()) {
-		return nil, connect.NewError(connect.CodeFailedPrecondition,
-			fmt.Errorf("invite link has expired"))
-	}
-	if link.MaxUses > 0 && link.CurrentUses >= link.MaxUses {
-		return nil, connect.NewError(connect.CodeFailedPrecondition,
-			fmt.Errorf("invite link has reached maximum uses"))
+	return connect.NewResponse(&pfinancev1.JoinGroupByLinkResponse{
+		Group: group,
+	}), nil
+}
+
+// redeemInviteLink resolves an invite code for userID and atomically consumes one
+// use of the link. Membership is checked first so existing members don't burn a use.
+func redeemInviteLink(ctx context.Context, st store.Store, code, userID string) (*pfinancev1.GroupInviteLink, *pfinancev1.FinanceGroup, error) {
+	link, err := st.GetInviteLinkByCode(ctx, code)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("invite link not found"))
 	}
 
-	group, err := s.store.GetGroup(ctx, link.GroupId)
+	group, err := st.GetGroup(ctx, link.GroupId)
 	if err != nil {
-		return nil, auth.WrapStoreError("get group", err)
+		return nil, nil, auth.WrapStoreError("get group", err)
 	}
 
 	// Check if user is already a member
-	if auth.IsGroupMember(claims.UID, group) {
-		return nil, connect.NewError(connect.CodeAlreadyExists,
+	if auth.IsGroupMember(userID, group) {
+		return nil, nil, connect.NewError(connect.CodeAlreadyExists,
 			fmt.Errorf("user is already a member of this group"))
 	}
 
-	// Add user to group using authenticated claims
-	newMember := &pfinancev1.GroupMember{
-		UserId:      claims.UID,
-		Email:       claims.Email,
-		DisplayName: claims.DisplayName,
-		Role:        link.DefaultRole,
-		JoinedAt:    timestamppb.Now(),
-	}
-
-	group.MemberIds = append(group.MemberIds, claims.UID)
-	group.Members = append(group.Members, newMember)
-	group.UpdatedAt = timestamppb.Now()
-
-	if err := s.store.UpdateGroup(ctx, group); err != nil {
-		return nil, auth.WrapStoreError("update group", err)
+	link, err = st.RedeemInviteLink(ctx, code)
+	if err != nil {
+		return nil, nil, inviteLinkError(err)
 	}
+	return link, group, nil
+}
 
-	// Increment link usage
-	link.CurrentUses++
-	if err := s.store.UpdateInviteLink(ctx, link); err != nil {
-		// Log but don't fail - user already joined
-		fmt.Printf("failed to update invite link usage: %v\n", err)
+// inviteLinkError maps invite link redemption errors to connect errors.
+func inviteLinkError(err error) error {
+	switch {
+	case errors.Is(err, store.ErrInviteLinkInactive),
+		errors.Is(err, store.ErrInviteLinkExpired),
+		errors.Is(err, store.ErrInviteLinkExhausted):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return auth.WrapStoreError("redeem invite link", err)
 	}
-
-	return connect.NewResponse(&pfinancev1.JoinGroupByLinkResponse{
-		Group: group,
-	}), nil
 }
 
 // ListInviteLinks lists invite links for a group
@@ -2293,16 +3316,24 @@ func (s *FinanceService) ListInviteLinks(ctx context.Context, req *connect.Reque
 			fmt.Errorf("user is not a member of this group"))
 	}
 
-	pageSize := auth.NormalizePageSize(req.Msg.PageSize)
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
 
-	links, nextPageToken, err := s.store.ListInviteLinks(ctx, req.Msg.GroupId, req.Msg.IncludeInactive, pageSize, req.Msg.PageToken)
+	fingerprint := store.QueryFingerprint(req.Msg.GroupId, req.Msg.IncludeInactive)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	links, nextPageToken, err := s.store.ListInviteLinks(ctx, req.Msg.GroupId, req.Msg.IncludeInactive, pageSize, pageToken)
 	if err != nil {
 		return nil, auth.WrapStoreError("list invite links", err)
 	}
 
 	return connect.NewResponse(&pfinancev1.ListInviteLinksResponse{
 		InviteLinks:   links,
-		NextPageToken: nextPageToken,
+		NextPageToken: store.EncodeQueryPageToken(nextPageToken, fingerprint),
 	}), nil
 }
 
@@ -2338,6 +3369,56 @@ func (s *FinanceService) DeactivateInviteLink(ctx context.Context, req *connect.
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+// GetInviteLinkStats returns usage statistics for an invite link, including the
+// members who joined through it. Only group owners and admins may view them.
+func (s *FinanceService) GetInviteLinkStats(ctx context.Context, req *connect.Request[pfinancev1.GetInviteLinkStatsRequest]) (*connect.Response[pfinancev1.GetInviteLinkStatsResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := s.store.GetInviteLink(ctx, req.Msg.LinkId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("invite link not found"))
+	}
+
+	group, err := s.store.GetGroup(ctx, link.GroupId)
+	if err != nil {
+		return nil, auth.WrapStoreError("get group", err)
+	}
+
+	if !auth.IsGroupAdminOrOwner(claims.UID, group) {
+		return nil, connect.NewError(connect.CodePermissionDenied,
+			fmt.Errorf("only owners or admins can view invite link stats"))
+	}
+
+	members, err := s.store.ListInviteLinkMembers(ctx, link.GroupId, link.Id)
+	if err != nil {
+		return nil, auth.WrapStoreError("list invite link members", err)
+	}
+
+	// Links used before LastUsedAt was tracked fall back to the latest join time
+	lastUsedAt := link.LastUsedAt
+	for _, m := range members {
+		if lastUsedAt == nil || (m.JoinedAt != nil && m.JoinedAt.AsTime().After(lastUsedAt.AsTime())) {
+			lastUsedAt = m.JoinedAt
+		}
+	}
+
+	resp := &pfinancev1.GetInviteLinkStatsResponse{
+		InviteLink:    link,
+		TotalUses:     link.CurrentUses,
+		LastUsedAt:    lastUsedAt,
+		JoinedMembers: members,
+	}
+	if link.MaxUses > 0 {
+		resp.RemainingUses = proto.Int32(max(0, link.MaxUses-link.CurrentUses))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 // Contribution operations
 
 // ContributeExpenseToGroup contributes a personal expense to a group
@@ -2486,16 +3567,24 @@ func (s *FinanceService) ListContributions(ctx context.Context, req *connect.Req
 			fmt.Errorf("cannot list another user's contributions"))
 	}
 
-	pageSize := auth.NormalizePageSize(req.Msg.PageSize)
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
 
-	contributions, nextPageToken, err := s.store.ListContributions(ctx, req.Msg.GroupId, req.Msg.UserId, pageSize, req.Msg.PageToken)
+	fingerprint := store.QueryFingerprint(req.Msg.GroupId, req.Msg.UserId)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	contributions, nextPageToken, err := s.store.ListContributions(ctx, req.Msg.GroupId, req.Msg.UserId, pageSize, pageToken)
 	if err != nil {
 		return nil, auth.WrapStoreError("list contributions", err)
 	}
 
 	return connect.NewResponse(&pfinancev1.ListContributionsResponse{
 		Contributions: contributions,
-		NextPageToken: nextPageToken,
+		NextPageToken: store.EncodeQueryPageToken(nextPageToken, fingerprint),
 	}), nil
 }
 
@@ -2620,16 +3709,24 @@ func (s *FinanceService) ListIncomeContributions(ctx context.Context, req *conne
 			fmt.Errorf("cannot list another user's income contributions"))
 	}
 
-	pageSize := auth.NormalizePageSize(req.Msg.PageSize)
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
 
-	contributions, nextPageToken, err := s.store.ListIncomeContributions(ctx, req.Msg.GroupId, req.Msg.UserId, pageSize, req.Msg.PageToken)
+	fingerprint := store.QueryFingerprint(req.Msg.GroupId, req.Msg.UserId)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	contributions, nextPageToken, err := s.store.ListIncomeContributions(ctx, req.Msg.GroupId, req.Msg.UserId, pageSize, pageToken)
 	if err != nil {
 		return nil, auth.WrapStoreError("list income contributions", err)
 	}
 
 	return connect.NewResponse(&pfinancev1.ListIncomeContributionsResponse{
 		Contributions: contributions,
-		NextPageToken: nextPageToken,
+		NextPageToken: store.EncodeQueryPageToken(nextPageToken, fingerprint),
 	}), nil
 }
 
@@ -2726,6 +3823,7 @@ func (s *FinanceService) CreateGoal(ctx context.Context, req *connect.Request[pf
 		Milestones:         milestones,
 		CreatedAt:          timestamppb.Now(),
 		UpdatedAt:          timestamppb.Now(),
+		Priority:           req.Msg.Priority,
 	}
 
 	if err := s.store.CreateGoal(ctx, goal); err != nil {
@@ -2840,12 +3938,22 @@ func (s *FinanceService) UpdateGoal(ctx context.Context, req *connect.Request[pf
 	if req.Msg.Color != "" {
 		existing.Color = req.Msg.Color
 	}
+	if req.Msg.Priority != pfinancev1.GoalPriority_GOAL_PRIORITY_UNSPECIFIED {
+		existing.Priority = req.Msg.Priority
+	}
 	existing.UpdatedAt = timestamppb.Now()
 
+	// A lower target can push progress past milestones
+	newlyAchieved := markAchievedMilestones(existing)
+
 	if err := s.store.UpdateGoal(ctx, existing); err != nil {
 		return nil, auth.WrapStoreError("update goal", err)
 	}
 
+	if len(newlyAchieved) > 0 {
+		s.notifyGoalMilestone(ctx, claims.UID, existing)
+	}
+
 	return connect.NewResponse(&pfinancev1.UpdateGoalResponse{
 		Goal: existing,
 	}), nil
@@ -2921,16 +4029,24 @@ func (s *FinanceService) ListGoals(ctx context.Context, req *connect.Request[pfi
 		userID = claims.UID
 	}
 
-	pageSize := auth.NormalizePageSize(req.Msg.PageSize)
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
 
-	goals, nextPageToken, err := s.store.ListGoals(ctx, userID, req.Msg.GroupId, req.Msg.Status, req.Msg.GoalType, pageSize, req.Msg.PageToken)
+	fingerprint := store.QueryFingerprint(userID, req.Msg.GroupId, req.Msg.Status, req.Msg.GoalType)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	goals, nextPageToken, err := s.store.ListGoals(ctx, userID, req.Msg.GroupId, req.Msg.Status, req.Msg.GoalType, pageSize, pageToken)
 	if err != nil {
 		return nil, auth.WrapStoreError("list goals", err)
 	}
 
 	return connect.NewResponse(&pfinancev1.ListGoalsResponse{
 		Goals:         goals,
-		NextPageToken: nextPageToken,
+		NextPageToken: store.EncodeQueryPageToken(nextPageToken, fingerprint),
 	}), nil
 }
 
@@ -2977,12 +4093,64 @@ func (s *FinanceService) GetGoalProgress(ctx context.Context, req *connect.Reque
 	if err != nil {
 		return nil, auth.WrapStoreError("get goal progress", err)
 	}
+	progress.CatchUpScenarios = goalCatchUpScenarios(goal, progress, asOfDate)
 
 	return connect.NewResponse(&pfinancev1.GetGoalProgressResponse{
 		Progress: progress,
 	}), nil
 }
 
+const (
+	// daysPerMonth is the average month length used for monthly goal rates.
+	daysPerMonth = 365.25 / 12
+	// maxGoalProjectionDays bounds the projected completion date; slower
+	// paces leave it unset.
+	maxGoalProjectionDays = 100 * 365
+)
+
+// goalCatchUpScenarios computes the contributions needed to still reach goal
+// by its target date, and when it will be reached at the actual daily rate.
+// Returns nil once the goal is reached.
+func goalCatchUpScenarios(goal *pfinancev1.FinancialGoal, progress *pfinancev1.GoalProgress, asOf time.Time) *pfinancev1.GoalCatchUpScenarios {
+	remainingCents := store.ToCents(progress.TargetAmount) - store.ToCents(progress.CurrentAmount)
+	if remainingCents <= 0 {
+		return nil
+	}
+	scenarios := &pfinancev1.GoalCatchUpScenarios{RemainingCents: remainingCents}
+
+	var targetDate time.Time
+	if goal.TargetDate != nil {
+		targetDate = goal.TargetDate.AsTime()
+		daysLeft := max(targetDate.Sub(asOf).Hours()/24, 0)
+		scenarios.Overdue = daysLeft == 0
+		scenarios.RequiredDailyCents = catchUpContribution(remainingCents, daysLeft, 1)
+		scenarios.RequiredWeeklyCents = catchUpContribution(remainingCents, daysLeft, 7)
+		scenarios.RequiredMonthlyCents = catchUpContribution(remainingCents, daysLeft, daysPerMonth)
+	}
+
+	if actualDailyCents := progress.ActualDailyRate * 100; actualDailyCents > 0 {
+		daysNeeded := math.Ceil(float64(remainingCents) / actualDailyCents)
+		if daysNeeded <= maxGoalProjectionDays {
+			projected := asOf.AddDate(0, 0, int(daysNeeded))
+			scenarios.ProjectedCompletionDate = timestamppb.New(projected)
+			if !targetDate.IsZero() && projected.After(targetDate) {
+				scenarios.DaysLate = int32(math.Ceil(projected.Sub(targetDate).Hours() / 24))
+			}
+		}
+	}
+	return scenarios
+}
+
+// catchUpContribution returns the contribution per period of periodDays that
+// pays off remainingCents over daysLeft, rounded up to whole cents. When less
+// than one period is left the whole remainder is due.
+func catchUpContribution(remainingCents int64, daysLeft, periodDays float64) int64 {
+	if daysLeft <= periodDays {
+		return remainingCents
+	}
+	return int64(math.Ceil(float64(remainingCents) * periodDays / daysLeft))
+}
+
 // ContributeToGoal adds a contribution to a goal
 func (s *FinanceService) ContributeToGoal(ctx context.Context, req *connect.Request[pfinancev1.ContributeToGoalRequest]) (*connect.Response[pfinancev1.ContributeToGoalResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
@@ -3031,6 +4199,17 @@ func (s *FinanceService) ContributeToGoal(ctx context.Context, req *connect.Requ
 		amountCents = int64(amount * 100)
 	}
 
+	// Negative amounts are withdrawals; they may not overdraw the goal
+	// unless the caller explicitly allows it, and the balance floors at zero.
+	currentCents := goal.CurrentAmountCents
+	if currentCents == 0 {
+		currentCents = int64(goal.CurrentAmount * 100)
+	}
+	if amountCents < 0 && -amountCents > currentCents && !req.Msg.AllowNegative {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("withdrawal of %d cents exceeds the goal's current balance of %d cents", -amountCents, currentCents))
+	}
+
 	// Create the contribution record
 	contribution := &pfinancev1.GoalContribution{
 		Id:            uuid.New().String(),
@@ -3047,18 +4226,14 @@ func (s *FinanceService) ContributeToGoal(ctx context.Context, req *connect.Requ
 	}
 
 	// Update goal current amount
-	goal.CurrentAmount += amount
-	goal.CurrentAmountCents += amountCents
+	currentCents = max(currentCents+amountCents, 0)
+	goal.CurrentAmountCents = currentCents
+	goal.CurrentAmount = float64(currentCents) / 100.0
 	goal.UpdatedAt = timestamppb.Now()
 
-	// Check and update milestones
-	percentageComplete := (goal.CurrentAmount / goal.TargetAmount) * 100
-	for _, milestone := range goal.Milestones {
-		if !milestone.IsAchieved && percentageComplete >= milestone.TargetPercentage {
-			milestone.IsAchieved = true
-			milestone.AchievedAt = timestamppb.Now()
-		}
-	}
+	// Check and update milestones. Milestones stay achieved after a withdrawal,
+	// so crossing one again on the way back up does not re-notify.
+	newlyAchieved := markAchievedMilestones(goal)
 
 	// Check if goal is completed
 	if goal.CurrentAmount >= goal.TargetAmount {
@@ -3069,16 +4244,10 @@ func (s *FinanceService) ContributeToGoal(ctx context.Context, req *connect.Requ
 		return nil, auth.WrapStoreError("update goal", err)
 	}
 
-	// Fire-and-forget: check if a goal milestone was crossed
-	func() {
-		trigger := NewNotificationTrigger(s.store)
-		// Use CurrentAmountCents if available, otherwise derive from CurrentAmount
-		currentCents := goal.CurrentAmountCents
-		if currentCents == 0 && goal.CurrentAmount > 0 {
-			currentCents = int64(goal.CurrentAmount * 100)
-		}
-		trigger.GoalMilestoneReached(ctx, claims.UID, goal, currentCents)
-	}()
+	// Fire-and-forget: notify if a goal milestone was crossed
+	if len(newlyAchieved) > 0 {
+		s.notifyGoalMilestone(ctx, claims.UID, goal)
+	}
 
 	return connect.NewResponse(&pfinancev1.ContributeToGoalResponse{
 		Goal:         goal,
@@ -3086,6 +4255,45 @@ func (s *FinanceService) ContributeToGoal(ctx context.Context, req *connect.Requ
 	}), nil
 }
 
+// markAchievedMilestones marks every milestone the goal's current amount has
+// crossed as achieved and returns the ones that were newly achieved.
+// The caller is responsible for persisting the goal.
+func markAchievedMilestones(goal *pfinancev1.FinancialGoal) []*pfinancev1.GoalMilestone {
+	targetCents := goal.TargetAmountCents
+	if targetCents == 0 {
+		targetCents = int64(goal.TargetAmount * 100)
+	}
+	if targetCents <= 0 {
+		return nil
+	}
+	currentCents := goal.CurrentAmountCents
+	if currentCents == 0 {
+		currentCents = int64(goal.CurrentAmount * 100)
+	}
+
+	percentageComplete := float64(currentCents) / float64(targetCents) * 100
+	var newlyAchieved []*pfinancev1.GoalMilestone
+	for _, milestone := range goal.Milestones {
+		if !milestone.IsAchieved && percentageComplete >= milestone.TargetPercentage {
+			milestone.IsAchieved = true
+			milestone.AchievedAt = timestamppb.Now()
+			newlyAchieved = append(newlyAchieved, milestone)
+		}
+	}
+	return newlyAchieved
+}
+
+// notifyGoalMilestone fires the goal milestone notification for the goal's current amount.
+func (s *FinanceService) notifyGoalMilestone(ctx context.Context, userID string, goal *pfinancev1.FinancialGoal) {
+	trigger := NewNotificationTrigger(s.store)
+	// Use CurrentAmountCents if available, otherwise derive from CurrentAmount
+	currentCents := goal.CurrentAmountCents
+	if currentCents == 0 && goal.CurrentAmount > 0 {
+		currentCents = int64(goal.CurrentAmount * 100)
+	}
+	trigger.GoalMilestoneReached(ctx, userID, goal, currentCents)
+}
+
 // ListGoalContributions lists contributions for a goal
 func (s *FinanceService) ListGoalContributions(ctx context.Context, req *connect.Request[pfinancev1.ListGoalContributionsRequest]) (*connect.Response[pfinancev1.ListGoalContributionsResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
@@ -3119,16 +4327,24 @@ func (s *FinanceService) ListGoalContributions(ctx context.Context, req *connect
 		}
 	}
 
-	pageSize := auth.NormalizePageSize(req.Msg.PageSize)
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
 
-	contributions, nextPageToken, err := s.store.ListGoalContributions(ctx, req.Msg.GoalId, pageSize, req.Msg.PageToken)
+	fingerprint := store.QueryFingerprint(req.Msg.GoalId)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	contributions, nextPageToken, err := s.store.ListGoalContributions(ctx, req.Msg.GoalId, pageSize, pageToken)
 	if err != nil {
 		return nil, auth.WrapStoreError("list goal contributions", err)
 	}
 
 	return connect.NewResponse(&pfinancev1.ListGoalContributionsResponse{
 		Contributions: contributions,
-		NextPageToken: nextPageToken,
+		NextPageToken: store.EncodeQueryPageToken(nextPageToken, fingerprint),
 	}), nil
 }
 
@@ -3199,7 +4415,7 @@ func (s *FinanceService) GetSpendingInsights(ctx context.Context, req *connect.R
 	}
 
 	// Get current period expenses
-	currentExpenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, &startDate, &endDate, 1000, "")
+	currentExpenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{StartDate: &startDate, EndDate: &endDate}, 1000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list expenses", err)
 	}
@@ -3212,7 +4428,7 @@ func (s *FinanceService) GetSpendingInsights(ctx context.Context, req *connect.R
 		prevEndDate = endDate.AddDate(-1, 0, 0)
 	}
 
-	prevExpenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, &prevStartDate, &prevEndDate, 1000, "")
+	prevExpenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{StartDate: &prevStartDate, EndDate: &prevEndDate}, 1000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list previous expenses", err)
 	}
@@ -3371,6 +4587,28 @@ func calculateNextOccurrence(startDate time.Time, frequency pfinancev1.ExpenseFr
 	return next
 }
 
+// validateRecurringAmountRange checks an optional amount range for a variable
+// recurring transaction: both bounds or neither, min at most max, and the
+// amount, when set, inside the range.
+func validateRecurringAmountRange(minCents, maxCents, amountCents int64) error {
+	if minCents == 0 && maxCents == 0 {
+		return nil
+	}
+	if minCents <= 0 || maxCents <= 0 {
+		return connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("min_amount_cents and max_amount_cents must both be positive"))
+	}
+	if minCents > maxCents {
+		return connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("min_amount_cents cannot exceed max_amount_cents"))
+	}
+	if amountCents != 0 && (amountCents < minCents || amountCents > maxCents) {
+		return connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("amount must be within min_amount_cents and max_amount_cents"))
+	}
+	return nil
+}
+
 func (s *FinanceService) CreateRecurringTransaction(ctx context.Context, req *connect.Request[pfinancev1.CreateRecurringTransactionRequest]) (*connect.Response[pfinancev1.CreateRecurringTransactionResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
 	if err != nil {
@@ -3413,6 +4651,14 @@ func (s *FinanceService) CreateRecurringTransaction(ctx context.Context, req *co
 	} else if amount != 0 && amountCents == 0 {
 		amountCents = int64(amount * 100)
 	}
+	if err := validateRecurringAmountRange(req.Msg.MinAmountCents, req.Msg.MaxAmountCents, amountCents); err != nil {
+		return nil, err
+	}
+	// A variable bill without an expected amount is expected at the middle of its range
+	if amountCents == 0 && req.Msg.MaxAmountCents > 0 {
+		amountCents = (req.Msg.MinAmountCents + req.Msg.MaxAmountCents) / 2
+		amount = float64(amountCents) / 100.0
+	}
 
 	rt := &pfinancev1.RecurringTransaction{
 		Id:             uuid.New().String(),
@@ -3429,10 +4675,12 @@ func (s *FinanceService) CreateRecurringTransaction(ctx context.Context, req *co
 		IsExpense:      req.Msg.IsExpense,
 		CreatedAt:      timestamppb.Now(),
 		UpdatedAt:      timestamppb.Now(),
-		Tags:           req.Msg.Tags,
+		Tags:           normalizeTags(req.Msg.Tags),
 		PaidByUserId:   req.Msg.PaidByUserId,
 		SplitType:      req.Msg.SplitType,
 		Allocations:    req.Msg.Allocations,
+		MinAmountCents: req.Msg.MinAmountCents,
+		MaxAmountCents: req.Msg.MaxAmountCents,
 	}
 
 	if req.Msg.EndDate != nil {
@@ -3522,6 +4770,15 @@ func (s *FinanceService) UpdateRecurringTransaction(ctx context.Context, req *co
 			rt.AmountCents = int64(rt.Amount * 100)
 		}
 	}
+	if req.Msg.MinAmountCents != nil {
+		rt.MinAmountCents = req.Msg.GetMinAmountCents()
+	}
+	if req.Msg.MaxAmountCents != nil {
+		rt.MaxAmountCents = req.Msg.GetMaxAmountCents()
+	}
+	if err := validateRecurringAmountRange(rt.MinAmountCents, rt.MaxAmountCents, rt.AmountCents); err != nil {
+		return nil, err
+	}
 	if req.Msg.Category != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED {
 		rt.Category = req.Msg.Category
 	}
@@ -3541,7 +4798,7 @@ func (s *FinanceService) UpdateRecurringTransaction(ctx context.Context, req *co
 	}
 	rt.IsExpense = req.Msg.IsExpense
 	if len(req.Msg.Tags) > 0 {
-		rt.Tags = req.Msg.Tags
+		rt.Tags = normalizeTags(req.Msg.Tags)
 	}
 	if req.Msg.PaidByUserId != "" {
 		rt.PaidByUserId = req.Msg.PaidByUserId
@@ -3621,14 +4878,23 @@ func (s *FinanceService) ListRecurringTransactions(ctx context.Context, req *con
 			fmt.Errorf("cannot list another user's recurring transactions"))
 	}
 
-	results, nextToken, err := s.store.ListRecurringTransactions(ctx, userID, req.Msg.GroupId, req.Msg.Status, req.Msg.FilterIsExpense, req.Msg.IsExpense, req.Msg.PageSize, req.Msg.PageToken)
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := store.QueryFingerprint(userID, req.Msg.GroupId, req.Msg.Status, req.Msg.FilterIsExpense, req.Msg.IsExpense)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	results, nextToken, err := s.store.ListRecurringTransactions(ctx, userID, req.Msg.GroupId, req.Msg.Status, req.Msg.FilterIsExpense, req.Msg.IsExpense, pageSize, pageToken)
 	if err != nil {
 		return nil, auth.WrapStoreError("list recurring transactions", err)
 	}
 
 	return connect.NewResponse(&pfinancev1.ListRecurringTransactionsResponse{
 		RecurringTransactions: results,
-		NextPageToken:         nextToken,
+		NextPageToken:         store.EncodeQueryPageToken(nextToken, fingerprint),
 	}), nil
 }
 
@@ -3692,15 +4958,74 @@ func (s *FinanceService) ResumeRecurringTransaction(ctx context.Context, req *co
 	}), nil
 }
 
-func (s *FinanceService) GetUpcomingBills(ctx context.Context, req *connect.Request[pfinancev1.GetUpcomingBillsRequest]) (*connect.Response[pfinancev1.GetUpcomingBillsResponse], error) {
+// SkipNextOccurrence advances a recurring transaction past its next occurrence
+// without creating an expense or income, recording the skipped date.
+func (s *FinanceService) SkipNextOccurrence(ctx context.Context, req *connect.Request[pfinancev1.SkipNextOccurrenceRequest]) (*connect.Response[pfinancev1.SkipNextOccurrenceResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	userID := req.Msg.UserId
-	if userID == "" {
-		userID = claims.UID
+	rt, err := s.store.GetRecurringTransaction(ctx, req.Msg.RecurringTransactionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("recurring transaction not found"))
+	}
+
+	if rt.GroupId == "" {
+		if rt.UserId != claims.UID {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("cannot skip another user's recurring transaction"))
+		}
+	} else {
+		group, err := s.store.GetGroup(ctx, rt.GroupId)
+		if err != nil {
+			return nil, auth.WrapStoreError("get group", err)
+		}
+		if !auth.IsGroupMember(claims.UID, group) {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("user is not a member of this group"))
+		}
+	}
+
+	if rt.Status == pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ENDED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("cannot skip an occurrence of an ended recurring transaction"))
+	}
+	if rt.NextOccurrence == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("recurring transaction has no next occurrence"))
+	}
+
+	skipped := rt.NextOccurrence
+	newNext := nextOccurrence(skipped.AsTime(), rt.Frequency)
+	rt.SkippedOccurrences = append(rt.SkippedOccurrences, skipped)
+	rt.NextOccurrence = timestamppb.New(newNext)
+	rt.UpdatedAt = timestamppb.Now()
+
+	// Skipping the final occurrence ends the transaction, as processing would
+	if rt.EndDate != nil && !rt.EndDate.AsTime().IsZero() && newNext.After(rt.EndDate.AsTime()) {
+		rt.Status = pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ENDED
+	}
+
+	if err := s.store.UpdateRecurringTransaction(ctx, rt); err != nil {
+		return nil, auth.WrapStoreError("skip recurring transaction occurrence", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.SkipNextOccurrenceResponse{
+		RecurringTransaction: rt,
+		SkippedOccurrence:    skipped,
+	}), nil
+}
+
+func (s *FinanceService) GetUpcomingBills(ctx context.Context, req *connect.Request[pfinancev1.GetUpcomingBillsRequest]) (*connect.Response[pfinancev1.GetUpcomingBillsResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" {
+		userID = claims.UID
 	}
 
 	// List active recurring transactions
@@ -3758,6 +5083,95 @@ func (s *FinanceService) GetUpcomingBills(ctx context.Context, req *connect.Requ
 	}), nil
 }
 
+const (
+	defaultPreviewOccurrences = 10
+	maxPreviewOccurrences     = 100
+)
+
+// PreviewRecurringOccurrences lists the upcoming dates a recurring transaction
+// with the given schedule would generate. Nothing is read or stored, so it can
+// back a creation form before the transaction exists.
+func (s *FinanceService) PreviewRecurringOccurrences(ctx context.Context, req *connect.Request[pfinancev1.PreviewRecurringOccurrencesRequest]) (*connect.Response[pfinancev1.PreviewRecurringOccurrencesResponse], error) {
+	if _, err := auth.RequireAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Frequency == pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_UNSPECIFIED {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("frequency is required"))
+	}
+	if req.Msg.StartDate == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("start_date is required"))
+	}
+	if req.Msg.MaxOccurrences < 0 || req.Msg.Count < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("max_occurrences and count must not be negative"))
+	}
+	startDate := req.Msg.StartDate.AsTime()
+	var endDate *time.Time
+	if req.Msg.EndDate != nil {
+		end := req.Msg.EndDate.AsTime()
+		if end.Before(startDate) {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("end_date must not be before start_date"))
+		}
+		endDate = &end
+	}
+
+	count := int(req.Msg.Count)
+	if count == 0 {
+		count = defaultPreviewOccurrences
+	}
+	count = min(count, maxPreviewOccurrences)
+
+	dates, hasMore := previewOccurrences(startDate, req.Msg.Frequency, endDate, int(req.Msg.MaxOccurrences), count, time.Now())
+	occurrences := make([]*timestamppb.Timestamp, 0, len(dates))
+	for _, d := range dates {
+		occurrences = append(occurrences, timestamppb.New(d))
+	}
+
+	return connect.NewResponse(&pfinancev1.PreviewRecurringOccurrencesResponse{
+		Occurrences: occurrences,
+		HasMore:     hasMore,
+	}), nil
+}
+
+// previewOccurrences walks the series from startDate with nextOccurrence and
+// returns up to count dates from the first one a new recurring transaction
+// would schedule (see calculateNextOccurrence). maxOccurrences, when positive,
+// caps the series length counted from startDate; endDate bounds it inclusively.
+// ONCE yields only startDate. hasMore reports whether the series continues.
+func previewOccurrences(startDate time.Time, frequency pfinancev1.ExpenseFrequency, endDate *time.Time, maxOccurrences, count int, now time.Time) ([]time.Time, bool) {
+	inSeries := func(t time.Time, index int) bool {
+		if maxOccurrences > 0 && index >= maxOccurrences {
+			return false
+		}
+		return endDate == nil || !t.After(*endDate)
+	}
+
+	if frequency == pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_ONCE {
+		if count == 0 || !inSeries(startDate, 0) {
+			return nil, false
+		}
+		return []time.Time{startDate}, false
+	}
+
+	var dates []time.Time
+	current := startDate
+	for index := 0; inSeries(current, index); index++ {
+		// Dates up to now are skipped, as calculateNextOccurrence does
+		if current.After(now) {
+			if len(dates) == count {
+				return dates, true
+			}
+			dates = append(dates, current)
+		}
+		current = nextOccurrence(current, frequency)
+	}
+	return dates, false
+}
+
 // SearchTransactions searches across expenses and incomes.
 // Routes to Algolia when configured, otherwise falls back to store-based search.
 func (s *FinanceService) SearchTransactions(ctx context.Context, req *connect.Request[pfinancev1.SearchTransactionsRequest]) (*connect.Response[pfinancev1.SearchTransactionsResponse], error) {
@@ -3792,34 +5206,56 @@ func (s *FinanceService) SearchTransactions(ctx context.Context, req *connect.Re
 		endDate = &t
 	}
 
+	amountMin := searchAmountBound(req.Msg.AmountMinCents, req.Msg.AmountMin)
+	amountMax := searchAmountBound(req.Msg.AmountMaxCents, req.Msg.AmountMax)
+	if amountMin != nil && amountMax != nil && *amountMin > *amountMax {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("amount_min must not exceed amount_max"))
+	}
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.SearchPageSize)
+	if err != nil {
+		return nil, err
+	}
+
 	// Route to Algolia when available
 	if s.algolia != nil {
-		return s.searchViaAlgolia(ctx, userID, req.Msg, startDate, endDate)
+		return s.searchViaAlgolia(ctx, userID, req.Msg, amountMin, amountMax, startDate, endDate, pageSize)
 	}
 
+	fingerprint := store.QueryFingerprint(userID, req.Msg.GroupId, req.Msg.Query, req.Msg.Category,
+		amountMin, amountMax, startDate, endDate, req.Msg.Type, req.Msg.SortBy)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
 	results, nextToken, totalCount, err := s.store.SearchTransactions(ctx,
 		userID, req.Msg.GroupId, req.Msg.Query, req.Msg.Category,
-		req.Msg.AmountMin, req.Msg.AmountMax,
-		startDate, endDate, req.Msg.Type,
-		req.Msg.PageSize, req.Msg.PageToken)
+		amountMin, amountMax,
+		startDate, endDate, req.Msg.Type, req.Msg.SortBy,
+		pageSize, pageToken)
 	if err != nil {
 		return nil, auth.WrapStoreError("search transactions", err)
 	}
 
 	return connect.NewResponse(&pfinancev1.SearchTransactionsResponse{
 		Results:       results,
-		NextPageToken: nextToken,
+		NextPageToken: store.EncodeQueryPageToken(nextToken, fingerprint),
 		TotalCount:    int32(totalCount),
 	}), nil
 }
 
-// searchViaAlgolia performs the search through Algolia.
-func (s *FinanceService) searchViaAlgolia(ctx context.Context, userID string, msg *pfinancev1.SearchTransactionsRequest, startDate, endDate *time.Time) (*connect.Response[pfinancev1.SearchTransactionsResponse], error) {
-	pageSize := int(msg.PageSize)
-	if pageSize <= 0 {
-		pageSize = 25
+// searchAmountBound resolves an optional search amount bound in dollars,
+// preferring the cents field. Nil means the bound was not supplied.
+func searchAmountBound(cents *int64, dollars *float64) *float64 {
+	if cents != nil {
+		v := float64(*cents) / 100
+		return &v
 	}
+	return dollars
+}
 
+// searchViaAlgolia performs the search through Algolia.
+func (s *FinanceService) searchViaAlgolia(ctx context.Context, userID string, msg *pfinancev1.SearchTransactionsRequest, amountMin, amountMax *float64, startDate, endDate *time.Time, pageSize int32) (*connect.Response[pfinancev1.SearchTransactionsResponse], error) {
 	// Repurpose page_token as page number for Algolia's offset pagination
 	pageNum := 0
 	if msg.PageToken != "" {
@@ -3833,13 +5269,13 @@ func (s *FinanceService) searchViaAlgolia(ctx context.Context, userID string, ms
 		UserID:    userID,
 		GroupID:   msg.GroupId,
 		Category:  msg.Category,
-		AmountMin: msg.AmountMin,
-		AmountMax: msg.AmountMax,
+		AmountMin: amountMin,
+		AmountMax: amountMax,
 		StartDate: startDate,
 		EndDate:   endDate,
 		Type:      msg.Type,
 		Page:      pageNum,
-		PageSize:  pageSize,
+		PageSize:  int(pageSize),
 	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("algolia search: %w", err))
@@ -3877,7 +5313,7 @@ func (s *FinanceService) DetectSubscriptions(ctx context.Context, req *connect.R
 
 	// Fetch expenses for the lookback period
 	startTime := time.Now().AddDate(0, -int(lookbackMonths), 0)
-	expenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, &startTime, nil, 1000, "")
+	expenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{StartDate: &startTime}, 1000, "")
 	if err != nil {
 		return nil, auth.WrapStoreError("list expenses", err)
 	}
@@ -3976,6 +5412,184 @@ func (s *FinanceService) ConvertToRecurring(ctx context.Context, req *connect.Re
 	}), nil
 }
 
+// ConvertExpenseToRecurring promotes a one-off expense to a recurring
+// transaction with the same amount, description and category. The first
+// occurrence is one period after the expense date. The expense is kept unless
+// delete_original is set.
+func (s *FinanceService) ConvertExpenseToRecurring(ctx context.Context, req *connect.Request[pfinancev1.ConvertExpenseToRecurringRequest]) (*connect.Response[pfinancev1.ConvertExpenseToRecurringResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.ExpenseId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expense_id is required"))
+	}
+	if req.Msg.Frequency == pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_ONCE || req.Msg.Frequency == pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_UNSPECIFIED {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("recurring transaction frequency must not be once or unspecified"))
+	}
+
+	expense, err := s.store.GetExpense(ctx, req.Msg.ExpenseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("expense not found"))
+	}
+
+	// Same rules as creating a recurring transaction, plus the rules of
+	// DeleteExpense when the original is removed.
+	if expense.GroupId == "" {
+		if expense.UserId != claims.UID {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("cannot convert another user's expense"))
+		}
+	} else {
+		group, err := s.store.GetGroup(ctx, expense.GroupId)
+		if err != nil {
+			return nil, auth.WrapStoreError("get group", err)
+		}
+		if !auth.IsGroupMember(claims.UID, group) {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("user is not a member of this group"))
+		}
+		isCreator := expense.UserId == claims.UID || expense.PaidByUserId == claims.UID
+		if req.Msg.DeleteOriginal && !isCreator && !auth.IsGroupAdminOrOwner(claims.UID, group) {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("only expense creator or group admin can delete this expense"))
+		}
+	}
+	// Converting without deleting only reads the expense
+	if req.Msg.DeleteOriginal {
+		if err := checkExpenseUnlocked(expense); err != nil {
+			return nil, err
+		}
+	}
+
+	startDate := time.Now()
+	if expense.Date != nil {
+		startDate = expense.Date.AsTime()
+	}
+
+	rt := &pfinancev1.RecurringTransaction{
+		Id:             uuid.New().String(),
+		UserId:         expense.UserId,
+		GroupId:        expense.GroupId,
+		Description:    expense.Description,
+		Amount:         effectiveDollars(expense.AmountCents, expense.Amount),
+		AmountCents:    store.ToCents(effectiveDollars(expense.AmountCents, expense.Amount)),
+		Category:       expense.Category,
+		Frequency:      req.Msg.Frequency,
+		StartDate:      timestamppb.New(startDate),
+		NextOccurrence: timestamppb.New(nextOccurrence(startDate, req.Msg.Frequency)),
+		Status:         pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+		IsExpense:      true,
+		CreatedAt:      timestamppb.Now(),
+		UpdatedAt:      timestamppb.Now(),
+		Tags:           expense.Tags,
+		PaidByUserId:   expense.PaidByUserId,
+		SplitType:      expense.SplitType,
+		Allocations:    expense.Allocations,
+	}
+
+	if err := s.store.CreateRecurringTransaction(ctx, rt); err != nil {
+		return nil, auth.WrapStoreError("create recurring transaction", err)
+	}
+
+	if req.Msg.DeleteOriginal {
+		if err := s.store.DeleteExpense(ctx, expense.Id); err != nil {
+			return nil, auth.WrapStoreError("delete expense", err)
+		}
+	}
+
+	return connect.NewResponse(&pfinancev1.ConvertExpenseToRecurringResponse{
+		RecurringTransaction: rt,
+	}), nil
+}
+
+// DetectRecurringPatterns suggests recurring transactions for regular
+// untracked spending in the expense history. Nothing is saved; the user
+// accepts a suggestion by creating its recurring transaction.
+func (s *FinanceService) DetectRecurringPatterns(ctx context.Context, req *connect.Request[pfinancev1.DetectRecurringPatternsRequest]) (*connect.Response[pfinancev1.DetectRecurringPatternsResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GroupId == "" {
+		if req.Msg.UserId != "" && req.Msg.UserId != claims.UID {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("cannot analyze another user's expenses"))
+		}
+	} else {
+		group, err := s.store.GetGroup(ctx, req.Msg.GroupId)
+		if err != nil {
+			return nil, auth.WrapStoreError("get group", err)
+		}
+		if !auth.IsGroupMember(claims.UID, group) {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("user is not a member of this group"))
+		}
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" && req.Msg.GroupId == "" {
+		userID = claims.UID
+	}
+
+	lookbackMonths := int32(6)
+	if req.Msg.LookbackMonths > 0 {
+		lookbackMonths = req.Msg.LookbackMonths
+	}
+
+	startTime := time.Now().AddDate(0, -int(lookbackMonths), 0)
+	expenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{StartDate: &startTime}, 1000, "")
+	if err != nil {
+		return nil, auth.WrapStoreError("list expenses", err)
+	}
+
+	existingRecurring, _, err := s.store.ListRecurringTransactions(ctx, userID, req.Msg.GroupId,
+		pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_UNSPECIFIED,
+		false, false, 1000, "")
+	if err != nil {
+		return nil, auth.WrapStoreError("list recurring transactions", err)
+	}
+
+	var suggestions []*pfinancev1.RecurringPatternSuggestion
+	for _, sub := range DetectSubscriptions(expenses, existingRecurring) {
+		if sub.IsAlreadyTracked {
+			continue
+		}
+		rt := &pfinancev1.RecurringTransaction{
+			UserId:         claims.UID,
+			GroupId:        req.Msg.GroupId,
+			Description:    sub.MerchantName,
+			Amount:         sub.AverageAmount,
+			AmountCents:    sub.AverageAmountCents,
+			Category:       pfinancev1.ExpenseCategory(pfinancev1.ExpenseCategory_value[sub.Category]),
+			Frequency:      sub.DetectedFrequency,
+			StartDate:      sub.LastSeen,
+			NextOccurrence: sub.ExpectedNext,
+			Status:         pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+			IsExpense:      true,
+		}
+		if req.Msg.GroupId != "" {
+			rt.PaidByUserId = claims.UID
+		}
+		suggestions = append(suggestions, &pfinancev1.RecurringPatternSuggestion{
+			RecurringTransaction: rt,
+			Frequency:            sub.DetectedFrequency,
+			TypicalAmountCents:   sub.AverageAmountCents,
+			NextPredictedDate:    sub.ExpectedNext,
+			Confidence:           sub.ConfidenceScore,
+			OccurrenceCount:      sub.OccurrenceCount,
+			MatchedExpenseIds:    sub.MatchedExpenseIds,
+		})
+	}
+
+	return connect.NewResponse(&pfinancev1.DetectRecurringPatternsResponse{
+		Suggestions: suggestions,
+	}), nil
+}
+
 // ============================================================================
 // Notification RPCs
 // ============================================================================
@@ -3994,7 +5608,16 @@ func (s *FinanceService) ListNotifications(ctx context.Context, req *connect.Req
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot list notifications for another user"))
 	}
 
-	notifications, nextPageToken, err := s.store.ListNotifications(ctx, userID, req.Msg.UnreadOnly, req.Msg.TypeFilter, req.Msg.PageSize, req.Msg.PageToken)
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.NotificationPageSize)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := store.QueryFingerprint(userID, req.Msg.UnreadOnly, req.Msg.TypeFilter)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	notifications, nextPageToken, err := s.store.ListNotifications(ctx, userID, req.Msg.UnreadOnly, req.Msg.TypeFilter, pageSize, pageToken)
 	if err != nil {
 		return nil, auth.WrapStoreError("list notifications", err)
 	}
@@ -4007,7 +5630,7 @@ func (s *FinanceService) ListNotifications(ctx context.Context, req *connect.Req
 
 	return connect.NewResponse(&pfinancev1.ListNotificationsResponse{
 		Notifications: notifications,
-		NextPageToken: nextPageToken,
+		NextPageToken: store.EncodeQueryPageToken(nextPageToken, fingerprint),
 		TotalUnread:   unreadCount,
 	}), nil
 }
@@ -4050,6 +5673,55 @@ func (s *FinanceService) MarkAllNotificationsRead(ctx context.Context, req *conn
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func (s *FinanceService) DeleteNotification(ctx context.Context, req *connect.Request[pfinancev1.DeleteNotificationRequest]) (*connect.Response[emptypb.Empty], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.NotificationId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("notification_id is required"))
+	}
+
+	notification, err := s.store.GetNotification(ctx, req.Msg.NotificationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("notification not found"))
+	}
+	if notification.UserId != claims.UID {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot delete another user's notification"))
+	}
+
+	if err := s.store.DeleteNotification(ctx, req.Msg.NotificationId); err != nil {
+		return nil, auth.WrapStoreError("delete notification", err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (s *FinanceService) DeleteAllReadNotifications(ctx context.Context, req *connect.Request[pfinancev1.DeleteAllReadNotificationsRequest]) (*connect.Response[pfinancev1.DeleteAllReadNotificationsResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" {
+		userID = claims.UID
+	}
+	if userID != claims.UID {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot modify notifications for another user"))
+	}
+
+	deleted, err := s.store.DeleteAllReadNotifications(ctx, userID)
+	if err != nil {
+		return nil, auth.WrapStoreError("delete read notifications", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.DeleteAllReadNotificationsResponse{
+		DeletedCount: deleted,
+	}), nil
+}
+
 func (s *FinanceService) GetUnreadNotificationCount(ctx context.Context, req *connect.Request[pfinancev1.GetUnreadNotificationCountRequest]) (*connect.Response[pfinancev1.GetUnreadNotificationCountResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
 	if err != nil {
@@ -4074,6 +5746,78 @@ func (s *FinanceService) GetUnreadNotificationCount(ctx context.Context, req *co
 	}), nil
 }
 
+// GetNotificationStats counts a user's notifications per UTC day and type
+func (s *FinanceService) GetNotificationStats(ctx context.Context, req *connect.Request[pfinancev1.GetNotificationStatsRequest]) (*connect.Response[pfinancev1.GetNotificationStatsResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" {
+		userID = claims.UID
+	}
+	if userID != claims.UID {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot query notifications for another user"))
+	}
+
+	if req.Msg.StartDate == nil || req.Msg.EndDate == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("start_date and end_date are required"))
+	}
+	startDate := req.Msg.StartDate.AsTime()
+	endDate := req.Msg.EndDate.AsTime()
+	if endDate.Before(startDate) {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("end_date must not be before start_date"))
+	}
+	if endDate.Sub(startDate).Hours()/24 > 366 {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("date range must not exceed 366 days"))
+	}
+
+	notifications, err := s.store.ListNotificationsInRange(ctx, userID, startDate, endDate)
+	if err != nil {
+		return nil, auth.WrapStoreError("list notifications", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.GetNotificationStatsResponse{
+		Counts:     countNotificationsByDay(notifications),
+		TotalCount: int32(len(notifications)),
+	}), nil
+}
+
+// countNotificationsByDay buckets notifications by UTC creation date and type,
+// sorted by date then type.
+func countNotificationsByDay(notifications []*pfinancev1.Notification) []*pfinancev1.NotificationDayCount {
+	type bucketKey struct {
+		date      string
+		notifType pfinancev1.NotificationType
+	}
+	buckets := make(map[bucketKey]*pfinancev1.NotificationDayCount)
+	for _, n := range notifications {
+		key := bucketKey{date: n.CreatedAt.AsTime().UTC().Format("2006-01-02"), notifType: n.Type}
+		bucket, ok := buckets[key]
+		if !ok {
+			bucket = &pfinancev1.NotificationDayCount{Date: key.date, Type: key.notifType}
+			buckets[key] = bucket
+		}
+		bucket.Count++
+	}
+
+	counts := make([]*pfinancev1.NotificationDayCount, 0, len(buckets))
+	for _, bucket := range buckets {
+		counts = append(counts, bucket)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Date != counts[j].Date {
+			return counts[i].Date < counts[j].Date
+		}
+		return counts[i].Type < counts[j].Type
+	})
+	return counts
+}
+
 func (s *FinanceService) GetNotificationPreferences(ctx context.Context, req *connect.Request[pfinancev1.GetNotificationPreferencesRequest]) (*connect.Response[pfinancev1.GetNotificationPreferencesResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
 	if err != nil {
@@ -4116,6 +5860,13 @@ func (s *FinanceService) UpdateNotificationPreferences(ctx context.Context, req
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("preferences is required"))
 	}
 
+	if p := req.Msg.Preferences.BudgetPaceMarginPct; p < 0 || p > 100 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("budget_pace_margin_pct must be between 0 and 100"))
+	}
+	if req.Msg.Preferences.MonthlySpendCapCents < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("monthly_spend_cap_cents cannot be negative"))
+	}
+
 	prefs := req.Msg.Preferences
 	prefs.UserId = userID
 
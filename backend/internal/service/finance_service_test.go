@@ -3,6 +3,9 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
@@ -11,6 +14,7 @@ import (
 	"github.com/castlemilk/pfinance/backend/internal/auth"
 	"github.com/castlemilk/pfinance/backend/internal/store"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -97,7 +101,7 @@ func TestCreateExpense(t *testing.T) {
 					AnyTimes()
 				mockStore.EXPECT().
 					CreateNotification(gomock.Any(), gomock.Any()).
-					Return(nil).
+					Return(nil, nil).
 					AnyTimes()
 			},
 			expectedError: false,
@@ -340,7 +344,7 @@ func TestCreateExpenseWithAllocation(t *testing.T) {
 		AnyTimes()
 	mockStore.EXPECT().
 		CreateNotification(gomock.Any(), gomock.Any()).
-		Return(nil).
+		Return(nil, nil).
 		AnyTimes()
 
 	for _, tt := range tests {
@@ -432,7 +436,7 @@ func TestListExpenses(t *testing.T) {
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
-					ListExpenses(gomock.Any(), "user-123", "", gomock.Any(), gomock.Any(), int32(10), "").
+					ListExpenses(gomock.Any(), "user-123", "", gomock.Any(), int32(10), "").
 					Return(mockExpenses, "", nil)
 			},
 			expectedCount: 2,
@@ -454,12 +458,31 @@ func TestListExpenses(t *testing.T) {
 						MemberIds: []string{"user-123"},
 					}, nil)
 				mockStore.EXPECT().
-					ListExpenses(gomock.Any(), "user-123", "group-456", gomock.Any(), gomock.Any(), int32(10), "").
+					ListExpenses(gomock.Any(), "user-123", "group-456", gomock.Any(), int32(10), "").
 					Return(mockExpenses, "", nil)
 			},
 			expectedCount: 2,
 			expectedError: false,
 		},
+		{
+			name: "passes category and tax-deductible filters to store",
+			request: &pfinancev1.ListExpensesRequest{
+				UserId:          "user-123",
+				PageSize:        10,
+				Category:        pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED.Enum(),
+				IsTaxDeductible: proto.Bool(true),
+			},
+			setupMock: func() {
+				mockStore.EXPECT().
+					ListExpenses(gomock.Any(), "user-123", "", gomock.Cond(func(q store.ExpenseQuery) bool {
+						return q.Category != nil && *q.Category == pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED &&
+							q.IsTaxDeductible != nil && *q.IsTaxDeductible
+					}), int32(10), "").
+					Return(mockExpenses[:1], "", nil)
+			},
+			expectedCount: 1,
+			expectedError: false,
+		},
 		{
 			name: "store error during listing",
 			request: &pfinancev1.ListExpensesRequest{
@@ -468,7 +491,7 @@ func TestListExpenses(t *testing.T) {
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
-					ListExpenses(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					ListExpenses(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, "", errors.New("store error"))
 			},
 			expectedError: true,
@@ -501,6 +524,509 @@ func TestListExpenses(t *testing.T) {
 	}
 }
 
+func TestListExpenses_PaginatesByDateThenID(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+	jan := timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	feb := timestamppb.New(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+	mar := timestamppb.New(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	// IDs deliberately disagree with date order, and b/c share a date.
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "a", UserId: "user-123", Date: mar},
+		{Id: "c", UserId: "user-123", Date: feb},
+		{Id: "b", UserId: "user-123", Date: feb},
+		{Id: "d", UserId: "user-123", Date: jan},
+		{Id: "e", UserId: "user-123"},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+
+	var got []string
+	token := ""
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatal("pagination did not terminate")
+		}
+		resp, err := service.ListExpenses(ctx, connect.NewRequest(&pfinancev1.ListExpensesRequest{
+			UserId:    "user-123",
+			PageSize:  2,
+			PageToken: token,
+		}))
+		if err != nil {
+			t.Fatalf("ListExpenses: %v", err)
+		}
+		for _, e := range resp.Msg.Expenses {
+			got = append(got, e.Id)
+		}
+		token = resp.Msg.NextPageToken
+		if token == "" {
+			break
+		}
+	}
+
+	want := []string{"e", "d", "b", "c", "a"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expense order = %v, want %v", got, want)
+	}
+
+	t.Run("legacy ID token resumes after that expense", func(t *testing.T) {
+		resp, err := service.ListExpenses(ctx, connect.NewRequest(&pfinancev1.ListExpensesRequest{
+			UserId:    "user-123",
+			PageSize:  10,
+			PageToken: store.EncodePageToken("b"),
+		}))
+		if err != nil {
+			t.Fatalf("ListExpenses: %v", err)
+		}
+		if len(resp.Msg.Expenses) != 2 || resp.Msg.Expenses[0].Id != "c" || resp.Msg.Expenses[1].Id != "a" {
+			t.Errorf("expenses after legacy token = %v, want [c a]", resp.Msg.Expenses)
+		}
+	})
+
+	t.Run("malformed token is rejected", func(t *testing.T) {
+		_, err := service.ListExpenses(ctx, connect.NewRequest(&pfinancev1.ListExpensesRequest{
+			UserId:    "user-123",
+			PageToken: "not base64!",
+		}))
+		if err == nil {
+			t.Error("expected error for malformed page token")
+		}
+	})
+
+	t.Run("token from a different query is rejected", func(t *testing.T) {
+		first, err := service.ListExpenses(ctx, connect.NewRequest(&pfinancev1.ListExpensesRequest{
+			UserId:   "user-123",
+			PageSize: 2,
+		}))
+		if err != nil {
+			t.Fatalf("ListExpenses: %v", err)
+		}
+
+		_, err = service.ListExpenses(ctx, connect.NewRequest(&pfinancev1.ListExpensesRequest{
+			UserId:    "user-123",
+			StartDate: feb,
+			PageSize:  2,
+			PageToken: first.Msg.NextPageToken,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+
+		// Page size is not part of the query, so it may change between pages
+		if _, err := service.ListExpenses(ctx, connect.NewRequest(&pfinancev1.ListExpensesRequest{
+			UserId:    "user-123",
+			PageSize:  3,
+			PageToken: first.Msg.NextPageToken,
+		})); err != nil {
+			t.Errorf("ListExpenses with a new page size: %v", err)
+		}
+	})
+}
+
+func TestListExpenses_FilterByTags(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	for _, req := range []*pfinancev1.CreateExpenseRequest{
+		{UserId: "user-123", Description: "Flights", AmountCents: 50000, Tags: []string{"Vacation-2025", " reimbursable", "vacation-2025"}},
+		{UserId: "user-123", Description: "Hotel", AmountCents: 30000, Tags: []string{"vacation-2025"}},
+		{UserId: "user-123", Description: "Taxi", AmountCents: 4000, Tags: []string{"REIMBURSABLE", "business-trip"}},
+		{UserId: "user-123", Description: "Groceries", AmountCents: 8000},
+	} {
+		if _, err := service.CreateExpense(ctx, connect.NewRequest(req)); err != nil {
+			t.Fatalf("CreateExpense(%s): %v", req.Description, err)
+		}
+	}
+
+	list := func(t *testing.T, tags []string, matchAll bool) []string {
+		t.Helper()
+		resp, err := service.ListExpenses(ctx, connect.NewRequest(&pfinancev1.ListExpensesRequest{
+			UserId:       "user-123",
+			Tags:         tags,
+			MatchAllTags: matchAll,
+		}))
+		if err != nil {
+			t.Fatalf("ListExpenses: %v", err)
+		}
+		var got []string
+		for _, e := range resp.Msg.Expenses {
+			got = append(got, e.Description)
+		}
+		slices.Sort(got)
+		return got
+	}
+
+	t.Run("tags are normalized on write", func(t *testing.T) {
+		resp, err := service.ListExpenses(ctx, connect.NewRequest(&pfinancev1.ListExpensesRequest{UserId: "user-123"}))
+		if err != nil {
+			t.Fatalf("ListExpenses: %v", err)
+		}
+		for _, e := range resp.Msg.Expenses {
+			if e.Description == "Flights" && !slices.Equal(e.Tags, []string{"vacation-2025", "reimbursable"}) {
+				t.Errorf("Flights tags = %v, want [vacation-2025 reimbursable]", e.Tags)
+			}
+		}
+	})
+
+	t.Run("match any", func(t *testing.T) {
+		got := list(t, []string{"Reimbursable", "business-trip"}, false)
+		if want := []string{"Flights", "Taxi"}; !slices.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("match all", func(t *testing.T) {
+		got := list(t, []string{"vacation-2025", "reimbursable"}, true)
+		if want := []string{"Flights"}; !slices.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("too many tags", func(t *testing.T) {
+		tags := make([]string, maxTagFilterValues+1)
+		for i := range tags {
+			tags[i] = fmt.Sprintf("tag-%d", i)
+		}
+		_, err := service.ListExpenses(ctx, connect.NewRequest(&pfinancev1.ListExpensesRequest{
+			UserId: "user-123",
+			Tags:   tags,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("expected CodeInvalidArgument, got %v", connect.CodeOf(err))
+		}
+	})
+}
+
+func TestListExpenses_FilterByAttachment(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "receipt", UserId: "user-123", Description: "Laptop", IsTaxDeductible: true, ReceiptStoragePath: "receipts/user-123/receipt/receipt.jpg"},
+		{Id: "attachment", UserId: "user-123", Description: "Phone", Attachments: []*pfinancev1.AttachmentRef{{StoragePath: "receipts/user-123/attachment/invoice.pdf"}}},
+		{Id: "missing", UserId: "user-123", Description: "Desk", IsTaxDeductible: true},
+		{Id: "plain", UserId: "user-123", Description: "Coffee"},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+
+	list := func(t *testing.T, hasAttachment, isTaxDeductible *bool) []string {
+		t.Helper()
+		resp, err := service.ListExpenses(ctx, connect.NewRequest(&pfinancev1.ListExpensesRequest{
+			UserId:          "user-123",
+			HasAttachment:   hasAttachment,
+			IsTaxDeductible: isTaxDeductible,
+		}))
+		if err != nil {
+			t.Fatalf("ListExpenses: %v", err)
+		}
+		var got []string
+		for _, e := range resp.Msg.Expenses {
+			got = append(got, e.Id)
+		}
+		slices.Sort(got)
+		return got
+	}
+
+	tests := []struct {
+		name            string
+		hasAttachment   *bool
+		isTaxDeductible *bool
+		want            []string
+	}{
+		{name: "any", want: []string{"attachment", "missing", "plain", "receipt"}},
+		{name: "with attachment", hasAttachment: proto.Bool(true), want: []string{"attachment", "receipt"}},
+		{name: "without attachment", hasAttachment: proto.Bool(false), want: []string{"missing", "plain"}},
+		{name: "deductible without receipt", hasAttachment: proto.Bool(false), isTaxDeductible: proto.Bool(true), want: []string{"missing"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := list(t, tt.hasAttachment, tt.isTaxDeductible); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaginationDefaults(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	for _, id := range []string{"a", "b", "c"} {
+		if err := memStore.CreateExpense(t.Context(), &pfinancev1.Expense{Id: id, UserId: "user-123"}); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+		if _, err := memStore.CreateNotification(t.Context(), &pfinancev1.Notification{Id: id, UserId: "user-123"}); err != nil {
+			t.Fatalf("CreateNotification: %v", err)
+		}
+	}
+
+	t.Run("page size above the maximum is rejected", func(t *testing.T) {
+		_, err := service.ListExpenses(ctx, connect.NewRequest(&pfinancev1.ListExpensesRequest{
+			UserId:   "user-123",
+			PageSize: 1001,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("ListExpenses code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+		_, err = service.ListNotifications(ctx, connect.NewRequest(&pfinancev1.ListNotificationsRequest{PageSize: 1001}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("ListNotifications code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("configured defaults apply per endpoint", func(t *testing.T) {
+		service := NewFinanceService(memStore, nil, nil)
+		service.SetPaginationDefaults(PaginationDefaults{
+			DefaultPageSize:      2,
+			SearchPageSize:       2,
+			NotificationPageSize: 1,
+			MaxPageSize:          10,
+		})
+
+		expenses, err := service.ListExpenses(ctx, connect.NewRequest(&pfinancev1.ListExpensesRequest{UserId: "user-123"}))
+		if err != nil {
+			t.Fatalf("ListExpenses: %v", err)
+		}
+		if len(expenses.Msg.Expenses) != 2 || expenses.Msg.NextPageToken == "" {
+			t.Errorf("got %d expenses (next %q), want a page of 2", len(expenses.Msg.Expenses), expenses.Msg.NextPageToken)
+		}
+
+		notifications, err := service.ListNotifications(ctx, connect.NewRequest(&pfinancev1.ListNotificationsRequest{}))
+		if err != nil {
+			t.Fatalf("ListNotifications: %v", err)
+		}
+		if len(notifications.Msg.Notifications) != 1 {
+			t.Errorf("got %d notifications, want a page of 1", len(notifications.Msg.Notifications))
+		}
+
+		_, err = service.ListExpenses(ctx, connect.NewRequest(&pfinancev1.ListExpensesRequest{UserId: "user-123", PageSize: 11}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument above the configured maximum", connect.CodeOf(err))
+		}
+	})
+}
+
+func TestGetTransactionCounts(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	jan := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2025, 2, 15, 0, 0, 0, 0, time.UTC)
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "e1", UserId: "user-123", Date: timestamppb.New(jan)},
+		{Id: "e2", UserId: "user-123", Date: timestamppb.New(feb)},
+		{Id: "e3", UserId: "user-123", Date: timestamppb.New(feb)},
+		{Id: "e4", UserId: "other-user", Date: timestamppb.New(feb)},
+		{Id: "e5", UserId: "user-123", GroupId: "group-1", Date: timestamppb.New(feb)},
+		{Id: "e6", UserId: "owner-1", GroupId: "group-1", Date: timestamppb.New(feb)},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+	for _, i := range []*pfinancev1.Income{
+		{Id: "i1", UserId: "user-123", Date: timestamppb.New(jan)},
+		{Id: "i2", UserId: "user-123", Date: timestamppb.New(feb)},
+	} {
+		if err := memStore.CreateIncome(t.Context(), i); err != nil {
+			t.Fatalf("CreateIncome: %v", err)
+		}
+	}
+	if err := memStore.CreateGroup(t.Context(), &pfinancev1.FinanceGroup{
+		Id: "group-1", OwnerId: "owner-1", MemberIds: []string{"owner-1", "user-123"},
+	}); err != nil {
+		t.Fatalf("CreateGroup: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		req          *pfinancev1.GetTransactionCountsRequest
+		wantExpenses int64
+		wantIncomes  int64
+	}{
+		{"defaults to caller's personal transactions", &pfinancev1.GetTransactionCountsRequest{}, 3, 2},
+		{"date range", &pfinancev1.GetTransactionCountsRequest{
+			StartDate: timestamppb.New(feb.AddDate(0, 0, -1)),
+			EndDate:   timestamppb.New(feb.AddDate(0, 0, 1)),
+		}, 2, 1},
+		{"group", &pfinancev1.GetTransactionCountsRequest{GroupId: "group-1"}, 2, 0},
+		{"group narrowed to a member", &pfinancev1.GetTransactionCountsRequest{GroupId: "group-1", UserId: "user-123"}, 1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.GetTransactionCounts(ctx, connect.NewRequest(tt.req))
+			if err != nil {
+				t.Fatalf("GetTransactionCounts: %v", err)
+			}
+			if resp.Msg.ExpenseCount != tt.wantExpenses || resp.Msg.IncomeCount != tt.wantIncomes {
+				t.Errorf("counts = (%d, %d), want (%d, %d)",
+					resp.Msg.ExpenseCount, resp.Msg.IncomeCount, tt.wantExpenses, tt.wantIncomes)
+			}
+		})
+	}
+
+	t.Run("another user is denied", func(t *testing.T) {
+		_, err := service.GetTransactionCounts(ctx, connect.NewRequest(&pfinancev1.GetTransactionCountsRequest{UserId: "other-user"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+	})
+}
+
+func TestGetTopExpenses(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	jan := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2025, 2, 15, 0, 0, 0, 0, time.UTC)
+	food := pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "rent", UserId: "user-123", AmountCents: 200000, Date: timestamppb.New(feb)},
+		{Id: "legacy", UserId: "user-123", Amount: 120.5, Category: food, Date: timestamppb.New(feb)},
+		{Id: "lunch", UserId: "user-123", AmountCents: 1850, Category: food, Date: timestamppb.New(feb)},
+		{Id: "coffee", UserId: "user-123", AmountCents: 450, Category: food, Date: timestamppb.New(feb)},
+		{Id: "old", UserId: "user-123", AmountCents: 999999, Date: timestamppb.New(jan)},
+		{Id: "other", UserId: "other-user", AmountCents: 500000, Date: timestamppb.New(feb)},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+	february := func(req *pfinancev1.GetTopExpensesRequest) *pfinancev1.GetTopExpensesRequest {
+		req.StartDate = timestamppb.New(feb.AddDate(0, 0, -14))
+		req.EndDate = timestamppb.New(feb.AddDate(0, 0, 13))
+		return req
+	}
+
+	tests := []struct {
+		name      string
+		req       *pfinancev1.GetTopExpensesRequest
+		want      []string
+		wantTotal int64
+	}{
+		{"largest first", february(&pfinancev1.GetTopExpensesRequest{Limit: 3}), []string{"rent", "legacy", "lunch"}, 213900},
+		{"category filter", february(&pfinancev1.GetTopExpensesRequest{Limit: 2, Category: &food}), []string{"legacy", "lunch"}, 13900},
+		{"no range defaults to every date", &pfinancev1.GetTopExpensesRequest{Limit: 1}, []string{"old"}, 999999},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.GetTopExpenses(ctx, connect.NewRequest(tt.req))
+			if err != nil {
+				t.Fatalf("GetTopExpenses: %v", err)
+			}
+			var got []string
+			for _, e := range resp.Msg.Expenses {
+				got = append(got, e.Id)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expenses = %v, want %v", got, tt.want)
+			}
+			if resp.Msg.TotalCents != tt.wantTotal {
+				t.Errorf("total = %d, want %d", resp.Msg.TotalCents, tt.wantTotal)
+			}
+		})
+	}
+
+	t.Run("legacy amounts are returned in cents", func(t *testing.T) {
+		resp, err := service.GetTopExpenses(ctx, connect.NewRequest(february(&pfinancev1.GetTopExpensesRequest{Category: &food})))
+		if err != nil {
+			t.Fatalf("GetTopExpenses: %v", err)
+		}
+		if got := resp.Msg.Expenses[0].AmountCents; got != 12050 {
+			t.Errorf("legacy amount_cents = %d, want 12050", got)
+		}
+		stored, _ := memStore.GetExpense(t.Context(), "legacy")
+		if stored.AmountCents != 0 {
+			t.Errorf("stored expense was modified: amount_cents = %d", stored.AmountCents)
+		}
+	})
+
+	t.Run("another user is denied", func(t *testing.T) {
+		_, err := service.GetTopExpenses(ctx, connect.NewRequest(&pfinancev1.GetTopExpensesRequest{UserId: "other-user"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+	})
+}
+
+func TestListExpensesScope(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	ctx := t.Context()
+
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "personal", UserId: "user-123", Date: timestamppb.Now()},
+		{Id: "own-shared", UserId: "user-123", GroupId: "group-1", Date: timestamppb.Now()},
+		{Id: "member-shared", UserId: "other-user", GroupId: "group-1", Date: timestamppb.Now()},
+		{Id: "other-group", UserId: "other-user", GroupId: "group-2", Date: timestamppb.Now()},
+		{Id: "other-personal", UserId: "other-user", Date: timestamppb.Now()},
+	} {
+		if err := memStore.CreateExpense(ctx, e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+	for _, g := range []*pfinancev1.FinanceGroup{
+		{Id: "group-1", OwnerId: "user-123", MemberIds: []string{"user-123", "other-user"}},
+		{Id: "group-2", OwnerId: "other-user", MemberIds: []string{"other-user"}},
+	} {
+		if err := memStore.CreateGroup(ctx, g); err != nil {
+			t.Fatalf("CreateGroup: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		userID  string
+		groupID string
+		scope   store.ExpenseScope
+		want    []string
+	}{
+		{"default ANDs user and group", "user-123", "group-1", store.ExpenseScopeDefault, []string{"own-shared"}},
+		{"default user includes their group expenses", "user-123", "", store.ExpenseScopeDefault, []string{"own-shared", "personal"}},
+		{"personal excludes group expenses", "user-123", "", store.ExpenseScopePersonal, []string{"personal"}},
+		{"group includes every member", "", "group-1", store.ExpenseScopeGroup, []string{"member-shared", "own-shared"}},
+		{"all adds member groups", "user-123", "", store.ExpenseScopeAll, []string{"member-shared", "own-shared", "personal"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expenses, _, err := memStore.ListExpenses(ctx, tt.userID, tt.groupID, store.ExpenseQuery{Scope: tt.scope}, 0, "")
+			if err != nil {
+				t.Fatalf("ListExpenses: %v", err)
+			}
+			var got []string
+			for _, e := range expenses {
+				got = append(got, e.Id)
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expenses = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("rejects the other ID", func(t *testing.T) {
+		for _, scope := range []store.ExpenseScope{store.ExpenseScopePersonal, store.ExpenseScopeAll} {
+			if _, _, err := memStore.ListExpenses(ctx, "user-123", "group-1", store.ExpenseQuery{Scope: scope}, 0, ""); err == nil {
+				t.Errorf("scope %d with a group ID: expected error", scope)
+			}
+		}
+		if _, _, err := memStore.ListExpenses(ctx, "user-123", "group-1", store.ExpenseQuery{Scope: store.ExpenseScopeGroup}, 0, ""); err == nil {
+			t.Error("group scope with a user ID: expected error")
+		}
+	})
+}
+
 func TestCreateGroup(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -684,12 +1210,22 @@ func TestInviteToGroup(t *testing.T) {
 	}
 }
 
+// expectTransactions makes RunInTransaction invoke its callback directly
+// against the mock so handler tests can set expectations on the inner calls.
+func expectTransactions(mockStore *store.MockStore) {
+	mockStore.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(store.Store) error) error {
+			return fn(mockStore)
+		}).AnyTimes()
+}
+
 func TestAcceptInvitation(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStore := store.NewMockStore(ctrl)
 	service := NewFinanceService(mockStore, nil, nil)
+	expectTransactions(mockStore)
 
 	mockInvitation := &pfinancev1.GroupInvitation{
 		Id:           "inv-123",
@@ -873,6 +1409,102 @@ func TestCreateIncome(t *testing.T) {
 	}
 }
 
+func TestIncomeDeductionValidation(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	create := func(amount float64, deductions ...*pfinancev1.Deduction) (*pfinancev1.Income, error) {
+		resp, err := service.CreateIncome(ctx, connect.NewRequest(&pfinancev1.CreateIncomeRequest{
+			UserId:     "user-123",
+			Source:     "Salary",
+			Amount:     amount,
+			Deductions: deductions,
+			Date:       timestamppb.Now(),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Income, nil
+	}
+
+	t.Run("dual-writes deduction cents", func(t *testing.T) {
+		income, err := create(5000, &pfinancev1.Deduction{Name: "PAYG", Amount: 1234.56}, &pfinancev1.Deduction{Name: "Super", AmountCents: 47500})
+		if err != nil {
+			t.Fatalf("CreateIncome: %v", err)
+		}
+		if got := income.Deductions[0].AmountCents; got != 123456 {
+			t.Errorf("PAYG AmountCents = %d, want 123456", got)
+		}
+		if got := income.Deductions[1].Amount; got != 475 {
+			t.Errorf("Super Amount = %f, want 475", got)
+		}
+	})
+
+	t.Run("rejects negative deduction", func(t *testing.T) {
+		_, err := create(5000, &pfinancev1.Deduction{Name: "PAYG", AmountCents: -100})
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("rejects deductions exceeding income", func(t *testing.T) {
+		_, err := create(1000, &pfinancev1.Deduction{Name: "PAYG", Amount: 600}, &pfinancev1.Deduction{Name: "Super", Amount: 400.01})
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("update cannot lower income below stored deductions", func(t *testing.T) {
+		income, err := create(1000, &pfinancev1.Deduction{Name: "PAYG", Amount: 300})
+		if err != nil {
+			t.Fatalf("CreateIncome: %v", err)
+		}
+		_, err = service.UpdateIncome(ctx, connect.NewRequest(&pfinancev1.UpdateIncomeRequest{
+			IncomeId: income.Id,
+			Amount:   200,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("deductions may equal the income", func(t *testing.T) {
+		income, err := create(19.99, &pfinancev1.Deduction{Name: "PAYG", Amount: 19.99})
+		if err != nil {
+			t.Fatalf("CreateIncome: %v", err)
+		}
+		if income.AmountCents != 1999 {
+			t.Errorf("AmountCents = %d, want 1999", income.AmountCents)
+		}
+		_, err = service.UpdateIncome(ctx, connect.NewRequest(&pfinancev1.UpdateIncomeRequest{
+			IncomeId: income.Id,
+			Amount:   19.99,
+		}))
+		if err != nil {
+			t.Errorf("UpdateIncome: %v", err)
+		}
+	})
+
+	t.Run("update back-fills cents on legacy incomes", func(t *testing.T) {
+		legacy := &pfinancev1.Income{Id: "legacy", UserId: "user-123", Source: "Salary", Amount: 1000,
+			Deductions: []*pfinancev1.Deduction{{Name: "PAYG", Amount: 300}}}
+		if err := memStore.CreateIncome(t.Context(), legacy); err != nil {
+			t.Fatalf("CreateIncome: %v", err)
+		}
+		resp, err := service.UpdateIncome(ctx, connect.NewRequest(&pfinancev1.UpdateIncomeRequest{
+			IncomeId: "legacy",
+			Source:   "Employer",
+		}))
+		if err != nil {
+			t.Fatalf("UpdateIncome: %v", err)
+		}
+		if resp.Msg.Income.AmountCents != 100000 {
+			t.Errorf("AmountCents = %d, want 100000", resp.Msg.Income.AmountCents)
+		}
+	})
+}
+
 func TestGetIncome(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -991,6 +1623,93 @@ func TestGetIncome(t *testing.T) {
 	}
 }
 
+func TestGetWithContributions(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+	earlier := timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	later := timestamppb.New(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	if err := memStore.CreateGroup(t.Context(), &pfinancev1.FinanceGroup{Id: "group-1", OwnerId: "user-123", MemberIds: []string{"user-123"}}); err != nil {
+		t.Fatalf("CreateGroup: %v", err)
+	}
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "personal-exp", UserId: "user-123"},
+		{Id: "group-exp", UserId: "user-123", GroupId: "group-1"},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+	for _, c := range []*pfinancev1.ExpenseContribution{
+		{Id: "ec-2", SourceExpenseId: "personal-exp", CreatedGroupExpenseId: "group-exp", ContributedAt: later},
+		{Id: "ec-1", SourceExpenseId: "other-exp", CreatedGroupExpenseId: "group-exp", ContributedAt: earlier},
+		{Id: "ec-unrelated", SourceExpenseId: "other-exp", CreatedGroupExpenseId: "other-group-exp", ContributedAt: earlier},
+	} {
+		if err := memStore.CreateContribution(t.Context(), c); err != nil {
+			t.Fatalf("CreateContribution: %v", err)
+		}
+	}
+	if err := memStore.CreateIncome(t.Context(), &pfinancev1.Income{Id: "salary", UserId: "user-123"}); err != nil {
+		t.Fatalf("CreateIncome: %v", err)
+	}
+	if err := memStore.CreateIncomeContribution(t.Context(), &pfinancev1.IncomeContribution{
+		Id: "ic-1", SourceIncomeId: "salary", CreatedGroupIncomeId: "group-salary", ContributedAt: earlier,
+	}); err != nil {
+		t.Fatalf("CreateIncomeContribution: %v", err)
+	}
+
+	t.Run("group expense includes contributions that created it", func(t *testing.T) {
+		resp, err := service.GetExpense(ctx, connect.NewRequest(&pfinancev1.GetExpenseRequest{
+			ExpenseId:            "group-exp",
+			IncludeContributions: true,
+		}))
+		if err != nil {
+			t.Fatalf("GetExpense: %v", err)
+		}
+		got := resp.Msg.Contributions
+		if len(got) != 2 || got[0].Id != "ec-1" || got[1].Id != "ec-2" {
+			t.Errorf("Expected [ec-1 ec-2] oldest first, got %v", got)
+		}
+	})
+
+	t.Run("personal expense includes contributions sourced from it", func(t *testing.T) {
+		resp, err := service.GetExpense(ctx, connect.NewRequest(&pfinancev1.GetExpenseRequest{
+			ExpenseId:            "personal-exp",
+			IncludeContributions: true,
+		}))
+		if err != nil {
+			t.Fatalf("GetExpense: %v", err)
+		}
+		if len(resp.Msg.Contributions) != 1 || resp.Msg.Contributions[0].Id != "ec-2" {
+			t.Errorf("Expected [ec-2], got %v", resp.Msg.Contributions)
+		}
+	})
+
+	t.Run("contributions omitted unless requested", func(t *testing.T) {
+		resp, err := service.GetExpense(ctx, connect.NewRequest(&pfinancev1.GetExpenseRequest{ExpenseId: "group-exp"}))
+		if err != nil {
+			t.Fatalf("GetExpense: %v", err)
+		}
+		if len(resp.Msg.Contributions) != 0 {
+			t.Errorf("Expected no contributions, got %d", len(resp.Msg.Contributions))
+		}
+	})
+
+	t.Run("income includes its contributions", func(t *testing.T) {
+		resp, err := service.GetIncome(ctx, connect.NewRequest(&pfinancev1.GetIncomeRequest{
+			IncomeId:             "salary",
+			IncludeContributions: true,
+		}))
+		if err != nil {
+			t.Fatalf("GetIncome: %v", err)
+		}
+		if len(resp.Msg.Contributions) != 1 || resp.Msg.Contributions[0].Id != "ic-1" {
+			t.Errorf("Expected [ic-1], got %v", resp.Msg.Contributions)
+		}
+	})
+}
+
 func TestGetTaxConfig(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1263,6 +1982,89 @@ func TestDeleteExpense(t *testing.T) {
 	}
 }
 
+func TestExpenseAttachments(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	if err := memStore.CreateExpense(t.Context(), &pfinancev1.Expense{Id: "expense-1", UserId: "user-123"}); err != nil {
+		t.Fatalf("CreateExpense: %v", err)
+	}
+	if err := memStore.CreateExpense(t.Context(), &pfinancev1.Expense{Id: "expense-other", UserId: "user-456"}); err != nil {
+		t.Fatalf("CreateExpense: %v", err)
+	}
+
+	add := func(expenseID, path string) (*pfinancev1.Expense, error) {
+		resp, err := service.AddExpenseAttachment(ctx, connect.NewRequest(&pfinancev1.AddExpenseAttachmentRequest{
+			ExpenseId:  expenseID,
+			Attachment: &pfinancev1.AttachmentRef{StoragePath: path, ContentType: "image/jpeg"},
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Expense, nil
+	}
+
+	expense, err := add("expense-1", "receipts/user-123/expense-1/a.jpg")
+	if err != nil {
+		t.Fatalf("AddExpenseAttachment: %v", err)
+	}
+	if len(expense.Attachments) != 1 || expense.Attachments[0].UploadedAt == nil {
+		t.Fatalf("expected one attachment with uploaded_at set, got %v", expense.Attachments)
+	}
+	if _, err := add("expense-1", "receipts/user-123/expense-1/b.jpg"); err != nil {
+		t.Fatalf("AddExpenseAttachment: %v", err)
+	}
+
+	errCases := []struct {
+		name      string
+		expenseID string
+		path      string
+		code      connect.Code
+	}{
+		{"duplicate path", "expense-1", "receipts/user-123/expense-1/a.jpg", connect.CodeAlreadyExists},
+		{"missing path", "expense-1", "", connect.CodeInvalidArgument},
+		{"another user's expense", "expense-other", "receipts/x.jpg", connect.CodePermissionDenied},
+		{"unknown expense", "expense-missing", "receipts/x.jpg", connect.CodeNotFound},
+	}
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := add(tc.expenseID, tc.path)
+			if connect.CodeOf(err) != tc.code {
+				t.Errorf("expected %v, got %v", tc.code, err)
+			}
+		})
+	}
+
+	resp, err := service.RemoveExpenseAttachment(ctx, connect.NewRequest(&pfinancev1.RemoveExpenseAttachmentRequest{
+		ExpenseId:   "expense-1",
+		StoragePath: "receipts/user-123/expense-1/a.jpg",
+	}))
+	if err != nil {
+		t.Fatalf("RemoveExpenseAttachment: %v", err)
+	}
+	remaining := resp.Msg.Expense.Attachments
+	if len(remaining) != 1 || remaining[0].StoragePath != "receipts/user-123/expense-1/b.jpg" {
+		t.Errorf("expected only b.jpg to remain, got %v", remaining)
+	}
+
+	stored, err := memStore.GetExpense(t.Context(), "expense-1")
+	if err != nil {
+		t.Fatalf("GetExpense: %v", err)
+	}
+	if len(stored.Attachments) != 1 {
+		t.Errorf("expected stored expense to have 1 attachment, got %d", len(stored.Attachments))
+	}
+
+	_, err = service.RemoveExpenseAttachment(ctx, connect.NewRequest(&pfinancev1.RemoveExpenseAttachmentRequest{
+		ExpenseId:   "expense-1",
+		StoragePath: "receipts/user-123/expense-1/a.jpg",
+	}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("expected NotFound removing a missing attachment, got %v", err)
+	}
+}
+
 func TestBatchCreateExpenses(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1459,7 +2261,122 @@ func TestBatchCreateExpenses(t *testing.T) {
 	}
 }
 
-func TestUpdateIncome(t *testing.T) {
+func TestQuickAddRepeatingExpense(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	monday := time.Date(2025, time.September, 1, 8, 0, 0, 0, time.UTC)
+	template := &pfinancev1.CreateExpenseRequest{
+		Description: "Coffee",
+		AmountCents: 550,
+		Category:    pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD,
+		Frequency:   pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_ONCE,
+	}
+
+	t.Run("creates an expense per matching day", func(t *testing.T) {
+		resp, err := service.QuickAddRepeatingExpense(ctx, connect.NewRequest(&pfinancev1.QuickAddRepeatingExpenseRequest{
+			Template:  template,
+			StartDate: timestamppb.New(monday),
+			EndDate:   timestamppb.New(monday.AddDate(0, 0, 13)),
+			DaysOfWeek: []pfinancev1.DayOfWeek{
+				pfinancev1.DayOfWeek_DAY_OF_WEEK_MONDAY,
+				pfinancev1.DayOfWeek_DAY_OF_WEEK_WEDNESDAY,
+				pfinancev1.DayOfWeek_DAY_OF_WEEK_FRIDAY,
+			},
+		}))
+		if err != nil {
+			t.Fatalf("QuickAddRepeatingExpense: %v", err)
+		}
+		if len(resp.Msg.ExpenseIds) != 6 {
+			t.Fatalf("created %d expenses, want 6", len(resp.Msg.ExpenseIds))
+		}
+
+		wantOffsets := []int{0, 2, 4, 7, 9, 11}
+		for i, id := range resp.Msg.ExpenseIds {
+			e, err := memStore.GetExpense(t.Context(), id)
+			if err != nil {
+				t.Fatalf("GetExpense: %v", err)
+			}
+			if want := monday.AddDate(0, 0, wantOffsets[i]); !e.Date.AsTime().Equal(want) {
+				t.Errorf("expense %d date = %v, want %v", i, e.Date.AsTime(), want)
+			}
+			if e.UserId != "user-123" || e.Description != "Coffee" || e.AmountCents != 550 {
+				t.Errorf("expense %d = %v, want a copy of the template for user-123", i, e)
+			}
+		}
+	})
+
+	t.Run("every day when no days are given", func(t *testing.T) {
+		resp, err := service.QuickAddRepeatingExpense(ctx, connect.NewRequest(&pfinancev1.QuickAddRepeatingExpenseRequest{
+			Template:  template,
+			StartDate: timestamppb.New(monday),
+			EndDate:   timestamppb.New(monday.AddDate(0, 0, 2)),
+		}))
+		if err != nil {
+			t.Fatalf("QuickAddRepeatingExpense: %v", err)
+		}
+		if len(resp.Msg.ExpenseIds) != 3 {
+			t.Errorf("created %d expenses, want 3", len(resp.Msg.ExpenseIds))
+		}
+	})
+
+	t.Run("rejects invalid requests", func(t *testing.T) {
+		tests := []struct {
+			name string
+			req  *pfinancev1.QuickAddRepeatingExpenseRequest
+			code connect.Code
+		}{
+			{
+				name: "range too long",
+				req: &pfinancev1.QuickAddRepeatingExpenseRequest{
+					Template:  template,
+					StartDate: timestamppb.New(monday),
+					EndDate:   timestamppb.New(monday.AddDate(0, 0, maxRepeatingExpenseDays)),
+				},
+				code: connect.CodeInvalidArgument,
+			},
+			{
+				name: "end before start",
+				req: &pfinancev1.QuickAddRepeatingExpenseRequest{
+					Template:  template,
+					StartDate: timestamppb.New(monday),
+					EndDate:   timestamppb.New(monday.AddDate(0, 0, -1)),
+				},
+				code: connect.CodeInvalidArgument,
+			},
+			{
+				name: "no matching days",
+				req: &pfinancev1.QuickAddRepeatingExpenseRequest{
+					Template:   template,
+					StartDate:  timestamppb.New(monday),
+					EndDate:    timestamppb.New(monday),
+					DaysOfWeek: []pfinancev1.DayOfWeek{pfinancev1.DayOfWeek_DAY_OF_WEEK_SUNDAY},
+				},
+				code: connect.CodeInvalidArgument,
+			},
+			{
+				name: "another user",
+				req: &pfinancev1.QuickAddRepeatingExpenseRequest{
+					Template:  &pfinancev1.CreateExpenseRequest{UserId: "user-456", Description: "Coffee", AmountCents: 550},
+					StartDate: timestamppb.New(monday),
+					EndDate:   timestamppb.New(monday),
+				},
+				code: connect.CodePermissionDenied,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := service.QuickAddRepeatingExpense(ctx, connect.NewRequest(tt.req))
+				if connect.CodeOf(err) != tt.code {
+					t.Errorf("code = %v, want %v", connect.CodeOf(err), tt.code)
+				}
+			})
+		}
+	})
+}
+
+func TestUpdateIncome(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -1677,6 +2594,94 @@ func TestDeleteIncome(t *testing.T) {
 	}
 }
 
+func TestDeleteIncomes_CascadeContributions(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	if err := memStore.CreateGroup(t.Context(), &pfinancev1.FinanceGroup{Id: "group-1", OwnerId: "user-123", MemberIds: []string{"user-123"}}); err != nil {
+		t.Fatalf("CreateGroup: %v", err)
+	}
+	for _, income := range []*pfinancev1.Income{
+		{Id: "salary", UserId: "user-123", AmountCents: 500000},
+		{Id: "bonus", UserId: "user-123", AmountCents: 100000},
+		{Id: "group-salary", UserId: "user-123", GroupId: "group-1", AmountCents: 200000},
+		{Id: "other", UserId: "user-456", AmountCents: 300000},
+	} {
+		if err := memStore.CreateIncome(t.Context(), income); err != nil {
+			t.Fatalf("CreateIncome: %v", err)
+		}
+	}
+	for _, c := range []*pfinancev1.IncomeContribution{
+		{Id: "c-salary", SourceIncomeId: "salary", CreatedGroupIncomeId: "group-salary", TargetGroupId: "group-1", ContributedBy: "user-123"},
+		{Id: "c-bonus", SourceIncomeId: "bonus", CreatedGroupIncomeId: "group-bonus", TargetGroupId: "group-1", ContributedBy: "user-123"},
+		{Id: "c-other", SourceIncomeId: "other", CreatedGroupIncomeId: "group-other", TargetGroupId: "group-1", ContributedBy: "user-456"},
+	} {
+		if err := memStore.CreateIncomeContribution(t.Context(), c); err != nil {
+			t.Fatalf("CreateIncomeContribution: %v", err)
+		}
+	}
+
+	remaining := func() map[string]bool {
+		t.Helper()
+		contributions, _, err := memStore.ListIncomeContributions(t.Context(), "group-1", "", 100, "")
+		if err != nil {
+			t.Fatalf("ListIncomeContributions: %v", err)
+		}
+		ids := make(map[string]bool)
+		for _, c := range contributions {
+			ids[c.Id] = true
+		}
+		return ids
+	}
+
+	t.Run("batch delete skips unowned incomes and cascades", func(t *testing.T) {
+		resp, err := service.BatchDeleteIncomes(ctx, connect.NewRequest(&pfinancev1.BatchDeleteIncomesRequest{
+			IncomeIds: []string{"bonus", "other", "missing"},
+		}))
+		if err != nil {
+			t.Fatalf("BatchDeleteIncomes: %v", err)
+		}
+		if resp.Msg.DeletedCount != 1 {
+			t.Errorf("Expected 1 deleted, got %d", resp.Msg.DeletedCount)
+		}
+		if len(resp.Msg.FailedIncomeIds) != 2 {
+			t.Errorf("Expected 2 failed IDs, got %v", resp.Msg.FailedIncomeIds)
+		}
+		if _, err := memStore.GetIncome(t.Context(), "bonus"); err == nil {
+			t.Error("Expected bonus income to be deleted")
+		}
+		if _, err := memStore.GetIncome(t.Context(), "other"); err != nil {
+			t.Error("Expected another user's income to survive")
+		}
+		if ids := remaining(); ids["c-bonus"] || !ids["c-other"] || !ids["c-salary"] {
+			t.Errorf("Unexpected contributions after batch delete: %v", ids)
+		}
+	})
+
+	t.Run("deleting the resulting group income removes the contribution", func(t *testing.T) {
+		if _, err := service.DeleteIncome(ctx, connect.NewRequest(&pfinancev1.DeleteIncomeRequest{
+			IncomeId: "group-salary",
+		})); err != nil {
+			t.Fatalf("DeleteIncome: %v", err)
+		}
+		if ids := remaining(); ids["c-salary"] {
+			t.Errorf("Expected c-salary to be removed, got %v", ids)
+		}
+		if _, err := memStore.GetIncome(t.Context(), "salary"); err != nil {
+			t.Error("Expected the source personal income to survive")
+		}
+	})
+
+	t.Run("rejects oversized batch", func(t *testing.T) {
+		ids := make([]string, 101)
+		_, err := service.BatchDeleteIncomes(ctx, connect.NewRequest(&pfinancev1.BatchDeleteIncomesRequest{IncomeIds: ids}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+}
+
 func TestListIncomes(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1716,7 +2721,7 @@ func TestListIncomes(t *testing.T) {
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
-					ListIncomes(gomock.Any(), "user-123", "", gomock.Any(), gomock.Any(), int32(10), "").
+					ListIncomes(gomock.Any(), "user-123", "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10), "").
 					Return(mockIncomes, "", nil)
 			},
 			expectedCount: 2,
@@ -1738,7 +2743,7 @@ func TestListIncomes(t *testing.T) {
 						MemberIds: []string{"user-123"},
 					}, nil)
 				mockStore.EXPECT().
-					ListIncomes(gomock.Any(), "user-123", "group-456", gomock.Any(), gomock.Any(), int32(10), "").
+					ListIncomes(gomock.Any(), "user-123", "group-456", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10), "").
 					Return(mockIncomes, "", nil)
 			},
 			expectedCount: 2,
@@ -1752,7 +2757,7 @@ func TestListIncomes(t *testing.T) {
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
-					ListIncomes(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					ListIncomes(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return([]*pfinancev1.Income{}, "", nil)
 			},
 			expectedCount: 0,
@@ -1768,12 +2773,29 @@ func TestListIncomes(t *testing.T) {
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
-					ListIncomes(gomock.Any(), "user-123", "", gomock.Any(), gomock.Any(), int32(10), "").
+					ListIncomes(gomock.Any(), "user-123", "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(10), "").
 					Return(mockIncomes, "", nil)
 			},
 			expectedCount: 2,
 			expectedError: false,
 		},
+		{
+			name: "source filter and amount sort passed through",
+			request: &pfinancev1.ListIncomesRequest{
+				UserId:        "user-123",
+				PageSize:      10,
+				Source:        "sal",
+				SortField:     pfinancev1.SortField_SORT_FIELD_AMOUNT,
+				SortDirection: pfinancev1.SortDirection_SORT_DIRECTION_ASC,
+			},
+			setupMock: func() {
+				mockStore.EXPECT().
+					ListIncomes(gomock.Any(), "user-123", "", gomock.Any(), gomock.Any(), "sal", pfinancev1.SortField_SORT_FIELD_AMOUNT, pfinancev1.SortDirection_SORT_DIRECTION_ASC, int32(10), "").
+					Return(mockIncomes[:1], "", nil)
+			},
+			expectedCount: 1,
+			expectedError: false,
+		},
 		{
 			name: "store error",
 			request: &pfinancev1.ListIncomesRequest{
@@ -1782,7 +2804,7 @@ func TestListIncomes(t *testing.T) {
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
-					ListIncomes(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					ListIncomes(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, "", errors.New("store error"))
 			},
 			expectedError: true,
@@ -1794,7 +2816,7 @@ func TestListIncomes(t *testing.T) {
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
-					ListIncomes(gomock.Any(), "user-123", "", gomock.Any(), gomock.Any(), int32(100), "").
+					ListIncomes(gomock.Any(), "user-123", "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(100), "").
 					Return(mockIncomes, "", nil)
 			},
 			expectedCount: 2,
@@ -1941,6 +2963,42 @@ func TestListGroups(t *testing.T) {
 	}
 }
 
+func TestListGroups_MemoryStore(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-1")
+
+	for _, g := range []*pfinancev1.FinanceGroup{
+		{Id: "group-a", OwnerId: "user-1", MemberIds: []string{"user-1"}},
+		{Id: "group-b", OwnerId: "user-2", MemberIds: []string{"user-2", "user-1"}},
+		{Id: "group-c", OwnerId: "user-2", MemberIds: []string{"user-2"}},
+		{Id: "group-d", OwnerId: "user-3", MemberIds: []string{"user-3", "user-1"}},
+	} {
+		if err := memStore.CreateGroup(t.Context(), g); err != nil {
+			t.Fatalf("CreateGroup: %v", err)
+		}
+	}
+
+	var ids []string
+	var pageToken string
+	for {
+		resp, err := service.ListGroups(ctx, connect.NewRequest(&pfinancev1.ListGroupsRequest{PageSize: 2, PageToken: pageToken}))
+		if err != nil {
+			t.Fatalf("ListGroups: %v", err)
+		}
+		for _, g := range resp.Msg.Groups {
+			ids = append(ids, g.Id)
+		}
+		if resp.Msg.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.Msg.NextPageToken
+	}
+	if want := []string{"group-a", "group-b", "group-d"}; !slices.Equal(ids, want) {
+		t.Errorf("groups = %v, want %v", ids, want)
+	}
+}
+
 func TestGetGroup(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -2901,7 +3959,7 @@ func TestGetMemberBalances(t *testing.T) {
 						MemberIds: []string{"user-123"},
 					}, nil)
 				mockStore.EXPECT().
-					ListExpenses(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), int32(1000), "").
+					ListExpenses(gomock.Any(), "", "group-123", gomock.Any(), int32(1000), "").
 					Return(mockExpenses, "", nil)
 			},
 			expectedError: false,
@@ -2928,7 +3986,7 @@ func TestGetMemberBalances(t *testing.T) {
 						MemberIds: []string{"user-123"},
 					}, nil)
 				mockStore.EXPECT().
-					ListExpenses(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), int32(1000), "").
+					ListExpenses(gomock.Any(), "", "group-123", gomock.Any(), int32(1000), "").
 					Return([]*pfinancev1.Expense{}, "", nil)
 			},
 			expectedError: false,
@@ -2954,7 +4012,7 @@ func TestGetMemberBalances(t *testing.T) {
 						MemberIds: []string{"user-123"},
 					}, nil)
 				mockStore.EXPECT().
-					ListExpenses(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), int32(1000), "").
+					ListExpenses(gomock.Any(), "", "group-123", gomock.Any(), int32(1000), "").
 					Return(mockExpenses, "", nil)
 			},
 			expectedError: false,
@@ -2973,7 +4031,7 @@ func TestGetMemberBalances(t *testing.T) {
 						MemberIds: []string{"user-123"},
 					}, nil)
 				mockStore.EXPECT().
-					ListExpenses(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					ListExpenses(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, "", errors.New("store error"))
 			},
 			expectedError: true,
@@ -3006,6 +4064,156 @@ func TestGetMemberBalances(t *testing.T) {
 	}
 }
 
+func TestGetGroupSettlement(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("alice")
+
+	if err := memStore.CreateGroup(t.Context(), &pfinancev1.FinanceGroup{
+		Id:        "group-1",
+		OwnerId:   "alice",
+		MemberIds: []string{"alice", "bob", "carol"},
+	}); err != nil {
+		t.Fatalf("CreateGroup: %v", err)
+	}
+	jan := timestamppb.New(time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC))
+	mar := timestamppb.New(time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+	for _, c := range []*pfinancev1.ExpenseContribution{
+		{Id: "c1", TargetGroupId: "group-1", ContributedBy: "alice", AmountCents: 9000, ContributedAt: jan},
+		{Id: "c2", TargetGroupId: "group-1", ContributedBy: "bob", Amount: 30, ContributedAt: jan},
+		{Id: "c3", TargetGroupId: "group-1", ContributedBy: "carol", AmountCents: 50000, ContributedAt: mar},
+		{Id: "c4", TargetGroupId: "other-group", ContributedBy: "bob", AmountCents: 100000, ContributedAt: jan},
+	} {
+		if err := memStore.CreateContribution(t.Context(), c); err != nil {
+			t.Fatalf("CreateContribution: %v", err)
+		}
+	}
+
+	balanceOf := func(resp *pfinancev1.GetGroupSettlementResponse, userID string) int64 {
+		for _, b := range resp.Balances {
+			if b.UserId == userID {
+				return b.BalanceCents
+			}
+		}
+		t.Fatalf("no balance for %s", userID)
+		return 0
+	}
+
+	t.Run("equal split within date range", func(t *testing.T) {
+		resp, err := service.GetGroupSettlement(ctx, connect.NewRequest(&pfinancev1.GetGroupSettlementRequest{
+			GroupId: "group-1",
+			EndDate: timestamppb.New(time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)),
+		}))
+		if err != nil {
+			t.Fatalf("GetGroupSettlement: %v", err)
+		}
+		// $120 split three ways: alice +50, bob -10, carol -40
+		if resp.Msg.TotalContributedCents != 12000 {
+			t.Errorf("TotalContributedCents = %d, want 12000", resp.Msg.TotalContributedCents)
+		}
+		for userID, want := range map[string]int64{"alice": 5000, "bob": -1000, "carol": -4000} {
+			if got := balanceOf(resp.Msg, userID); got != want {
+				t.Errorf("%s balance = %d, want %d", userID, got, want)
+			}
+		}
+		if len(resp.Msg.Transfers) != 2 {
+			t.Fatalf("expected 2 transfers, got %v", resp.Msg.Transfers)
+		}
+		if tr := resp.Msg.Transfers[0]; tr.FromUserId != "carol" || tr.ToUserId != "alice" || tr.AmountCents != 4000 {
+			t.Errorf("transfers[0] = %v, want carol -> alice 4000", tr)
+		}
+	})
+
+	t.Run("weighted split", func(t *testing.T) {
+		resp, err := service.GetGroupSettlement(ctx, connect.NewRequest(&pfinancev1.GetGroupSettlementRequest{
+			GroupId:      "group-1",
+			SplitWeights: map[string]float64{"alice": 1, "bob": 1},
+		}))
+		if err != nil {
+			t.Fatalf("GetGroupSettlement: %v", err)
+		}
+		// $620 split between alice and bob: alice -220, bob -280, carol +500
+		for userID, want := range map[string]int64{"alice": -22000, "bob": -28000, "carol": 50000} {
+			if got := balanceOf(resp.Msg, userID); got != want {
+				t.Errorf("%s balance = %d, want %d", userID, got, want)
+			}
+		}
+		var received int64
+		for _, tr := range resp.Msg.Transfers {
+			if tr.ToUserId != "carol" {
+				t.Errorf("unexpected transfer %v", tr)
+			}
+			received += tr.AmountCents
+		}
+		if received != 50000 {
+			t.Errorf("carol receives %d, want 50000", received)
+		}
+	})
+
+	t.Run("rejects weights for non-members", func(t *testing.T) {
+		_, err := service.GetGroupSettlement(ctx, connect.NewRequest(&pfinancev1.GetGroupSettlementRequest{
+			GroupId:      "group-1",
+			SplitWeights: map[string]float64{"mallory": 1},
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("expected CodeInvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("requires membership", func(t *testing.T) {
+		_, err := service.GetGroupSettlement(testContext("mallory"), connect.NewRequest(&pfinancev1.GetGroupSettlementRequest{
+			GroupId: "group-1",
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("expected CodePermissionDenied, got %v", err)
+		}
+	})
+}
+
+func TestSplitCentsByWeight(t *testing.T) {
+	shares := splitCentsByWeight(1000, map[string]float64{"a": 1, "b": 1, "c": 1})
+	if shares["a"]+shares["b"]+shares["c"] != 1000 {
+		t.Errorf("shares %v do not sum to 1000", shares)
+	}
+	if shares["a"] != 334 || shares["b"] != 333 || shares["c"] != 333 {
+		t.Errorf("shares = %v, want a=334 b=333 c=333", shares)
+	}
+
+	shares = splitCentsByWeight(1000, map[string]float64{"a": 3, "b": 1, "c": 0})
+	if shares["a"] != 750 || shares["b"] != 250 || shares["c"] != 0 {
+		t.Errorf("weighted shares = %v, want a=750 b=250 c=0", shares)
+	}
+}
+
+func TestComputeSettlementTransfers(t *testing.T) {
+	balances := map[string]int64{"a": 6000, "b": -1000, "c": -2000, "d": -3000, "e": 0}
+	transfers := computeSettlementTransfers(balances)
+
+	if len(transfers) != 3 {
+		t.Fatalf("expected 3 transfers, got %d", len(transfers))
+	}
+	net := make(map[string]int64)
+	for _, tr := range transfers {
+		if tr.AmountCents <= 0 {
+			t.Errorf("non-positive transfer %v", tr)
+		}
+		net[tr.FromUserId] -= tr.AmountCents
+		net[tr.ToUserId] += tr.AmountCents
+	}
+	for userID, balance := range balances {
+		if net[userID] != balance {
+			t.Errorf("%s nets %d from transfers, want %d", userID, net[userID], balance)
+		}
+	}
+	if tr := transfers[0]; tr.FromUserId != "d" || tr.ToUserId != "a" || tr.AmountCents != 3000 {
+		t.Errorf("transfers[0] = %v, want d -> a 3000", tr)
+	}
+
+	if got := computeSettlementTransfers(map[string]int64{"a": 0}); len(got) != 0 {
+		t.Errorf("expected no transfers for settled balances, got %v", got)
+	}
+}
+
 func TestSettleExpense(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -3261,11 +4469,11 @@ func TestGetGroupSummary(t *testing.T) {
 					}, nil)
 
 				mockStore.EXPECT().
-					ListExpenses(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), int32(1000), "").
+					ListExpenses(gomock.Any(), "", "group-123", gomock.Any(), int32(1000), "").
 					Return(mockExpenses, "", nil)
 
 				mockStore.EXPECT().
-					ListIncomes(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), int32(1000), "").
+					ListIncomes(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(1000), "").
 					Return(mockIncomes, "", nil)
 			},
 			expectedError: false,
@@ -3299,11 +4507,11 @@ func TestGetGroupSummary(t *testing.T) {
 					}, nil)
 
 				mockStore.EXPECT().
-					ListExpenses(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), int32(1000), "").
+					ListExpenses(gomock.Any(), "", "group-123", gomock.Any(), int32(1000), "").
 					Return([]*pfinancev1.Expense{}, "", nil)
 
 				mockStore.EXPECT().
-					ListIncomes(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), int32(1000), "").
+					ListIncomes(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(1000), "").
 					Return([]*pfinancev1.Income{}, "", nil)
 			},
 			expectedError: false,
@@ -3333,11 +4541,11 @@ func TestGetGroupSummary(t *testing.T) {
 					}, nil)
 
 				mockStore.EXPECT().
-					ListExpenses(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), int32(1000), "").
+					ListExpenses(gomock.Any(), "", "group-123", gomock.Any(), int32(1000), "").
 					Return(mockExpenses, "", nil)
 
 				mockStore.EXPECT().
-					ListIncomes(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), int32(1000), "").
+					ListIncomes(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(1000), "").
 					Return(mockIncomes, "", nil)
 			},
 			expectedError: false,
@@ -3357,7 +4565,7 @@ func TestGetGroupSummary(t *testing.T) {
 					}, nil)
 
 				mockStore.EXPECT().
-					ListExpenses(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					ListExpenses(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, "", errors.New("store error"))
 			},
 			expectedError: true,
@@ -3377,11 +4585,11 @@ func TestGetGroupSummary(t *testing.T) {
 					}, nil)
 
 				mockStore.EXPECT().
-					ListExpenses(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), int32(1000), "").
+					ListExpenses(gomock.Any(), "", "group-123", gomock.Any(), int32(1000), "").
 					Return(mockExpenses, "", nil)
 
 				mockStore.EXPECT().
-					ListIncomes(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), int32(1000), "").
+					ListIncomes(gomock.Any(), "", "group-123", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(1000), "").
 					Return(nil, "", errors.New("store error"))
 			},
 			expectedError: true,
@@ -3716,6 +4924,7 @@ func TestJoinGroupByLink(t *testing.T) {
 
 	mockStore := store.NewMockStore(ctrl)
 	service := NewFinanceService(mockStore, nil, nil)
+	expectTransactions(mockStore)
 
 	tests := []struct {
 		name          string
@@ -3760,26 +4969,61 @@ func TestJoinGroupByLink(t *testing.T) {
 					GetGroup(gomock.Any(), "group-123").
 					Return(mockGroup, nil)
 
+				redeemed := proto.Clone(mockLink).(*pfinancev1.GroupInviteLink)
+				redeemed.CurrentUses = 1
+				mockStore.EXPECT().
+					RedeemInviteLink(gomock.Any(), "ABC12345").
+					Return(redeemed, nil)
+
 				mockStore.EXPECT().
 					UpdateGroup(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(_ context.Context, group *pfinancev1.FinanceGroup) error {
 						if len(group.MemberIds) != 2 {
 							t.Errorf("Expected 2 members, got %d", len(group.MemberIds))
 						}
-						return nil
-					})
-
-				mockStore.EXPECT().
-					UpdateInviteLink(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ context.Context, link *pfinancev1.GroupInviteLink) error {
-						if link.CurrentUses != 1 {
-							t.Errorf("Expected CurrentUses 1, got %d", link.CurrentUses)
+						if group.Members[1].InviteLinkId != "link-123" {
+							t.Errorf("Expected InviteLinkId link-123, got %q", group.Members[1].InviteLinkId)
 						}
 						return nil
 					})
 			},
 			expectedError: false,
 		},
+		{
+			name: "link exhausted on redemption",
+			request: &pfinancev1.JoinGroupByLinkRequest{
+				Code:   "ABC12345",
+				UserId: "user-late",
+			},
+			setupMock: func() {
+				mockLink := &pfinancev1.GroupInviteLink{
+					Id:       "link-123",
+					GroupId:  "group-123",
+					Code:     "ABC12345",
+					IsActive: true,
+					MaxUses:  1,
+				}
+
+				mockGroup := &pfinancev1.FinanceGroup{
+					Id:        "group-123",
+					MemberIds: []string{"user-123"},
+				}
+
+				mockStore.EXPECT().
+					GetInviteLinkByCode(gomock.Any(), "ABC12345").
+					Return(mockLink, nil)
+
+				mockStore.EXPECT().
+					GetGroup(gomock.Any(), "group-123").
+					Return(mockGroup, nil)
+
+				// Another user redeemed the last use between lookup and redemption
+				mockStore.EXPECT().
+					RedeemInviteLink(gomock.Any(), "ABC12345").
+					Return(nil, store.ErrInviteLinkExhausted)
+			},
+			expectedError: true,
+		},
 		{
 			name: "link not found",
 			request: &pfinancev1.JoinGroupByLinkRequest{
@@ -3855,63 +5099,304 @@ func TestJoinGroupByLink(t *testing.T) {
 	}
 }
 
-func TestListInviteLinks(t *testing.T) {
+func TestListIncomesMemoryStore_SourceAndSort(t *testing.T) {
+	s := store.NewMemoryStore()
+	ctx := t.Context()
+
+	for _, income := range []*pfinancev1.Income{
+		{Id: "inc-1", UserId: "user-1", Source: "ACME Salary", AmountCents: 500000, Date: timestamppb.New(time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC))},
+		{Id: "inc-2", UserId: "user-1", Source: "Freelance", AmountCents: 120000, Date: timestamppb.New(time.Date(2025, 2, 15, 0, 0, 0, 0, time.UTC))},
+		{Id: "inc-3", UserId: "user-1", Source: "Bonus salary", AmountCents: 250000, Date: timestamppb.New(time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC))},
+		{Id: "inc-4", UserId: "user-1", Source: "salary adjustment", Amount: 10.00, Date: timestamppb.New(time.Date(2025, 4, 15, 0, 0, 0, 0, time.UTC))},
+	} {
+		if err := s.CreateIncome(ctx, income); err != nil {
+			t.Fatalf("create income: %v", err)
+		}
+	}
+
+	ids := func(incomes []*pfinancev1.Income) []string {
+		var out []string
+		for _, i := range incomes {
+			out = append(out, i.Id)
+		}
+		return out
+	}
+
+	t.Run("source filter is case-insensitive and sorts by amount desc", func(t *testing.T) {
+		incomes, next, err := s.ListIncomes(ctx, "user-1", "", nil, nil, "SALARY",
+			pfinancev1.SortField_SORT_FIELD_AMOUNT, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 10, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got, want := ids(incomes), []string{"inc-1", "inc-3", "inc-4"}; !slices.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		if next != "" {
+			t.Errorf("expected no next page, got %q", next)
+		}
+	})
+
+	t.Run("date ascending paginates by offset", func(t *testing.T) {
+		page1, next, err := s.ListIncomes(ctx, "user-1", "", nil, nil, "",
+			pfinancev1.SortField_SORT_FIELD_DATE, pfinancev1.SortDirection_SORT_DIRECTION_ASC, 3, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got, want := ids(page1), []string{"inc-1", "inc-2", "inc-3"}; !slices.Equal(got, want) {
+			t.Errorf("page 1: got %v, want %v", got, want)
+		}
+		if next == "" {
+			t.Fatal("expected a next page token")
+		}
+
+		page2, next, err := s.ListIncomes(ctx, "user-1", "", nil, nil, "",
+			pfinancev1.SortField_SORT_FIELD_DATE, pfinancev1.SortDirection_SORT_DIRECTION_ASC, 3, next)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got, want := ids(page2), []string{"inc-4"}; !slices.Equal(got, want) {
+			t.Errorf("page 2: got %v, want %v", got, want)
+		}
+		if next != "" {
+			t.Errorf("expected no next page, got %q", next)
+		}
+	})
+}
+
+func TestRedeemInviteLinkMemoryStore(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("single-use link redeemed once under concurrency", func(t *testing.T) {
+		s := store.NewMemoryStore()
+		if err := s.CreateInviteLink(ctx, &pfinancev1.GroupInviteLink{
+			Id: "link-1", GroupId: "group-1", Code: "SINGLE01", IsActive: true, MaxUses: 1,
+		}); err != nil {
+			t.Fatalf("create invite link: %v", err)
+		}
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		succeeded, exhausted := 0, 0
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := s.RedeemInviteLink(ctx, "SINGLE01")
+				mu.Lock()
+				defer mu.Unlock()
+				switch {
+				case err == nil:
+					succeeded++
+				case errors.Is(err, store.ErrInviteLinkExhausted):
+					exhausted++
+				default:
+					t.Errorf("unexpected error: %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if succeeded != 1 || exhausted != 9 {
+			t.Errorf("expected 1 success and 9 exhausted, got %d and %d", succeeded, exhausted)
+		}
+	})
+
+	t.Run("expired link", func(t *testing.T) {
+		s := store.NewMemoryStore()
+		if err := s.CreateInviteLink(ctx, &pfinancev1.GroupInviteLink{
+			Id: "link-2", GroupId: "group-1", Code: "EXPIRED1", IsActive: true,
+			ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour)),
+		}); err != nil {
+			t.Fatalf("create invite link: %v", err)
+		}
+
+		if _, err := s.RedeemInviteLink(ctx, "EXPIRED1"); !errors.Is(err, store.ErrInviteLinkExpired) {
+			t.Errorf("expected ErrInviteLinkExpired, got %v", err)
+		}
+	})
+
+	t.Run("inactive link", func(t *testing.T) {
+		s := store.NewMemoryStore()
+		if err := s.CreateInviteLink(ctx, &pfinancev1.GroupInviteLink{
+			Id: "link-3", GroupId: "group-1", Code: "INACTIVE",
+		}); err != nil {
+			t.Fatalf("create invite link: %v", err)
+		}
+
+		if _, err := s.RedeemInviteLink(ctx, "INACTIVE"); !errors.Is(err, store.ErrInviteLinkInactive) {
+			t.Errorf("expected ErrInviteLinkInactive, got %v", err)
+		}
+	})
+}
+
+func TestGetInviteLinkStats(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStore := store.NewMockStore(ctrl)
 	service := NewFinanceService(mockStore, nil, nil)
 
-	mockLinks := []*pfinancev1.GroupInviteLink{
-		{
-			Id:       "link-1",
-			GroupId:  "group-123",
-			Code:     "ABC11111",
-			IsActive: true,
-		},
-		{
-			Id:       "link-2",
-			GroupId:  "group-123",
-			Code:     "ABC22222",
-			IsActive: true,
+	joinedAt := timestamppb.New(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	mockGroup := &pfinancev1.FinanceGroup{
+		Id:        "group-123",
+		OwnerId:   "user-owner",
+		MemberIds: []string{"user-owner", "user-member", "user-joined"},
+		Members: []*pfinancev1.GroupMember{
+			{UserId: "user-owner", Role: pfinancev1.GroupRole_GROUP_ROLE_OWNER},
+			{UserId: "user-member", Role: pfinancev1.GroupRole_GROUP_ROLE_MEMBER},
+			{UserId: "user-joined", Role: pfinancev1.GroupRole_GROUP_ROLE_MEMBER, JoinedAt: joinedAt, InviteLinkId: "link-123"},
 		},
 	}
 
 	tests := []struct {
 		name          string
-		request       *pfinancev1.ListInviteLinksRequest
-		setupMock     func()
-		expectedCount int
-		expectedError bool
+		userID        string
+		link          *pfinancev1.GroupInviteLink
+		setupMock     func(link *pfinancev1.GroupInviteLink)
+		expectedCode  connect.Code
+		wantRemaining *int32
 	}{
 		{
-			name: "successful list",
-			request: &pfinancev1.ListInviteLinksRequest{
-				GroupId:  "group-123",
-				PageSize: 10,
+			name:   "owner sees capped link stats",
+			userID: "user-owner",
+			link:   &pfinancev1.GroupInviteLink{Id: "link-123", GroupId: "group-123", MaxUses: 5, CurrentUses: 1},
+			setupMock: func(link *pfinancev1.GroupInviteLink) {
+				mockStore.EXPECT().GetInviteLink(gomock.Any(), "link-123").Return(link, nil)
+				mockStore.EXPECT().GetGroup(gomock.Any(), "group-123").Return(mockGroup, nil)
+				mockStore.EXPECT().ListInviteLinkMembers(gomock.Any(), "group-123", "link-123").
+					Return([]*pfinancev1.GroupMember{mockGroup.Members[2]}, nil)
 			},
-			setupMock: func() {
-				mockStore.EXPECT().
-					GetGroup(gomock.Any(), "group-123").
-					Return(&pfinancev1.FinanceGroup{
-						Id:        "group-123",
-						OwnerId:   "user-123",
-						MemberIds: []string{"user-123"},
-						Members: []*pfinancev1.GroupMember{
-							{UserId: "user-123", Role: pfinancev1.GroupRole_GROUP_ROLE_OWNER},
-						},
-					}, nil)
-
-				mockStore.EXPECT().
-					ListInviteLinks(gomock.Any(), "group-123", false, int32(10), "").
-					Return(mockLinks, "", nil)
+			wantRemaining: proto.Int32(4),
+		},
+		{
+			name:   "uncapped link has no remaining uses",
+			userID: "user-owner",
+			link:   &pfinancev1.GroupInviteLink{Id: "link-123", GroupId: "group-123", CurrentUses: 1},
+			setupMock: func(link *pfinancev1.GroupInviteLink) {
+				mockStore.EXPECT().GetInviteLink(gomock.Any(), "link-123").Return(link, nil)
+				mockStore.EXPECT().GetGroup(gomock.Any(), "group-123").Return(mockGroup, nil)
+				mockStore.EXPECT().ListInviteLinkMembers(gomock.Any(), "group-123", "link-123").
+					Return([]*pfinancev1.GroupMember{mockGroup.Members[2]}, nil)
 			},
-			expectedCount: 2,
-			expectedError: false,
 		},
 		{
-			name: "include inactive",
-			request: &pfinancev1.ListInviteLinksRequest{
+			name:   "non-admin member denied",
+			userID: "user-member",
+			link:   &pfinancev1.GroupInviteLink{Id: "link-123", GroupId: "group-123"},
+			setupMock: func(link *pfinancev1.GroupInviteLink) {
+				mockStore.EXPECT().GetInviteLink(gomock.Any(), "link-123").Return(link, nil)
+				mockStore.EXPECT().GetGroup(gomock.Any(), "group-123").Return(mockGroup, nil)
+			},
+			expectedCode: connect.CodePermissionDenied,
+		},
+		{
+			name:   "link not found",
+			userID: "user-owner",
+			link:   &pfinancev1.GroupInviteLink{Id: "link-123"},
+			setupMock: func(link *pfinancev1.GroupInviteLink) {
+				mockStore.EXPECT().GetInviteLink(gomock.Any(), "link-123").Return(nil, errors.New("not found"))
+			},
+			expectedCode: connect.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock(tt.link)
+			ctx := testContextWithUser(tt.userID)
+
+			resp, err := service.GetInviteLinkStats(ctx, connect.NewRequest(&pfinancev1.GetInviteLinkStatsRequest{
+				LinkId: "link-123",
+			}))
+
+			if tt.expectedCode != 0 {
+				if connect.CodeOf(err) != tt.expectedCode {
+					t.Errorf("Expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if resp.Msg.TotalUses != 1 {
+				t.Errorf("Expected 1 total use, got %d", resp.Msg.TotalUses)
+			}
+			if len(resp.Msg.JoinedMembers) != 1 || resp.Msg.JoinedMembers[0].UserId != "user-joined" {
+				t.Errorf("Expected user-joined in joined members, got %v", resp.Msg.JoinedMembers)
+			}
+			// LastUsedAt falls back to the latest join time
+			if !resp.Msg.LastUsedAt.AsTime().Equal(joinedAt.AsTime()) {
+				t.Errorf("Expected LastUsedAt %v, got %v", joinedAt.AsTime(), resp.Msg.LastUsedAt.AsTime())
+			}
+			if tt.wantRemaining == nil {
+				if resp.Msg.RemainingUses != nil {
+					t.Errorf("Expected no remaining uses, got %d", resp.Msg.GetRemainingUses())
+				}
+			} else if resp.Msg.GetRemainingUses() != *tt.wantRemaining {
+				t.Errorf("Expected %d remaining uses, got %d", *tt.wantRemaining, resp.Msg.GetRemainingUses())
+			}
+		})
+	}
+}
+
+func TestListInviteLinks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := store.NewMockStore(ctrl)
+	service := NewFinanceService(mockStore, nil, nil)
+
+	mockLinks := []*pfinancev1.GroupInviteLink{
+		{
+			Id:       "link-1",
+			GroupId:  "group-123",
+			Code:     "ABC11111",
+			IsActive: true,
+		},
+		{
+			Id:       "link-2",
+			GroupId:  "group-123",
+			Code:     "ABC22222",
+			IsActive: true,
+		},
+	}
+
+	tests := []struct {
+		name          string
+		request       *pfinancev1.ListInviteLinksRequest
+		setupMock     func()
+		expectedCount int
+		expectedError bool
+	}{
+		{
+			name: "successful list",
+			request: &pfinancev1.ListInviteLinksRequest{
+				GroupId:  "group-123",
+				PageSize: 10,
+			},
+			setupMock: func() {
+				mockStore.EXPECT().
+					GetGroup(gomock.Any(), "group-123").
+					Return(&pfinancev1.FinanceGroup{
+						Id:        "group-123",
+						OwnerId:   "user-123",
+						MemberIds: []string{"user-123"},
+						Members: []*pfinancev1.GroupMember{
+							{UserId: "user-123", Role: pfinancev1.GroupRole_GROUP_ROLE_OWNER},
+						},
+					}, nil)
+
+				mockStore.EXPECT().
+					ListInviteLinks(gomock.Any(), "group-123", false, int32(10), "").
+					Return(mockLinks, "", nil)
+			},
+			expectedCount: 2,
+			expectedError: false,
+		},
+		{
+			name: "include inactive",
+			request: &pfinancev1.ListInviteLinksRequest{
 				GroupId:         "group-123",
 				IncludeInactive: true,
 				PageSize:        10,
@@ -4503,6 +5988,17 @@ func TestUpdateTaxConfig(t *testing.T) {
 			},
 			expectedError: true,
 		},
+		{
+			name: "invalid fiscal year start month",
+			request: &pfinancev1.UpdateTaxConfigRequest{
+				UserId: "user-123",
+				TaxConfig: &pfinancev1.TaxConfig{
+					FiscalYearStartMonth: 13,
+				},
+			},
+			setupMock:     func() {},
+			expectedError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -4530,3 +6026,1019 @@ func TestUpdateTaxConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestContributeToGoal_MilestoneCrossing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := store.NewMockStore(ctrl)
+	service := NewFinanceService(mockStore, nil, nil)
+
+	newGoal := func() *pfinancev1.FinancialGoal {
+		return &pfinancev1.FinancialGoal{
+			Id:                 "goal-1",
+			UserId:             "user-123",
+			Name:               "Emergency Fund",
+			TargetAmount:       1000,
+			TargetAmountCents:  100000,
+			CurrentAmount:      490,
+			CurrentAmountCents: 49000, // 49%
+			Status:             pfinancev1.GoalStatus_GOAL_STATUS_ACTIVE,
+			Milestones: []*pfinancev1.GoalMilestone{
+				{Id: "m25", TargetPercentage: 25, IsAchieved: true},
+				{Id: "m50", TargetPercentage: 50},
+				{Id: "m75", TargetPercentage: 75},
+			},
+		}
+	}
+
+	t.Run("crossing 50% marks, persists and notifies", func(t *testing.T) {
+		mockStore.EXPECT().GetGoal(gomock.Any(), "goal-1").Return(newGoal(), nil)
+		mockStore.EXPECT().CreateGoalContribution(gomock.Any(), gomock.Any()).Return(nil)
+
+		var saved *pfinancev1.FinancialGoal
+		mockStore.EXPECT().UpdateGoal(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, g *pfinancev1.FinancialGoal) error {
+				saved = g
+				return nil
+			})
+		mockStore.EXPECT().GetNotificationPreferences(gomock.Any(), "user-123").
+			Return(&pfinancev1.NotificationPreferences{UserId: "user-123", GoalMilestones: true}, nil)
+		mockStore.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		_, err := service.ContributeToGoal(testContext("user-123"), connect.NewRequest(&pfinancev1.ContributeToGoalRequest{
+			GoalId:      "goal-1",
+			AmountCents: 2000, // 49% -> 51%
+		}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if saved == nil {
+			t.Fatal("Expected goal to be persisted")
+		}
+		for _, m := range saved.Milestones {
+			switch m.Id {
+			case "m50":
+				if !m.IsAchieved || m.AchievedAt == nil {
+					t.Errorf("Expected 50%% milestone achieved with timestamp, got %v", m)
+				}
+			case "m75":
+				if m.IsAchieved {
+					t.Error("Expected 75% milestone not achieved")
+				}
+			}
+		}
+	})
+
+	t.Run("no crossing skips notification", func(t *testing.T) {
+		mockStore.EXPECT().GetGoal(gomock.Any(), "goal-1").Return(newGoal(), nil)
+		mockStore.EXPECT().CreateGoalContribution(gomock.Any(), gomock.Any()).Return(nil)
+		mockStore.EXPECT().UpdateGoal(gomock.Any(), gomock.Any()).Return(nil)
+		// No notification calls expected: 49% -> 49.5% crosses nothing
+
+		_, err := service.ContributeToGoal(testContext("user-123"), connect.NewRequest(&pfinancev1.ContributeToGoalRequest{
+			GoalId:      "goal-1",
+			AmountCents: 500,
+		}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("withdrawal lowers balance and keeps milestones", func(t *testing.T) {
+		mockStore.EXPECT().GetGoal(gomock.Any(), "goal-1").Return(newGoal(), nil)
+		mockStore.EXPECT().CreateGoalContribution(gomock.Any(), gomock.Any()).Return(nil)
+
+		var saved *pfinancev1.FinancialGoal
+		mockStore.EXPECT().UpdateGoal(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, g *pfinancev1.FinancialGoal) error {
+				saved = g
+				return nil
+			})
+
+		resp, err := service.ContributeToGoal(testContext("user-123"), connect.NewRequest(&pfinancev1.ContributeToGoalRequest{
+			GoalId:      "goal-1",
+			AmountCents: -30000, // 49% -> 19%
+		}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if saved.CurrentAmountCents != 19000 || saved.CurrentAmount != 190 {
+			t.Errorf("Expected balance 19000 cents / 190, got %d / %v", saved.CurrentAmountCents, saved.CurrentAmount)
+		}
+		if resp.Msg.Contribution.Amount != -300 {
+			t.Errorf("Expected contribution amount -300, got %v", resp.Msg.Contribution.Amount)
+		}
+		if !saved.Milestones[0].IsAchieved {
+			t.Error("Expected 25% milestone to stay achieved after withdrawal")
+		}
+	})
+
+	t.Run("withdrawal exceeding balance is rejected", func(t *testing.T) {
+		mockStore.EXPECT().GetGoal(gomock.Any(), "goal-1").Return(newGoal(), nil)
+
+		_, err := service.ContributeToGoal(testContext("user-123"), connect.NewRequest(&pfinancev1.ContributeToGoalRequest{
+			GoalId:      "goal-1",
+			AmountCents: -50000,
+		}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Fatalf("Expected FailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("allow negative floors balance at zero", func(t *testing.T) {
+		mockStore.EXPECT().GetGoal(gomock.Any(), "goal-1").Return(newGoal(), nil)
+		mockStore.EXPECT().CreateGoalContribution(gomock.Any(), gomock.Any()).Return(nil)
+
+		var saved *pfinancev1.FinancialGoal
+		mockStore.EXPECT().UpdateGoal(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, g *pfinancev1.FinancialGoal) error {
+				saved = g
+				return nil
+			})
+
+		_, err := service.ContributeToGoal(testContext("user-123"), connect.NewRequest(&pfinancev1.ContributeToGoalRequest{
+			GoalId:        "goal-1",
+			AmountCents:   -50000,
+			AllowNegative: true,
+		}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if saved.CurrentAmountCents != 0 || saved.CurrentAmount != 0 {
+			t.Errorf("Expected balance floored at zero, got %d / %v", saved.CurrentAmountCents, saved.CurrentAmount)
+		}
+	})
+
+	t.Run("re-crossing an achieved milestone does not notify", func(t *testing.T) {
+		goal := newGoal()
+		goal.CurrentAmount, goal.CurrentAmountCents = 450, 45000 // withdrew from 55% earlier
+		goal.Milestones[1].IsAchieved = true
+		mockStore.EXPECT().GetGoal(gomock.Any(), "goal-1").Return(goal, nil)
+		mockStore.EXPECT().CreateGoalContribution(gomock.Any(), gomock.Any()).Return(nil)
+		mockStore.EXPECT().UpdateGoal(gomock.Any(), gomock.Any()).Return(nil)
+		// No notification calls expected: 50% was already achieved
+
+		_, err := service.ContributeToGoal(testContext("user-123"), connect.NewRequest(&pfinancev1.ContributeToGoalRequest{
+			GoalId:      "goal-1",
+			AmountCents: 1000, // 45% -> 55%
+		}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+}
+
+func TestGetGoalProgress_AfterWithdrawal(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	created, err := service.CreateGoal(ctx, connect.NewRequest(&pfinancev1.CreateGoalRequest{
+		UserId:             "user-123",
+		Name:               "Holiday",
+		TargetAmountCents:  100000,
+		InitialAmountCents: 10000,
+	}))
+	if err != nil {
+		t.Fatalf("CreateGoal: %v", err)
+	}
+	goalID := created.Msg.Goal.Id
+
+	for _, cents := range []int64{50000, -30000} { // 10% -> 60% -> 30%
+		if _, err := service.ContributeToGoal(ctx, connect.NewRequest(&pfinancev1.ContributeToGoalRequest{
+			GoalId:      goalID,
+			AmountCents: cents,
+		})); err != nil {
+			t.Fatalf("ContributeToGoal(%d): %v", cents, err)
+		}
+	}
+
+	resp, err := service.GetGoalProgress(ctx, connect.NewRequest(&pfinancev1.GetGoalProgressRequest{GoalId: goalID}))
+	if err != nil {
+		t.Fatalf("GetGoalProgress: %v", err)
+	}
+	progress := resp.Msg.Progress
+	if progress.PercentageComplete != 30 {
+		t.Errorf("Expected 30%% complete, got %v", progress.PercentageComplete)
+	}
+	if len(progress.AchievedMilestones) != 2 {
+		t.Errorf("Expected 25%% and 50%% milestones to stay achieved, got %d", len(progress.AchievedMilestones))
+	}
+	if progress.NextMilestone == nil || progress.NextMilestone.TargetPercentage != 50 {
+		t.Errorf("Expected next milestone to be 50%% again, got %v", progress.NextMilestone)
+	}
+}
+
+func TestGoalCatchUpScenarios(t *testing.T) {
+	asOf := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	goalDue := func(days int) *pfinancev1.FinancialGoal {
+		return &pfinancev1.FinancialGoal{TargetDate: timestamppb.New(asOf.AddDate(0, 0, days))}
+	}
+
+	tests := []struct {
+		name          string
+		goal          *pfinancev1.FinancialGoal
+		progress      *pfinancev1.GoalProgress
+		want          *pfinancev1.GoalCatchUpScenarios
+		wantProjected time.Time
+	}{
+		{
+			name:     "on pace",
+			goal:     goalDue(60),
+			progress: &pfinancev1.GoalProgress{TargetAmount: 1000, CurrentAmount: 400, ActualDailyRate: 10},
+			want: &pfinancev1.GoalCatchUpScenarios{
+				RemainingCents: 60000, RequiredDailyCents: 1000, RequiredWeeklyCents: 7000, RequiredMonthlyCents: 30438,
+			},
+			wantProjected: asOf.AddDate(0, 0, 60),
+		},
+		{
+			name:     "behind pace",
+			goal:     goalDue(60),
+			progress: &pfinancev1.GoalProgress{TargetAmount: 1000, CurrentAmount: 200, ActualDailyRate: 5},
+			want: &pfinancev1.GoalCatchUpScenarios{
+				RemainingCents: 80000, RequiredDailyCents: 1334, RequiredWeeklyCents: 9334, RequiredMonthlyCents: 40584, DaysLate: 100,
+			},
+			wantProjected: asOf.AddDate(0, 0, 160),
+		},
+		{
+			name:     "overdue needs the full remainder",
+			goal:     goalDue(-10),
+			progress: &pfinancev1.GoalProgress{TargetAmount: 1000, CurrentAmount: 200, ActualDailyRate: 5},
+			want: &pfinancev1.GoalCatchUpScenarios{
+				RemainingCents: 80000, RequiredDailyCents: 80000, RequiredWeeklyCents: 80000, RequiredMonthlyCents: 80000,
+				DaysLate: 170, Overdue: true,
+			},
+			wantProjected: asOf.AddDate(0, 0, 160),
+		},
+		{
+			name:     "less than a week left",
+			goal:     goalDue(3),
+			progress: &pfinancev1.GoalProgress{TargetAmount: 100, CurrentAmount: 70},
+			want: &pfinancev1.GoalCatchUpScenarios{
+				RemainingCents: 3000, RequiredDailyCents: 1000, RequiredWeeklyCents: 3000, RequiredMonthlyCents: 3000,
+			},
+		},
+		{
+			name:          "no target date only projects",
+			goal:          &pfinancev1.FinancialGoal{},
+			progress:      &pfinancev1.GoalProgress{TargetAmount: 100, CurrentAmount: 50, ActualDailyRate: 1},
+			want:          &pfinancev1.GoalCatchUpScenarios{RemainingCents: 5000},
+			wantProjected: asOf.AddDate(0, 0, 50),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := goalCatchUpScenarios(tt.goal, tt.progress, asOf)
+			if got == nil {
+				t.Fatal("expected scenarios for an unfinished goal")
+			}
+			if !tt.wantProjected.IsZero() {
+				tt.want.ProjectedCompletionDate = timestamppb.New(tt.wantProjected)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("scenarios = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("reached goal has none", func(t *testing.T) {
+		progress := &pfinancev1.GoalProgress{TargetAmount: 100, CurrentAmount: 120, ActualDailyRate: 1}
+		if got := goalCatchUpScenarios(goalDue(-10), progress, asOf); got != nil {
+			t.Errorf("scenarios = %v, want nil", got)
+		}
+	})
+}
+
+func TestSkipNextOccurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := store.NewMockStore(ctrl)
+	service := NewFinanceService(mockStore, nil, nil)
+
+	next := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		rt           *pfinancev1.RecurringTransaction
+		group        *pfinancev1.FinanceGroup
+		expectUpdate bool
+		expectedCode connect.Code
+		wantNext     time.Time
+		wantStatus   pfinancev1.RecurringTransactionStatus
+	}{
+		{
+			name: "advances monthly transaction by one period",
+			rt: &pfinancev1.RecurringTransaction{
+				Id:             "rt-1",
+				UserId:         "user-123",
+				Frequency:      pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY,
+				NextOccurrence: timestamppb.New(next),
+				Status:         pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+			},
+			expectUpdate: true,
+			wantNext:     time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+			wantStatus:   pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+		},
+		{
+			name: "skipping final occurrence ends transaction",
+			rt: &pfinancev1.RecurringTransaction{
+				Id:             "rt-1",
+				UserId:         "user-123",
+				Frequency:      pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY,
+				NextOccurrence: timestamppb.New(next),
+				EndDate:        timestamppb.New(next.AddDate(0, 0, 10)),
+				Status:         pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+			},
+			expectUpdate: true,
+			wantNext:     time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+			wantStatus:   pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ENDED,
+		},
+		{
+			name: "rejects ended transaction",
+			rt: &pfinancev1.RecurringTransaction{
+				Id:             "rt-1",
+				UserId:         "user-123",
+				Frequency:      pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY,
+				NextOccurrence: timestamppb.New(next),
+				Status:         pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ENDED,
+			},
+			expectedCode: connect.CodeFailedPrecondition,
+		},
+		{
+			name: "rejects another user's transaction",
+			rt: &pfinancev1.RecurringTransaction{
+				Id:             "rt-1",
+				UserId:         "user-other",
+				Frequency:      pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY,
+				NextOccurrence: timestamppb.New(next),
+				Status:         pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+			},
+			expectedCode: connect.CodePermissionDenied,
+		},
+		{
+			name: "advances group transaction for a member",
+			rt: &pfinancev1.RecurringTransaction{
+				Id:             "rt-1",
+				UserId:         "user-other",
+				GroupId:        "group-1",
+				Frequency:      pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY,
+				NextOccurrence: timestamppb.New(next),
+				Status:         pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+			},
+			group:        &pfinancev1.FinanceGroup{Id: "group-1", MemberIds: []string{"user-other", "user-123"}},
+			expectUpdate: true,
+			wantNext:     time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+			wantStatus:   pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+		},
+		{
+			name: "rejects group transaction for a non-member",
+			rt: &pfinancev1.RecurringTransaction{
+				Id:             "rt-1",
+				UserId:         "user-other",
+				GroupId:        "group-1",
+				Frequency:      pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY,
+				NextOccurrence: timestamppb.New(next),
+				Status:         pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+			},
+			group:        &pfinancev1.FinanceGroup{Id: "group-1", MemberIds: []string{"user-other"}},
+			expectedCode: connect.CodePermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore.EXPECT().GetRecurringTransaction(gomock.Any(), "rt-1").Return(tt.rt, nil)
+			if tt.group != nil {
+				mockStore.EXPECT().GetGroup(gomock.Any(), tt.group.Id).Return(tt.group, nil)
+			}
+			if tt.expectUpdate {
+				mockStore.EXPECT().UpdateRecurringTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			resp, err := service.SkipNextOccurrence(testContext("user-123"), connect.NewRequest(&pfinancev1.SkipNextOccurrenceRequest{
+				RecurringTransactionId: "rt-1",
+			}))
+
+			if tt.expectedCode != 0 {
+				if connect.CodeOf(err) != tt.expectedCode {
+					t.Errorf("Expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			rt := resp.Msg.RecurringTransaction
+			if !rt.NextOccurrence.AsTime().Equal(tt.wantNext) {
+				t.Errorf("Expected next occurrence %v, got %v", tt.wantNext, rt.NextOccurrence.AsTime())
+			}
+			if rt.Status != tt.wantStatus {
+				t.Errorf("Expected status %v, got %v", tt.wantStatus, rt.Status)
+			}
+			if len(rt.SkippedOccurrences) != 1 || !rt.SkippedOccurrences[0].AsTime().Equal(next) {
+				t.Errorf("Expected skipped occurrence %v, got %v", next, rt.SkippedOccurrences)
+			}
+			if !resp.Msg.SkippedOccurrence.AsTime().Equal(next) {
+				t.Errorf("Expected response skipped occurrence %v, got %v", next, resp.Msg.SkippedOccurrence.AsTime())
+			}
+		})
+	}
+}
+
+func TestPreviewOccurrences(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		name           string
+		start          time.Time
+		frequency      pfinancev1.ExpenseFrequency
+		end            *time.Time
+		maxOccurrences int
+		count          int
+		want           []time.Time
+		wantMore       bool
+	}{
+		{
+			name:      "future start returns next k",
+			start:     day(2026, 4, 1),
+			frequency: pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY,
+			count:     3,
+			want:      []time.Time{day(2026, 4, 1), day(2026, 5, 1), day(2026, 6, 1)},
+			wantMore:  true,
+		},
+		{
+			name:      "past start skips to first upcoming",
+			start:     day(2026, 3, 1),
+			frequency: pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_WEEKLY,
+			count:     2,
+			want:      []time.Time{day(2026, 3, 22), day(2026, 3, 29)},
+			wantMore:  true,
+		},
+		{
+			name:      "end date bounds the series",
+			start:     day(2026, 4, 1),
+			frequency: pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY,
+			end:       ptr(day(2026, 5, 1)),
+			count:     5,
+			want:      []time.Time{day(2026, 4, 1), day(2026, 5, 1)},
+		},
+		{
+			name:           "occurrence cap counts from start",
+			start:          day(2026, 1, 1),
+			frequency:      pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY,
+			maxOccurrences: 5,
+			count:          10,
+			want:           []time.Time{day(2026, 4, 1), day(2026, 5, 1)},
+		},
+		{
+			name:      "once returns the start date",
+			start:     day(2026, 4, 1),
+			frequency: pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_ONCE,
+			count:     5,
+			want:      []time.Time{day(2026, 4, 1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, more := previewOccurrences(tt.start, tt.frequency, tt.end, tt.maxOccurrences, tt.count, now)
+			if !slices.EqualFunc(got, tt.want, time.Time.Equal) {
+				t.Errorf("occurrences = %v, want %v", got, tt.want)
+			}
+			if more != tt.wantMore {
+				t.Errorf("hasMore = %v, want %v", more, tt.wantMore)
+			}
+		})
+	}
+}
+
+func TestPreviewRecurringOccurrences(t *testing.T) {
+	service := NewFinanceService(nil, nil, nil)
+	ctx := testContext("user-123")
+	start := time.Now().AddDate(0, 1, 0).Truncate(time.Second)
+
+	t.Run("defaults count", func(t *testing.T) {
+		resp, err := service.PreviewRecurringOccurrences(ctx, connect.NewRequest(&pfinancev1.PreviewRecurringOccurrencesRequest{
+			Frequency: pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_FORTNIGHTLY,
+			StartDate: timestamppb.New(start),
+		}))
+		if err != nil {
+			t.Fatalf("PreviewRecurringOccurrences: %v", err)
+		}
+		if len(resp.Msg.Occurrences) != defaultPreviewOccurrences || !resp.Msg.HasMore {
+			t.Fatalf("got %d occurrences (hasMore=%v), want %d with more", len(resp.Msg.Occurrences), resp.Msg.HasMore, defaultPreviewOccurrences)
+		}
+		if !resp.Msg.Occurrences[0].AsTime().Equal(start) || !resp.Msg.Occurrences[1].AsTime().Equal(start.AddDate(0, 0, 14)) {
+			t.Errorf("first occurrences = %v, %v", resp.Msg.Occurrences[0].AsTime(), resp.Msg.Occurrences[1].AsTime())
+		}
+	})
+
+	t.Run("validates input", func(t *testing.T) {
+		for _, req := range []*pfinancev1.PreviewRecurringOccurrencesRequest{
+			{StartDate: timestamppb.New(start)},
+			{Frequency: pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY},
+			{Frequency: pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY, StartDate: timestamppb.New(start), EndDate: timestamppb.New(start.AddDate(0, 0, -1))},
+		} {
+			_, err := service.PreviewRecurringOccurrences(ctx, connect.NewRequest(req))
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("%v: expected CodeInvalidArgument, got %v", req, err)
+			}
+		}
+	})
+}
+
+func TestRecurringTransactionAmountRange(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	resp, err := service.CreateRecurringTransaction(ctx, connect.NewRequest(&pfinancev1.CreateRecurringTransactionRequest{
+		UserId:         "user-123",
+		Description:    "Electricity",
+		Frequency:      pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_QUARTERLY,
+		IsExpense:      true,
+		MinAmountCents: 12000,
+		MaxAmountCents: 26000,
+	}))
+	if err != nil {
+		t.Fatalf("CreateRecurringTransaction: %v", err)
+	}
+	rt := resp.Msg.RecurringTransaction
+	if rt.AmountCents != 19000 || rt.Amount != 190 || rt.MinAmountCents != 12000 || rt.MaxAmountCents != 26000 {
+		t.Errorf("created amount = %d (%d-%d), want 19000 (12000-26000)", rt.AmountCents, rt.MinAmountCents, rt.MaxAmountCents)
+	}
+
+	// Both bounds set to zero make the amount fixed again
+	updated, err := service.UpdateRecurringTransaction(ctx, connect.NewRequest(&pfinancev1.UpdateRecurringTransactionRequest{
+		RecurringTransactionId: rt.Id,
+		IsExpense:              true,
+		MinAmountCents:         proto.Int64(0),
+		MaxAmountCents:         proto.Int64(0),
+	}))
+	if err != nil {
+		t.Fatalf("UpdateRecurringTransaction: %v", err)
+	}
+	if got := updated.Msg.RecurringTransaction; got.MinAmountCents != 0 || got.MaxAmountCents != 0 || got.AmountCents != 19000 {
+		t.Errorf("updated amount = %d (%d-%d), want a fixed 19000", got.AmountCents, got.MinAmountCents, got.MaxAmountCents)
+	}
+
+	t.Run("validates range", func(t *testing.T) {
+		for _, req := range []*pfinancev1.CreateRecurringTransactionRequest{
+			{MinAmountCents: 12000},
+			{MinAmountCents: 26000, MaxAmountCents: 12000},
+			{AmountCents: 30000, MinAmountCents: 12000, MaxAmountCents: 26000},
+		} {
+			req.UserId = "user-123"
+			req.Frequency = pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY
+			_, err := service.CreateRecurringTransaction(ctx, connect.NewRequest(req))
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("%v: expected CodeInvalidArgument, got %v", req, err)
+			}
+		}
+
+		_, err := service.UpdateRecurringTransaction(ctx, connect.NewRequest(&pfinancev1.UpdateRecurringTransactionRequest{
+			RecurringTransactionId: rt.Id,
+			MinAmountCents:         proto.Int64(20000),
+			MaxAmountCents:         proto.Int64(25000),
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("range excluding the amount: expected CodeInvalidArgument, got %v", err)
+		}
+	})
+}
+
+func TestMemoryStoreRunInTransaction(t *testing.T) {
+	ctx := t.Context()
+	memStore := store.NewMemoryStore()
+	if err := memStore.CreateGroup(ctx, &pfinancev1.FinanceGroup{Id: "group-1", Name: "Before"}); err != nil {
+		t.Fatalf("CreateGroup: %v", err)
+	}
+
+	t.Run("rolls back on error", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		err := memStore.RunInTransaction(ctx, func(tx store.Store) error {
+			group, err := tx.GetGroup(ctx, "group-1")
+			if err != nil {
+				return err
+			}
+			group.Name = "During"
+			if err := tx.UpdateGroup(ctx, group); err != nil {
+				return err
+			}
+			if err := tx.CreateInvitation(ctx, &pfinancev1.GroupInvitation{Id: "inv-1", GroupId: "group-1"}); err != nil {
+				return err
+			}
+			return errBoom
+		})
+		if !errors.Is(err, errBoom) {
+			t.Fatalf("expected callback error, got %v", err)
+		}
+
+		group, _ := memStore.GetGroup(ctx, "group-1")
+		if group.Name != "Before" {
+			t.Errorf("group name = %q, want rollback to %q", group.Name, "Before")
+		}
+		if _, err := memStore.GetInvitation(ctx, "inv-1"); err == nil {
+			t.Error("expected invitation write to be rolled back")
+		}
+	})
+
+	t.Run("commits on success", func(t *testing.T) {
+		err := memStore.RunInTransaction(ctx, func(tx store.Store) error {
+			group, err := tx.GetGroup(ctx, "group-1")
+			if err != nil {
+				return err
+			}
+			group.Name = "After"
+			if err := tx.UpdateGroup(ctx, group); err != nil {
+				return err
+			}
+			return tx.CreateInvitation(ctx, &pfinancev1.GroupInvitation{Id: "inv-2", GroupId: "group-1"})
+		})
+		if err != nil {
+			t.Fatalf("RunInTransaction: %v", err)
+		}
+
+		group, _ := memStore.GetGroup(ctx, "group-1")
+		if group.Name != "After" {
+			t.Errorf("group name = %q, want %q", group.Name, "After")
+		}
+		if _, err := memStore.GetInvitation(ctx, "inv-2"); err != nil {
+			t.Errorf("expected committed invitation, got %v", err)
+		}
+	})
+
+	t.Run("carries extraction preferences", func(t *testing.T) {
+		if err := memStore.UpdateExtractionPreferences(ctx, &pfinancev1.ExtractionPreferences{UserId: "user-1", AutoRejectThreshold: proto.Float64(0.2)}); err != nil {
+			t.Fatalf("UpdateExtractionPreferences: %v", err)
+		}
+		err := memStore.RunInTransaction(ctx, func(tx store.Store) error {
+			prefs, err := tx.GetExtractionPreferences(ctx, "user-1")
+			if err != nil {
+				return err
+			}
+			if prefs.GetAutoRejectThreshold() != 0.2 {
+				t.Errorf("preferences in transaction = %v, want the stored ones", prefs)
+			}
+			return tx.UpdateExtractionPreferences(ctx, &pfinancev1.ExtractionPreferences{UserId: "user-1", AutoRejectThreshold: proto.Float64(0.4)})
+		})
+		if err != nil {
+			t.Fatalf("RunInTransaction: %v", err)
+		}
+		prefs, err := memStore.GetExtractionPreferences(ctx, "user-1")
+		if err != nil || prefs.GetAutoRejectThreshold() != 0.4 {
+			t.Errorf("preferences = %v, %v; want the committed update", prefs, err)
+		}
+	})
+}
+
+// failInvitationUpdates wraps a store so invitation writes fail, including
+// inside transactions.
+type failInvitationUpdates struct {
+	store.Store
+}
+
+func (f failInvitationUpdates) UpdateInvitation(context.Context, *pfinancev1.GroupInvitation) error {
+	return errors.New("invitation write failed")
+}
+
+func (f failInvitationUpdates) RunInTransaction(ctx context.Context, fn func(store.Store) error) error {
+	return f.Store.RunInTransaction(ctx, func(tx store.Store) error {
+		return fn(failInvitationUpdates{tx})
+	})
+}
+
+func TestAcceptInvitation_AtomicOnFailure(t *testing.T) {
+	ctx := testContext("user-999")
+	memStore := store.NewMemoryStore()
+
+	if err := memStore.CreateGroup(t.Context(), &pfinancev1.FinanceGroup{
+		Id: "group-1", OwnerId: "user-1", MemberIds: []string{"user-1"},
+	}); err != nil {
+		t.Fatalf("CreateGroup: %v", err)
+	}
+	if err := memStore.CreateInvitation(t.Context(), &pfinancev1.GroupInvitation{
+		Id: "inv-1", GroupId: "group-1", InviteeEmail: "user-999@test.com",
+		Status:    pfinancev1.InvitationStatus_INVITATION_STATUS_PENDING,
+		ExpiresAt: timestamppb.New(time.Now().Add(time.Hour)),
+	}); err != nil {
+		t.Fatalf("CreateInvitation: %v", err)
+	}
+	req := &pfinancev1.AcceptInvitationRequest{InvitationId: "inv-1", UserId: "user-999"}
+
+	// The group write succeeds but the invitation write fails: the membership
+	// must be rolled back with it.
+	failing := NewFinanceService(failInvitationUpdates{memStore}, nil, nil)
+	if _, err := failing.AcceptInvitation(ctx, connect.NewRequest(req)); err == nil {
+		t.Fatal("expected error when the invitation update fails")
+	}
+	group, _ := memStore.GetGroup(t.Context(), "group-1")
+	if auth.IsGroupMember("user-999", group) {
+		t.Error("expected membership to be rolled back when the invitation update fails")
+	}
+
+	service := NewFinanceService(memStore, nil, nil)
+	if _, err := service.AcceptInvitation(ctx, connect.NewRequest(req)); err != nil {
+		t.Fatalf("AcceptInvitation: %v", err)
+	}
+	group, _ = memStore.GetGroup(t.Context(), "group-1")
+	inv, _ := memStore.GetInvitation(t.Context(), "inv-1")
+	if !auth.IsGroupMember("user-999", group) || inv.Status != pfinancev1.InvitationStatus_INVITATION_STATUS_ACCEPTED {
+		t.Errorf("expected membership and accepted invitation to be committed together, got members %v status %v", group.MemberIds, inv.Status)
+	}
+}
+
+func TestSearchTransactions_AmountRange(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-1")
+
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "free", UserId: "user-1", Description: "Free trial", Amount: 0, Date: timestamppb.Now()},
+		{Id: "coffee", UserId: "user-1", Description: "Coffee", Amount: 4.5, Date: timestamppb.Now()},
+		{Id: "dinner", UserId: "user-1", Description: "Dinner", Amount: 60, Date: timestamppb.Now()},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		req     *pfinancev1.SearchTransactionsRequest
+		wantIDs []string
+	}{
+		{
+			name:    "no bounds",
+			req:     &pfinancev1.SearchTransactionsRequest{},
+			wantIDs: []string{"coffee", "dinner", "free"},
+		},
+		{
+			name:    "zero floor with max includes $0.00",
+			req:     &pfinancev1.SearchTransactionsRequest{AmountMin: proto.Float64(0), AmountMax: proto.Float64(10)},
+			wantIDs: []string{"coffee", "free"},
+		},
+		{
+			name:    "exactly zero",
+			req:     &pfinancev1.SearchTransactionsRequest{AmountMin: proto.Float64(0), AmountMax: proto.Float64(0)},
+			wantIDs: []string{"free"},
+		},
+		{
+			name:    "cents bounds take precedence",
+			req:     &pfinancev1.SearchTransactionsRequest{AmountMinCents: proto.Int64(1000), AmountMin: proto.Float64(0)},
+			wantIDs: []string{"dinner"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Type = pfinancev1.TransactionType_TRANSACTION_TYPE_EXPENSE
+			resp, err := service.SearchTransactions(ctx, connect.NewRequest(tt.req))
+			if err != nil {
+				t.Fatalf("SearchTransactions: %v", err)
+			}
+			var ids []string
+			for _, r := range resp.Msg.Results {
+				ids = append(ids, r.Id)
+			}
+			slices.Sort(ids)
+			if !slices.Equal(ids, tt.wantIDs) {
+				t.Errorf("got %v, want %v", ids, tt.wantIDs)
+			}
+		})
+	}
+
+	_, err := service.SearchTransactions(ctx, connect.NewRequest(&pfinancev1.SearchTransactionsRequest{
+		AmountMin: proto.Float64(10),
+		AmountMax: proto.Float64(5),
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("expected InvalidArgument for inverted range, got %v", err)
+	}
+}
+
+func TestSearchTransactions_Notes(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-1")
+
+	created, err := service.CreateExpense(ctx, connect.NewRequest(&pfinancev1.CreateExpenseRequest{
+		UserId:      "user-1",
+		Description: "Qantas",
+		AmountCents: 45000,
+		Date:        timestamppb.Now(),
+		Note:        " Reimbursed by work in March ",
+	}))
+	if err != nil {
+		t.Fatalf("CreateExpense: %v", err)
+	}
+	if created.Msg.Expense.Note != "Reimbursed by work in March" {
+		t.Errorf("note = %q, want trimmed", created.Msg.Expense.Note)
+	}
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "laptop", UserId: "user-1", Description: "JB Hi-Fi", TaxDeductionNote: "Work laptop, REIMBURSED 50%", Date: timestamppb.Now()},
+		{Id: "reimbursement", UserId: "user-1", Description: "Reimbursement transfer", Date: timestamppb.Now()},
+		{Id: "groceries", UserId: "user-1", Description: "Coles", Note: "weekly shop", Date: timestamppb.Now()},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+
+	search := func(t *testing.T, query string) []string {
+		t.Helper()
+		resp, err := service.SearchTransactions(ctx, connect.NewRequest(&pfinancev1.SearchTransactionsRequest{Query: query}))
+		if err != nil {
+			t.Fatalf("SearchTransactions: %v", err)
+		}
+		var ids []string
+		for _, r := range resp.Msg.Results {
+			ids = append(ids, r.Id)
+		}
+		slices.Sort(ids)
+		return ids
+	}
+
+	want := []string{created.Msg.Expense.Id, "laptop", "reimbursement"}
+	slices.Sort(want)
+	if got := search(t, "reimburse"); !slices.Equal(got, want) {
+		t.Errorf("search(reimburse) = %v, want %v", got, want)
+	}
+
+	t.Run("clearing the note drops the match", func(t *testing.T) {
+		if _, err := service.UpdateExpense(ctx, connect.NewRequest(&pfinancev1.UpdateExpenseRequest{
+			ExpenseId: created.Msg.Expense.Id,
+			Note:      proto.String(""),
+		})); err != nil {
+			t.Fatalf("UpdateExpense: %v", err)
+		}
+		if got := search(t, "reimburse"); !slices.Equal(got, []string{"laptop", "reimbursement"}) {
+			t.Errorf("search(reimburse) = %v after clearing the note", got)
+		}
+	})
+}
+
+func TestSearchTransactions_Relevance(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-1")
+
+	now := time.Now()
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "in-word", UserId: "user-1", Description: "SQ *MYNETFLIX PAYMENT", AmountCents: 1699, Date: timestamppb.New(now)},
+		{Id: "word-start", UserId: "user-1", Description: "SQ *NETFLIX.COM", AmountCents: 1699, Date: timestamppb.New(now.AddDate(0, 0, -1))},
+		{Id: "prefix", UserId: "user-1", Description: "Netflix subscription", AmountCents: 2299, Date: timestamppb.New(now.AddDate(0, 0, -2))},
+		{Id: "exact", UserId: "user-1", Description: "Netflix", AmountCents: 1699, Date: timestamppb.New(now.AddDate(0, 0, -3))},
+		{Id: "food-works", UserId: "user-1", Description: "Food Works", Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_OTHER, Date: timestamppb.New(now)},
+		{Id: "food-court", UserId: "user-1", Description: "Food Court", Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD, Date: timestamppb.New(now.AddDate(0, 0, -1))},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+
+	search := func(t *testing.T, query string, sortBy pfinancev1.SearchSortBy) ([]string, int32) {
+		t.Helper()
+		resp, err := service.SearchTransactions(ctx, connect.NewRequest(&pfinancev1.SearchTransactionsRequest{
+			Query:    query,
+			SortBy:   sortBy,
+			PageSize: 2,
+		}))
+		if err != nil {
+			t.Fatalf("SearchTransactions: %v", err)
+		}
+		var ids []string
+		for _, r := range resp.Msg.Results {
+			ids = append(ids, r.Id)
+		}
+		return ids, resp.Msg.TotalCount
+	}
+
+	tests := []struct {
+		name   string
+		query  string
+		sortBy pfinancev1.SearchSortBy
+		want   []string
+		total  int32
+	}{
+		{"default is newest first", "netflix", pfinancev1.SearchSortBy_SEARCH_SORT_BY_UNSPECIFIED, []string{"in-word", "word-start"}, 4},
+		{"date", "netflix", pfinancev1.SearchSortBy_SEARCH_SORT_BY_DATE, []string{"in-word", "word-start"}, 4},
+		{"relevance ranks exact and prefix matches first", "netflix", pfinancev1.SearchSortBy_SEARCH_SORT_BY_RELEVANCE, []string{"exact", "prefix"}, 4},
+		{"relevance boosts a matching category", "food", pfinancev1.SearchSortBy_SEARCH_SORT_BY_RELEVANCE, []string{"food-court", "food-works"}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, total := search(t, tt.query, tt.sortBy)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("first page = %v, want %v", got, tt.want)
+			}
+			if total != tt.total {
+				t.Errorf("total_count = %d, want %d", total, tt.total)
+			}
+		})
+	}
+}
+
+func TestClearUserData_RequiresConfirmationToken(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	service := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	for _, id := range []string{"e1", "e2"} {
+		if err := memStore.CreateExpense(t.Context(), &pfinancev1.Expense{Id: id, UserId: "user-123", Date: timestamppb.Now()}); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+	if err := memStore.CreateIncome(t.Context(), &pfinancev1.Income{Id: "i1", UserId: "user-123", Date: timestamppb.Now()}); err != nil {
+		t.Fatalf("CreateIncome: %v", err)
+	}
+
+	prepare := func(t *testing.T) *pfinancev1.PrepareClearUserDataResponse {
+		t.Helper()
+		resp, err := service.PrepareClearUserData(ctx, connect.NewRequest(&pfinancev1.PrepareClearUserDataRequest{UserId: "user-123"}))
+		if err != nil {
+			t.Fatalf("PrepareClearUserData: %v", err)
+		}
+		return resp.Msg
+	}
+	clear := func(token string) error {
+		_, err := service.ClearUserData(ctx, connect.NewRequest(&pfinancev1.ClearUserDataRequest{
+			UserId:            "user-123",
+			Confirm:           true,
+			ConfirmationToken: token,
+		}))
+		return err
+	}
+
+	t.Run("missing token is rejected", func(t *testing.T) {
+		if err := clear(""); connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("token is invalidated when data changes", func(t *testing.T) {
+		preview := prepare(t)
+		if err := memStore.CreateExpense(t.Context(), &pfinancev1.Expense{Id: "e3", UserId: "user-123", Date: timestamppb.Now()}); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+		if err := clear(preview.ConfirmationToken); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("code = %v, want FailedPrecondition", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("expired token is rejected", func(t *testing.T) {
+		preview := prepare(t)
+		stale := service.clearDataToken("user-123", time.Now().Add(-clearDataTokenTTL-time.Minute), preview)
+		if err := clear(stale); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("code = %v, want FailedPrecondition", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("token signed with another key is rejected", func(t *testing.T) {
+		preview := prepare(t)
+		other := NewFinanceService(memStore, nil, nil)
+		other.SetTokenSecret("another-secret")
+		issuedAt := preview.ExpiresAt.AsTime().Add(-clearDataTokenTTL)
+		if err := clear(other.clearDataToken("user-123", issuedAt, preview)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("code = %v, want FailedPrecondition", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("instances sharing a secret accept each other's tokens", func(t *testing.T) {
+		a := NewFinanceService(memStore, nil, nil)
+		b := NewFinanceService(memStore, nil, nil)
+		a.SetTokenSecret("shared-secret")
+		b.SetTokenSecret("shared-secret")
+		issuedAt := time.Now()
+		preview := prepare(t)
+		if a.clearDataToken("user-123", issuedAt, preview) != b.clearDataToken("user-123", issuedAt, preview) {
+			t.Error("expected the same token from instances sharing a secret")
+		}
+	})
+
+	t.Run("clears and records an audit entry", func(t *testing.T) {
+		preview := prepare(t)
+		if preview.ExpenseCount != 3 || preview.IncomeCount != 1 {
+			t.Fatalf("preview counts = (%d, %d), want (3, 1)", preview.ExpenseCount, preview.IncomeCount)
+		}
+		if err := clear(preview.ConfirmationToken); err != nil {
+			t.Fatalf("ClearUserData: %v", err)
+		}
+
+		if count, _ := memStore.CountExpenses(t.Context(), "user-123", "", nil, nil); count != 0 {
+			t.Errorf("expected expenses to be cleared, %d remain", count)
+		}
+		records, err := memStore.ListDataClearRecords(t.Context(), "user-123")
+		if err != nil {
+			t.Fatalf("ListDataClearRecords: %v", err)
+		}
+		if len(records) != 1 || records[0].ExpenseCount != 3 || records[0].IncomeCount != 1 {
+			t.Errorf("audit records = %v, want one recording 3 expenses and 1 income", records)
+		}
+	})
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/auth"
+)
+
+// undatedGoalMonths is the horizon over which a goal without a target date is
+// treated as due when weighing its urgency.
+const undatedGoalMonths = 12
+
+// RecommendGoalAllocations splits a monthly savings budget across the active
+// goals of a user or group, weighted by priority and by the monthly
+// contribution each goal needs to reach its target in time.
+func (s *FinanceService) RecommendGoalAllocations(ctx context.Context, req *connect.Request[pfinancev1.RecommendGoalAllocationsRequest]) (*connect.Response[pfinancev1.RecommendGoalAllocationsResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" {
+		userID = claims.UID
+	}
+	if req.Msg.GroupId == "" {
+		if userID != claims.UID {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("cannot access another user's goals"))
+		}
+	} else {
+		group, err := s.store.GetGroup(ctx, req.Msg.GroupId)
+		if err != nil {
+			return nil, auth.WrapStoreError("get group", err)
+		}
+		if !auth.IsGroupMember(claims.UID, group) {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("user is not a member of this group"))
+		}
+	}
+	if req.Msg.MonthlyBudgetCents <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("monthly_budget_cents must be positive"))
+	}
+
+	asOfDate := time.Now()
+	if req.Msg.AsOfDate != nil {
+		asOfDate = req.Msg.AsOfDate.AsTime()
+	}
+
+	if req.Msg.GroupId != "" {
+		userID = ""
+	}
+	goals, _, err := s.store.ListGoals(ctx, userID, req.Msg.GroupId,
+		pfinancev1.GoalStatus_GOAL_STATUS_ACTIVE, pfinancev1.GoalType_GOAL_TYPE_UNSPECIFIED, 1000, "")
+	if err != nil {
+		return nil, auth.WrapStoreError("list goals", err)
+	}
+
+	var allocations []*pfinancev1.GoalAllocation
+	var weights []float64
+	var caps []int64
+	for _, goal := range goals {
+		progress, err := s.store.GetGoalProgress(ctx, goal.Id, asOfDate)
+		if err != nil {
+			return nil, auth.WrapStoreError("get goal progress", err)
+		}
+		scenarios := goalCatchUpScenarios(goal, progress, asOfDate)
+		if scenarios == nil {
+			continue
+		}
+
+		urgency := scenarios.RequiredMonthlyCents
+		if goal.TargetDate == nil {
+			urgency = catchUpContribution(scenarios.RemainingCents, undatedGoalMonths*daysPerMonth, daysPerMonth)
+		}
+		allocations = append(allocations, &pfinancev1.GoalAllocation{
+			GoalId:               goal.Id,
+			GoalName:             goal.Name,
+			Priority:             goal.Priority,
+			RemainingCents:       scenarios.RemainingCents,
+			RequiredMonthlyCents: scenarios.RequiredMonthlyCents,
+		})
+		weights = append(weights, goalPriorityWeight(goal.Priority)*float64(urgency))
+		caps = append(caps, scenarios.RemainingCents)
+	}
+	if len(allocations) == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("no active goals left to fund"))
+	}
+
+	for i, cents := range allocateByWeight(req.Msg.MonthlyBudgetCents, weights, caps) {
+		allocations[i].SuggestedMonthlyCents = cents
+	}
+	sort.SliceStable(allocations, func(i, j int) bool {
+		return allocations[i].SuggestedMonthlyCents > allocations[j].SuggestedMonthlyCents
+	})
+
+	return connect.NewResponse(&pfinancev1.RecommendGoalAllocationsResponse{
+		Allocations: allocations,
+	}), nil
+}
+
+// goalPriorityWeight returns the allocation weight of a priority; an unset
+// priority counts as medium.
+func goalPriorityWeight(p pfinancev1.GoalPriority) float64 {
+	switch p {
+	case pfinancev1.GoalPriority_GOAL_PRIORITY_LOW:
+		return 1
+	case pfinancev1.GoalPriority_GOAL_PRIORITY_HIGH:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// allocateByWeight splits total cents in proportion to weights without giving
+// any entry more than its cap, handing the excess of capped entries to the
+// rest. Once every cap is reached the remainder is split by weight alone, so
+// the result always sums to total.
+func allocateByWeight(total int64, weights []float64, caps []int64) []int64 {
+	alloc := make([]int64, len(weights))
+	open := make([]int, 0, len(weights))
+	for i := range weights {
+		if caps[i] > 0 {
+			open = append(open, i)
+		}
+	}
+
+	left := total
+	for left > 0 && len(open) > 0 {
+		openWeights := make([]float64, len(open))
+		for k, i := range open {
+			openWeights[k] = weights[i]
+		}
+		shares := splitByWeight(left, openWeights)
+
+		// Fill every entry whose share reaches its cap, then re-split what is
+		// left among the others; when none is capped the shares are final.
+		var stillOpen []int
+		for k, i := range open {
+			if room := caps[i] - alloc[i]; shares[k] >= room {
+				alloc[i] += room
+				left -= room
+			} else {
+				stillOpen = append(stillOpen, i)
+			}
+		}
+		if len(stillOpen) == len(open) {
+			for k, i := range open {
+				alloc[i] += shares[k]
+			}
+			left = 0
+		}
+		open = stillOpen
+	}
+
+	if left > 0 {
+		for i, cents := range splitByWeight(left, weights) {
+			alloc[i] += cents
+		}
+	}
+	return alloc
+}
+
+// splitByWeight splits total cents in proportion to weights, giving the
+// rounding remainder to the largest fractional shares. Equal shares are used
+// when no weight is positive.
+func splitByWeight(total int64, weights []float64) []int64 {
+	shares := make([]int64, len(weights))
+	if len(weights) == 0 {
+		return shares
+	}
+
+	var sum float64
+	for _, w := range weights {
+		sum += max(w, 0)
+	}
+	exact := make([]float64, len(weights))
+	for i, w := range weights {
+		if sum > 0 {
+			exact[i] = float64(total) * max(w, 0) / sum
+		} else {
+			exact[i] = float64(total) / float64(len(weights))
+		}
+	}
+
+	var assigned int64
+	order := make([]int, len(weights))
+	for i := range exact {
+		shares[i] = int64(math.Floor(exact[i]))
+		assigned += shares[i]
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		fa := exact[order[a]] - math.Floor(exact[order[a]])
+		fb := exact[order[b]] - math.Floor(exact[order[b]])
+		return fa > fb
+	})
+	for k := 0; assigned < total; k = (k + 1) % len(order) {
+		shares[order[k]]++
+		assigned++
+	}
+	return shares
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAllocateByWeight(t *testing.T) {
+	tests := []struct {
+		name    string
+		total   int64
+		weights []float64
+		caps    []int64
+		want    []int64
+	}{
+		{"proportional", 1000, []float64{3, 1}, []int64{5000, 5000}, []int64{750, 250}},
+		{"rounding remainder goes to the largest fraction", 100, []float64{1, 1, 1}, []int64{500, 500, 500}, []int64{34, 33, 33}},
+		{"capped excess is redistributed", 1000, []float64{3, 1, 1}, []int64{100, 5000, 5000}, []int64{100, 450, 450}},
+		{"surplus past every cap is still allocated", 1000, []float64{1, 1}, []int64{100, 300}, []int64{400, 600}},
+		{"zero weights split evenly", 10, []float64{0, 0}, []int64{100, 100}, []int64{5, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := allocateByWeight(tt.total, tt.weights, tt.caps)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("allocateByWeight = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecommendGoalAllocations(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	now := time.Now()
+	create := func(name string, targetCents int64, targetDate time.Time, priority pfinancev1.GoalPriority) string {
+		t.Helper()
+		resp, err := svc.CreateGoal(ctx, connect.NewRequest(&pfinancev1.CreateGoalRequest{
+			UserId:            "user-123",
+			Name:              name,
+			GoalType:          pfinancev1.GoalType_GOAL_TYPE_SAVINGS,
+			TargetAmountCents: targetCents,
+			StartDate:         timestamppb.New(now),
+			TargetDate:        timestamppb.New(targetDate),
+			Priority:          priority,
+		}))
+		if err != nil {
+			t.Fatalf("CreateGoal(%s): %v", name, err)
+		}
+		return resp.Msg.Goal.Id
+	}
+	emergency := create("Emergency fund", 600000, now.AddDate(0, 6, 0), pfinancev1.GoalPriority_GOAL_PRIORITY_HIGH)
+	holiday := create("Holiday", 600000, now.AddDate(0, 12, 0), pfinancev1.GoalPriority_GOAL_PRIORITY_LOW)
+	almostDone := create("New phone", 5000, now.AddDate(0, 1, 0), pfinancev1.GoalPriority_GOAL_PRIORITY_UNSPECIFIED)
+
+	resp, err := svc.RecommendGoalAllocations(ctx, connect.NewRequest(&pfinancev1.RecommendGoalAllocationsRequest{
+		UserId:             "user-123",
+		MonthlyBudgetCents: 200000,
+	}))
+	if err != nil {
+		t.Fatalf("RecommendGoalAllocations: %v", err)
+	}
+
+	suggested := make(map[string]int64)
+	var sum int64
+	for _, a := range resp.Msg.Allocations {
+		suggested[a.GoalId] = a.SuggestedMonthlyCents
+		sum += a.SuggestedMonthlyCents
+	}
+	if sum != 200000 {
+		t.Errorf("allocations sum to %d, want the whole budget", sum)
+	}
+	if suggested[almostDone] != 5000 {
+		t.Errorf("new phone gets %d, want capped at its remaining 5000", suggested[almostDone])
+	}
+	if suggested[emergency] <= suggested[holiday] {
+		t.Errorf("emergency %d <= holiday %d; want the urgent high-priority goal favoured", suggested[emergency], suggested[holiday])
+	}
+
+	t.Run("defaults to the caller", func(t *testing.T) {
+		resp, err := svc.RecommendGoalAllocations(ctx, connect.NewRequest(&pfinancev1.RecommendGoalAllocationsRequest{
+			MonthlyBudgetCents: 200000,
+		}))
+		if err != nil {
+			t.Fatalf("RecommendGoalAllocations: %v", err)
+		}
+		if len(resp.Msg.Allocations) != 3 {
+			t.Errorf("got %d allocations, want the caller's 3 goals", len(resp.Msg.Allocations))
+		}
+	})
+
+	t.Run("requires a budget", func(t *testing.T) {
+		_, err := svc.RecommendGoalAllocations(ctx, connect.NewRequest(&pfinancev1.RecommendGoalAllocationsRequest{UserId: "user-123"}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("no active goals", func(t *testing.T) {
+		_, err := svc.RecommendGoalAllocations(testContext("other-user"), connect.NewRequest(&pfinancev1.RecommendGoalAllocationsRequest{
+			UserId:             "other-user",
+			MonthlyBudgetCents: 100000,
+		}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("code = %v, want FailedPrecondition", connect.CodeOf(err))
+		}
+	})
+}
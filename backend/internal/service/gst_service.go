@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/auth"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// gstComponentCents returns the GST included in a GST-inclusive total, which
+// in Australia is 1/11th of the total, rounded to the nearest cent.
+func gstComponentCents(totalCents int64) int64 {
+	if totalCents <= 0 {
+		return 0
+	}
+	return (totalCents + 5) / 11
+}
+
+// resolveGstCents returns the GST to store for an amount. An explicit
+// gstCents wins; otherwise a GST-registered entry gets the computed 1/11th
+// component and anything else has no GST.
+func resolveGstCents(gstCents int64, gstRegistered bool, amountCents int64) (int64, error) {
+	if gstCents < 0 {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("gst_cents must not be negative"))
+	}
+	if gstCents > amountCents {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("gst_cents cannot exceed the amount"))
+	}
+	if gstCents > 0 {
+		return gstCents, nil
+	}
+	if gstRegistered {
+		return gstComponentCents(amountCents), nil
+	}
+	return 0, nil
+}
+
+// updatedGstCents applies the GST fields of an update request to the current
+// GST. Without either field the current value is kept, rescaled in proportion
+// when the amount changed from previousCents.
+func updatedGstCents(current, previousCents int64, gstCents *int64, gstRegistered bool, amountCents int64) (int64, error) {
+	switch {
+	case gstCents != nil:
+		return resolveGstCents(*gstCents, gstRegistered, amountCents)
+	case gstRegistered:
+		return resolveGstCents(0, true, amountCents)
+	case current > 0 && previousCents > 0 && amountCents != previousCents:
+		scaled := int64(math.Round(float64(current) * float64(amountCents) / float64(previousCents)))
+		return resolveGstCents(scaled, false, amountCents)
+	default:
+		return resolveGstCents(current, false, amountCents)
+	}
+}
+
+// GetGstSummary sums the GST collected on incomes and paid on expenses over
+// the BAS quarter containing as_of_date (default: today).
+func (s *FinanceService) GetGstSummary(ctx context.Context, req *connect.Request[pfinancev1.GetGstSummaryRequest]) (*connect.Response[pfinancev1.GetGstSummaryResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireProWithFallback(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GroupId == "" {
+		if req.Msg.UserId != "" && req.Msg.UserId != claims.UID {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("cannot view another user's GST summary"))
+		}
+	} else {
+		group, err := s.store.GetGroup(ctx, req.Msg.GroupId)
+		if err != nil {
+			return nil, auth.WrapStoreError("get group", err)
+		}
+		if !auth.IsGroupMember(claims.UID, group) {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				fmt.Errorf("user is not a member of this group"))
+		}
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" && req.Msg.GroupId == "" {
+		userID = claims.UID
+	}
+
+	asOf := time.Now()
+	if req.Msg.AsOfDate != nil {
+		asOf = req.Msg.AsOfDate.AsTime()
+	}
+	start, end, q := calendarQuarter(asOf)
+
+	expenses, _, err := s.store.ListExpenses(ctx, userID, req.Msg.GroupId, store.ExpenseQuery{StartDate: &start, EndDate: &end}, 10000, "")
+	if err != nil {
+		return nil, auth.WrapStoreError("list expenses", err)
+	}
+	incomes, _, err := s.store.ListIncomes(ctx, userID, req.Msg.GroupId, &start, &end, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 10000, "")
+	if err != nil {
+		return nil, auth.WrapStoreError("list incomes", err)
+	}
+
+	resp := &pfinancev1.GetGstSummaryResponse{
+		PeriodStart: timestamppb.New(start),
+		PeriodEnd:   timestamppb.New(end),
+		PeriodLabel: fmt.Sprintf("Q%d %d", q, start.Year()),
+	}
+	for _, e := range expenses {
+		if e.GstCents > 0 {
+			resp.GstPaidCents += e.GstCents
+			resp.ExpenseCount++
+		}
+	}
+	for _, inc := range incomes {
+		if inc.GstCents > 0 {
+			resp.GstCollectedCents += inc.GstCents
+			resp.IncomeCount++
+		}
+	}
+	resp.NetGstCents = resp.GstCollectedCents - resp.GstPaidCents
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestResolveGstCents(t *testing.T) {
+	tests := []struct {
+		name       string
+		gstCents   int64
+		registered bool
+		amount     int64
+		want       int64
+		wantErr    bool
+	}{
+		{"unregistered has no GST", 0, false, 11000, 0, false},
+		{"registered computes 1/11th", 0, true, 11000, 1000, false},
+		{"rounds to the nearest cent", 0, true, 1000, 91, false},
+		{"explicit GST wins", 500, true, 11000, 500, false},
+		{"negative GST", -1, false, 11000, 0, true},
+		{"GST above the amount", 12000, false, 11000, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveGstCents(tt.gstCents, tt.registered, tt.amount)
+			if tt.wantErr {
+				if connect.CodeOf(err) != connect.CodeInvalidArgument {
+					t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("resolveGstCents = %d, %v; want %d", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdatedGstCents(t *testing.T) {
+	tests := []struct {
+		name       string
+		current    int64
+		previous   int64
+		gstCents   *int64
+		registered bool
+		amount     int64
+		want       int64
+	}{
+		{"unchanged amount keeps GST", 1000, 11000, nil, false, 11000, 1000},
+		{"lower amount rescales GST", 1000, 11000, nil, false, 5500, 500},
+		{"higher amount rescales partial GST", 300, 11000, nil, false, 22000, 600},
+		{"no GST stays zero", 0, 11000, nil, false, 5500, 0},
+		{"registered recomputes", 300, 11000, nil, true, 5500, 500},
+		{"explicit GST wins", 1000, 11000, proto.Int64(200), false, 5500, 200},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := updatedGstCents(tt.current, tt.previous, tt.gstCents, tt.registered, tt.amount)
+			if err != nil || got != tt.want {
+				t.Errorf("updatedGstCents = %d, %v; want %d", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetGstSummary(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testProContext("user-123")
+
+	inQuarter := time.Date(2025, time.August, 15, 12, 0, 0, 0, time.Local)
+	nextQuarter := time.Date(2025, time.October, 2, 12, 0, 0, 0, time.Local)
+
+	for _, req := range []*pfinancev1.CreateExpenseRequest{
+		{UserId: "user-123", Description: "Laptop", AmountCents: 220000, IsGstRegistered: true, Date: timestamppb.New(inQuarter)},
+		{UserId: "user-123", Description: "Coffee", AmountCents: 550, Date: timestamppb.New(inQuarter)},
+		{UserId: "user-123", Description: "Software", AmountCents: 11000, GstCents: 1000, Date: timestamppb.New(nextQuarter)},
+	} {
+		req.Category = pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_OTHER
+		req.Frequency = pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_ONCE
+		if _, err := svc.CreateExpense(ctx, connect.NewRequest(req)); err != nil {
+			t.Fatalf("CreateExpense(%s): %v", req.Description, err)
+		}
+	}
+	if _, err := svc.CreateIncome(ctx, connect.NewRequest(&pfinancev1.CreateIncomeRequest{
+		UserId:          "user-123",
+		Source:          "Consulting",
+		AmountCents:     550000,
+		Frequency:       pfinancev1.IncomeFrequency_INCOME_FREQUENCY_MONTHLY,
+		IsGstRegistered: true,
+		Date:            timestamppb.New(inQuarter),
+	})); err != nil {
+		t.Fatalf("CreateIncome: %v", err)
+	}
+
+	resp, err := svc.GetGstSummary(ctx, connect.NewRequest(&pfinancev1.GetGstSummaryRequest{
+		UserId:   "user-123",
+		AsOfDate: timestamppb.New(inQuarter),
+	}))
+	if err != nil {
+		t.Fatalf("GetGstSummary: %v", err)
+	}
+	got := resp.Msg
+	if got.PeriodLabel != "Q3 2025" {
+		t.Errorf("label = %q, want Q3 2025", got.PeriodLabel)
+	}
+	if got.GstCollectedCents != 50000 || got.GstPaidCents != 20000 || got.NetGstCents != 30000 {
+		t.Errorf("collected/paid/net = %d/%d/%d, want 50000/20000/30000",
+			got.GstCollectedCents, got.GstPaidCents, got.NetGstCents)
+	}
+	if got.IncomeCount != 1 || got.ExpenseCount != 1 {
+		t.Errorf("counts = %d incomes, %d expenses; want 1 and 1", got.IncomeCount, got.ExpenseCount)
+	}
+
+	t.Run("another user's summary is rejected", func(t *testing.T) {
+		_, err := svc.GetGstSummary(testProContext("other-user"), connect.NewRequest(&pfinancev1.GetGstSummaryRequest{UserId: "user-123"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+	})
+}
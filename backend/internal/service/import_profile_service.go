@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/auth"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxImportProfilesPerUser = 50
+	maxImportProfileRules    = 500
+)
+
+// CreateImportProfile saves a new import profile for the authenticated user.
+func (s *FinanceService) CreateImportProfile(ctx context.Context, req *connect.Request[pfinancev1.CreateImportProfileRequest]) (*connect.Response[pfinancev1.CreateImportProfileResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &pfinancev1.ImportProfile{
+		Id:             uuid.New().String(),
+		UserId:         claims.UID,
+		Name:           strings.TrimSpace(req.Msg.Name),
+		BankName:       strings.TrimSpace(req.Msg.BankName),
+		DateFormat:     req.Msg.DateFormat,
+		ColumnMappings: req.Msg.ColumnMappings,
+		CategoryRules:  req.Msg.CategoryRules,
+		CreatedAt:      timestamppb.Now(),
+		UpdatedAt:      timestamppb.Now(),
+	}
+	if err := validateImportProfile(profile); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.store.ListImportProfiles(ctx, claims.UID)
+	if err != nil {
+		return nil, auth.WrapStoreError("list import profiles", err)
+	}
+	if len(existing) >= maxImportProfilesPerUser {
+		return nil, connect.NewError(connect.CodeResourceExhausted,
+			fmt.Errorf("maximum of %d import profiles allowed", maxImportProfilesPerUser))
+	}
+
+	if err := s.store.CreateImportProfile(ctx, profile); err != nil {
+		return nil, auth.WrapStoreError("create import profile", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.CreateImportProfileResponse{
+		Profile: profile,
+	}), nil
+}
+
+// GetImportProfile returns one of the authenticated user's import profiles.
+func (s *FinanceService) GetImportProfile(ctx context.Context, req *connect.Request[pfinancev1.GetImportProfileRequest]) (*connect.Response[pfinancev1.GetImportProfileResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.getOwnedImportProfile(ctx, claims.UID, req.Msg.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pfinancev1.GetImportProfileResponse{
+		Profile: profile,
+	}), nil
+}
+
+// UpdateImportProfile replaces the fields of an existing import profile.
+func (s *FinanceService) UpdateImportProfile(ctx context.Context, req *connect.Request[pfinancev1.UpdateImportProfileRequest]) (*connect.Response[pfinancev1.UpdateImportProfileResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.getOwnedImportProfile(ctx, claims.UID, req.Msg.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+
+	profile.Name = strings.TrimSpace(req.Msg.Name)
+	profile.BankName = strings.TrimSpace(req.Msg.BankName)
+	profile.DateFormat = req.Msg.DateFormat
+	profile.ColumnMappings = req.Msg.ColumnMappings
+	profile.CategoryRules = req.Msg.CategoryRules
+	profile.UpdatedAt = timestamppb.Now()
+	if err := validateImportProfile(profile); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.UpdateImportProfile(ctx, profile); err != nil {
+		return nil, auth.WrapStoreError("update import profile", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.UpdateImportProfileResponse{
+		Profile: profile,
+	}), nil
+}
+
+// DeleteImportProfile deletes one of the authenticated user's import profiles.
+func (s *FinanceService) DeleteImportProfile(ctx context.Context, req *connect.Request[pfinancev1.DeleteImportProfileRequest]) (*connect.Response[emptypb.Empty], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedImportProfile(ctx, claims.UID, req.Msg.ProfileId); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.DeleteImportProfile(ctx, req.Msg.ProfileId); err != nil {
+		return nil, auth.WrapStoreError("delete import profile", err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// ListImportProfiles returns the authenticated user's import profiles.
+func (s *FinanceService) ListImportProfiles(ctx context.Context, req *connect.Request[pfinancev1.ListImportProfilesRequest]) (*connect.Response[pfinancev1.ListImportProfilesResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := s.store.ListImportProfiles(ctx, claims.UID)
+	if err != nil {
+		return nil, auth.WrapStoreError("list import profiles", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.ListImportProfilesResponse{
+		Profiles: profiles,
+	}), nil
+}
+
+// getOwnedImportProfile loads an import profile and checks that userID owns it.
+func (s *FinanceService) getOwnedImportProfile(ctx context.Context, userID, profileID string) (*pfinancev1.ImportProfile, error) {
+	if profileID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("profile_id is required"))
+	}
+
+	profile, err := s.store.GetImportProfile(ctx, profileID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("import profile not found"))
+	}
+	if profile.UserId != userID {
+		return nil, connect.NewError(connect.CodePermissionDenied,
+			fmt.Errorf("cannot access another user's import profile"))
+	}
+	return profile, nil
+}
+
+// validateImportProfile checks a profile's name, column mappings and category
+// rules. Rule patterns are lowercased so matching can compare them directly.
+func validateImportProfile(profile *pfinancev1.ImportProfile) error {
+	if profile.Name == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+
+	columns := make(map[string]bool, len(profile.ColumnMappings))
+	for _, m := range profile.ColumnMappings {
+		column := strings.ToLower(strings.TrimSpace(m.GetColumn()))
+		if column == "" {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("column mappings need a column name"))
+		}
+		if m.GetField() == pfinancev1.ImportField_IMPORT_FIELD_UNSPECIFIED {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("column %q needs a field", m.Column))
+		}
+		if columns[column] {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("column %q is mapped more than once", m.Column))
+		}
+		columns[column] = true
+	}
+
+	if len(profile.CategoryRules) > maxImportProfileRules {
+		return connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("at most %d category rules allowed", maxImportProfileRules))
+	}
+	for _, r := range profile.CategoryRules {
+		if r == nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("category rules must not be empty"))
+		}
+		r.Pattern = strings.ToLower(strings.TrimSpace(r.Pattern))
+		if r.Pattern == "" {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("category rules need a pattern"))
+		}
+		if r.Category == pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("category rule %q needs a category", r.Pattern))
+		}
+	}
+	return nil
+}
+
+// applyImportCategoryRules sets the suggested category and merchant of each
+// transaction from the first rule whose pattern its description contains.
+func applyImportCategoryRules(transactions []*pfinancev1.BankTransaction, rules []*pfinancev1.ImportCategoryRule) {
+	for _, tx := range transactions {
+		description := strings.ToLower(tx.Description)
+		for _, r := range rules {
+			if !strings.Contains(description, r.Pattern) {
+				continue
+			}
+			tx.SuggestedCategory = r.Category
+			if r.NormalizedMerchant != "" {
+				tx.NormalizedMerchant = r.NormalizedMerchant
+			}
+			break
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+)
+
+func TestImportProfileCRUD(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	created, err := svc.CreateImportProfile(ctx, connect.NewRequest(&pfinancev1.CreateImportProfileRequest{
+		Name:       " CBA everyday ",
+		BankName:   "CBA",
+		DateFormat: "02/01/2006",
+		ColumnMappings: []*pfinancev1.ImportColumnMapping{
+			{Column: "Date", Field: pfinancev1.ImportField_IMPORT_FIELD_DATE},
+			{Column: "Amount", Field: pfinancev1.ImportField_IMPORT_FIELD_AMOUNT},
+		},
+		CategoryRules: []*pfinancev1.ImportCategoryRule{
+			{Pattern: " WOOLWORTHS ", Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+		},
+	}))
+	if err != nil {
+		t.Fatalf("CreateImportProfile: %v", err)
+	}
+	profile := created.Msg.Profile
+	if profile.Name != "CBA everyday" || profile.UserId != "user-123" {
+		t.Errorf("profile = %q owned by %q, want trimmed name owned by the caller", profile.Name, profile.UserId)
+	}
+	if got := profile.CategoryRules[0].Pattern; got != "woolworths" {
+		t.Errorf("rule pattern = %q, want normalized to lowercase", got)
+	}
+
+	t.Run("get and list", func(t *testing.T) {
+		got, err := svc.GetImportProfile(ctx, connect.NewRequest(&pfinancev1.GetImportProfileRequest{ProfileId: profile.Id}))
+		if err != nil {
+			t.Fatalf("GetImportProfile: %v", err)
+		}
+		if got.Msg.Profile.BankName != "CBA" {
+			t.Errorf("bank = %q, want CBA", got.Msg.Profile.BankName)
+		}
+		list, err := svc.ListImportProfiles(ctx, connect.NewRequest(&pfinancev1.ListImportProfilesRequest{}))
+		if err != nil {
+			t.Fatalf("ListImportProfiles: %v", err)
+		}
+		if len(list.Msg.Profiles) != 1 {
+			t.Errorf("got %d profiles, want 1", len(list.Msg.Profiles))
+		}
+	})
+
+	t.Run("other users cannot see it", func(t *testing.T) {
+		other := testContext("other-user")
+		_, err := svc.GetImportProfile(other, connect.NewRequest(&pfinancev1.GetImportProfileRequest{ProfileId: profile.Id}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+		list, err := svc.ListImportProfiles(other, connect.NewRequest(&pfinancev1.ListImportProfilesRequest{}))
+		if err != nil || len(list.Msg.Profiles) != 0 {
+			t.Errorf("other user's list = %v, %v; want empty", list, err)
+		}
+	})
+
+	t.Run("invalid update leaves the profile unchanged", func(t *testing.T) {
+		_, err := svc.UpdateImportProfile(ctx, connect.NewRequest(&pfinancev1.UpdateImportProfileRequest{
+			ProfileId: profile.Id,
+			Name:      "Renamed",
+			ColumnMappings: []*pfinancev1.ImportColumnMapping{
+				{Column: "Date", Field: pfinancev1.ImportField_IMPORT_FIELD_DATE},
+				{Column: "date", Field: pfinancev1.ImportField_IMPORT_FIELD_DESCRIPTION},
+			},
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf("code = %v, want InvalidArgument for a duplicate column", connect.CodeOf(err))
+		}
+		stored, _ := memStore.GetImportProfile(t.Context(), profile.Id)
+		if stored.Name != "CBA everyday" {
+			t.Errorf("name = %q after a rejected update", stored.Name)
+		}
+	})
+
+	t.Run("update replaces fields", func(t *testing.T) {
+		resp, err := svc.UpdateImportProfile(ctx, connect.NewRequest(&pfinancev1.UpdateImportProfileRequest{
+			ProfileId: profile.Id,
+			Name:      "Renamed",
+			BankName:  "Westpac",
+		}))
+		if err != nil {
+			t.Fatalf("UpdateImportProfile: %v", err)
+		}
+		if resp.Msg.Profile.Name != "Renamed" || resp.Msg.Profile.BankName != "Westpac" || len(resp.Msg.Profile.CategoryRules) != 0 {
+			t.Errorf("updated profile = %v", resp.Msg.Profile)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if _, err := svc.DeleteImportProfile(ctx, connect.NewRequest(&pfinancev1.DeleteImportProfileRequest{ProfileId: profile.Id})); err != nil {
+			t.Fatalf("DeleteImportProfile: %v", err)
+		}
+		_, err := svc.GetImportProfile(ctx, connect.NewRequest(&pfinancev1.GetImportProfileRequest{ProfileId: profile.Id}))
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("code = %v, want NotFound after delete", connect.CodeOf(err))
+		}
+	})
+}
+
+func TestValidateImportProfile(t *testing.T) {
+	food := pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD
+	tests := []struct {
+		name    string
+		profile *pfinancev1.ImportProfile
+	}{
+		{"missing name", &pfinancev1.ImportProfile{}},
+		{"unnamed column", &pfinancev1.ImportProfile{Name: "p", ColumnMappings: []*pfinancev1.ImportColumnMapping{
+			{Field: pfinancev1.ImportField_IMPORT_FIELD_DATE},
+		}}},
+		{"unmapped column", &pfinancev1.ImportProfile{Name: "p", ColumnMappings: []*pfinancev1.ImportColumnMapping{
+			{Column: "Date"},
+		}}},
+		{"rule without pattern", &pfinancev1.ImportProfile{Name: "p", CategoryRules: []*pfinancev1.ImportCategoryRule{
+			{Pattern: "  ", Category: food},
+		}}},
+		{"rule without category", &pfinancev1.ImportProfile{Name: "p", CategoryRules: []*pfinancev1.ImportCategoryRule{
+			{Pattern: "coles"},
+		}}},
+		{"nil rule", &pfinancev1.ImportProfile{Name: "p", CategoryRules: []*pfinancev1.ImportCategoryRule{nil}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateImportProfile(tt.profile); connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestParseBankStatement_ImportProfile(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	mock := &mockExtractor{statementResult: &pfinancev1.BankStatementResult{
+		Transactions: []*pfinancev1.BankTransaction{
+			{Id: "t1", Description: "WOOLWORTHS 1234 SYDNEY"},
+			{Id: "t2", Description: "Direct debit SPOTIFY"},
+			{Id: "t3", Description: "Transfer to savings"},
+		},
+	}}
+	SetExtractionService(mock)
+	defer SetExtractionService(nil)
+
+	created, err := svc.CreateImportProfile(ctx, connect.NewRequest(&pfinancev1.CreateImportProfileRequest{
+		Name:     "CBA",
+		BankName: "CBA",
+		CategoryRules: []*pfinancev1.ImportCategoryRule{
+			{Pattern: "spotify", Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT, NormalizedMerchant: "Spotify"},
+			{Pattern: "woolworths", Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+			{Pattern: "debit", Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_OTHER},
+		},
+	}))
+	if err != nil {
+		t.Fatalf("CreateImportProfile: %v", err)
+	}
+
+	resp, err := svc.ParseBankStatement(ctx, connect.NewRequest(&pfinancev1.ParseBankStatementRequest{
+		PdfData:         []byte("%PDF"),
+		ImportProfileId: created.Msg.Profile.Id,
+	}))
+	if err != nil {
+		t.Fatalf("ParseBankStatement: %v", err)
+	}
+
+	if mock.lastBankHint != "CBA" {
+		t.Errorf("bank hint = %q, want the profile's bank", mock.lastBankHint)
+	}
+	txs := resp.Msg.Result.Transactions
+	if txs[0].SuggestedCategory != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD {
+		t.Errorf("t1 category = %v, want FOOD", txs[0].SuggestedCategory)
+	}
+	if txs[1].SuggestedCategory != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT || txs[1].NormalizedMerchant != "Spotify" {
+		t.Errorf("t2 = (%v, %q), want the first matching rule", txs[1].SuggestedCategory, txs[1].NormalizedMerchant)
+	}
+	if txs[2].SuggestedCategory != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED {
+		t.Errorf("t3 category = %v, want no rule applied", txs[2].SuggestedCategory)
+	}
+
+	t.Run("another user's profile is rejected", func(t *testing.T) {
+		_, err := svc.ParseBankStatement(testContext("other-user"), connect.NewRequest(&pfinancev1.ParseBankStatementRequest{
+			PdfData:         []byte("%PDF"),
+			ImportProfileId: created.Msg.Profile.Id,
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+	})
+}
+
+func TestParseBankStatement_CSVImportProfile(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	created, err := svc.CreateImportProfile(ctx, connect.NewRequest(&pfinancev1.CreateImportProfileRequest{
+		Name:       "ING",
+		BankName:   "ING",
+		DateFormat: "02-01-2006",
+		ColumnMappings: []*pfinancev1.ImportColumnMapping{
+			{Column: "Txn Date", Field: pfinancev1.ImportField_IMPORT_FIELD_DATE},
+			{Column: "Narrative", Field: pfinancev1.ImportField_IMPORT_FIELD_DESCRIPTION},
+			{Column: "Value", Field: pfinancev1.ImportField_IMPORT_FIELD_AMOUNT},
+		},
+		CategoryRules: []*pfinancev1.ImportCategoryRule{
+			{Pattern: "woolworths", Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+		},
+	}))
+	if err != nil {
+		t.Fatalf("CreateImportProfile: %v", err)
+	}
+
+	// No extraction service is configured: CSV is parsed locally.
+	resp, err := svc.ParseBankStatement(ctx, connect.NewRequest(&pfinancev1.ParseBankStatementRequest{
+		CsvData:         []byte("Txn Date,Narrative,Value\n05-03-2025,WOOLWORTHS 1234,-45.20\n"),
+		ImportProfileId: created.Msg.Profile.Id,
+	}))
+	if err != nil {
+		t.Fatalf("ParseBankStatement: %v", err)
+	}
+
+	txs := resp.Msg.Result.Transactions
+	if len(txs) != 1 {
+		t.Fatalf("got %d transactions, want 1", len(txs))
+	}
+	if txs[0].Date != "2025-03-05" || txs[0].Description != "WOOLWORTHS 1234" || txs[0].AmountCents != 4520 || !txs[0].IsDebit {
+		t.Errorf("transaction = %v, want the profile's columns and date format applied", txs[0])
+	}
+	if txs[0].SuggestedCategory != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD {
+		t.Errorf("category = %v, want FOOD from the profile's rules", txs[0].SuggestedCategory)
+	}
+	if resp.Msg.Result.BankDetected != "ING" {
+		t.Errorf("bank = %q, want the profile's bank", resp.Msg.Result.BankDetected)
+	}
+
+	t.Run("headers the profile doesn't map are rejected", func(t *testing.T) {
+		_, err := svc.ParseBankStatement(ctx, connect.NewRequest(&pfinancev1.ParseBankStatementRequest{
+			CsvData:         []byte("Date,Description,Amount\n2025-03-05,X,-1.00\n"),
+			ImportProfileId: created.Msg.Profile.Id,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+}
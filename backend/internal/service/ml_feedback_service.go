@@ -12,6 +12,7 @@ import (
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
 	"github.com/castlemilk/pfinance/backend/internal/auth"
 	"github.com/castlemilk/pfinance/backend/internal/extraction"
+	"github.com/castlemilk/pfinance/backend/internal/store"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -149,10 +150,7 @@ func (s *FinanceService) upsertCategoryMapping(ctx context.Context, userID, merc
 
 	for _, m := range mappings {
 		if strings.EqualFold(m.RawPattern, rawPattern) {
-			m.Category = category
-			m.CorrectionCount++
-			m.Confidence = merchantConfidence(m.CorrectionCount)
-			m.LastUsed = timestamppb.Now()
+			mergeMerchantMapping(m, "", category)
 			return true, s.store.UpsertMerchantMapping(ctx, m)
 		}
 	}
@@ -171,6 +169,21 @@ func (s *FinanceService) upsertCategoryMapping(ctx context.Context, userID, merc
 	return true, s.store.UpsertMerchantMapping(ctx, mapping)
 }
 
+// mergeMerchantMapping folds another correction into an existing mapping: a
+// non-empty name and a specified category replace the stored ones, and the
+// correction count and confidence go up.
+func mergeMerchantMapping(existing *pfinancev1.MerchantMapping, name string, category pfinancev1.ExpenseCategory) {
+	if name != "" {
+		existing.NormalizedName = name
+	}
+	if category != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED {
+		existing.Category = category
+	}
+	existing.CorrectionCount++
+	existing.Confidence = merchantConfidence(existing.CorrectionCount)
+	existing.LastUsed = timestamppb.Now()
+}
+
 // merchantConfidence returns confidence derived from correction count.
 func merchantConfidence(count int32) float64 {
 	return math.Min(0.99, 0.7+0.05*float64(count))
@@ -220,7 +233,7 @@ func (s *FinanceService) findDuplicatesForTransaction(ctx context.Context, userI
 		}
 	}
 
-	expenses, _, err := s.store.ListExpenses(ctx, userID, groupID, startDate, endDate, 100, "")
+	expenses, _, err := s.store.ListExpenses(ctx, userID, groupID, store.ExpenseQuery{StartDate: startDate, EndDate: endDate}, 100, "")
 	if err != nil {
 		return nil
 	}
@@ -412,6 +425,157 @@ func (s *FinanceService) GetMerchantSuggestions(ctx context.Context, req *connec
 	}), nil
 }
 
+// ListMerchantMappings returns a page of the user's learned merchant mappings
+// so they can be audited.
+func (s *FinanceService) ListMerchantMappings(ctx context.Context, req *connect.Request[pfinancev1.ListMerchantMappingsRequest]) (*connect.Response[pfinancev1.ListMerchantMappingsResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" {
+		userID = claims.UID
+	}
+	if userID != claims.UID {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot list merchant mappings for another user"))
+	}
+
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := store.QueryFingerprint(userID, req.Msg.Query, req.Msg.SortBy)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	mappings, nextPageToken, err := s.store.ListMerchantMappings(ctx, userID, req.Msg.Query, req.Msg.SortBy, pageSize, pageToken)
+	if err != nil {
+		return nil, auth.WrapStoreError("list merchant mappings", err)
+	}
+
+	return connect.NewResponse(&pfinancev1.ListMerchantMappingsResponse{
+		Mappings:      mappings,
+		NextPageToken: store.EncodeQueryPageToken(nextPageToken, fingerprint),
+	}), nil
+}
+
+// DeleteMerchantMapping removes a learned merchant mapping, e.g. one learned
+// from a mistaken correction.
+func (s *FinanceService) DeleteMerchantMapping(ctx context.Context, req *connect.Request[pfinancev1.DeleteMerchantMappingRequest]) (*connect.Response[pfinancev1.DeleteMerchantMappingResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" {
+		userID = claims.UID
+	}
+	if userID != claims.UID {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot delete merchant mappings for another user"))
+	}
+	if req.Msg.RawPattern == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("raw_pattern is required"))
+	}
+
+	if err := s.store.DeleteMerchantMapping(ctx, userID, req.Msg.RawPattern); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("merchant mapping not found"))
+	}
+
+	return connect.NewResponse(&pfinancev1.DeleteMerchantMappingResponse{}), nil
+}
+
+// maxMerchantMappingBatch caps how many mappings BatchUpsertMerchantMappings
+// accepts in one request.
+const maxMerchantMappingBatch = 500
+
+// BatchUpsertMerchantMappings bulk-loads merchant mappings, e.g. when migrating
+// from another tool. Each entry is merged into the user's existing mapping for
+// the same pattern, as a correction would be, or creates a new one. Invalid
+// entries are reported back rather than failing the whole batch.
+func (s *FinanceService) BatchUpsertMerchantMappings(ctx context.Context, req *connect.Request[pfinancev1.BatchUpsertMerchantMappingsRequest]) (*connect.Response[pfinancev1.BatchUpsertMerchantMappingsResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" {
+		userID = claims.UID
+	}
+	if userID != claims.UID {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot import merchant mappings for another user"))
+	}
+	if len(req.Msg.Mappings) > maxMerchantMappingBatch {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("at most %d merchant mappings can be imported at once", maxMerchantMappingBatch))
+	}
+
+	existing, err := s.store.GetMerchantMappings(ctx, userID)
+	if err != nil {
+		return nil, auth.WrapStoreError("get merchant mappings", err)
+	}
+	byPattern := make(map[string]*pfinancev1.MerchantMapping, len(existing))
+	for _, m := range existing {
+		byPattern[strings.ToLower(m.RawPattern)] = m
+	}
+
+	resp := &pfinancev1.BatchUpsertMerchantMappingsResponse{}
+	reject := func(i int, pattern, reason string) {
+		resp.Rejected = append(resp.Rejected, &pfinancev1.RejectedMerchantMapping{
+			Index:      int32(i),
+			RawPattern: pattern,
+			Reason:     reason,
+		})
+	}
+	for i, in := range req.Msg.Mappings {
+		rawPattern := strings.ToLower(strings.TrimSpace(in.RawPattern))
+		normalizedName := strings.TrimSpace(in.NormalizedName)
+		if rawPattern == "" {
+			reject(i, in.RawPattern, "raw_pattern is required")
+			continue
+		}
+		if normalizedName == "" && in.Category == pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_UNSPECIFIED {
+			reject(i, in.RawPattern, "normalized_name or category is required")
+			continue
+		}
+
+		// Same-pattern entries merge, including repeats within this batch
+		if m, ok := byPattern[rawPattern]; ok {
+			mergeMerchantMapping(m, normalizedName, in.Category)
+			if err := s.store.UpsertMerchantMapping(ctx, m); err != nil {
+				return nil, auth.WrapStoreError("upsert merchant mapping", err)
+			}
+			resp.UpdatedCount++
+			continue
+		}
+
+		if normalizedName == "" {
+			normalizedName = strings.TrimSpace(in.RawPattern)
+		}
+		mapping := &pfinancev1.MerchantMapping{
+			Id:              uuid.New().String(),
+			UserId:          userID,
+			RawPattern:      rawPattern,
+			NormalizedName:  normalizedName,
+			Category:        in.Category,
+			CorrectionCount: 1,
+			Confidence:      merchantConfidence(1),
+			LastUsed:        timestamppb.Now(),
+			CreatedAt:       timestamppb.Now(),
+		}
+		if err := s.store.UpsertMerchantMapping(ctx, mapping); err != nil {
+			return nil, auth.WrapStoreError("upsert merchant mapping", err)
+		}
+		byPattern[rawPattern] = mapping
+		resp.CreatedCount++
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 // GetExtractionMetrics returns extraction quality metrics.
 func (s *FinanceService) GetExtractionMetrics(ctx context.Context, req *connect.Request[pfinancev1.GetExtractionMetricsRequest]) (*connect.Response[pfinancev1.GetExtractionMetricsResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -198,7 +199,7 @@ func TestCheckDuplicates(t *testing.T) {
 
 	t.Run("detects exact duplicate", func(t *testing.T) {
 		mockStore.EXPECT().
-			ListExpenses(gomock.Any(), "user-1", "group-1", gomock.Any(), gomock.Any(), int32(100), "").
+			ListExpenses(gomock.Any(), "user-1", "group-1", gomock.Any(), int32(100), "").
 			Return([]*pfinancev1.Expense{
 				{
 					Id:          "exp-1",
@@ -243,7 +244,7 @@ func TestCheckDuplicates(t *testing.T) {
 
 	t.Run("no duplicates for different amounts", func(t *testing.T) {
 		mockStore.EXPECT().
-			ListExpenses(gomock.Any(), "user-1", "", gomock.Any(), gomock.Any(), int32(100), "").
+			ListExpenses(gomock.Any(), "user-1", "", gomock.Any(), int32(100), "").
 			Return([]*pfinancev1.Expense{
 				{
 					Id:          "exp-2",
@@ -909,6 +910,156 @@ func TestCategoryOverrideMemoryStore(t *testing.T) {
 	})
 }
 
+func TestMerchantMappingRPCs(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-1")
+
+	now := time.Now()
+	for i, m := range []*pfinancev1.MerchantMapping{
+		{RawPattern: "WOOLWORTHS 1234", Confidence: 0.7, LastUsed: timestamppb.New(now.Add(-48 * time.Hour))},
+		{RawPattern: "COLES 88", Confidence: 0.9, LastUsed: timestamppb.New(now.Add(-72 * time.Hour))},
+		{RawPattern: "WOOLWORTHS METRO", Confidence: 0.5, LastUsed: timestamppb.New(now)},
+	} {
+		m.Id = string(rune('a' + i))
+		m.UserId = "user-1"
+		if err := memStore.UpsertMerchantMapping(t.Context(), m); err != nil {
+			t.Fatalf("UpsertMerchantMapping: %v", err)
+		}
+	}
+	_ = memStore.UpsertMerchantMapping(t.Context(), &pfinancev1.MerchantMapping{Id: "z", UserId: "user-2", RawPattern: "COLES 88", Confidence: 1})
+
+	list := func(t *testing.T, req *pfinancev1.ListMerchantMappingsRequest) *pfinancev1.ListMerchantMappingsResponse {
+		t.Helper()
+		resp, err := svc.ListMerchantMappings(ctx, connect.NewRequest(req))
+		if err != nil {
+			t.Fatalf("ListMerchantMappings: %v", err)
+		}
+		return resp.Msg
+	}
+	patterns := func(mappings []*pfinancev1.MerchantMapping) []string {
+		var out []string
+		for _, m := range mappings {
+			out = append(out, m.RawPattern)
+		}
+		return out
+	}
+
+	t.Run("sorted by confidence and paged", func(t *testing.T) {
+		first := list(t, &pfinancev1.ListMerchantMappingsRequest{PageSize: 2})
+		if got := patterns(first.Mappings); len(got) != 2 || got[0] != "COLES 88" || got[1] != "WOOLWORTHS 1234" {
+			t.Errorf("first page = %v, want highest confidence first", got)
+		}
+		if first.NextPageToken == "" {
+			t.Fatal("expected a next page token")
+		}
+		second := list(t, &pfinancev1.ListMerchantMappingsRequest{PageSize: 2, PageToken: first.NextPageToken})
+		if got := patterns(second.Mappings); len(got) != 1 || got[0] != "WOOLWORTHS METRO" || second.NextPageToken != "" {
+			t.Errorf("second page = %v (token %q), want the last mapping", got, second.NextPageToken)
+		}
+	})
+
+	t.Run("sorted by last used with a pattern filter", func(t *testing.T) {
+		resp := list(t, &pfinancev1.ListMerchantMappingsRequest{
+			Query:  "woolworths",
+			SortBy: pfinancev1.MerchantMappingSort_MERCHANT_MAPPING_SORT_LAST_USED,
+		})
+		if got := patterns(resp.Mappings); len(got) != 2 || got[0] != "WOOLWORTHS METRO" {
+			t.Errorf("mappings = %v, want the woolworths mappings, most recent first", got)
+		}
+	})
+
+	t.Run("another user's mappings are denied", func(t *testing.T) {
+		_, err := svc.ListMerchantMappings(ctx, connect.NewRequest(&pfinancev1.ListMerchantMappingsRequest{UserId: "user-2"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if _, err := svc.DeleteMerchantMapping(ctx, connect.NewRequest(&pfinancev1.DeleteMerchantMappingRequest{RawPattern: "COLES 88"})); err != nil {
+			t.Fatalf("DeleteMerchantMapping: %v", err)
+		}
+		if got := patterns(list(t, &pfinancev1.ListMerchantMappingsRequest{}).Mappings); len(got) != 2 {
+			t.Errorf("mappings after delete = %v, want 2", got)
+		}
+		others, _ := memStore.GetMerchantMappings(t.Context(), "user-2")
+		if len(others) != 1 {
+			t.Errorf("user-2 has %d mappings, want the same pattern left untouched", len(others))
+		}
+
+		_, err := svc.DeleteMerchantMapping(ctx, connect.NewRequest(&pfinancev1.DeleteMerchantMappingRequest{RawPattern: "COLES 88"}))
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("code = %v, want NotFound for a deleted mapping", connect.CodeOf(err))
+		}
+	})
+}
+
+func TestBatchUpsertMerchantMappings(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-1")
+
+	if err := memStore.UpsertMerchantMapping(t.Context(), &pfinancev1.MerchantMapping{
+		Id: "existing", UserId: "user-1", RawPattern: "COLES 88", NormalizedName: "Coles",
+		Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD, CorrectionCount: 2, Confidence: merchantConfidence(2),
+	}); err != nil {
+		t.Fatalf("UpsertMerchantMapping: %v", err)
+	}
+
+	resp, err := svc.BatchUpsertMerchantMappings(ctx, connect.NewRequest(&pfinancev1.BatchUpsertMerchantMappingsRequest{
+		Mappings: []*pfinancev1.MerchantMapping{
+			{RawPattern: "coles 88", Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_SHOPPING},
+			{RawPattern: " Netflix.com ", NormalizedName: "Netflix", Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT},
+			{RawPattern: "NETFLIX.COM", NormalizedName: "Netflix Premium"},
+			{RawPattern: "  ", NormalizedName: "Blank"},
+			{RawPattern: "UBER"},
+		},
+	}))
+	if err != nil {
+		t.Fatalf("BatchUpsertMerchantMappings: %v", err)
+	}
+	if resp.Msg.CreatedCount != 1 || resp.Msg.UpdatedCount != 2 {
+		t.Errorf("created/updated = %d/%d, want 1/2", resp.Msg.CreatedCount, resp.Msg.UpdatedCount)
+	}
+	if len(resp.Msg.Rejected) != 2 || resp.Msg.Rejected[0].Index != 3 || resp.Msg.Rejected[1].Index != 4 {
+		t.Fatalf("rejected = %v, want the blank pattern and the empty mapping", resp.Msg.Rejected)
+	}
+
+	mappings, _ := memStore.GetMerchantMappings(t.Context(), "user-1")
+	byPattern := map[string]*pfinancev1.MerchantMapping{}
+	for _, m := range mappings {
+		byPattern[strings.ToLower(m.RawPattern)] = m
+	}
+	if len(byPattern) != 2 {
+		t.Fatalf("got %d mappings, want 2", len(byPattern))
+	}
+	coles := byPattern["coles 88"]
+	if coles.Id != "existing" || coles.NormalizedName != "Coles" || coles.Category != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_SHOPPING || coles.CorrectionCount != 3 {
+		t.Errorf("coles mapping = %+v, want the existing mapping recategorized", coles)
+	}
+	netflix := byPattern["netflix.com"]
+	if netflix.NormalizedName != "Netflix Premium" || netflix.Category != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT {
+		t.Errorf("netflix mapping = %+v, want the repeat merged into the new mapping", netflix)
+	}
+
+	t.Run("rejects oversized batches", func(t *testing.T) {
+		_, err := svc.BatchUpsertMerchantMappings(ctx, connect.NewRequest(&pfinancev1.BatchUpsertMerchantMappingsRequest{
+			Mappings: make([]*pfinancev1.MerchantMapping, maxMerchantMappingBatch+1),
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("another user's mappings are denied", func(t *testing.T) {
+		_, err := svc.BatchUpsertMerchantMappings(ctx, connect.NewRequest(&pfinancev1.BatchUpsertMerchantMappingsRequest{UserId: "user-2"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+	})
+}
+
 func TestLevenshteinRatio(t *testing.T) {
 	tests := []struct {
 		a, b     string
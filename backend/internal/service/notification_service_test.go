@@ -1,13 +1,16 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
 	"github.com/castlemilk/pfinance/backend/internal/store"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -192,6 +195,90 @@ func TestMarkAllNotificationsRead(t *testing.T) {
 	})
 }
 
+func TestDeleteNotifications(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	for _, n := range []*pfinancev1.Notification{
+		{Id: "read-1", UserId: "user-123", IsRead: true},
+		{Id: "read-2", UserId: "user-123", IsRead: true},
+		{Id: "unread-1", UserId: "user-123"},
+		{Id: "unread-2", UserId: "user-123"},
+		{Id: "other-read", UserId: "user-456", IsRead: true},
+	} {
+		if _, err := memStore.CreateNotification(t.Context(), n); err != nil {
+			t.Fatalf("CreateNotification: %v", err)
+		}
+	}
+
+	unreadCount := func() int32 {
+		t.Helper()
+		resp, err := svc.GetUnreadNotificationCount(ctx, connect.NewRequest(&pfinancev1.GetUnreadNotificationCountRequest{}))
+		if err != nil {
+			t.Fatalf("GetUnreadNotificationCount: %v", err)
+		}
+		return resp.Msg.Count
+	}
+
+	t.Run("cannot delete another user's notification", func(t *testing.T) {
+		_, err := svc.DeleteNotification(ctx, connect.NewRequest(&pfinancev1.DeleteNotificationRequest{
+			NotificationId: "other-read",
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Fatalf("expected permission denied, got %v", err)
+		}
+		if _, err := memStore.GetNotification(t.Context(), "other-read"); err != nil {
+			t.Error("expected other user's notification to survive")
+		}
+	})
+
+	t.Run("missing notification is not found", func(t *testing.T) {
+		_, err := svc.DeleteNotification(ctx, connect.NewRequest(&pfinancev1.DeleteNotificationRequest{
+			NotificationId: "missing",
+		}))
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Fatalf("expected not found, got %v", err)
+		}
+	})
+
+	t.Run("deleting an unread notification updates unread count", func(t *testing.T) {
+		if _, err := svc.DeleteNotification(ctx, connect.NewRequest(&pfinancev1.DeleteNotificationRequest{
+			NotificationId: "unread-1",
+		})); err != nil {
+			t.Fatalf("DeleteNotification: %v", err)
+		}
+		if got := unreadCount(); got != 1 {
+			t.Errorf("expected 1 unread, got %d", got)
+		}
+	})
+
+	t.Run("delete all read keeps unread and other users", func(t *testing.T) {
+		resp, err := svc.DeleteAllReadNotifications(ctx, connect.NewRequest(&pfinancev1.DeleteAllReadNotificationsRequest{}))
+		if err != nil {
+			t.Fatalf("DeleteAllReadNotifications: %v", err)
+		}
+		if resp.Msg.DeletedCount != 2 {
+			t.Errorf("expected 2 deleted, got %d", resp.Msg.DeletedCount)
+		}
+		if got := unreadCount(); got != 1 {
+			t.Errorf("expected 1 unread, got %d", got)
+		}
+		if _, err := memStore.GetNotification(t.Context(), "other-read"); err != nil {
+			t.Error("expected other user's read notification to survive")
+		}
+	})
+
+	t.Run("delete all read for different user is denied", func(t *testing.T) {
+		_, err := svc.DeleteAllReadNotifications(ctx, connect.NewRequest(&pfinancev1.DeleteAllReadNotificationsRequest{
+			UserId: "user-456",
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("expected permission denied, got %v", err)
+		}
+	})
+}
+
 func TestGetUnreadNotificationCount(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -217,6 +304,77 @@ func TestGetUnreadNotificationCount(t *testing.T) {
 	})
 }
 
+func TestGetNotificationStats(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	day1 := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	day2 := time.Date(2025, 3, 11, 18, 0, 0, 0, time.UTC)
+	for i, n := range []*pfinancev1.Notification{
+		{UserId: "user-123", Type: pfinancev1.NotificationType_NOTIFICATION_TYPE_BUDGET_THRESHOLD, CreatedAt: timestamppb.New(day1)},
+		{UserId: "user-123", Type: pfinancev1.NotificationType_NOTIFICATION_TYPE_BUDGET_THRESHOLD, CreatedAt: timestamppb.New(day1.Add(time.Hour))},
+		{UserId: "user-123", Type: pfinancev1.NotificationType_NOTIFICATION_TYPE_BILL_REMINDER, CreatedAt: timestamppb.New(day1)},
+		{UserId: "user-123", Type: pfinancev1.NotificationType_NOTIFICATION_TYPE_GOAL_MILESTONE, CreatedAt: timestamppb.New(day2)},
+		{UserId: "user-123", Type: pfinancev1.NotificationType_NOTIFICATION_TYPE_GOAL_MILESTONE, CreatedAt: timestamppb.New(day2.AddDate(0, 1, 0))},
+		{UserId: "user-456", Type: pfinancev1.NotificationType_NOTIFICATION_TYPE_GOAL_MILESTONE, CreatedAt: timestamppb.New(day2)},
+	} {
+		n.Id = fmt.Sprintf("n%d", i)
+		if _, err := memStore.CreateNotification(t.Context(), n); err != nil {
+			t.Fatalf("CreateNotification: %v", err)
+		}
+	}
+
+	t.Run("buckets by day and type", func(t *testing.T) {
+		resp, err := svc.GetNotificationStats(ctx, connect.NewRequest(&pfinancev1.GetNotificationStatsRequest{
+			StartDate: timestamppb.New(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)),
+			EndDate:   timestamppb.New(time.Date(2025, 3, 31, 23, 59, 59, 0, time.UTC)),
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Msg.TotalCount != 4 {
+			t.Errorf("expected total 4, got %d", resp.Msg.TotalCount)
+		}
+		want := []struct {
+			date  string
+			typ   pfinancev1.NotificationType
+			count int32
+		}{
+			{"2025-03-10", pfinancev1.NotificationType_NOTIFICATION_TYPE_BUDGET_THRESHOLD, 2},
+			{"2025-03-10", pfinancev1.NotificationType_NOTIFICATION_TYPE_BILL_REMINDER, 1},
+			{"2025-03-11", pfinancev1.NotificationType_NOTIFICATION_TYPE_GOAL_MILESTONE, 1},
+		}
+		if len(resp.Msg.Counts) != len(want) {
+			t.Fatalf("expected %d buckets, got %d", len(want), len(resp.Msg.Counts))
+		}
+		for i, w := range want {
+			got := resp.Msg.Counts[i]
+			if got.Date != w.date || got.Type != w.typ || got.Count != w.count {
+				t.Errorf("bucket %d = (%s, %v, %d), want (%s, %v, %d)", i, got.Date, got.Type, got.Count, w.date, w.typ, w.count)
+			}
+		}
+	})
+
+	t.Run("permission denied for different user", func(t *testing.T) {
+		_, err := svc.GetNotificationStats(ctx, connect.NewRequest(&pfinancev1.GetNotificationStatsRequest{
+			UserId:    "user-456",
+			StartDate: timestamppb.New(day1),
+			EndDate:   timestamppb.New(day2),
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("expected PermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("requires a date range", func(t *testing.T) {
+		_, err := svc.GetNotificationStats(ctx, connect.NewRequest(&pfinancev1.GetNotificationStatsRequest{}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err)
+		}
+	})
+}
+
 func TestGetNotificationPreferences(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -305,14 +463,9 @@ func TestNotificationTrigger_BudgetThreshold(t *testing.T) {
 				UserId:       "user-123",
 				BudgetAlerts: true,
 			}, nil)
-		mockStore.EXPECT().
-			HasNotification(gomock.Any(), "user-123",
-				pfinancev1.NotificationType_NOTIFICATION_TYPE_BUDGET_THRESHOLD,
-				"budget-1", "threshold", "80", 720).
-			Return(false, nil)
 		mockStore.EXPECT().
 			CreateNotification(gomock.Any(), gomock.Any()).
-			Return(nil)
+			Return(nil, nil)
 
 		budget := &pfinancev1.Budget{
 			Id:          "budget-1",
@@ -350,33 +503,228 @@ func TestNotificationTrigger_BudgetThreshold(t *testing.T) {
 }
 
 func TestNotificationTrigger_BudgetThreshold_Dedup(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+	memStore := store.NewMemoryStore()
+	trigger := NewNotificationTrigger(memStore)
 
-	mockStore := store.NewMockStore(ctrl)
-	trigger := NewNotificationTrigger(mockStore)
+	budget := &pfinancev1.Budget{
+		Id:          "budget-1",
+		Name:        "Food",
+		AmountCents: 50000,
+	}
+	for range 2 {
+		trigger.CheckBudgetThreshold(testContext("user-123"), "user-123", budget, 45000, 80)
+	}
 
-	t.Run("skips when duplicate exists", func(t *testing.T) {
-		mockStore.EXPECT().
-			GetNotificationPreferences(gomock.Any(), "user-123").
-			Return(&pfinancev1.NotificationPreferences{
-				UserId:       "user-123",
-				BudgetAlerts: true,
-			}, nil)
-		mockStore.EXPECT().
-			HasNotification(gomock.Any(), "user-123",
-				pfinancev1.NotificationType_NOTIFICATION_TYPE_BUDGET_THRESHOLD,
-						"budget-1", "threshold", "80", 720).
-			Return(true, nil) // duplicate exists!
-		// No CreateNotification expected
+	notifications, _, err := memStore.ListNotifications(t.Context(), "user-123", false,
+		pfinancev1.NotificationType_NOTIFICATION_TYPE_BUDGET_THRESHOLD, 10, "")
+	if err != nil {
+		t.Fatalf("ListNotifications: %v", err)
+	}
+	if len(notifications) != 1 {
+		t.Fatalf("got %d notifications, want 1", len(notifications))
+	}
+	if want := "budget-1:threshold:80:" + time.Now().Format("2006-01"); notifications[0].DedupKey != want {
+		t.Errorf("dedup key = %q, want %q", notifications[0].DedupKey, want)
+	}
+}
 
-		budget := &pfinancev1.Budget{
-			Id:          "budget-1",
-			Name:        "Food",
-			AmountCents: 50000,
+func TestNotificationTrigger_BudgetPace(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	trigger := NewNotificationTrigger(memStore)
+	ctx := testContext("user-123")
+
+	budget := &pfinancev1.Budget{Id: "budget-1", Name: "Food", AmountCents: 100000}
+	periodStart := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	progress := func(spentCents int64) *pfinancev1.BudgetProgress {
+		return &pfinancev1.BudgetProgress{
+			BudgetId:         budget.Id,
+			SpentAmountCents: spentCents,
+			PeriodStart:      timestamppb.New(periodStart),
+			PeriodEnd:        timestamppb.New(periodStart.AddDate(0, 1, 0)),
 		}
-		trigger.CheckBudgetThreshold(testContext("user-123"), "user-123", budget, 45000, 80)
-	})
+	}
+	day9 := periodStart.AddDate(0, 0, 8).Add(12 * time.Hour) // 30% of June elapsed
+	paceAlerts := func() []*pfinancev1.Notification {
+		t.Helper()
+		notifications, _, err := memStore.ListNotifications(t.Context(), "user-123", false,
+			pfinancev1.NotificationType_NOTIFICATION_TYPE_BUDGET_PACE, 10, "")
+		if err != nil {
+			t.Fatalf("ListNotifications: %v", err)
+		}
+		return notifications
+	}
+
+	// 45% spent at 30% elapsed is within the default 20 point margin
+	trigger.CheckBudgetPace(ctx, "user-123", budget, progress(45000), day9)
+	if n := len(paceAlerts()); n != 0 {
+		t.Fatalf("got %d alerts within the margin, want 0", n)
+	}
+
+	// 60% spent at 30% elapsed is ahead of pace, and alerts once per period
+	for range 2 {
+		trigger.CheckBudgetPace(ctx, "user-123", budget, progress(60000), day9)
+	}
+	alerts := paceAlerts()
+	if len(alerts) != 1 {
+		t.Fatalf("got %d alerts, want 1", len(alerts))
+	}
+	if want := "budget-1:pace:2025-06-01"; alerts[0].DedupKey != want {
+		t.Errorf("dedup key = %q, want %q", alerts[0].DedupKey, want)
+	}
+
+	// A tighter user margin alerts earlier
+	if err := memStore.UpdateNotificationPreferences(t.Context(), &pfinancev1.NotificationPreferences{
+		UserId: "user-456", BudgetAlerts: true, BudgetPaceMarginPct: 10,
+	}); err != nil {
+		t.Fatalf("UpdateNotificationPreferences: %v", err)
+	}
+	trigger.CheckBudgetPace(testContext("user-456"), "user-456", budget, progress(45000), day9)
+	notifications, _, _ := memStore.ListNotifications(t.Context(), "user-456", false,
+		pfinancev1.NotificationType_NOTIFICATION_TYPE_BUDGET_PACE, 10, "")
+	if len(notifications) != 1 {
+		t.Errorf("got %d alerts with a 10 point margin, want 1", len(notifications))
+	}
+}
+
+func TestNotificationTrigger_DeductionSubstantiation(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	trigger := NewNotificationTrigger(memStore)
+	ctx := testContext("user-123")
+
+	date := time.Date(2025, time.September, 10, 0, 0, 0, 0, time.UTC) // FY 2025-26
+	add := func(id string, category pfinancev1.TaxDeductionCategory, cents int64, receipt string) *pfinancev1.Expense {
+		t.Helper()
+		e := &pfinancev1.Expense{
+			Id: id, UserId: "user-123", Description: id, AmountCents: cents, Date: timestamppb.New(date),
+			IsTaxDeductible: true, TaxDeductionCategory: category, ReceiptStoragePath: receipt,
+		}
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+		return e
+	}
+	alerts := func() []*pfinancev1.Notification {
+		t.Helper()
+		notifications, _, err := memStore.ListNotifications(t.Context(), "user-123", false,
+			pfinancev1.NotificationType_NOTIFICATION_TYPE_TAX_SUBSTANTIATION, 10, "")
+		if err != nil {
+			t.Fatalf("ListNotifications: %v", err)
+		}
+		return notifications
+	}
+
+	// $120 of unreceipted laundry is under the $150 threshold, and receipted
+	// claims never count towards it
+	add("uniform-1", pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNIFORM, 20000, "receipts/uniform-1.jpg")
+	trigger.CheckDeductionSubstantiation(ctx, "user-123",
+		add("uniform-2", pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNIFORM, 12000, ""))
+	if n := len(alerts()); n != 0 {
+		t.Fatalf("got %d alerts under the no-receipt threshold, want 0", n)
+	}
+
+	// Crossing the threshold alerts once per category per FY
+	e := add("uniform-3", pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNIFORM, 5000, "")
+	for range 2 {
+		trigger.CheckDeductionSubstantiation(ctx, "user-123", e)
+	}
+	got := alerts()
+	if len(got) != 1 {
+		t.Fatalf("got %d alerts, want 1", len(got))
+	}
+	if want := "tax-substantiation-2025-26:TAX_DEDUCTION_CATEGORY_UNIFORM"; got[0].DedupKey != want {
+		t.Errorf("dedup key = %q, want %q", got[0].DedupKey, want)
+	}
+	if got[0].Metadata["unreceipted_cents"] != "17000" {
+		t.Errorf("unreceipted_cents = %q, want 17000", got[0].Metadata["unreceipted_cents"])
+	}
+
+	// Vehicle claims warn at 80% of the cap, receipted or not
+	trigger.CheckDeductionSubstantiation(ctx, "user-123",
+		add("car-1", pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_VEHICLE, 300000, "receipts/car-1.jpg"))
+	if n := len(alerts()); n != 1 {
+		t.Fatalf("got %d alerts below 80%% of the vehicle cap, want 1", n)
+	}
+	trigger.CheckDeductionSubstantiation(ctx, "user-123",
+		add("car-2", pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_VEHICLE, 60000, "receipts/car-2.jpg"))
+	got = alerts()
+	if len(got) != 2 {
+		t.Fatalf("got %d alerts, want the vehicle cap alert too", len(got))
+	}
+	var vehicle *pfinancev1.Notification
+	for _, n := range got {
+		if n.Metadata["category"] == pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_VEHICLE.String() {
+			vehicle = n
+		}
+	}
+	if vehicle == nil || vehicle.Metadata["claimed_cents"] != "360000" || vehicle.Metadata["cap_cents"] != "440000" {
+		t.Errorf("unexpected vehicle alert: %+v", vehicle)
+	}
+
+	// Categories without limits never alert
+	trigger.CheckDeductionSubstantiation(ctx, "user-123",
+		add("office-1", pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_HOME_OFFICE, 900000, ""))
+	if n := len(alerts()); n != 2 {
+		t.Errorf("got %d alerts after a home office claim, want 2", n)
+	}
+}
+
+func TestDeductionSubstantiationOnTaxStatusUpdate(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testProContext("user-123")
+
+	for _, id := range []string{"gift-1", "gift-2"} {
+		if err := memStore.CreateExpense(t.Context(), &pfinancev1.Expense{
+			Id: id, UserId: "user-123", Description: id, AmountCents: 2500, Date: timestamppb.Now(),
+		}); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+	markDeductible := func(id string) {
+		t.Helper()
+		_, err := svc.BatchUpdateExpenseTaxStatus(ctx, connect.NewRequest(&pfinancev1.BatchUpdateExpenseTaxStatusRequest{
+			UserId: "user-123",
+			Updates: []*pfinancev1.ExpenseTaxUpdate{{
+				ExpenseId:            id,
+				IsTaxDeductible:      true,
+				TaxDeductionCategory: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_DONATIONS,
+			}},
+		}))
+		if err != nil {
+			t.Fatalf("BatchUpdateExpenseTaxStatus: %v", err)
+		}
+	}
+	alerts := func() int {
+		t.Helper()
+		notifications, _, err := memStore.ListNotifications(t.Context(), "user-123", false,
+			pfinancev1.NotificationType_NOTIFICATION_TYPE_TAX_SUBSTANTIATION, 10, "")
+		if err != nil {
+			t.Fatalf("ListNotifications: %v", err)
+		}
+		return len(notifications)
+	}
+
+	// Substantiation warnings can be turned off without muting budget alerts
+	if err := memStore.UpdateNotificationPreferences(t.Context(), &pfinancev1.NotificationPreferences{
+		UserId: "user-123", BudgetAlerts: true, SubstantiationAlerts: proto.Bool(false),
+	}); err != nil {
+		t.Fatalf("UpdateNotificationPreferences: %v", err)
+	}
+	markDeductible("gift-1")
+	if n := alerts(); n != 0 {
+		t.Fatalf("got %d alerts with substantiation alerts off, want 0", n)
+	}
+
+	// Left unset, as in preferences saved before the setting existed, they are on
+	if err := memStore.UpdateNotificationPreferences(t.Context(), &pfinancev1.NotificationPreferences{
+		UserId: "user-123",
+	}); err != nil {
+		t.Fatalf("UpdateNotificationPreferences: %v", err)
+	}
+	markDeductible("gift-2")
+	if n := alerts(); n != 1 {
+		t.Errorf("got %d alerts for $50 of unreceipted donations, want 1", n)
+	}
 }
 
 func TestNotificationTrigger_GoalMilestone(t *testing.T) {
@@ -393,14 +741,9 @@ func TestNotificationTrigger_GoalMilestone(t *testing.T) {
 				UserId:         "user-123",
 				GoalMilestones: true,
 			}, nil)
-		mockStore.EXPECT().
-			HasNotification(gomock.Any(), "user-123",
-				pfinancev1.NotificationType_NOTIFICATION_TYPE_GOAL_MILESTONE,
-				"goal-1", "milestone", "50", 8760).
-			Return(false, nil)
 		mockStore.EXPECT().
 			CreateNotification(gomock.Any(), gomock.Any()).
-			Return(nil)
+			Return(nil, nil)
 
 		goal := &pfinancev1.FinancialGoal{
 			Id:                "goal-1",
@@ -417,14 +760,9 @@ func TestNotificationTrigger_GoalMilestone(t *testing.T) {
 				UserId:         "user-123",
 				GoalMilestones: true,
 			}, nil)
-		mockStore.EXPECT().
-			HasNotification(gomock.Any(), "user-123",
-				pfinancev1.NotificationType_NOTIFICATION_TYPE_GOAL_MILESTONE,
-				"goal-1", "milestone", "100", 8760).
-			Return(false, nil)
 		mockStore.EXPECT().
 			CreateNotification(gomock.Any(), gomock.Any()).
-			Return(nil)
+			Return(nil, nil)
 
 		goal := &pfinancev1.FinancialGoal{
 			Id:                "goal-1",
@@ -452,32 +790,104 @@ func TestNotificationTrigger_GoalMilestone(t *testing.T) {
 }
 
 func TestNotificationTrigger_GoalMilestone_Dedup(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	trigger := NewNotificationTrigger(memStore)
+
+	goal := &pfinancev1.FinancialGoal{
+		Id:                "goal-1",
+		Name:              "Emergency Fund",
+		TargetAmountCents: 1000000,
+	}
+	trigger.GoalMilestoneReached(testContext("user-123"), "user-123", goal, 500000)
+	trigger.GoalMilestoneReached(testContext("user-123"), "user-123", goal, 600000)
+	trigger.GoalMilestoneReached(testContext("user-123"), "user-123", goal, 800000)
+
+	notifications, _, err := memStore.ListNotifications(t.Context(), "user-123", false,
+		pfinancev1.NotificationType_NOTIFICATION_TYPE_GOAL_MILESTONE, 10, "")
+	if err != nil {
+		t.Fatalf("ListNotifications: %v", err)
+	}
+	if len(notifications) != 2 {
+		t.Errorf("got %d notifications, want one each for 50%% and 75%%", len(notifications))
+	}
+}
+
+func TestNotificationTrigger_MonthlySpendCap(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStore := store.NewMockStore(ctrl)
 	trigger := NewNotificationTrigger(mockStore)
 
-	t.Run("skips when milestone duplicate exists", func(t *testing.T) {
+	capPrefs := &pfinancev1.NotificationPreferences{
+		UserId:               "user-123",
+		MonthlySpendCapCents: 200000, // $2,000
+	}
+	referenceID := "spend-cap-" + time.Now().Format("2006-01")
+
+	t.Run("creates notification at 80% of cap", func(t *testing.T) {
 		mockStore.EXPECT().
 			GetNotificationPreferences(gomock.Any(), "user-123").
-			Return(&pfinancev1.NotificationPreferences{
-				UserId:         "user-123",
-				GoalMilestones: true,
-			}, nil)
+			Return(capPrefs, nil)
 		mockStore.EXPECT().
-			HasNotification(gomock.Any(), "user-123",
-				pfinancev1.NotificationType_NOTIFICATION_TYPE_GOAL_MILESTONE,
-				"goal-1", "milestone", "50", 8760).
-			Return(true, nil)
-		// No CreateNotification expected
+			CreateNotification(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, n *pfinancev1.Notification) (*pfinancev1.Notification, error) {
+				if n.Type != pfinancev1.NotificationType_NOTIFICATION_TYPE_SPEND_CAP {
+					t.Errorf("expected SPEND_CAP type, got %v", n.Type)
+				}
+				if n.Metadata["threshold"] != "80" {
+					t.Errorf("expected threshold 80, got %s", n.Metadata["threshold"])
+				}
+				if n.DedupKey != referenceID+":threshold:80" {
+					t.Errorf("expected dedup key for this month's 80%% threshold, got %q", n.DedupKey)
+				}
+				return n, nil
+			})
 
-		goal := &pfinancev1.FinancialGoal{
-			Id:                "goal-1",
-			Name:              "Emergency Fund",
-			TargetAmountCents: 1000000,
-		}
-		trigger.GoalMilestoneReached(testContext("user-123"), "user-123", goal, 500000)
+		trigger.CheckMonthlySpendCap(testContext("user-123"), "user-123", 170000) // 85%
+	})
+
+	t.Run("creates notification at 100% of cap", func(t *testing.T) {
+		mockStore.EXPECT().
+			GetNotificationPreferences(gomock.Any(), "user-123").
+			Return(capPrefs, nil)
+		mockStore.EXPECT().
+			CreateNotification(gomock.Any(), gomock.Any()).
+			Return(nil, nil)
+
+		trigger.CheckMonthlySpendCap(testContext("user-123"), "user-123", 210000)
+	})
+
+	t.Run("same threshold this month reuses the dedup key", func(t *testing.T) {
+		mockStore.EXPECT().
+			GetNotificationPreferences(gomock.Any(), "user-123").
+			Return(capPrefs, nil)
+		mockStore.EXPECT().
+			CreateNotification(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, n *pfinancev1.Notification) (*pfinancev1.Notification, error) {
+				if n.DedupKey != referenceID+":threshold:80" {
+					t.Errorf("expected dedup key for this month's 80%% threshold, got %q", n.DedupKey)
+				}
+				return n, nil
+			})
+
+		trigger.CheckMonthlySpendCap(testContext("user-123"), "user-123", 160000)
+	})
+
+	t.Run("skips below 80%", func(t *testing.T) {
+		mockStore.EXPECT().
+			GetNotificationPreferences(gomock.Any(), "user-123").
+			Return(capPrefs, nil)
+
+		trigger.CheckMonthlySpendCap(testContext("user-123"), "user-123", 100000)
+	})
+
+	t.Run("zero cap disables alert", func(t *testing.T) {
+		mockStore.EXPECT().
+			GetNotificationPreferences(gomock.Any(), "user-123").
+			Return(&pfinancev1.NotificationPreferences{UserId: "user-123"}, nil)
+
+		trigger.CheckMonthlySpendCap(testContext("user-123"), "user-123", 1000000)
 	})
 }
 
@@ -495,14 +905,9 @@ func TestNotificationTrigger_BillReminder(t *testing.T) {
 				UserId:        "user-123",
 				BillReminders: true,
 			}, nil)
-		mockStore.EXPECT().
-			HasNotification(gomock.Any(), "user-123",
-				pfinancev1.NotificationType_NOTIFICATION_TYPE_BILL_REMINDER,
-				"rt-1", "", "", 720).
-			Return(false, nil)
 		mockStore.EXPECT().
 			CreateNotification(gomock.Any(), gomock.Any()).
-			Return(nil)
+			Return(nil, nil)
 
 		rt := &pfinancev1.RecurringTransaction{
 			Id:             "rt-1",
@@ -527,7 +932,7 @@ func TestNotificationTrigger_BillReminder(t *testing.T) {
 		trigger.BillReminder(testContext("user-123"), "user-123", rt)
 	})
 
-	t.Run("skips when bill reminder duplicate exists", func(t *testing.T) {
+	t.Run("dedup key is scoped to the occurrence", func(t *testing.T) {
 		mockStore.EXPECT().
 			GetNotificationPreferences(gomock.Any(), "user-123").
 			Return(&pfinancev1.NotificationPreferences{
@@ -535,15 +940,18 @@ func TestNotificationTrigger_BillReminder(t *testing.T) {
 				BillReminders: true,
 			}, nil)
 		mockStore.EXPECT().
-			HasNotification(gomock.Any(), "user-123",
-				pfinancev1.NotificationType_NOTIFICATION_TYPE_BILL_REMINDER,
-				"rt-1", "", "", 720).
-			Return(true, nil)
-		// No CreateNotification expected
+			CreateNotification(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, n *pfinancev1.Notification) (*pfinancev1.Notification, error) {
+				if n.DedupKey != "rt-1:bill:2025-03-14" {
+					t.Errorf("dedup key = %q, want rt-1:bill:2025-03-14", n.DedupKey)
+				}
+				return n, nil
+			})
 
 		rt := &pfinancev1.RecurringTransaction{
-			Id:          "rt-1",
-			Description: "Netflix",
+			Id:             "rt-1",
+			Description:    "Netflix",
+			NextOccurrence: timestamppb.New(time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)),
 		}
 		trigger.BillReminder(testContext("user-123"), "user-123", rt)
 	})
@@ -576,7 +984,7 @@ func TestNotificationTrigger_GroupExpenseAdded(t *testing.T) {
 		// Expect notifications for user-2 and user-3 (not user-1 = actor)
 		mockStore.EXPECT().
 			CreateNotification(gomock.Any(), gomock.Any()).
-			Return(nil).Times(2)
+			Return(nil, nil).Times(2)
 
 		trigger.GroupExpenseAdded(testContext("user-1"), "user-1", group, expense)
 	})
@@ -608,7 +1016,7 @@ func TestNotificationTrigger_GroupIncomeAdded(t *testing.T) {
 		// Only user-2 should get notified (user-1 is actor)
 		mockStore.EXPECT().
 			CreateNotification(gomock.Any(), gomock.Any()).
-			Return(nil).Times(1)
+			Return(nil, nil).Times(1)
 
 		trigger.GroupIncomeAdded(testContext("user-1"), "user-1", group, income)
 	})
@@ -630,13 +1038,13 @@ func TestGenerateWeeklyDigest(t *testing.T) {
 				WeeklyDigest: true,
 			}, nil)
 		mockStore.EXPECT().
-			ListExpenses(gomock.Any(), "user-123", "", gomock.Any(), gomock.Any(), int32(1000), "").
+			ListExpenses(gomock.Any(), "user-123", "", gomock.Any(), int32(1000), "").
 			Return([]*pfinancev1.Expense{
 				{AmountCents: 5000, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
 				{AmountCents: 3000, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_TRANSPORTATION},
 			}, "", nil)
 		mockStore.EXPECT().
-			ListIncomes(gomock.Any(), "user-123", "", gomock.Any(), gomock.Any(), int32(1000), "").
+			ListIncomes(gomock.Any(), "user-123", "", gomock.Any(), gomock.Any(), "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(1000), "").
 			Return([]*pfinancev1.Income{
 				{AmountCents: 100000},
 			}, "", nil)
@@ -656,7 +1064,7 @@ func TestGenerateWeeklyDigest(t *testing.T) {
 			Return([]*pfinancev1.RecurringTransaction{}, "", nil)
 		mockStore.EXPECT().
 			CreateNotification(gomock.Any(), gomock.Any()).
-			Return(nil)
+			Return(nil, nil)
 
 		ctx := testContext("user-123")
 		resp, err := svc.GenerateWeeklyDigest(ctx, connect.NewRequest(&pfinancev1.GenerateWeeklyDigestRequest{
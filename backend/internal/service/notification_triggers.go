@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math"
+	"strings"
 	"time"
 
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
@@ -22,7 +24,7 @@ func NewNotificationTrigger(store store.Store) *NotificationTrigger {
 }
 
 // CheckBudgetThreshold creates a notification if budget spending exceeds a threshold.
-// Deduplication: only one notification per budget+threshold per 30 days.
+// Deduplication: only one notification per budget+threshold per calendar month.
 func (t *NotificationTrigger) CheckBudgetThreshold(ctx context.Context, userID string, budget *pfinancev1.Budget, spentCents int64, thresholdPct float64) {
 	if budget.AmountCents <= 0 {
 		return
@@ -39,18 +41,7 @@ func (t *NotificationTrigger) CheckBudgetThreshold(ctx context.Context, userID s
 		return
 	}
 
-	// Dedup: check if we already sent this threshold notification within 30 days
 	thresholdStr := fmt.Sprintf("%.0f", thresholdPct)
-	exists, err := t.store.HasNotification(ctx, userID,
-		pfinancev1.NotificationType_NOTIFICATION_TYPE_BUDGET_THRESHOLD,
-		budget.Id, "threshold", thresholdStr, 720) // 720 hours = 30 days
-	if err != nil {
-		log.Printf("[NotificationTrigger] Failed to check for existing budget notification: %v", err)
-		return
-	}
-	if exists {
-		return
-	}
 
 	title := fmt.Sprintf("Budget Alert: %s", budget.Name)
 	message := fmt.Sprintf("You've spent %.0f%% of your %s budget.", pct, budget.Name)
@@ -70,13 +61,123 @@ func (t *NotificationTrigger) CheckBudgetThreshold(ctx context.Context, userID s
 		ReferenceType: "budget",
 		CreatedAt:     timestamppb.Now(),
 		Metadata:      map[string]string{"threshold": thresholdStr},
+		DedupKey:      fmt.Sprintf("%s:threshold:%s:%s", budget.Id, thresholdStr, time.Now().Format("2006-01")),
 	}
 
-	if err := t.store.CreateNotification(ctx, notification); err != nil {
+	if _, err := t.store.CreateNotification(ctx, notification); err != nil {
 		log.Printf("[NotificationTrigger] Failed to create budget threshold notification: %v", err)
 	}
 }
 
+// defaultBudgetPaceMarginPct is how many percentage points spending may run
+// ahead of the elapsed period before CheckBudgetPace alerts, when the user has
+// not set a margin.
+const defaultBudgetPaceMarginPct = 20
+
+// CheckBudgetPace creates a notification when a budget is being spent faster
+// than its period is passing, e.g. 60% spent 30% of the way through the month.
+// Budgets already at 100% are left to CheckBudgetThreshold.
+// Deduplication: only one notification per budget per period.
+func (t *NotificationTrigger) CheckBudgetPace(ctx context.Context, userID string, budget *pfinancev1.Budget, progress *pfinancev1.BudgetProgress, now time.Time) {
+	if budget.AmountCents <= 0 || progress.PeriodStart == nil || progress.PeriodEnd == nil {
+		return
+	}
+
+	spentPct := float64(progress.SpentAmountCents) / float64(budget.AmountCents) * 100
+	periodStart := progress.PeriodStart.AsTime()
+	elapsedPct := periodElapsedFraction(periodStart, progress.PeriodEnd.AsTime(), now) * 100
+	if spentPct >= 100 || elapsedPct <= 0 || elapsedPct >= 100 || spentPct <= elapsedPct {
+		return
+	}
+
+	prefs, err := t.store.GetNotificationPreferences(ctx, userID)
+	if err != nil || !prefs.BudgetAlerts {
+		return
+	}
+	margin := prefs.BudgetPaceMarginPct
+	if margin <= 0 {
+		margin = defaultBudgetPaceMarginPct
+	}
+	if spentPct-elapsedPct < margin {
+		return
+	}
+
+	periodKey := periodStart.Format("2006-01-02")
+	notification := &pfinancev1.Notification{
+		Id:            uuid.New().String(),
+		UserId:        userID,
+		Type:          pfinancev1.NotificationType_NOTIFICATION_TYPE_BUDGET_PACE,
+		Title:         fmt.Sprintf("Budget Pace: %s", budget.Name),
+		Message:       fmt.Sprintf("You've spent %.0f%% of your %s budget with %.0f%% of the period gone.", spentPct, budget.Name, elapsedPct),
+		IsRead:        false,
+		ActionUrl:     "/personal/budgets/",
+		ReferenceId:   budget.Id,
+		ReferenceType: "budget",
+		CreatedAt:     timestamppb.Now(),
+		Metadata: map[string]string{
+			"spent_pct":    fmt.Sprintf("%.0f", spentPct),
+			"elapsed_pct":  fmt.Sprintf("%.0f", elapsedPct),
+			"period_start": periodKey,
+		},
+		DedupKey: fmt.Sprintf("%s:pace:%s", budget.Id, periodKey),
+	}
+
+	if _, err := t.store.CreateNotification(ctx, notification); err != nil {
+		log.Printf("[NotificationTrigger] Failed to create budget pace notification: %v", err)
+	}
+}
+
+// CheckMonthlySpendCap creates a notification when total spending this month reaches
+// 80% or 100% of the user's monthly spend cap. A zero cap disables the alert.
+// Deduplication: only one notification per threshold per calendar month.
+func (t *NotificationTrigger) CheckMonthlySpendCap(ctx context.Context, userID string, spentThisMonthCents int64) {
+	prefs, err := t.store.GetNotificationPreferences(ctx, userID)
+	if err != nil || prefs.MonthlySpendCapCents <= 0 {
+		return
+	}
+
+	pct := float64(spentThisMonthCents) / float64(prefs.MonthlySpendCapCents) * 100
+
+	var threshold string
+	switch {
+	case pct >= 100:
+		threshold = "100"
+	case pct >= 80:
+		threshold = "80"
+	default:
+		return
+	}
+
+	// The reference ID is scoped to the month so each month starts fresh
+	monthKey := time.Now().Format("2006-01")
+	referenceID := "spend-cap-" + monthKey
+
+	capDollars := float64(prefs.MonthlySpendCapCents) / 100.0
+	message := fmt.Sprintf("You've spent %.0f%% of your $%.2f monthly spending cap.", pct, capDollars)
+	if pct >= 100 {
+		message = fmt.Sprintf("You've exceeded your $%.2f monthly spending cap!", capDollars)
+	}
+
+	notification := &pfinancev1.Notification{
+		Id:            uuid.New().String(),
+		UserId:        userID,
+		Type:          pfinancev1.NotificationType_NOTIFICATION_TYPE_SPEND_CAP,
+		Title:         "Monthly Spending Cap",
+		Message:       message,
+		IsRead:        false,
+		ActionUrl:     "/personal/expenses/",
+		ReferenceId:   referenceID,
+		ReferenceType: "spend_cap",
+		CreatedAt:     timestamppb.Now(),
+		Metadata:      map[string]string{"threshold": threshold, "month": monthKey},
+		DedupKey:      fmt.Sprintf("%s:threshold:%s", referenceID, threshold),
+	}
+
+	if _, err := t.store.CreateNotification(ctx, notification); err != nil {
+		log.Printf("[NotificationTrigger] Failed to create spend cap notification: %v", err)
+	}
+}
+
 // GoalMilestoneReached creates a notification when a goal hits a milestone (25%, 50%, 75%, 100%).
 // Deduplication: only one notification per goal+milestone per year.
 func (t *NotificationTrigger) GoalMilestoneReached(ctx context.Context, userID string, goal *pfinancev1.FinancialGoal, currentCents int64) {
@@ -105,18 +206,6 @@ func (t *NotificationTrigger) GoalMilestoneReached(ctx context.Context, userID s
 		return
 	}
 
-	// Dedup: check if we already sent this milestone notification within 1 year
-	exists, err := t.store.HasNotification(ctx, userID,
-		pfinancev1.NotificationType_NOTIFICATION_TYPE_GOAL_MILESTONE,
-		goal.Id, "milestone", milestone, 8760) // 8760 hours = 1 year
-	if err != nil {
-		log.Printf("[NotificationTrigger] Failed to check for existing goal notification: %v", err)
-		return
-	}
-	if exists {
-		return
-	}
-
 	notification := &pfinancev1.Notification{
 		Id:            uuid.New().String(),
 		UserId:        userID,
@@ -129,33 +218,22 @@ func (t *NotificationTrigger) GoalMilestoneReached(ctx context.Context, userID s
 		ReferenceType: "goal",
 		CreatedAt:     timestamppb.Now(),
 		Metadata:      map[string]string{"milestone": milestone},
+		DedupKey:      fmt.Sprintf("%s:milestone:%s:%d", goal.Id, milestone, time.Now().Year()),
 	}
 
-	if err := t.store.CreateNotification(ctx, notification); err != nil {
+	if _, err := t.store.CreateNotification(ctx, notification); err != nil {
 		log.Printf("[NotificationTrigger] Failed to create goal milestone notification: %v", err)
 	}
 }
 
 // BillReminder creates a notification for upcoming recurring transactions.
-// Deduplication: only one reminder per recurring transaction per billing cycle (30 days).
+// Deduplication: only one reminder per recurring transaction per occurrence.
 func (t *NotificationTrigger) BillReminder(ctx context.Context, userID string, rt *pfinancev1.RecurringTransaction) {
 	prefs, err := t.store.GetNotificationPreferences(ctx, userID)
 	if err != nil || !prefs.BillReminders {
 		return
 	}
 
-	// Dedup: check if we already sent a reminder for this transaction within 30 days
-	exists, err := t.store.HasNotification(ctx, userID,
-		pfinancev1.NotificationType_NOTIFICATION_TYPE_BILL_REMINDER,
-		rt.Id, "", "", 720)
-	if err != nil {
-		log.Printf("[NotificationTrigger] Failed to check for existing bill reminder: %v", err)
-		return
-	}
-	if exists {
-		return
-	}
-
 	notification := &pfinancev1.Notification{
 		Id:            uuid.New().String(),
 		UserId:        userID,
@@ -167,9 +245,10 @@ func (t *NotificationTrigger) BillReminder(ctx context.Context, userID string, r
 		ReferenceId:   rt.Id,
 		ReferenceType: "recurring_transaction",
 		CreatedAt:     timestamppb.Now(),
+		DedupKey:      fmt.Sprintf("%s:bill:%s", rt.Id, rt.NextOccurrence.AsTime().Format("2006-01-02")),
 	}
 
-	if err := t.store.CreateNotification(ctx, notification); err != nil {
+	if _, err := t.store.CreateNotification(ctx, notification); err != nil {
 		log.Printf("[NotificationTrigger] Failed to create bill reminder notification: %v", err)
 	}
 }
@@ -193,7 +272,7 @@ func (t *NotificationTrigger) ExtractionComplete(ctx context.Context, userID str
 		CreatedAt: timestamppb.Now(),
 	}
 
-	if err := t.store.CreateNotification(ctx, notification); err != nil {
+	if _, err := t.store.CreateNotification(ctx, notification); err != nil {
 		log.Printf("[NotificationTrigger] Failed to create extraction complete notification: %v", err)
 	}
 }
@@ -216,7 +295,7 @@ func (t *NotificationTrigger) SubscriptionAlert(ctx context.Context, userID stri
 		CreatedAt: timestamppb.Now(),
 	}
 
-	if err := t.store.CreateNotification(ctx, notification); err != nil {
+	if _, err := t.store.CreateNotification(ctx, notification); err != nil {
 		log.Printf("[NotificationTrigger] Failed to create subscription alert notification: %v", err)
 	}
 }
@@ -256,7 +335,7 @@ func (t *NotificationTrigger) GroupExpenseAdded(ctx context.Context, actorUID st
 			Metadata:      map[string]string{"group_id": group.Id, "actor": actorUID},
 		}
 
-		if err := t.store.CreateNotification(ctx, notification); err != nil {
+		if _, err := t.store.CreateNotification(ctx, notification); err != nil {
 			log.Printf("[NotificationTrigger] Failed to create group expense notification for %s: %v", memberID, err)
 		}
 	}
@@ -296,7 +375,7 @@ func (t *NotificationTrigger) GroupIncomeAdded(ctx context.Context, actorUID str
 			Metadata:      map[string]string{"group_id": group.Id, "actor": actorUID},
 		}
 
-		if err := t.store.CreateNotification(ctx, notification); err != nil {
+		if _, err := t.store.CreateNotification(ctx, notification); err != nil {
 			log.Printf("[NotificationTrigger] Failed to create group income notification for %s: %v", memberID, err)
 		}
 	}
@@ -321,22 +400,11 @@ func (t *NotificationTrigger) CheckMonthlyTaxSavings(ctx context.Context, userID
 	now := time.Now()
 	monthKey := now.Format("2006-01")
 
-	exists, err := t.store.HasNotification(ctx, userID,
-		pfinancev1.NotificationType_NOTIFICATION_TYPE_TAX_SAVINGS,
-		"monthly-tax", "month", monthKey, 744) // 744 hours ≈ 31 days
-	if err != nil {
-		log.Printf("[NotificationTrigger] Failed to check for existing tax savings notification: %v", err)
-		return
-	}
-	if exists {
-		return
-	}
-
 	// Compute total deductions for the current FY month range
 	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
 	monthEnd := monthStart.AddDate(0, 1, 0)
 
-	expenses, _, err := t.store.ListExpenses(ctx, userID, "", &monthStart, &monthEnd, 500, "")
+	expenses, _, err := t.store.ListExpenses(ctx, userID, "", store.ExpenseQuery{StartDate: &monthStart, EndDate: &monthEnd}, 500, "")
 	if err != nil {
 		log.Printf("[NotificationTrigger] Failed to list expenses for tax savings: %v", err)
 		return
@@ -373,9 +441,186 @@ func (t *NotificationTrigger) CheckMonthlyTaxSavings(ctx context.Context, userID
 		ReferenceType: "tax_summary",
 		CreatedAt:     timestamppb.Now(),
 		Metadata:      map[string]string{"month": monthKey, "total_cents": fmt.Sprintf("%d", totalDeductibleCents)},
+		DedupKey:      "monthly-tax:" + monthKey,
 	}
 
-	if err := t.store.CreateNotification(ctx, notification); err != nil {
+	if _, err := t.store.CreateNotification(ctx, notification); err != nil {
 		log.Printf("[NotificationTrigger] Failed to create tax savings notification: %v", err)
 	}
 }
+
+// deductionSubstantiationLimit holds the ATO limits for one deduction
+// category, in cents. A zero limit is not checked.
+type deductionSubstantiationLimit struct {
+	capCents       int64 // Most that can be claimed in the FY
+	noReceiptCents int64 // Claims without receipts above this need written evidence
+}
+
+// deductionSubstantiationLimits are the per-category limits
+// CheckDeductionSubstantiation warns about. Review them each financial year.
+var deductionSubstantiationLimits = map[pfinancev1.TaxDeductionCategory]deductionSubstantiationLimit{
+	pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_WORK_TRAVEL:    {noReceiptCents: 30000},
+	pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNIFORM:        {noReceiptCents: 15000}, // Laundry without written evidence
+	pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_SELF_EDUCATION: {noReceiptCents: 30000},
+	pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_OTHER_WORK:     {noReceiptCents: 30000},
+	pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_VEHICLE:        {capCents: 440000},     // 5,000 km at 88c/km
+	pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_DONATIONS:      {noReceiptCents: 1000}, // Bucket donations
+}
+
+// substantiationAlertsEnabled reports whether the user wants substantiation
+// warnings. Preferences saved before the setting existed leave it unset, which
+// keeps the warnings on.
+func substantiationAlertsEnabled(prefs *pfinancev1.NotificationPreferences) bool {
+	return prefs.SubstantiationAlerts == nil || prefs.GetSubstantiationAlerts()
+}
+
+// CheckDeductionSubstantiationBatch runs CheckDeductionSubstantiation for a
+// batch of reclassified expenses, once per category and financial year.
+func (t *NotificationTrigger) CheckDeductionSubstantiationBatch(ctx context.Context, userID string, expenses []*pfinancev1.Expense) {
+	var startMonth time.Month
+	checked := make(map[string]bool)
+	for _, expense := range expenses {
+		if !expense.IsTaxDeductible || expense.GroupId != "" {
+			continue
+		}
+		if _, ok := deductionSubstantiationLimits[expense.TaxDeductionCategory]; !ok {
+			continue
+		}
+		if startMonth == 0 {
+			startMonth = loadFiscalYearStartMonth(ctx, t.store, userID)
+		}
+		date := time.Now()
+		if expense.Date != nil {
+			date = expense.Date.AsTime()
+		}
+		key := expense.TaxDeductionCategory.String() + "/" + fiscalYearFor(date, startMonth)
+		if checked[key] {
+			continue
+		}
+		checked[key] = true
+		t.CheckDeductionSubstantiation(ctx, userID, expense)
+	}
+}
+
+// substantiationCapWarnPct is how close to a category's cap, as a percentage,
+// deductions must get before CheckDeductionSubstantiation warns.
+const substantiationCapWarnPct = 80
+
+// CheckDeductionSubstantiation creates a notification when the user's
+// deductions in the expense's category for its financial year approach the
+// category's cap, or when the deductions in it without a receipt exceed what
+// the ATO accepts without written evidence.
+// Deduplication: only one notification per category per financial year.
+func (t *NotificationTrigger) CheckDeductionSubstantiation(ctx context.Context, userID string, expense *pfinancev1.Expense) {
+	if !expense.IsTaxDeductible {
+		return
+	}
+	category := expense.TaxDeductionCategory
+	limit, ok := deductionSubstantiationLimits[category]
+	if !ok {
+		return
+	}
+
+	prefs, err := t.store.GetNotificationPreferences(ctx, userID)
+	if err != nil || !substantiationAlertsEnabled(prefs) {
+		return
+	}
+
+	date := time.Now()
+	if expense.Date != nil {
+		date = expense.Date.AsTime()
+	}
+	startMonth := loadFiscalYearStartMonth(ctx, t.store, userID)
+	fy := fiscalYearFor(date, startMonth)
+	fyStart, fyEnd, err := parseFYDateRange(fy, startMonth)
+	if err != nil {
+		return
+	}
+
+	var claimedCents int64
+	if limit.capCents > 0 {
+		deductions, err := t.store.AggregateDeductionsByCategory(ctx, userID, "", fyStart, fyEnd)
+		if err != nil {
+			log.Printf("[NotificationTrigger] Failed to aggregate deductions for substantiation: %v", err)
+			return
+		}
+		for _, d := range deductions {
+			if d.Category == category {
+				claimedCents = d.TotalCents
+			}
+		}
+	}
+
+	var unreceiptedCents int64
+	if limit.noReceiptCents > 0 {
+		deductible, noAttachment := true, false
+		var pageToken string
+		for {
+			expenses, nextToken, err := t.store.ListExpenses(ctx, userID, "", store.ExpenseQuery{StartDate: &fyStart, EndDate: &fyEnd, IsTaxDeductible: &deductible, HasAttachment: &noAttachment}, 500, pageToken)
+			if err != nil {
+				log.Printf("[NotificationTrigger] Failed to list expenses for substantiation: %v", err)
+				return
+			}
+			for _, e := range expenses {
+				if e.TaxDeductionCategory != category {
+					continue
+				}
+				pct := e.TaxDeductiblePercent
+				if pct <= 0 {
+					pct = 1.0
+				}
+				cents := e.AmountCents
+				if cents == 0 {
+					cents = store.ToCents(e.Amount)
+				}
+				unreceiptedCents += int64(math.Round(float64(cents) * pct))
+			}
+			if nextToken == "" {
+				break
+			}
+			pageToken = nextToken
+		}
+	}
+
+	nearCap := limit.capCents > 0 && claimedCents*100 >= limit.capCents*substantiationCapWarnPct
+	needsReceipts := limit.noReceiptCents > 0 && unreceiptedCents > limit.noReceiptCents
+	if !nearCap && !needsReceipts {
+		return
+	}
+
+	label := friendlyDeductionCategory(category)
+	var messages []string
+	metadata := map[string]string{"financial_year": fy, "category": category.String()}
+	if nearCap {
+		messages = append(messages, fmt.Sprintf("You've claimed $%.2f of the $%.2f limit for %s.",
+			float64(claimedCents)/100, float64(limit.capCents)/100, label))
+		metadata["claimed_cents"] = fmt.Sprintf("%d", claimedCents)
+		metadata["cap_cents"] = fmt.Sprintf("%d", limit.capCents)
+	}
+	if needsReceipts {
+		messages = append(messages, fmt.Sprintf("$%.2f of your %s claims have no receipt; the ATO needs written evidence above $%.2f.",
+			float64(unreceiptedCents)/100, label, float64(limit.noReceiptCents)/100))
+		metadata["unreceipted_cents"] = fmt.Sprintf("%d", unreceiptedCents)
+		metadata["no_receipt_cents"] = fmt.Sprintf("%d", limit.noReceiptCents)
+	}
+
+	referenceID := fmt.Sprintf("tax-substantiation-%s", fy)
+	notification := &pfinancev1.Notification{
+		Id:            uuid.New().String(),
+		UserId:        userID,
+		Type:          pfinancev1.NotificationType_NOTIFICATION_TYPE_TAX_SUBSTANTIATION,
+		Title:         fmt.Sprintf("Tax Deductions: %s", label),
+		Message:       strings.Join(messages, " "),
+		IsRead:        false,
+		ActionUrl:     "/personal/tax/",
+		ReferenceId:   referenceID,
+		ReferenceType: "tax_summary",
+		CreatedAt:     timestamppb.Now(),
+		Metadata:      metadata,
+		DedupKey:      fmt.Sprintf("%s:%s", referenceID, category.String()),
+	}
+
+	if _, err := t.store.CreateNotification(ctx, notification); err != nil {
+		log.Printf("[NotificationTrigger] Failed to create tax substantiation notification: %v", err)
+	}
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"connectrpc.com/connect"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+)
+
+// decodeListPageToken returns the store page token inside a list request's
+// page token. A token issued for a query with a different fingerprint, or one
+// that does not decode, is rejected as an invalid argument.
+func decodeListPageToken(token, fingerprint string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	inner, err := store.DecodeQueryPageToken(token, fingerprint)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return inner, nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+)
+
+// PaginationDefaults configures the page sizes list endpoints use.
+type PaginationDefaults struct {
+	DefaultPageSize      int32 // When a request leaves page_size unset
+	SearchPageSize       int32 // SearchTransactions' default
+	NotificationPageSize int32 // ListNotifications' default
+	MaxPageSize          int32 // Larger requests are rejected
+}
+
+// DefaultPaginationDefaults returns the page sizes a new FinanceService uses.
+func DefaultPaginationDefaults() PaginationDefaults {
+	return PaginationDefaults{
+		DefaultPageSize:      100,
+		SearchPageSize:       20,
+		NotificationPageSize: 50,
+		MaxPageSize:          1000,
+	}
+}
+
+// SetPaginationDefaults overrides the service's list page sizes.
+func (s *FinanceService) SetPaginationDefaults(p PaginationDefaults) {
+	s.pagination = p
+}
+
+// resolvePageSize returns the page size to serve for a request: requested, or
+// def when it is unset. Requests above the configured maximum are rejected
+// rather than silently truncated.
+func (s *FinanceService) resolvePageSize(requested, def int32) (int32, error) {
+	if requested > s.pagination.MaxPageSize {
+		return 0, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("page_size must not exceed %d", s.pagination.MaxPageSize))
+	}
+	if requested <= 0 {
+		return def, nil
+	}
+	return requested, nil
+}
@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
 	"github.com/castlemilk/pfinance/backend/internal/auth"
+	"github.com/castlemilk/pfinance/backend/internal/store"
 
 	gcsstorage "cloud.google.com/go/storage"
 )
@@ -30,12 +32,13 @@ func (s *FinanceService) ExportReceipts(ctx context.Context, req *connect.Reques
 		return nil, err
 	}
 
+	startMonth := s.fiscalYearStartMonth(ctx, claims.UID)
 	fy := req.Msg.FinancialYear
 	if fy == "" {
-		fy = currentAustralianFY()
+		fy = fiscalYearFor(time.Now(), startMonth)
 	}
 
-	start, end, err := parseFYDateRange(fy)
+	start, end, err := parseFYDateRange(fy, startMonth)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
@@ -44,7 +47,7 @@ func (s *FinanceService) ExportReceipts(ctx context.Context, req *connect.Reques
 	var allExpenses []*pfinancev1.Expense
 	var pageToken string
 	for {
-		expenses, nextToken, listErr := s.store.ListExpenses(ctx, claims.UID, "", &start, &end, 500, pageToken)
+		expenses, nextToken, listErr := s.store.ListExpenses(ctx, claims.UID, "", store.ExpenseQuery{StartDate: &start, EndDate: &end}, 500, pageToken)
 		if listErr != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list expenses: %w", listErr))
 		}
@@ -21,7 +21,7 @@ func (s *FinanceService) ProcessRecurringTransactions(
 ) (*connect.Response[pfinancev1.ProcessRecurringTransactionsResponse], error) {
 
 	now := time.Now()
-	var processedCount, skippedCount, endedCount, errorCount int32
+	summary := &pfinancev1.ProcessRecurringTransactionsResponse{}
 
 	// Paginate through all active recurring transactions across all users.
 	// We pass empty userID/groupID and ACTIVE status to get everything.
@@ -57,97 +57,170 @@ func (s *FinanceService) ProcessRecurringTransactions(
 					trigger.BillReminder(ctx, rt.UserId, rt)
 				}
 			}
-
-			processed, ended, procErr := s.processOneRecurringTransaction(ctx, rt, now)
-			if procErr != nil {
-				log.Printf("[RecurringProcessor] error processing rt %s (user %s): %v", rt.Id, rt.UserId, procErr)
-				errorCount++
-				continue
-			}
-			if ended {
-				endedCount++
-			} else if processed {
-				processedCount++
-			} else {
-				skippedCount++
-			}
 		}
 
+		pageSummary := s.MaterializeDueRecurringTransactions(ctx, rts, now)
+		summary.ProcessedCount += pageSummary.ProcessedCount
+		summary.SkippedCount += pageSummary.SkippedCount
+		summary.EndedCount += pageSummary.EndedCount
+		summary.ErrorCount += pageSummary.ErrorCount
+		summary.CreatedCount += pageSummary.CreatedCount
+
 		if nextToken == "" {
 			break
 		}
 		pageToken = nextToken
 	}
 
-	log.Printf("[RecurringProcessor] completed: processed=%d skipped=%d ended=%d errors=%d",
-		processedCount, skippedCount, endedCount, errorCount)
+	log.Printf("[RecurringProcessor] completed: processed=%d skipped=%d ended=%d errors=%d created=%d",
+		summary.ProcessedCount, summary.SkippedCount, summary.EndedCount, summary.ErrorCount, summary.CreatedCount)
+
+	// The same scheduled run rolls recurring budgets into their next period
+	if rolled, err := s.RollActiveBudgets(ctx, now); err != nil {
+		log.Printf("[BudgetRoller] failed: %v", err)
+	} else {
+		log.Printf("[BudgetRoller] completed: created=%d", rolled)
+	}
+
+	return connect.NewResponse(summary), nil
+}
+
+// maxMaterializedOccurrences caps how many missed occurrences of one recurring
+// transaction are booked in a single run; the rest are caught up next run.
+const maxMaterializedOccurrences = 100
 
-	return connect.NewResponse(&pfinancev1.ProcessRecurringTransactionsResponse{
-		ProcessedCount: processedCount,
-		SkippedCount:   skippedCount,
-		EndedCount:     endedCount,
-		ErrorCount:     errorCount,
-	}), nil
+// MaterializeDueRecurringTransactions books every due occurrence of the given
+// recurring transactions as an Expense (IsExpense) or Income, then advances
+// NextOccurrence past now. Records get an ID derived from the transaction and
+// occurrence date, so re-running after a partial failure never books the same
+// occurrence twice.
+func (s *FinanceService) MaterializeDueRecurringTransactions(
+	ctx context.Context,
+	rts []*pfinancev1.RecurringTransaction,
+	now time.Time,
+) *pfinancev1.ProcessRecurringTransactionsResponse {
+	summary := &pfinancev1.ProcessRecurringTransactionsResponse{}
+	for _, rt := range rts {
+		created, ended, err := s.processOneRecurringTransaction(ctx, rt, now)
+		summary.CreatedCount += created
+		if err != nil {
+			log.Printf("[RecurringProcessor] error processing rt %s (user %s): %v", rt.Id, rt.UserId, err)
+			summary.ErrorCount++
+			continue
+		}
+		if ended && created == 0 {
+			summary.EndedCount++
+		} else if created > 0 {
+			summary.ProcessedCount++
+		} else {
+			summary.SkippedCount++
+		}
+	}
+	return summary
 }
 
-// processOneRecurringTransaction handles a single recurring transaction.
-// Returns (processed, ended, error).
+// processOneRecurringTransaction books the due occurrences of a single
+// recurring transaction. Returns (occurrences created, ended, error).
 func (s *FinanceService) processOneRecurringTransaction(
 	ctx context.Context,
 	rt *pfinancev1.RecurringTransaction,
 	now time.Time,
-) (bool, bool, error) {
+) (int32, bool, error) {
 	if rt.NextOccurrence == nil {
-		return false, false, fmt.Errorf("recurring transaction %s has nil next_occurrence", rt.Id)
+		return 0, false, fmt.Errorf("recurring transaction %s has nil next_occurrence", rt.Id)
 	}
 
-	nextOccurrence := rt.NextOccurrence.AsTime()
+	occurrence := rt.NextOccurrence.AsTime()
 
 	// Not yet due -- skip
-	if nextOccurrence.After(now) {
-		return false, false, nil
+	if occurrence.After(now) {
+		return 0, false, nil
 	}
 
 	// Check if past end_date -- mark as ENDED
-	if rt.EndDate != nil && !rt.EndDate.AsTime().IsZero() && nextOccurrence.After(rt.EndDate.AsTime()) {
+	if rt.EndDate != nil && !rt.EndDate.AsTime().IsZero() && occurrence.After(rt.EndDate.AsTime()) {
 		rt.Status = pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ENDED
 		rt.UpdatedAt = timestamppb.Now()
 		if err := s.store.UpdateRecurringTransaction(ctx, rt); err != nil {
-			return false, false, fmt.Errorf("failed to mark recurring transaction as ended: %w", err)
+			return 0, false, fmt.Errorf("failed to mark recurring transaction as ended: %w", err)
 		}
-		return false, true, nil
+		return 0, true, nil
 	}
 
-	// Create the expense or income
-	if rt.IsExpense {
-		if err := s.createExpenseFromRecurring(ctx, rt); err != nil {
-			return false, false, fmt.Errorf("failed to create expense: %w", err)
+	// Book each missed occurrence up to now, stopping at end_date
+	var created int32
+	ended := false
+	for !occurrence.After(now) && created < maxMaterializedOccurrences {
+		if rt.EndDate != nil && !rt.EndDate.AsTime().IsZero() && occurrence.After(rt.EndDate.AsTime()) {
+			break
 		}
-	} else {
-		if err := s.createIncomeFromRecurring(ctx, rt); err != nil {
-			return false, false, fmt.Errorf("failed to create income: %w", err)
+		if err := s.materializeOccurrence(ctx, rt, occurrence); err != nil {
+			return created, false, err
 		}
+		created++
+
+		if !isRepeatingFrequency(rt.Frequency) {
+			// A one-off has nothing left to book
+			ended = true
+			break
+		}
+		occurrence = nextOccurrence(occurrence, rt.Frequency)
 	}
 
-	// Advance next_occurrence
-	newNext := calculateNextOccurrence(nextOccurrence, rt.Frequency)
-	rt.NextOccurrence = timestamppb.New(newNext)
+	rt.NextOccurrence = timestamppb.New(occurrence)
 	rt.UpdatedAt = timestamppb.Now()
 
 	// If the new next_occurrence is past end_date, mark as ENDED
-	if rt.EndDate != nil && !rt.EndDate.AsTime().IsZero() && newNext.After(rt.EndDate.AsTime()) {
+	if rt.EndDate != nil && !rt.EndDate.AsTime().IsZero() && occurrence.After(rt.EndDate.AsTime()) {
+		ended = true
+	}
+	if ended {
 		rt.Status = pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ENDED
 	}
 
 	if err := s.store.UpdateRecurringTransaction(ctx, rt); err != nil {
-		return false, false, fmt.Errorf("failed to update recurring transaction next_occurrence: %w", err)
+		return created, false, fmt.Errorf("failed to update recurring transaction next_occurrence: %w", err)
 	}
 
-	return true, false, nil
+	return created, ended, nil
+}
+
+// isRepeatingFrequency reports whether freq advances to further occurrences.
+func isRepeatingFrequency(freq pfinancev1.ExpenseFrequency) bool {
+	return freq != pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_ONCE &&
+		freq != pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_UNSPECIFIED
+}
+
+// recurringOccurrenceID derives a stable record ID for one occurrence of a
+// recurring transaction.
+func recurringOccurrenceID(rtID string, occurrence time.Time) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte("recurring:"+rtID+"/"+occurrence.UTC().Format(time.RFC3339))).String()
+}
+
+// materializeOccurrence creates the expense or income for one occurrence,
+// unless an earlier run already booked it.
+func (s *FinanceService) materializeOccurrence(ctx context.Context, rt *pfinancev1.RecurringTransaction, occurrence time.Time) error {
+	id := recurringOccurrenceID(rt.Id, occurrence)
+	if rt.IsExpense {
+		if existing, err := s.store.GetExpense(ctx, id); err == nil && existing != nil {
+			return nil
+		}
+		if err := s.createExpenseFromRecurring(ctx, rt, id, occurrence); err != nil {
+			return fmt.Errorf("failed to create expense: %w", err)
+		}
+		return nil
+	}
+	if existing, err := s.store.GetIncome(ctx, id); err == nil && existing != nil {
+		return nil
+	}
+	if err := s.createIncomeFromRecurring(ctx, rt, id, occurrence); err != nil {
+		return fmt.Errorf("failed to create income: %w", err)
+	}
+	return nil
 }
 
 // createExpenseFromRecurring creates a one-time expense from a recurring transaction.
-func (s *FinanceService) createExpenseFromRecurring(ctx context.Context, rt *pfinancev1.RecurringTransaction) error {
+func (s *FinanceService) createExpenseFromRecurring(ctx context.Context, rt *pfinancev1.RecurringTransaction, id string, occurrence time.Time) error {
 	// Build tags: copy existing tags and add "auto-recurring"
 	tags := make([]string, 0, len(rt.Tags)+1)
 	tags = append(tags, rt.Tags...)
@@ -160,7 +233,7 @@ func (s *FinanceService) createExpenseFromRecurring(ctx context.Context, rt *pfi
 	}
 
 	expense := &pfinancev1.Expense{
-		Id:           uuid.New().String(),
+		Id:           id,
 		UserId:       rt.UserId,
 		GroupId:      rt.GroupId,
 		Description:  rt.Description,
@@ -168,13 +241,13 @@ func (s *FinanceService) createExpenseFromRecurring(ctx context.Context, rt *pfi
 		AmountCents:  rt.AmountCents,
 		Category:     rt.Category,
 		Frequency:    pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_ONCE,
-		Date:         rt.NextOccurrence,
+		Date:         timestamppb.New(occurrence),
 		CreatedAt:    timestamppb.Now(),
 		UpdatedAt:    timestamppb.Now(),
 		PaidByUserId: paidByUserID,
 		SplitType:    rt.SplitType,
 		Allocations:  rt.Allocations,
-		Tags:         tags,
+		Tags:         normalizeTags(tags),
 		IsSettled:    false,
 	}
 
@@ -182,16 +255,16 @@ func (s *FinanceService) createExpenseFromRecurring(ctx context.Context, rt *pfi
 }
 
 // createIncomeFromRecurring creates a one-time income from a recurring transaction.
-func (s *FinanceService) createIncomeFromRecurring(ctx context.Context, rt *pfinancev1.RecurringTransaction) error {
+func (s *FinanceService) createIncomeFromRecurring(ctx context.Context, rt *pfinancev1.RecurringTransaction, id string, occurrence time.Time) error {
 	income := &pfinancev1.Income{
-		Id:          uuid.New().String(),
+		Id:          id,
 		UserId:      rt.UserId,
 		GroupId:     rt.GroupId,
 		Source:      rt.Description,
 		Amount:      rt.Amount,
 		AmountCents: rt.AmountCents,
 		Frequency:   pfinancev1.IncomeFrequency_INCOME_FREQUENCY_UNSPECIFIED,
-		Date:        rt.NextOccurrence,
+		Date:        timestamppb.New(occurrence),
 		CreatedAt:   timestamppb.Now(),
 		UpdatedAt:   timestamppb.Now(),
 	}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -24,7 +25,27 @@ func setupNotificationMocks(mockStore *store.MockStore) {
 		AnyTimes()
 	mockStore.EXPECT().
 		CreateNotification(gomock.Any(), gomock.Any()).
-		Return(nil).
+		Return(nil, nil).
+		AnyTimes()
+}
+
+// expectNoMaterializedOccurrences reports every occurrence as not yet booked.
+func expectNoMaterializedOccurrences(mockStore *store.MockStore) {
+	mockStore.EXPECT().
+		GetExpense(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("expense not found")).
+		AnyTimes()
+	mockStore.EXPECT().
+		GetIncome(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("income not found")).
+		AnyTimes()
+}
+
+// expectNoBudgetTemplates leaves the scheduler run with no budgets to roll.
+func expectNoBudgetTemplates(mockStore *store.MockStore) {
+	mockStore.EXPECT().
+		ListBudgetTemplates(gomock.Any(), "", "").
+		Return(nil, nil).
 		AnyTimes()
 }
 
@@ -35,6 +56,8 @@ func TestProcessRecurringTransactions_CreatesExpense(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	setupNotificationMocks(mockStore)
+	expectNoMaterializedOccurrences(mockStore)
+	expectNoBudgetTemplates(mockStore)
 
 	pastDate := time.Now().Add(-24 * time.Hour) // yesterday
 	rt := &pfinancev1.RecurringTransaction{
@@ -157,6 +180,8 @@ func TestProcessRecurringTransactions_CreatesIncome(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	setupNotificationMocks(mockStore)
+	expectNoMaterializedOccurrences(mockStore)
+	expectNoBudgetTemplates(mockStore)
 
 	pastDate := time.Now().Add(-2 * time.Hour)
 	rt := &pfinancev1.RecurringTransaction{
@@ -215,6 +240,8 @@ func TestProcessRecurringTransactions_SkipsNotYetDue(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	setupNotificationMocks(mockStore)
+	expectNoMaterializedOccurrences(mockStore)
+	expectNoBudgetTemplates(mockStore)
 
 	futureDate := time.Now().Add(7 * 24 * time.Hour) // 1 week from now
 	rt := &pfinancev1.RecurringTransaction{
@@ -256,6 +283,8 @@ func TestProcessRecurringTransactions_MarksEnded(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	setupNotificationMocks(mockStore)
+	expectNoMaterializedOccurrences(mockStore)
+	expectNoBudgetTemplates(mockStore)
 
 	pastDate := time.Now().Add(-24 * time.Hour)
 	endDate := time.Now().Add(-48 * time.Hour) // end date is before next occurrence
@@ -310,6 +339,8 @@ func TestProcessRecurringTransactions_MultipleTransactions(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	setupNotificationMocks(mockStore)
+	expectNoMaterializedOccurrences(mockStore)
+	expectNoBudgetTemplates(mockStore)
 
 	pastDate := time.Now().Add(-24 * time.Hour)
 	futureDate := time.Now().Add(7 * 24 * time.Hour)
@@ -373,6 +404,8 @@ func TestProcessRecurringTransactions_GroupExpenseWithAllocations(t *testing.T)
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	setupNotificationMocks(mockStore)
+	expectNoMaterializedOccurrences(mockStore)
+	expectNoBudgetTemplates(mockStore)
 
 	pastDate := time.Now().Add(-1 * time.Hour)
 	rt := &pfinancev1.RecurringTransaction{
@@ -437,6 +470,8 @@ func TestProcessRecurringTransactions_EndsAfterProcessing(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	setupNotificationMocks(mockStore)
+	expectNoMaterializedOccurrences(mockStore)
+	expectNoBudgetTemplates(mockStore)
 
 	pastDate := time.Now().Add(-1 * time.Hour)
 	// End date is in the future relative to next_occurrence but before the NEXT next_occurrence
@@ -495,6 +530,8 @@ func TestProcessRecurringTransactions_Empty(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	setupNotificationMocks(mockStore)
+	expectNoMaterializedOccurrences(mockStore)
+	expectNoBudgetTemplates(mockStore)
 
 	mockStore.EXPECT().
 		ListRecurringTransactions(gomock.Any(), "", "", pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE, false, false, int32(1000), "").
@@ -519,3 +556,73 @@ func TestProcessRecurringTransactions_Empty(t *testing.T) {
 		t.Errorf("expected error_count=0, got %d", resp.Msg.ErrorCount)
 	}
 }
+
+func TestMaterializeDueRecurringTransactions(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := t.Context()
+
+	now := time.Date(2025, 4, 20, 12, 0, 0, 0, time.UTC)
+	firstPayday := time.Date(2025, 3, 7, 9, 0, 0, 0, time.UTC)
+	salary := &pfinancev1.RecurringTransaction{
+		Id:             "rt-salary",
+		UserId:         "user-1",
+		Description:    "Salary",
+		AmountCents:    320000,
+		Amount:         3200,
+		Frequency:      pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_FORTNIGHTLY,
+		NextOccurrence: timestamppb.New(firstPayday),
+		Status:         pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+	}
+	oneOff := &pfinancev1.RecurringTransaction{
+		Id:             "rt-once",
+		UserId:         "user-1",
+		Description:    "Rego",
+		AmountCents:    80000,
+		Frequency:      pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_ONCE,
+		NextOccurrence: timestamppb.New(now.AddDate(0, 0, -1)),
+		Status:         pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+		IsExpense:      true,
+	}
+	for _, rt := range []*pfinancev1.RecurringTransaction{salary, oneOff} {
+		if err := memStore.CreateRecurringTransaction(ctx, rt); err != nil {
+			t.Fatalf("CreateRecurringTransaction: %v", err)
+		}
+	}
+
+	summary := svc.MaterializeDueRecurringTransactions(ctx, []*pfinancev1.RecurringTransaction{salary, oneOff}, now)
+	// Paydays Mar 7, Mar 21, Apr 4 and Apr 18 are due, plus the one-off
+	if summary.CreatedCount != 5 || summary.ProcessedCount != 2 || summary.ErrorCount != 0 {
+		t.Fatalf("summary = %+v, want 5 created across 2 processed", summary)
+	}
+
+	incomes, _, err := memStore.ListIncomes(ctx, "user-1", "", nil, nil, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 100, "")
+	if err != nil {
+		t.Fatalf("ListIncomes: %v", err)
+	}
+	if len(incomes) != 4 {
+		t.Errorf("expected 4 salary incomes, got %d", len(incomes))
+	}
+	if got := salary.NextOccurrence.AsTime(); !got.Equal(time.Date(2025, 5, 2, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("salary next occurrence = %v, want 2025-05-02", got)
+	}
+	if oneOff.Status != pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ENDED {
+		t.Errorf("one-off status = %v, want ENDED", oneOff.Status)
+	}
+
+	t.Run("re-running an occurrence does not duplicate it", func(t *testing.T) {
+		// Simulate a run whose NextOccurrence update was lost
+		salary.NextOccurrence = timestamppb.New(time.Date(2025, 4, 18, 9, 0, 0, 0, time.UTC))
+		summary := svc.MaterializeDueRecurringTransactions(ctx, []*pfinancev1.RecurringTransaction{salary}, now)
+		if summary.ErrorCount != 0 {
+			t.Fatalf("summary = %+v, want no errors", summary)
+		}
+		incomes, _, err := memStore.ListIncomes(ctx, "user-1", "", nil, nil, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 100, "")
+		if err != nil {
+			t.Fatalf("ListIncomes: %v", err)
+		}
+		if len(incomes) != 4 {
+			t.Errorf("expected still 4 salary incomes, got %d", len(incomes))
+		}
+	})
+}
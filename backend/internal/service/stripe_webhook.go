@@ -48,26 +48,62 @@ func (h *StripeWebhookHandler) HandleWebhook(w http.ResponseWriter, r *http.Requ
 
 	ctx := r.Context()
 
+	// Stripe delivers at least once, so claim the event ID before acting on it
+	claimed, err := h.store.ClaimWebhookEvent(ctx, event.ID)
+	if err != nil {
+		log.Printf("[Stripe] Failed to record event %s: %v", event.ID, err)
+		http.Error(w, "failed to record event", http.StatusInternalServerError)
+		return
+	}
+	if !claimed {
+		log.Printf("[Stripe] Ignoring duplicate event %s (%s)", event.ID, event.Type)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"received": true}`)
+		return
+	}
+
+	if err := h.dispatch(ctx, event); err != nil {
+		log.Printf("[Stripe] Failed to process event %s (%s): %v", event.ID, event.Type, err)
+		// Release the claim so Stripe's retry is processed rather than skipped
+		if relErr := h.store.ReleaseWebhookEvent(ctx, event.ID); relErr != nil {
+			log.Printf("[Stripe] Failed to release event %s: %v", event.ID, relErr)
+		}
+		http.Error(w, "failed to process event", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, `{"received": true}`)
+}
+
+// dispatch routes a verified event to its handler. Handlers return an error
+// only for failures worth a retry; malformed or unattributable events are
+// logged and acknowledged.
+func (h *StripeWebhookHandler) dispatch(ctx context.Context, event stripe.Event) error {
 	switch event.Type {
 	case "checkout.session.completed":
-		h.handleCheckoutCompleted(ctx, event)
-	case "customer.subscription.updated":
-		h.handleSubscriptionUpdated(ctx, event)
+		return h.handleCheckoutCompleted(ctx, event)
+	case "customer.subscription.created",
+		"customer.subscription.updated",
+		"customer.subscription.paused",
+		"customer.subscription.resumed":
+		// A trial ending arrives as an update moving the status off trialing
+		return h.handleSubscriptionUpdated(ctx, event)
 	case "customer.subscription.deleted":
-		h.handleSubscriptionDeleted(ctx, event)
+		return h.handleSubscriptionDeleted(ctx, event)
+	case "customer.subscription.trial_will_end":
+		log.Printf("[Stripe] Trial ending soon for event %s; status changes when it ends", event.ID)
 	case "invoice.payment_failed":
-		h.handlePaymentFailed(ctx, event)
+		return h.handlePaymentFailed(ctx, event)
 	default:
 		log.Printf("[Stripe] Unhandled event type: %s", event.Type)
 	}
-
-	w.WriteHeader(http.StatusOK)
-	fmt.Fprint(w, `{"received": true}`)
+	return nil
 }
 
 // handleCheckoutCompleted processes a completed checkout session.
 // It reads the user ID from metadata and updates the user's subscription to PRO.
-func (h *StripeWebhookHandler) handleCheckoutCompleted(ctx context.Context, event stripe.Event) {
+func (h *StripeWebhookHandler) handleCheckoutCompleted(ctx context.Context, event stripe.Event) error {
 	var session struct {
 		Customer     string            `json:"customer"`
 		Subscription string            `json:"subscription"`
@@ -75,13 +111,13 @@ func (h *StripeWebhookHandler) handleCheckoutCompleted(ctx context.Context, even
 	}
 	if err := json.Unmarshal(event.Data.Raw, &session); err != nil {
 		log.Printf("[Stripe] Failed to parse checkout.session.completed: %v", err)
-		return
+		return nil
 	}
 
 	userID := session.Metadata["pfinance_user_id"]
 	if userID == "" {
 		log.Printf("[Stripe] checkout.session.completed: missing pfinance_user_id in metadata")
-		return
+		return nil
 	}
 
 	log.Printf("[Stripe] Checkout completed: user=%s customer=%s subscription=%s", userID, session.Customer, session.Subscription)
@@ -99,104 +135,74 @@ func (h *StripeWebhookHandler) handleCheckoutCompleted(ctx context.Context, even
 	user.StripeSubscriptionId = session.Subscription
 	user.SubscriptionTier = pfinancev1.SubscriptionTier_SUBSCRIPTION_TIER_PRO
 	user.SubscriptionStatus = pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE
-	user.UpdatedAt = timestamppb.Now()
-
-	if err := h.store.UpdateUser(ctx, user); err != nil {
-		log.Printf("[Stripe] Failed to update user %s after checkout: %v", userID, err)
-	}
-
-	// Update Firebase custom claims
-	if h.firebaseAuth != nil {
-		if err := h.firebaseAuth.SetSubscriptionClaims(ctx, userID, user.SubscriptionTier, user.SubscriptionStatus); err != nil {
-			log.Printf("[Stripe] Warning: failed to set custom claims for user %s: %v", userID, err)
-		}
-	}
+	return h.saveSubscription(ctx, user)
 }
 
-// handleSubscriptionUpdated maps Stripe subscription status to our proto enum.
-func (h *StripeWebhookHandler) handleSubscriptionUpdated(ctx context.Context, event stripe.Event) {
+// handleSubscriptionUpdated syncs the user's tier and status with the
+// subscription's Stripe status.
+func (h *StripeWebhookHandler) handleSubscriptionUpdated(ctx context.Context, event stripe.Event) error {
 	var sub struct {
 		ID       string            `json:"id"`
 		Status   string            `json:"status"`
 		Metadata map[string]string `json:"metadata"`
 	}
 	if err := json.Unmarshal(event.Data.Raw, &sub); err != nil {
-		log.Printf("[Stripe] Failed to parse customer.subscription.updated: %v", err)
-		return
+		log.Printf("[Stripe] Failed to parse %s: %v", event.Type, err)
+		return nil
 	}
 
 	userID := sub.Metadata["pfinance_user_id"]
 	if userID == "" {
-		log.Printf("[Stripe] subscription.updated: missing pfinance_user_id in metadata (sub=%s)", sub.ID)
-		return
+		log.Printf("[Stripe] %s: missing pfinance_user_id in metadata (sub=%s)", event.Type, sub.ID)
+		return nil
 	}
 
-	log.Printf("[Stripe] Subscription updated: user=%s status=%s", userID, sub.Status)
+	log.Printf("[Stripe] Subscription %s: user=%s status=%s", event.Type, userID, sub.Status)
 
 	user, err := h.store.GetUser(ctx, userID)
 	if err != nil {
-		log.Printf("[Stripe] Failed to get user %s for subscription update: %v", userID, err)
-		return
+		return fmt.Errorf("get user %s: %w", userID, err)
 	}
 
-	user.SubscriptionStatus = mapStripeStatus(sub.Status)
-	user.UpdatedAt = timestamppb.Now()
-
-	if err := h.store.UpdateUser(ctx, user); err != nil {
-		log.Printf("[Stripe] Failed to update user %s subscription status: %v", userID, err)
-	}
-
-	// Update Firebase custom claims
-	if h.firebaseAuth != nil {
-		if err := h.firebaseAuth.SetSubscriptionClaims(ctx, userID, user.SubscriptionTier, user.SubscriptionStatus); err != nil {
-			log.Printf("[Stripe] Warning: failed to set custom claims for user %s: %v", userID, err)
-		}
+	user.StripeSubscriptionId = sub.ID
+	if status := mapStripeStatus(sub.Status); status != pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED {
+		user.SubscriptionStatus = status
 	}
+	user.SubscriptionTier = stripeStatusTier(sub.Status, user.SubscriptionTier)
+	return h.saveSubscription(ctx, user)
 }
 
 // handleSubscriptionDeleted downgrades user to FREE tier.
-func (h *StripeWebhookHandler) handleSubscriptionDeleted(ctx context.Context, event stripe.Event) {
+func (h *StripeWebhookHandler) handleSubscriptionDeleted(ctx context.Context, event stripe.Event) error {
 	var sub struct {
 		ID       string            `json:"id"`
 		Metadata map[string]string `json:"metadata"`
 	}
 	if err := json.Unmarshal(event.Data.Raw, &sub); err != nil {
 		log.Printf("[Stripe] Failed to parse customer.subscription.deleted: %v", err)
-		return
+		return nil
 	}
 
 	userID := sub.Metadata["pfinance_user_id"]
 	if userID == "" {
 		log.Printf("[Stripe] subscription.deleted: missing pfinance_user_id in metadata (sub=%s)", sub.ID)
-		return
+		return nil
 	}
 
 	log.Printf("[Stripe] Subscription deleted: user=%s", userID)
 
 	user, err := h.store.GetUser(ctx, userID)
 	if err != nil {
-		log.Printf("[Stripe] Failed to get user %s for subscription deletion: %v", userID, err)
-		return
+		return fmt.Errorf("get user %s: %w", userID, err)
 	}
 
 	user.SubscriptionTier = pfinancev1.SubscriptionTier_SUBSCRIPTION_TIER_FREE
 	user.SubscriptionStatus = pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELED
-	user.UpdatedAt = timestamppb.Now()
-
-	if err := h.store.UpdateUser(ctx, user); err != nil {
-		log.Printf("[Stripe] Failed to update user %s after subscription deletion: %v", userID, err)
-	}
-
-	// Update Firebase custom claims
-	if h.firebaseAuth != nil {
-		if err := h.firebaseAuth.SetSubscriptionClaims(ctx, userID, user.SubscriptionTier, user.SubscriptionStatus); err != nil {
-			log.Printf("[Stripe] Warning: failed to set custom claims for user %s: %v", userID, err)
-		}
-	}
+	return h.saveSubscription(ctx, user)
 }
 
 // handlePaymentFailed marks the subscription as past due.
-func (h *StripeWebhookHandler) handlePaymentFailed(ctx context.Context, event stripe.Event) {
+func (h *StripeWebhookHandler) handlePaymentFailed(ctx context.Context, event stripe.Event) error {
 	var invoice struct {
 		Subscription string            `json:"subscription"`
 		Metadata     map[string]string `json:"metadata"`
@@ -205,37 +211,42 @@ func (h *StripeWebhookHandler) handlePaymentFailed(ctx context.Context, event st
 	}
 	if err := json.Unmarshal(event.Data.Raw, &invoice); err != nil {
 		log.Printf("[Stripe] Failed to parse invoice.payment_failed: %v", err)
-		return
+		return nil
 	}
 
 	// Try invoice metadata first, then fall back
 	userID := invoice.Metadata["pfinance_user_id"]
 	if userID == "" {
 		log.Printf("[Stripe] invoice.payment_failed: missing pfinance_user_id (sub=%s customer=%s)", invoice.Subscription, invoice.Customer)
-		return
+		return nil
 	}
 
 	log.Printf("[Stripe] Payment failed: user=%s", userID)
 
 	user, err := h.store.GetUser(ctx, userID)
 	if err != nil {
-		log.Printf("[Stripe] Failed to get user %s for payment failure: %v", userID, err)
-		return
+		return fmt.Errorf("get user %s: %w", userID, err)
 	}
 
 	user.SubscriptionStatus = pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_PAST_DUE
-	user.UpdatedAt = timestamppb.Now()
+	return h.saveSubscription(ctx, user)
+}
 
+// saveSubscription persists the user's subscription fields and mirrors them
+// into Firebase custom claims. A claims failure is only logged: the store is
+// authoritative and requireProWithFallback reads it when claims are stale.
+func (h *StripeWebhookHandler) saveSubscription(ctx context.Context, user *pfinancev1.User) error {
+	user.UpdatedAt = timestamppb.Now()
 	if err := h.store.UpdateUser(ctx, user); err != nil {
-		log.Printf("[Stripe] Failed to update user %s subscription status to past_due: %v", userID, err)
+		return fmt.Errorf("update user %s: %w", user.Id, err)
 	}
 
-	// Update Firebase custom claims
 	if h.firebaseAuth != nil {
-		if err := h.firebaseAuth.SetSubscriptionClaims(ctx, userID, user.SubscriptionTier, user.SubscriptionStatus); err != nil {
-			log.Printf("[Stripe] Warning: failed to set custom claims for user %s: %v", userID, err)
+		if err := h.firebaseAuth.SetSubscriptionClaims(ctx, user.Id, user.SubscriptionTier, user.SubscriptionStatus); err != nil {
+			log.Printf("[Stripe] Warning: failed to set custom claims for user %s: %v", user.Id, err)
 		}
 	}
+	return nil
 }
 
 // mapStripeStatus converts a Stripe subscription status string to the proto enum.
@@ -245,7 +256,7 @@ func mapStripeStatus(status string) pfinancev1.SubscriptionStatus {
 		return pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE
 	case "past_due":
 		return pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_PAST_DUE
-	case "canceled", "unpaid":
+	case "canceled", "unpaid", "incomplete_expired", "paused":
 		return pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELED
 	case "trialing":
 		return pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_TRIALING
@@ -253,3 +264,17 @@ func mapStripeStatus(status string) pfinancev1.SubscriptionStatus {
 		return pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED
 	}
 }
+
+// stripeStatusTier returns the tier a Stripe subscription status grants. Past
+// due keeps Pro while Stripe retries payment; statuses that do not settle the
+// question (such as incomplete) leave the current tier unchanged.
+func stripeStatusTier(status string, current pfinancev1.SubscriptionTier) pfinancev1.SubscriptionTier {
+	switch status {
+	case "active", "trialing", "past_due":
+		return pfinancev1.SubscriptionTier_SUBSCRIPTION_TIER_PRO
+	case "canceled", "unpaid", "incomplete_expired", "paused":
+		return pfinancev1.SubscriptionTier_SUBSCRIPTION_TIER_FREE
+	default:
+		return current
+	}
+}
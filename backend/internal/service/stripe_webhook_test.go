@@ -0,0 +1,145 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"github.com/stripe/stripe-go/v82"
+	"github.com/stripe/stripe-go/v82/webhook"
+)
+
+const testWebhookSecret = "whsec_test"
+
+// postStripeEvent delivers a signed event to the handler and returns the status code.
+func postStripeEvent(t *testing.T, h *StripeWebhookHandler, id, eventType string, object map[string]any, secret string) int {
+	t.Helper()
+	payload, err := json.Marshal(map[string]any{
+		"id":          id,
+		"object":      "event",
+		"type":        eventType,
+		"api_version": stripe.APIVersion,
+		"data":        map[string]any{"object": object},
+	})
+	if err != nil {
+		t.Fatalf("marshal event: %v", err)
+	}
+	signed := webhook.GenerateTestSignedPayload(&webhook.UnsignedPayload{Payload: payload, Secret: secret})
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/stripe", strings.NewReader(string(payload)))
+	req.Header.Set("Stripe-Signature", signed.Header)
+	rec := httptest.NewRecorder()
+	h.HandleWebhook(rec, req)
+	return rec.Code
+}
+
+func TestStripeWebhookSubscriptionSync(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	h := NewStripeWebhookHandler(memStore, testWebhookSecret, nil)
+	userID := "user-123"
+	if err := memStore.UpdateUser(t.Context(), &pfinancev1.User{Id: userID}); err != nil {
+		t.Fatalf("UpdateUser: %v", err)
+	}
+
+	subscription := func(status string) map[string]any {
+		return map[string]any{
+			"id":       "sub_1",
+			"object":   "subscription",
+			"status":   status,
+			"metadata": map[string]string{"pfinance_user_id": userID},
+		}
+	}
+	assertUser := func(t *testing.T, tier pfinancev1.SubscriptionTier, status pfinancev1.SubscriptionStatus) {
+		t.Helper()
+		user, err := memStore.GetUser(t.Context(), userID)
+		if err != nil {
+			t.Fatalf("GetUser: %v", err)
+		}
+		if user.SubscriptionTier != tier || user.SubscriptionStatus != status {
+			t.Errorf("user = (%v, %v), want (%v, %v)", user.SubscriptionTier, user.SubscriptionStatus, tier, status)
+		}
+	}
+
+	t.Run("rejects an invalid signature", func(t *testing.T) {
+		code := postStripeEvent(t, h, "evt_bad", "customer.subscription.created", subscription("active"), "whsec_wrong")
+		if code != http.StatusBadRequest {
+			t.Errorf("status = %d, want 400", code)
+		}
+		assertUser(t, pfinancev1.SubscriptionTier_SUBSCRIPTION_TIER_UNSPECIFIED, pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED)
+	})
+
+	steps := []struct {
+		name      string
+		id        string
+		eventType string
+		status    string
+		wantTier  pfinancev1.SubscriptionTier
+		want      pfinancev1.SubscriptionStatus
+	}{
+		{"trial started", "evt_1", "customer.subscription.created", "trialing",
+			pfinancev1.SubscriptionTier_SUBSCRIPTION_TIER_PRO, pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_TRIALING},
+		{"trial ended into active", "evt_2", "customer.subscription.updated", "active",
+			pfinancev1.SubscriptionTier_SUBSCRIPTION_TIER_PRO, pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE},
+		{"payment past due keeps pro", "evt_3", "customer.subscription.updated", "past_due",
+			pfinancev1.SubscriptionTier_SUBSCRIPTION_TIER_PRO, pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_PAST_DUE},
+		{"canceled downgrades", "evt_4", "customer.subscription.updated", "canceled",
+			pfinancev1.SubscriptionTier_SUBSCRIPTION_TIER_FREE, pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELED},
+	}
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			if code := postStripeEvent(t, h, step.id, step.eventType, subscription(step.status), testWebhookSecret); code != http.StatusOK {
+				t.Fatalf("status = %d, want 200", code)
+			}
+			assertUser(t, step.wantTier, step.want)
+		})
+	}
+
+	t.Run("replayed event is ignored", func(t *testing.T) {
+		// evt_2 carried "active"; replaying it must not undo the cancellation
+		if code := postStripeEvent(t, h, "evt_2", "customer.subscription.updated", subscription("active"), testWebhookSecret); code != http.StatusOK {
+			t.Fatalf("status = %d, want 200", code)
+		}
+		assertUser(t, pfinancev1.SubscriptionTier_SUBSCRIPTION_TIER_FREE, pfinancev1.SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELED)
+	})
+
+	t.Run("failed event is released for retry", func(t *testing.T) {
+		orphan := subscription("active")
+		orphan["metadata"] = map[string]string{"pfinance_user_id": "missing-user"}
+		if code := postStripeEvent(t, h, "evt_5", "customer.subscription.updated", orphan, testWebhookSecret); code != http.StatusInternalServerError {
+			t.Fatalf("status = %d, want 500", code)
+		}
+		claimed, err := memStore.ClaimWebhookEvent(t.Context(), "evt_5")
+		if err != nil || !claimed {
+			t.Errorf("ClaimWebhookEvent(evt_5) = %v, %v; want the failed event released", claimed, err)
+		}
+	})
+}
+
+func TestStripeStatusTier(t *testing.T) {
+	pro := pfinancev1.SubscriptionTier_SUBSCRIPTION_TIER_PRO
+	free := pfinancev1.SubscriptionTier_SUBSCRIPTION_TIER_FREE
+	tests := []struct {
+		status  string
+		current pfinancev1.SubscriptionTier
+		want    pfinancev1.SubscriptionTier
+	}{
+		{"active", free, pro},
+		{"trialing", free, pro},
+		{"past_due", pro, pro},
+		{"canceled", pro, free},
+		{"unpaid", pro, free},
+		{"incomplete_expired", pro, free},
+		{"paused", pro, free},
+		{"incomplete", pro, pro},
+		{"incomplete", free, free},
+	}
+	for _, tt := range tests {
+		if got := stripeStatusTier(tt.status, tt.current); got != tt.want {
+			t.Errorf("stripeStatusTier(%q, %v) = %v, want %v", tt.status, tt.current, got, tt.want)
+		}
+	}
+}
@@ -40,24 +40,14 @@ func DetectSubscriptions(expenses []*pfinancev1.Expense, existingRecurring []*pf
 			return expenseGroup[i].Date.AsTime().Before(expenseGroup[j].Date.AsTime())
 		})
 
-		// Calculate intervals between transactions (in days)
-		var intervals []float64
-		for i := 1; i < len(expenseGroup); i++ {
-			if expenseGroup[i-1].Date == nil || expenseGroup[i].Date == nil {
-				continue
-			}
-			days := expenseGroup[i].Date.AsTime().Sub(expenseGroup[i-1].Date.AsTime()).Hours() / 24
-			if days > 0 {
-				intervals = append(intervals, days)
+		// Detect frequency pattern
+		var dates []time.Time
+		for _, e := range expenseGroup {
+			if e.Date != nil {
+				dates = append(dates, e.Date.AsTime())
 			}
 		}
-
-		if len(intervals) == 0 {
-			continue
-		}
-
-		// Detect frequency pattern
-		freq, freqConfidence := detectFrequency(intervals)
+		freq, freqConfidence := detectRecurringInterval(dates)
 		if freq == pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_UNSPECIFIED {
 			continue
 		}
@@ -138,6 +128,20 @@ func normalizeMerchant(name string) string {
 	return strings.ToLower(strings.TrimSpace(name))
 }
 
+// detectRecurringInterval detects the frequency at which the sorted dates
+// recur and the fraction of gaps that fit it. Same-day repeats are ignored;
+// returns UNSPECIFIED when no frequency fits.
+func detectRecurringInterval(dates []time.Time) (pfinancev1.ExpenseFrequency, float64) {
+	var intervals []float64
+	for i := 1; i < len(dates); i++ {
+		days := dates[i].Sub(dates[i-1]).Hours() / 24
+		if days > 0 {
+			intervals = append(intervals, days)
+		}
+	}
+	return detectFrequency(intervals)
+}
+
 func detectFrequency(intervals []float64) (pfinancev1.ExpenseFrequency, float64) {
 	if len(intervals) == 0 {
 		return pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_UNSPECIFIED, 0
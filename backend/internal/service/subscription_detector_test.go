@@ -0,0 +1,188 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDetectRecurringInterval(t *testing.T) {
+	start := time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC)
+	every := func(n int, step func(i int) time.Time) []time.Time {
+		var dates []time.Time
+		for i := 0; i < n; i++ {
+			dates = append(dates, step(i))
+		}
+		return dates
+	}
+
+	tests := []struct {
+		name     string
+		dates    []time.Time
+		wantFreq pfinancev1.ExpenseFrequency
+		wantConf float64
+	}{
+		{"monthly", every(4, func(i int) time.Time { return start.AddDate(0, i, 0) }),
+			pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY, 1},
+		{"weekly", every(5, func(i int) time.Time { return start.AddDate(0, 0, 7*i) }),
+			pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_WEEKLY, 1},
+		{"same-day repeats are ignored", []time.Time{start, start, start.AddDate(0, 0, 7), start.AddDate(0, 0, 14)},
+			pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_WEEKLY, 1},
+		{"irregular", []time.Time{start, start.AddDate(0, 0, 3), start.AddDate(0, 0, 50)},
+			pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_UNSPECIFIED, 0},
+		{"single date", []time.Time{start},
+			pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_UNSPECIFIED, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			freq, conf := detectRecurringInterval(tt.dates)
+			if freq != tt.wantFreq || conf != tt.wantConf {
+				t.Errorf("detectRecurringInterval = %v, %v; want %v, %v", freq, conf, tt.wantFreq, tt.wantConf)
+			}
+		})
+	}
+}
+
+func TestDetectRecurringPatterns(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	now := time.Now()
+	add := func(description string, amountCents int64, date time.Time) {
+		t.Helper()
+		err := memStore.CreateExpense(t.Context(), &pfinancev1.Expense{
+			Id:          fmt.Sprintf("%s-%d", description, date.Unix()),
+			UserId:      "user-123",
+			Description: description,
+			Amount:      float64(amountCents) / 100,
+			AmountCents: amountCents,
+			Category:    pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT,
+			Date:        timestamppb.New(date),
+		})
+		if err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+	for i := 1; i <= 5; i++ {
+		add("Netflix", 1699, now.AddDate(0, -i, 0))
+		add("Spotify", 1299, now.AddDate(0, -i, 0))
+	}
+	add("Concert", 12000, now.AddDate(0, 0, -10))
+
+	if err := memStore.CreateRecurringTransaction(t.Context(), &pfinancev1.RecurringTransaction{
+		Id:          "rt-spotify",
+		UserId:      "user-123",
+		Description: "spotify",
+		Frequency:   pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY,
+		Status:      pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
+	}); err != nil {
+		t.Fatalf("CreateRecurringTransaction: %v", err)
+	}
+
+	resp, err := svc.DetectRecurringPatterns(ctx, connect.NewRequest(&pfinancev1.DetectRecurringPatternsRequest{UserId: "user-123"}))
+	if err != nil {
+		t.Fatalf("DetectRecurringPatterns: %v", err)
+	}
+	if len(resp.Msg.Suggestions) != 1 {
+		t.Fatalf("got %d suggestions, want only the untracked subscription", len(resp.Msg.Suggestions))
+	}
+	got := resp.Msg.Suggestions[0]
+	rt := got.RecurringTransaction
+	if rt.Description != "Netflix" || rt.AmountCents != 1699 || rt.Id != "" {
+		t.Errorf("suggested transaction = %v, want an unsaved Netflix record", rt)
+	}
+	if got.Frequency != pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY || got.TypicalAmountCents != 1699 {
+		t.Errorf("frequency/amount = %v/%d, want MONTHLY/1699", got.Frequency, got.TypicalAmountCents)
+	}
+	if rt.Category != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT {
+		t.Errorf("category = %v, want ENTERTAINMENT", rt.Category)
+	}
+	if !got.NextPredictedDate.AsTime().After(now.AddDate(0, 0, -1)) || got.Confidence <= 0 || got.OccurrenceCount != 5 {
+		t.Errorf("suggestion = %v, want a future prediction from 5 occurrences", got)
+	}
+
+	t.Run("another user's history is rejected", func(t *testing.T) {
+		_, err := svc.DetectRecurringPatterns(testContext("other-user"), connect.NewRequest(&pfinancev1.DetectRecurringPatternsRequest{UserId: "user-123"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+	})
+}
+
+func TestConvertExpenseToRecurring(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-123")
+
+	expenseDate := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "exp-keep", Description: "Netflix", AmountCents: 2299, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT, Date: timestamppb.New(expenseDate)},
+		{Id: "exp-delete", Description: "Gym", AmountCents: 1500, Date: timestamppb.New(expenseDate)},
+	} {
+		e.UserId = "user-123"
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+
+	resp, err := svc.ConvertExpenseToRecurring(ctx, connect.NewRequest(&pfinancev1.ConvertExpenseToRecurringRequest{
+		ExpenseId: "exp-keep",
+		Frequency: pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY,
+	}))
+	if err != nil {
+		t.Fatalf("ConvertExpenseToRecurring: %v", err)
+	}
+	rt := resp.Msg.RecurringTransaction
+	if rt.Description != "Netflix" || rt.AmountCents != 2299 || rt.Amount != 22.99 ||
+		rt.Category != pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT || !rt.IsExpense {
+		t.Errorf("recurring transaction = %v, want a copy of the expense", rt)
+	}
+	if want := expenseDate.AddDate(0, 1, 0); !rt.NextOccurrence.AsTime().Equal(want) {
+		t.Errorf("next occurrence = %v, want %v", rt.NextOccurrence.AsTime(), want)
+	}
+	if _, err := memStore.GetRecurringTransaction(t.Context(), rt.Id); err != nil {
+		t.Errorf("recurring transaction not stored: %v", err)
+	}
+	if _, err := memStore.GetExpense(t.Context(), "exp-keep"); err != nil {
+		t.Errorf("original expense removed without delete_original: %v", err)
+	}
+
+	t.Run("deletes the original when asked", func(t *testing.T) {
+		if _, err := svc.ConvertExpenseToRecurring(ctx, connect.NewRequest(&pfinancev1.ConvertExpenseToRecurringRequest{
+			ExpenseId:      "exp-delete",
+			Frequency:      pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_WEEKLY,
+			DeleteOriginal: true,
+		})); err != nil {
+			t.Fatalf("ConvertExpenseToRecurring: %v", err)
+		}
+		if _, err := memStore.GetExpense(t.Context(), "exp-delete"); err == nil {
+			t.Error("original expense still exists")
+		}
+	})
+
+	t.Run("rejects a one-off frequency", func(t *testing.T) {
+		_, err := svc.ConvertExpenseToRecurring(ctx, connect.NewRequest(&pfinancev1.ConvertExpenseToRecurringRequest{
+			ExpenseId: "exp-keep",
+			Frequency: pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_ONCE,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("other users are rejected", func(t *testing.T) {
+		_, err := svc.ConvertExpenseToRecurring(testContext("other-user"), connect.NewRequest(&pfinancev1.ConvertExpenseToRecurringRequest{
+			ExpenseId: "exp-keep",
+			Frequency: pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY,
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+	})
+}
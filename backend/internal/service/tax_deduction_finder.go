@@ -3,10 +3,14 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"connectrpc.com/connect"
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
 	"github.com/castlemilk/pfinance/backend/internal/auth"
+	"github.com/castlemilk/pfinance/backend/internal/extraction"
+	"github.com/castlemilk/pfinance/backend/internal/store"
 )
 
 // FindPotentialDeductions scans unclassified expenses and returns suggestions
@@ -20,12 +24,13 @@ func (s *FinanceService) FindPotentialDeductions(ctx context.Context, req *conne
 		return nil, err
 	}
 
+	startMonth := s.fiscalYearStartMonth(ctx, claims.UID)
 	fy := req.Msg.FinancialYear
 	if fy == "" {
-		fy = currentAustralianFY()
+		fy = fiscalYearFor(time.Now(), startMonth)
 	}
 
-	start, end, err := parseFYDateRange(fy)
+	start, end, err := parseFYDateRange(fy, startMonth)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
@@ -34,7 +39,7 @@ func (s *FinanceService) FindPotentialDeductions(ctx context.Context, req *conne
 	var allExpenses []*pfinancev1.Expense
 	var pageToken string
 	for {
-		expenses, nextToken, listErr := s.store.ListExpenses(ctx, claims.UID, "", &start, &end, 500, pageToken)
+		expenses, nextToken, listErr := s.store.ListExpenses(ctx, claims.UID, "", store.ExpenseQuery{StartDate: &start, EndDate: &end}, 500, pageToken)
 		if listErr != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list expenses: %w", listErr))
 		}
@@ -119,7 +124,67 @@ func (s *FinanceService) FindPotentialDeductions(ctx context.Context, req *conne
 	}), nil
 }
 
-// CompareTaxYears computes tax for two financial years and returns per-category deltas.
+// GetTaxDeductionChecklist lists the deductions commonly claimed by the given
+// occupation, marking which ones already have deductible expenses in the
+// financial year so users can spot deductions they may have missed.
+func (s *FinanceService) GetTaxDeductionChecklist(ctx context.Context, req *connect.Request[pfinancev1.GetTaxDeductionChecklistRequest]) (*connect.Response[pfinancev1.GetTaxDeductionChecklistResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireProWithFallback(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	startMonth := s.fiscalYearStartMonth(ctx, claims.UID)
+	fy := req.Msg.FinancialYear
+	if fy == "" {
+		fy = fiscalYearFor(time.Now(), startMonth)
+	}
+
+	start, end, err := parseFYDateRange(fy, startMonth)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	deductions, err := s.store.AggregateDeductionsByCategory(ctx, claims.UID, "", start, end)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("aggregate deductions: %w", err))
+	}
+	byCategory := make(map[pfinancev1.TaxDeductionCategory]*pfinancev1.TaxDeductionSummary, len(deductions))
+	for _, d := range deductions {
+		byCategory[d.Category] = d
+	}
+
+	occupation, entries := extraction.DeductionChecklist(req.Msg.Occupation)
+	resp := &pfinancev1.GetTaxDeductionChecklistResponse{
+		MatchedOccupation: occupation,
+		FinancialYear:     fy,
+	}
+	for _, e := range entries {
+		item := &pfinancev1.TaxDeductionChecklistItem{
+			Category:           e.Category,
+			Title:              e.Title,
+			Description:        e.Description,
+			OccupationSpecific: e.OccupationSpecific,
+		}
+		if d, ok := byCategory[e.Category]; ok && d.ExpenseCount > 0 {
+			item.HasExpenses = true
+			item.ExpenseCount = d.ExpenseCount
+			item.TotalCents = d.TotalCents
+			item.TotalAmount = d.TotalAmount
+		} else {
+			resp.MissingCount++
+		}
+		resp.Items = append(resp.Items, item)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// CompareTaxYears computes tax for two financial years with the same bracket and
+// offset logic as GetTaxEstimate, returning both calculations alongside the
+// headline and per-category deltas (year_b minus year_a).
 func (s *FinanceService) CompareTaxYears(ctx context.Context, req *connect.Request[pfinancev1.CompareTaxYearsRequest]) (*connect.Response[pfinancev1.CompareTaxYearsResponse], error) {
 	claims, err := auth.RequireAuth(ctx)
 	if err != nil {
@@ -133,22 +198,115 @@ func (s *FinanceService) CompareTaxYears(ctx context.Context, req *connect.Reque
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("both year_a and year_b are required"))
 	}
 
-	calcA, err := s.computeTaxForFY(ctx, claims.UID, req.Msg.YearA, 0, 0, false, false)
+	startMonth := s.fiscalYearStartMonth(ctx, claims.UID)
+	calcA, err := s.computeTaxForFY(ctx, claims.UID, req.Msg.YearA, startMonth, taxOptions{nonResident: req.Msg.IsNonResident})
 	if err != nil {
 		return nil, fmt.Errorf("compute tax for %s: %w", req.Msg.YearA, err)
 	}
-	calcB, err := s.computeTaxForFY(ctx, claims.UID, req.Msg.YearB, 0, 0, false, false)
+	calcB, err := s.computeTaxForFY(ctx, claims.UID, req.Msg.YearB, startMonth, taxOptions{nonResident: req.Msg.IsNonResident})
 	if err != nil {
 		return nil, fmt.Errorf("compute tax for %s: %w", req.Msg.YearB, err)
 	}
 
-	// Build per-category deltas
+	return connect.NewResponse(&pfinancev1.CompareTaxYearsResponse{
+		Comparison: &pfinancev1.TaxYearComparison{
+			YearA:                    req.Msg.YearA,
+			YearB:                    req.Msg.YearB,
+			CalculationA:             calcA,
+			CalculationB:             calcB,
+			CategoryDeltas:           deductionCategoryDeltas(calcA.Deductions, calcB.Deductions),
+			IncomeChangeCents:        calcB.GrossIncomeCents - calcA.GrossIncomeCents,
+			DeductionChangeCents:     calcB.TotalDeductionsCents - calcA.TotalDeductionsCents,
+			TaxChangeCents:           calcB.TotalTaxCents - calcA.TotalTaxCents,
+			TaxableIncomeChangeCents: calcB.TaxableIncomeCents - calcA.TaxableIncomeCents,
+			EffectiveRateChange:      calcB.EffectiveRate - calcA.EffectiveRate,
+		},
+	}), nil
+}
+
+// GetDeductionProgress compares the deductions captured so far this FY with
+// the whole prior FY, per category, and counts the expenses batch
+// classification flagged for review that the user has yet to settle.
+func (s *FinanceService) GetDeductionProgress(ctx context.Context, req *connect.Request[pfinancev1.GetDeductionProgressRequest]) (*connect.Response[pfinancev1.GetDeductionProgressResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireProWithFallback(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	startMonth := s.fiscalYearStartMonth(ctx, claims.UID)
+	fy := req.Msg.FinancialYear
+	if fy == "" {
+		fy = fiscalYearFor(time.Now(), startMonth)
+	}
+	start, end, err := parseFYDateRange(fy, startMonth)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	priorFY := fiscalYearFor(start.AddDate(-1, 0, 0), startMonth)
+	priorStart, priorEnd, err := parseFYDateRange(priorFY, startMonth)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	current, err := s.store.AggregateDeductionsByCategory(ctx, claims.UID, "", start, end)
+	if err != nil {
+		return nil, auth.WrapStoreError("aggregate deductions", err)
+	}
+	prior, err := s.store.AggregateDeductionsByCategory(ctx, claims.UID, "", priorStart, priorEnd)
+	if err != nil {
+		return nil, auth.WrapStoreError("aggregate deductions", err)
+	}
+
+	var needsReview int32
+	var pageToken string
+	for {
+		expenses, nextToken, err := s.store.ListExpenses(ctx, claims.UID, "", store.ExpenseQuery{StartDate: &start, EndDate: &end}, 500, pageToken)
+		if err != nil {
+			return nil, auth.WrapStoreError("list expenses", err)
+		}
+		for _, e := range expenses {
+			if e.TaxNeedsReview {
+				needsReview++
+			}
+		}
+		if nextToken == "" {
+			break
+		}
+		pageToken = nextToken
+	}
+
+	var currentCents, priorCents int64
+	for _, d := range current {
+		currentCents += d.TotalCents
+	}
+	for _, d := range prior {
+		priorCents += d.TotalCents
+	}
+
+	return connect.NewResponse(&pfinancev1.GetDeductionProgressResponse{
+		FinancialYear:      fy,
+		PriorFinancialYear: priorFY,
+		CurrentTotalCents:  currentCents,
+		PriorTotalCents:    priorCents,
+		ChangeCents:        currentCents - priorCents,
+		ChangePercent:      categoryChangePercent(float64(currentCents), float64(priorCents)),
+		CategoryDeltas:     deductionCategoryDeltas(prior, current),
+		NeedsReviewCount:   needsReview,
+	}), nil
+}
+
+// deductionCategoryDeltas returns the per-category change in deductions from
+// year a to year b, ordered by category.
+func deductionCategoryDeltas(a, b []*pfinancev1.TaxDeductionSummary) []*pfinancev1.CategoryDelta {
 	catMapA := make(map[pfinancev1.TaxDeductionCategory]int64)
-	for _, d := range calcA.Deductions {
+	for _, d := range a {
 		catMapA[d.Category] = d.TotalCents
 	}
 	catMapB := make(map[pfinancev1.TaxDeductionCategory]int64)
-	for _, d := range calcB.Deductions {
+	for _, d := range b {
 		catMapB[d.Category] = d.TotalCents
 	}
 
@@ -178,17 +336,8 @@ func (s *FinanceService) CompareTaxYears(ctx context.Context, req *connect.Reque
 			ChangePercent: changePct,
 		})
 	}
-
-	return connect.NewResponse(&pfinancev1.CompareTaxYearsResponse{
-		Comparison: &pfinancev1.TaxYearComparison{
-			YearA:                req.Msg.YearA,
-			YearB:                req.Msg.YearB,
-			CalculationA:         calcA,
-			CalculationB:         calcB,
-			CategoryDeltas:       deltas,
-			IncomeChangeCents:    calcB.GrossIncomeCents - calcA.GrossIncomeCents,
-			DeductionChangeCents: calcB.TotalDeductionsCents - calcA.TotalDeductionsCents,
-			TaxChangeCents:       calcB.TotalTaxCents - calcA.TotalTaxCents,
-		},
-	}), nil
+	sort.Slice(deltas, func(i, j int) bool {
+		return deltas[i].Category < deltas[j].Category
+	})
+	return deltas
 }
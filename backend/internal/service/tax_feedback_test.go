@@ -2,12 +2,14 @@ package service
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"connectrpc.com/connect"
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
 	"github.com/castlemilk/pfinance/backend/internal/store"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -163,6 +165,10 @@ func TestBatchUpdateExpenseTaxStatus_FeedbackCreatesMapping(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	// Substantiation warnings are off, so the check stops at the preferences
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	mockStore.EXPECT().GetNotificationPreferences(gomock.Any(), gomock.Any()).
+		Return(&pfinancev1.NotificationPreferences{SubstantiationAlerts: proto.Bool(false)}, nil).AnyTimes()
 
 	userID := "feedback-user-1"
 	ctx := testProContext(userID)
@@ -176,7 +182,7 @@ func TestBatchUpdateExpenseTaxStatus_FeedbackCreatesMapping(t *testing.T) {
 		Date:        timestamppb.Now(),
 	}
 
-	mockStore.EXPECT().GetExpense(gomock.Any(), "exp-1").Return(expense, nil)
+	mockStore.EXPECT().GetExpensesByIDs(gomock.Any(), []string{"exp-1"}).Return(map[string]*pfinancev1.Expense{"exp-1": expense}, nil)
 	mockStore.EXPECT().UpdateExpense(gomock.Any(), gomock.Any()).Return(nil)
 
 	// Feedback loop: no existing mappings
@@ -228,6 +234,10 @@ func TestBatchUpdateExpenseTaxStatus_FeedbackUpdatesExisting(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	// Substantiation warnings are off, so the check stops at the preferences
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	mockStore.EXPECT().GetNotificationPreferences(gomock.Any(), gomock.Any()).
+		Return(&pfinancev1.NotificationPreferences{SubstantiationAlerts: proto.Bool(false)}, nil).AnyTimes()
 
 	userID := "feedback-user-2"
 	ctx := testProContext(userID)
@@ -251,7 +261,7 @@ func TestBatchUpdateExpenseTaxStatus_FeedbackUpdatesExisting(t *testing.T) {
 		Confidence:        0.80,
 	}
 
-	mockStore.EXPECT().GetExpense(gomock.Any(), "exp-2").Return(expense, nil)
+	mockStore.EXPECT().GetExpensesByIDs(gomock.Any(), []string{"exp-2"}).Return(map[string]*pfinancev1.Expense{"exp-2": expense}, nil)
 	mockStore.EXPECT().UpdateExpense(gomock.Any(), gomock.Any()).Return(nil)
 
 	// Existing mapping found
@@ -292,6 +302,65 @@ func TestBatchUpdateExpenseTaxStatus_FeedbackUpdatesExisting(t *testing.T) {
 	}
 }
 
+func TestLearnTaxDeductibility_AveragesPercent(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := t.Context()
+
+	userID := "feedback-user-pct"
+	expense := &pfinancev1.Expense{
+		Id:          "exp-phone",
+		UserId:      userID,
+		Description: "Telstra mobile",
+	}
+
+	for _, pct := range []float64{0.7, 0.5} {
+		svc.learnTaxDeductibility(ctx, userID, expense, &pfinancev1.ExpenseTaxUpdate{
+			ExpenseId:            expense.Id,
+			IsTaxDeductible:      true,
+			TaxDeductionCategory: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_OTHER_WORK,
+			TaxDeductiblePercent: pct,
+		})
+	}
+
+	mappings, err := memStore.GetTaxDeductibilityMappings(ctx, userID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mappings) != 1 {
+		t.Fatalf("expected 1 mapping, got %d", len(mappings))
+	}
+	if mappings[0].ConfirmationCount != 2 {
+		t.Errorf("ConfirmationCount = %d, want 2", mappings[0].ConfirmationCount)
+	}
+	if math.Abs(mappings[0].DeductiblePercent-0.6) > 1e-9 {
+		t.Errorf("DeductiblePercent = %f, want 0.6", mappings[0].DeductiblePercent)
+	}
+}
+
+func TestAverageDeductiblePercent(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   float64
+		count     int32
+		confirmed float64
+		want      float64
+	}{
+		{"first confirmation", 0, 0, 0.7, 0.7},
+		{"unset percent", 0, 3, 0.4, 0.4},
+		{"two confirmations", 0.7, 1, 0.5, 0.6},
+		{"weighted by count", 1.0, 3, 0.6, 0.9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := averageDeductiblePercent(tt.current, tt.count, tt.confirmed)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("averageDeductiblePercent() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestBatchUpdateExpenseTaxStatus_NoFeedbackForNonDeductible(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -312,7 +381,7 @@ func TestBatchUpdateExpenseTaxStatus_NoFeedbackForNonDeductible(t *testing.T) {
 		Date:        timestamppb.Now(),
 	}
 
-	mockStore.EXPECT().GetExpense(gomock.Any(), "exp-3").Return(expense, nil)
+	mockStore.EXPECT().GetExpensesByIDs(gomock.Any(), []string{"exp-3"}).Return(map[string]*pfinancev1.Expense{"exp-3": expense}, nil)
 	mockStore.EXPECT().UpdateExpense(gomock.Any(), gomock.Any()).Return(nil)
 
 	// No feedback calls expected for non-deductible updates
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/auth"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LockFinancialYear locks every one of the user's expenses dated in the FY so
+// they cannot be changed after its tax return has been filed.
+func (s *FinanceService) LockFinancialYear(ctx context.Context, req *connect.Request[pfinancev1.LockFinancialYearRequest]) (*connect.Response[pfinancev1.LockFinancialYearResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireProWithFallback(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	fy := req.Msg.FinancialYear
+	if fy == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("financial_year is required"))
+	}
+	reason := req.Msg.Reason
+	if reason == "" {
+		reason = fmt.Sprintf("FY %s tax return filed", fy)
+	}
+
+	expenses, err := s.listFinancialYearExpenses(ctx, claims.UID, fy)
+	if err != nil {
+		return nil, err
+	}
+
+	var lockedCount int32
+	for _, expense := range expenses {
+		if expense.Locked {
+			continue
+		}
+		expense.Locked = true
+		expense.LockedReason = reason
+		expense.UpdatedAt = timestamppb.Now()
+		if err := s.store.UpdateExpense(ctx, expense); err != nil {
+			return nil, auth.WrapStoreError("update expense", err)
+		}
+		lockedCount++
+	}
+
+	return connect.NewResponse(&pfinancev1.LockFinancialYearResponse{
+		LockedCount: lockedCount,
+	}), nil
+}
+
+// UnlockFinancialYear clears the lock on the user's expenses dated in the FY,
+// e.g. to lodge an amendment. It is not Pro-gated so a user who has since
+// downgraded can still edit their own expenses.
+func (s *FinanceService) UnlockFinancialYear(ctx context.Context, req *connect.Request[pfinancev1.UnlockFinancialYearRequest]) (*connect.Response[pfinancev1.UnlockFinancialYearResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fy := req.Msg.FinancialYear
+	if fy == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("financial_year is required"))
+	}
+
+	expenses, err := s.listFinancialYearExpenses(ctx, claims.UID, fy)
+	if err != nil {
+		return nil, err
+	}
+
+	var unlockedCount int32
+	for _, expense := range expenses {
+		if !expense.Locked {
+			continue
+		}
+		expense.Locked = false
+		expense.LockedReason = ""
+		expense.UpdatedAt = timestamppb.Now()
+		if err := s.store.UpdateExpense(ctx, expense); err != nil {
+			return nil, auth.WrapStoreError("update expense", err)
+		}
+		unlockedCount++
+	}
+
+	return connect.NewResponse(&pfinancev1.UnlockFinancialYearResponse{
+		UnlockedCount: unlockedCount,
+	}), nil
+}
+
+// listFinancialYearExpenses returns all of userID's expenses dated in fy,
+// which starts in the month their tax config sets.
+func (s *FinanceService) listFinancialYearExpenses(ctx context.Context, userID, fy string) ([]*pfinancev1.Expense, error) {
+	start, end, err := parseFYDateRange(fy, s.fiscalYearStartMonth(ctx, userID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var all []*pfinancev1.Expense
+	var pageToken string
+	for {
+		expenses, nextToken, err := s.store.ListExpenses(ctx, userID, "", store.ExpenseQuery{StartDate: &start, EndDate: &end}, 500, pageToken)
+		if err != nil {
+			return nil, auth.WrapStoreError("list expenses", err)
+		}
+		all = append(all, expenses...)
+		if nextToken == "" {
+			return all, nil
+		}
+		pageToken = nextToken
+	}
+}
+
+// checkExpenseUnlocked rejects changes to an expense locked by
+// LockFinancialYear.
+func checkExpenseUnlocked(expense *pfinancev1.Expense) error {
+	if !expense.Locked {
+		return nil
+	}
+	reason := expense.LockedReason
+	if reason == "" {
+		reason = "financial year locked"
+	}
+	return connect.NewError(connect.CodeFailedPrecondition,
+		fmt.Errorf("expense %s is locked (%s); unlock its financial year to change it", expense.Id, reason))
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/extraction"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestLockFinancialYear(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testProContext("user-1")
+
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "filed", UserId: "user-1", Description: "Laptop", AmountCents: 200000,
+			Date: timestamppb.New(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC))},
+		{Id: "current", UserId: "user-1", Description: "Coffee", AmountCents: 500,
+			Date: timestamppb.New(time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC))},
+		{Id: "other-user", UserId: "user-2", Description: "Desk", AmountCents: 30000,
+			Date: timestamppb.New(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC))},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+
+	lockResp, err := svc.LockFinancialYear(ctx, connect.NewRequest(&pfinancev1.LockFinancialYearRequest{
+		FinancialYear: "2024-25",
+	}))
+	if err != nil {
+		t.Fatalf("LockFinancialYear: %v", err)
+	}
+	if lockResp.Msg.LockedCount != 1 {
+		t.Errorf("locked_count = %d, want 1", lockResp.Msg.LockedCount)
+	}
+
+	filed, err := memStore.GetExpense(t.Context(), "filed")
+	if err != nil {
+		t.Fatalf("GetExpense: %v", err)
+	}
+	if !filed.Locked || filed.LockedReason != "FY 2024-25 tax return filed" {
+		t.Errorf("filed expense locked = %v, reason = %q", filed.Locked, filed.LockedReason)
+	}
+	for _, id := range []string{"current", "other-user"} {
+		e, err := memStore.GetExpense(t.Context(), id)
+		if err != nil {
+			t.Fatalf("GetExpense: %v", err)
+		}
+		if e.Locked {
+			t.Errorf("expense %s was locked", id)
+		}
+	}
+
+	t.Run("locked expenses reject changes", func(t *testing.T) {
+		_, err := svc.UpdateExpense(ctx, connect.NewRequest(&pfinancev1.UpdateExpenseRequest{
+			ExpenseId:   "filed",
+			Description: "Edited",
+		}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("UpdateExpense code = %v, want FailedPrecondition", connect.CodeOf(err))
+		}
+
+		_, err = svc.DeleteExpense(ctx, connect.NewRequest(&pfinancev1.DeleteExpenseRequest{ExpenseId: "filed"}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("DeleteExpense code = %v, want FailedPrecondition", connect.CodeOf(err))
+		}
+
+		_, err = svc.BatchUpdateExpenseTaxStatus(ctx, connect.NewRequest(&pfinancev1.BatchUpdateExpenseTaxStatusRequest{
+			Updates: []*pfinancev1.ExpenseTaxUpdate{
+				{ExpenseId: "current", IsTaxDeductible: true},
+				{ExpenseId: "filed", IsTaxDeductible: true},
+			},
+		}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("BatchUpdateExpenseTaxStatus code = %v, want FailedPrecondition", connect.CodeOf(err))
+		}
+
+		filed, err := memStore.GetExpense(t.Context(), "filed")
+		if err != nil {
+			t.Fatalf("GetExpense: %v", err)
+		}
+		if filed.Description != "Laptop" || filed.IsTaxDeductible {
+			t.Errorf("locked expense was changed: %v", filed)
+		}
+		current, err := memStore.GetExpense(t.Context(), "current")
+		if err != nil {
+			t.Fatalf("GetExpense: %v", err)
+		}
+		if current.IsTaxDeductible {
+			t.Error("rejected batch still updated an unlocked expense")
+		}
+	})
+
+	t.Run("unlocking allows changes again", func(t *testing.T) {
+		unlockResp, err := svc.UnlockFinancialYear(ctx, connect.NewRequest(&pfinancev1.UnlockFinancialYearRequest{
+			FinancialYear: "2024-25",
+		}))
+		if err != nil {
+			t.Fatalf("UnlockFinancialYear: %v", err)
+		}
+		if unlockResp.Msg.UnlockedCount != 1 {
+			t.Errorf("unlocked_count = %d, want 1", unlockResp.Msg.UnlockedCount)
+		}
+
+		resp, err := svc.UpdateExpense(ctx, connect.NewRequest(&pfinancev1.UpdateExpenseRequest{
+			ExpenseId:   "filed",
+			Description: "Edited",
+		}))
+		if err != nil {
+			t.Fatalf("UpdateExpense: %v", err)
+		}
+		if resp.Msg.Expense.Locked || resp.Msg.Expense.LockedReason != "" {
+			t.Errorf("expense still locked after unlock: %v", resp.Msg.Expense)
+		}
+	})
+
+	t.Run("invalid financial year", func(t *testing.T) {
+		for _, fy := range []string{"", "2024"} {
+			_, err := svc.LockFinancialYear(ctx, connect.NewRequest(&pfinancev1.LockFinancialYearRequest{FinancialYear: fy}))
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("LockFinancialYear(%q) code = %v, want InvalidArgument", fy, connect.CodeOf(err))
+			}
+		}
+	})
+}
+
+func TestLockFinancialYearUsesFiscalYearStartMonth(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testProContext("user-1")
+
+	// A January fiscal year: "2025-26" runs through calendar 2025
+	if err := memStore.UpdateTaxConfig(t.Context(), "user-1", "", &pfinancev1.TaxConfig{FiscalYearStartMonth: 1}); err != nil {
+		t.Fatalf("UpdateTaxConfig: %v", err)
+	}
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "in-year", UserId: "user-1", Description: "Laptop", AmountCents: 200000,
+			Date: timestamppb.New(time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC))},
+		{Id: "next-year", UserId: "user-1", Description: "Coffee", AmountCents: 500,
+			Date: timestamppb.New(time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC))},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+
+	resp, err := svc.LockFinancialYear(ctx, connect.NewRequest(&pfinancev1.LockFinancialYearRequest{
+		FinancialYear: "2025-26",
+	}))
+	if err != nil {
+		t.Fatalf("LockFinancialYear: %v", err)
+	}
+	if resp.Msg.LockedCount != 1 {
+		t.Errorf("locked_count = %d, want 1", resp.Msg.LockedCount)
+	}
+	for id, want := range map[string]bool{"in-year": true, "next-year": false} {
+		e, err := memStore.GetExpense(t.Context(), id)
+		if err != nil {
+			t.Fatalf("GetExpense: %v", err)
+		}
+		if e.Locked != want {
+			t.Errorf("expense %s locked = %v, want %v", id, e.Locked, want)
+		}
+	}
+}
+
+func TestLockedExpenseRejectsIndirectChanges(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	svc.SetTaxClassificationPipeline(extraction.NewTaxClassificationPipeline(""))
+	ctx := testProContext("user-1")
+
+	filedDate := timestamppb.New(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC))
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "locked", UserId: "user-1", Description: "h&r block tax prep", AmountCents: 25000, Date: filedDate,
+			Locked: true, LockedReason: "FY 2024-25 tax return filed"},
+		{Id: "duplicate", UserId: "user-1", Description: "H&R BLOCK", AmountCents: 25000, Date: filedDate},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+
+	t.Run("merge", func(t *testing.T) {
+		for _, req := range []*pfinancev1.MergeExpensesRequest{
+			{PrimaryExpenseId: "locked", SecondaryExpenseId: "duplicate"},
+			{PrimaryExpenseId: "duplicate", SecondaryExpenseId: "locked"},
+		} {
+			_, err := svc.MergeExpenses(ctx, connect.NewRequest(req))
+			if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+				t.Errorf("MergeExpenses(%s <- %s) code = %v, want FailedPrecondition", req.PrimaryExpenseId, req.SecondaryExpenseId, connect.CodeOf(err))
+			}
+		}
+		for _, id := range []string{"locked", "duplicate"} {
+			if _, err := memStore.GetExpense(t.Context(), id); err != nil {
+				t.Errorf("expense %s was deleted by a rejected merge", id)
+			}
+		}
+	})
+
+	t.Run("classify auto-apply", func(t *testing.T) {
+		_, err := svc.ClassifyTaxDeductibility(ctx, connect.NewRequest(&pfinancev1.ClassifyTaxDeductibilityRequest{ExpenseId: "locked"}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("ClassifyTaxDeductibility code = %v, want FailedPrecondition", connect.CodeOf(err))
+		}
+
+		resp, err := svc.BatchClassifyTaxDeductibility(ctx, connect.NewRequest(&pfinancev1.BatchClassifyTaxDeductibilityRequest{
+			FinancialYear: "2024-25",
+			AutoApply:     true,
+		}))
+		if err != nil {
+			t.Fatalf("BatchClassifyTaxDeductibility: %v", err)
+		}
+		for _, r := range resp.Msg.Results {
+			if r.ExpenseId == "locked" && r.AutoApplied {
+				t.Error("batch classify reported auto-applying a locked expense")
+			}
+		}
+
+		locked, err := memStore.GetExpense(t.Context(), "locked")
+		if err != nil {
+			t.Fatalf("GetExpense: %v", err)
+		}
+		if locked.IsTaxDeductible || locked.TaxDeductionCategory != pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNSPECIFIED {
+			t.Errorf("classification overwrote a locked expense: %v", locked)
+		}
+	})
+
+	t.Run("batch delete", func(t *testing.T) {
+		resp, err := svc.BatchDeleteExpenses(ctx, connect.NewRequest(&pfinancev1.BatchDeleteExpensesRequest{
+			ExpenseIds: []string{"locked"},
+		}))
+		if err != nil {
+			t.Fatalf("BatchDeleteExpenses: %v", err)
+		}
+		if resp.Msg.DeletedCount != 0 || len(resp.Msg.FailedExpenseIds) != 1 || resp.Msg.FailedExpenseIds[0] != "locked" {
+			t.Errorf("BatchDeleteExpenses = deleted %d, failed %v; want the locked expense reported as failed",
+				resp.Msg.DeletedCount, resp.Msg.FailedExpenseIds)
+		}
+		if _, err := memStore.GetExpense(t.Context(), "locked"); err != nil {
+			t.Error("locked expense was deleted by a batch delete")
+		}
+	})
+
+	t.Run("convert with delete_original", func(t *testing.T) {
+		_, err := svc.ConvertExpenseToRecurring(ctx, connect.NewRequest(&pfinancev1.ConvertExpenseToRecurringRequest{
+			ExpenseId:      "locked",
+			Frequency:      pfinancev1.ExpenseFrequency_EXPENSE_FREQUENCY_MONTHLY,
+			DeleteOriginal: true,
+		}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("ConvertExpenseToRecurring code = %v, want FailedPrecondition", connect.CodeOf(err))
+		}
+	})
+}
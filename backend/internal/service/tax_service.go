@@ -1,12 +1,14 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"log"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +17,7 @@ import (
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
 	"github.com/castlemilk/pfinance/backend/internal/auth"
 	"github.com/castlemilk/pfinance/backend/internal/extraction"
+	"github.com/castlemilk/pfinance/backend/internal/store"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -57,13 +60,46 @@ func australianBrackets(fy string) []taxBracket {
 	}
 }
 
+// nonResidentBrackets returns the foreign resident tax brackets, which have no
+// tax-free threshold.
+// Source: https://www.ato.gov.au/tax-rates-and-codes/tax-rates-foreign-residents
+func nonResidentBrackets(fy string) []taxBracket {
+	switch fy {
+	case "2023-24":
+		return []taxBracket{
+			{Min: 0, Max: 120000, Rate: 0.325},
+			{Min: 120001, Max: 180000, Rate: 0.37},
+			{Min: 180001, Max: 0, Rate: 0.45},
+		}
+	default: // 2024-25 onwards (Stage 3)
+		return []taxBracket{
+			{Min: 0, Max: 135000, Rate: 0.30},
+			{Min: 135001, Max: 190000, Rate: 0.37},
+			{Min: 190001, Max: 0, Rate: 0.45},
+		}
+	}
+}
+
+// taxBrackets returns the resident or foreign resident brackets for fy.
+func taxBrackets(fy string, nonResident bool) []taxBracket {
+	if nonResident {
+		return nonResidentBrackets(fy)
+	}
+	return australianBrackets(fy)
+}
+
 // ============================================================================
 // Tax Calculation Functions
 // ============================================================================
 
-// parseFYDateRange converts a financial year string (e.g., "2025-26") to start and end dates.
-// Australian FY: July 1 to June 30.
-func parseFYDateRange(fy string) (time.Time, time.Time, error) {
+// defaultFiscalYearStartMonth is the month an Australian financial year starts in.
+const defaultFiscalYearStartMonth = time.July
+
+// parseFYDateRange converts a financial year string (e.g., "2025-26") to
+// start and end dates for a fiscal year starting on the 1st of startMonth in
+// the first year: July 1, 2025 to June 30, 2026 for the Australian default,
+// or January 1, 2025 to December 31, 2025 for January.
+func parseFYDateRange(fy string, startMonth time.Month) (time.Time, time.Time, error) {
 	parts := strings.SplitN(fy, "-", 2)
 	if len(parts) != 2 {
 		return time.Time{}, time.Time{}, fmt.Errorf("invalid financial year format: %s (expected YYYY-YY)", fy)
@@ -82,12 +118,39 @@ func parseFYDateRange(fy string) (time.Time, time.Time, error) {
 		return time.Time{}, time.Time{}, fmt.Errorf("invalid financial year: end year %02d does not match start year %d (expected %02d)", endYearShort, startYear, expectedEnd)
 	}
 
-	start := time.Date(startYear, time.July, 1, 0, 0, 0, 0, time.UTC)
-	// Use exclusive upper bound: July 1 of next year catches all nanosecond-precision timestamps on June 30
-	end := time.Date(startYear+1, time.July, 1, 0, 0, 0, 0, time.UTC)
+	start := time.Date(startYear, startMonth, 1, 0, 0, 0, 0, time.UTC)
+	// Use exclusive upper bound: the 1st of startMonth next year catches all
+	// nanosecond-precision timestamps on the fiscal year's last day
+	end := start.AddDate(1, 0, 0)
 	return start, end, nil
 }
 
+// fiscalYearStartMonth returns the month the user's fiscal year starts in,
+// from their tax config, defaulting to July.
+func (s *FinanceService) fiscalYearStartMonth(ctx context.Context, userID string) time.Month {
+	return loadFiscalYearStartMonth(ctx, s.store, userID)
+}
+
+// loadFiscalYearStartMonth is fiscalYearStartMonth for callers holding only a
+// store, such as NotificationTrigger.
+func loadFiscalYearStartMonth(ctx context.Context, st store.Store, userID string) time.Month {
+	taxCfg, err := st.GetTaxConfig(ctx, userID, "")
+	if err != nil {
+		return defaultFiscalYearStartMonth
+	}
+	return fiscalYearStartMonthOf(taxCfg)
+}
+
+// fiscalYearStartMonthOf returns the fiscal year start month a tax config
+// sets, or July when it sets none.
+func fiscalYearStartMonthOf(taxCfg *pfinancev1.TaxConfig) time.Month {
+	month := taxCfg.GetFiscalYearStartMonth()
+	if month < 1 || month > 12 {
+		return defaultFiscalYearStartMonth
+	}
+	return time.Month(month)
+}
+
 // calculateBracketTax calculates the progressive tax based on taxable income in dollars.
 func calculateBracketTax(taxableIncome float64, brackets []taxBracket) float64 {
 	if taxableIncome <= 0 {
@@ -208,8 +271,15 @@ func calculateLITO(taxableIncome float64) float64 {
 }
 
 // calculateAustralianTax computes the full tax calculation.
-func calculateAustralianTax(grossIncomeCents int64, deductions []*pfinancev1.TaxDeductionSummary, taxWithheldCents int64, includeHELP, medicareExempt bool, fy string) *pfinancev1.TaxCalculation {
+// netCapitalGainsCents is added to gross income before deductions, and counts
+// towards the income the effective rate is taken over.
+// priorYearLossCents is a carried-forward loss applied after deductions; any
+// portion exceeding taxable income is reported as unused. Foreign residents
+// are taxed on the non-resident brackets and get neither LITO nor the
+// Medicare levy.
+func calculateAustralianTax(grossIncomeCents, netCapitalGainsCents int64, deductions []*pfinancev1.TaxDeductionSummary, priorYearLossCents, taxWithheldCents int64, includeHELP, medicareExempt, nonResident bool, fy string) *pfinancev1.TaxCalculation {
 	grossIncome := float64(grossIncomeCents) / 100.0
+	netCapitalGains := float64(netCapitalGainsCents) / 100.0
 
 	var totalDeductionsCents int64
 	for _, d := range deductions {
@@ -217,20 +287,32 @@ func calculateAustralianTax(grossIncomeCents int64, deductions []*pfinancev1.Tax
 	}
 	totalDeductions := float64(totalDeductionsCents) / 100.0
 
-	taxableIncome := math.Max(0, grossIncome-totalDeductions)
+	taxableIncome := math.Max(0, grossIncome+netCapitalGains-totalDeductions)
 	taxableIncomeCents := int64(taxableIncome * 100)
 
-	brackets := australianBrackets(fy)
+	// Apply prior-year losses, floored at zero taxable income
+	var lossAppliedCents, unusedLossCents int64
+	if priorYearLossCents > 0 {
+		lossAppliedCents = min(priorYearLossCents, taxableIncomeCents)
+		unusedLossCents = priorYearLossCents - lossAppliedCents
+		taxableIncomeCents -= lossAppliedCents
+		taxableIncome = float64(taxableIncomeCents) / 100.0
+	}
+
+	brackets := taxBrackets(fy, nonResident)
 	baseTax := calculateBracketTax(taxableIncome, brackets)
 	baseTaxCents := int64(math.Round(baseTax * 100))
 
-	medicareLevyDollars := calculateMedicareLevy(taxableIncome, medicareExempt)
+	medicareLevyDollars := calculateMedicareLevy(taxableIncome, medicareExempt || nonResident)
 	medicareLevyCents := int64(math.Round(medicareLevyDollars * 100))
 
 	helpRepaymentDollars := calculateHELPRepayment(taxableIncome, includeHELP)
 	helpRepaymentCents := int64(math.Round(helpRepaymentDollars * 100))
 
-	litoDollars := calculateLITO(taxableIncome)
+	var litoDollars float64
+	if !nonResident {
+		litoDollars = calculateLITO(taxableIncome)
+	}
 	litoCents := int64(math.Round(litoDollars * 100))
 
 	totalTaxCents := baseTaxCents + medicareLevyCents + helpRepaymentCents - litoCents
@@ -240,8 +322,8 @@ func calculateAustralianTax(grossIncomeCents int64, deductions []*pfinancev1.Tax
 	totalTax := float64(totalTaxCents) / 100.0
 
 	var effectiveRate float64
-	if grossIncome > 0 {
-		effectiveRate = totalTax / grossIncome
+	if totalIncome := grossIncome + netCapitalGains; totalIncome > 0 {
+		effectiveRate = totalTax / totalIncome
 	}
 
 	// Positive = refund, negative = owed
@@ -249,32 +331,52 @@ func calculateAustralianTax(grossIncomeCents int64, deductions []*pfinancev1.Tax
 	refundOrOwedCents := taxWithheldCents - totalTaxCents
 
 	return &pfinancev1.TaxCalculation{
-		FinancialYear:        fy,
-		GrossIncomeCents:     grossIncomeCents,
-		GrossIncome:          grossIncome,
-		Deductions:           deductions,
-		TotalDeductionsCents: totalDeductionsCents,
-		TotalDeductions:      totalDeductions,
-		TaxableIncomeCents:   taxableIncomeCents,
-		TaxableIncome:        taxableIncome,
-		BaseTaxCents:         baseTaxCents,
-		BaseTax:              baseTax,
-		MedicareLevyCents:    medicareLevyCents,
-		MedicareLevy:         medicareLevyDollars,
-		HelpRepaymentCents:   helpRepaymentCents,
-		HelpRepayment:        helpRepaymentDollars,
-		LitoCents:            litoCents,
-		Lito:                 litoDollars,
-		TotalTaxCents:        totalTaxCents,
-		TotalTax:             totalTax,
-		EffectiveRate:        effectiveRate,
-		RefundOrOwedCents:    refundOrOwedCents,
-		RefundOrOwed:         float64(refundOrOwedCents) / 100.0,
-		TaxWithheldCents:     taxWithheldCents,
-		TaxWithheld:          taxWithheld,
+		FinancialYear:           fy,
+		GrossIncomeCents:        grossIncomeCents,
+		GrossIncome:             grossIncome,
+		Deductions:              deductions,
+		TotalDeductionsCents:    totalDeductionsCents,
+		TotalDeductions:         totalDeductions,
+		TaxableIncomeCents:      taxableIncomeCents,
+		TaxableIncome:           taxableIncome,
+		BaseTaxCents:            baseTaxCents,
+		BaseTax:                 baseTax,
+		MedicareLevyCents:       medicareLevyCents,
+		MedicareLevy:            medicareLevyDollars,
+		HelpRepaymentCents:      helpRepaymentCents,
+		HelpRepayment:           helpRepaymentDollars,
+		LitoCents:               litoCents,
+		Lito:                    litoDollars,
+		TotalTaxCents:           totalTaxCents,
+		TotalTax:                totalTax,
+		EffectiveRate:           effectiveRate,
+		RefundOrOwedCents:       refundOrOwedCents,
+		RefundOrOwed:            float64(refundOrOwedCents) / 100.0,
+		TaxWithheldCents:        taxWithheldCents,
+		TaxWithheld:             taxWithheld,
+		LossCarriedForwardCents: lossAppliedCents,
+		LossCarriedForward:      float64(lossAppliedCents) / 100.0,
+		UnusedLossCents:         unusedLossCents,
+		UnusedLoss:              float64(unusedLossCents) / 100.0,
+		IsNonResident:           nonResident,
+		NetCapitalGainsCents:    netCapitalGainsCents,
+		NetCapitalGains:         netCapitalGains,
 	}
 }
 
+// capitalGainsDiscount is the share of a discount-eligible capital gain that
+// is taxed: assets held over 12 months have their gain halved.
+const capitalGainsDiscount = 0.5
+
+// netCapitalGainsCents applies the capital gains discount when the gain is
+// eligible. Foreign residents do not get the discount.
+func netCapitalGainsCents(gainCents int64, discountEligible, nonResident bool) int64 {
+	if discountEligible && !nonResident {
+		return int64(math.Round(float64(gainCents) * capitalGainsDiscount))
+	}
+	return gainCents
+}
+
 // ============================================================================
 // Tax RPC Handlers
 // ============================================================================
@@ -289,12 +391,20 @@ func (s *FinanceService) GetTaxSummary(ctx context.Context, req *connect.Request
 		return nil, err
 	}
 
+	startMonth := s.fiscalYearStartMonth(ctx, claims.UID)
 	fy := req.Msg.FinancialYear
 	if fy == "" {
-		fy = currentAustralianFY()
+		fy = fiscalYearFor(time.Now(), startMonth)
+	}
+
+	if req.Msg.PriorYearLossCents < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("prior_year_loss_cents must not be negative"))
 	}
 
-	calc, err := s.computeTaxForFY(ctx, claims.UID, fy, 0, 0, false, false)
+	calc, err := s.computeTaxForFY(ctx, claims.UID, fy, startMonth, taxOptions{
+		priorYearLossCents: req.Msg.PriorYearLossCents,
+		nonResident:        req.Msg.IsNonResident,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -314,9 +424,10 @@ func (s *FinanceService) GetTaxEstimate(ctx context.Context, req *connect.Reques
 		return nil, err
 	}
 
+	startMonth := s.fiscalYearStartMonth(ctx, claims.UID)
 	fy := req.Msg.FinancialYear
 	if fy == "" {
-		fy = currentAustralianFY()
+		fy = fiscalYearFor(time.Now(), startMonth)
 	}
 
 	grossOverrideCents := req.Msg.GrossIncomeOverrideCents
@@ -328,7 +439,24 @@ func (s *FinanceService) GetTaxEstimate(ctx context.Context, req *connect.Reques
 		addDeductionsCents = int64(req.Msg.AdditionalDeductions * 100)
 	}
 
-	calc, err := s.computeTaxForFY(ctx, claims.UID, fy, grossOverrideCents, addDeductionsCents, req.Msg.IncludeHelp, req.Msg.MedicareExemption)
+	if req.Msg.PriorYearLossCents < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("prior_year_loss_cents must not be negative"))
+	}
+	// A net capital loss cannot offset ordinary income, only future gains
+	if req.Msg.CapitalGainsCents < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("capital_gains_cents must not be negative"))
+	}
+	capitalGainsCents := netCapitalGainsCents(req.Msg.CapitalGainsCents, req.Msg.CapitalGainsDiscountEligible, req.Msg.IsNonResident)
+
+	calc, err := s.computeTaxForFY(ctx, claims.UID, fy, startMonth, taxOptions{
+		grossOverrideCents:        grossOverrideCents,
+		additionalDeductionsCents: addDeductionsCents,
+		netCapitalGainsCents:      capitalGainsCents,
+		priorYearLossCents:        req.Msg.PriorYearLossCents,
+		includeHELP:               req.Msg.IncludeHelp,
+		medicareExempt:            req.Msg.MedicareExemption,
+		nonResident:               req.Msg.IsNonResident,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -338,9 +466,22 @@ func (s *FinanceService) GetTaxEstimate(ctx context.Context, req *connect.Reques
 	}), nil
 }
 
-// computeTaxForFY fetches incomes + deductible expenses and computes the tax calculation.
-func (s *FinanceService) computeTaxForFY(ctx context.Context, userID, fy string, grossOverrideCents, additionalDeductionsCents int64, includeHELP, medicareExempt bool) (*pfinancev1.TaxCalculation, error) {
-	start, end, err := parseFYDateRange(fy)
+// taxOptions holds computeTaxForFY's what-if overrides and taxpayer settings.
+// The zero value computes from the user's recorded incomes and expenses.
+type taxOptions struct {
+	grossOverrideCents        int64 // Replaces recorded income when positive
+	additionalDeductionsCents int64
+	netCapitalGainsCents      int64 // Already discounted; adds to taxable income
+	priorYearLossCents        int64
+	includeHELP               bool
+	medicareExempt            bool
+	nonResident               bool
+}
+
+// computeTaxForFY fetches incomes + deductible expenses and computes the tax
+// calculation for the fiscal year fy, which starts in startMonth.
+func (s *FinanceService) computeTaxForFY(ctx context.Context, userID, fy string, startMonth time.Month, opts taxOptions) (*pfinancev1.TaxCalculation, error) {
+	start, end, err := parseFYDateRange(fy, startMonth)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
@@ -348,14 +489,15 @@ func (s *FinanceService) computeTaxForFY(ctx context.Context, userID, fy string,
 	// Compute gross income
 	var grossIncomeCents int64
 	var taxWithheldCents int64
+	withheldBySource := make(map[string]*pfinancev1.WithheldTaxBySource)
 
-	if grossOverrideCents > 0 {
-		grossIncomeCents = grossOverrideCents
+	if opts.grossOverrideCents > 0 {
+		grossIncomeCents = opts.grossOverrideCents
 	} else {
 		// Sum all incomes in the FY
 		var pageToken string
 		for {
-			incomes, nextToken, err := s.store.ListIncomes(ctx, userID, "", &start, &end, 500, pageToken)
+			incomes, nextToken, err := s.store.ListIncomes(ctx, userID, "", &start, &end, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 500, pageToken)
 			if err != nil {
 				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list incomes: %w", err))
 			}
@@ -367,14 +509,25 @@ func (s *FinanceService) computeTaxForFY(ctx context.Context, userID, fy string,
 				grossIncomeCents += cents
 
 				// Accumulate tax withheld from deductions marked as tax_deductible
+				var incomeWithheldCents int64
 				for _, ded := range inc.Deductions {
 					if ded.IsTaxDeductible {
 						dedCents := ded.AmountCents
 						if dedCents == 0 {
 							dedCents = int64(ded.Amount * 100)
 						}
-						taxWithheldCents += dedCents
+						incomeWithheldCents += dedCents
+					}
+				}
+				if incomeWithheldCents != 0 {
+					taxWithheldCents += incomeWithheldCents
+					bySource, ok := withheldBySource[inc.Source]
+					if !ok {
+						bySource = &pfinancev1.WithheldTaxBySource{Source: inc.Source}
+						withheldBySource[inc.Source] = bySource
 					}
+					bySource.WithheldCents += incomeWithheldCents
+					bySource.IncomeCount++
 				}
 			}
 			if nextToken == "" {
@@ -391,16 +544,28 @@ func (s *FinanceService) computeTaxForFY(ctx context.Context, userID, fy string,
 	}
 
 	// Add additional deductions if specified
-	if additionalDeductionsCents > 0 {
+	if opts.additionalDeductionsCents > 0 {
 		deductions = append(deductions, &pfinancev1.TaxDeductionSummary{
 			Category:     pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_OTHER,
-			TotalCents:   additionalDeductionsCents,
-			TotalAmount:  float64(additionalDeductionsCents) / 100.0,
+			TotalCents:   opts.additionalDeductionsCents,
+			TotalAmount:  float64(opts.additionalDeductionsCents) / 100.0,
 			ExpenseCount: 0,
 		})
 	}
 
-	calc := calculateAustralianTax(grossIncomeCents, deductions, taxWithheldCents, includeHELP, medicareExempt, fy)
+	calc := calculateAustralianTax(grossIncomeCents, opts.netCapitalGainsCents, deductions, opts.priorYearLossCents, taxWithheldCents, opts.includeHELP, opts.medicareExempt, opts.nonResident, fy)
+	for _, bySource := range withheldBySource {
+		bySource.Withheld = float64(bySource.WithheldCents) / 100.0
+		calc.WithheldBySource = append(calc.WithheldBySource, bySource)
+	}
+	// Largest withholding first, ties by source name
+	sort.Slice(calc.WithheldBySource, func(i, j int) bool {
+		a, b := calc.WithheldBySource[i], calc.WithheldBySource[j]
+		if a.WithheldCents != b.WithheldCents {
+			return a.WithheldCents > b.WithheldCents
+		}
+		return a.Source < b.Source
+	})
 	return calc, nil
 }
 
@@ -418,12 +583,33 @@ func (s *FinanceService) BatchUpdateExpenseTaxStatus(ctx context.Context, req *c
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max 100 updates per batch"))
 	}
 
+	// Fetch every expense in one read; missing IDs fail individually below
+	ids := make([]string, 0, len(req.Msg.Updates))
+	for _, update := range req.Msg.Updates {
+		ids = append(ids, update.ExpenseId)
+	}
+	expenses, err := s.store.GetExpensesByIDs(ctx, ids)
+	if err != nil {
+		return nil, auth.WrapStoreError("get expenses", err)
+	}
+
+	// Reject the whole batch before writing anything if it touches a locked FY
+	for _, expense := range expenses {
+		if expense.UserId != claims.UID {
+			continue
+		}
+		if err := checkExpenseUnlocked(expense); err != nil {
+			return nil, err
+		}
+	}
+
 	var updatedCount int32
 	var failedIDs []string
+	var updated []*pfinancev1.Expense
 
 	for _, update := range req.Msg.Updates {
-		expense, err := s.store.GetExpense(ctx, update.ExpenseId)
-		if err != nil {
+		expense, ok := expenses[update.ExpenseId]
+		if !ok {
 			failedIDs = append(failedIDs, update.ExpenseId)
 			continue
 		}
@@ -442,6 +628,7 @@ func (s *FinanceService) BatchUpdateExpenseTaxStatus(ctx context.Context, req *c
 		} else if update.IsTaxDeductible {
 			expense.TaxDeductiblePercent = 1.0
 		}
+		expense.TaxNeedsReview = false
 		expense.UpdatedAt = timestamppb.Now()
 
 		if err := s.store.UpdateExpense(ctx, expense); err != nil {
@@ -449,6 +636,7 @@ func (s *FinanceService) BatchUpdateExpenseTaxStatus(ctx context.Context, req *c
 			continue
 		}
 		updatedCount++
+		updated = append(updated, expense)
 
 		// Feed correction back into TaxDeductibilityMapping (Tier 1 learning)
 		if update.IsTaxDeductible && update.TaxDeductionCategory != pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNSPECIFIED {
@@ -456,6 +644,9 @@ func (s *FinanceService) BatchUpdateExpenseTaxStatus(ctx context.Context, req *c
 		}
 	}
 
+	// Fire-and-forget: warn about deductions nearing their cap or needing receipts
+	NewNotificationTrigger(s.store).CheckDeductionSubstantiationBatch(ctx, claims.UID, updated)
+
 	return connect.NewResponse(&pfinancev1.BatchUpdateExpenseTaxStatusResponse{
 		UpdatedCount:     updatedCount,
 		FailedExpenseIds: failedIDs,
@@ -484,15 +675,17 @@ func (s *FinanceService) learnTaxDeductibility(ctx context.Context, userID strin
 		return
 	}
 
+	pct := update.TaxDeductiblePercent
+	if pct <= 0 {
+		pct = 1.0
+	}
+
 	for _, m := range mappings {
 		if strings.EqualFold(m.MerchantPattern, merchantPattern) {
-			// Update existing mapping
+			// Update existing mapping, learning the percentage as a running
+			// average across confirmations
 			m.DeductionCategory = update.TaxDeductionCategory
-			if update.TaxDeductiblePercent > 0 {
-				m.DeductiblePercent = update.TaxDeductiblePercent
-			} else {
-				m.DeductiblePercent = 1.0
-			}
+			m.DeductiblePercent = averageDeductiblePercent(m.DeductiblePercent, m.ConfirmationCount, pct)
 			m.ConfirmationCount++
 			m.Confidence = taxMappingConfidence(m.ConfirmationCount)
 			m.LastUsed = timestamppb.Now()
@@ -504,10 +697,6 @@ func (s *FinanceService) learnTaxDeductibility(ctx context.Context, userID strin
 	}
 
 	// Create new mapping
-	pct := update.TaxDeductiblePercent
-	if pct <= 0 {
-		pct = 1.0
-	}
 	mapping := &pfinancev1.TaxDeductibilityMapping{
 		UserId:            userID,
 		MerchantPattern:   merchantPattern,
@@ -523,6 +712,15 @@ func (s *FinanceService) learnTaxDeductibility(ctx context.Context, userID strin
 	}
 }
 
+// averageDeductiblePercent folds a newly confirmed percentage into a mapping's
+// running average, weighting the existing value by its confirmation count.
+func averageDeductiblePercent(current float64, count int32, confirmed float64) float64 {
+	if count <= 0 || current <= 0 {
+		return confirmed
+	}
+	return (current*float64(count) + confirmed) / float64(count+1)
+}
+
 // extractMerchantPattern extracts a reusable merchant pattern from a description.
 // Strips trailing dates, reference numbers, and transaction IDs.
 func extractMerchantPattern(desc string) string {
@@ -570,12 +768,13 @@ func (s *FinanceService) ListDeductibleExpenses(ctx context.Context, req *connec
 		return nil, err
 	}
 
+	startMonth := s.fiscalYearStartMonth(ctx, claims.UID)
 	fy := req.Msg.FinancialYear
 	if fy == "" {
-		fy = currentAustralianFY()
+		fy = fiscalYearFor(time.Now(), startMonth)
 	}
 
-	start, end, err := parseFYDateRange(fy)
+	start, end, err := parseFYDateRange(fy, startMonth)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
@@ -583,7 +782,16 @@ func (s *FinanceService) ListDeductibleExpenses(ctx context.Context, req *connec
 	// Always use authenticated user's ID to prevent IDOR
 	userID := claims.UID
 
-	expenses, nextToken, err := s.store.ListDeductibleExpenses(ctx, userID, req.Msg.GroupId, &start, &end, req.Msg.Category, req.Msg.PageSize, req.Msg.PageToken)
+	pageSize, err := s.resolvePageSize(req.Msg.PageSize, s.pagination.DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := store.QueryFingerprint(userID, req.Msg.GroupId, &start, &end, req.Msg.Category)
+	pageToken, err := decodeListPageToken(req.Msg.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	expenses, nextToken, err := s.store.ListDeductibleExpenses(ctx, userID, req.Msg.GroupId, &start, &end, req.Msg.Category, pageSize, pageToken)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list deductible expenses: %w", err))
 	}
@@ -604,7 +812,7 @@ func (s *FinanceService) ListDeductibleExpenses(ctx context.Context, req *connec
 
 	return connect.NewResponse(&pfinancev1.ListDeductibleExpensesResponse{
 		Expenses:             expenses,
-		NextPageToken:        nextToken,
+		NextPageToken:        store.EncodeQueryPageToken(nextToken, fingerprint),
 		TotalDeductibleCents: totalCents,
 		TotalDeductible:      float64(totalCents) / 100.0,
 	}), nil
@@ -640,20 +848,28 @@ func (s *FinanceService) ExportTaxReturn(ctx context.Context, req *connect.Reque
 		return nil, err
 	}
 
-	fy := req.Msg.FinancialYear
-	if fy == "" {
-		fy = currentAustralianFY()
-	}
-
-	// Load user's tax config for HELP/Medicare settings
+	// Load user's tax config for HELP/Medicare settings and their fiscal year
 	var includeHELP, medicareExempt bool
+	startMonth := defaultFiscalYearStartMonth
 	taxCfg, err := s.store.GetTaxConfig(ctx, claims.UID, "")
-	if err == nil && taxCfg != nil && taxCfg.Settings != nil {
-		includeHELP = taxCfg.Settings.IncludeStudentLoan
-		medicareExempt = taxCfg.Settings.MedicareExemption
+	if err == nil && taxCfg != nil {
+		startMonth = fiscalYearStartMonthOf(taxCfg)
+		if taxCfg.Settings != nil {
+			includeHELP = taxCfg.Settings.IncludeStudentLoan
+			medicareExempt = taxCfg.Settings.MedicareExemption
+		}
+	}
+
+	fy := req.Msg.FinancialYear
+	if fy == "" {
+		fy = fiscalYearFor(time.Now(), startMonth)
 	}
 
-	calc, err := s.computeTaxForFY(ctx, claims.UID, fy, 0, 0, includeHELP, medicareExempt)
+	calc, err := s.computeTaxForFY(ctx, claims.UID, fy, startMonth, taxOptions{
+		includeHELP:    includeHELP,
+		medicareExempt: medicareExempt,
+		nonResident:    req.Msg.IsNonResident,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -678,27 +894,35 @@ func (s *FinanceService) ExportTaxReturn(ctx context.Context, req *connect.Reque
 	case pfinancev1.TaxExportFormat_TAX_EXPORT_FORMAT_CSV:
 		var buf strings.Builder
 		w := csv.NewWriter(&buf)
+		roundToDollar := req.Msg.Options.GetRoundToDollar()
+		amountRow := func(field string, cents int64, amount float64) {
+			dollarsCol, centsCol := formatTaxExportAmount(cents, amount, roundToDollar)
+			_ = w.Write([]string{field, dollarsCol, centsCol})
+		}
 		_ = w.Write([]string{"Field", "Amount ($)", "Amount (cents)"})
 		_ = w.Write([]string{"Financial Year", fy, ""})
-		_ = w.Write([]string{"Gross Income", fmt.Sprintf("%.2f", calc.GrossIncome), fmt.Sprintf("%d", calc.GrossIncomeCents)})
+		amountRow("Gross Income", calc.GrossIncomeCents, calc.GrossIncome)
 		for _, d := range calc.Deductions {
 			label := friendlyDeductionCategory(d.Category)
-			_ = w.Write([]string{fmt.Sprintf("Deduction: %s", label), fmt.Sprintf("%.2f", d.TotalAmount), fmt.Sprintf("%d", d.TotalCents)})
-		}
-		_ = w.Write([]string{"Total Deductions", fmt.Sprintf("%.2f", calc.TotalDeductions), fmt.Sprintf("%d", calc.TotalDeductionsCents)})
-		_ = w.Write([]string{"Taxable Income", fmt.Sprintf("%.2f", calc.TaxableIncome), fmt.Sprintf("%d", calc.TaxableIncomeCents)})
-		_ = w.Write([]string{"Base Tax", fmt.Sprintf("%.2f", calc.BaseTax), fmt.Sprintf("%d", calc.BaseTaxCents)})
-		_ = w.Write([]string{"Medicare Levy", fmt.Sprintf("%.2f", calc.MedicareLevy), fmt.Sprintf("%d", calc.MedicareLevyCents)})
-		_ = w.Write([]string{"HELP Repayment", fmt.Sprintf("%.2f", calc.HelpRepayment), fmt.Sprintf("%d", calc.HelpRepaymentCents)})
-		_ = w.Write([]string{"LITO (offset)", fmt.Sprintf("%.2f", calc.Lito), fmt.Sprintf("%d", calc.LitoCents)})
-		_ = w.Write([]string{"Total Tax", fmt.Sprintf("%.2f", calc.TotalTax), fmt.Sprintf("%d", calc.TotalTaxCents)})
+			amountRow(fmt.Sprintf("Deduction: %s", label), d.TotalCents, d.TotalAmount)
+		}
+		amountRow("Total Deductions", calc.TotalDeductionsCents, calc.TotalDeductions)
+		amountRow("Taxable Income", calc.TaxableIncomeCents, calc.TaxableIncome)
+		amountRow("Base Tax", calc.BaseTaxCents, calc.BaseTax)
+		amountRow("Medicare Levy", calc.MedicareLevyCents, calc.MedicareLevy)
+		amountRow("HELP Repayment", calc.HelpRepaymentCents, calc.HelpRepayment)
+		amountRow("LITO (offset)", calc.LitoCents, calc.Lito)
+		amountRow("Total Tax", calc.TotalTaxCents, calc.TotalTax)
 		_ = w.Write([]string{"Effective Rate", fmt.Sprintf("%.4f", calc.EffectiveRate), ""})
-		_ = w.Write([]string{"Tax Withheld", fmt.Sprintf("%.2f", calc.TaxWithheld), fmt.Sprintf("%d", calc.TaxWithheldCents)})
-		_ = w.Write([]string{"Refund/Owed", fmt.Sprintf("%.2f", calc.RefundOrOwed), fmt.Sprintf("%d", calc.RefundOrOwedCents)})
+		amountRow("Tax Withheld", calc.TaxWithheldCents, calc.TaxWithheld)
+		amountRow("Refund/Owed", calc.RefundOrOwedCents, calc.RefundOrOwed)
 		w.Flush()
 		data = []byte(buf.String())
 		contentType = "text/csv"
 		filename = fmt.Sprintf("tax-return-%s.csv", fy)
+		if roundToDollar {
+			filename = fmt.Sprintf("tax-return-%s-rounded.csv", fy)
+		}
 	}
 
 	return connect.NewResponse(&pfinancev1.ExportTaxReturnResponse{
@@ -709,6 +933,132 @@ func (s *FinanceService) ExportTaxReturn(ctx context.Context, req *connect.Reque
 	}), nil
 }
 
+// formatTaxExportAmount formats an amount for the tax return CSV's dollars and
+// cents columns. With roundToDollar both columns hold the amount rounded to
+// the nearest whole dollar, half away from zero.
+func formatTaxExportAmount(cents int64, amount float64, roundToDollar bool) (string, string) {
+	if !roundToDollar {
+		return fmt.Sprintf("%.2f", amount), fmt.Sprintf("%d", cents)
+	}
+	dollars := int64(math.Round(float64(cents) / 100))
+	return fmt.Sprintf("%d", dollars), fmt.Sprintf("%d", dollars*100)
+}
+
+// ExportTransactionsStream streams the FY's expenses as CSV, one batch per page
+// read from the store. The first message carries the filename, content type and
+// CSV header row so clients can start writing the file immediately.
+func (s *FinanceService) ExportTransactionsStream(ctx context.Context, req *connect.Request[pfinancev1.ExportTransactionsStreamRequest], stream *connect.ServerStream[pfinancev1.ExportTransactionsStreamResponse]) error {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.requireProWithFallback(ctx, claims); err != nil {
+		return err
+	}
+
+	startMonth := s.fiscalYearStartMonth(ctx, claims.UID)
+	fy := req.Msg.FinancialYear
+	if fy == "" {
+		fy = fiscalYearFor(time.Now(), startMonth)
+	}
+	start, end, err := parseFYDateRange(fy, startMonth)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	batchSize := req.Msg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > 1000 {
+		batchSize = 1000
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"Date", "Description", "Category", "Amount ($)", "Amount (cents)", "Tax Deductible", "Deduction Category", "Deductible (%)", "Note"})
+	w.Flush()
+
+	first := &pfinancev1.ExportTransactionsStreamResponse{
+		Filename:    fmt.Sprintf("transactions-%s.csv", fy),
+		ContentType: "text/csv",
+	}
+	if req.Msg.DeductibleOnly {
+		first.Filename = fmt.Sprintf("deductible-transactions-%s.csv", fy)
+	}
+
+	var pageToken string
+	for {
+		var expenses []*pfinancev1.Expense
+		var nextToken string
+		if req.Msg.DeductibleOnly {
+			expenses, nextToken, err = s.store.ListDeductibleExpenses(ctx, claims.UID, "", &start, &end, pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNSPECIFIED, batchSize, pageToken)
+		} else {
+			expenses, nextToken, err = s.store.ListExpenses(ctx, claims.UID, "", store.ExpenseQuery{StartDate: &start, EndDate: &end}, batchSize, pageToken)
+		}
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("list expenses: %w", err))
+		}
+
+		for _, e := range expenses {
+			cents := e.AmountCents
+			if cents == 0 {
+				cents = int64(e.Amount * 100)
+			}
+			var date, deductionCategory, deductiblePercent string
+			if e.Date != nil {
+				date = e.Date.AsTime().Format("2006-01-02")
+			}
+			if e.IsTaxDeductible {
+				deductionCategory = friendlyDeductionCategory(e.TaxDeductionCategory)
+				pct := e.TaxDeductiblePercent
+				if pct <= 0 {
+					pct = 1.0
+				}
+				deductiblePercent = fmt.Sprintf("%.0f", pct*100)
+			}
+			_ = w.Write([]string{
+				date,
+				e.Description,
+				e.Category.String(),
+				fmt.Sprintf("%.2f", float64(cents)/100),
+				fmt.Sprintf("%d", cents),
+				strconv.FormatBool(e.IsTaxDeductible),
+				deductionCategory,
+				deductiblePercent,
+				e.TaxDeductionNote,
+			})
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("write CSV: %w", err))
+		}
+
+		// Always send the first message (even with no rows) so the client gets
+		// the header; after that, skip empty pages.
+		if first != nil || len(expenses) > 0 {
+			msg := &pfinancev1.ExportTransactionsStreamResponse{}
+			if first != nil {
+				msg = first
+				first = nil
+			}
+			msg.Data = append([]byte(nil), buf.Bytes()...)
+			msg.RowCount = int32(len(expenses))
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+			buf.Reset()
+		}
+
+		if nextToken == "" {
+			break
+		}
+		pageToken = nextToken
+	}
+
+	return nil
+}
+
 // ============================================================================
 // Tax Classification Handlers
 // ============================================================================
@@ -731,6 +1081,11 @@ func (s *FinanceService) ClassifyTaxDeductibility(ctx context.Context, req *conn
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expense_id is required"))
 	}
 
+	autoThreshold, reviewThreshold, err := resolveClassificationThresholds(req.Msg.AutoApplyThreshold, req.Msg.ReviewThreshold)
+	if err != nil {
+		return nil, err
+	}
+
 	expense, err := s.store.GetExpense(ctx, req.Msg.ExpenseId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("expense not found: %w", err))
@@ -749,17 +1104,20 @@ func (s *FinanceService) ClassifyTaxDeductibility(ctx context.Context, req *conn
 	// Fetch correction history for feedback signals
 	correctionSignals := s.getCorrectionSignals(ctx, claims.UID)
 
-	results := s.taxPipeline.ClassifyExpenses(ctx, []*pfinancev1.Expense{expense}, userMappings, req.Msg.Occupation, 0.85, correctionSignals)
+	results := s.taxPipeline.ClassifyExpenses(ctx, []*pfinancev1.Expense{expense}, userMappings, req.Msg.Occupation, autoThreshold, correctionSignals)
 	if len(results) == 0 {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("classification returned no results"))
 	}
 
 	cls := results[0].Classification
-	autoApplied := cls.Confidence >= 0.85
-	needsReview := cls.Confidence >= 0.60 && cls.Confidence < 0.85
+	autoApplied := cls.Confidence >= autoThreshold
+	needsReview := cls.Confidence >= reviewThreshold && cls.Confidence < autoThreshold
 
 	// Auto-apply high-confidence results to the expense
 	if autoApplied {
+		if err := checkExpenseUnlocked(expense); err != nil {
+			return nil, err
+		}
 		expense.IsTaxDeductible = cls.IsDeductible
 		expense.TaxDeductionCategory = cls.Category
 		expense.TaxDeductionNote = cls.Reasoning
@@ -771,6 +1129,9 @@ func (s *FinanceService) ClassifyTaxDeductibility(ctx context.Context, req *conn
 		expense.UpdatedAt = timestamppb.Now()
 		if err := s.store.UpdateExpense(ctx, expense); err != nil {
 			log.Printf("[TaxClassify] Failed to auto-apply classification: %v", err)
+		} else if expense.GroupId == "" {
+			// Fire-and-forget: warn about deductions nearing their cap or needing receipts
+			NewNotificationTrigger(s.store).CheckDeductionSubstantiation(ctx, claims.UID, expense)
 		}
 	}
 
@@ -803,12 +1164,18 @@ func (s *FinanceService) BatchClassifyTaxDeductibility(ctx context.Context, req
 		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("tax classification service is not available"))
 	}
 
+	autoThreshold, reviewThreshold, err := resolveClassificationThresholds(req.Msg.AutoApplyThreshold, req.Msg.ReviewThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	startMonth := s.fiscalYearStartMonth(ctx, claims.UID)
 	fy := req.Msg.FinancialYear
 	if fy == "" {
-		fy = currentAustralianFY()
+		fy = fiscalYearFor(time.Now(), startMonth)
 	}
 
-	start, end, err := parseFYDateRange(fy)
+	start, end, err := parseFYDateRange(fy, startMonth)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
@@ -824,7 +1191,7 @@ func (s *FinanceService) BatchClassifyTaxDeductibility(ctx context.Context, req
 	var allExpenses []*pfinancev1.Expense
 	var pageToken string
 	for {
-		expenses, nextToken, err := s.store.ListExpenses(ctx, claims.UID, "", &start, &end, 500, pageToken)
+		expenses, nextToken, err := s.store.ListExpenses(ctx, claims.UID, "", store.ExpenseQuery{StartDate: &start, EndDate: &end}, 500, pageToken)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list expenses: %w", err))
 		}
@@ -845,7 +1212,7 @@ func (s *FinanceService) BatchClassifyTaxDeductibility(ctx context.Context, req
 	correctionSignals := s.getCorrectionSignals(ctx, claims.UID)
 
 	// Run the classification pipeline
-	classResults := s.taxPipeline.ClassifyExpenses(ctx, allExpenses, userMappings, req.Msg.Occupation, 0.85, correctionSignals)
+	classResults := s.taxPipeline.ClassifyExpenses(ctx, allExpenses, userMappings, req.Msg.Occupation, autoThreshold, correctionSignals)
 
 	var (
 		totalProcessed int32
@@ -853,14 +1220,15 @@ func (s *FinanceService) BatchClassifyTaxDeductibility(ctx context.Context, req
 		needsReview    int32
 		skipped        int32
 		results        []*pfinancev1.TaxClassificationResult
+		applied        []*pfinancev1.Expense
 	)
 
 	for _, cr := range classResults {
 		totalProcessed++
 		cls := cr.Classification
 
-		isAutoApply := cls.Confidence >= 0.85
-		isNeedsReview := cls.Confidence >= 0.60 && cls.Confidence < 0.85
+		isAutoApply := cls.Confidence >= autoThreshold
+		isNeedsReview := cls.Confidence >= reviewThreshold && cls.Confidence < autoThreshold
 		isSkipped := cls.Confidence < 0.40
 
 		// Skip expenses already classified by user (source == "user")
@@ -869,7 +1237,11 @@ func (s *FinanceService) BatchClassifyTaxDeductibility(ctx context.Context, req
 			continue
 		}
 
-		if isAutoApply && req.Msg.AutoApply {
+		// Locked expenses are reported but never rewritten
+		apply := isAutoApply && req.Msg.AutoApply && !cr.Expense.Locked
+		if isAutoApply && req.Msg.AutoApply && cr.Expense.Locked {
+			skipped++
+		} else if apply {
 			// Apply classification to expense
 			cr.Expense.IsTaxDeductible = cls.IsDeductible
 			cr.Expense.TaxDeductionCategory = cls.Category
@@ -879,14 +1251,24 @@ func (s *FinanceService) BatchClassifyTaxDeductibility(ctx context.Context, req
 			} else if cls.IsDeductible {
 				cr.Expense.TaxDeductiblePercent = 1.0
 			}
+			cr.Expense.TaxNeedsReview = false
 			cr.Expense.UpdatedAt = timestamppb.Now()
 			if err := s.store.UpdateExpense(ctx, cr.Expense); err != nil {
 				log.Printf("[TaxBatchClassify] Failed to update expense %s: %v", cr.Expense.Id, err)
 			} else {
 				autoApplied++
+				applied = append(applied, cr.Expense)
 			}
 		} else if isNeedsReview {
 			needsReview++
+			// Remember the flag so GetDeductionProgress can count what is left
+			if !cr.Expense.TaxNeedsReview && !cr.Expense.Locked {
+				cr.Expense.TaxNeedsReview = true
+				cr.Expense.UpdatedAt = timestamppb.Now()
+				if err := s.store.UpdateExpense(ctx, cr.Expense); err != nil {
+					log.Printf("[TaxBatchClassify] Failed to flag expense %s for review: %v", cr.Expense.Id, err)
+				}
+			}
 		} else if isSkipped {
 			skipped++
 		}
@@ -898,12 +1280,15 @@ func (s *FinanceService) BatchClassifyTaxDeductibility(ctx context.Context, req
 			DeductiblePercent: cls.DeductiblePct,
 			Confidence:        cls.Confidence,
 			Reasoning:         cls.Reasoning,
-			AutoApplied:       isAutoApply && req.Msg.AutoApply,
+			AutoApplied:       apply,
 			NeedsReview:       isNeedsReview,
 			FieldConfidences:  toProtoFieldConfidences(cls.FieldConfidences),
 		})
 	}
 
+	// Fire-and-forget: warn about deductions nearing their cap or needing receipts
+	NewNotificationTrigger(s.store).CheckDeductionSubstantiationBatch(ctx, claims.UID, applied)
+
 	return connect.NewResponse(&pfinancev1.BatchClassifyTaxDeductibilityResponse{
 		TotalProcessed: totalProcessed,
 		AutoApplied:    autoApplied,
@@ -917,12 +1302,36 @@ func (s *FinanceService) BatchClassifyTaxDeductibility(ctx context.Context, req
 // Helper Functions
 // ============================================================================
 
-// currentAustralianFY returns the current Australian financial year string.
-// If the current month is July or later, FY starts this year, otherwise last year.
-func currentAustralianFY() string {
-	now := time.Now()
-	startYear := now.Year()
-	if now.Month() < time.July {
+const (
+	defaultAutoApplyThreshold = 0.85
+	defaultReviewThreshold    = 0.60
+)
+
+// resolveClassificationThresholds applies the default auto-apply/review thresholds
+// for unset values and validates the result. An explicit 0 is kept.
+func resolveClassificationThresholds(autoApplyThreshold, reviewThreshold *float64) (float64, float64, error) {
+	autoApply, review := defaultAutoApplyThreshold, defaultReviewThreshold
+	if autoApplyThreshold != nil {
+		autoApply = *autoApplyThreshold
+	}
+	if reviewThreshold != nil {
+		review = *reviewThreshold
+	}
+	if autoApply < 0 || autoApply > 1 || review < 0 || review > 1 {
+		return 0, 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("confidence thresholds must be between 0 and 1"))
+	}
+	if autoApply < review {
+		return 0, 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("auto_apply_threshold (%.2f) must be >= review_threshold (%.2f)", autoApply, review))
+	}
+	return autoApply, review, nil
+}
+
+// fiscalYearFor returns the fiscal year starting in startMonth that contains
+// t. If t is in startMonth or later, FY starts that year, otherwise the year
+// before.
+func fiscalYearFor(t time.Time, startMonth time.Month) string {
+	startYear := t.Year()
+	if t.Month() < startMonth {
 		startYear--
 	}
 	endYear := (startYear + 1) % 100
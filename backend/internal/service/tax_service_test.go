@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/castlemilk/pfinance/backend/internal/extraction"
 	"github.com/castlemilk/pfinance/backend/internal/store"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -43,7 +45,7 @@ func TestParseFYDateRange(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.fy, func(t *testing.T) {
-			start, end, err := parseFYDateRange(tt.fy)
+			start, end, err := parseFYDateRange(tt.fy, defaultFiscalYearStartMonth)
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("expected error for fy=%q", tt.fy)
@@ -88,6 +90,28 @@ func TestCalculateBracketTax(t *testing.T) {
 	}
 }
 
+func TestNonResidentBracketTax(t *testing.T) {
+	tests := []struct {
+		name   string
+		fy     string
+		income float64
+		want   float64
+	}{
+		{"no tax-free threshold", "2024-25", 18200, 5460},
+		{"$100,000 income", "2024-25", 100000, 30000},
+		{"$200,000 income", "2024-25", 200000, 65350}, // 40,500 + 20,350 + 4,500
+		{"2023-24 rates", "2023-24", 100000, 32500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := calculateBracketTax(tt.income, taxBrackets(tt.fy, true))
+			if math.Abs(got-tt.want) > 1.0 {
+				t.Errorf("calculateBracketTax(%v) = %.2f, want ~%.2f", tt.income, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCalculateLITO(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -161,10 +185,13 @@ func TestCalculateAustralianTax(t *testing.T) {
 	t.Run("basic calculation with $100k income no deductions", func(t *testing.T) {
 		calc := calculateAustralianTax(
 			10000000, // $100,000 gross
+			0,        // no capital gains
 			nil,      // no deductions
+			0,        // no prior-year loss
 			2000000,  // $20,000 withheld
 			false,    // no HELP
 			false,    // no medicare exemption
+			false,    // resident
 			"2024-25",
 		)
 
@@ -196,7 +223,7 @@ func TestCalculateAustralianTax(t *testing.T) {
 				TotalCents: 200000, // $2,000
 			},
 		}
-		calc := calculateAustralianTax(10000000, deductions, 2000000, false, false, "2024-25")
+		calc := calculateAustralianTax(10000000, 0, deductions, 0, 2000000, false, false, false, "2024-25")
 
 		if calc.TaxableIncome != 95000 {
 			t.Errorf("TaxableIncome = %v, want 95000", calc.TaxableIncome)
@@ -207,12 +234,109 @@ func TestCalculateAustralianTax(t *testing.T) {
 	})
 
 	t.Run("refund when withheld > owed", func(t *testing.T) {
-		calc := calculateAustralianTax(5000000, nil, 1500000, false, false, "2024-25")
+		calc := calculateAustralianTax(5000000, 0, nil, 0, 1500000, false, false, false, "2024-25")
 		// $50k income, $15k withheld — should get a refund since tax on $50k is ~$6,717
 		if calc.RefundOrOwedCents <= 0 {
 			t.Errorf("expected refund (positive), got %d cents", calc.RefundOrOwedCents)
 		}
 	})
+
+	t.Run("non-resident skips LITO and Medicare levy", func(t *testing.T) {
+		resident := calculateAustralianTax(3000000, 0, nil, 0, 0, false, false, false, "2024-25")
+		calc := calculateAustralianTax(3000000, 0, nil, 0, 0, false, false, true, "2024-25")
+
+		if calc.BaseTaxCents != 900000 {
+			t.Errorf("BaseTaxCents = %d, want 900000 (30%% from the first dollar)", calc.BaseTaxCents)
+		}
+		if calc.MedicareLevyCents != 0 || calc.LitoCents != 0 {
+			t.Errorf("MedicareLevyCents = %d, LitoCents = %d; want both zero", calc.MedicareLevyCents, calc.LitoCents)
+		}
+		if calc.TotalTaxCents != 900000 || !calc.IsNonResident {
+			t.Errorf("TotalTaxCents = %d, IsNonResident = %v; want 900000, true", calc.TotalTaxCents, calc.IsNonResident)
+		}
+		if resident.LitoCents == 0 || resident.MedicareLevyCents == 0 || resident.IsNonResident {
+			t.Error("resident calculation should keep LITO and the Medicare levy")
+		}
+	})
+
+	t.Run("prior-year loss reduces taxable income", func(t *testing.T) {
+		without := calculateAustralianTax(10000000, 0, nil, 0, 0, false, false, false, "2024-25")
+		calc := calculateAustralianTax(10000000, 0, nil, 1500000, 0, false, false, false, "2024-25")
+
+		if calc.TaxableIncomeCents != 8500000 {
+			t.Errorf("TaxableIncomeCents = %d, want 8500000", calc.TaxableIncomeCents)
+		}
+		if calc.LossCarriedForwardCents != 1500000 {
+			t.Errorf("LossCarriedForwardCents = %d, want 1500000", calc.LossCarriedForwardCents)
+		}
+		if calc.UnusedLossCents != 0 {
+			t.Errorf("UnusedLossCents = %d, want 0", calc.UnusedLossCents)
+		}
+		if calc.TotalTaxCents >= without.TotalTaxCents {
+			t.Errorf("TotalTaxCents = %d, want less than %d without loss", calc.TotalTaxCents, without.TotalTaxCents)
+		}
+		if without.LossCarriedForwardCents != 0 || without.UnusedLossCents != 0 {
+			t.Error("expected no loss fields without a prior-year loss")
+		}
+	})
+
+	t.Run("prior-year loss exceeding income reports remainder", func(t *testing.T) {
+		deductions := []*pfinancev1.TaxDeductionSummary{
+			{Category: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_OTHER_WORK, TotalCents: 500000},
+		}
+		calc := calculateAustralianTax(3000000, 0, deductions, 4000000, 0, false, false, false, "2024-25")
+
+		if calc.TaxableIncomeCents != 0 {
+			t.Errorf("TaxableIncomeCents = %d, want 0", calc.TaxableIncomeCents)
+		}
+		if calc.LossCarriedForwardCents != 2500000 {
+			t.Errorf("LossCarriedForwardCents = %d, want 2500000", calc.LossCarriedForwardCents)
+		}
+		if calc.UnusedLossCents != 1500000 {
+			t.Errorf("UnusedLossCents = %d, want 1500000", calc.UnusedLossCents)
+		}
+		if calc.TotalTaxCents != 0 {
+			t.Errorf("TotalTaxCents = %d, want 0", calc.TotalTaxCents)
+		}
+	})
+
+	t.Run("net capital gains add to taxable income", func(t *testing.T) {
+		without := calculateAustralianTax(10000000, 0, nil, 0, 0, false, false, false, "2024-25")
+		calc := calculateAustralianTax(10000000, 1000000, nil, 0, 0, false, false, false, "2024-25")
+
+		if calc.TaxableIncomeCents != 11000000 || calc.GrossIncomeCents != 10000000 {
+			t.Errorf("TaxableIncomeCents = %d, GrossIncomeCents = %d; want 11000000, 10000000", calc.TaxableIncomeCents, calc.GrossIncomeCents)
+		}
+		if calc.NetCapitalGainsCents != 1000000 || calc.NetCapitalGains != 10000 {
+			t.Errorf("NetCapitalGains = %d cents / %v, want 1000000 / 10000", calc.NetCapitalGainsCents, calc.NetCapitalGains)
+		}
+		if calc.TotalTaxCents <= without.TotalTaxCents {
+			t.Errorf("TotalTaxCents = %d, want more than %d without gains", calc.TotalTaxCents, without.TotalTaxCents)
+		}
+		if want := calc.TotalTax / 110000; calc.EffectiveRate != want {
+			t.Errorf("EffectiveRate = %v, want %v over income and gains", calc.EffectiveRate, want)
+		}
+	})
+}
+
+func TestNetCapitalGainsCents(t *testing.T) {
+	tests := []struct {
+		name        string
+		eligible    bool
+		nonResident bool
+		want        int64
+	}{
+		{"no discount", false, false, 1000001},
+		{"discount halves the gain", true, false, 500001},
+		{"foreign residents get no discount", true, true, 1000001},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := netCapitalGainsCents(1000001, tt.eligible, tt.nonResident); got != tt.want {
+				t.Errorf("netCapitalGainsCents = %d, want %d", got, tt.want)
+			}
+		})
+	}
 }
 
 // ============================================================================
@@ -226,6 +350,8 @@ func TestTaxGetTaxSummary(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	// Without a tax config the fiscal year starts in July
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), "").Return(nil, fmt.Errorf("not found")).AnyTimes()
 
 	userID := "tax-user-1"
 	ctx := testProContext(userID)
@@ -245,7 +371,7 @@ func TestTaxGetTaxSummary(t *testing.T) {
 				},
 			},
 		}
-		mockStore.EXPECT().ListIncomes(gomock.Any(), userID, "", &fyStart, &fyEnd, int32(500), "").
+		mockStore.EXPECT().ListIncomes(gomock.Any(), userID, "", &fyStart, &fyEnd, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(500), "").
 			Return(incomes, "", nil)
 
 		deductionSummaries := []*pfinancev1.TaxDeductionSummary{
@@ -280,6 +406,54 @@ func TestTaxGetTaxSummary(t *testing.T) {
 		if calc.TotalTaxCents <= 0 {
 			t.Error("TotalTaxCents should be > 0")
 		}
+		if len(calc.WithheldBySource) != 1 || calc.WithheldBySource[0].WithheldCents != 1800000 {
+			t.Errorf("WithheldBySource = %v, want a single 1800000 cent entry", calc.WithheldBySource)
+		}
+	})
+
+	t.Run("withheld tax broken down by income source", func(t *testing.T) {
+		fyStart := time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)
+		fyEnd := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+		payg := func(cents int64) []*pfinancev1.Deduction {
+			return []*pfinancev1.Deduction{
+				{Name: "PAYG Tax", AmountCents: cents, IsTaxDeductible: true},
+				{Name: "Super", AmountCents: 50000},
+			}
+		}
+
+		incomes := []*pfinancev1.Income{
+			{Id: "inc-1", UserId: userID, Source: "Acme Corp", AmountCents: 4000000, Deductions: payg(900000)},
+			{Id: "inc-2", UserId: userID, Source: "Acme Corp", AmountCents: 1000000, Deductions: payg(250000)},
+			{Id: "inc-3", UserId: userID, Source: "Side Gig", AmountCents: 2000000, Deductions: payg(400000)},
+			{Id: "inc-4", UserId: userID, Source: "Dividends", AmountCents: 300000},
+		}
+		mockStore.EXPECT().ListIncomes(gomock.Any(), userID, "", &fyStart, &fyEnd, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(500), "").
+			Return(incomes, "", nil)
+		mockStore.EXPECT().AggregateDeductionsByCategory(gomock.Any(), userID, "", fyStart, fyEnd).
+			Return(nil, nil)
+
+		resp, err := svc.GetTaxSummary(ctx, connect.NewRequest(&pfinancev1.GetTaxSummaryRequest{
+			UserId:        userID,
+			FinancialYear: "2024-25",
+		}))
+		if err != nil {
+			t.Fatalf("GetTaxSummary failed: %v", err)
+		}
+
+		calc := resp.Msg.Calculation
+		if calc.TaxWithheldCents != 1550000 {
+			t.Errorf("TaxWithheldCents = %d, want 1550000", calc.TaxWithheldCents)
+		}
+		bySource := calc.WithheldBySource
+		if len(bySource) != 2 {
+			t.Fatalf("expected 2 sources with withholding, got %d", len(bySource))
+		}
+		if bySource[0].Source != "Acme Corp" || bySource[0].WithheldCents != 1150000 || bySource[0].IncomeCount != 2 {
+			t.Errorf("bySource[0] = %v, want Acme Corp 1150000 cents from 2 incomes", bySource[0])
+		}
+		if bySource[1].Source != "Side Gig" || bySource[1].WithheldCents != 400000 || bySource[1].Withheld != 4000 {
+			t.Errorf("bySource[1] = %v, want Side Gig 400000 cents", bySource[1])
+		}
 	})
 }
 
@@ -290,6 +464,10 @@ func TestTaxBatchUpdateExpenseTaxStatus(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	// Substantiation warnings are off, so the check stops at the preferences
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	mockStore.EXPECT().GetNotificationPreferences(gomock.Any(), gomock.Any()).
+		Return(&pfinancev1.NotificationPreferences{SubstantiationAlerts: proto.Bool(false)}, nil).AnyTimes()
 
 	userID := "tax-user-2"
 	ctx := testProContext(userID)
@@ -298,8 +476,8 @@ func TestTaxBatchUpdateExpenseTaxStatus(t *testing.T) {
 		expense1 := &pfinancev1.Expense{Id: "exp-1", UserId: userID, AmountCents: 5000}
 		expense2 := &pfinancev1.Expense{Id: "exp-2", UserId: userID, AmountCents: 3000}
 
-		mockStore.EXPECT().GetExpense(gomock.Any(), "exp-1").Return(expense1, nil)
-		mockStore.EXPECT().GetExpense(gomock.Any(), "exp-2").Return(expense2, nil)
+		mockStore.EXPECT().GetExpensesByIDs(gomock.Any(), []string{"exp-1", "exp-2"}).
+			Return(map[string]*pfinancev1.Expense{"exp-1": expense1, "exp-2": expense2}, nil)
 		mockStore.EXPECT().UpdateExpense(gomock.Any(), gomock.Any()).Return(nil).Times(2)
 
 		resp, err := svc.BatchUpdateExpenseTaxStatus(ctx, connect.NewRequest(&pfinancev1.BatchUpdateExpenseTaxStatusRequest{
@@ -330,6 +508,31 @@ func TestTaxBatchUpdateExpenseTaxStatus(t *testing.T) {
 		}
 	})
 
+	t.Run("missing expense fails only its own update", func(t *testing.T) {
+		expense := &pfinancev1.Expense{Id: "exp-1", UserId: userID, AmountCents: 5000}
+
+		mockStore.EXPECT().GetExpensesByIDs(gomock.Any(), []string{"exp-1", "exp-gone"}).
+			Return(map[string]*pfinancev1.Expense{"exp-1": expense}, nil)
+		mockStore.EXPECT().UpdateExpense(gomock.Any(), expense).Return(nil)
+
+		resp, err := svc.BatchUpdateExpenseTaxStatus(ctx, connect.NewRequest(&pfinancev1.BatchUpdateExpenseTaxStatusRequest{
+			UserId: userID,
+			Updates: []*pfinancev1.ExpenseTaxUpdate{
+				{ExpenseId: "exp-1", IsTaxDeductible: false},
+				{ExpenseId: "exp-gone", IsTaxDeductible: false},
+			},
+		}))
+		if err != nil {
+			t.Fatalf("BatchUpdateExpenseTaxStatus failed: %v", err)
+		}
+		if resp.Msg.UpdatedCount != 1 {
+			t.Errorf("UpdatedCount = %d, want 1", resp.Msg.UpdatedCount)
+		}
+		if !slices.Equal(resp.Msg.FailedExpenseIds, []string{"exp-gone"}) {
+			t.Errorf("FailedExpenseIds = %v, want [exp-gone]", resp.Msg.FailedExpenseIds)
+		}
+	})
+
 	t.Run("rejects batch over 100", func(t *testing.T) {
 		updates := make([]*pfinancev1.ExpenseTaxUpdate, 101)
 		for i := range updates {
@@ -352,6 +555,7 @@ func TestTaxListDeductibleExpenses(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), "").Return(nil, fmt.Errorf("not found")).AnyTimes()
 
 	userID := "tax-user-3"
 	ctx := testProContext(userID)
@@ -379,7 +583,7 @@ func TestTaxListDeductibleExpenses(t *testing.T) {
 			},
 		}
 
-		mockStore.EXPECT().ListDeductibleExpenses(gomock.Any(), userID, "", &fyStart, &fyEnd, pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNSPECIFIED, int32(0), "").
+		mockStore.EXPECT().ListDeductibleExpenses(gomock.Any(), userID, "", &fyStart, &fyEnd, pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNSPECIFIED, int32(100), "").
 			Return(expenses, "", nil)
 
 		resp, err := svc.ListDeductibleExpenses(ctx, connect.NewRequest(&pfinancev1.ListDeductibleExpensesRequest{
@@ -491,6 +695,96 @@ func TestTaxClassify_NotDeductible(t *testing.T) {
 	}
 }
 
+func TestTaxClassify_CustomAutoApplyThreshold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := store.NewMockStore(ctrl)
+	svc := NewFinanceService(mockStore, nil, nil)
+	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+
+	svc.SetTaxClassificationPipeline(extraction.NewTaxClassificationPipeline(""))
+
+	userID := "tax-user"
+	ctx := testProContext(userID)
+
+	expense := &pfinancev1.Expense{
+		Id:          "exp-grocery",
+		UserId:      userID,
+		Description: "woolworths groceries",
+		AmountCents: 15000,
+	}
+
+	mockStore.EXPECT().GetExpense(gomock.Any(), "exp-grocery").Return(expense, nil)
+	mockStore.EXPECT().GetTaxDeductibilityMappings(gomock.Any(), userID).Return(nil, nil)
+	mockStore.EXPECT().ListCorrectionRecords(gomock.Any(), userID, 200).Return(nil, nil)
+	// Confidence 0.90 is below the 0.95 auto-apply threshold, so no UpdateExpense
+
+	resp, err := svc.ClassifyTaxDeductibility(ctx, connect.NewRequest(&pfinancev1.ClassifyTaxDeductibilityRequest{
+		UserId:             userID,
+		ExpenseId:          "exp-grocery",
+		AutoApplyThreshold: proto.Float64(0.95),
+	}))
+	if err != nil {
+		t.Fatalf("ClassifyTaxDeductibility failed: %v", err)
+	}
+
+	result := resp.Msg.Result
+	if result.AutoApplied {
+		t.Error("expected AutoApplied=false below custom threshold")
+	}
+	if !result.NeedsReview {
+		t.Error("expected NeedsReview=true between review and auto-apply thresholds")
+	}
+}
+
+func TestTaxClassify_InvalidThresholds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := store.NewMockStore(ctrl)
+	svc := NewFinanceService(mockStore, nil, nil)
+	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+
+	svc.SetTaxClassificationPipeline(extraction.NewTaxClassificationPipeline(""))
+
+	ctx := testProContext("tax-user")
+
+	tests := []struct {
+		name            string
+		autoThreshold   *float64
+		reviewThreshold *float64
+	}{
+		{"auto below review", proto.Float64(0.50), proto.Float64(0.70)},
+		{"auto below default review", proto.Float64(0.55), nil},
+		{"out of range", proto.Float64(1.5), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ClassifyTaxDeductibility(ctx, connect.NewRequest(&pfinancev1.ClassifyTaxDeductibilityRequest{
+				UserId:             "tax-user",
+				ExpenseId:          "exp-1",
+				AutoApplyThreshold: tt.autoThreshold,
+				ReviewThreshold:    tt.reviewThreshold,
+			}))
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("error code = %v, want InvalidArgument", connect.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestResolveClassificationThresholds(t *testing.T) {
+	auto, review, err := resolveClassificationThresholds(nil, nil)
+	if err != nil || auto != defaultAutoApplyThreshold || review != defaultReviewThreshold {
+		t.Errorf("unset = %v, %v, %v; want the defaults", auto, review, err)
+	}
+	auto, review, err = resolveClassificationThresholds(proto.Float64(0.9), proto.Float64(0))
+	if err != nil || auto != 0.9 || review != 0 {
+		t.Errorf("explicit zero review = %v, %v, %v; want 0.9, 0", auto, review, err)
+	}
+}
+
 func TestTaxClassify_MissingExpenseId(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -607,6 +901,7 @@ func TestTaxBatchClassify_EmptyExpenses(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), "").Return(nil, fmt.Errorf("not found")).AnyTimes()
 
 	svc.SetTaxClassificationPipeline(extraction.NewTaxClassificationPipeline(""))
 
@@ -617,7 +912,7 @@ func TestTaxBatchClassify_EmptyExpenses(t *testing.T) {
 	fyEnd := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
 
 	mockStore.EXPECT().GetTaxDeductibilityMappings(gomock.Any(), userID).Return(nil, nil)
-	mockStore.EXPECT().ListExpenses(gomock.Any(), userID, "", &fyStart, &fyEnd, int32(500), "").
+	mockStore.EXPECT().ListExpenses(gomock.Any(), userID, "", store.ExpenseQuery{StartDate: &fyStart, EndDate: &fyEnd}, int32(500), "").
 		Return([]*pfinancev1.Expense{}, "", nil)
 
 	resp, err := svc.BatchClassifyTaxDeductibility(ctx, connect.NewRequest(&pfinancev1.BatchClassifyTaxDeductibilityRequest{
@@ -639,6 +934,7 @@ func TestTaxBatchClassify_AutoApplyFalse(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), "").Return(nil, fmt.Errorf("not found")).AnyTimes()
 
 	svc.SetTaxClassificationPipeline(extraction.NewTaxClassificationPipeline(""))
 
@@ -659,7 +955,7 @@ func TestTaxBatchClassify_AutoApplyFalse(t *testing.T) {
 
 	mockStore.EXPECT().GetTaxDeductibilityMappings(gomock.Any(), userID).Return(nil, nil)
 	mockStore.EXPECT().ListCorrectionRecords(gomock.Any(), userID, 200).Return(nil, nil)
-	mockStore.EXPECT().ListExpenses(gomock.Any(), userID, "", &fyStart, &fyEnd, int32(500), "").
+	mockStore.EXPECT().ListExpenses(gomock.Any(), userID, "", store.ExpenseQuery{StartDate: &fyStart, EndDate: &fyEnd}, int32(500), "").
 		Return(expenses, "", nil)
 	// UpdateExpense should NOT be called because auto_apply=false
 
@@ -686,12 +982,14 @@ func TestTaxBatchClassify_InvalidFY(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), "").Return(nil, fmt.Errorf("not found")).AnyTimes()
 
 	svc.SetTaxClassificationPipeline(extraction.NewTaxClassificationPipeline(""))
 
 	ctx := testProContext("tax-user")
 
-	// parseFYDateRange runs before GetTaxDeductibilityMappings, so no store calls expected
+	// parseFYDateRange runs before GetTaxDeductibilityMappings, so only the tax
+	// config is read
 
 	_, err := svc.BatchClassifyTaxDeductibility(ctx, connect.NewRequest(&pfinancev1.BatchClassifyTaxDeductibilityRequest{
 		UserId:        "tax-user",
@@ -756,7 +1054,7 @@ func TestTaxExport_CSV(t *testing.T) {
 			Date:        timestamppb.New(time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)),
 		},
 	}
-	mockStore.EXPECT().ListIncomes(gomock.Any(), userID, "", &fyStart, &fyEnd, int32(500), "").
+	mockStore.EXPECT().ListIncomes(gomock.Any(), userID, "", &fyStart, &fyEnd, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(500), "").
 		Return(incomes, "", nil)
 
 	deductions := []*pfinancev1.TaxDeductionSummary{
@@ -801,6 +1099,93 @@ func TestTaxExport_CSV(t *testing.T) {
 	}
 }
 
+func TestTaxExport_CSVRoundToDollar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := store.NewMockStore(ctrl)
+	svc := NewFinanceService(mockStore, nil, nil)
+	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+
+	userID := "tax-user"
+	ctx := testProContext(userID)
+
+	fyStart := time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)
+	fyEnd := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), userID, "").Return(nil, fmt.Errorf("not found"))
+
+	incomes := []*pfinancev1.Income{
+		{
+			Id:          "inc-1",
+			UserId:      userID,
+			AmountCents: 10000050, // $100,000.50
+			Date:        timestamppb.New(time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)),
+		},
+	}
+	mockStore.EXPECT().ListIncomes(gomock.Any(), userID, "", &fyStart, &fyEnd, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(500), "").
+		Return(incomes, "", nil)
+
+	deductions := []*pfinancev1.TaxDeductionSummary{
+		{
+			Category:     pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_HOME_OFFICE,
+			TotalCents:   100049, // $1,000.49
+			TotalAmount:  1000.49,
+			ExpenseCount: 3,
+		},
+	}
+	mockStore.EXPECT().AggregateDeductionsByCategory(gomock.Any(), userID, "", fyStart, fyEnd).
+		Return(deductions, nil)
+
+	resp, err := svc.ExportTaxReturn(ctx, connect.NewRequest(&pfinancev1.ExportTaxReturnRequest{
+		UserId:        userID,
+		FinancialYear: "2024-25",
+		Format:        pfinancev1.TaxExportFormat_TAX_EXPORT_FORMAT_CSV,
+		Options:       &pfinancev1.ExportOptions{RoundToDollar: true},
+	}))
+	if err != nil {
+		t.Fatalf("ExportTaxReturn CSV failed: %v", err)
+	}
+
+	if resp.Msg.Filename != "tax-return-2024-25-rounded.csv" {
+		t.Errorf("Filename = %q, want tax-return-2024-25-rounded.csv", resp.Msg.Filename)
+	}
+	if resp.Msg.Calculation.GrossIncomeCents != 10000050 {
+		t.Errorf("Calculation.GrossIncomeCents = %d, want precise 10000050", resp.Msg.Calculation.GrossIncomeCents)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(string(resp.Msg.Data))).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to parse CSV: %v", err)
+	}
+	rows := make(map[string][]string)
+	for _, record := range records {
+		rows[record[0]] = record[1:]
+	}
+
+	wantRows := map[string][]string{
+		"Gross Income":                {"100001", "10000100"},
+		"Deduction: D5 - Home Office": {"1000", "100000"},
+		"Total Deductions":            {"1000", "100000"},
+	}
+	for field, want := range wantRows {
+		if got := rows[field]; !slices.Equal(got, want) {
+			t.Errorf("%s = %v, want %v", field, got, want)
+		}
+	}
+	if rate := rows["Effective Rate"]; len(rate) == 0 || !strings.Contains(rate[0], ".") {
+		t.Errorf("Effective Rate = %v, want an unrounded ratio", rate)
+	}
+	for field, cols := range rows {
+		if field == "Field" || field == "Financial Year" || field == "Effective Rate" {
+			continue
+		}
+		if strings.Contains(cols[0], ".") {
+			t.Errorf("%s = %q, want whole dollars", field, cols[0])
+		}
+	}
+}
+
 func TestTaxExport_JSON(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -825,7 +1210,7 @@ func TestTaxExport_JSON(t *testing.T) {
 			Date:        timestamppb.New(time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)),
 		},
 	}
-	mockStore.EXPECT().ListIncomes(gomock.Any(), userID, "", &fyStart, &fyEnd, int32(500), "").
+	mockStore.EXPECT().ListIncomes(gomock.Any(), userID, "", &fyStart, &fyEnd, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(500), "").
 		Return(incomes, "", nil)
 
 	deductions := []*pfinancev1.TaxDeductionSummary{
@@ -878,7 +1263,7 @@ func TestTaxExport_NoIncome(t *testing.T) {
 	fyEnd := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
 
 	mockStore.EXPECT().GetTaxConfig(gomock.Any(), userID, "").Return(nil, fmt.Errorf("not found"))
-	mockStore.EXPECT().ListIncomes(gomock.Any(), userID, "", &fyStart, &fyEnd, int32(500), "").
+	mockStore.EXPECT().ListIncomes(gomock.Any(), userID, "", &fyStart, &fyEnd, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(500), "").
 		Return([]*pfinancev1.Income{}, "", nil)
 	mockStore.EXPECT().AggregateDeductionsByCategory(gomock.Any(), userID, "", fyStart, fyEnd).
 		Return([]*pfinancev1.TaxDeductionSummary{}, nil)
@@ -907,6 +1292,8 @@ func TestTaxEstimate_WithGrossOverride(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	// Without a tax config the fiscal year starts in July
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), "").Return(nil, fmt.Errorf("not found")).AnyTimes()
 
 	userID := "tax-user"
 	ctx := testProContext(userID)
@@ -932,6 +1319,52 @@ func TestTaxEstimate_WithGrossOverride(t *testing.T) {
 	}
 }
 
+func TestTaxEstimate_WithCapitalGains(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := store.NewMockStore(ctrl)
+	svc := NewFinanceService(mockStore, nil, nil)
+	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), "").Return(nil, fmt.Errorf("not found")).AnyTimes()
+
+	userID := "tax-user"
+	ctx := testProContext(userID)
+
+	fyStart := time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)
+	fyEnd := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+	mockStore.EXPECT().AggregateDeductionsByCategory(gomock.Any(), userID, "", fyStart, fyEnd).
+		Return([]*pfinancev1.TaxDeductionSummary{}, nil)
+
+	resp, err := svc.GetTaxEstimate(ctx, connect.NewRequest(&pfinancev1.GetTaxEstimateRequest{
+		UserId:                       userID,
+		FinancialYear:                "2024-25",
+		GrossIncomeOverrideCents:     10000000, // $100k
+		CapitalGainsCents:            2000000,  // $20k, held over a year
+		CapitalGainsDiscountEligible: true,
+	}))
+	if err != nil {
+		t.Fatalf("GetTaxEstimate failed: %v", err)
+	}
+	calc := resp.Msg.Calculation
+	if calc.NetCapitalGainsCents != 1000000 {
+		t.Errorf("NetCapitalGainsCents = %d, want 1000000 after the discount", calc.NetCapitalGainsCents)
+	}
+	if calc.TaxableIncomeCents != 11000000 {
+		t.Errorf("TaxableIncomeCents = %d, want 11000000", calc.TaxableIncomeCents)
+	}
+
+	t.Run("rejects negative capital gains", func(t *testing.T) {
+		_, err := svc.GetTaxEstimate(ctx, connect.NewRequest(&pfinancev1.GetTaxEstimateRequest{
+			FinancialYear:     "2024-25",
+			CapitalGainsCents: -100,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+}
+
 func TestTaxEstimate_WithHELP(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -939,6 +1372,8 @@ func TestTaxEstimate_WithHELP(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	// Without a tax config the fiscal year starts in July
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), "").Return(nil, fmt.Errorf("not found")).AnyTimes()
 
 	userID := "tax-user"
 	ctx := testProContext(userID)
@@ -971,6 +1406,8 @@ func TestTaxEstimate_WithMedicareExemption(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	// Without a tax config the fiscal year starts in July
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), "").Return(nil, fmt.Errorf("not found")).AnyTimes()
 
 	userID := "tax-user"
 	ctx := testProContext(userID)
@@ -1003,6 +1440,8 @@ func TestTaxEstimate_InvalidFY(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	// Without a tax config the fiscal year starts in July
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), "").Return(nil, fmt.Errorf("not found")).AnyTimes()
 
 	ctx := testProContext("tax-user")
 
@@ -1030,6 +1469,8 @@ func TestTaxGetTaxSummary_NoIncome(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	// Without a tax config the fiscal year starts in July
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), "").Return(nil, fmt.Errorf("not found")).AnyTimes()
 
 	userID := "tax-user"
 	ctx := testProContext(userID)
@@ -1037,7 +1478,7 @@ func TestTaxGetTaxSummary_NoIncome(t *testing.T) {
 	fyStart := time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)
 	fyEnd := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
 
-	mockStore.EXPECT().ListIncomes(gomock.Any(), userID, "", &fyStart, &fyEnd, int32(500), "").
+	mockStore.EXPECT().ListIncomes(gomock.Any(), userID, "", &fyStart, &fyEnd, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, int32(500), "").
 		Return([]*pfinancev1.Income{}, "", nil)
 	mockStore.EXPECT().AggregateDeductionsByCategory(gomock.Any(), userID, "", fyStart, fyEnd).
 		Return([]*pfinancev1.TaxDeductionSummary{}, nil)
@@ -1061,6 +1502,8 @@ func TestTaxGetTaxSummary_InvalidFY(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	// Without a tax config the fiscal year starts in July
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), "").Return(nil, fmt.Errorf("not found")).AnyTimes()
 
 	ctx := testProContext("tax-user")
 
@@ -1092,7 +1535,7 @@ func TestTaxBatchUpdate_OwnershipRejection(t *testing.T) {
 		UserId:      "different-user",
 		AmountCents: 5000,
 	}
-	mockStore.EXPECT().GetExpense(gomock.Any(), "exp-wrong-owner").Return(wrongOwnerExpense, nil)
+	mockStore.EXPECT().GetExpensesByIDs(gomock.Any(), []string{"exp-wrong-owner"}).Return(map[string]*pfinancev1.Expense{"exp-wrong-owner": wrongOwnerExpense}, nil)
 
 	resp, err := svc.BatchUpdateExpenseTaxStatus(ctx, connect.NewRequest(&pfinancev1.BatchUpdateExpenseTaxStatusRequest{
 		UserId: userID,
@@ -1130,7 +1573,7 @@ func TestTaxBatchUpdate_UpdateError(t *testing.T) {
 		UserId:      userID,
 		AmountCents: 5000,
 	}
-	mockStore.EXPECT().GetExpense(gomock.Any(), "exp-update-fail").Return(expense, nil)
+	mockStore.EXPECT().GetExpensesByIDs(gomock.Any(), []string{"exp-update-fail"}).Return(map[string]*pfinancev1.Expense{"exp-update-fail": expense}, nil)
 	mockStore.EXPECT().UpdateExpense(gomock.Any(), gomock.Any()).Return(fmt.Errorf("store error"))
 
 	resp, err := svc.BatchUpdateExpenseTaxStatus(ctx, connect.NewRequest(&pfinancev1.BatchUpdateExpenseTaxStatusRequest{
@@ -1157,6 +1600,7 @@ func TestTaxListDeductible_EmptyResults(t *testing.T) {
 	mockStore := store.NewMockStore(ctrl)
 	svc := NewFinanceService(mockStore, nil, nil)
 	mockStore.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	mockStore.EXPECT().GetTaxConfig(gomock.Any(), gomock.Any(), "").Return(nil, fmt.Errorf("not found")).AnyTimes()
 
 	userID := "tax-user"
 	ctx := testProContext(userID)
@@ -1164,7 +1608,7 @@ func TestTaxListDeductible_EmptyResults(t *testing.T) {
 	fyStart := time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)
 	fyEnd := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
 
-	mockStore.EXPECT().ListDeductibleExpenses(gomock.Any(), userID, "", &fyStart, &fyEnd, pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNSPECIFIED, int32(0), "").
+	mockStore.EXPECT().ListDeductibleExpenses(gomock.Any(), userID, "", &fyStart, &fyEnd, pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNSPECIFIED, int32(100), "").
 		Return([]*pfinancev1.Expense{}, "", nil)
 
 	resp, err := svc.ListDeductibleExpenses(ctx, connect.NewRequest(&pfinancev1.ListDeductibleExpensesRequest{
@@ -1187,7 +1631,7 @@ func TestTaxListDeductible_EmptyResults(t *testing.T) {
 // ============================================================================
 
 func TestParseFYDateRange_MismatchedYears(t *testing.T) {
-	_, _, err := parseFYDateRange("2024-99")
+	_, _, err := parseFYDateRange("2024-99", defaultFiscalYearStartMonth)
 	if err == nil {
 		t.Fatal("expected error for mismatched FY years 2024-99")
 	}
@@ -1195,3 +1639,258 @@ func TestParseFYDateRange_MismatchedYears(t *testing.T) {
 		t.Errorf("error message = %q, expected to contain 'does not match'", err.Error())
 	}
 }
+
+func TestTaxGetTaxSummary_FiscalYearStartMonth(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	userID := "tax-user"
+	ctx := testProContext(userID)
+
+	// A calendar-year business: "2025-26" runs January to December 2025
+	if err := memStore.UpdateTaxConfig(t.Context(), userID, "", &pfinancev1.TaxConfig{
+		Country: pfinancev1.TaxCountry_TAX_COUNTRY_AUSTRALIA, FiscalYearStartMonth: 1,
+	}); err != nil {
+		t.Fatalf("UpdateTaxConfig: %v", err)
+	}
+	for _, inc := range []*pfinancev1.Income{
+		{Id: "inc-jan", UserId: userID, AmountCents: 3000000, Date: timestamppb.New(time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC))},
+		{Id: "inc-dec", UserId: userID, AmountCents: 2000000, Date: timestamppb.New(time.Date(2025, time.December, 31, 12, 0, 0, 0, time.UTC))},
+		{Id: "inc-next", UserId: userID, AmountCents: 9000000, Date: timestamppb.New(time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC))},
+	} {
+		if err := memStore.CreateIncome(t.Context(), inc); err != nil {
+			t.Fatalf("CreateIncome: %v", err)
+		}
+	}
+
+	resp, err := svc.GetTaxSummary(ctx, connect.NewRequest(&pfinancev1.GetTaxSummaryRequest{FinancialYear: "2025-26"}))
+	if err != nil {
+		t.Fatalf("GetTaxSummary: %v", err)
+	}
+	if got := resp.Msg.Calculation.GrossIncomeCents; got != 5000000 {
+		t.Errorf("GrossIncomeCents = %d, want 5000000 from January to December", got)
+	}
+
+	start, end, err := parseFYDateRange("2025-26", time.April)
+	if err != nil {
+		t.Fatalf("parseFYDateRange: %v", err)
+	}
+	if !start.Equal(time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("April FY range = %v to %v", start, end)
+	}
+	if got := fiscalYearFor(time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC), time.April); got != "2025-26" {
+		t.Errorf("fiscalYearFor(March 31, April start) = %s, want 2025-26", got)
+	}
+	if got := fiscalYearStartMonthOf(&pfinancev1.TaxConfig{}); got != time.July {
+		t.Errorf("unset start month = %v, want July", got)
+	}
+}
+
+func TestCompareTaxYears(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	userID := "tax-user"
+	ctx := testProContext(userID)
+
+	incomes := []*pfinancev1.Income{
+		{Id: "inc-a", UserId: userID, AmountCents: 8000000, Date: timestamppb.New(time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC))},
+		{Id: "inc-b", UserId: userID, AmountCents: 10000000, Date: timestamppb.New(time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC))},
+	}
+	for _, inc := range incomes {
+		if err := memStore.CreateIncome(t.Context(), inc); err != nil {
+			t.Fatalf("CreateIncome: %v", err)
+		}
+	}
+	if err := memStore.CreateExpense(t.Context(), &pfinancev1.Expense{
+		Id: "exp-b", UserId: userID, AmountCents: 200000, Amount: 2000,
+		IsTaxDeductible: true, TaxDeductiblePercent: 1.0,
+		TaxDeductionCategory: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_SELF_EDUCATION,
+		Date:                 timestamppb.New(time.Date(2025, time.November, 1, 0, 0, 0, 0, time.UTC)),
+	}); err != nil {
+		t.Fatalf("CreateExpense: %v", err)
+	}
+
+	resp, err := svc.CompareTaxYears(ctx, connect.NewRequest(&pfinancev1.CompareTaxYearsRequest{
+		YearA: "2024-25",
+		YearB: "2025-26",
+	}))
+	if err != nil {
+		t.Fatalf("CompareTaxYears failed: %v", err)
+	}
+	cmp := resp.Msg.Comparison
+	a, b := cmp.CalculationA, cmp.CalculationB
+
+	if a.GrossIncomeCents != 8000000 || b.GrossIncomeCents != 10000000 {
+		t.Fatalf("gross incomes = %d/%d, want 8000000/10000000", a.GrossIncomeCents, b.GrossIncomeCents)
+	}
+	if b.TaxableIncomeCents != 9800000 {
+		t.Errorf("year B TaxableIncomeCents = %d, want 9800000", b.TaxableIncomeCents)
+	}
+	if cmp.TaxableIncomeChangeCents != b.TaxableIncomeCents-a.TaxableIncomeCents {
+		t.Errorf("TaxableIncomeChangeCents = %d, want %d", cmp.TaxableIncomeChangeCents, b.TaxableIncomeCents-a.TaxableIncomeCents)
+	}
+	if cmp.TaxChangeCents != b.TotalTaxCents-a.TotalTaxCents || cmp.TaxChangeCents <= 0 {
+		t.Errorf("TaxChangeCents = %d, want positive %d", cmp.TaxChangeCents, b.TotalTaxCents-a.TotalTaxCents)
+	}
+	if cmp.EffectiveRateChange != b.EffectiveRate-a.EffectiveRate {
+		t.Errorf("EffectiveRateChange = %v, want %v", cmp.EffectiveRateChange, b.EffectiveRate-a.EffectiveRate)
+	}
+
+	// The calculations must match what GetTaxEstimate produces for each year.
+	for _, tc := range []struct {
+		fy   string
+		calc *pfinancev1.TaxCalculation
+	}{{"2024-25", a}, {"2025-26", b}} {
+		est, err := svc.GetTaxEstimate(ctx, connect.NewRequest(&pfinancev1.GetTaxEstimateRequest{FinancialYear: tc.fy}))
+		if err != nil {
+			t.Fatalf("GetTaxEstimate(%s): %v", tc.fy, err)
+		}
+		if est.Msg.Calculation.TotalTaxCents != tc.calc.TotalTaxCents {
+			t.Errorf("%s: comparison tax %d differs from estimate %d", tc.fy, tc.calc.TotalTaxCents, est.Msg.Calculation.TotalTaxCents)
+		}
+	}
+
+	if len(cmp.CategoryDeltas) != 1 || cmp.CategoryDeltas[0].ChangeCents != 200000 {
+		t.Errorf("CategoryDeltas = %v, want single +200000 self-education delta", cmp.CategoryDeltas)
+	}
+
+	_, err = svc.CompareTaxYears(ctx, connect.NewRequest(&pfinancev1.CompareTaxYearsRequest{YearA: "2024-25", YearB: "bogus"}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("expected InvalidArgument for malformed year, got %v", err)
+	}
+}
+
+func TestGetDeductionProgress(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	userID := "tax-user"
+	ctx := testProContext(userID)
+
+	prior := time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+	current := time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "prior-edu", AmountCents: 100000, Date: timestamppb.New(prior), IsTaxDeductible: true,
+			TaxDeductionCategory: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_SELF_EDUCATION},
+		{Id: "prior-tools", AmountCents: 50000, Date: timestamppb.New(prior), IsTaxDeductible: true,
+			TaxDeductionCategory: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_OTHER_WORK},
+		{Id: "cur-edu", AmountCents: 180000, Date: timestamppb.New(current), IsTaxDeductible: true,
+			TaxDeductionCategory: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_SELF_EDUCATION},
+		{Id: "cur-review-1", AmountCents: 2000, Date: timestamppb.New(current), TaxNeedsReview: true},
+		{Id: "cur-review-2", AmountCents: 3000, Date: timestamppb.New(current), TaxNeedsReview: true},
+		{Id: "prior-review", AmountCents: 3000, Date: timestamppb.New(prior), TaxNeedsReview: true},
+	} {
+		e.UserId = userID
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+
+	progress := func(t *testing.T) *pfinancev1.GetDeductionProgressResponse {
+		t.Helper()
+		resp, err := svc.GetDeductionProgress(ctx, connect.NewRequest(&pfinancev1.GetDeductionProgressRequest{FinancialYear: "2025-26"}))
+		if err != nil {
+			t.Fatalf("GetDeductionProgress: %v", err)
+		}
+		return resp.Msg
+	}
+
+	got := progress(t)
+	if got.PriorFinancialYear != "2024-25" {
+		t.Errorf("PriorFinancialYear = %q, want 2024-25", got.PriorFinancialYear)
+	}
+	if got.CurrentTotalCents != 180000 || got.PriorTotalCents != 150000 || got.ChangeCents != 30000 {
+		t.Errorf("totals = %d vs %d (change %d), want 180000 vs 150000 (change 30000)",
+			got.CurrentTotalCents, got.PriorTotalCents, got.ChangeCents)
+	}
+	if got.ChangePercent != 20 {
+		t.Errorf("ChangePercent = %v, want 20", got.ChangePercent)
+	}
+	deltas := map[pfinancev1.TaxDeductionCategory]int64{}
+	for _, d := range got.CategoryDeltas {
+		deltas[d.Category] = d.ChangeCents
+	}
+	if deltas[pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_SELF_EDUCATION] != 80000 ||
+		deltas[pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_OTHER_WORK] != -50000 {
+		t.Errorf("CategoryDeltas = %v, want +80000 self-education and -50000 other work", got.CategoryDeltas)
+	}
+	if got.NeedsReviewCount != 2 {
+		t.Errorf("NeedsReviewCount = %d, want 2 from this FY", got.NeedsReviewCount)
+	}
+
+	t.Run("settling a flagged expense clears it from the count", func(t *testing.T) {
+		if _, err := svc.BatchUpdateExpenseTaxStatus(ctx, connect.NewRequest(&pfinancev1.BatchUpdateExpenseTaxStatusRequest{
+			Updates: []*pfinancev1.ExpenseTaxUpdate{{ExpenseId: "cur-review-1"}},
+		})); err != nil {
+			t.Fatalf("BatchUpdateExpenseTaxStatus: %v", err)
+		}
+		if got := progress(t).NeedsReviewCount; got != 1 {
+			t.Errorf("NeedsReviewCount = %d, want 1", got)
+		}
+	})
+
+	t.Run("rejects a malformed year", func(t *testing.T) {
+		_, err := svc.GetDeductionProgress(ctx, connect.NewRequest(&pfinancev1.GetDeductionProgressRequest{FinancialYear: "bogus"}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("code = %v, want InvalidArgument", connect.CodeOf(err))
+		}
+	})
+}
+
+func TestGetTaxDeductionChecklist(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	userID := "tax-user"
+	ctx := testProContext(userID)
+
+	for _, e := range []*pfinancev1.Expense{
+		{
+			Id: "scrubs", UserId: userID, AmountCents: 12000, Amount: 120,
+			IsTaxDeductible: true, TaxDeductiblePercent: 1.0,
+			TaxDeductionCategory: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNIFORM,
+			Date:                 timestamppb.New(time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			Id: "last-year", UserId: userID, AmountCents: 5000, Amount: 50,
+			IsTaxDeductible: true, TaxDeductiblePercent: 1.0,
+			TaxDeductionCategory: pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_DONATIONS,
+			Date:                 timestamppb.New(time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC)),
+		},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+
+	resp, err := svc.GetTaxDeductionChecklist(ctx, connect.NewRequest(&pfinancev1.GetTaxDeductionChecklistRequest{
+		FinancialYear: "2025-26",
+		Occupation:    "Registered Nurse",
+	}))
+	if err != nil {
+		t.Fatalf("GetTaxDeductionChecklist failed: %v", err)
+	}
+	if resp.Msg.MatchedOccupation != "nurse" {
+		t.Errorf("MatchedOccupation = %q, want nurse", resp.Msg.MatchedOccupation)
+	}
+
+	var claimed []pfinancev1.TaxDeductionCategory
+	for _, item := range resp.Msg.Items {
+		if item.HasExpenses {
+			claimed = append(claimed, item.Category)
+			if item.TotalCents != 12000 || item.ExpenseCount != 1 {
+				t.Errorf("%v: total = %d cents over %d expenses, want 12000 over 1", item.Category, item.TotalCents, item.ExpenseCount)
+			}
+		}
+	}
+	if !slices.Equal(claimed, []pfinancev1.TaxDeductionCategory{pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNIFORM}) {
+		t.Errorf("categories with expenses = %v, want only UNIFORM (the donation is in the prior FY)", claimed)
+	}
+	if want := int32(len(resp.Msg.Items) - 1); resp.Msg.MissingCount != want {
+		t.Errorf("MissingCount = %d, want %d", resp.Msg.MissingCount, want)
+	}
+
+	t.Run("requires pro", func(t *testing.T) {
+		_, err := svc.GetTaxDeductionChecklist(testContext(userID), connect.NewRequest(&pfinancev1.GetTaxDeductionChecklistRequest{}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+	})
+}
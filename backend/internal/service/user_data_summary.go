@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	gcsstorage "cloud.google.com/go/storage"
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/auth"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetUserDataSummary reports what data is held for the user: record counts per
+// collection, the span of their transactions and their attachment storage.
+// Its counts match what ClearUserData would delete.
+func (s *FinanceService) GetUserDataSummary(ctx context.Context, req *connect.Request[pfinancev1.GetUserDataSummaryRequest]) (*connect.Response[pfinancev1.GetUserDataSummaryResponse], error) {
+	claims, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := req.Msg.UserId
+	if userID == "" {
+		userID = claims.UID
+	}
+	if userID != claims.UID {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot summarize another user's data"))
+	}
+
+	counts, err := s.countClearableData(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	notifications, _, err := s.store.ListNotifications(ctx, userID, false, pfinancev1.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED, 10000, "")
+	if err != nil {
+		return nil, auth.WrapStoreError("list notifications", err)
+	}
+	tokenCount, err := s.store.CountActiveApiTokens(ctx, userID)
+	if err != nil {
+		return nil, auth.WrapStoreError("count api tokens", err)
+	}
+
+	summary := &pfinancev1.GetUserDataSummaryResponse{
+		ExpenseCount:              counts.ExpenseCount,
+		IncomeCount:               counts.IncomeCount,
+		BudgetCount:               counts.BudgetCount,
+		GoalCount:                 counts.GoalCount,
+		RecurringTransactionCount: counts.RecurringTransactionCount,
+		NotificationCount:         int64(len(notifications)),
+		ApiTokenCount:             int64(tokenCount),
+	}
+
+	var earliest, latest time.Time
+	observe := func(ts *timestamppb.Timestamp) {
+		if ts == nil {
+			return
+		}
+		t := ts.AsTime()
+		if earliest.IsZero() || t.Before(earliest) {
+			earliest = t
+		}
+		if latest.IsZero() || t.After(latest) {
+			latest = t
+		}
+	}
+
+	var attachmentPaths []string
+	var pageToken string
+	for {
+		expenses, nextToken, err := s.store.ListExpenses(ctx, userID, "", store.ExpenseQuery{}, 500, pageToken)
+		if err != nil {
+			return nil, auth.WrapStoreError("list expenses", err)
+		}
+		for _, e := range expenses {
+			observe(e.Date)
+			if e.ReceiptStoragePath != "" {
+				attachmentPaths = append(attachmentPaths, e.ReceiptStoragePath)
+			}
+			for _, a := range e.Attachments {
+				if a.StoragePath != "" {
+					attachmentPaths = append(attachmentPaths, a.StoragePath)
+				}
+			}
+		}
+		if nextToken == "" {
+			break
+		}
+		pageToken = nextToken
+	}
+
+	pageToken = ""
+	for {
+		incomes, nextToken, err := s.store.ListIncomes(ctx, userID, "", nil, nil, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 500, pageToken)
+		if err != nil {
+			return nil, auth.WrapStoreError("list incomes", err)
+		}
+		for _, inc := range incomes {
+			observe(inc.Date)
+		}
+		if nextToken == "" {
+			break
+		}
+		pageToken = nextToken
+	}
+
+	if !earliest.IsZero() {
+		summary.EarliestTransactionDate = timestamppb.New(earliest)
+		summary.LatestTransactionDate = timestamppb.New(latest)
+	}
+	summary.AttachmentCount = int64(len(attachmentPaths))
+
+	if s.storageBucket != nil {
+		size, err := s.attachmentStorageBytes(ctx, attachmentPaths)
+		if err != nil {
+			return nil, err
+		}
+		summary.AttachmentStorageBytes = &size
+	}
+
+	return connect.NewResponse(summary), nil
+}
+
+// attachmentStatConcurrency bounds the object metadata reads
+// attachmentStorageBytes has in flight at once.
+const attachmentStatConcurrency = 16
+
+// attachmentStorageBytes sums the stored size of the objects at paths. Objects
+// that no longer exist are skipped.
+func (s *FinanceService) attachmentStorageBytes(ctx context.Context, paths []string) (int64, error) {
+	var total atomic.Int64
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(attachmentStatConcurrency)
+	for _, path := range paths {
+		g.Go(func() error {
+			attrs, err := s.storageBucket.Object(path).Attrs(gctx)
+			if errors.Is(err, gcsstorage.ErrObjectNotExist) {
+				return nil
+			}
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, fmt.Errorf("stat attachment %s: %w", path, err))
+			}
+			total.Add(attrs.Size)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return 0, err
+	}
+	return total.Load(), nil
+}
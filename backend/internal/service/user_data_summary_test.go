@@ -0,0 +1,94 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/castlemilk/pfinance/backend/internal/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetUserDataSummary(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	svc := NewFinanceService(memStore, nil, nil)
+	ctx := testContext("user-1")
+
+	jan := time.Date(2025, time.January, 5, 0, 0, 0, 0, time.UTC)
+	jun := time.Date(2025, time.June, 20, 0, 0, 0, 0, time.UTC)
+	aug := time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, e := range []*pfinancev1.Expense{
+		{Id: "e1", UserId: "user-1", Description: "Laptop", Date: timestamppb.New(jun),
+			ReceiptStoragePath: "receipts/user-1/e1/receipt.jpg",
+			Attachments:        []*pfinancev1.AttachmentRef{{StoragePath: "receipts/user-1/e1/invoice.pdf"}}},
+		{Id: "e2", UserId: "user-1", Description: "Coffee", Date: timestamppb.New(jan)},
+		{Id: "e3", UserId: "user-1", Description: "Undated"},
+		{Id: "other", UserId: "user-2", Description: "Desk", Date: timestamppb.New(jan.AddDate(-1, 0, 0))},
+	} {
+		if err := memStore.CreateExpense(t.Context(), e); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+	if err := memStore.CreateIncome(t.Context(), &pfinancev1.Income{Id: "i1", UserId: "user-1", Amount: 5000, Date: timestamppb.New(aug)}); err != nil {
+		t.Fatalf("CreateIncome: %v", err)
+	}
+	if err := memStore.CreateBudget(t.Context(), &pfinancev1.Budget{Id: "b1", UserId: "user-1", Name: "Food", IsActive: true}); err != nil {
+		t.Fatalf("CreateBudget: %v", err)
+	}
+	if _, err := memStore.CreateNotification(t.Context(), &pfinancev1.Notification{Id: "n1", UserId: "user-1", Title: "Hello"}); err != nil {
+		t.Fatalf("CreateNotification: %v", err)
+	}
+	for _, tok := range []*pfinancev1.ApiToken{
+		{Id: "t1", UserId: "user-1", TokenHash: "hash-1"},
+		{Id: "t2", UserId: "user-1", TokenHash: "hash-2", IsRevoked: true},
+	} {
+		if err := memStore.CreateApiToken(t.Context(), tok); err != nil {
+			t.Fatalf("CreateApiToken: %v", err)
+		}
+	}
+
+	resp, err := svc.GetUserDataSummary(ctx, connect.NewRequest(&pfinancev1.GetUserDataSummaryRequest{}))
+	if err != nil {
+		t.Fatalf("GetUserDataSummary: %v", err)
+	}
+	got := resp.Msg
+
+	if got.ExpenseCount != 3 || got.IncomeCount != 1 || got.BudgetCount != 1 {
+		t.Errorf("counts = %d expenses, %d incomes, %d budgets; want 3, 1, 1", got.ExpenseCount, got.IncomeCount, got.BudgetCount)
+	}
+	if got.NotificationCount != 1 {
+		t.Errorf("notification_count = %d, want 1", got.NotificationCount)
+	}
+	if got.ApiTokenCount != 1 {
+		t.Errorf("api_token_count = %d, want 1 active token", got.ApiTokenCount)
+	}
+	if !got.EarliestTransactionDate.AsTime().Equal(jan) || !got.LatestTransactionDate.AsTime().Equal(aug) {
+		t.Errorf("transaction range = %v to %v, want %v to %v",
+			got.EarliestTransactionDate.AsTime(), got.LatestTransactionDate.AsTime(), jan, aug)
+	}
+	if got.AttachmentCount != 2 {
+		t.Errorf("attachment_count = %d, want 2", got.AttachmentCount)
+	}
+	if got.AttachmentStorageBytes != nil {
+		t.Errorf("attachment_storage_bytes = %d, want unset without storage", got.GetAttachmentStorageBytes())
+	}
+
+	t.Run("empty account has no transaction range", func(t *testing.T) {
+		resp, err := svc.GetUserDataSummary(testContext("user-3"), connect.NewRequest(&pfinancev1.GetUserDataSummaryRequest{}))
+		if err != nil {
+			t.Fatalf("GetUserDataSummary: %v", err)
+		}
+		if resp.Msg.EarliestTransactionDate != nil || resp.Msg.LatestTransactionDate != nil {
+			t.Errorf("expected no transaction range, got %v to %v", resp.Msg.EarliestTransactionDate, resp.Msg.LatestTransactionDate)
+		}
+	})
+
+	t.Run("cannot summarize another user", func(t *testing.T) {
+		_, err := svc.GetUserDataSummary(ctx, connect.NewRequest(&pfinancev1.GetUserDataSummaryRequest{UserId: "user-2"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("code = %v, want PermissionDenied", connect.CodeOf(err))
+		}
+	})
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"time"
 
 	"connectrpc.com/connect"
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
 	"github.com/castlemilk/pfinance/backend/internal/auth"
+	"github.com/castlemilk/pfinance/backend/internal/store"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -94,64 +96,23 @@ func (s *FinanceService) generateDigestForUser(ctx context.Context, userID strin
 	}
 
 	// Fetch expenses for the period
-	expenses, _, err := s.store.ListExpenses(ctx, userID, "", &start, &end, 1000, "")
+	expenses, _, err := s.store.ListExpenses(ctx, userID, "", store.ExpenseQuery{StartDate: &start, EndDate: &end}, 1000, "")
 	if err != nil {
 		return false, fmt.Errorf("failed to list expenses: %w", err)
 	}
 
 	// Fetch incomes for the period
-	incomes, _, err := s.store.ListIncomes(ctx, userID, "", &start, &end, 1000, "")
+	incomes, _, err := s.store.ListIncomes(ctx, userID, "", &start, &end, "", pfinancev1.SortField_SORT_FIELD_UNSPECIFIED, pfinancev1.SortDirection_SORT_DIRECTION_UNSPECIFIED, 1000, "")
 	if err != nil {
 		return false, fmt.Errorf("failed to list incomes: %w", err)
 	}
 
-	// Calculate totals
-	var totalSpentCents int64
-	categoryTotals := make(map[pfinancev1.ExpenseCategory]int64)
-	for _, e := range expenses {
-		cents := e.AmountCents
-		if cents == 0 {
-			cents = int64(e.Amount * 100)
-		}
-		totalSpentCents += cents
-		categoryTotals[e.Category] += cents
-	}
-
-	var totalIncomeCents int64
-	for _, i := range incomes {
-		cents := i.AmountCents
-		if cents == 0 {
-			cents = int64(i.Amount * 100)
-		}
-		totalIncomeCents += cents
-	}
-
-	// Build top categories (up to 5)
-	var topCategories []*pfinancev1.CategoryAmount
-	for cat, cents := range categoryTotals {
-		topCategories = append(topCategories, &pfinancev1.CategoryAmount{
-			Category:    cat,
-			AmountCents: cents,
-		})
-	}
-	// Sort by amount descending
-	for i := 0; i < len(topCategories); i++ {
-		for j := i + 1; j < len(topCategories); j++ {
-			if topCategories[j].AmountCents > topCategories[i].AmountCents {
-				topCategories[i], topCategories[j] = topCategories[j], topCategories[i]
-			}
-		}
-	}
-	if len(topCategories) > 5 {
-		topCategories = topCategories[:5]
-	}
-
-	// Fetch active budgets
+	// Fetch active budgets with their progress as of the period end
 	budgets, _, err := s.store.ListBudgets(ctx, userID, "", false, 100, "")
 	if err != nil {
 		log.Printf("[WeeklyDigest] Failed to list budgets for user %s: %v", userID, err)
 	}
-	var budgetSummaries []*pfinancev1.DigestBudgetSummary
+	var digestBudgets []DigestBudget
 	for _, b := range budgets {
 		if !b.IsActive {
 			continue
@@ -160,12 +121,7 @@ func (s *FinanceService) generateDigestForUser(ctx context.Context, userID strin
 		if err != nil {
 			continue
 		}
-		budgetSummaries = append(budgetSummaries, &pfinancev1.DigestBudgetSummary{
-			Name:           b.Name,
-			SpentCents:     progress.SpentAmountCents,
-			BudgetCents:    b.AmountCents,
-			PercentageUsed: progress.PercentageUsed,
-		})
+		digestBudgets = append(digestBudgets, DigestBudget{Budget: b, Progress: progress})
 	}
 
 	// Fetch active goals
@@ -176,50 +132,17 @@ func (s *FinanceService) generateDigestForUser(ctx context.Context, userID strin
 	if err != nil {
 		log.Printf("[WeeklyDigest] Failed to list goals for user %s: %v", userID, err)
 	}
-	var goalSummaries []*pfinancev1.DigestGoalSummary
-	for _, g := range goals {
-		pct := float64(0)
-		if g.TargetAmountCents > 0 {
-			pct = float64(g.CurrentAmountCents) / float64(g.TargetAmountCents) * 100
-		}
-		goalSummaries = append(goalSummaries, &pfinancev1.DigestGoalSummary{
-			Name:               g.Name,
-			CurrentCents:       g.CurrentAmountCents,
-			TargetCents:        g.TargetAmountCents,
-			PercentageComplete: pct,
-		})
-	}
 
-	// Count upcoming bills (next 7 days)
+	// Fetch active recurring transactions for upcoming bills
 	rts, _, err := s.store.ListRecurringTransactions(ctx, userID, "",
 		pfinancev1.RecurringTransactionStatus_RECURRING_TRANSACTION_STATUS_ACTIVE,
 		true, true, 100, "")
 	if err != nil {
 		log.Printf("[WeeklyDigest] Failed to list recurring transactions for user %s: %v", userID, err)
 	}
-	var upcomingBillsCount int32
-	weekAhead := end.AddDate(0, 0, 7)
-	for _, rt := range rts {
-		if rt.NextOccurrence != nil {
-			nextOcc := rt.NextOccurrence.AsTime()
-			if nextOcc.After(end) && nextOcc.Before(weekAhead) {
-				upcomingBillsCount++
-			}
-		}
-	}
 
-	// Build digest data
-	digestData := &pfinancev1.WeeklyDigestData{
-		TotalSpentCents:    totalSpentCents,
-		TotalIncomeCents:   totalIncomeCents,
-		NetCents:           totalIncomeCents - totalSpentCents,
-		TopCategories:      topCategories,
-		BudgetSummaries:    budgetSummaries,
-		GoalSummaries:      goalSummaries,
-		UpcomingBillsCount: upcomingBillsCount,
-		PeriodStart:        start.Format("2006-01-02"),
-		PeriodEnd:          end.Format("2006-01-02"),
-	}
+	summary := BuildWeeklyDigest(start, end, expenses, incomes, digestBudgets, goals, rts)
+	digestData := summary.Data()
 
 	// Serialize to JSON for metadata
 	digestJSON, err := json.Marshal(digestData)
@@ -235,7 +158,7 @@ func (s *FinanceService) generateDigestForUser(ctx context.Context, userID strin
 		UserId:        userID,
 		Type:          pfinancev1.NotificationType_NOTIFICATION_TYPE_WEEKLY_DIGEST,
 		Title:         "Your Weekly Financial Summary",
-		Message:       fmt.Sprintf("You spent $%.2f and earned $%.2f this week.", float64(totalSpentCents)/100, float64(totalIncomeCents)/100),
+		Message:       summary.Message(),
 		IsRead:        false,
 		ActionUrl:     "/personal/notifications/",
 		ReferenceType: "weekly_digest",
@@ -243,9 +166,174 @@ func (s *FinanceService) generateDigestForUser(ctx context.Context, userID strin
 		Metadata:      map[string]string{"digest_data": string(digestJSON)},
 	}
 
-	if err := s.store.CreateNotification(ctx, notification); err != nil {
+	if _, err := s.store.CreateNotification(ctx, notification); err != nil {
 		return false, fmt.Errorf("failed to create digest notification: %w", err)
 	}
 
 	return true, nil
 }
+
+// digestTopCategoryLimit is the number of spending categories highlighted in a digest.
+const digestTopCategoryLimit = 3
+
+// DigestBudget pairs an active budget with its progress at the end of the digest period.
+type DigestBudget struct {
+	Budget   *pfinancev1.Budget
+	Progress *pfinancev1.BudgetProgress
+}
+
+// DigestSummary is the computed content of a weekly digest, independent of how
+// it is delivered. Amounts are in cents.
+type DigestSummary struct {
+	PeriodStart      time.Time
+	PeriodEnd        time.Time
+	TotalSpentCents  int64
+	TotalIncomeCents int64
+	NetCents         int64
+	// TopCategories holds the highest-spend categories, largest first.
+	TopCategories []*pfinancev1.CategoryAmount
+	// Budgets summarises every active budget; OverBudget is the subset whose
+	// spend exceeds the allocated amount.
+	Budgets    []*pfinancev1.DigestBudgetSummary
+	OverBudget []*pfinancev1.DigestBudgetSummary
+	Goals      []*pfinancev1.DigestGoalSummary
+	// UpcomingBills are recurring transactions due within 7 days after the
+	// period end, soonest first.
+	UpcomingBills []*pfinancev1.RecurringTransaction
+}
+
+// BuildWeeklyDigest assembles the digest for the period [start, end] from
+// already-fetched data. It performs no I/O so it can be reused by any delivery
+// channel and tested in isolation.
+func BuildWeeklyDigest(
+	start, end time.Time,
+	expenses []*pfinancev1.Expense,
+	incomes []*pfinancev1.Income,
+	budgets []DigestBudget,
+	goals []*pfinancev1.FinancialGoal,
+	recurring []*pfinancev1.RecurringTransaction,
+) *DigestSummary {
+	summary := &DigestSummary{PeriodStart: start, PeriodEnd: end}
+
+	categoryTotals := make(map[pfinancev1.ExpenseCategory]*pfinancev1.CategoryAmount)
+	for _, e := range expenses {
+		cents := e.AmountCents
+		if cents == 0 {
+			cents = int64(e.Amount * 100)
+		}
+		summary.TotalSpentCents += cents
+		ca, ok := categoryTotals[e.Category]
+		if !ok {
+			ca = &pfinancev1.CategoryAmount{Category: e.Category}
+			categoryTotals[e.Category] = ca
+		}
+		ca.AmountCents += cents
+		ca.Count++
+	}
+
+	for _, i := range incomes {
+		cents := i.AmountCents
+		if cents == 0 {
+			cents = int64(i.Amount * 100)
+		}
+		summary.TotalIncomeCents += cents
+	}
+	summary.NetCents = summary.TotalIncomeCents - summary.TotalSpentCents
+
+	for _, ca := range categoryTotals {
+		ca.Amount = float64(ca.AmountCents) / 100
+		summary.TopCategories = append(summary.TopCategories, ca)
+	}
+	sort.Slice(summary.TopCategories, func(i, j int) bool {
+		a, b := summary.TopCategories[i], summary.TopCategories[j]
+		if a.AmountCents != b.AmountCents {
+			return a.AmountCents > b.AmountCents
+		}
+		return a.Category < b.Category
+	})
+	if len(summary.TopCategories) > digestTopCategoryLimit {
+		summary.TopCategories = summary.TopCategories[:digestTopCategoryLimit]
+	}
+
+	for _, db := range budgets {
+		if db.Budget == nil || db.Progress == nil {
+			continue
+		}
+		budgetCents := db.Budget.AmountCents
+		if budgetCents == 0 {
+			budgetCents = int64(db.Budget.Amount * 100)
+		}
+		spentCents := db.Progress.SpentAmountCents
+		if spentCents == 0 {
+			spentCents = int64(db.Progress.SpentAmount * 100)
+		}
+		bs := &pfinancev1.DigestBudgetSummary{
+			Name:           db.Budget.Name,
+			SpentCents:     spentCents,
+			BudgetCents:    budgetCents,
+			PercentageUsed: db.Progress.PercentageUsed,
+		}
+		summary.Budgets = append(summary.Budgets, bs)
+		if budgetCents > 0 && spentCents > budgetCents {
+			summary.OverBudget = append(summary.OverBudget, bs)
+		}
+	}
+
+	for _, g := range goals {
+		pct := float64(0)
+		if g.TargetAmountCents > 0 {
+			pct = float64(g.CurrentAmountCents) / float64(g.TargetAmountCents) * 100
+		}
+		summary.Goals = append(summary.Goals, &pfinancev1.DigestGoalSummary{
+			Name:               g.Name,
+			CurrentCents:       g.CurrentAmountCents,
+			TargetCents:        g.TargetAmountCents,
+			PercentageComplete: pct,
+		})
+	}
+
+	weekAhead := end.AddDate(0, 0, 7)
+	for _, rt := range recurring {
+		if rt.NextOccurrence == nil {
+			continue
+		}
+		nextOcc := rt.NextOccurrence.AsTime()
+		if nextOcc.After(end) && nextOcc.Before(weekAhead) {
+			summary.UpcomingBills = append(summary.UpcomingBills, rt)
+		}
+	}
+	sort.SliceStable(summary.UpcomingBills, func(i, j int) bool {
+		return summary.UpcomingBills[i].NextOccurrence.AsTime().Before(summary.UpcomingBills[j].NextOccurrence.AsTime())
+	})
+
+	return summary
+}
+
+// Data converts the summary into the structured payload stored in the
+// notification metadata.
+func (d *DigestSummary) Data() *pfinancev1.WeeklyDigestData {
+	return &pfinancev1.WeeklyDigestData{
+		TotalSpentCents:    d.TotalSpentCents,
+		TotalIncomeCents:   d.TotalIncomeCents,
+		NetCents:           d.NetCents,
+		TopCategories:      d.TopCategories,
+		BudgetSummaries:    d.Budgets,
+		GoalSummaries:      d.Goals,
+		UpcomingBillsCount: int32(len(d.UpcomingBills)),
+		PeriodStart:        d.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:          d.PeriodEnd.Format("2006-01-02"),
+	}
+}
+
+// Message returns the one-line notification text for the digest.
+func (d *DigestSummary) Message() string {
+	msg := fmt.Sprintf("You spent $%.2f and earned $%.2f this week.",
+		float64(d.TotalSpentCents)/100, float64(d.TotalIncomeCents)/100)
+	switch n := len(d.OverBudget); {
+	case n == 1:
+		msg += fmt.Sprintf(" %s is over budget.", d.OverBudget[0].Name)
+	case n > 1:
+		msg += fmt.Sprintf(" %d budgets are over their limit.", n)
+	}
+	return msg
+}
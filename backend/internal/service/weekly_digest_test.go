@@ -0,0 +1,124 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBuildWeeklyDigest(t *testing.T) {
+	end := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	start := end.AddDate(0, 0, -7)
+
+	expenses := []*pfinancev1.Expense{
+		{AmountCents: 5000, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+		{AmountCents: 2500, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD},
+		{AmountCents: 3000, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_TRANSPORTATION},
+		{Amount: 12.50, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT},
+		{AmountCents: 1000, Category: pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_SHOPPING},
+	}
+	incomes := []*pfinancev1.Income{
+		{AmountCents: 100000},
+		{Amount: 250},
+	}
+	budgets := []DigestBudget{
+		{
+			Budget:   &pfinancev1.Budget{Name: "Groceries", AmountCents: 40000},
+			Progress: &pfinancev1.BudgetProgress{SpentAmountCents: 45000, PercentageUsed: 112.5},
+		},
+		{
+			Budget:   &pfinancev1.Budget{Name: "Fun", Amount: 200},
+			Progress: &pfinancev1.BudgetProgress{SpentAmount: 50, PercentageUsed: 25},
+		},
+	}
+	goals := []*pfinancev1.FinancialGoal{
+		{Name: "Holiday", CurrentAmountCents: 25000, TargetAmountCents: 100000},
+	}
+	recurring := []*pfinancev1.RecurringTransaction{
+		{Id: "later", NextOccurrence: timestamppb.New(end.AddDate(0, 0, 5))},
+		{Id: "soon", NextOccurrence: timestamppb.New(end.AddDate(0, 0, 1))},
+		{Id: "too-far", NextOccurrence: timestamppb.New(end.AddDate(0, 0, 10))},
+		{Id: "past", NextOccurrence: timestamppb.New(end.AddDate(0, 0, -1))},
+		{Id: "no-date"},
+	}
+
+	d := BuildWeeklyDigest(start, end, expenses, incomes, budgets, goals, recurring)
+
+	if d.TotalSpentCents != 12750 {
+		t.Errorf("TotalSpentCents = %d, want 12750", d.TotalSpentCents)
+	}
+	if d.TotalIncomeCents != 125000 {
+		t.Errorf("TotalIncomeCents = %d, want 125000", d.TotalIncomeCents)
+	}
+	if d.NetCents != 112250 {
+		t.Errorf("NetCents = %d, want 112250", d.NetCents)
+	}
+
+	wantTop := []struct {
+		category pfinancev1.ExpenseCategory
+		cents    int64
+		count    int32
+	}{
+		{pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_FOOD, 7500, 2},
+		{pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_TRANSPORTATION, 3000, 1},
+		{pfinancev1.ExpenseCategory_EXPENSE_CATEGORY_ENTERTAINMENT, 1250, 1},
+	}
+	if len(d.TopCategories) != len(wantTop) {
+		t.Fatalf("TopCategories has %d entries, want %d", len(d.TopCategories), len(wantTop))
+	}
+	for i, want := range wantTop {
+		got := d.TopCategories[i]
+		if got.Category != want.category || got.AmountCents != want.cents || got.Count != want.count {
+			t.Errorf("TopCategories[%d] = %v/%d/%d, want %v/%d/%d",
+				i, got.Category, got.AmountCents, got.Count, want.category, want.cents, want.count)
+		}
+	}
+
+	if len(d.Budgets) != 2 {
+		t.Fatalf("Budgets has %d entries, want 2", len(d.Budgets))
+	}
+	if d.Budgets[1].BudgetCents != 20000 || d.Budgets[1].SpentCents != 5000 {
+		t.Errorf("Fun budget = %d/%d cents, want 5000/20000", d.Budgets[1].SpentCents, d.Budgets[1].BudgetCents)
+	}
+	if len(d.OverBudget) != 1 || d.OverBudget[0].Name != "Groceries" {
+		t.Errorf("OverBudget = %v, want only Groceries", d.OverBudget)
+	}
+
+	if len(d.Goals) != 1 || d.Goals[0].PercentageComplete != 25 {
+		t.Errorf("Goals = %v, want Holiday at 25%%", d.Goals)
+	}
+
+	if len(d.UpcomingBills) != 2 || d.UpcomingBills[0].Id != "soon" || d.UpcomingBills[1].Id != "later" {
+		t.Errorf("UpcomingBills = %v, want [soon later]", d.UpcomingBills)
+	}
+
+	data := d.Data()
+	if data.UpcomingBillsCount != 2 {
+		t.Errorf("UpcomingBillsCount = %d, want 2", data.UpcomingBillsCount)
+	}
+	if data.PeriodStart != "2026-03-08" || data.PeriodEnd != "2026-03-15" {
+		t.Errorf("period = %s..%s, want 2026-03-08..2026-03-15", data.PeriodStart, data.PeriodEnd)
+	}
+
+	wantMsg := "You spent $127.50 and earned $1250.00 this week. Groceries is over budget."
+	if got := d.Message(); got != wantMsg {
+		t.Errorf("Message() = %q, want %q", got, wantMsg)
+	}
+}
+
+func TestBuildWeeklyDigest_Empty(t *testing.T) {
+	end := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	d := BuildWeeklyDigest(end.AddDate(0, 0, -7), end, nil, nil, nil, nil, nil)
+
+	if d.TotalSpentCents != 0 || d.TotalIncomeCents != 0 || d.NetCents != 0 {
+		t.Errorf("totals = %d/%d/%d, want all zero", d.TotalSpentCents, d.TotalIncomeCents, d.NetCents)
+	}
+	if len(d.TopCategories) != 0 || len(d.OverBudget) != 0 || len(d.UpcomingBills) != 0 {
+		t.Errorf("expected empty collections, got %+v", d)
+	}
+	if got, want := d.Message(), "You spent $0.00 and earned $0.00 this week."; got != want {
+		t.Errorf("Message() = %q, want %q", got, want)
+	}
+}
@@ -3,11 +3,14 @@ package store
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/firestore/apiv1/firestorepb"
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -24,23 +27,32 @@ func NewFirestoreStore(client *firestore.Client) Store {
 	}
 }
 
-// applyDateAwarePagination handles pagination for queries with date range filters.
-// Firestore requires OrderBy on inequality fields first, so we use OrderBy("Date") + OrderBy(__name__).
-// The cursor must include both the Date value and the document ID.
+// applyDateAwarePagination orders a query by Date then document ID and resumes
+// after the page token's (date, ID) cursor, limiting to pageSize+1 docs so the
+// caller can detect a next page without reading the whole collection. Firestore
+// requires OrderBy on an inequality field first, so date-filtered queries must
+// use this. Callers build the next token with dateCursorFor.
 func (s *FirestoreStore) applyDateAwarePagination(ctx context.Context, query firestore.Query, collection string, pageSize int32, pageToken string) (firestore.Query, error) {
 	query = query.OrderBy("Date", firestore.Asc).OrderBy(firestore.DocumentID, firestore.Asc)
 
 	if pageToken != "" {
-		docID, err := DecodePageToken(pageToken)
+		date, docID, ok, err := decodeDateCursor(pageToken)
 		if err != nil {
 			return query, fmt.Errorf("invalid page token: %w", err)
 		}
-		// Fetch the cursor document to get its Date value for composite StartAfter
-		cursorDoc, err := s.client.Collection(collection).Doc(docID).Get(ctx)
-		if err != nil {
-			return query, fmt.Errorf("failed to fetch cursor document: %w", err)
+		var dateVal interface{}
+		if ok {
+			if !date.IsZero() {
+				dateVal = date
+			}
+		} else {
+			// Tokens issued before cursors carried the date: look it up
+			cursorDoc, err := s.client.Collection(collection).Doc(docID).Get(ctx)
+			if err != nil {
+				return query, fmt.Errorf("failed to fetch cursor document: %w", err)
+			}
+			dateVal = cursorDoc.Data()["Date"]
 		}
-		dateVal := cursorDoc.Data()["Date"]
 		query = query.StartAfter(dateVal, docID)
 	}
 
@@ -51,6 +63,13 @@ func (s *FirestoreStore) applyDateAwarePagination(ctx context.Context, query fir
 	return query, nil
 }
 
+// dateCursorFor returns the page token resuming after doc in a query built by
+// applyDateAwarePagination.
+func dateCursorFor(doc *firestore.DocumentSnapshot) string {
+	date, _ := doc.Data()["Date"].(time.Time)
+	return encodeDateCursor(date, doc.Ref.ID)
+}
+
 // applyCursorPagination adds OrderBy + StartAfter + Limit to a query for cursor-based pagination.
 // It fetches pageSize+1 docs so the caller can detect whether a next page exists.
 func (s *FirestoreStore) applyCursorPagination(query firestore.Query, pageSize int32, pageToken string) (firestore.Query, error) {
@@ -151,6 +170,43 @@ func (s *FirestoreStore) GetExpense(ctx context.Context, expenseID string) (*pfi
 	return &expense, nil
 }
 
+// GetExpensesByIDs fetches expenses by ID in a single batched read, checking
+// both the personal and group collections. IDs that don't exist are absent
+// from the result.
+func (s *FirestoreStore) GetExpensesByIDs(ctx context.Context, ids []string) (map[string]*pfinancev1.Expense, error) {
+	expenses := make(map[string]*pfinancev1.Expense, len(ids))
+	if len(ids) == 0 {
+		return expenses, nil
+	}
+
+	refs := make([]*firestore.DocumentRef, 0, 2*len(ids))
+	for _, id := range ids {
+		refs = append(refs,
+			s.client.Collection("expenses").Doc(id),
+			s.client.Collection("groupExpenses").Doc(id))
+	}
+	docs, err := s.client.GetAll(ctx, refs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expenses: %w", err)
+	}
+
+	for _, doc := range docs {
+		if !doc.Exists() {
+			continue
+		}
+		// Personal expenses win, matching GetExpense's lookup order
+		if _, ok := expenses[doc.Ref.ID]; ok {
+			continue
+		}
+		var expense pfinancev1.Expense
+		if err := doc.DataTo(&expense); err != nil {
+			return nil, fmt.Errorf("failed to parse expense: %w", err)
+		}
+		expenses[doc.Ref.ID] = &expense
+	}
+	return expenses, nil
+}
+
 // UpdateExpense updates an existing expense in Firestore
 func (s *FirestoreStore) UpdateExpense(ctx context.Context, expense *pfinancev1.Expense) error {
 	collection := "expenses"
@@ -163,98 +219,235 @@ func (s *FirestoreStore) UpdateExpense(ctx context.Context, expense *pfinancev1.
 }
 
 // ListExpenses lists expenses from Firestore
-func (s *FirestoreStore) ListExpenses(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, pageSize int32, pageToken string) ([]*pfinancev1.Expense, string, error) {
+func (s *FirestoreStore) ListExpenses(ctx context.Context, userID, groupID string, filter ExpenseQuery, pageSize int32, pageToken string) ([]*pfinancev1.Expense, string, error) {
+	scope := filter.Scope
+	if err := scope.Validate(userID, groupID); err != nil {
+		return nil, "", err
+	}
+
 	collection := "expenses"
 	if groupID != "" {
 		collection = "groupExpenses"
 	}
 
-	var query firestore.Query
-	query = s.client.Collection(collection).Query
-
-	// Apply filters
 	// NOTE: Field names must match Go struct field names (PascalCase) as that's how Firestore serializes protobuf structs
-	if groupID != "" {
-		query = query.Where("GroupId", "==", groupID)
-	} else if userID != "" {
-		query = query.Where("UserId", "==", userID)
+	var queries []firestore.Query
+	switch scope {
+	case ExpenseScopePersonal:
+		queries = append(queries, s.client.Collection("expenses").Where("UserId", "==", userID))
+	case ExpenseScopeGroup:
+		queries = append(queries, s.client.Collection("groupExpenses").Where("GroupId", "==", groupID))
+	case ExpenseScopeAll:
+		groupIDs, err := s.memberGroupIDs(ctx, userID)
+		if err != nil {
+			return nil, "", err
+		}
+		queries = append(queries, s.client.Collection("expenses").Where("UserId", "==", userID))
+		// Firestore "in" filters accept at most 30 values
+		for i := 0; i < len(groupIDs); i += 30 {
+			chunk := groupIDs[i:min(i+30, len(groupIDs))]
+			queries = append(queries, s.client.Collection("groupExpenses").Where("GroupId", "in", chunk))
+		}
+	default:
+		query := s.client.Collection(collection).Query
+		if groupID != "" {
+			query = query.Where("GroupId", "==", groupID)
+		} else if userID != "" {
+			query = query.Where("UserId", "==", userID)
+		}
+		queries = append(queries, query)
 	}
 
-	hasDateFilter := startDate != nil || endDate != nil
-	if startDate != nil {
-		query = query.Where("Date", ">=", *startDate)
-	}
-	if endDate != nil {
-		query = query.Where("Date", "<=", *endDate)
+	if pageSize <= 0 {
+		pageSize = 100
 	}
 
-	// When date range filters are present, Firestore requires OrderBy on the range
-	// field first. Use date-aware pagination to avoid "cannot contain more fields
-	// after the key" errors.
-	if hasDateFilter {
-		query, err := s.applyDateAwarePagination(ctx, query, collection, pageSize, pageToken)
+	// Each query pages by the same (Date, ID) cursor, so their pages can be
+	// merged and cut back to pageSize+1 without skipping anything.
+	var docs []*firestore.DocumentSnapshot
+	for _, query := range queries {
+		query, err := s.applyDateAwarePagination(ctx, filterExpenseQuery(query, filter), collection, pageSize, pageToken)
 		if err != nil {
 			return nil, "", err
 		}
-
-		docs, err := query.Documents(ctx).GetAll()
+		queryDocs, err := query.Documents(ctx).GetAll()
 		if err != nil {
 			return nil, "", fmt.Errorf("failed to list expenses: %w", err)
 		}
+		docs = append(docs, queryDocs...)
+	}
+	if len(queries) > 1 {
+		sort.Slice(docs, func(i, j int) bool {
+			di, _ := docs[i].Data()["Date"].(time.Time)
+			dj, _ := docs[j].Data()["Date"].(time.Time)
+			if !di.Equal(dj) {
+				return di.Before(dj)
+			}
+			return docs[i].Ref.ID < docs[j].Ref.ID
+		})
+	}
 
-		if pageSize <= 0 {
-			pageSize = 100
-		}
+	// Detect next page
+	var nextPageToken string
+	if len(docs) > int(pageSize) {
+		docs = docs[:pageSize]
+		nextPageToken = dateCursorFor(docs[pageSize-1])
+	}
 
-		var nextPageToken string
-		if len(docs) > int(pageSize) {
-			docs = docs[:pageSize]
-			nextPageToken = EncodePageToken(docs[pageSize-1].Ref.ID)
+	// The cursor is taken before match-all and attachment filtering, so a page
+	// may hold fewer than pageSize expenses while more remain.
+	expenses := make([]*pfinancev1.Expense, 0, len(docs))
+	for _, doc := range docs {
+		var expense pfinancev1.Expense
+		if err := doc.DataTo(&expense); err != nil {
+			return nil, "", fmt.Errorf("failed to parse expense: %w", err)
+		}
+		if !filter.Tags.Matches(expense.Tags) || !MatchesAttachment(&expense, filter.HasAttachment) {
+			continue
 		}
+		expenses = append(expenses, &expense)
+	}
 
-		expenses := make([]*pfinancev1.Expense, 0, len(docs))
+	return expenses, nextPageToken, nil
+}
+
+// filterExpenseQuery applies ListExpenses' category, tax, tag and date filters.
+func filterExpenseQuery(query firestore.Query, filter ExpenseQuery) firestore.Query {
+	if filter.Category != nil {
+		query = query.Where("Category", "==", int32(*filter.Category))
+	}
+	if filter.IsTaxDeductible != nil {
+		query = query.Where("IsTaxDeductible", "==", *filter.IsTaxDeductible)
+	}
+	// Firestore allows a single array filter per query, so array-contains-any
+	// narrows to expenses with at least one tag and match-all is finished in
+	// memory.
+	if filter.Tags != nil && len(filter.Tags.Tags) > 0 {
+		query = query.Where("Tags", "array-contains-any", filter.Tags.Tags)
+	}
+	if filter.StartDate != nil {
+		query = query.Where("Date", ">=", *filter.StartDate)
+	}
+	if filter.EndDate != nil {
+		query = query.Where("Date", "<=", *filter.EndDate)
+	}
+	return query
+}
+
+// memberGroupIDs returns the IDs of the groups userID belongs to.
+func (s *FirestoreStore) memberGroupIDs(ctx context.Context, userID string) ([]string, error) {
+	docs, err := s.client.Collection("financeGroups").
+		Where("MemberIds", "array-contains", userID).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user groups: %w", err)
+	}
+	ids := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.Ref.ID)
+	}
+	return ids, nil
+}
+
+// CountExpenses counts matching expenses with a server-side aggregation query
+func (s *FirestoreStore) CountExpenses(ctx context.Context, userID, groupID string, startDate, endDate *time.Time) (int64, error) {
+	count, err := countQuery(ctx, s.countRangeQuery("expenses", "groupExpenses", userID, groupID, startDate, endDate))
+	if err != nil {
+		return 0, fmt.Errorf("failed to count expenses: %w", err)
+	}
+	return count, nil
+}
+
+// ListExpensesMissingCents queries both expense collections for a zero
+// AmountCents and keeps those with a dollar amount, ordered by ID
+func (s *FirestoreStore) ListExpensesMissingCents(ctx context.Context, userID string) ([]*pfinancev1.Expense, error) {
+	var expenses []*pfinancev1.Expense
+	for _, collection := range []string{"expenses", "groupExpenses"} {
+		docs, err := s.missingCentsQuery(collection, userID).Documents(ctx).GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list expenses missing cents: %w", err)
+		}
 		for _, doc := range docs {
 			var expense pfinancev1.Expense
 			if err := doc.DataTo(&expense); err != nil {
-				return nil, "", fmt.Errorf("failed to parse expense: %w", err)
+				return nil, fmt.Errorf("failed to parse expense: %w", err)
+			}
+			if MissingCents(expense.AmountCents, expense.Amount) {
+				expenses = append(expenses, &expense)
 			}
-			expenses = append(expenses, &expense)
 		}
-		return expenses, nextPageToken, nil
 	}
+	sort.Slice(expenses, func(i, j int) bool {
+		return expenses[i].Id < expenses[j].Id
+	})
+	return expenses, nil
+}
 
-	query, err := s.applyCursorPagination(query, pageSize, pageToken)
-	if err != nil {
-		return nil, "", err
+// missingCentsQuery selects a collection's documents with a zero AmountCents,
+// optionally for one user. A zero Amount still has to be filtered out.
+func (s *FirestoreStore) missingCentsQuery(collection, userID string) firestore.Query {
+	query := s.client.Collection(collection).Where("AmountCents", "==", 0)
+	if userID != "" {
+		query = query.Where("UserId", "==", userID)
 	}
+	return query
+}
 
-	// Execute query
-	docs, err := query.Documents(ctx).GetAll()
+// HasMerchantExpense looks for a single expense with the description in the
+// date range, so at most one document is read.
+func (s *FirestoreStore) HasMerchantExpense(ctx context.Context, userID, groupID, description string, since, before time.Time) (bool, error) {
+	docs, err := s.ownedRangeQuery("expenses", "groupExpenses", userID, groupID, &since, nil).
+		Where("Date", "<", before).
+		Where("Description", "==", description).
+		Limit(1).Documents(ctx).GetAll()
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to list expenses: %w", err)
+		return false, fmt.Errorf("failed to check merchant history: %w", err)
 	}
+	return len(docs) > 0, nil
+}
 
-	if pageSize <= 0 {
-		pageSize = 100
+// ListTopExpenses returns the limit largest matching expenses, ordered by
+// AmountCents descending so only those documents are read. Ordering by
+// AmountCents leaves out legacy documents without the field, so until every
+// match is backfilled they are all read and ranked in memory by Amount, as
+// the memory store does.
+func (s *FirestoreStore) ListTopExpenses(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, category *pfinancev1.ExpenseCategory, limit int) ([]*pfinancev1.Expense, error) {
+	query := s.ownedRangeQuery("expenses", "groupExpenses", userID, groupID, startDate, endDate)
+	if category != nil {
+		query = query.Where("Category", "==", int32(*category))
 	}
-
-	// Detect next page
-	var nextPageToken string
-	if len(docs) > int(pageSize) {
-		docs = docs[:pageSize]
-		nextPageToken = EncodePageToken(docs[pageSize-1].Ref.ID)
+	backfilled, err := allHaveAmountCents(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list top expenses: %w", err)
+	}
+	if backfilled {
+		query = query.OrderBy("AmountCents", firestore.Desc).Limit(limit)
+	}
+	docs, err := query.Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list top expenses: %w", err)
 	}
 
 	expenses := make([]*pfinancev1.Expense, 0, len(docs))
 	for _, doc := range docs {
 		var expense pfinancev1.Expense
 		if err := doc.DataTo(&expense); err != nil {
-			return nil, "", fmt.Errorf("failed to parse expense: %w", err)
+			return nil, fmt.Errorf("failed to parse expense: %w", err)
 		}
 		expenses = append(expenses, &expense)
 	}
-
-	return expenses, nextPageToken, nil
+	if !backfilled {
+		sort.Slice(expenses, func(i, j int) bool {
+			ai, aj := expenseCents(expenses[i]), expenseCents(expenses[j])
+			if ai != aj {
+				return ai > aj
+			}
+			return expenses[i].Id < expenses[j].Id
+		})
+		if len(expenses) > limit {
+			expenses = expenses[:limit]
+		}
+	}
+	return expenses, nil
 }
 
 // DeleteExpense deletes an expense from Firestore
@@ -317,21 +510,151 @@ func (s *FirestoreStore) UpdateIncome(ctx context.Context, income *pfinancev1.In
 	return err
 }
 
-// DeleteIncome deletes an income from Firestore
+// DeleteIncome deletes an income and its income contributions from Firestore
 func (s *FirestoreStore) DeleteIncome(ctx context.Context, incomeID string) error {
-	// Try to delete from personal incomes first
-	_, err := s.client.Collection("incomes").Doc(incomeID).Delete(ctx)
-	if err == nil {
-		return nil
+	return s.BatchDeleteIncomes(ctx, []string{incomeID})
+}
+
+// BatchDeleteIncomes deletes multiple incomes in Firestore batch writes, along with
+// any income contributions that reference them as the source or resulting group income.
+// Tries both personal and group income collections.
+func (s *FirestoreStore) BatchDeleteIncomes(ctx context.Context, incomeIDs []string) error {
+	contributionRefs, err := s.incomeContributionRefs(ctx, incomeIDs)
+	if err != nil {
+		return err
 	}
 
-	// Try group incomes
-	_, err = s.client.Collection("groupIncomes").Doc(incomeID).Delete(ctx)
-	return err
+	refs := make([]*firestore.DocumentRef, 0, 2*len(incomeIDs)+len(contributionRefs))
+	for _, id := range incomeIDs {
+		// Delete from both collections — Firestore ignores deletes on non-existent docs
+		refs = append(refs,
+			s.client.Collection("incomes").Doc(id),
+			s.client.Collection("groupIncomes").Doc(id))
+	}
+	refs = append(refs, contributionRefs...)
+
+	for i := 0; i < len(refs); i += 500 {
+		batch := s.client.Batch()
+		end := min(i+500, len(refs))
+		for _, ref := range refs[i:end] {
+			batch.Delete(ref)
+		}
+		if _, err := batch.Commit(ctx); err != nil {
+			return fmt.Errorf("batch delete incomes (chunk %d): %w", i/500, err)
+		}
+	}
+	return nil
+}
+
+// incomeContributionRefs returns the income contributions whose source or
+// resulting group income is one of incomeIDs.
+func (s *FirestoreStore) incomeContributionRefs(ctx context.Context, incomeIDs []string) ([]*firestore.DocumentRef, error) {
+	seen := make(map[string]bool)
+	var refs []*firestore.DocumentRef
+	// Firestore "in" filters accept at most 30 values
+	for i := 0; i < len(incomeIDs); i += 30 {
+		chunk := incomeIDs[i:min(i+30, len(incomeIDs))]
+		for _, field := range []string{"SourceIncomeId", "CreatedGroupIncomeId"} {
+			docs, err := s.client.Collection("incomeContributions").
+				Where(field, "in", chunk).Documents(ctx).GetAll()
+			if err != nil {
+				return nil, fmt.Errorf("failed to find income contributions: %w", err)
+			}
+			for _, doc := range docs {
+				if !seen[doc.Ref.ID] {
+					seen[doc.Ref.ID] = true
+					refs = append(refs, doc.Ref)
+				}
+			}
+		}
+	}
+	return refs, nil
+}
+
+// CountIncomes counts matching incomes with a server-side aggregation query
+func (s *FirestoreStore) CountIncomes(ctx context.Context, userID, groupID string, startDate, endDate *time.Time) (int64, error) {
+	count, err := countQuery(ctx, s.countRangeQuery("incomes", "groupIncomes", userID, groupID, startDate, endDate))
+	if err != nil {
+		return 0, fmt.Errorf("failed to count incomes: %w", err)
+	}
+	return count, nil
+}
+
+// ListIncomesMissingCents queries both income collections for a zero
+// AmountCents and keeps those with a dollar amount, ordered by ID
+func (s *FirestoreStore) ListIncomesMissingCents(ctx context.Context, userID string) ([]*pfinancev1.Income, error) {
+	var incomes []*pfinancev1.Income
+	for _, collection := range []string{"incomes", "groupIncomes"} {
+		docs, err := s.missingCentsQuery(collection, userID).Documents(ctx).GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list incomes missing cents: %w", err)
+		}
+		for _, doc := range docs {
+			var income pfinancev1.Income
+			if err := doc.DataTo(&income); err != nil {
+				return nil, fmt.Errorf("failed to parse income: %w", err)
+			}
+			if MissingCents(income.AmountCents, income.Amount) {
+				incomes = append(incomes, &income)
+			}
+		}
+	}
+	sort.Slice(incomes, func(i, j int) bool {
+		return incomes[i].Id < incomes[j].Id
+	})
+	return incomes, nil
+}
+
+// countRangeQuery is ownedRangeQuery for CountExpenses and CountIncomes, which
+// narrow a group's records to userID's when both IDs are set.
+func (s *FirestoreStore) countRangeQuery(personal, group, userID, groupID string, startDate, endDate *time.Time) firestore.Query {
+	query := s.ownedRangeQuery(personal, group, userID, groupID, startDate, endDate)
+	if groupID != "" && userID != "" {
+		query = query.Where("UserId", "==", userID)
+	}
+	return query
+}
+
+// ownedRangeQuery scopes a personal or group collection to an owner and an
+// optional Date range, matching the filters ListExpenses and ListIncomes apply.
+func (s *FirestoreStore) ownedRangeQuery(personal, group, userID, groupID string, startDate, endDate *time.Time) firestore.Query {
+	query := s.client.Collection(personal).Query
+	if groupID != "" {
+		query = s.client.Collection(group).Where("GroupId", "==", groupID)
+	} else if userID != "" {
+		query = query.Where("UserId", "==", userID)
+	}
+	if startDate != nil {
+		query = query.Where("Date", ">=", *startDate)
+	}
+	if endDate != nil {
+		query = query.Where("Date", "<=", *endDate)
+	}
+	return query
+}
+
+// countQuery runs a COUNT aggregation, which is billed per batch of index
+// entries rather than per document read.
+func countQuery(ctx context.Context, query firestore.Query) (int64, error) {
+	result, err := query.NewAggregationQuery().WithCount("count").Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	value, ok := result["count"].(*firestorepb.Value)
+	if !ok {
+		return 0, fmt.Errorf("unexpected count result %T", result["count"])
+	}
+	return value.GetIntegerValue(), nil
 }
 
-// ListIncomes lists incomes from Firestore
-func (s *FirestoreStore) ListIncomes(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, pageSize int32, pageToken string) ([]*pfinancev1.Income, string, error) {
+// ListIncomes lists incomes from Firestore. A sort field orders by Date or
+// AmountCents in Firestore and pages with a (value, ID) cursor. Until the
+// cents backfill has reached every matching income, an amount sort reads the
+// matches and sorts them in memory instead, so incomes without AmountCents
+// rank by Amount as they do in the memory store. Firestore can't match a
+// case-insensitive substring, so the source filter is applied as documents
+// are read, fetching further batches until the page is full.
+func (s *FirestoreStore) ListIncomes(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, source string, sortField pfinancev1.SortField, sortDirection pfinancev1.SortDirection, pageSize int32, pageToken string) ([]*pfinancev1.Income, string, error) {
 	collection := "incomes"
 	if groupID != "" {
 		collection = "groupIncomes"
@@ -356,61 +679,76 @@ func (s *FirestoreStore) ListIncomes(ctx context.Context, userID, groupID string
 		query = query.Where("Date", "<=", *endDate)
 	}
 
-	// When date range filters are present, Firestore requires OrderBy on the range
-	// field first. Use date-aware pagination to avoid query ordering conflicts.
-	if hasDateFilter {
-		query, err := s.applyDateAwarePagination(ctx, query, collection, pageSize, pageToken)
-		if err != nil {
-			return nil, "", err
-		}
-
-		docs, err := query.Documents(ctx).GetAll()
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+	if sortField == pfinancev1.SortField_SORT_FIELD_AMOUNT {
+		backfilled, err := allHaveAmountCents(ctx, query)
 		if err != nil {
 			return nil, "", fmt.Errorf("failed to list incomes: %w", err)
 		}
-
-		if pageSize <= 0 {
-			pageSize = 100
+		if !backfilled {
+			return listIncomesInMemory(ctx, query, source, sortField, sortDirection, pageSize, pageToken)
 		}
+	}
+	query, cursorFor, err := s.orderIncomeQuery(ctx, query, collection, sortField, sortDirection, hasDateFilter, pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
 
-		var nextPageToken string
-		if len(docs) > int(pageSize) {
-			docs = docs[:pageSize]
-			nextPageToken = EncodePageToken(docs[pageSize-1].Ref.ID)
+	// Read pageSize+1 matches: the extra one only shows another page exists,
+	// and the token resumes after the last document read before it.
+	needle := strings.ToLower(strings.TrimSpace(source))
+	batch := int(pageSize) + 1
+	incomes := make([]*pfinancev1.Income, 0, pageSize)
+	var last *firestore.DocumentSnapshot
+	for {
+		docs, err := query.Limit(batch).Documents(ctx).GetAll()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to list incomes: %w", err)
 		}
-
-		incomes := make([]*pfinancev1.Income, 0, len(docs))
 		for _, doc := range docs {
 			var income pfinancev1.Income
 			if err := doc.DataTo(&income); err != nil {
 				return nil, "", fmt.Errorf("failed to parse income: %w", err)
 			}
-			incomes = append(incomes, &income)
+			if needle == "" || strings.Contains(strings.ToLower(income.Source), needle) {
+				if len(incomes) == int(pageSize) {
+					return incomes, cursorFor(last), nil
+				}
+				incomes = append(incomes, &income)
+			}
+			last = doc
+		}
+		if len(docs) < batch {
+			return incomes, "", nil
 		}
-		return incomes, nextPageToken, nil
+		query = query.StartAfter(last)
 	}
+}
 
-	query, err := s.applyCursorPagination(query, pageSize, pageToken)
+// allHaveAmountCents reports whether every document the query matches has a
+// positive AmountCents, so ordering by it in Firestore neither drops documents
+// missing the field nor ranks legacy ones as zero.
+func allHaveAmountCents(ctx context.Context, query firestore.Query) (bool, error) {
+	total, err := countQuery(ctx, query)
 	if err != nil {
-		return nil, "", err
+		return false, err
+	}
+	withCents, err := countQuery(ctx, query.Where("AmountCents", ">", 0))
+	if err != nil {
+		return false, err
 	}
+	return withCents == total, nil
+}
 
-	// Execute query
+// listIncomesInMemory reads every income the query matches, then filters,
+// sorts and pages them in memory with an offset page token.
+func listIncomesInMemory(ctx context.Context, query firestore.Query, source string, sortField pfinancev1.SortField, sortDirection pfinancev1.SortDirection, pageSize int32, pageToken string) ([]*pfinancev1.Income, string, error) {
 	docs, err := query.Documents(ctx).GetAll()
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to list incomes: %w", err)
 	}
-
-	if pageSize <= 0 {
-		pageSize = 100
-	}
-
-	var nextPageToken string
-	if len(docs) > int(pageSize) {
-		docs = docs[:pageSize]
-		nextPageToken = EncodePageToken(docs[pageSize-1].Ref.ID)
-	}
-
 	incomes := make([]*pfinancev1.Income, 0, len(docs))
 	for _, doc := range docs {
 		var income pfinancev1.Income
@@ -420,7 +758,92 @@ func (s *FirestoreStore) ListIncomes(ctx context.Context, userID, groupID string
 		incomes = append(incomes, &income)
 	}
 
-	return incomes, nextPageToken, nil
+	incomes = filterAndSortIncomes(incomes, source, sortField, sortDirection)
+	start, end, nextPageToken, err := paginateByOffset(len(incomes), pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return incomes[start:end], nextPageToken, nil
+}
+
+// orderIncomeQuery orders a ListIncomes query and resumes it after pageToken,
+// returning the function that builds the page token for a document. Without a
+// sort field it keeps the Date or document ID order used before sorting
+// existed. Sorts default to descending.
+func (s *FirestoreStore) orderIncomeQuery(ctx context.Context, query firestore.Query, collection string, sortField pfinancev1.SortField, sortDirection pfinancev1.SortDirection, hasDateFilter bool, pageSize int32, pageToken string) (firestore.Query, func(*firestore.DocumentSnapshot) string, error) {
+	dir := firestore.Desc
+	if sortDirection == pfinancev1.SortDirection_SORT_DIRECTION_ASC {
+		dir = firestore.Asc
+	}
+
+	switch sortField {
+	case pfinancev1.SortField_SORT_FIELD_AMOUNT:
+		query = query.OrderBy("AmountCents", dir).OrderBy(firestore.DocumentID, dir)
+		if pageToken != "" {
+			cents, docID, err := decodeAmountCursor(pageToken)
+			if err != nil {
+				return query, nil, fmt.Errorf("invalid page token: %w", err)
+			}
+			query = query.StartAfter(cents, docID)
+		}
+		return query, amountCursorFor, nil
+	case pfinancev1.SortField_SORT_FIELD_DATE:
+		query = query.OrderBy("Date", dir).OrderBy(firestore.DocumentID, dir)
+		if pageToken != "" {
+			date, docID, ok, err := decodeDateCursor(pageToken)
+			if err != nil || !ok {
+				return query, nil, fmt.Errorf("invalid page token %q", pageToken)
+			}
+			var dateVal interface{}
+			if !date.IsZero() {
+				dateVal = date
+			}
+			query = query.StartAfter(dateVal, docID)
+		}
+		return query, dateCursorFor, nil
+	}
+
+	// When date range filters are present, Firestore requires OrderBy on the range
+	// field first. Use date-aware pagination to avoid query ordering conflicts.
+	if hasDateFilter {
+		query, err := s.applyDateAwarePagination(ctx, query, collection, pageSize, pageToken)
+		return query, dateCursorFor, err
+	}
+	query, err := s.applyCursorPagination(query, pageSize, pageToken)
+	return query, func(doc *firestore.DocumentSnapshot) string {
+		return EncodePageToken(doc.Ref.ID)
+	}, err
+}
+
+// amountCursorPrefix marks page tokens that carry an (AmountCents, ID) sort key.
+const amountCursorPrefix = "a1:"
+
+// amountCursorFor returns the page token resuming after doc in a query ordered
+// by AmountCents then document ID.
+func amountCursorFor(doc *firestore.DocumentSnapshot) string {
+	cents, _ := doc.Data()["AmountCents"].(int64)
+	return EncodePageToken(amountCursorPrefix + strconv.FormatInt(cents, 10) + "|" + doc.Ref.ID)
+}
+
+// decodeAmountCursor decodes a token from amountCursorFor.
+func decodeAmountCursor(token string) (int64, string, error) {
+	decoded, err := DecodePageToken(token)
+	if err != nil {
+		return 0, "", err
+	}
+	rest, found := strings.CutPrefix(decoded, amountCursorPrefix)
+	if !found {
+		return 0, "", fmt.Errorf("not an amount cursor")
+	}
+	c, docID, found := strings.Cut(rest, "|")
+	if !found || docID == "" {
+		return 0, "", fmt.Errorf("malformed amount cursor")
+	}
+	cents, err := strconv.ParseInt(c, 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("malformed amount cursor: %w", err)
+	}
+	return cents, docID, nil
 }
 
 // CreateGroup creates a new group in Firestore
@@ -449,7 +872,9 @@ func (s *FirestoreStore) UpdateGroup(ctx context.Context, group *pfinancev1.Fina
 	return err
 }
 
-// ListGroups lists groups for a user
+// ListGroups lists groups for a user. Membership is matched with an
+// array-contains query on MemberIds, served by Firestore's automatic
+// single-field index, so only the user's groups are read.
 func (s *FirestoreStore) ListGroups(ctx context.Context, userID string, pageSize int32, pageToken string) ([]*pfinancev1.FinanceGroup, string, error) {
 	var query firestore.Query
 	query = s.client.Collection("financeGroups").Query
@@ -662,6 +1087,51 @@ func (s *FirestoreStore) UpdateInviteLink(ctx context.Context, link *pfinancev1.
 	return err
 }
 
+// RedeemInviteLink validates and consumes one use of an invite link in a transaction,
+// so concurrent redemptions cannot exceed MaxUses.
+func (s *FirestoreStore) RedeemInviteLink(ctx context.Context, code string) (*pfinancev1.GroupInviteLink, error) {
+	var redeemed *pfinancev1.GroupInviteLink
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		link, err := s.redeemInviteLinkTx(tx, code)
+		if err != nil {
+			return err
+		}
+		redeemed = link
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return redeemed, nil
+}
+
+// redeemInviteLinkTx validates and consumes one use of an invite link within tx.
+func (s *FirestoreStore) redeemInviteLinkTx(tx *firestore.Transaction, code string) (*pfinancev1.GroupInviteLink, error) {
+	query := s.client.Collection("groupInviteLinks").Where("Code", "==", code).Limit(1)
+	docs, err := tx.Documents(query).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invite link: %w", err)
+	}
+	if len(docs) == 0 {
+		return nil, fmt.Errorf("invite link not found with code: %s", code)
+	}
+
+	var link pfinancev1.GroupInviteLink
+	if err := docs[0].DataTo(&link); err != nil {
+		return nil, fmt.Errorf("failed to parse invite link: %w", err)
+	}
+	if err := ValidateInviteLink(&link, time.Now()); err != nil {
+		return nil, err
+	}
+
+	link.CurrentUses++
+	link.LastUsedAt = timestamppb.Now()
+	if err := tx.Set(docs[0].Ref, &link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
 // ListInviteLinks lists invite links for a group
 func (s *FirestoreStore) ListInviteLinks(ctx context.Context, groupID string, includeInactive bool, pageSize int32, pageToken string) ([]*pfinancev1.GroupInviteLink, string, error) {
 	var query firestore.Query
@@ -709,6 +1179,23 @@ func (s *FirestoreStore) ListInviteLinks(ctx context.Context, groupID string, in
 	return links, nextPageToken, nil
 }
 
+// ListInviteLinkMembers returns the members of a group who joined via the given invite link.
+// Members are embedded in the group document, so this filters them in memory.
+func (s *FirestoreStore) ListInviteLinkMembers(ctx context.Context, groupID, linkID string) ([]*pfinancev1.GroupMember, error) {
+	group, err := s.GetGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*pfinancev1.GroupMember
+	for _, member := range group.Members {
+		if member.InviteLinkId == linkID {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
 // Contribution operations
 
 // CreateContribution creates a new expense contribution in Firestore
@@ -777,6 +1264,47 @@ func (s *FirestoreStore) ListContributions(ctx context.Context, groupID, userID
 	return contributions, nextPageToken, nil
 }
 
+// ListContributionsForExpense returns contributions whose source personal expense
+// or resulting group expense is expenseID, oldest first.
+func (s *FirestoreStore) ListContributionsForExpense(ctx context.Context, expenseID string) ([]*pfinancev1.ExpenseContribution, error) {
+	docs, err := s.contributionDocsFor(ctx, "expenseContributions", expenseID, "SourceExpenseId", "CreatedGroupExpenseId")
+	if err != nil {
+		return nil, err
+	}
+
+	contributions := make([]*pfinancev1.ExpenseContribution, 0, len(docs))
+	for _, doc := range docs {
+		var contribution pfinancev1.ExpenseContribution
+		if err := doc.DataTo(&contribution); err != nil {
+			return nil, fmt.Errorf("failed to parse contribution: %w", err)
+		}
+		contributions = append(contributions, &contribution)
+	}
+	sort.Slice(contributions, func(i, j int) bool {
+		return contributions[i].ContributedAt.AsTime().Before(contributions[j].ContributedAt.AsTime())
+	})
+	return contributions, nil
+}
+
+// contributionDocsFor returns the documents in collection where any of fields equals id.
+func (s *FirestoreStore) contributionDocsFor(ctx context.Context, collection, id string, fields ...string) ([]*firestore.DocumentSnapshot, error) {
+	seen := make(map[string]bool)
+	var result []*firestore.DocumentSnapshot
+	for _, field := range fields {
+		docs, err := s.client.Collection(collection).Where(field, "==", id).Documents(ctx).GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to query %s by %s: %w", collection, field, err)
+		}
+		for _, doc := range docs {
+			if !seen[doc.Ref.ID] {
+				seen[doc.Ref.ID] = true
+				result = append(result, doc)
+			}
+		}
+	}
+	return result, nil
+}
+
 // Income contribution operations
 
 // CreateIncomeContribution creates a new income contribution in Firestore
@@ -845,6 +1373,28 @@ func (s *FirestoreStore) ListIncomeContributions(ctx context.Context, groupID, u
 	return contributions, nextPageToken, nil
 }
 
+// ListIncomeContributionsForIncome returns contributions whose source personal income
+// or resulting group income is incomeID, oldest first.
+func (s *FirestoreStore) ListIncomeContributionsForIncome(ctx context.Context, incomeID string) ([]*pfinancev1.IncomeContribution, error) {
+	docs, err := s.contributionDocsFor(ctx, "incomeContributions", incomeID, "SourceIncomeId", "CreatedGroupIncomeId")
+	if err != nil {
+		return nil, err
+	}
+
+	contributions := make([]*pfinancev1.IncomeContribution, 0, len(docs))
+	for _, doc := range docs {
+		var contribution pfinancev1.IncomeContribution
+		if err := doc.DataTo(&contribution); err != nil {
+			return nil, fmt.Errorf("failed to parse income contribution: %w", err)
+		}
+		contributions = append(contributions, &contribution)
+	}
+	sort.Slice(contributions, func(i, j int) bool {
+		return contributions[i].ContributedAt.AsTime().Before(contributions[j].ContributedAt.AsTime())
+	})
+	return contributions, nil
+}
+
 // Budget operations
 
 // CreateBudget creates a new budget in Firestore
@@ -972,7 +1522,7 @@ func (s *FirestoreStore) GetBudgetProgress(ctx context.Context, budgetID string,
 	}
 
 	// Calculate period start and end dates based on budget period
-	periodStart, periodEnd := s.calculateBudgetPeriod(budget, asOfDate)
+	periodStart, periodEnd := BudgetPeriodBounds(budget, asOfDate)
 
 	// Get expenses within the budget period
 	collection := "expenses"
@@ -1006,15 +1556,108 @@ func (s *FirestoreStore) GetBudgetProgress(ctx context.Context, budgetID string,
 		return nil, fmt.Errorf("failed to get expenses for budget: %w", err)
 	}
 
+	expenses := make([]*pfinancev1.Expense, 0, len(docs))
+	for _, doc := range docs {
+		var expense pfinancev1.Expense
+		if err := doc.DataTo(&expense); err != nil {
+			continue
+		}
+		expenses = append(expenses, &expense)
+	}
+
+	return buildBudgetProgress(budget, expenses, periodStart, periodEnd, asOfDate), nil
+}
+
+// GetAllBudgetProgress computes progress for every active budget of a user or
+// group. Expenses are fetched once for the union of all budget periods and then
+// partitioned per budget, instead of issuing one query per budget.
+func (s *FirestoreStore) GetAllBudgetProgress(ctx context.Context, userID, groupID string, asOfDate time.Time) ([]*pfinancev1.BudgetProgress, error) {
+	budgetCollection, expenseCollection := "budgets", "expenses"
+	ownerField, ownerID := "UserId", userID
+	if groupID != "" {
+		budgetCollection, expenseCollection = "groupBudgets", "groupExpenses"
+		ownerField, ownerID = "GroupId", groupID
+	}
+
+	// NOTE: Field names must match Go struct field names (PascalCase) as that's how Firestore serializes protobuf structs
+	budgetDocs, err := s.client.Collection(budgetCollection).
+		Where(ownerField, "==", ownerID).
+		Where("IsActive", "==", true).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list budgets: %w", err)
+	}
+
+	type budgetPeriod struct {
+		budget     *pfinancev1.Budget
+		start, end time.Time
+	}
+	var periods []budgetPeriod
+	var rangeStart, rangeEnd time.Time
+	for _, doc := range budgetDocs {
+		var budget pfinancev1.Budget
+		if err := doc.DataTo(&budget); err != nil {
+			continue
+		}
+		start, end := BudgetPeriodBounds(&budget, asOfDate)
+		if len(periods) == 0 || start.Before(rangeStart) {
+			rangeStart = start
+		}
+		if len(periods) == 0 || end.After(rangeEnd) {
+			rangeEnd = end
+		}
+		periods = append(periods, budgetPeriod{budget: &budget, start: start, end: end})
+	}
+	if len(periods) == 0 {
+		return nil, nil
+	}
+	sort.Slice(periods, func(i, j int) bool { return periods[i].budget.Id < periods[j].budget.Id })
+
+	expenseDocs, err := s.client.Collection(expenseCollection).
+		Where(ownerField, "==", ownerID).
+		Where("Date", ">=", rangeStart).
+		Where("Date", "<=", rangeEnd).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expenses for budgets: %w", err)
+	}
+	expenses := make([]*pfinancev1.Expense, 0, len(expenseDocs))
+	for _, doc := range expenseDocs {
+		var expense pfinancev1.Expense
+		if err := doc.DataTo(&expense); err != nil {
+			continue
+		}
+		expenses = append(expenses, &expense)
+	}
+
+	result := make([]*pfinancev1.BudgetProgress, 0, len(periods))
+	for _, p := range periods {
+		var matched []*pfinancev1.Expense
+		for _, expense := range expenses {
+			if expense.Date == nil {
+				continue
+			}
+			date := expense.Date.AsTime()
+			if date.Before(p.start) || date.After(p.end) {
+				continue
+			}
+			if len(p.budget.CategoryIds) > 0 && !slices.Contains(p.budget.CategoryIds, expense.Category) {
+				continue
+			}
+			matched = append(matched, expense)
+		}
+		result = append(result, buildBudgetProgress(p.budget, matched, p.start, p.end, asOfDate))
+	}
+	return result, nil
+}
+
+// buildBudgetProgress summarises the expenses that fall within a budget period.
+func buildBudgetProgress(budget *pfinancev1.Budget, expenses []*pfinancev1.Expense, periodStart, periodEnd, asOfDate time.Time) *pfinancev1.BudgetProgress {
 	// Calculate spending by category
 	categorySpending := make(map[pfinancev1.ExpenseCategory]float64)
 	totalSpent := 0.0
 
-	for _, doc := range docs {
-		var expense pfinancev1.Expense
-		if err := doc.DataTo(&expense); err != nil {
-			continue
-		}
+	for _, expense := range expenses {
 		categorySpending[expense.Category] += expense.Amount
 		totalSpent += expense.Amount
 	}
@@ -1047,7 +1690,7 @@ func (s *FirestoreStore) GetBudgetProgress(ctx context.Context, budgetID string,
 	}
 
 	return &pfinancev1.BudgetProgress{
-		BudgetId:          budgetID,
+		BudgetId:          budget.Id,
 		AllocatedAmount:   budget.Amount,
 		SpentAmount:       totalSpent,
 		RemainingAmount:   remainingAmount,
@@ -1056,63 +1699,64 @@ func (s *FirestoreStore) GetBudgetProgress(ctx context.Context, budgetID string,
 		PeriodStart:       timestamppb.New(periodStart),
 		PeriodEnd:         timestamppb.New(periodEnd),
 		CategoryBreakdown: categoryBreakdown,
-	}, nil
+		CategoryCaps:      categoryCapProgress(budget.CategoryCaps, expenses),
+	}
 }
 
-// calculateBudgetPeriod calculates the start and end dates for a budget period
-func (s *FirestoreStore) calculateBudgetPeriod(budget *pfinancev1.Budget, asOfDate time.Time) (time.Time, time.Time) {
-	// If budget has fixed start/end dates, use those
-	if budget.StartDate != nil && budget.EndDate != nil {
-		return budget.StartDate.AsTime(), budget.EndDate.AsTime()
-	}
-
-	// Otherwise, calculate based on period type
-	var periodStart, periodEnd time.Time
-
-	switch budget.Period {
-	case pfinancev1.BudgetPeriod_BUDGET_PERIOD_WEEKLY:
-		// Find start of current week (Sunday)
-		daysFromSunday := int(asOfDate.Weekday())
-		periodStart = asOfDate.AddDate(0, 0, -daysFromSunday)
-		periodEnd = periodStart.AddDate(0, 0, 6)
-
-	case pfinancev1.BudgetPeriod_BUDGET_PERIOD_FORTNIGHTLY:
-		// Use budget start date as reference for fortnightly periods
-		refDate := budget.StartDate.AsTime()
-		daysDiff := int(asOfDate.Sub(refDate).Hours() / 24)
-		periodsSince := daysDiff / 14
-		periodStart = refDate.AddDate(0, 0, periodsSince*14)
-		periodEnd = periodStart.AddDate(0, 0, 13)
-
-	case pfinancev1.BudgetPeriod_BUDGET_PERIOD_MONTHLY:
-		// Start of current month
-		periodStart = time.Date(asOfDate.Year(), asOfDate.Month(), 1, 0, 0, 0, 0, asOfDate.Location())
-		// End of current month
-		periodEnd = periodStart.AddDate(0, 1, -1)
-
-	case pfinancev1.BudgetPeriod_BUDGET_PERIOD_QUARTERLY:
-		// Find start of current quarter
-		month := asOfDate.Month()
-		quarterStartMonth := time.Month(((int(month)-1)/3)*3 + 1)
-		periodStart = time.Date(asOfDate.Year(), quarterStartMonth, 1, 0, 0, 0, 0, asOfDate.Location())
-		periodEnd = periodStart.AddDate(0, 3, -1)
-
-	case pfinancev1.BudgetPeriod_BUDGET_PERIOD_YEARLY:
-		// Start of current year
-		periodStart = time.Date(asOfDate.Year(), 1, 1, 0, 0, 0, 0, asOfDate.Location())
-		periodEnd = time.Date(asOfDate.Year(), 12, 31, 23, 59, 59, 999999999, asOfDate.Location())
+// CreateBudgetTemplate stores a new recurring budget template
+func (s *FirestoreStore) CreateBudgetTemplate(ctx context.Context, template *pfinancev1.RecurringBudget) error {
+	_, err := s.client.Collection("budget_templates").Doc(template.Id).Set(ctx, template)
+	return err
+}
 
-	default:
-		// Default to monthly
-		periodStart = time.Date(asOfDate.Year(), asOfDate.Month(), 1, 0, 0, 0, 0, asOfDate.Location())
-		periodEnd = periodStart.AddDate(0, 1, -1)
+// ListBudgetTemplates lists recurring budget templates, sorted by name
+func (s *FirestoreStore) ListBudgetTemplates(ctx context.Context, userID, groupID string) ([]*pfinancev1.RecurringBudget, error) {
+	query := s.client.Collection("budget_templates").Query
+	if groupID != "" {
+		query = query.Where("GroupId", "==", groupID)
+	} else if userID != "" {
+		query = query.Where("UserId", "==", userID).Where("GroupId", "==", "")
+	}
+
+	docs, err := query.Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("list budget templates: %w", err)
+	}
+	templates := make([]*pfinancev1.RecurringBudget, 0, len(docs))
+	for _, doc := range docs {
+		var t pfinancev1.RecurringBudget
+		if err := doc.DataTo(&t); err != nil {
+			return nil, fmt.Errorf("failed to parse budget template: %w", err)
+		}
+		templates = append(templates, &t)
+	}
+	sortBudgetTemplates(templates)
+	return templates, nil
+}
+
+// GetBudgetTemplate retrieves a recurring budget template
+func (s *FirestoreStore) GetBudgetTemplate(ctx context.Context, templateID string) (*pfinancev1.RecurringBudget, error) {
+	doc, err := s.client.Collection("budget_templates").Doc(templateID).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("budget template not found: %w", err)
 	}
+	var template pfinancev1.RecurringBudget
+	if err := doc.DataTo(&template); err != nil {
+		return nil, fmt.Errorf("failed to parse budget template: %w", err)
+	}
+	return &template, nil
+}
 
-	// Set to beginning and end of day
-	periodStart = time.Date(periodStart.Year(), periodStart.Month(), periodStart.Day(), 0, 0, 0, 0, periodStart.Location())
-	periodEnd = time.Date(periodEnd.Year(), periodEnd.Month(), periodEnd.Day(), 23, 59, 59, 999999999, periodEnd.Location())
+// UpdateBudgetTemplate replaces a recurring budget template
+func (s *FirestoreStore) UpdateBudgetTemplate(ctx context.Context, template *pfinancev1.RecurringBudget) error {
+	_, err := s.client.Collection("budget_templates").Doc(template.Id).Set(ctx, template)
+	return err
+}
 
-	return periodStart, periodEnd
+// DeleteBudgetTemplate deletes a recurring budget template
+func (s *FirestoreStore) DeleteBudgetTemplate(ctx context.Context, templateID string) error {
+	_, err := s.client.Collection("budget_templates").Doc(templateID).Delete(ctx)
+	return err
 }
 
 // User operations
@@ -1236,6 +1880,32 @@ func (s *FirestoreStore) DeleteUser(ctx context.Context, userID string) error {
 	return nil
 }
 
+// CreateDataClearRecord stores an audit record of a data clear
+func (s *FirestoreStore) CreateDataClearRecord(ctx context.Context, record *pfinancev1.DataClearRecord) error {
+	_, err := s.client.Collection("data_clear_records").Doc(record.Id).Set(ctx, record)
+	return err
+}
+
+// ListDataClearRecords lists a user's data clear audit records, newest first
+func (s *FirestoreStore) ListDataClearRecords(ctx context.Context, userID string) ([]*pfinancev1.DataClearRecord, error) {
+	docs, err := s.client.Collection("data_clear_records").
+		Where("UserId", "==", userID).
+		OrderBy("ClearedAt", firestore.Desc).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("list data clear records: %w", err)
+	}
+	var records []*pfinancev1.DataClearRecord
+	for _, doc := range docs {
+		var r pfinancev1.DataClearRecord
+		if err := doc.DataTo(&r); err != nil {
+			continue
+		}
+		records = append(records, &r)
+	}
+	return records, nil
+}
+
 // ClearUserData deletes all financial data for a user but keeps the account, notification preferences, and API tokens
 func (s *FirestoreStore) ClearUserData(ctx context.Context, userID string) error {
 	// Helper to delete all documents in a collection matching a query
@@ -1502,7 +2172,11 @@ func (s *FirestoreStore) GetGoalProgress(ctx context.Context, goalID string, asO
 	for _, milestone := range goal.Milestones {
 		if milestone.IsAchieved {
 			achievedMilestones = append(achievedMilestones, milestone)
-		} else if nextMilestone == nil || milestone.TargetPercentage < nextMilestone.TargetPercentage {
+		}
+		// Withdrawals can drop the balance back below an achieved milestone, so
+		// the next milestone is the lowest one the current balance hasn't reached.
+		if percentageComplete < milestone.TargetPercentage &&
+			(nextMilestone == nil || milestone.TargetPercentage < nextMilestone.TargetPercentage) {
 			nextMilestone = milestone
 		}
 	}
@@ -1574,7 +2248,7 @@ func (s *FirestoreStore) ListGoalContributions(ctx context.Context, goalID strin
 
 // Search operations
 
-func (s *FirestoreStore) SearchTransactions(ctx context.Context, userID, groupID, query, category string, amountMin, amountMax float64, startDate, endDate *time.Time, txType pfinancev1.TransactionType, pageSize int32, pageToken string) ([]*pfinancev1.SearchResult, string, int, error) {
+func (s *FirestoreStore) SearchTransactions(ctx context.Context, userID, groupID, query, category string, amountMin, amountMax *float64, startDate, endDate *time.Time, txType pfinancev1.TransactionType, sortBy pfinancev1.SearchSortBy, pageSize int32, pageToken string) ([]*pfinancev1.SearchResult, string, int, error) {
 	queryLower := strings.ToLower(query)
 	var results []*pfinancev1.SearchResult
 
@@ -1603,16 +2277,16 @@ func (s *FirestoreStore) SearchTransactions(ctx context.Context, userID, groupID
 			if err := doc.DataTo(&expense); err != nil {
 				continue
 			}
-			if query != "" && !strings.Contains(strings.ToLower(expense.Description), queryLower) {
+			if query != "" && !expenseMatchesQuery(&expense, queryLower) {
 				continue
 			}
 			if category != "" && expense.Category.String() != category {
 				continue
 			}
-			if amountMin > 0 && expense.Amount < amountMin {
+			if amountMin != nil && expense.Amount < *amountMin {
 				continue
 			}
-			if amountMax > 0 && expense.Amount > amountMax {
+			if amountMax != nil && expense.Amount > *amountMax {
 				continue
 			}
 			if startDate != nil && expense.Date != nil && expense.Date.AsTime().Before(*startDate) {
@@ -1661,10 +2335,10 @@ func (s *FirestoreStore) SearchTransactions(ctx context.Context, userID, groupID
 			if query != "" && !strings.Contains(strings.ToLower(income.Source), queryLower) {
 				continue
 			}
-			if amountMin > 0 && income.Amount < amountMin {
+			if amountMin != nil && income.Amount < *amountMin {
 				continue
 			}
-			if amountMax > 0 && income.Amount > amountMax {
+			if amountMax != nil && income.Amount > *amountMax {
 				continue
 			}
 			if startDate != nil && income.Date != nil && income.Date.AsTime().Before(*startDate) {
@@ -1687,13 +2361,7 @@ func (s *FirestoreStore) SearchTransactions(ctx context.Context, userID, groupID
 
 	totalCount := len(results)
 
-	// Sort by date descending
-	sort.Slice(results, func(i, j int) bool {
-		if results[i].Date == nil || results[j].Date == nil {
-			return results[i].Date != nil
-		}
-		return results[i].Date.AsTime().After(results[j].Date.AsTime())
-	})
+	sortSearchResults(results, sortBy, queryLower)
 
 	// Paginate
 	if pageSize <= 0 {
@@ -1841,7 +2509,7 @@ func (s *FirestoreStore) ListRecurringTransactions(ctx context.Context, userID,
 
 // Notification operations
 
-func (s *FirestoreStore) CreateNotification(ctx context.Context, notification *pfinancev1.Notification) error {
+func (s *FirestoreStore) CreateNotification(ctx context.Context, notification *pfinancev1.Notification) (*pfinancev1.Notification, error) {
 	// Store with an ExpiresAt field for Firestore TTL auto-deletion (90 days).
 	// Enable TTL policy via:
 	//   gcloud firestore fields ttls update ExpiresAt \
@@ -1859,10 +2527,44 @@ func (s *FirestoreStore) CreateNotification(ctx context.Context, notification *p
 		"CreatedAt":     notification.CreatedAt,
 		"ReadAt":        notification.ReadAt,
 		"Metadata":      notification.Metadata,
+		"DedupKey":      notification.DedupKey,
 		"ExpiresAt":     time.Now().Add(90 * 24 * time.Hour),
 	}
-	_, err := s.client.Collection("notifications").Doc(notification.Id).Set(ctx, data)
-	return err
+	if notification.DedupKey == "" {
+		_, err := s.client.Collection("notifications").Doc(notification.Id).Set(ctx, data)
+		if err != nil {
+			return nil, err
+		}
+		return notification, nil
+	}
+
+	// Keyed notifications live at an ID derived from the key, and Create fails
+	// when that document exists, so concurrent triggers cannot both write one.
+	// Dedup lasts as long as the document, i.e. until the TTL removes it.
+	notification.Id = notificationDedupID(notification.UserId, notification.DedupKey)
+	data["Id"] = notification.Id
+	ref := s.client.Collection("notifications").Doc(notification.Id)
+	_, err := ref.Create(ctx, data)
+	if err == nil {
+		return notification, nil
+	}
+	if existing, getErr := s.GetNotification(ctx, notification.Id); getErr == nil {
+		return existing, nil
+	}
+	return nil, fmt.Errorf("create notification: %w", err)
+}
+
+func (s *FirestoreStore) GetNotification(ctx context.Context, notificationID string) (*pfinancev1.Notification, error) {
+	doc, err := s.client.Collection("notifications").Doc(notificationID).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("notification not found: %w", err)
+	}
+
+	var notification pfinancev1.Notification
+	if err := doc.DataTo(&notification); err != nil {
+		return nil, fmt.Errorf("failed to parse notification: %w", err)
+	}
+	return &notification, nil
 }
 
 func (s *FirestoreStore) ListNotifications(ctx context.Context, userID string, unreadOnly bool, typeFilter pfinancev1.NotificationType, pageSize int32, pageToken string) ([]*pfinancev1.Notification, string, error) {
@@ -1951,6 +2653,32 @@ func (s *FirestoreStore) MarkAllNotificationsRead(ctx context.Context, userID st
 	return err
 }
 
+func (s *FirestoreStore) DeleteNotification(ctx context.Context, notificationID string) error {
+	_, err := s.client.Collection("notifications").Doc(notificationID).Delete(ctx)
+	return err
+}
+
+func (s *FirestoreStore) DeleteAllReadNotifications(ctx context.Context, userID string) (int32, error) {
+	docs, err := s.client.Collection("notifications").
+		Where("UserId", "==", userID).
+		Where("IsRead", "==", true).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return 0, fmt.Errorf("failed to query read notifications: %w", err)
+	}
+
+	for i := 0; i < len(docs); i += 500 {
+		batch := s.client.Batch()
+		for _, doc := range docs[i:min(i+500, len(docs))] {
+			batch.Delete(doc.Ref)
+		}
+		if _, err := batch.Commit(ctx); err != nil {
+			return 0, fmt.Errorf("delete read notifications (chunk %d): %w", i/500, err)
+		}
+	}
+	return int32(len(docs)), nil
+}
+
 func (s *FirestoreStore) GetUnreadNotificationCount(ctx context.Context, userID string) (int32, error) {
 	docs, err := s.client.Collection("notifications").
 		Where("UserId", "==", userID).
@@ -1963,6 +2691,29 @@ func (s *FirestoreStore) GetUnreadNotificationCount(ctx context.Context, userID
 	return int32(len(docs)), nil
 }
 
+func (s *FirestoreStore) ListNotificationsInRange(ctx context.Context, userID string, startDate, endDate time.Time) ([]*pfinancev1.Notification, error) {
+	// Ordering by CreatedAt descending reuses the (UserId, CreatedAt DESC) index
+	docs, err := s.client.Collection("notifications").
+		Where("UserId", "==", userID).
+		Where("CreatedAt", ">=", startDate).
+		Where("CreatedAt", "<=", endDate).
+		OrderBy("CreatedAt", firestore.Desc).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications in range: %w", err)
+	}
+
+	notifications := make([]*pfinancev1.Notification, 0, len(docs))
+	for _, doc := range docs {
+		var notification pfinancev1.Notification
+		if err := doc.DataTo(&notification); err != nil {
+			return nil, fmt.Errorf("failed to parse notification: %w", err)
+		}
+		notifications = append(notifications, &notification)
+	}
+	return notifications, nil
+}
+
 func (s *FirestoreStore) GetNotificationPreferences(ctx context.Context, userID string) (*pfinancev1.NotificationPreferences, error) {
 	doc, err := s.client.Collection("notificationPreferences").Doc(userID).Get(ctx)
 	if err != nil {
@@ -2072,7 +2823,7 @@ func (s *FirestoreStore) GetDailyAggregates(ctx context.Context, userID, groupID
 			continue
 		}
 
-		dateStr := expense.Date.AsTime().Format("2006-01-02")
+		dateStr := DailyAggregateDate(expense.Date)
 
 		day, ok := days[dateStr]
 		if !ok {
@@ -2123,6 +2874,30 @@ func (s *FirestoreStore) GetDailyAggregates(ctx context.Context, userID, groupID
 	return result, nil
 }
 
+// UpsertCategoryBaseline creates or replaces the baseline for a user's category.
+func (s *FirestoreStore) UpsertCategoryBaseline(ctx context.Context, baseline *pfinancev1.CategoryBaseline) error {
+	docID := fmt.Sprintf("%s_%d", baseline.UserId, baseline.Category)
+	_, err := s.client.Collection("category_baselines").Doc(docID).Set(ctx, baseline)
+	return err
+}
+
+// GetCategoryBaselines returns every stored category baseline for a user.
+func (s *FirestoreStore) GetCategoryBaselines(ctx context.Context, userID string) ([]*pfinancev1.CategoryBaseline, error) {
+	docs, err := s.client.Collection("category_baselines").Where("UserId", "==", userID).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("get category baselines: %w", err)
+	}
+	baselines := make([]*pfinancev1.CategoryBaseline, 0, len(docs))
+	for _, doc := range docs {
+		var b pfinancev1.CategoryBaseline
+		if err := doc.DataTo(&b); err != nil {
+			return nil, fmt.Errorf("failed to parse category baseline: %w", err)
+		}
+		baselines = append(baselines, &b)
+	}
+	return baselines, nil
+}
+
 // CreateCorrectionRecord stores a correction record
 func (s *FirestoreStore) CreateCorrectionRecord(ctx context.Context, record *pfinancev1.CorrectionRecord) error {
 	_, err := s.client.Collection("correction_records").Doc(record.Id).Set(ctx, record)
@@ -2175,6 +2950,32 @@ func (s *FirestoreStore) GetMerchantMappings(ctx context.Context, userID string)
 	return mappings, nil
 }
 
+// ListMerchantMappings returns one page of a user's merchant mappings, filtered
+// by raw pattern and sorted by sortBy. Substring filtering has no Firestore
+// equivalent, so the user's mappings are sorted and paged in memory.
+func (s *FirestoreStore) ListMerchantMappings(ctx context.Context, userID, query string, sortBy pfinancev1.MerchantMappingSort, pageSize int32, pageToken string) ([]*pfinancev1.MerchantMapping, string, error) {
+	mappings, err := s.GetMerchantMappings(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	mappings = filterAndSortMerchantMappings(mappings, query, sortBy)
+	start, end, nextToken, err := paginateByOffset(len(mappings), pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return mappings[start:end], nextToken, nil
+}
+
+// DeleteMerchantMapping deletes a user's mapping for a raw pattern
+func (s *FirestoreStore) DeleteMerchantMapping(ctx context.Context, userID, rawPattern string) error {
+	ref := s.client.Collection("merchant_mappings").Doc(fmt.Sprintf("%s_%s", userID, rawPattern))
+	if _, err := ref.Get(ctx); err != nil {
+		return fmt.Errorf("merchant mapping not found: %w", err)
+	}
+	_, err := ref.Delete(ctx)
+	return err
+}
+
 // CreateExtractionEvent stores an extraction event
 func (s *FirestoreStore) CreateExtractionEvent(ctx context.Context, event *pfinancev1.ExtractionEvent) error {
 	_, err := s.client.Collection("extraction_events").Doc(event.Id).Set(ctx, event)
@@ -2249,7 +3050,7 @@ func (s *FirestoreStore) ListDeductibleExpenses(ctx context.Context, userID, gro
 		var nextPageToken string
 		if len(docs) > int(pageSize) {
 			docs = docs[:pageSize]
-			nextPageToken = EncodePageToken(docs[pageSize-1].Ref.ID)
+			nextPageToken = dateCursorFor(docs[pageSize-1])
 		}
 		expenses := make([]*pfinancev1.Expense, 0, len(docs))
 		for _, doc := range docs {
@@ -2289,8 +3090,12 @@ func (s *FirestoreStore) ListDeductibleExpenses(ctx context.Context, userID, gro
 	return expenses, nextPageToken, nil
 }
 
+// aggregateDeductionsPageSize bounds each read of AggregateDeductionsByCategory.
+const aggregateDeductionsPageSize = 500
+
 // AggregateDeductionsByCategory sums deductible expenses by TaxDeductionCategory for a date range.
-// It pages through all matching expenses and groups by category.
+// The date range is filtered server-side and matching expenses are read in
+// pages of aggregateDeductionsPageSize, so a heavy year never loads at once.
 func (s *FirestoreStore) AggregateDeductionsByCategory(ctx context.Context, userID, groupID string, startDate, endDate time.Time) ([]*pfinancev1.TaxDeductionSummary, error) {
 	collection := "expenses"
 	if groupID != "" {
@@ -2307,58 +3112,28 @@ func (s *FirestoreStore) AggregateDeductionsByCategory(ctx context.Context, user
 	}
 
 	query = query.Where("Date", ">=", startDate).Where("Date", "<=", endDate)
-	query = query.OrderBy("Date", firestore.Asc)
-
-	docs, err := query.Documents(ctx).GetAll()
-	if err != nil {
-		return nil, fmt.Errorf("aggregate deductions: %w", err)
-	}
-
-	type agg struct {
-		totalCents   int64
-		expenseCount int32
-	}
-	byCategory := make(map[pfinancev1.TaxDeductionCategory]*agg)
-
-	for _, doc := range docs {
-		var expense pfinancev1.Expense
-		if err := doc.DataTo(&expense); err != nil {
-			continue
-		}
+	query = query.OrderBy("Date", firestore.Asc).OrderBy(firestore.DocumentID, firestore.Asc).Limit(aggregateDeductionsPageSize)
 
-		cat := expense.TaxDeductionCategory
-		pct := expense.TaxDeductiblePercent
-		if pct <= 0 {
-			pct = 1.0
+	totals := make(deductionTotals)
+	page := query
+	for {
+		docs, err := page.Documents(ctx).GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("aggregate deductions: %w", err)
 		}
-
-		cents := expense.AmountCents
-		if cents == 0 {
-			cents = int64(expense.Amount * 100)
+		for _, doc := range docs {
+			var expense pfinancev1.Expense
+			if err := doc.DataTo(&expense); err != nil {
+				continue
+			}
+			totals.add(&expense)
 		}
-		deductibleCents := int64(float64(cents) * pct)
-
-		if _, ok := byCategory[cat]; !ok {
-			byCategory[cat] = &agg{}
+		if len(docs) < aggregateDeductionsPageSize {
+			break
 		}
-		byCategory[cat].totalCents += deductibleCents
-		byCategory[cat].expenseCount++
-	}
-
-	var summaries []*pfinancev1.TaxDeductionSummary
-	for cat, a := range byCategory {
-		summaries = append(summaries, &pfinancev1.TaxDeductionSummary{
-			Category:     cat,
-			TotalCents:   a.totalCents,
-			TotalAmount:  float64(a.totalCents) / 100.0,
-			ExpenseCount: a.expenseCount,
-		})
+		page = query.StartAfter(docs[len(docs)-1])
 	}
-
-	sort.Slice(summaries, func(i, j int) bool {
-		return summaries[i].TotalCents > summaries[j].TotalCents
-	})
-	return summaries, nil
+	return totals.summaries(), nil
 }
 
 // UpsertTaxDeductibilityMapping upserts a tax deductibility mapping
@@ -2497,6 +3272,116 @@ func (s *FirestoreStore) CountActiveApiTokens(ctx context.Context, userID string
 	return len(docs), nil
 }
 
+// ============================================================================
+// Import profile operations
+// ============================================================================
+
+// CreateImportProfile stores a new import profile
+func (s *FirestoreStore) CreateImportProfile(ctx context.Context, profile *pfinancev1.ImportProfile) error {
+	_, err := s.client.Collection("import_profiles").Doc(profile.Id).Set(ctx, profile)
+	return err
+}
+
+// GetImportProfile retrieves an import profile by ID
+func (s *FirestoreStore) GetImportProfile(ctx context.Context, profileID string) (*pfinancev1.ImportProfile, error) {
+	doc, err := s.client.Collection("import_profiles").Doc(profileID).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("import profile not found: %w", err)
+	}
+	var profile pfinancev1.ImportProfile
+	if err := doc.DataTo(&profile); err != nil {
+		return nil, fmt.Errorf("failed to parse import profile: %w", err)
+	}
+	return &profile, nil
+}
+
+// UpdateImportProfile replaces an existing import profile
+func (s *FirestoreStore) UpdateImportProfile(ctx context.Context, profile *pfinancev1.ImportProfile) error {
+	_, err := s.client.Collection("import_profiles").Doc(profile.Id).Set(ctx, profile)
+	return err
+}
+
+// DeleteImportProfile deletes an import profile
+func (s *FirestoreStore) DeleteImportProfile(ctx context.Context, profileID string) error {
+	_, err := s.client.Collection("import_profiles").Doc(profileID).Delete(ctx)
+	return err
+}
+
+// ListImportProfiles lists a user's import profiles, sorted by name
+func (s *FirestoreStore) ListImportProfiles(ctx context.Context, userID string) ([]*pfinancev1.ImportProfile, error) {
+	docs, err := s.client.Collection("import_profiles").
+		Where("UserId", "==", userID).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("list import profiles: %w", err)
+	}
+	profiles := make([]*pfinancev1.ImportProfile, 0, len(docs))
+	for _, doc := range docs {
+		var p pfinancev1.ImportProfile
+		if err := doc.DataTo(&p); err != nil {
+			return nil, fmt.Errorf("failed to parse import profile: %w", err)
+		}
+		profiles = append(profiles, &p)
+	}
+	sortImportProfiles(profiles)
+	return profiles, nil
+}
+
+// ============================================================================
+// Extraction preference operations
+// ============================================================================
+
+// GetExtractionPreferences returns a user's extraction preferences
+func (s *FirestoreStore) GetExtractionPreferences(ctx context.Context, userID string) (*pfinancev1.ExtractionPreferences, error) {
+	doc, err := s.client.Collection("extractionPreferences").Doc(userID).Get(ctx)
+	if err != nil {
+		return &pfinancev1.ExtractionPreferences{UserId: userID}, nil
+	}
+
+	var prefs pfinancev1.ExtractionPreferences
+	if err := doc.DataTo(&prefs); err != nil {
+		return nil, fmt.Errorf("failed to parse extraction preferences: %w", err)
+	}
+	return &prefs, nil
+}
+
+// UpdateExtractionPreferences stores a user's extraction preferences
+func (s *FirestoreStore) UpdateExtractionPreferences(ctx context.Context, prefs *pfinancev1.ExtractionPreferences) error {
+	_, err := s.client.Collection("extractionPreferences").Doc(prefs.UserId).Set(ctx, prefs)
+	return err
+}
+
+// DeleteExtractionPreferences removes a user's extraction preferences
+func (s *FirestoreStore) DeleteExtractionPreferences(ctx context.Context, userID string) error {
+	_, err := s.client.Collection("extractionPreferences").Doc(userID).Delete(ctx)
+	return err
+}
+
+// ============================================================================
+// Webhook event operations (dedup)
+// ============================================================================
+
+// ClaimWebhookEvent records a webhook event ID, reporting false if it was
+// already recorded. Create fails when the document exists, so concurrent
+// deliveries of one event cannot both claim it.
+func (s *FirestoreStore) ClaimWebhookEvent(ctx context.Context, eventID string) (bool, error) {
+	ref := s.client.Collection("webhook_events").Doc(eventID)
+	_, err := ref.Create(ctx, map[string]interface{}{"ReceivedAt": time.Now()})
+	if err == nil {
+		return true, nil
+	}
+	if doc, getErr := ref.Get(ctx); getErr == nil && doc.Exists() {
+		return false, nil
+	}
+	return false, fmt.Errorf("claim webhook event: %w", err)
+}
+
+// ReleaseWebhookEvent forgets a webhook event ID so the event can be processed again
+func (s *FirestoreStore) ReleaseWebhookEvent(ctx context.Context, eventID string) error {
+	_, err := s.client.Collection("webhook_events").Doc(eventID).Delete(ctx)
+	return err
+}
+
 // ============================================================================
 // Processed Statement operations (dedup)
 // ============================================================================
@@ -2563,3 +3448,87 @@ func (s *FirestoreStore) FindOverlappingStatements(ctx context.Context, userID,
 	}
 	return results, nil
 }
+
+// RunInTransaction runs fn inside a Firestore transaction. Firestore requires
+// all transactional reads to happen before any writes, and retries fn on
+// contention.
+func (s *FirestoreStore) RunInTransaction(ctx context.Context, fn func(txStore Store) error) error {
+	return s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		return fn(&firestoreTxStore{FirestoreStore: s, tx: tx})
+	})
+}
+
+// firestoreTxStore is the Store handed to RunInTransaction callbacks. Only
+// GetGroup, UpdateGroup, GetInvitation, UpdateInvitation, GetInviteLinkByCode
+// and RedeemInviteLink go through the transaction.
+//
+// Every other method falls through to the embedded store, and it does not
+// error. Its reads are not isolated and its writes commit at once, even if the
+// transaction later fails or is retried. Callbacks must not use those methods.
+// To make another method transactional, override it here with a tx-based
+// version.
+type firestoreTxStore struct {
+	*FirestoreStore
+	tx *firestore.Transaction
+}
+
+// RunInTransaction on a transactional store joins the existing transaction.
+func (t *firestoreTxStore) RunInTransaction(ctx context.Context, fn func(txStore Store) error) error {
+	return fn(t)
+}
+
+func (t *firestoreTxStore) GetGroup(ctx context.Context, groupID string) (*pfinancev1.FinanceGroup, error) {
+	doc, err := t.tx.Get(t.client.Collection("financeGroups").Doc(groupID))
+	if err != nil {
+		return nil, fmt.Errorf("group not found: %w", err)
+	}
+
+	var group pfinancev1.FinanceGroup
+	if err := doc.DataTo(&group); err != nil {
+		return nil, fmt.Errorf("failed to parse group: %w", err)
+	}
+	return &group, nil
+}
+
+func (t *firestoreTxStore) UpdateGroup(ctx context.Context, group *pfinancev1.FinanceGroup) error {
+	return t.tx.Set(t.client.Collection("financeGroups").Doc(group.Id), group)
+}
+
+func (t *firestoreTxStore) GetInvitation(ctx context.Context, invitationID string) (*pfinancev1.GroupInvitation, error) {
+	doc, err := t.tx.Get(t.client.Collection("groupInvitations").Doc(invitationID))
+	if err != nil {
+		return nil, fmt.Errorf("invitation not found: %w", err)
+	}
+
+	var invitation pfinancev1.GroupInvitation
+	if err := doc.DataTo(&invitation); err != nil {
+		return nil, fmt.Errorf("failed to parse invitation: %w", err)
+	}
+	return &invitation, nil
+}
+
+func (t *firestoreTxStore) UpdateInvitation(ctx context.Context, invitation *pfinancev1.GroupInvitation) error {
+	return t.tx.Set(t.client.Collection("groupInvitations").Doc(invitation.Id), invitation)
+}
+
+func (t *firestoreTxStore) GetInviteLinkByCode(ctx context.Context, code string) (*pfinancev1.GroupInviteLink, error) {
+	// NOTE: Field names must match Go struct field names (PascalCase) as that's how Firestore serializes protobuf structs
+	query := t.client.Collection("groupInviteLinks").Where("Code", "==", code).Limit(1)
+	docs, err := t.tx.Documents(query).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invite link: %w", err)
+	}
+	if len(docs) == 0 {
+		return nil, fmt.Errorf("invite link not found with code: %s", code)
+	}
+
+	var link pfinancev1.GroupInviteLink
+	if err := docs[0].DataTo(&link); err != nil {
+		return nil, fmt.Errorf("failed to parse invite link: %w", err)
+	}
+	return &link, nil
+}
+
+func (t *firestoreTxStore) RedeemInviteLink(ctx context.Context, code string) (*pfinancev1.GroupInviteLink, error) {
+	return t.redeemInviteLinkTx(t.tx, code)
+}
@@ -3,6 +3,8 @@ package store
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -10,6 +12,7 @@ import (
 
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -27,6 +30,7 @@ type MemoryStore struct {
 	incomeContributions      map[string]*pfinancev1.IncomeContribution
 	taxConfigs               map[string]*pfinancev1.TaxConfig
 	budgets                  map[string]*pfinancev1.Budget
+	budgetTemplates          map[string]*pfinancev1.RecurringBudget
 	users                    map[string]*pfinancev1.User
 	goals                    map[string]*pfinancev1.FinancialGoal
 	goalContributions        map[string]*pfinancev1.GoalContribution
@@ -34,12 +38,17 @@ type MemoryStore struct {
 	notifications            map[string]*pfinancev1.Notification
 	notificationPreferences  map[string]*pfinancev1.NotificationPreferences
 	correctionRecords        map[string]*pfinancev1.CorrectionRecord
+	dataClearRecords         map[string]*pfinancev1.DataClearRecord
 	merchantMappings         map[string]*pfinancev1.MerchantMapping
 	extractionEvents         map[string]*pfinancev1.ExtractionEvent
 	taxDeductibilityMappings map[string]*pfinancev1.TaxDeductibilityMapping
 	categoryOverrides        map[string]*pfinancev1.CategoryOverride
 	apiTokens                map[string]*pfinancev1.ApiToken
+	categoryBaselines        map[string]*pfinancev1.CategoryBaseline
 	processedStatements      []*pfinancev1.ProcessedStatement
+	importProfiles           map[string]*pfinancev1.ImportProfile
+	extractionPreferences    map[string]*pfinancev1.ExtractionPreferences
+	webhookEvents            map[string]time.Time
 }
 
 // NewMemoryStore creates a new in-memory store
@@ -54,6 +63,7 @@ func NewMemoryStore() *MemoryStore {
 		incomeContributions:      make(map[string]*pfinancev1.IncomeContribution),
 		taxConfigs:               make(map[string]*pfinancev1.TaxConfig),
 		budgets:                  make(map[string]*pfinancev1.Budget),
+		budgetTemplates:          make(map[string]*pfinancev1.RecurringBudget),
 		users:                    make(map[string]*pfinancev1.User),
 		goals:                    make(map[string]*pfinancev1.FinancialGoal),
 		goalContributions:        make(map[string]*pfinancev1.GoalContribution),
@@ -61,14 +71,74 @@ func NewMemoryStore() *MemoryStore {
 		notifications:            make(map[string]*pfinancev1.Notification),
 		notificationPreferences:  make(map[string]*pfinancev1.NotificationPreferences),
 		correctionRecords:        make(map[string]*pfinancev1.CorrectionRecord),
+		dataClearRecords:         make(map[string]*pfinancev1.DataClearRecord),
 		merchantMappings:         make(map[string]*pfinancev1.MerchantMapping),
 		extractionEvents:         make(map[string]*pfinancev1.ExtractionEvent),
 		taxDeductibilityMappings: make(map[string]*pfinancev1.TaxDeductibilityMapping),
 		categoryOverrides:        make(map[string]*pfinancev1.CategoryOverride),
 		apiTokens:                make(map[string]*pfinancev1.ApiToken),
+		categoryBaselines:        make(map[string]*pfinancev1.CategoryBaseline),
+		importProfiles:           make(map[string]*pfinancev1.ImportProfile),
+		extractionPreferences:    make(map[string]*pfinancev1.ExtractionPreferences),
+		webhookEvents:            make(map[string]time.Time),
 	}
 }
 
+// dateSortKey is the (date, ID) ordering used for date-paginated listings.
+// A zero date represents a missing date and sorts first.
+type dateSortKey struct {
+	date time.Time
+	id   string
+}
+
+func (k dateSortKey) less(o dateSortKey) bool {
+	if !k.date.Equal(o.date) {
+		return k.date.Before(o.date)
+	}
+	return k.id < o.id
+}
+
+// paginateByDate orders keys by date then ID and returns the IDs on the page
+// after pageToken, mirroring the Firestore store's date-aware pagination.
+func paginateByDate(keys []dateSortKey, pageSize int32, pageToken string) ([]string, string, error) {
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].less(keys[j]) })
+
+	startIdx := 0
+	if pageToken != "" {
+		date, docID, ok, err := decodeDateCursor(pageToken)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid page token: %w", err)
+		}
+		cursor := dateSortKey{date: date, id: docID}
+		if !ok {
+			// Legacy token: resume after the referenced item's position.
+			idx := slices.IndexFunc(keys, func(k dateSortKey) bool { return k.id == docID })
+			if idx < 0 {
+				return nil, "", fmt.Errorf("invalid page token: cursor %s not found", docID)
+			}
+			cursor = keys[idx]
+		}
+		startIdx = sort.Search(len(keys), func(i int) bool { return cursor.less(keys[i]) })
+	}
+
+	endIdx := min(startIdx+int(pageSize), len(keys))
+	ids := make([]string, 0, endIdx-startIdx)
+	for _, k := range keys[startIdx:endIdx] {
+		ids = append(ids, k.id)
+	}
+
+	var nextToken string
+	if endIdx < len(keys) {
+		last := keys[endIdx-1]
+		nextToken = encodeDateCursor(last.date, last.id)
+	}
+	return ids, nextToken, nil
+}
+
 // paginateIDs applies cursor-based pagination to a sorted slice of IDs.
 // Returns the paginated IDs and the next page token (empty if no more pages).
 func paginateIDs(ids []string, pageSize int32, pageToken string) ([]string, string) {
@@ -160,6 +230,19 @@ func (m *MemoryStore) GetExpense(ctx context.Context, expenseID string) (*pfinan
 	return expense, nil
 }
 
+func (m *MemoryStore) GetExpensesByIDs(ctx context.Context, ids []string) (map[string]*pfinancev1.Expense, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	expenses := make(map[string]*pfinancev1.Expense, len(ids))
+	for _, id := range ids {
+		if expense, ok := m.expenses[id]; ok {
+			expenses[id] = expense
+		}
+	}
+	return expenses, nil
+}
+
 func (m *MemoryStore) UpdateExpense(ctx context.Context, expense *pfinancev1.Expense) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -172,32 +255,71 @@ func (m *MemoryStore) UpdateExpense(ctx context.Context, expense *pfinancev1.Exp
 	return nil
 }
 
-func (m *MemoryStore) ListExpenses(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, pageSize int32, pageToken string) ([]*pfinancev1.Expense, string, error) {
+func (m *MemoryStore) ListExpenses(ctx context.Context, userID, groupID string, filter ExpenseQuery, pageSize int32, pageToken string) ([]*pfinancev1.Expense, string, error) {
+	scope := filter.Scope
+	if err := scope.Validate(userID, groupID); err != nil {
+		return nil, "", err
+	}
+
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	// First pass: collect matching IDs
-	var matchingIDs []string
+	var memberGroups map[string]bool
+	if scope == ExpenseScopeAll {
+		memberGroups = m.memberGroupIDsLocked(userID)
+	}
+
+	// First pass: collect the sort keys of matching expenses
+	var matching []dateSortKey
 	for id, expense := range m.expenses {
-		if userID != "" && expense.UserId != userID {
-			continue
-		}
-		if groupID != "" && expense.GroupId != groupID {
-			continue
-		}
-		if startDate != nil || endDate != nil {
-			expenseTime := expense.Date.AsTime()
-			if startDate != nil && expenseTime.Before(*startDate) {
+		switch scope {
+		case ExpenseScopePersonal:
+			if expense.UserId != userID || expense.GroupId != "" {
+				continue
+			}
+		case ExpenseScopeGroup:
+			if expense.GroupId != groupID {
 				continue
 			}
-			if endDate != nil && expenseTime.After(*endDate) {
+		case ExpenseScopeAll:
+			personal := expense.UserId == userID && expense.GroupId == ""
+			if !personal && !memberGroups[expense.GroupId] {
+				continue
+			}
+		default:
+			if userID != "" && expense.UserId != userID {
+				continue
+			}
+			if groupID != "" && expense.GroupId != groupID {
 				continue
 			}
 		}
-		matchingIDs = append(matchingIDs, id)
+		if filter.Category != nil && expense.Category != *filter.Category {
+			continue
+		}
+		if filter.IsTaxDeductible != nil && expense.IsTaxDeductible != *filter.IsTaxDeductible {
+			continue
+		}
+		if !filter.Tags.Matches(expense.Tags) {
+			continue
+		}
+		if !MatchesAttachment(expense, filter.HasAttachment) {
+			continue
+		}
+		if !dateInRange(expense.Date, filter.StartDate, filter.EndDate) {
+			continue
+		}
+		var date time.Time
+		if expense.Date != nil {
+			date = expense.Date.AsTime()
+		}
+		matching = append(matching, dateSortKey{date: date, id: id})
 	}
 
-	paginatedIDs, nextToken := paginateIDs(matchingIDs, pageSize, pageToken)
+	paginatedIDs, nextToken, err := paginateByDate(matching, pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
 	result := make([]*pfinancev1.Expense, 0, len(paginatedIDs))
 	for _, id := range paginatedIDs {
 		result = append(result, m.expenses[id])
@@ -205,6 +327,120 @@ func (m *MemoryStore) ListExpenses(ctx context.Context, userID, groupID string,
 	return result, nextToken, nil
 }
 
+// memberGroupIDsLocked returns the IDs of the groups userID belongs to. The
+// caller must hold m.mu.
+func (m *MemoryStore) memberGroupIDsLocked(userID string) map[string]bool {
+	ids := make(map[string]bool)
+	for id, group := range m.groups {
+		if slices.Contains(group.MemberIds, userID) {
+			ids[id] = true
+		}
+	}
+	return ids
+}
+
+// CountExpenses mirrors Firestore's collections: group expenses are counted
+// only for their groupID, and personal expenses only without one.
+func (m *MemoryStore) CountExpenses(ctx context.Context, userID, groupID string, startDate, endDate *time.Time) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var count int64
+	for _, expense := range m.expenses {
+		if userID != "" && expense.UserId != userID {
+			continue
+		}
+		if expense.GroupId != groupID {
+			continue
+		}
+		if !dateInRange(expense.Date, startDate, endDate) {
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// ListExpensesMissingCents returns expenses with an amount but no cents value, ordered by ID
+func (m *MemoryStore) ListExpensesMissingCents(ctx context.Context, userID string) ([]*pfinancev1.Expense, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var expenses []*pfinancev1.Expense
+	for _, expense := range m.expenses {
+		if userID != "" && expense.UserId != userID {
+			continue
+		}
+		if MissingCents(expense.AmountCents, expense.Amount) {
+			expenses = append(expenses, expense)
+		}
+	}
+	sort.Slice(expenses, func(i, j int) bool {
+		return expenses[i].Id < expenses[j].Id
+	})
+	return expenses, nil
+}
+
+func (m *MemoryStore) HasMerchantExpense(ctx context.Context, userID, groupID, description string, since, before time.Time) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, expense := range m.expenses {
+		if userID != "" && expense.UserId != userID {
+			continue
+		}
+		if groupID != "" && expense.GroupId != groupID {
+			continue
+		}
+		if expense.Description != description || expense.Date == nil {
+			continue
+		}
+		expenseTime := expense.Date.AsTime()
+		if !expenseTime.Before(since) && expenseTime.Before(before) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MemoryStore) ListTopExpenses(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, category *pfinancev1.ExpenseCategory, limit int) ([]*pfinancev1.Expense, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []*pfinancev1.Expense
+	for _, expense := range m.expenses {
+		if userID != "" && expense.UserId != userID {
+			continue
+		}
+		if groupID != "" && expense.GroupId != groupID {
+			continue
+		}
+		if category != nil && expense.Category != *category {
+			continue
+		}
+		if !dateInRange(expense.Date, startDate, endDate) {
+			continue
+		}
+		result = append(result, expense)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		ai, aj := expenseCents(result[i]), expenseCents(result[j])
+		if ai != aj {
+			return ai > aj
+		}
+		return result[i].Id < result[j].Id
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	// Callers fill in AmountCents on the results, so hand out copies
+	for i, expense := range result {
+		result[i] = proto.Clone(expense).(*pfinancev1.Expense)
+	}
+	return result, nil
+}
+
 func (m *MemoryStore) DeleteExpense(ctx context.Context, expenseID string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -255,11 +491,75 @@ func (m *MemoryStore) DeleteIncome(ctx context.Context, incomeID string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	delete(m.incomes, incomeID)
+	m.deleteIncomesLocked([]string{incomeID})
+	return nil
+}
+
+// BatchDeleteIncomes deletes multiple incomes from the memory store.
+func (m *MemoryStore) BatchDeleteIncomes(ctx context.Context, incomeIDs []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.deleteIncomesLocked(incomeIDs)
 	return nil
 }
 
-func (m *MemoryStore) ListIncomes(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, pageSize int32, pageToken string) ([]*pfinancev1.Income, string, error) {
+// deleteIncomesLocked removes the incomes along with any income contributions
+// that reference them as the source or resulting group income. Caller must hold m.mu.
+func (m *MemoryStore) deleteIncomesLocked(incomeIDs []string) {
+	ids := make(map[string]bool, len(incomeIDs))
+	for _, id := range incomeIDs {
+		ids[id] = true
+		delete(m.incomes, id)
+	}
+	for id, c := range m.incomeContributions {
+		if ids[c.SourceIncomeId] || ids[c.CreatedGroupIncomeId] {
+			delete(m.incomeContributions, id)
+		}
+	}
+}
+
+func (m *MemoryStore) CountIncomes(ctx context.Context, userID, groupID string, startDate, endDate *time.Time) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var count int64
+	for _, income := range m.incomes {
+		if userID != "" && income.UserId != userID {
+			continue
+		}
+		if income.GroupId != groupID {
+			continue
+		}
+		if !dateInRange(income.Date, startDate, endDate) {
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// ListIncomesMissingCents returns incomes with an amount but no cents value, ordered by ID
+func (m *MemoryStore) ListIncomesMissingCents(ctx context.Context, userID string) ([]*pfinancev1.Income, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var incomes []*pfinancev1.Income
+	for _, income := range m.incomes {
+		if userID != "" && income.UserId != userID {
+			continue
+		}
+		if MissingCents(income.AmountCents, income.Amount) {
+			incomes = append(incomes, income)
+		}
+	}
+	sort.Slice(incomes, func(i, j int) bool {
+		return incomes[i].Id < incomes[j].Id
+	})
+	return incomes, nil
+}
+
+func (m *MemoryStore) ListIncomes(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, source string, sortField pfinancev1.SortField, sortDirection pfinancev1.SortDirection, pageSize int32, pageToken string) ([]*pfinancev1.Income, string, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
@@ -271,18 +571,26 @@ func (m *MemoryStore) ListIncomes(ctx context.Context, userID, groupID string, s
 		if groupID != "" && income.GroupId != groupID {
 			continue
 		}
-		if startDate != nil || endDate != nil {
-			incomeTime := income.Date.AsTime()
-			if startDate != nil && incomeTime.Before(*startDate) {
-				continue
-			}
-			if endDate != nil && incomeTime.After(*endDate) {
-				continue
-			}
+		if !dateInRange(income.Date, startDate, endDate) {
+			continue
 		}
 		matchingIDs = append(matchingIDs, id)
 	}
 
+	// Source filtering and sorting reorder results, so page by offset instead of ID cursor
+	if source != "" || sortField != pfinancev1.SortField_SORT_FIELD_UNSPECIFIED {
+		matching := make([]*pfinancev1.Income, 0, len(matchingIDs))
+		for _, id := range matchingIDs {
+			matching = append(matching, m.incomes[id])
+		}
+		matching = filterAndSortIncomes(matching, source, sortField, sortDirection)
+		start, end, nextToken, err := paginateByOffset(len(matching), pageSize, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		return matching[start:end], nextToken, nil
+	}
+
 	paginatedIDs, nextToken := paginateIDs(matchingIDs, pageSize, pageToken)
 	result := make([]*pfinancev1.Income, 0, len(paginatedIDs))
 	for _, id := range paginatedIDs {
@@ -329,22 +637,14 @@ func (m *MemoryStore) UpdateGroup(ctx context.Context, group *pfinancev1.Finance
 	return nil
 }
 
+// ListGroups scans every group for userID in MemberIds, the field the
+// Firestore store queries with array-contains, so both agree on membership.
 func (m *MemoryStore) ListGroups(ctx context.Context, userID string, pageSize int32, pageToken string) ([]*pfinancev1.FinanceGroup, string, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	var matchingIDs []string
-	for id, group := range m.groups {
-		isMember := false
-		for _, member := range group.Members {
-			if member.UserId == userID {
-				isMember = true
-				break
-			}
-		}
-		if !isMember {
-			continue
-		}
+	for id := range m.memberGroupIDsLocked(userID) {
 		matchingIDs = append(matchingIDs, id)
 	}
 
@@ -476,6 +776,26 @@ func (m *MemoryStore) UpdateInviteLink(ctx context.Context, link *pfinancev1.Gro
 	return nil
 }
 
+// RedeemInviteLink validates and consumes one use of an invite link under the store lock.
+func (m *MemoryStore) RedeemInviteLink(ctx context.Context, code string) (*pfinancev1.GroupInviteLink, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, link := range m.inviteLinks {
+		if link.Code != code {
+			continue
+		}
+		if err := ValidateInviteLink(link, time.Now()); err != nil {
+			return nil, err
+		}
+		link.CurrentUses++
+		link.LastUsedAt = timestamppb.Now()
+		return link, nil
+	}
+
+	return nil, fmt.Errorf("invite link not found with code: %s", code)
+}
+
 func (m *MemoryStore) ListInviteLinks(ctx context.Context, groupID string, includeInactive bool, pageSize int32, pageToken string) ([]*pfinancev1.GroupInviteLink, string, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -499,6 +819,24 @@ func (m *MemoryStore) ListInviteLinks(ctx context.Context, groupID string, inclu
 	return result, nextToken, nil
 }
 
+func (m *MemoryStore) ListInviteLinkMembers(ctx context.Context, groupID, linkID string) ([]*pfinancev1.GroupMember, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	group, ok := m.groups[groupID]
+	if !ok {
+		return nil, fmt.Errorf("group not found: %s", groupID)
+	}
+
+	var members []*pfinancev1.GroupMember
+	for _, member := range group.Members {
+		if member.InviteLinkId == linkID {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
 // Contribution operations
 
 func (m *MemoryStore) CreateContribution(ctx context.Context, contribution *pfinancev1.ExpenseContribution) error {
@@ -548,6 +886,24 @@ func (m *MemoryStore) ListContributions(ctx context.Context, groupID, userID str
 	return result, nextToken, nil
 }
 
+// ListContributionsForExpense returns contributions whose source personal expense
+// or resulting group expense is expenseID, oldest first.
+func (m *MemoryStore) ListContributionsForExpense(ctx context.Context, expenseID string) ([]*pfinancev1.ExpenseContribution, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []*pfinancev1.ExpenseContribution
+	for _, c := range m.contributions {
+		if c.SourceExpenseId == expenseID || c.CreatedGroupExpenseId == expenseID {
+			result = append(result, c)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return contributedBefore(result[i].ContributedAt, result[j].ContributedAt, result[i].Id, result[j].Id)
+	})
+	return result, nil
+}
+
 // Income contribution operations
 
 func (m *MemoryStore) CreateIncomeContribution(ctx context.Context, contribution *pfinancev1.IncomeContribution) error {
@@ -597,6 +953,32 @@ func (m *MemoryStore) ListIncomeContributions(ctx context.Context, groupID, user
 	return result, nextToken, nil
 }
 
+// ListIncomeContributionsForIncome returns contributions whose source personal income
+// or resulting group income is incomeID, oldest first.
+func (m *MemoryStore) ListIncomeContributionsForIncome(ctx context.Context, incomeID string) ([]*pfinancev1.IncomeContribution, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []*pfinancev1.IncomeContribution
+	for _, c := range m.incomeContributions {
+		if c.SourceIncomeId == incomeID || c.CreatedGroupIncomeId == incomeID {
+			result = append(result, c)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return contributedBefore(result[i].ContributedAt, result[j].ContributedAt, result[i].Id, result[j].Id)
+	})
+	return result, nil
+}
+
+// contributedBefore orders contributions by time, then ID for stability.
+func contributedBefore(a, b *timestamppb.Timestamp, aID, bID string) bool {
+	if !a.AsTime().Equal(b.AsTime()) {
+		return a.AsTime().Before(b.AsTime())
+	}
+	return aID < bID
+}
+
 // Tax config operations
 
 func (m *MemoryStore) GetTaxConfig(ctx context.Context, userID, groupID string) (*pfinancev1.TaxConfig, error) {
@@ -673,95 +1055,222 @@ func (m *MemoryStore) UpdateBudget(ctx context.Context, budget *pfinancev1.Budge
 	return nil
 }
 
-func (m *MemoryStore) DeleteBudget(ctx context.Context, budgetID string) error {
+func (m *MemoryStore) DeleteBudget(ctx context.Context, budgetID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.budgets, budgetID)
+	return nil
+}
+
+func (m *MemoryStore) ListBudgets(ctx context.Context, userID, groupID string, includeInactive bool, pageSize int32, pageToken string) ([]*pfinancev1.Budget, string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var matchingIDs []string
+	for id, budget := range m.budgets {
+		if !budgetInScope(budget, userID, groupID) {
+			continue
+		}
+		if !includeInactive && !budget.IsActive {
+			continue
+		}
+		matchingIDs = append(matchingIDs, id)
+	}
+
+	paginatedIDs, nextToken := paginateIDs(matchingIDs, pageSize, pageToken)
+	result := make([]*pfinancev1.Budget, 0, len(paginatedIDs))
+	for _, id := range paginatedIDs {
+		result = append(result, m.budgets[id])
+	}
+	return result, nextToken, nil
+}
+
+func (m *MemoryStore) GetBudgetProgress(ctx context.Context, budgetID string, asOfDate time.Time) (*pfinancev1.BudgetProgress, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	budget, ok := m.budgets[budgetID]
+	if !ok {
+		return nil, fmt.Errorf("budget not found: %s", budgetID)
+	}
+
+	// Calculate spent amount by summing matching expenses
+	var spentAmount float64
+	var matched []*pfinancev1.Expense
+	for _, expense := range m.expenses {
+		if budgetIncludesExpense(budget, expense) {
+			spentAmount += expense.Amount
+			matched = append(matched, expense)
+		}
+	}
+
+	return memoryBudgetProgress(budget, spentAmount, matched), nil
+}
+
+// GetAllBudgetProgress computes progress for every active budget of a user or
+// group in a single pass over the stored expenses.
+func (m *MemoryStore) GetAllBudgetProgress(ctx context.Context, userID, groupID string, asOfDate time.Time) ([]*pfinancev1.BudgetProgress, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var budgets []*pfinancev1.Budget
+	for _, budget := range m.budgets {
+		if !budgetInScope(budget, userID, groupID) {
+			continue
+		}
+		if !budget.IsActive {
+			continue
+		}
+		budgets = append(budgets, budget)
+	}
+	if len(budgets) == 0 {
+		return nil, nil
+	}
+	sort.Slice(budgets, func(i, j int) bool { return budgets[i].Id < budgets[j].Id })
+
+	spent := make([]float64, len(budgets))
+	matched := make([][]*pfinancev1.Expense, len(budgets))
+	for _, expense := range m.expenses {
+		for i, budget := range budgets {
+			if budgetIncludesExpense(budget, expense) {
+				spent[i] += expense.Amount
+				matched[i] = append(matched[i], expense)
+			}
+		}
+	}
+
+	result := make([]*pfinancev1.BudgetProgress, len(budgets))
+	for i, budget := range budgets {
+		result[i] = memoryBudgetProgress(budget, spent[i], matched[i])
+	}
+	return result, nil
+}
+
+// budgetInScope reports whether a budget belongs to groupID when it is set,
+// or otherwise is a personal budget of userID (any user when empty), mirroring
+// the Firestore store's separate personal and group budget collections.
+func budgetInScope(budget *pfinancev1.Budget, userID, groupID string) bool {
+	if groupID != "" {
+		return budget.GroupId == groupID
+	}
+	return budget.GroupId == "" && (userID == "" || budget.UserId == userID)
+}
+
+// budgetIncludesExpense reports whether an expense counts towards a budget:
+// same owner, a matching category (if the budget restricts categories), and
+// dated within the budget's start and end dates. A group budget counts every
+// member's expenses in the group; a personal budget only its owner's personal
+// expenses.
+func budgetIncludesExpense(budget *pfinancev1.Budget, expense *pfinancev1.Expense) bool {
+	// Match by user/group
+	if budget.GroupId != "" {
+		if expense.GroupId != budget.GroupId {
+			return false
+		}
+	} else if expense.GroupId != "" || (budget.UserId != "" && expense.UserId != budget.UserId) {
+		return false
+	}
+
+	// Match by category if specified in budget
+	if len(budget.CategoryIds) > 0 {
+		categoryMatch := false
+		for _, catId := range budget.CategoryIds {
+			if expense.Category == catId {
+				categoryMatch = true
+				break
+			}
+		}
+		if !categoryMatch {
+			return false
+		}
+	}
+
+	// Check if expense is within budget period; undated expenses belong to none
+	if expense.Date == nil {
+		return false
+	}
+	expenseTime := expense.Date.AsTime()
+	budgetStart := budget.StartDate.AsTime()
+	budgetEnd := budget.EndDate.AsTime()
+
+	return !expenseTime.Before(budgetStart) && !expenseTime.After(budgetEnd)
+}
+
+// memoryBudgetProgress builds the progress for a budget given its spent amount
+// and the expenses that make it up.
+func memoryBudgetProgress(budget *pfinancev1.Budget, spentAmount float64, expenses []*pfinancev1.Expense) *pfinancev1.BudgetProgress {
+	remainingAmount := budget.Amount - spentAmount
+	percentageUsed := (spentAmount / budget.Amount) * 100
+
+	return &pfinancev1.BudgetProgress{
+		BudgetId:        budget.Id,
+		SpentAmount:     spentAmount,
+		RemainingAmount: remainingAmount,
+		PercentageUsed:  percentageUsed,
+		CategoryCaps:    categoryCapProgress(budget.CategoryCaps, expenses),
+	}
+}
+
+// CreateBudgetTemplate stores a new recurring budget template
+func (m *MemoryStore) CreateBudgetTemplate(ctx context.Context, template *pfinancev1.RecurringBudget) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	delete(m.budgets, budgetID)
+	m.budgetTemplates[template.Id] = template
 	return nil
 }
 
-func (m *MemoryStore) ListBudgets(ctx context.Context, userID, groupID string, includeInactive bool, pageSize int32, pageToken string) ([]*pfinancev1.Budget, string, error) {
+// ListBudgetTemplates lists recurring budget templates, sorted by name
+func (m *MemoryStore) ListBudgetTemplates(ctx context.Context, userID, groupID string) ([]*pfinancev1.RecurringBudget, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	var matchingIDs []string
-	for id, budget := range m.budgets {
-		if userID != "" && budget.UserId != userID {
-			continue
-		}
-		if groupID != "" && budget.GroupId != groupID {
+	var templates []*pfinancev1.RecurringBudget
+	for _, t := range m.budgetTemplates {
+		if groupID != "" && t.GroupId != groupID {
 			continue
 		}
-		if !includeInactive && !budget.IsActive {
+		if groupID == "" && userID != "" && (t.UserId != userID || t.GroupId != "") {
 			continue
 		}
-		matchingIDs = append(matchingIDs, id)
-	}
-
-	paginatedIDs, nextToken := paginateIDs(matchingIDs, pageSize, pageToken)
-	result := make([]*pfinancev1.Budget, 0, len(paginatedIDs))
-	for _, id := range paginatedIDs {
-		result = append(result, m.budgets[id])
+		templates = append(templates, t)
 	}
-	return result, nextToken, nil
+	sortBudgetTemplates(templates)
+	return templates, nil
 }
 
-func (m *MemoryStore) GetBudgetProgress(ctx context.Context, budgetID string, asOfDate time.Time) (*pfinancev1.BudgetProgress, error) {
+// GetBudgetTemplate retrieves a recurring budget template
+func (m *MemoryStore) GetBudgetTemplate(ctx context.Context, templateID string) (*pfinancev1.RecurringBudget, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	budget, ok := m.budgets[budgetID]
+	template, ok := m.budgetTemplates[templateID]
 	if !ok {
-		return nil, fmt.Errorf("budget not found: %s", budgetID)
+		return nil, fmt.Errorf("budget template not found: %s", templateID)
 	}
+	return template, nil
+}
 
-	// Calculate spent amount by summing matching expenses
-	var spentAmount float64
-	for _, expense := range m.expenses {
-		// Match by user/group
-		if budget.UserId != "" && expense.UserId != budget.UserId {
-			continue
-		}
-		if budget.GroupId != "" && expense.GroupId != budget.GroupId {
-			continue
-		}
-
-		// Match by category if specified in budget
-		if len(budget.CategoryIds) > 0 {
-			categoryMatch := false
-			for _, catId := range budget.CategoryIds {
-				if expense.Category == catId {
-					categoryMatch = true
-					break
-				}
-			}
-			if !categoryMatch {
-				continue
-			}
-		}
-
-		// Check if expense is within budget period
-		expenseTime := expense.Date.AsTime()
-		budgetStart := budget.StartDate.AsTime()
-		budgetEnd := budget.EndDate.AsTime()
-
-		if expenseTime.Before(budgetStart) || expenseTime.After(budgetEnd) {
-			continue
-		}
+// UpdateBudgetTemplate replaces a recurring budget template
+func (m *MemoryStore) UpdateBudgetTemplate(ctx context.Context, template *pfinancev1.RecurringBudget) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-		spentAmount += expense.Amount
+	if _, ok := m.budgetTemplates[template.Id]; !ok {
+		return fmt.Errorf("budget template not found: %s", template.Id)
 	}
+	m.budgetTemplates[template.Id] = template
+	return nil
+}
 
-	remainingAmount := budget.Amount - spentAmount
-	percentageUsed := (spentAmount / budget.Amount) * 100
+// DeleteBudgetTemplate deletes a recurring budget template
+func (m *MemoryStore) DeleteBudgetTemplate(ctx context.Context, templateID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-	return &pfinancev1.BudgetProgress{
-		BudgetId:        budgetID,
-		SpentAmount:     spentAmount,
-		RemainingAmount: remainingAmount,
-		PercentageUsed:  percentageUsed,
-	}, nil
+	delete(m.budgetTemplates, templateID)
+	return nil
 }
 
 // User operations
@@ -886,6 +1395,33 @@ func (m *MemoryStore) DeleteUser(ctx context.Context, userID string) error {
 	return nil
 }
 
+// CreateDataClearRecord stores an audit record of a data clear
+func (m *MemoryStore) CreateDataClearRecord(ctx context.Context, record *pfinancev1.DataClearRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if record.Id == "" {
+		record.Id = uuid.New().String()
+	}
+	m.dataClearRecords[record.Id] = record
+	return nil
+}
+
+// ListDataClearRecords lists a user's data clear audit records, newest first
+func (m *MemoryStore) ListDataClearRecords(ctx context.Context, userID string) ([]*pfinancev1.DataClearRecord, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var records []*pfinancev1.DataClearRecord
+	for _, r := range m.dataClearRecords {
+		if r.UserId == userID {
+			records = append(records, r)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].ClearedAt.AsTime().After(records[j].ClearedAt.AsTime())
+	})
+	return records, nil
+}
+
 // ClearUserData deletes all financial data for a user but keeps the account, notification preferences, and API tokens
 func (m *MemoryStore) ClearUserData(ctx context.Context, userID string) error {
 	m.mu.Lock()
@@ -1110,7 +1646,11 @@ func (m *MemoryStore) GetGoalProgress(ctx context.Context, goalID string, asOfDa
 	for _, milestone := range goal.Milestones {
 		if milestone.IsAchieved {
 			achievedMilestones = append(achievedMilestones, milestone)
-		} else if nextMilestone == nil || milestone.TargetPercentage < nextMilestone.TargetPercentage {
+		}
+		// Withdrawals can drop the balance back below an achieved milestone, so
+		// the next milestone is the lowest one the current balance hasn't reached.
+		if percentageComplete < milestone.TargetPercentage &&
+			(nextMilestone == nil || milestone.TargetPercentage < nextMilestone.TargetPercentage) {
 			nextMilestone = milestone
 		}
 	}
@@ -1165,7 +1705,7 @@ func (m *MemoryStore) ListGoalContributions(ctx context.Context, goalID string,
 
 // Search operations
 
-func (m *MemoryStore) SearchTransactions(ctx context.Context, userID, groupID, query, category string, amountMin, amountMax float64, startDate, endDate *time.Time, txType pfinancev1.TransactionType, pageSize int32, pageToken string) ([]*pfinancev1.SearchResult, string, int, error) {
+func (m *MemoryStore) SearchTransactions(ctx context.Context, userID, groupID, query, category string, amountMin, amountMax *float64, startDate, endDate *time.Time, txType pfinancev1.TransactionType, sortBy pfinancev1.SearchSortBy, pageSize int32, pageToken string) ([]*pfinancev1.SearchResult, string, int, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
@@ -1181,16 +1721,16 @@ func (m *MemoryStore) SearchTransactions(ctx context.Context, userID, groupID, q
 			if groupID != "" && expense.GroupId != groupID {
 				continue
 			}
-			if query != "" && !strings.Contains(strings.ToLower(expense.Description), queryLower) {
+			if query != "" && !expenseMatchesQuery(expense, queryLower) {
 				continue
 			}
 			if category != "" && expense.Category.String() != category {
 				continue
 			}
-			if amountMin > 0 && expense.Amount < amountMin {
+			if amountMin != nil && expense.Amount < *amountMin {
 				continue
 			}
-			if amountMax > 0 && expense.Amount > amountMax {
+			if amountMax != nil && expense.Amount > *amountMax {
 				continue
 			}
 			if startDate != nil && expense.Date != nil && expense.Date.AsTime().Before(*startDate) {
@@ -1224,10 +1764,10 @@ func (m *MemoryStore) SearchTransactions(ctx context.Context, userID, groupID, q
 			if query != "" && !strings.Contains(strings.ToLower(income.Source), queryLower) {
 				continue
 			}
-			if amountMin > 0 && income.Amount < amountMin {
+			if amountMin != nil && income.Amount < *amountMin {
 				continue
 			}
-			if amountMax > 0 && income.Amount > amountMax {
+			if amountMax != nil && income.Amount > *amountMax {
 				continue
 			}
 			if startDate != nil && income.Date != nil && income.Date.AsTime().Before(*startDate) {
@@ -1250,13 +1790,7 @@ func (m *MemoryStore) SearchTransactions(ctx context.Context, userID, groupID, q
 
 	totalCount := len(results)
 
-	// Sort by date descending
-	sort.Slice(results, func(i, j int) bool {
-		if results[i].Date == nil || results[j].Date == nil {
-			return results[i].Date != nil
-		}
-		return results[i].Date.AsTime().After(results[j].Date.AsTime())
-	})
+	sortSearchResults(results, sortBy, queryLower)
 
 	// Paginate
 	if pageSize <= 0 {
@@ -1367,16 +1901,33 @@ func (m *MemoryStore) ListRecurringTransactions(ctx context.Context, userID, gro
 
 // Notification operations
 
-func (m *MemoryStore) CreateNotification(ctx context.Context, notification *pfinancev1.Notification) error {
+func (m *MemoryStore) CreateNotification(ctx context.Context, notification *pfinancev1.Notification) (*pfinancev1.Notification, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	if notification.DedupKey != "" {
+		notification.Id = notificationDedupID(notification.UserId, notification.DedupKey)
+		if existing, ok := m.notifications[notification.Id]; ok {
+			return existing, nil
+		}
+	}
 	if notification.Id == "" {
 		notification.Id = uuid.New().String()
 	}
 
 	m.notifications[notification.Id] = notification
-	return nil
+	return notification, nil
+}
+
+func (m *MemoryStore) GetNotification(ctx context.Context, notificationID string) (*pfinancev1.Notification, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	notification, ok := m.notifications[notificationID]
+	if !ok {
+		return nil, fmt.Errorf("notification not found: %s", notificationID)
+	}
+	return notification, nil
 }
 
 func (m *MemoryStore) ListNotifications(ctx context.Context, userID string, unreadOnly bool, typeFilter pfinancev1.NotificationType, pageSize int32, pageToken string) ([]*pfinancev1.Notification, string, error) {
@@ -1466,6 +2017,31 @@ func (m *MemoryStore) MarkAllNotificationsRead(ctx context.Context, userID strin
 	return nil
 }
 
+func (m *MemoryStore) DeleteNotification(ctx context.Context, notificationID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.notifications[notificationID]; !ok {
+		return fmt.Errorf("notification not found: %s", notificationID)
+	}
+	delete(m.notifications, notificationID)
+	return nil
+}
+
+func (m *MemoryStore) DeleteAllReadNotifications(ctx context.Context, userID string) (int32, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var deleted int32
+	for id, notification := range m.notifications {
+		if notification.UserId == userID && notification.IsRead {
+			delete(m.notifications, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
 func (m *MemoryStore) GetUnreadNotificationCount(ctx context.Context, userID string) (int32, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -1479,6 +2055,24 @@ func (m *MemoryStore) GetUnreadNotificationCount(ctx context.Context, userID str
 	return count, nil
 }
 
+func (m *MemoryStore) ListNotificationsInRange(ctx context.Context, userID string, startDate, endDate time.Time) ([]*pfinancev1.Notification, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []*pfinancev1.Notification
+	for _, notification := range m.notifications {
+		if notification.UserId != userID || notification.CreatedAt == nil {
+			continue
+		}
+		createdAt := notification.CreatedAt.AsTime()
+		if createdAt.Before(startDate) || createdAt.After(endDate) {
+			continue
+		}
+		result = append(result, notification)
+	}
+	return result, nil
+}
+
 func (m *MemoryStore) GetNotificationPreferences(ctx context.Context, userID string) (*pfinancev1.NotificationPreferences, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -1571,7 +2165,7 @@ func (m *MemoryStore) GetDailyAggregates(ctx context.Context, userID, groupID st
 			continue
 		}
 
-		dateStr := expenseTime.Format("2006-01-02")
+		dateStr := DailyAggregateDate(expense.Date)
 
 		day, ok := days[dateStr]
 		if !ok {
@@ -1622,6 +2216,34 @@ func (m *MemoryStore) GetDailyAggregates(ctx context.Context, userID, groupID st
 	return result, nil
 }
 
+// categoryBaselineKey keys a baseline by user and category.
+func categoryBaselineKey(userID string, category pfinancev1.ExpenseCategory) string {
+	return fmt.Sprintf("%s_%d", userID, category)
+}
+
+// UpsertCategoryBaseline creates or replaces the baseline for a user's category.
+func (m *MemoryStore) UpsertCategoryBaseline(ctx context.Context, baseline *pfinancev1.CategoryBaseline) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.categoryBaselines[categoryBaselineKey(baseline.UserId, baseline.Category)] = baseline
+	return nil
+}
+
+// GetCategoryBaselines returns every stored category baseline for a user.
+func (m *MemoryStore) GetCategoryBaselines(ctx context.Context, userID string) ([]*pfinancev1.CategoryBaseline, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var baselines []*pfinancev1.CategoryBaseline
+	for _, b := range m.categoryBaselines {
+		if b.UserId == userID {
+			baselines = append(baselines, b)
+		}
+	}
+	return baselines, nil
+}
+
 // CreateCorrectionRecord stores a correction record
 func (m *MemoryStore) CreateCorrectionRecord(ctx context.Context, record *pfinancev1.CorrectionRecord) error {
 	m.mu.Lock()
@@ -1691,6 +2313,38 @@ func (m *MemoryStore) GetMerchantMappings(ctx context.Context, userID string) ([
 	return mappings, nil
 }
 
+// ListMerchantMappings returns one page of a user's merchant mappings, filtered
+// by raw pattern and sorted by sortBy
+func (m *MemoryStore) ListMerchantMappings(ctx context.Context, userID, query string, sortBy pfinancev1.MerchantMappingSort, pageSize int32, pageToken string) ([]*pfinancev1.MerchantMapping, string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var mappings []*pfinancev1.MerchantMapping
+	for _, mm := range m.merchantMappings {
+		if mm.UserId == userID {
+			mappings = append(mappings, mm)
+		}
+	}
+	mappings = filterAndSortMerchantMappings(mappings, query, sortBy)
+	start, end, nextToken, err := paginateByOffset(len(mappings), pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return mappings[start:end], nextToken, nil
+}
+
+// DeleteMerchantMapping deletes a user's mapping for a raw pattern
+func (m *MemoryStore) DeleteMerchantMapping(ctx context.Context, userID, rawPattern string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, mm := range m.merchantMappings {
+		if mm.UserId == userID && strings.EqualFold(mm.RawPattern, rawPattern) {
+			delete(m.merchantMappings, id)
+			return nil
+		}
+	}
+	return fmt.Errorf("merchant mapping not found: %s", rawPattern)
+}
+
 // CreateExtractionEvent stores an extraction event
 func (m *MemoryStore) CreateExtractionEvent(ctx context.Context, event *pfinancev1.ExtractionEvent) error {
 	m.mu.Lock()
@@ -1747,14 +2401,8 @@ func (m *MemoryStore) ListDeductibleExpenses(ctx context.Context, userID, groupI
 		if category != pfinancev1.TaxDeductionCategory_TAX_DEDUCTION_CATEGORY_UNSPECIFIED && expense.TaxDeductionCategory != category {
 			continue
 		}
-		if startDate != nil || endDate != nil {
-			expenseTime := expense.Date.AsTime()
-			if startDate != nil && expenseTime.Before(*startDate) {
-				continue
-			}
-			if endDate != nil && expenseTime.After(*endDate) {
-				continue
-			}
+		if !dateInRange(expense.Date, startDate, endDate) {
+			continue
 		}
 		matchingIDs = append(matchingIDs, id)
 	}
@@ -1767,21 +2415,21 @@ func (m *MemoryStore) ListDeductibleExpenses(ctx context.Context, userID, groupI
 	return result, nextToken, nil
 }
 
-// AggregateDeductionsByCategory sums deductible expenses by TaxDeductionCategory for a date range
+// AggregateDeductionsByCategory sums deductible expenses by TaxDeductionCategory for a date range.
+// The lock is only held while collecting the candidate expenses, not while
+// filtering and summing them.
 func (m *MemoryStore) AggregateDeductionsByCategory(ctx context.Context, userID, groupID string, startDate, endDate time.Time) ([]*pfinancev1.TaxDeductionSummary, error) {
 	m.mu.RLock()
-	defer m.mu.RUnlock()
-
-	type agg struct {
-		totalCents   int64
-		expenseCount int32
-	}
-	byCategory := make(map[pfinancev1.TaxDeductionCategory]*agg)
-
+	candidates := make([]*pfinancev1.Expense, 0, len(m.expenses))
 	for _, expense := range m.expenses {
-		if !expense.IsTaxDeductible {
-			continue
+		if expense.IsTaxDeductible {
+			candidates = append(candidates, expense)
 		}
+	}
+	m.mu.RUnlock()
+
+	totals := make(deductionTotals)
+	for _, expense := range candidates {
 		if userID != "" && expense.UserId != userID {
 			continue
 		}
@@ -1794,36 +2442,9 @@ func (m *MemoryStore) AggregateDeductionsByCategory(ctx context.Context, userID,
 				continue
 			}
 		}
-
-		cat := expense.TaxDeductionCategory
-		pct := expense.TaxDeductiblePercent
-		if pct <= 0 {
-			pct = 1.0
-		}
-
-		cents := expense.AmountCents
-		if cents == 0 {
-			cents = int64(expense.Amount * 100)
-		}
-		deductibleCents := int64(float64(cents) * pct)
-
-		if _, ok := byCategory[cat]; !ok {
-			byCategory[cat] = &agg{}
-		}
-		byCategory[cat].totalCents += deductibleCents
-		byCategory[cat].expenseCount++
+		totals.add(expense)
 	}
-
-	var summaries []*pfinancev1.TaxDeductionSummary
-	for cat, a := range byCategory {
-		summaries = append(summaries, &pfinancev1.TaxDeductionSummary{
-			Category:     cat,
-			TotalCents:   a.totalCents,
-			TotalAmount:  float64(a.totalCents) / 100.0,
-			ExpenseCount: a.expenseCount,
-		})
-	}
-	return summaries, nil
+	return totals.summaries(), nil
 }
 
 // UpsertTaxDeductibilityMapping upserts a tax deductibility mapping
@@ -1996,6 +2617,127 @@ func (m *MemoryStore) CountActiveApiTokens(ctx context.Context, userID string) (
 	return count, nil
 }
 
+// ============================================================================
+// Import profile operations
+// ============================================================================
+
+// CreateImportProfile stores a new import profile
+func (m *MemoryStore) CreateImportProfile(ctx context.Context, profile *pfinancev1.ImportProfile) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.importProfiles[profile.Id] = profile
+	return nil
+}
+
+// GetImportProfile retrieves an import profile by ID
+func (m *MemoryStore) GetImportProfile(ctx context.Context, profileID string) (*pfinancev1.ImportProfile, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	profile, ok := m.importProfiles[profileID]
+	if !ok {
+		return nil, fmt.Errorf("import profile not found: %s", profileID)
+	}
+	// Callers edit the result before validating it, so hand out a copy
+	return proto.Clone(profile).(*pfinancev1.ImportProfile), nil
+}
+
+// UpdateImportProfile replaces an existing import profile
+func (m *MemoryStore) UpdateImportProfile(ctx context.Context, profile *pfinancev1.ImportProfile) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.importProfiles[profile.Id]; !ok {
+		return fmt.Errorf("import profile not found: %s", profile.Id)
+	}
+	m.importProfiles[profile.Id] = profile
+	return nil
+}
+
+// DeleteImportProfile deletes an import profile
+func (m *MemoryStore) DeleteImportProfile(ctx context.Context, profileID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.importProfiles, profileID)
+	return nil
+}
+
+// ListImportProfiles lists a user's import profiles, sorted by name
+func (m *MemoryStore) ListImportProfiles(ctx context.Context, userID string) ([]*pfinancev1.ImportProfile, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var profiles []*pfinancev1.ImportProfile
+	for _, p := range m.importProfiles {
+		if p.UserId == userID {
+			profiles = append(profiles, p)
+		}
+	}
+	sortImportProfiles(profiles)
+	return profiles, nil
+}
+
+// ============================================================================
+// Extraction preference operations
+// ============================================================================
+
+// GetExtractionPreferences returns a user's extraction preferences
+func (m *MemoryStore) GetExtractionPreferences(ctx context.Context, userID string) (*pfinancev1.ExtractionPreferences, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	prefs, ok := m.extractionPreferences[userID]
+	if !ok {
+		return &pfinancev1.ExtractionPreferences{UserId: userID}, nil
+	}
+	return prefs, nil
+}
+
+// UpdateExtractionPreferences stores a user's extraction preferences
+func (m *MemoryStore) UpdateExtractionPreferences(ctx context.Context, prefs *pfinancev1.ExtractionPreferences) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.extractionPreferences[prefs.UserId] = prefs
+	return nil
+}
+
+// DeleteExtractionPreferences removes a user's extraction preferences
+func (m *MemoryStore) DeleteExtractionPreferences(ctx context.Context, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.extractionPreferences, userID)
+	return nil
+}
+
+// ============================================================================
+// Webhook event operations (dedup)
+// ============================================================================
+
+// ClaimWebhookEvent records a webhook event ID, reporting false if it was already recorded
+func (m *MemoryStore) ClaimWebhookEvent(ctx context.Context, eventID string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.webhookEvents[eventID]; ok {
+		return false, nil
+	}
+	m.webhookEvents[eventID] = time.Now()
+	return true, nil
+}
+
+// ReleaseWebhookEvent forgets a webhook event ID so the event can be processed again
+func (m *MemoryStore) ReleaseWebhookEvent(ctx context.Context, eventID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.webhookEvents, eventID)
+	return nil
+}
+
 // ============================================================================
 // Processed Statement operations (dedup)
 // ============================================================================
@@ -2054,3 +2796,97 @@ func (m *MemoryStore) FindOverlappingStatements(ctx context.Context, userID, ban
 	}
 	return results, nil
 }
+
+// RunInTransaction runs fn against a deep copy of the store and swaps the copy in
+// only if fn succeeds, so a failed fn leaves no partial writes behind. The store
+// lock is held throughout, so fn must only use txStore.
+func (m *MemoryStore) RunInTransaction(ctx context.Context, fn func(txStore Store) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tx := m.snapshot()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	m.adopt(tx)
+	return nil
+}
+
+// snapshot returns a deep copy of the store's data. Callers must hold m.mu.
+func (m *MemoryStore) snapshot() *MemoryStore {
+	statements := make([]*pfinancev1.ProcessedStatement, len(m.processedStatements))
+	for i, stmt := range m.processedStatements {
+		statements[i] = proto.Clone(stmt).(*pfinancev1.ProcessedStatement)
+	}
+	return &MemoryStore{
+		expenses:                 cloneMessages(m.expenses),
+		incomes:                  cloneMessages(m.incomes),
+		groups:                   cloneMessages(m.groups),
+		invitations:              cloneMessages(m.invitations),
+		inviteLinks:              cloneMessages(m.inviteLinks),
+		contributions:            cloneMessages(m.contributions),
+		incomeContributions:      cloneMessages(m.incomeContributions),
+		taxConfigs:               cloneMessages(m.taxConfigs),
+		budgets:                  cloneMessages(m.budgets),
+		budgetTemplates:          cloneMessages(m.budgetTemplates),
+		users:                    cloneMessages(m.users),
+		goals:                    cloneMessages(m.goals),
+		goalContributions:        cloneMessages(m.goalContributions),
+		recurringTransactions:    cloneMessages(m.recurringTransactions),
+		notifications:            cloneMessages(m.notifications),
+		notificationPreferences:  cloneMessages(m.notificationPreferences),
+		correctionRecords:        cloneMessages(m.correctionRecords),
+		dataClearRecords:         cloneMessages(m.dataClearRecords),
+		merchantMappings:         cloneMessages(m.merchantMappings),
+		extractionEvents:         cloneMessages(m.extractionEvents),
+		taxDeductibilityMappings: cloneMessages(m.taxDeductibilityMappings),
+		categoryOverrides:        cloneMessages(m.categoryOverrides),
+		apiTokens:                cloneMessages(m.apiTokens),
+		categoryBaselines:        cloneMessages(m.categoryBaselines),
+		processedStatements:      statements,
+		importProfiles:           cloneMessages(m.importProfiles),
+		extractionPreferences:    cloneMessages(m.extractionPreferences),
+		webhookEvents:            maps.Clone(m.webhookEvents),
+	}
+}
+
+// adopt replaces the store's data with tx's committed data. Callers must hold m.mu.
+func (m *MemoryStore) adopt(tx *MemoryStore) {
+	m.expenses = tx.expenses
+	m.incomes = tx.incomes
+	m.groups = tx.groups
+	m.invitations = tx.invitations
+	m.inviteLinks = tx.inviteLinks
+	m.contributions = tx.contributions
+	m.incomeContributions = tx.incomeContributions
+	m.taxConfigs = tx.taxConfigs
+	m.budgets = tx.budgets
+	m.budgetTemplates = tx.budgetTemplates
+	m.users = tx.users
+	m.goals = tx.goals
+	m.goalContributions = tx.goalContributions
+	m.recurringTransactions = tx.recurringTransactions
+	m.notifications = tx.notifications
+	m.notificationPreferences = tx.notificationPreferences
+	m.correctionRecords = tx.correctionRecords
+	m.dataClearRecords = tx.dataClearRecords
+	m.merchantMappings = tx.merchantMappings
+	m.extractionEvents = tx.extractionEvents
+	m.taxDeductibilityMappings = tx.taxDeductibilityMappings
+	m.categoryOverrides = tx.categoryOverrides
+	m.apiTokens = tx.apiTokens
+	m.categoryBaselines = tx.categoryBaselines
+	m.processedStatements = tx.processedStatements
+	m.importProfiles = tx.importProfiles
+	m.extractionPreferences = tx.extractionPreferences
+	m.webhookEvents = tx.webhookEvents
+}
+
+// cloneMessages deep-copies a map of proto messages.
+func cloneMessages[M proto.Message](src map[string]M) map[string]M {
+	dst := make(map[string]M, len(src))
+	for k, v := range src {
+		dst[k] = proto.Clone(v).(M)
+	}
+	return dst
+}
@@ -2,10 +2,24 @@ package store
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 //go:generate mockgen -source=store.go -destination=store_mock.go -package=store
@@ -17,16 +31,33 @@ type Store interface {
 	BatchCreateExpenses(ctx context.Context, expenses []*pfinancev1.Expense) error
 	BatchDeleteExpenses(ctx context.Context, expenseIDs []string) error
 	GetExpense(ctx context.Context, expenseID string) (*pfinancev1.Expense, error)
+	GetExpensesByIDs(ctx context.Context, ids []string) (map[string]*pfinancev1.Expense, error)
 	UpdateExpense(ctx context.Context, expense *pfinancev1.Expense) error
 	DeleteExpense(ctx context.Context, expenseID string) error
-	ListExpenses(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, pageSize int32, pageToken string) ([]*pfinancev1.Expense, string, error)
+	ListExpenses(ctx context.Context, userID, groupID string, filter ExpenseQuery, pageSize int32, pageToken string) ([]*pfinancev1.Expense, string, error)
+	// CountExpenses counts expenses dated in [startDate, endDate]. A groupID
+	// counts the group's expenses, narrowed to userID's when both are set; a
+	// userID alone counts the user's personal expenses.
+	CountExpenses(ctx context.Context, userID, groupID string, startDate, endDate *time.Time) (int64, error)
+	// HasMerchantExpense reports whether the user or group has an expense with
+	// exactly this description dated in [since, before).
+	HasMerchantExpense(ctx context.Context, userID, groupID, description string, since, before time.Time) (bool, error)
+	ListTopExpenses(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, category *pfinancev1.ExpenseCategory, limit int) ([]*pfinancev1.Expense, error)
+	// ListExpensesMissingCents returns the user's personal and group expenses
+	// for which MissingCents holds. An empty userID scans every user.
+	ListExpensesMissingCents(ctx context.Context, userID string) ([]*pfinancev1.Expense, error)
 
 	// Income operations
 	CreateIncome(ctx context.Context, income *pfinancev1.Income) error
 	GetIncome(ctx context.Context, incomeID string) (*pfinancev1.Income, error)
 	UpdateIncome(ctx context.Context, income *pfinancev1.Income) error
 	DeleteIncome(ctx context.Context, incomeID string) error
-	ListIncomes(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, pageSize int32, pageToken string) ([]*pfinancev1.Income, string, error)
+	BatchDeleteIncomes(ctx context.Context, incomeIDs []string) error
+	ListIncomes(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, source string, sortField pfinancev1.SortField, sortDirection pfinancev1.SortDirection, pageSize int32, pageToken string) ([]*pfinancev1.Income, string, error)
+	// CountIncomes is CountExpenses for incomes.
+	CountIncomes(ctx context.Context, userID, groupID string, startDate, endDate *time.Time) (int64, error)
+	// ListIncomesMissingCents is ListExpensesMissingCents for incomes.
+	ListIncomesMissingCents(ctx context.Context, userID string) ([]*pfinancev1.Income, error)
 
 	// Group operations
 	CreateGroup(ctx context.Context, group *pfinancev1.FinanceGroup) error
@@ -47,16 +78,20 @@ type Store interface {
 	GetInviteLinkByCode(ctx context.Context, code string) (*pfinancev1.GroupInviteLink, error)
 	UpdateInviteLink(ctx context.Context, link *pfinancev1.GroupInviteLink) error
 	ListInviteLinks(ctx context.Context, groupID string, includeInactive bool, pageSize int32, pageToken string) ([]*pfinancev1.GroupInviteLink, string, error)
+	RedeemInviteLink(ctx context.Context, code string) (*pfinancev1.GroupInviteLink, error)
+	ListInviteLinkMembers(ctx context.Context, groupID, linkID string) ([]*pfinancev1.GroupMember, error)
 
 	// Expense contribution operations
 	CreateContribution(ctx context.Context, contribution *pfinancev1.ExpenseContribution) error
 	GetContribution(ctx context.Context, contributionID string) (*pfinancev1.ExpenseContribution, error)
 	ListContributions(ctx context.Context, groupID, userID string, pageSize int32, pageToken string) ([]*pfinancev1.ExpenseContribution, string, error)
+	ListContributionsForExpense(ctx context.Context, expenseID string) ([]*pfinancev1.ExpenseContribution, error)
 
 	// Income contribution operations
 	CreateIncomeContribution(ctx context.Context, contribution *pfinancev1.IncomeContribution) error
 	GetIncomeContribution(ctx context.Context, contributionID string) (*pfinancev1.IncomeContribution, error)
 	ListIncomeContributions(ctx context.Context, groupID, userID string, pageSize int32, pageToken string) ([]*pfinancev1.IncomeContribution, string, error)
+	ListIncomeContributionsForIncome(ctx context.Context, incomeID string) ([]*pfinancev1.IncomeContribution, error)
 
 	// Tax config operations
 	GetTaxConfig(ctx context.Context, userID, groupID string) (*pfinancev1.TaxConfig, error)
@@ -69,12 +104,24 @@ type Store interface {
 	DeleteBudget(ctx context.Context, budgetID string) error
 	ListBudgets(ctx context.Context, userID, groupID string, includeInactive bool, pageSize int32, pageToken string) ([]*pfinancev1.Budget, string, error)
 	GetBudgetProgress(ctx context.Context, budgetID string, asOfDate time.Time) (*pfinancev1.BudgetProgress, error)
+	GetAllBudgetProgress(ctx context.Context, userID, groupID string, asOfDate time.Time) ([]*pfinancev1.BudgetProgress, error)
+
+	// Budget template operations
+	CreateBudgetTemplate(ctx context.Context, template *pfinancev1.RecurringBudget) error
+	// ListBudgetTemplates lists a user's or group's templates; with both empty
+	// it lists every template.
+	ListBudgetTemplates(ctx context.Context, userID, groupID string) ([]*pfinancev1.RecurringBudget, error)
+	GetBudgetTemplate(ctx context.Context, templateID string) (*pfinancev1.RecurringBudget, error)
+	UpdateBudgetTemplate(ctx context.Context, template *pfinancev1.RecurringBudget) error
+	DeleteBudgetTemplate(ctx context.Context, templateID string) error
 
 	// User operations
 	GetUser(ctx context.Context, userID string) (*pfinancev1.User, error)
 	UpdateUser(ctx context.Context, user *pfinancev1.User) error
 	DeleteUser(ctx context.Context, userID string) error
 	ClearUserData(ctx context.Context, userID string) error
+	CreateDataClearRecord(ctx context.Context, record *pfinancev1.DataClearRecord) error
+	ListDataClearRecords(ctx context.Context, userID string) ([]*pfinancev1.DataClearRecord, error)
 
 	// Goal operations
 	CreateGoal(ctx context.Context, goal *pfinancev1.FinancialGoal) error
@@ -89,7 +136,7 @@ type Store interface {
 	ListGoalContributions(ctx context.Context, goalID string, pageSize int32, pageToken string) ([]*pfinancev1.GoalContribution, string, error)
 
 	// Search operations
-	SearchTransactions(ctx context.Context, userID, groupID, query, category string, amountMin, amountMax float64, startDate, endDate *time.Time, txType pfinancev1.TransactionType, pageSize int32, pageToken string) ([]*pfinancev1.SearchResult, string, int, error)
+	SearchTransactions(ctx context.Context, userID, groupID, query, category string, amountMin, amountMax *float64, startDate, endDate *time.Time, txType pfinancev1.TransactionType, sortBy pfinancev1.SearchSortBy, pageSize int32, pageToken string) ([]*pfinancev1.SearchResult, string, int, error)
 
 	// Recurring transaction operations
 	CreateRecurringTransaction(ctx context.Context, rt *pfinancev1.RecurringTransaction) error
@@ -99,23 +146,37 @@ type Store interface {
 	ListRecurringTransactions(ctx context.Context, userID, groupID string, status pfinancev1.RecurringTransactionStatus, filterIsExpense bool, isExpense bool, pageSize int32, pageToken string) ([]*pfinancev1.RecurringTransaction, string, error)
 
 	// Notification operations
-	CreateNotification(ctx context.Context, notification *pfinancev1.Notification) error
+	// CreateNotification stores a notification and returns it. A notification
+	// with a DedupKey is created at most once per user and key: if one already
+	// exists, it is returned instead and nothing is written.
+	CreateNotification(ctx context.Context, notification *pfinancev1.Notification) (*pfinancev1.Notification, error)
+	GetNotification(ctx context.Context, notificationID string) (*pfinancev1.Notification, error)
 	ListNotifications(ctx context.Context, userID string, unreadOnly bool, typeFilter pfinancev1.NotificationType, pageSize int32, pageToken string) ([]*pfinancev1.Notification, string, error)
 	MarkNotificationRead(ctx context.Context, notificationID string) error
 	MarkAllNotificationsRead(ctx context.Context, userID string) error
+	DeleteNotification(ctx context.Context, notificationID string) error
+	DeleteAllReadNotifications(ctx context.Context, userID string) (int32, error)
 	GetUnreadNotificationCount(ctx context.Context, userID string) (int32, error)
+	ListNotificationsInRange(ctx context.Context, userID string, startDate, endDate time.Time) ([]*pfinancev1.Notification, error)
 	GetNotificationPreferences(ctx context.Context, userID string) (*pfinancev1.NotificationPreferences, error)
 	UpdateNotificationPreferences(ctx context.Context, prefs *pfinancev1.NotificationPreferences) error
+	// HasNotification reports whether a matching notification was created in
+	// the last withinHours. It is a read before a write, so prefer a DedupKey
+	// on CreateNotification where duplicates matter.
 	HasNotification(ctx context.Context, userID string, notifType pfinancev1.NotificationType, referenceID string, metadataKey string, metadataValue string, withinHours int) (bool, error)
 
 	// Analytics operations
 	GetDailyAggregates(ctx context.Context, userID, groupID string, startDate, endDate time.Time) ([]*pfinancev1.DailyAggregate, error)
+	UpsertCategoryBaseline(ctx context.Context, baseline *pfinancev1.CategoryBaseline) error
+	GetCategoryBaselines(ctx context.Context, userID string) ([]*pfinancev1.CategoryBaseline, error)
 
 	// ML Feedback operations
 	CreateCorrectionRecord(ctx context.Context, record *pfinancev1.CorrectionRecord) error
 	ListCorrectionRecords(ctx context.Context, userID string, limit int) ([]*pfinancev1.CorrectionRecord, error)
 	UpsertMerchantMapping(ctx context.Context, mapping *pfinancev1.MerchantMapping) error
 	GetMerchantMappings(ctx context.Context, userID string) ([]*pfinancev1.MerchantMapping, error)
+	ListMerchantMappings(ctx context.Context, userID, query string, sortBy pfinancev1.MerchantMappingSort, pageSize int32, pageToken string) ([]*pfinancev1.MerchantMapping, string, error)
+	DeleteMerchantMapping(ctx context.Context, userID, rawPattern string) error
 	CreateExtractionEvent(ctx context.Context, event *pfinancev1.ExtractionEvent) error
 	ListExtractionEvents(ctx context.Context, userID string, since time.Time) ([]*pfinancev1.ExtractionEvent, error)
 
@@ -142,6 +203,35 @@ type Store interface {
 	RevokeApiToken(ctx context.Context, tokenID string) error
 	UpdateApiTokenLastUsed(ctx context.Context, tokenID string, lastUsed time.Time) error
 	CountActiveApiTokens(ctx context.Context, userID string) (int, error)
+
+	// Import profile operations
+	CreateImportProfile(ctx context.Context, profile *pfinancev1.ImportProfile) error
+	GetImportProfile(ctx context.Context, profileID string) (*pfinancev1.ImportProfile, error)
+	UpdateImportProfile(ctx context.Context, profile *pfinancev1.ImportProfile) error
+	DeleteImportProfile(ctx context.Context, profileID string) error
+	ListImportProfiles(ctx context.Context, userID string) ([]*pfinancev1.ImportProfile, error)
+
+	// Extraction preference operations
+	// GetExtractionPreferences returns preferences with no thresholds set when
+	// the user has not saved any.
+	GetExtractionPreferences(ctx context.Context, userID string) (*pfinancev1.ExtractionPreferences, error)
+	UpdateExtractionPreferences(ctx context.Context, prefs *pfinancev1.ExtractionPreferences) error
+	DeleteExtractionPreferences(ctx context.Context, userID string) error
+
+	// Webhook event deduplication
+	// ClaimWebhookEvent records eventID as being processed and reports false if
+	// it was already claimed. ReleaseWebhookEvent undoes a claim so a failed
+	// delivery can be retried.
+	ClaimWebhookEvent(ctx context.Context, eventID string) (bool, error)
+	ReleaseWebhookEvent(ctx context.Context, eventID string) error
+
+	// Transactions
+	// RunInTransaction runs fn against a transactional view of the store. Writes
+	// made through txStore are committed together if fn returns nil and discarded
+	// otherwise. fn may be retried, so it must only use txStore and must not have
+	// side effects outside it. Not every txStore method is transactional; see
+	// firestoreTxStore for the ones that are.
+	RunInTransaction(ctx context.Context, fn func(txStore Store) error) error
 }
 
 // EncodePageToken encodes a document ID into a page token.
@@ -163,3 +253,595 @@ func DecodePageToken(token string) (string, error) {
 	}
 	return string(b), nil
 }
+
+// queryTokenPrefix marks page tokens bound to a query fingerprint by
+// EncodeQueryPageToken.
+const queryTokenPrefix = "q1:"
+
+// ErrPageTokenMismatch is returned by DecodeQueryPageToken for a page token
+// issued for a different query.
+var ErrPageTokenMismatch = errors.New("page token was issued for a different query")
+
+// QueryFingerprint hashes the parameters that select and order a list query,
+// so a page token can be tied to the query it was issued for. Pointers are
+// compared by the value they point to.
+func QueryFingerprint(params ...any) string {
+	h := sha256.New()
+	for _, p := range params {
+		if v := reflect.ValueOf(p); v.Kind() == reflect.Pointer && !v.IsNil() {
+			if _, ok := p.(fmt.Stringer); !ok {
+				p = v.Elem().Interface()
+			}
+		}
+		fmt.Fprintf(h, "%v\x00", p)
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// EncodeQueryPageToken binds a store page token to the fingerprint of the
+// query that produced it.
+func EncodeQueryPageToken(token, fingerprint string) string {
+	if token == "" {
+		return ""
+	}
+	return EncodePageToken(queryTokenPrefix + fingerprint + "|" + token)
+}
+
+// DecodeQueryPageToken returns the store page token inside a token from
+// EncodeQueryPageToken, or ErrPageTokenMismatch if it was issued for a query
+// with a different fingerprint. Legacy tokens without a fingerprint are
+// returned unchanged.
+func DecodeQueryPageToken(token, fingerprint string) (string, error) {
+	decoded, err := DecodePageToken(token)
+	if err != nil {
+		return "", fmt.Errorf("invalid page token: %w", err)
+	}
+	rest, found := strings.CutPrefix(decoded, queryTokenPrefix)
+	if !found {
+		return token, nil
+	}
+	tokenFingerprint, inner, found := strings.Cut(rest, "|")
+	if !found {
+		return "", fmt.Errorf("invalid page token: malformed query token")
+	}
+	if tokenFingerprint != fingerprint {
+		return "", ErrPageTokenMismatch
+	}
+	return inner, nil
+}
+
+// dateCursorPrefix marks page tokens that carry a (date, ID) sort key rather
+// than a bare document ID.
+const dateCursorPrefix = "d1:"
+
+// encodeDateCursor encodes the (date, ID) sort key of the last item on a page.
+// A zero date stands for a missing date, which sorts first.
+func encodeDateCursor(date time.Time, docID string) string {
+	var d string
+	if !date.IsZero() {
+		d = date.UTC().Format(time.RFC3339Nano)
+	}
+	return EncodePageToken(dateCursorPrefix + d + "|" + docID)
+}
+
+// decodeDateCursor decodes a token from encodeDateCursor. ok is false for
+// legacy tokens holding only a document ID, which is returned as docID.
+func decodeDateCursor(token string) (date time.Time, docID string, ok bool, err error) {
+	decoded, err := DecodePageToken(token)
+	if err != nil {
+		return time.Time{}, "", false, err
+	}
+	rest, found := strings.CutPrefix(decoded, dateCursorPrefix)
+	if !found {
+		return time.Time{}, decoded, false, nil
+	}
+	d, docID, found := strings.Cut(rest, "|")
+	if !found || docID == "" {
+		return time.Time{}, "", false, fmt.Errorf("malformed date cursor")
+	}
+	if d != "" {
+		if date, err = time.Parse(time.RFC3339Nano, d); err != nil {
+			return time.Time{}, "", false, fmt.Errorf("malformed date cursor: %w", err)
+		}
+	}
+	return date, docID, true, nil
+}
+
+// Invite link redemption errors returned by RedeemInviteLink and ValidateInviteLink.
+var (
+	ErrInviteLinkInactive  = errors.New("invite link is no longer active")
+	ErrInviteLinkExpired   = errors.New("invite link has expired")
+	ErrInviteLinkExhausted = errors.New("invite link has reached maximum uses")
+)
+
+// ValidateInviteLink reports whether an invite link can still be redeemed at now.
+func ValidateInviteLink(link *pfinancev1.GroupInviteLink, now time.Time) error {
+	if !link.IsActive {
+		return ErrInviteLinkInactive
+	}
+	if link.ExpiresAt != nil && link.ExpiresAt.AsTime().Before(now) {
+		return ErrInviteLinkExpired
+	}
+	if link.MaxUses > 0 && link.CurrentUses >= link.MaxUses {
+		return ErrInviteLinkExhausted
+	}
+	return nil
+}
+
+// filterAndSortIncomes applies the case-insensitive source filter and requested
+// ordering to incomes. Without a sort field, incomes are ordered by ID so offset
+// pagination stays stable. Ties are broken by ID.
+func filterAndSortIncomes(incomes []*pfinancev1.Income, source string, sortField pfinancev1.SortField, sortDirection pfinancev1.SortDirection) []*pfinancev1.Income {
+	needle := strings.ToLower(strings.TrimSpace(source))
+	filtered := make([]*pfinancev1.Income, 0, len(incomes))
+	for _, income := range incomes {
+		if needle != "" && !strings.Contains(strings.ToLower(income.Source), needle) {
+			continue
+		}
+		filtered = append(filtered, income)
+	}
+
+	asc := sortDirection == pfinancev1.SortDirection_SORT_DIRECTION_ASC
+	sort.SliceStable(filtered, func(i, j int) bool {
+		a, b := filtered[i], filtered[j]
+		var less, greater bool
+		switch sortField {
+		case pfinancev1.SortField_SORT_FIELD_AMOUNT:
+			ac, bc := incomeCents(a), incomeCents(b)
+			less, greater = ac < bc, ac > bc
+		case pfinancev1.SortField_SORT_FIELD_DATE:
+			at, bt := a.Date.AsTime(), b.Date.AsTime()
+			less, greater = at.Before(bt), at.After(bt)
+		default:
+			return a.Id < b.Id
+		}
+		if less == greater {
+			return a.Id < b.Id
+		}
+		if asc {
+			return less
+		}
+		return greater
+	})
+	return filtered
+}
+
+// filterAndSortMerchantMappings keeps the mappings whose raw pattern contains
+// query (case-insensitive) and orders them highest first by sortBy, breaking
+// ties by ID.
+func filterAndSortMerchantMappings(mappings []*pfinancev1.MerchantMapping, query string, sortBy pfinancev1.MerchantMappingSort) []*pfinancev1.MerchantMapping {
+	needle := strings.ToLower(strings.TrimSpace(query))
+	filtered := make([]*pfinancev1.MerchantMapping, 0, len(mappings))
+	for _, m := range mappings {
+		if needle != "" && !strings.Contains(strings.ToLower(m.RawPattern), needle) {
+			continue
+		}
+		filtered = append(filtered, m)
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		a, b := filtered[i], filtered[j]
+		if sortBy == pfinancev1.MerchantMappingSort_MERCHANT_MAPPING_SORT_LAST_USED {
+			at, bt := a.LastUsed.AsTime(), b.LastUsed.AsTime()
+			if !at.Equal(bt) {
+				return at.After(bt)
+			}
+		} else if a.Confidence != b.Confidence {
+			return a.Confidence > b.Confidence
+		}
+		return a.Id < b.Id
+	})
+	return filtered
+}
+
+// expenseMatchesQuery reports whether an expense's description, note or tax
+// deduction note contains queryLower, which must already be lowercased.
+func expenseMatchesQuery(expense *pfinancev1.Expense, queryLower string) bool {
+	return strings.Contains(strings.ToLower(expense.Description), queryLower) ||
+		strings.Contains(strings.ToLower(expense.Note), queryLower) ||
+		strings.Contains(strings.ToLower(expense.TaxDeductionNote), queryLower)
+}
+
+// sortSearchResults orders search results for sortBy: newest first by
+// default, or by searchRelevance to queryLower for SEARCH_SORT_BY_RELEVANCE
+// with newest first among equal scores. Undated results sort after dated ones
+// and IDs break remaining ties, so page tokens stay stable.
+func sortSearchResults(results []*pfinancev1.SearchResult, sortBy pfinancev1.SearchSortBy, queryLower string) {
+	var scores map[*pfinancev1.SearchResult]float64
+	if sortBy == pfinancev1.SearchSortBy_SEARCH_SORT_BY_RELEVANCE {
+		scores = make(map[*pfinancev1.SearchResult]float64, len(results))
+		for _, r := range results {
+			scores[r] = searchRelevance(r, queryLower)
+		}
+	}
+	slices.SortFunc(results, func(a, b *pfinancev1.SearchResult) int {
+		if scores != nil && scores[a] != scores[b] {
+			if scores[a] > scores[b] {
+				return -1
+			}
+			return 1
+		}
+		switch {
+		case a.Date == nil && b.Date != nil:
+			return 1
+		case a.Date != nil && b.Date == nil:
+			return -1
+		case a.Date != nil && b.Date != nil:
+			if c := b.Date.AsTime().Compare(a.Date.AsTime()); c != 0 {
+				return c
+			}
+		}
+		return strings.Compare(a.Id, b.Id)
+	})
+}
+
+// searchRelevance scores how well a search result matches queryLower, which
+// must already be lowercased; higher is better. The description scores 100
+// for an exact match, 75 for a prefix, 25-50 for a match at the start of a
+// later word and 0-25 for a match inside a word, earlier matches scoring
+// higher. A query word naming the result's category or its exact amount adds
+// 20 each. A result that matched only on a note scores nothing for its
+// description.
+func searchRelevance(result *pfinancev1.SearchResult, queryLower string) float64 {
+	queryLower = strings.TrimSpace(queryLower)
+	if queryLower == "" {
+		return 0
+	}
+
+	var score float64
+	desc := strings.ToLower(strings.TrimSpace(result.Description))
+	if pos := strings.Index(desc, queryLower); pos >= 0 {
+		position := float64(pos) / float64(len(desc))
+		prev, _ := utf8.DecodeLastRuneInString(desc[:pos])
+		switch {
+		case desc == queryLower:
+			score = 100
+		case pos == 0:
+			score = 75
+		case !unicode.IsLetter(prev) && !unicode.IsDigit(prev):
+			score = 50 - 25*position
+		default:
+			score = 25 * (1 - position)
+		}
+	}
+
+	category := strings.ToLower(strings.TrimPrefix(result.Category, "EXPENSE_CATEGORY_"))
+	amountCents := result.AmountCents
+	if amountCents == 0 {
+		amountCents = ToCents(result.Amount)
+	}
+	var categoryMatched, amountMatched bool
+	for _, word := range strings.Fields(queryLower) {
+		if category != "" && word == category {
+			categoryMatched = true
+		}
+		if v, err := strconv.ParseFloat(strings.TrimPrefix(word, "$"), 64); err == nil && ToCents(v) == amountCents {
+			amountMatched = true
+		}
+	}
+	if categoryMatched {
+		score += 20
+	}
+	if amountMatched {
+		score += 20
+	}
+	return score
+}
+
+// expenseCents returns the expense amount in cents, falling back to the legacy dollar field.
+func expenseCents(expense *pfinancev1.Expense) int64 {
+	if expense.AmountCents != 0 {
+		return expense.AmountCents
+	}
+	return ToCents(expense.Amount)
+}
+
+// categoryCapProgress returns the spend against each of a budget's category
+// caps, given the expenses counted towards the budget.
+func categoryCapProgress(caps []*pfinancev1.CategoryCap, expenses []*pfinancev1.Expense) []*pfinancev1.CategoryCapProgress {
+	if len(caps) == 0 {
+		return nil
+	}
+	spent := make(map[pfinancev1.ExpenseCategory]int64)
+	for _, expense := range expenses {
+		spent[expense.Category] += expenseCents(expense)
+	}
+	result := make([]*pfinancev1.CategoryCapProgress, 0, len(caps))
+	for _, c := range caps {
+		p := &pfinancev1.CategoryCapProgress{
+			Category:       c.Category,
+			CapCents:       c.CapCents,
+			SpentCents:     spent[c.Category],
+			RemainingCents: c.CapCents - spent[c.Category],
+		}
+		if c.CapCents > 0 {
+			p.PercentageUsed = float64(p.SpentCents) / float64(c.CapCents) * 100
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// incomeCents returns the income amount in cents, falling back to the legacy dollar field.
+func incomeCents(income *pfinancev1.Income) int64 {
+	if income.AmountCents != 0 {
+		return income.AmountCents
+	}
+	return ToCents(income.Amount)
+}
+
+// ToCents converts dollars to cents, rounding half away from zero. Rounding to
+// a micro-cent first absorbs float representation error, so 1.005 becomes 101
+// rather than 100 (1.005 * 100 is 100.49999999999999 in float64).
+func ToCents(dollars float64) int64 {
+	return int64(math.Round(math.Round(dollars*1e6) / 1e4))
+}
+
+// deductionTotals accumulates deductible expenses per TaxDeductionCategory for
+// AggregateDeductionsByCategory.
+type deductionTotals map[pfinancev1.TaxDeductionCategory]*pfinancev1.TaxDeductionSummary
+
+// add counts the deductible share of an expense; an unset percent means fully
+// deductible.
+func (d deductionTotals) add(expense *pfinancev1.Expense) {
+	pct := expense.TaxDeductiblePercent
+	if pct <= 0 {
+		pct = 1.0
+	}
+	cents := expense.AmountCents
+	if cents == 0 {
+		cents = ToCents(expense.Amount)
+	}
+
+	summary, ok := d[expense.TaxDeductionCategory]
+	if !ok {
+		summary = &pfinancev1.TaxDeductionSummary{Category: expense.TaxDeductionCategory}
+		d[expense.TaxDeductionCategory] = summary
+	}
+	summary.TotalCents += int64(math.Round(float64(cents) * pct))
+	summary.ExpenseCount++
+}
+
+// summaries returns the totals, largest first.
+func (d deductionTotals) summaries() []*pfinancev1.TaxDeductionSummary {
+	var summaries []*pfinancev1.TaxDeductionSummary
+	for _, summary := range d {
+		summary.TotalAmount = float64(summary.TotalCents) / 100.0
+		summaries = append(summaries, summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].TotalCents > summaries[j].TotalCents
+	})
+	return summaries
+}
+
+// DailyAggregateDate returns the YYYY-MM-DD day bucket GetDailyAggregates
+// groups a transaction dated t into.
+func DailyAggregateDate(t *timestamppb.Timestamp) string {
+	return t.AsTime().Format("2006-01-02")
+}
+
+// dateInRange reports whether date falls within the optional [start, end]
+// bounds. A record without a date never matches a bounded range; without
+// this check it would be compared as the Unix epoch.
+func dateInRange(date *timestamppb.Timestamp, start, end *time.Time) bool {
+	if start == nil && end == nil {
+		return true
+	}
+	if date == nil {
+		return false
+	}
+	t := date.AsTime()
+	if start != nil && t.Before(*start) {
+		return false
+	}
+	return end == nil || !t.After(*end)
+}
+
+// paginateByOffset returns the [start, end) window for an offset page token and
+// the token for the following page. Used where results are sorted in memory and
+// document-ID cursors no longer match the result order.
+func paginateByOffset(total int, pageSize int32, pageToken string) (int, int, string, error) {
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+
+	start := 0
+	if pageToken != "" {
+		decoded, err := DecodePageToken(pageToken)
+		if err != nil {
+			return 0, 0, "", fmt.Errorf("invalid page token: %w", err)
+		}
+		start, err = strconv.Atoi(decoded)
+		if err != nil || start < 0 {
+			return 0, 0, "", fmt.Errorf("invalid page token: %q", pageToken)
+		}
+	}
+	if start > total {
+		start = total
+	}
+
+	end := start + int(pageSize)
+	var nextToken string
+	if end < total {
+		nextToken = EncodePageToken(strconv.Itoa(end))
+	} else {
+		end = total
+	}
+	return start, end, nextToken, nil
+}
+
+// notificationDedupID derives the ID of the notification for a user and dedup
+// key, so creating it twice targets the same record.
+func notificationDedupID(userID, dedupKey string) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte("notification:"+userID+"/"+dedupKey)).String()
+}
+
+// sortBudgetTemplates orders templates by name, then ID for equal names.
+func sortBudgetTemplates(templates []*pfinancev1.RecurringBudget) {
+	sort.Slice(templates, func(i, j int) bool {
+		if templates[i].Name != templates[j].Name {
+			return templates[i].Name < templates[j].Name
+		}
+		return templates[i].Id < templates[j].Id
+	})
+}
+
+// sortImportProfiles orders profiles by name, then ID for equal names.
+func sortImportProfiles(profiles []*pfinancev1.ImportProfile) {
+	sort.Slice(profiles, func(i, j int) bool {
+		if profiles[i].Name != profiles[j].Name {
+			return profiles[i].Name < profiles[j].Name
+		}
+		return profiles[i].Id < profiles[j].Id
+	})
+}
+
+// ExpenseQuery holds ListExpenses' optional filters. The zero value lists
+// every expense matching userID and groupID; nil fields don't filter.
+type ExpenseQuery struct {
+	Scope           ExpenseScope
+	StartDate       *time.Time
+	EndDate         *time.Time
+	Category        *pfinancev1.ExpenseCategory
+	IsTaxDeductible *bool
+	Tags            *TagFilter
+	HasAttachment   *bool
+}
+
+// ExpenseScope selects which expenses ListExpenses considers before any other
+// filter is applied.
+type ExpenseScope int
+
+const (
+	// ExpenseScopeDefault ANDs userID and groupID, each ignored when empty.
+	ExpenseScopeDefault ExpenseScope = iota
+	// ExpenseScopePersonal lists userID's expenses that belong to no group.
+	ExpenseScopePersonal
+	// ExpenseScopeGroup lists every member's expenses in groupID.
+	ExpenseScopeGroup
+	// ExpenseScopeAll lists userID's personal expenses plus the shared
+	// expenses of every group userID currently belongs to.
+	ExpenseScopeAll
+)
+
+// Validate checks the scope against the IDs it is used with. The personal and
+// all scopes are keyed by userID alone and the group scope by groupID alone,
+// so passing the other ID is rejected rather than silently ignored.
+func (s ExpenseScope) Validate(userID, groupID string) error {
+	switch s {
+	case ExpenseScopeDefault:
+		return nil
+	case ExpenseScopePersonal, ExpenseScopeAll:
+		if userID == "" || groupID != "" {
+			return fmt.Errorf("expense scope %d requires a user ID and no group ID", s)
+		}
+	case ExpenseScopeGroup:
+		if groupID == "" || userID != "" {
+			return fmt.Errorf("expense scope %d requires a group ID and no user ID", s)
+		}
+	default:
+		return fmt.Errorf("unknown expense scope %d", s)
+	}
+	return nil
+}
+
+// TagFilter restricts a listing to expenses carrying the given tags. Tags are
+// compared exactly, so callers should pass them normalized the same way they
+// are stored.
+type TagFilter struct {
+	Tags     []string
+	MatchAll bool // Require every tag instead of any one of them
+}
+
+// Matches reports whether an expense's tags satisfy the filter. A nil or empty
+// filter matches everything.
+func (f *TagFilter) Matches(tags []string) bool {
+	if f == nil || len(f.Tags) == 0 {
+		return true
+	}
+	for _, want := range f.Tags {
+		found := slices.Contains(tags, want)
+		if found && !f.MatchAll {
+			return true
+		}
+		if !found && f.MatchAll {
+			return false
+		}
+	}
+	return f.MatchAll
+}
+
+// MatchesAttachment reports whether an expense satisfies ListExpenses'
+// has-attachment filter: a nil filter matches everything, otherwise the
+// expense must have (true) or lack (false) a receipt or attachment.
+func MatchesAttachment(expense *pfinancev1.Expense, hasAttachment *bool) bool {
+	if hasAttachment == nil {
+		return true
+	}
+	has := expense.ReceiptUrl != "" || expense.ReceiptStoragePath != "" || len(expense.Attachments) > 0
+	return has == *hasAttachment
+}
+
+// MissingCents reports whether a record has a dollar amount but no cents
+// value, i.e. was written by a client predating the cents fields.
+func MissingCents(amountCents int64, amount float64) bool {
+	return amountCents == 0 && amount != 0
+}
+
+// BudgetPeriodBounds calculates the start and end dates of the budget period
+// containing asOfDate.
+func BudgetPeriodBounds(budget *pfinancev1.Budget, asOfDate time.Time) (time.Time, time.Time) {
+	// If budget has fixed start/end dates, use those
+	if budget.StartDate != nil && budget.EndDate != nil {
+		return budget.StartDate.AsTime(), budget.EndDate.AsTime()
+	}
+
+	// Otherwise, calculate based on period type
+	var periodStart, periodEnd time.Time
+
+	switch budget.Period {
+	case pfinancev1.BudgetPeriod_BUDGET_PERIOD_WEEKLY:
+		// Find start of current week (Sunday)
+		daysFromSunday := int(asOfDate.Weekday())
+		periodStart = asOfDate.AddDate(0, 0, -daysFromSunday)
+		periodEnd = periodStart.AddDate(0, 0, 6)
+
+	case pfinancev1.BudgetPeriod_BUDGET_PERIOD_FORTNIGHTLY:
+		// Use budget start date as reference for fortnightly periods
+		refDate := budget.StartDate.AsTime()
+		daysDiff := int(asOfDate.Sub(refDate).Hours() / 24)
+		periodsSince := daysDiff / 14
+		periodStart = refDate.AddDate(0, 0, periodsSince*14)
+		periodEnd = periodStart.AddDate(0, 0, 13)
+
+	case pfinancev1.BudgetPeriod_BUDGET_PERIOD_MONTHLY:
+		// Start of current month
+		periodStart = time.Date(asOfDate.Year(), asOfDate.Month(), 1, 0, 0, 0, 0, asOfDate.Location())
+		// End of current month
+		periodEnd = periodStart.AddDate(0, 1, -1)
+
+	case pfinancev1.BudgetPeriod_BUDGET_PERIOD_QUARTERLY:
+		// Find start of current quarter
+		month := asOfDate.Month()
+		quarterStartMonth := time.Month(((int(month)-1)/3)*3 + 1)
+		periodStart = time.Date(asOfDate.Year(), quarterStartMonth, 1, 0, 0, 0, 0, asOfDate.Location())
+		periodEnd = periodStart.AddDate(0, 3, -1)
+
+	case pfinancev1.BudgetPeriod_BUDGET_PERIOD_YEARLY:
+		// Start of current year
+		periodStart = time.Date(asOfDate.Year(), 1, 1, 0, 0, 0, 0, asOfDate.Location())
+		periodEnd = time.Date(asOfDate.Year(), 12, 31, 23, 59, 59, 999999999, asOfDate.Location())
+
+	default:
+		// Default to monthly
+		periodStart = time.Date(asOfDate.Year(), asOfDate.Month(), 1, 0, 0, 0, 0, asOfDate.Location())
+		periodEnd = periodStart.AddDate(0, 1, -1)
+	}
+
+	// Set to beginning and end of day
+	periodStart = time.Date(periodStart.Year(), periodStart.Month(), periodStart.Day(), 0, 0, 0, 0, periodStart.Location())
+	periodEnd = time.Date(periodEnd.Year(), periodEnd.Month(), periodEnd.Day(), 23, 59, 59, 999999999, periodEnd.Location())
+
+	return periodStart, periodEnd
+}
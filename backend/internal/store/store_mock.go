@@ -85,6 +85,35 @@ func (mr *MockStoreMockRecorder) BatchDeleteExpenses(ctx, expenseIDs any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeleteExpenses", reflect.TypeOf((*MockStore)(nil).BatchDeleteExpenses), ctx, expenseIDs)
 }
 
+// BatchDeleteIncomes mocks base method.
+func (m *MockStore) BatchDeleteIncomes(ctx context.Context, incomeIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDeleteIncomes", ctx, incomeIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchDeleteIncomes indicates an expected call of BatchDeleteIncomes.
+func (mr *MockStoreMockRecorder) BatchDeleteIncomes(ctx, incomeIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeleteIncomes", reflect.TypeOf((*MockStore)(nil).BatchDeleteIncomes), ctx, incomeIDs)
+}
+
+// ClaimWebhookEvent mocks base method.
+func (m *MockStore) ClaimWebhookEvent(ctx context.Context, eventID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWebhookEvent", ctx, eventID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimWebhookEvent indicates an expected call of ClaimWebhookEvent.
+func (mr *MockStoreMockRecorder) ClaimWebhookEvent(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWebhookEvent", reflect.TypeOf((*MockStore)(nil).ClaimWebhookEvent), ctx, eventID)
+}
+
 // ClearUserData mocks base method.
 func (m *MockStore) ClearUserData(ctx context.Context, userID string) error {
 	m.ctrl.T.Helper()
@@ -114,6 +143,36 @@ func (mr *MockStoreMockRecorder) CountActiveApiTokens(ctx, userID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveApiTokens", reflect.TypeOf((*MockStore)(nil).CountActiveApiTokens), ctx, userID)
 }
 
+// CountExpenses mocks base method.
+func (m *MockStore) CountExpenses(ctx context.Context, userID, groupID string, startDate, endDate *time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountExpenses", ctx, userID, groupID, startDate, endDate)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountExpenses indicates an expected call of CountExpenses.
+func (mr *MockStoreMockRecorder) CountExpenses(ctx, userID, groupID, startDate, endDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountExpenses", reflect.TypeOf((*MockStore)(nil).CountExpenses), ctx, userID, groupID, startDate, endDate)
+}
+
+// CountIncomes mocks base method.
+func (m *MockStore) CountIncomes(ctx context.Context, userID, groupID string, startDate, endDate *time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountIncomes", ctx, userID, groupID, startDate, endDate)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountIncomes indicates an expected call of CountIncomes.
+func (mr *MockStoreMockRecorder) CountIncomes(ctx, userID, groupID, startDate, endDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountIncomes", reflect.TypeOf((*MockStore)(nil).CountIncomes), ctx, userID, groupID, startDate, endDate)
+}
+
 // CreateApiToken mocks base method.
 func (m *MockStore) CreateApiToken(ctx context.Context, token *pfinancev1.ApiToken) error {
 	m.ctrl.T.Helper()
@@ -142,6 +201,20 @@ func (mr *MockStoreMockRecorder) CreateBudget(ctx, budget any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBudget", reflect.TypeOf((*MockStore)(nil).CreateBudget), ctx, budget)
 }
 
+// CreateBudgetTemplate mocks base method.
+func (m *MockStore) CreateBudgetTemplate(ctx context.Context, template *pfinancev1.RecurringBudget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBudgetTemplate", ctx, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBudgetTemplate indicates an expected call of CreateBudgetTemplate.
+func (mr *MockStoreMockRecorder) CreateBudgetTemplate(ctx, template any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBudgetTemplate", reflect.TypeOf((*MockStore)(nil).CreateBudgetTemplate), ctx, template)
+}
+
 // CreateContribution mocks base method.
 func (m *MockStore) CreateContribution(ctx context.Context, contribution *pfinancev1.ExpenseContribution) error {
 	m.ctrl.T.Helper()
@@ -170,6 +243,20 @@ func (mr *MockStoreMockRecorder) CreateCorrectionRecord(ctx, record any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCorrectionRecord", reflect.TypeOf((*MockStore)(nil).CreateCorrectionRecord), ctx, record)
 }
 
+// CreateDataClearRecord mocks base method.
+func (m *MockStore) CreateDataClearRecord(ctx context.Context, record *pfinancev1.DataClearRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDataClearRecord", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDataClearRecord indicates an expected call of CreateDataClearRecord.
+func (mr *MockStoreMockRecorder) CreateDataClearRecord(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataClearRecord", reflect.TypeOf((*MockStore)(nil).CreateDataClearRecord), ctx, record)
+}
+
 // CreateExpense mocks base method.
 func (m *MockStore) CreateExpense(ctx context.Context, expense *pfinancev1.Expense) error {
 	m.ctrl.T.Helper()
@@ -240,6 +327,20 @@ func (mr *MockStoreMockRecorder) CreateGroup(ctx, group any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockStore)(nil).CreateGroup), ctx, group)
 }
 
+// CreateImportProfile mocks base method.
+func (m *MockStore) CreateImportProfile(ctx context.Context, profile *pfinancev1.ImportProfile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImportProfile", ctx, profile)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateImportProfile indicates an expected call of CreateImportProfile.
+func (mr *MockStoreMockRecorder) CreateImportProfile(ctx, profile any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImportProfile", reflect.TypeOf((*MockStore)(nil).CreateImportProfile), ctx, profile)
+}
+
 // CreateIncome mocks base method.
 func (m *MockStore) CreateIncome(ctx context.Context, income *pfinancev1.Income) error {
 	m.ctrl.T.Helper()
@@ -297,11 +398,12 @@ func (mr *MockStoreMockRecorder) CreateInviteLink(ctx, link any) *gomock.Call {
 }
 
 // CreateNotification mocks base method.
-func (m *MockStore) CreateNotification(ctx context.Context, notification *pfinancev1.Notification) error {
+func (m *MockStore) CreateNotification(ctx context.Context, notification *pfinancev1.Notification) (*pfinancev1.Notification, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateNotification", ctx, notification)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*pfinancev1.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreateNotification indicates an expected call of CreateNotification.
@@ -338,6 +440,21 @@ func (mr *MockStoreMockRecorder) CreateRecurringTransaction(ctx, rt any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecurringTransaction", reflect.TypeOf((*MockStore)(nil).CreateRecurringTransaction), ctx, rt)
 }
 
+// DeleteAllReadNotifications mocks base method.
+func (m *MockStore) DeleteAllReadNotifications(ctx context.Context, userID string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllReadNotifications", ctx, userID)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAllReadNotifications indicates an expected call of DeleteAllReadNotifications.
+func (mr *MockStoreMockRecorder) DeleteAllReadNotifications(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllReadNotifications", reflect.TypeOf((*MockStore)(nil).DeleteAllReadNotifications), ctx, userID)
+}
+
 // DeleteBudget mocks base method.
 func (m *MockStore) DeleteBudget(ctx context.Context, budgetID string) error {
 	m.ctrl.T.Helper()
@@ -352,6 +469,20 @@ func (mr *MockStoreMockRecorder) DeleteBudget(ctx, budgetID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudget", reflect.TypeOf((*MockStore)(nil).DeleteBudget), ctx, budgetID)
 }
 
+// DeleteBudgetTemplate mocks base method.
+func (m *MockStore) DeleteBudgetTemplate(ctx context.Context, templateID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBudgetTemplate", ctx, templateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBudgetTemplate indicates an expected call of DeleteBudgetTemplate.
+func (mr *MockStoreMockRecorder) DeleteBudgetTemplate(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudgetTemplate", reflect.TypeOf((*MockStore)(nil).DeleteBudgetTemplate), ctx, templateID)
+}
+
 // DeleteCategoryOverride mocks base method.
 func (m *MockStore) DeleteCategoryOverride(ctx context.Context, userID, merchantNormalized string) error {
 	m.ctrl.T.Helper()
@@ -380,6 +511,20 @@ func (mr *MockStoreMockRecorder) DeleteExpense(ctx, expenseID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpense", reflect.TypeOf((*MockStore)(nil).DeleteExpense), ctx, expenseID)
 }
 
+// DeleteExtractionPreferences mocks base method.
+func (m *MockStore) DeleteExtractionPreferences(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExtractionPreferences", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExtractionPreferences indicates an expected call of DeleteExtractionPreferences.
+func (mr *MockStoreMockRecorder) DeleteExtractionPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExtractionPreferences", reflect.TypeOf((*MockStore)(nil).DeleteExtractionPreferences), ctx, userID)
+}
+
 // DeleteGoal mocks base method.
 func (m *MockStore) DeleteGoal(ctx context.Context, goalID string) error {
 	m.ctrl.T.Helper()
@@ -408,6 +553,20 @@ func (mr *MockStoreMockRecorder) DeleteGroup(ctx, groupID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockStore)(nil).DeleteGroup), ctx, groupID)
 }
 
+// DeleteImportProfile mocks base method.
+func (m *MockStore) DeleteImportProfile(ctx context.Context, profileID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImportProfile", ctx, profileID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImportProfile indicates an expected call of DeleteImportProfile.
+func (mr *MockStoreMockRecorder) DeleteImportProfile(ctx, profileID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImportProfile", reflect.TypeOf((*MockStore)(nil).DeleteImportProfile), ctx, profileID)
+}
+
 // DeleteIncome mocks base method.
 func (m *MockStore) DeleteIncome(ctx context.Context, incomeID string) error {
 	m.ctrl.T.Helper()
@@ -422,6 +581,34 @@ func (mr *MockStoreMockRecorder) DeleteIncome(ctx, incomeID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIncome", reflect.TypeOf((*MockStore)(nil).DeleteIncome), ctx, incomeID)
 }
 
+// DeleteMerchantMapping mocks base method.
+func (m *MockStore) DeleteMerchantMapping(ctx context.Context, userID, rawPattern string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMerchantMapping", ctx, userID, rawPattern)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMerchantMapping indicates an expected call of DeleteMerchantMapping.
+func (mr *MockStoreMockRecorder) DeleteMerchantMapping(ctx, userID, rawPattern any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMerchantMapping", reflect.TypeOf((*MockStore)(nil).DeleteMerchantMapping), ctx, userID, rawPattern)
+}
+
+// DeleteNotification mocks base method.
+func (m *MockStore) DeleteNotification(ctx context.Context, notificationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotification", ctx, notificationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotification indicates an expected call of DeleteNotification.
+func (mr *MockStoreMockRecorder) DeleteNotification(ctx, notificationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotification", reflect.TypeOf((*MockStore)(nil).DeleteNotification), ctx, notificationID)
+}
+
 // DeleteRecurringTransaction mocks base method.
 func (m *MockStore) DeleteRecurringTransaction(ctx context.Context, rtID string) error {
 	m.ctrl.T.Helper()
@@ -480,6 +667,21 @@ func (mr *MockStoreMockRecorder) FindProcessedStatement(ctx, userID, fingerprint
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProcessedStatement", reflect.TypeOf((*MockStore)(nil).FindProcessedStatement), ctx, userID, fingerprint)
 }
 
+// GetAllBudgetProgress mocks base method.
+func (m *MockStore) GetAllBudgetProgress(ctx context.Context, userID, groupID string, asOfDate time.Time) ([]*pfinancev1.BudgetProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllBudgetProgress", ctx, userID, groupID, asOfDate)
+	ret0, _ := ret[0].([]*pfinancev1.BudgetProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllBudgetProgress indicates an expected call of GetAllBudgetProgress.
+func (mr *MockStoreMockRecorder) GetAllBudgetProgress(ctx, userID, groupID, asOfDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBudgetProgress", reflect.TypeOf((*MockStore)(nil).GetAllBudgetProgress), ctx, userID, groupID, asOfDate)
+}
+
 // GetApiTokenByHash mocks base method.
 func (m *MockStore) GetApiTokenByHash(ctx context.Context, tokenHash string) (*pfinancev1.ApiToken, error) {
 	m.ctrl.T.Helper()
@@ -525,6 +727,36 @@ func (mr *MockStoreMockRecorder) GetBudgetProgress(ctx, budgetID, asOfDate any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetProgress", reflect.TypeOf((*MockStore)(nil).GetBudgetProgress), ctx, budgetID, asOfDate)
 }
 
+// GetBudgetTemplate mocks base method.
+func (m *MockStore) GetBudgetTemplate(ctx context.Context, templateID string) (*pfinancev1.RecurringBudget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudgetTemplate", ctx, templateID)
+	ret0, _ := ret[0].(*pfinancev1.RecurringBudget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudgetTemplate indicates an expected call of GetBudgetTemplate.
+func (mr *MockStoreMockRecorder) GetBudgetTemplate(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetTemplate", reflect.TypeOf((*MockStore)(nil).GetBudgetTemplate), ctx, templateID)
+}
+
+// GetCategoryBaselines mocks base method.
+func (m *MockStore) GetCategoryBaselines(ctx context.Context, userID string) ([]*pfinancev1.CategoryBaseline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryBaselines", ctx, userID)
+	ret0, _ := ret[0].([]*pfinancev1.CategoryBaseline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryBaselines indicates an expected call of GetCategoryBaselines.
+func (mr *MockStoreMockRecorder) GetCategoryBaselines(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryBaselines", reflect.TypeOf((*MockStore)(nil).GetCategoryBaselines), ctx, userID)
+}
+
 // GetCategoryOverrides mocks base method.
 func (m *MockStore) GetCategoryOverrides(ctx context.Context, userID string) ([]*pfinancev1.CategoryOverride, error) {
 	m.ctrl.T.Helper()
@@ -585,6 +817,36 @@ func (mr *MockStoreMockRecorder) GetExpense(ctx, expenseID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpense", reflect.TypeOf((*MockStore)(nil).GetExpense), ctx, expenseID)
 }
 
+// GetExpensesByIDs mocks base method.
+func (m *MockStore) GetExpensesByIDs(ctx context.Context, ids []string) (map[string]*pfinancev1.Expense, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpensesByIDs", ctx, ids)
+	ret0, _ := ret[0].(map[string]*pfinancev1.Expense)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpensesByIDs indicates an expected call of GetExpensesByIDs.
+func (mr *MockStoreMockRecorder) GetExpensesByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpensesByIDs", reflect.TypeOf((*MockStore)(nil).GetExpensesByIDs), ctx, ids)
+}
+
+// GetExtractionPreferences mocks base method.
+func (m *MockStore) GetExtractionPreferences(ctx context.Context, userID string) (*pfinancev1.ExtractionPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExtractionPreferences", ctx, userID)
+	ret0, _ := ret[0].(*pfinancev1.ExtractionPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExtractionPreferences indicates an expected call of GetExtractionPreferences.
+func (mr *MockStoreMockRecorder) GetExtractionPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExtractionPreferences", reflect.TypeOf((*MockStore)(nil).GetExtractionPreferences), ctx, userID)
+}
+
 // GetGoal mocks base method.
 func (m *MockStore) GetGoal(ctx context.Context, goalID string) (*pfinancev1.FinancialGoal, error) {
 	m.ctrl.T.Helper()
@@ -630,6 +892,21 @@ func (mr *MockStoreMockRecorder) GetGroup(ctx, groupID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockStore)(nil).GetGroup), ctx, groupID)
 }
 
+// GetImportProfile mocks base method.
+func (m *MockStore) GetImportProfile(ctx context.Context, profileID string) (*pfinancev1.ImportProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportProfile", ctx, profileID)
+	ret0, _ := ret[0].(*pfinancev1.ImportProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportProfile indicates an expected call of GetImportProfile.
+func (mr *MockStoreMockRecorder) GetImportProfile(ctx, profileID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportProfile", reflect.TypeOf((*MockStore)(nil).GetImportProfile), ctx, profileID)
+}
+
 // GetIncome mocks base method.
 func (m *MockStore) GetIncome(ctx context.Context, incomeID string) (*pfinancev1.Income, error) {
 	m.ctrl.T.Helper()
@@ -720,6 +997,21 @@ func (mr *MockStoreMockRecorder) GetMerchantMappings(ctx, userID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMerchantMappings", reflect.TypeOf((*MockStore)(nil).GetMerchantMappings), ctx, userID)
 }
 
+// GetNotification mocks base method.
+func (m *MockStore) GetNotification(ctx context.Context, notificationID string) (*pfinancev1.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotification", ctx, notificationID)
+	ret0, _ := ret[0].(*pfinancev1.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotification indicates an expected call of GetNotification.
+func (mr *MockStoreMockRecorder) GetNotification(ctx, notificationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotification", reflect.TypeOf((*MockStore)(nil).GetNotification), ctx, notificationID)
+}
+
 // GetNotificationPreferences mocks base method.
 func (m *MockStore) GetNotificationPreferences(ctx context.Context, userID string) (*pfinancev1.NotificationPreferences, error) {
 	m.ctrl.T.Helper()
@@ -810,6 +1102,21 @@ func (mr *MockStoreMockRecorder) GetUser(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, userID)
 }
 
+// HasMerchantExpense mocks base method.
+func (m *MockStore) HasMerchantExpense(ctx context.Context, userID, groupID, description string, since, before time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasMerchantExpense", ctx, userID, groupID, description, since, before)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasMerchantExpense indicates an expected call of HasMerchantExpense.
+func (mr *MockStoreMockRecorder) HasMerchantExpense(ctx, userID, groupID, description, since, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasMerchantExpense", reflect.TypeOf((*MockStore)(nil).HasMerchantExpense), ctx, userID, groupID, description, since, before)
+}
+
 // HasNotification mocks base method.
 func (m *MockStore) HasNotification(ctx context.Context, userID string, notifType pfinancev1.NotificationType, referenceID, metadataKey, metadataValue string, withinHours int) (bool, error) {
 	m.ctrl.T.Helper()
@@ -840,6 +1147,21 @@ func (mr *MockStoreMockRecorder) ListApiTokens(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApiTokens", reflect.TypeOf((*MockStore)(nil).ListApiTokens), ctx, userID)
 }
 
+// ListBudgetTemplates mocks base method.
+func (m *MockStore) ListBudgetTemplates(ctx context.Context, userID, groupID string) ([]*pfinancev1.RecurringBudget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBudgetTemplates", ctx, userID, groupID)
+	ret0, _ := ret[0].([]*pfinancev1.RecurringBudget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBudgetTemplates indicates an expected call of ListBudgetTemplates.
+func (mr *MockStoreMockRecorder) ListBudgetTemplates(ctx, userID, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBudgetTemplates", reflect.TypeOf((*MockStore)(nil).ListBudgetTemplates), ctx, userID, groupID)
+}
+
 // ListBudgets mocks base method.
 func (m *MockStore) ListBudgets(ctx context.Context, userID, groupID string, includeInactive bool, pageSize int32, pageToken string) ([]*pfinancev1.Budget, string, error) {
 	m.ctrl.T.Helper()
@@ -872,6 +1194,21 @@ func (mr *MockStoreMockRecorder) ListContributions(ctx, groupID, userID, pageSiz
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContributions", reflect.TypeOf((*MockStore)(nil).ListContributions), ctx, groupID, userID, pageSize, pageToken)
 }
 
+// ListContributionsForExpense mocks base method.
+func (m *MockStore) ListContributionsForExpense(ctx context.Context, expenseID string) ([]*pfinancev1.ExpenseContribution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContributionsForExpense", ctx, expenseID)
+	ret0, _ := ret[0].([]*pfinancev1.ExpenseContribution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContributionsForExpense indicates an expected call of ListContributionsForExpense.
+func (mr *MockStoreMockRecorder) ListContributionsForExpense(ctx, expenseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContributionsForExpense", reflect.TypeOf((*MockStore)(nil).ListContributionsForExpense), ctx, expenseID)
+}
+
 // ListCorrectionRecords mocks base method.
 func (m *MockStore) ListCorrectionRecords(ctx context.Context, userID string, limit int) ([]*pfinancev1.CorrectionRecord, error) {
 	m.ctrl.T.Helper()
@@ -887,6 +1224,21 @@ func (mr *MockStoreMockRecorder) ListCorrectionRecords(ctx, userID, limit any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCorrectionRecords", reflect.TypeOf((*MockStore)(nil).ListCorrectionRecords), ctx, userID, limit)
 }
 
+// ListDataClearRecords mocks base method.
+func (m *MockStore) ListDataClearRecords(ctx context.Context, userID string) ([]*pfinancev1.DataClearRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDataClearRecords", ctx, userID)
+	ret0, _ := ret[0].([]*pfinancev1.DataClearRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDataClearRecords indicates an expected call of ListDataClearRecords.
+func (mr *MockStoreMockRecorder) ListDataClearRecords(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDataClearRecords", reflect.TypeOf((*MockStore)(nil).ListDataClearRecords), ctx, userID)
+}
+
 // ListDeductibleExpenses mocks base method.
 func (m *MockStore) ListDeductibleExpenses(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, category pfinancev1.TaxDeductionCategory, pageSize int32, pageToken string) ([]*pfinancev1.Expense, string, error) {
 	m.ctrl.T.Helper()
@@ -904,9 +1256,9 @@ func (mr *MockStoreMockRecorder) ListDeductibleExpenses(ctx, userID, groupID, st
 }
 
 // ListExpenses mocks base method.
-func (m *MockStore) ListExpenses(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, pageSize int32, pageToken string) ([]*pfinancev1.Expense, string, error) {
+func (m *MockStore) ListExpenses(ctx context.Context, userID, groupID string, filter ExpenseQuery, pageSize int32, pageToken string) ([]*pfinancev1.Expense, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListExpenses", ctx, userID, groupID, startDate, endDate, pageSize, pageToken)
+	ret := m.ctrl.Call(m, "ListExpenses", ctx, userID, groupID, filter, pageSize, pageToken)
 	ret0, _ := ret[0].([]*pfinancev1.Expense)
 	ret1, _ := ret[1].(string)
 	ret2, _ := ret[2].(error)
@@ -914,9 +1266,24 @@ func (m *MockStore) ListExpenses(ctx context.Context, userID, groupID string, st
 }
 
 // ListExpenses indicates an expected call of ListExpenses.
-func (mr *MockStoreMockRecorder) ListExpenses(ctx, userID, groupID, startDate, endDate, pageSize, pageToken any) *gomock.Call {
+func (mr *MockStoreMockRecorder) ListExpenses(ctx, userID, groupID, filter, pageSize, pageToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpenses", reflect.TypeOf((*MockStore)(nil).ListExpenses), ctx, userID, groupID, filter, pageSize, pageToken)
+}
+
+// ListExpensesMissingCents mocks base method.
+func (m *MockStore) ListExpensesMissingCents(ctx context.Context, userID string) ([]*pfinancev1.Expense, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpensesMissingCents", ctx, userID)
+	ret0, _ := ret[0].([]*pfinancev1.Expense)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpensesMissingCents indicates an expected call of ListExpensesMissingCents.
+func (mr *MockStoreMockRecorder) ListExpensesMissingCents(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpenses", reflect.TypeOf((*MockStore)(nil).ListExpenses), ctx, userID, groupID, startDate, endDate, pageSize, pageToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpensesMissingCents", reflect.TypeOf((*MockStore)(nil).ListExpensesMissingCents), ctx, userID)
 }
 
 // ListExtractionEvents mocks base method.
@@ -982,6 +1349,21 @@ func (mr *MockStoreMockRecorder) ListGroups(ctx, userID, pageSize, pageToken any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroups", reflect.TypeOf((*MockStore)(nil).ListGroups), ctx, userID, pageSize, pageToken)
 }
 
+// ListImportProfiles mocks base method.
+func (m *MockStore) ListImportProfiles(ctx context.Context, userID string) ([]*pfinancev1.ImportProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImportProfiles", ctx, userID)
+	ret0, _ := ret[0].([]*pfinancev1.ImportProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImportProfiles indicates an expected call of ListImportProfiles.
+func (mr *MockStoreMockRecorder) ListImportProfiles(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImportProfiles", reflect.TypeOf((*MockStore)(nil).ListImportProfiles), ctx, userID)
+}
+
 // ListIncomeContributions mocks base method.
 func (m *MockStore) ListIncomeContributions(ctx context.Context, groupID, userID string, pageSize int32, pageToken string) ([]*pfinancev1.IncomeContribution, string, error) {
 	m.ctrl.T.Helper()
@@ -998,10 +1380,25 @@ func (mr *MockStoreMockRecorder) ListIncomeContributions(ctx, groupID, userID, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIncomeContributions", reflect.TypeOf((*MockStore)(nil).ListIncomeContributions), ctx, groupID, userID, pageSize, pageToken)
 }
 
+// ListIncomeContributionsForIncome mocks base method.
+func (m *MockStore) ListIncomeContributionsForIncome(ctx context.Context, incomeID string) ([]*pfinancev1.IncomeContribution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIncomeContributionsForIncome", ctx, incomeID)
+	ret0, _ := ret[0].([]*pfinancev1.IncomeContribution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIncomeContributionsForIncome indicates an expected call of ListIncomeContributionsForIncome.
+func (mr *MockStoreMockRecorder) ListIncomeContributionsForIncome(ctx, incomeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIncomeContributionsForIncome", reflect.TypeOf((*MockStore)(nil).ListIncomeContributionsForIncome), ctx, incomeID)
+}
+
 // ListIncomes mocks base method.
-func (m *MockStore) ListIncomes(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, pageSize int32, pageToken string) ([]*pfinancev1.Income, string, error) {
+func (m *MockStore) ListIncomes(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, source string, sortField pfinancev1.SortField, sortDirection pfinancev1.SortDirection, pageSize int32, pageToken string) ([]*pfinancev1.Income, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListIncomes", ctx, userID, groupID, startDate, endDate, pageSize, pageToken)
+	ret := m.ctrl.Call(m, "ListIncomes", ctx, userID, groupID, startDate, endDate, source, sortField, sortDirection, pageSize, pageToken)
 	ret0, _ := ret[0].([]*pfinancev1.Income)
 	ret1, _ := ret[1].(string)
 	ret2, _ := ret[2].(error)
@@ -1009,9 +1406,24 @@ func (m *MockStore) ListIncomes(ctx context.Context, userID, groupID string, sta
 }
 
 // ListIncomes indicates an expected call of ListIncomes.
-func (mr *MockStoreMockRecorder) ListIncomes(ctx, userID, groupID, startDate, endDate, pageSize, pageToken any) *gomock.Call {
+func (mr *MockStoreMockRecorder) ListIncomes(ctx, userID, groupID, startDate, endDate, source, sortField, sortDirection, pageSize, pageToken any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIncomes", reflect.TypeOf((*MockStore)(nil).ListIncomes), ctx, userID, groupID, startDate, endDate, pageSize, pageToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIncomes", reflect.TypeOf((*MockStore)(nil).ListIncomes), ctx, userID, groupID, startDate, endDate, source, sortField, sortDirection, pageSize, pageToken)
+}
+
+// ListIncomesMissingCents mocks base method.
+func (m *MockStore) ListIncomesMissingCents(ctx context.Context, userID string) ([]*pfinancev1.Income, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIncomesMissingCents", ctx, userID)
+	ret0, _ := ret[0].([]*pfinancev1.Income)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIncomesMissingCents indicates an expected call of ListIncomesMissingCents.
+func (mr *MockStoreMockRecorder) ListIncomesMissingCents(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIncomesMissingCents", reflect.TypeOf((*MockStore)(nil).ListIncomesMissingCents), ctx, userID)
 }
 
 // ListInvitations mocks base method.
@@ -1030,6 +1442,21 @@ func (mr *MockStoreMockRecorder) ListInvitations(ctx, userEmail, status, pageSiz
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitations", reflect.TypeOf((*MockStore)(nil).ListInvitations), ctx, userEmail, status, pageSize, pageToken)
 }
 
+// ListInviteLinkMembers mocks base method.
+func (m *MockStore) ListInviteLinkMembers(ctx context.Context, groupID, linkID string) ([]*pfinancev1.GroupMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInviteLinkMembers", ctx, groupID, linkID)
+	ret0, _ := ret[0].([]*pfinancev1.GroupMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInviteLinkMembers indicates an expected call of ListInviteLinkMembers.
+func (mr *MockStoreMockRecorder) ListInviteLinkMembers(ctx, groupID, linkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInviteLinkMembers", reflect.TypeOf((*MockStore)(nil).ListInviteLinkMembers), ctx, groupID, linkID)
+}
+
 // ListInviteLinks mocks base method.
 func (m *MockStore) ListInviteLinks(ctx context.Context, groupID string, includeInactive bool, pageSize int32, pageToken string) ([]*pfinancev1.GroupInviteLink, string, error) {
 	m.ctrl.T.Helper()
@@ -1046,6 +1473,22 @@ func (mr *MockStoreMockRecorder) ListInviteLinks(ctx, groupID, includeInactive,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInviteLinks", reflect.TypeOf((*MockStore)(nil).ListInviteLinks), ctx, groupID, includeInactive, pageSize, pageToken)
 }
 
+// ListMerchantMappings mocks base method.
+func (m *MockStore) ListMerchantMappings(ctx context.Context, userID, query string, sortBy pfinancev1.MerchantMappingSort, pageSize int32, pageToken string) ([]*pfinancev1.MerchantMapping, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMerchantMappings", ctx, userID, query, sortBy, pageSize, pageToken)
+	ret0, _ := ret[0].([]*pfinancev1.MerchantMapping)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListMerchantMappings indicates an expected call of ListMerchantMappings.
+func (mr *MockStoreMockRecorder) ListMerchantMappings(ctx, userID, query, sortBy, pageSize, pageToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMerchantMappings", reflect.TypeOf((*MockStore)(nil).ListMerchantMappings), ctx, userID, query, sortBy, pageSize, pageToken)
+}
+
 // ListNotifications mocks base method.
 func (m *MockStore) ListNotifications(ctx context.Context, userID string, unreadOnly bool, typeFilter pfinancev1.NotificationType, pageSize int32, pageToken string) ([]*pfinancev1.Notification, string, error) {
 	m.ctrl.T.Helper()
@@ -1062,6 +1505,21 @@ func (mr *MockStoreMockRecorder) ListNotifications(ctx, userID, unreadOnly, type
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockStore)(nil).ListNotifications), ctx, userID, unreadOnly, typeFilter, pageSize, pageToken)
 }
 
+// ListNotificationsInRange mocks base method.
+func (m *MockStore) ListNotificationsInRange(ctx context.Context, userID string, startDate, endDate time.Time) ([]*pfinancev1.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationsInRange", ctx, userID, startDate, endDate)
+	ret0, _ := ret[0].([]*pfinancev1.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationsInRange indicates an expected call of ListNotificationsInRange.
+func (mr *MockStoreMockRecorder) ListNotificationsInRange(ctx, userID, startDate, endDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationsInRange", reflect.TypeOf((*MockStore)(nil).ListNotificationsInRange), ctx, userID, startDate, endDate)
+}
+
 // ListRecurringTransactions mocks base method.
 func (m *MockStore) ListRecurringTransactions(ctx context.Context, userID, groupID string, status pfinancev1.RecurringTransactionStatus, filterIsExpense, isExpense bool, pageSize int32, pageToken string) ([]*pfinancev1.RecurringTransaction, string, error) {
 	m.ctrl.T.Helper()
@@ -1078,6 +1536,21 @@ func (mr *MockStoreMockRecorder) ListRecurringTransactions(ctx, userID, groupID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecurringTransactions", reflect.TypeOf((*MockStore)(nil).ListRecurringTransactions), ctx, userID, groupID, status, filterIsExpense, isExpense, pageSize, pageToken)
 }
 
+// ListTopExpenses mocks base method.
+func (m *MockStore) ListTopExpenses(ctx context.Context, userID, groupID string, startDate, endDate *time.Time, category *pfinancev1.ExpenseCategory, limit int) ([]*pfinancev1.Expense, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTopExpenses", ctx, userID, groupID, startDate, endDate, category, limit)
+	ret0, _ := ret[0].([]*pfinancev1.Expense)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTopExpenses indicates an expected call of ListTopExpenses.
+func (mr *MockStoreMockRecorder) ListTopExpenses(ctx, userID, groupID, startDate, endDate, category, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopExpenses", reflect.TypeOf((*MockStore)(nil).ListTopExpenses), ctx, userID, groupID, startDate, endDate, category, limit)
+}
+
 // MarkAllNotificationsRead mocks base method.
 func (m *MockStore) MarkAllNotificationsRead(ctx context.Context, userID string) error {
 	m.ctrl.T.Helper()
@@ -1106,6 +1579,35 @@ func (mr *MockStoreMockRecorder) MarkNotificationRead(ctx, notificationID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationRead", reflect.TypeOf((*MockStore)(nil).MarkNotificationRead), ctx, notificationID)
 }
 
+// RedeemInviteLink mocks base method.
+func (m *MockStore) RedeemInviteLink(ctx context.Context, code string) (*pfinancev1.GroupInviteLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemInviteLink", ctx, code)
+	ret0, _ := ret[0].(*pfinancev1.GroupInviteLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemInviteLink indicates an expected call of RedeemInviteLink.
+func (mr *MockStoreMockRecorder) RedeemInviteLink(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemInviteLink", reflect.TypeOf((*MockStore)(nil).RedeemInviteLink), ctx, code)
+}
+
+// ReleaseWebhookEvent mocks base method.
+func (m *MockStore) ReleaseWebhookEvent(ctx context.Context, eventID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseWebhookEvent", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseWebhookEvent indicates an expected call of ReleaseWebhookEvent.
+func (mr *MockStoreMockRecorder) ReleaseWebhookEvent(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseWebhookEvent", reflect.TypeOf((*MockStore)(nil).ReleaseWebhookEvent), ctx, eventID)
+}
+
 // RevokeApiToken mocks base method.
 func (m *MockStore) RevokeApiToken(ctx context.Context, tokenID string) error {
 	m.ctrl.T.Helper()
@@ -1120,10 +1622,24 @@ func (mr *MockStoreMockRecorder) RevokeApiToken(ctx, tokenID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeApiToken", reflect.TypeOf((*MockStore)(nil).RevokeApiToken), ctx, tokenID)
 }
 
+// RunInTransaction mocks base method.
+func (m *MockStore) RunInTransaction(ctx context.Context, fn func(txStore Store) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTransaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTransaction indicates an expected call of RunInTransaction.
+func (mr *MockStoreMockRecorder) RunInTransaction(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTransaction", reflect.TypeOf((*MockStore)(nil).RunInTransaction), ctx, fn)
+}
+
 // SearchTransactions mocks base method.
-func (m *MockStore) SearchTransactions(ctx context.Context, userID, groupID, query, category string, amountMin, amountMax float64, startDate, endDate *time.Time, txType pfinancev1.TransactionType, pageSize int32, pageToken string) ([]*pfinancev1.SearchResult, string, int, error) {
+func (m *MockStore) SearchTransactions(ctx context.Context, userID, groupID, query, category string, amountMin, amountMax *float64, startDate, endDate *time.Time, txType pfinancev1.TransactionType, sortBy pfinancev1.SearchSortBy, pageSize int32, pageToken string) ([]*pfinancev1.SearchResult, string, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchTransactions", ctx, userID, groupID, query, category, amountMin, amountMax, startDate, endDate, txType, pageSize, pageToken)
+	ret := m.ctrl.Call(m, "SearchTransactions", ctx, userID, groupID, query, category, amountMin, amountMax, startDate, endDate, txType, sortBy, pageSize, pageToken)
 	ret0, _ := ret[0].([]*pfinancev1.SearchResult)
 	ret1, _ := ret[1].(string)
 	ret2, _ := ret[2].(int)
@@ -1132,9 +1648,9 @@ func (m *MockStore) SearchTransactions(ctx context.Context, userID, groupID, que
 }
 
 // SearchTransactions indicates an expected call of SearchTransactions.
-func (mr *MockStoreMockRecorder) SearchTransactions(ctx, userID, groupID, query, category, amountMin, amountMax, startDate, endDate, txType, pageSize, pageToken any) *gomock.Call {
+func (mr *MockStoreMockRecorder) SearchTransactions(ctx, userID, groupID, query, category, amountMin, amountMax, startDate, endDate, txType, sortBy, pageSize, pageToken any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTransactions", reflect.TypeOf((*MockStore)(nil).SearchTransactions), ctx, userID, groupID, query, category, amountMin, amountMax, startDate, endDate, txType, pageSize, pageToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTransactions", reflect.TypeOf((*MockStore)(nil).SearchTransactions), ctx, userID, groupID, query, category, amountMin, amountMax, startDate, endDate, txType, sortBy, pageSize, pageToken)
 }
 
 // UpdateApiTokenLastUsed mocks base method.
@@ -1165,6 +1681,20 @@ func (mr *MockStoreMockRecorder) UpdateBudget(ctx, budget any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBudget", reflect.TypeOf((*MockStore)(nil).UpdateBudget), ctx, budget)
 }
 
+// UpdateBudgetTemplate mocks base method.
+func (m *MockStore) UpdateBudgetTemplate(ctx context.Context, template *pfinancev1.RecurringBudget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBudgetTemplate", ctx, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBudgetTemplate indicates an expected call of UpdateBudgetTemplate.
+func (mr *MockStoreMockRecorder) UpdateBudgetTemplate(ctx, template any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBudgetTemplate", reflect.TypeOf((*MockStore)(nil).UpdateBudgetTemplate), ctx, template)
+}
+
 // UpdateExpense mocks base method.
 func (m *MockStore) UpdateExpense(ctx context.Context, expense *pfinancev1.Expense) error {
 	m.ctrl.T.Helper()
@@ -1179,6 +1709,20 @@ func (mr *MockStoreMockRecorder) UpdateExpense(ctx, expense any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExpense", reflect.TypeOf((*MockStore)(nil).UpdateExpense), ctx, expense)
 }
 
+// UpdateExtractionPreferences mocks base method.
+func (m *MockStore) UpdateExtractionPreferences(ctx context.Context, prefs *pfinancev1.ExtractionPreferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExtractionPreferences", ctx, prefs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateExtractionPreferences indicates an expected call of UpdateExtractionPreferences.
+func (mr *MockStoreMockRecorder) UpdateExtractionPreferences(ctx, prefs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExtractionPreferences", reflect.TypeOf((*MockStore)(nil).UpdateExtractionPreferences), ctx, prefs)
+}
+
 // UpdateGoal mocks base method.
 func (m *MockStore) UpdateGoal(ctx context.Context, goal *pfinancev1.FinancialGoal) error {
 	m.ctrl.T.Helper()
@@ -1207,6 +1751,20 @@ func (mr *MockStoreMockRecorder) UpdateGroup(ctx, group any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroup", reflect.TypeOf((*MockStore)(nil).UpdateGroup), ctx, group)
 }
 
+// UpdateImportProfile mocks base method.
+func (m *MockStore) UpdateImportProfile(ctx context.Context, profile *pfinancev1.ImportProfile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImportProfile", ctx, profile)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateImportProfile indicates an expected call of UpdateImportProfile.
+func (mr *MockStoreMockRecorder) UpdateImportProfile(ctx, profile any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImportProfile", reflect.TypeOf((*MockStore)(nil).UpdateImportProfile), ctx, profile)
+}
+
 // UpdateIncome mocks base method.
 func (m *MockStore) UpdateIncome(ctx context.Context, income *pfinancev1.Income) error {
 	m.ctrl.T.Helper()
@@ -1305,6 +1863,20 @@ func (mr *MockStoreMockRecorder) UpdateUser(ctx, user any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockStore)(nil).UpdateUser), ctx, user)
 }
 
+// UpsertCategoryBaseline mocks base method.
+func (m *MockStore) UpsertCategoryBaseline(ctx context.Context, baseline *pfinancev1.CategoryBaseline) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertCategoryBaseline", ctx, baseline)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertCategoryBaseline indicates an expected call of UpsertCategoryBaseline.
+func (mr *MockStoreMockRecorder) UpsertCategoryBaseline(ctx, baseline any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertCategoryBaseline", reflect.TypeOf((*MockStore)(nil).UpsertCategoryBaseline), ctx, baseline)
+}
+
 // UpsertCategoryOverride mocks base method.
 func (m *MockStore) UpsertCategoryOverride(ctx context.Context, override *pfinancev1.CategoryOverride) error {
 	m.ctrl.T.Helper()
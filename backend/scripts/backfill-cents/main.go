@@ -18,6 +18,7 @@ import (
 	"os"
 
 	"cloud.google.com/go/firestore"
+	"github.com/castlemilk/pfinance/backend/internal/store"
 	"google.golang.org/api/iterator"
 )
 
@@ -182,7 +183,7 @@ func backfillCollection(ctx context.Context, client *firestore.Client, col colle
 			}
 
 			// Compute cents from the double value.
-			cents := int64(doubleVal * 100)
+			cents := store.ToCents(doubleVal)
 			updates = append(updates, firestore.Update{
 				Path:  fm.centsField,
 				Value: cents,
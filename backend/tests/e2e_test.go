@@ -1,10 +1,17 @@
 package tests
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	pfinancev1 "github.com/castlemilk/pfinance/backend/gen/pfinance/v1"
@@ -106,7 +113,7 @@ func TestE2EFinanceService(t *testing.T) {
 		}
 
 		mockStore.EXPECT().
-			ListExpenses(gomock.Any(), "local-dev-user", "", gomock.Any(), gomock.Any(), int32(10), "").
+			ListExpenses(gomock.Any(), "local-dev-user", "", gomock.Any(), int32(10), "").
 			Return(mockExpenses, "", nil)
 
 		ctx := context.Background()
@@ -128,6 +135,182 @@ func TestE2EFinanceService(t *testing.T) {
 			t.Errorf("Expected 'Mock expense', got %s", resp.Msg.Expenses[0].Description)
 		}
 	})
+
+	t.Run("export transactions stream with mock", func(t *testing.T) {
+		date := timestamppb.New(time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC))
+		mockStore.EXPECT().
+			GetTaxConfig(gomock.Any(), "local-dev-user", "").
+			Return(nil, fmt.Errorf("not found"))
+		gomock.InOrder(
+			mockStore.EXPECT().
+				ListExpenses(gomock.Any(), "local-dev-user", "", gomock.Any(), int32(2), "").
+				Return([]*pfinancev1.Expense{
+					{Id: "e1", Description: "Laptop", AmountCents: 150000, Date: date, IsTaxDeductible: true},
+					{Id: "e2", Description: "Coffee", AmountCents: 450, Date: date},
+				}, "next", nil),
+			mockStore.EXPECT().
+				ListExpenses(gomock.Any(), "local-dev-user", "", gomock.Any(), int32(2), "next").
+				Return([]*pfinancev1.Expense{
+					{Id: "e3", Description: "Lunch", AmountCents: 1200, Date: date},
+				}, "", nil),
+		)
+
+		stream, err := client.ExportTransactionsStream(context.Background(), connect.NewRequest(&pfinancev1.ExportTransactionsStreamRequest{
+			FinancialYear: "2025-26",
+			BatchSize:     2,
+		}))
+		if err != nil {
+			t.Fatalf("Failed to start export stream: %v", err)
+		}
+		defer stream.Close()
+
+		var msgs []*pfinancev1.ExportTransactionsStreamResponse
+		for stream.Receive() {
+			msgs = append(msgs, stream.Msg())
+		}
+		if err := stream.Err(); err != nil {
+			t.Fatalf("Export stream failed: %v", err)
+		}
+
+		if len(msgs) != 2 {
+			t.Fatalf("Expected 2 messages, got %d", len(msgs))
+		}
+		if msgs[0].ContentType != "text/csv" || msgs[0].Filename != "transactions-2025-26.csv" {
+			t.Errorf("Expected CSV header on first message, got %q %q", msgs[0].ContentType, msgs[0].Filename)
+		}
+		if msgs[1].Filename != "" {
+			t.Errorf("Expected filename only on first message, got %q", msgs[1].Filename)
+		}
+		if msgs[0].RowCount != 2 || msgs[1].RowCount != 1 {
+			t.Errorf("Expected row counts 2 and 1, got %d and %d", msgs[0].RowCount, msgs[1].RowCount)
+		}
+
+		var csvData []byte
+		for _, m := range msgs {
+			csvData = append(csvData, m.Data...)
+		}
+		rows, err := csv.NewReader(bytes.NewReader(csvData)).ReadAll()
+		if err != nil {
+			t.Fatalf("Failed to parse streamed CSV: %v", err)
+		}
+		if len(rows) != 4 {
+			t.Fatalf("Expected header + 3 rows, got %d", len(rows))
+		}
+		if rows[1][1] != "Laptop" || rows[1][3] != "1500.00" || rows[1][5] != "true" {
+			t.Errorf("Unexpected first data row: %v", rows[1])
+		}
+	})
+}
+
+// TestExportAllDataArchive streams a full data archive over Connect and checks
+// that the chunks join into one valid JSON document.
+func TestExportAllDataArchive(t *testing.T) {
+	memStore := store.NewMemoryStore()
+	financeService := service.NewFinanceService(memStore, nil, nil)
+
+	interceptors := connect.WithInterceptors(auth.LocalDevInterceptor())
+	path, handler := pfinancev1connect.NewFinanceServiceHandler(financeService, interceptors)
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := pfinancev1connect.NewFinanceServiceClient(http.DefaultClient, server.URL, connect.WithGRPC())
+
+	ctx := context.Background()
+	userID := "local-dev-user"
+	date := timestamppb.New(time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC))
+	for _, id := range []string{"e1", "e2", "e3"} {
+		if err := memStore.CreateExpense(ctx, &pfinancev1.Expense{Id: id, UserId: userID, Description: id, AmountCents: 1000, Date: date}); err != nil {
+			t.Fatalf("CreateExpense: %v", err)
+		}
+	}
+	if err := memStore.CreateExpense(ctx, &pfinancev1.Expense{Id: "other", UserId: "someone-else", Description: "other"}); err != nil {
+		t.Fatalf("CreateExpense: %v", err)
+	}
+	if err := memStore.CreateIncome(ctx, &pfinancev1.Income{Id: "i1", UserId: userID, Source: "Salary", AmountCents: 500000, Date: date}); err != nil {
+		t.Fatalf("CreateIncome: %v", err)
+	}
+	if err := memStore.CreateGoal(ctx, &pfinancev1.FinancialGoal{Id: "g1", UserId: userID, Name: "Holiday"}); err != nil {
+		t.Fatalf("CreateGoal: %v", err)
+	}
+	for _, id := range []string{"gc1", "gc2"} {
+		if err := memStore.CreateGoalContribution(ctx, &pfinancev1.GoalContribution{Id: id, GoalId: "g1", UserId: userID, Amount: 50}); err != nil {
+			t.Fatalf("CreateGoalContribution: %v", err)
+		}
+	}
+	if err := memStore.CreateApiToken(ctx, &pfinancev1.ApiToken{Id: "t1", UserId: userID, Name: "Script", TokenHash: "secret-hash"}); err != nil {
+		t.Fatalf("CreateApiToken: %v", err)
+	}
+
+	stream, err := client.ExportAllData(ctx, connect.NewRequest(&pfinancev1.ExportAllDataRequest{BatchSize: 2}))
+	if err != nil {
+		t.Fatalf("Failed to start export stream: %v", err)
+	}
+	defer stream.Close()
+
+	var msgs []*pfinancev1.ExportAllDataResponse
+	for stream.Receive() {
+		msgs = append(msgs, stream.Msg())
+	}
+	if err := stream.Err(); err != nil {
+		t.Fatalf("Export stream failed: %v", err)
+	}
+
+	if msgs[0].ContentType != "application/json" || !strings.HasPrefix(msgs[0].Filename, "pfinance-archive-") {
+		t.Errorf("Expected archive metadata on first message, got %q %q", msgs[0].ContentType, msgs[0].Filename)
+	}
+	var archiveData []byte
+	var expenseChunks []int32
+	for i, m := range msgs {
+		if i > 0 && m.Filename != "" {
+			t.Errorf("Expected filename only on first message, got %q on message %d", m.Filename, i)
+		}
+		if m.Collection == "expenses" {
+			expenseChunks = append(expenseChunks, m.RecordCount)
+		}
+		archiveData = append(archiveData, m.Data...)
+	}
+	if !slices.Equal(expenseChunks, []int32{2, 1}) {
+		t.Errorf("Expected expenses in chunks of 2 and 1, got %v", expenseChunks)
+	}
+
+	var archive struct {
+		SchemaVersion int                         `json:"schema_version"`
+		UserID        string                      `json:"user_id"`
+		Collections   map[string][]map[string]any `json:"collections"`
+		Manifest      struct {
+			Collections []struct {
+				Name        string `json:"name"`
+				RecordCount int    `json:"record_count"`
+			} `json:"collections"`
+		} `json:"manifest"`
+	}
+	if err := json.Unmarshal(archiveData, &archive); err != nil {
+		t.Fatalf("Archive is not valid JSON: %v\n%s", err, archiveData)
+	}
+	if archive.SchemaVersion != 1 || archive.UserID != userID {
+		t.Errorf("Unexpected archive header: version %d, user %q", archive.SchemaVersion, archive.UserID)
+	}
+
+	counts := make(map[string]int)
+	for _, c := range archive.Manifest.Collections {
+		counts[c.Name] = c.RecordCount
+		if got := len(archive.Collections[c.Name]); got != c.RecordCount {
+			t.Errorf("Manifest says %d %s, archive has %d", c.RecordCount, c.Name, got)
+		}
+	}
+	for name, want := range map[string]int{"expenses": 3, "incomes": 1, "goals": 1, "goal_contributions": 2, "api_tokens": 1, "tax_configs": 1} {
+		if counts[name] != want {
+			t.Errorf("Expected %d %s, manifest has %d", want, name, counts[name])
+		}
+	}
+	if got := archive.Collections["expenses"][0]["user_id"]; got != userID {
+		t.Errorf("Expected snake_case expense fields, got user_id %v", got)
+	}
+	if _, ok := archive.Collections["api_tokens"][0]["token_hash"]; ok {
+		t.Error("Archive must not contain API token hashes")
+	}
 }
 
 // TestConnectIntegration tests that our service correctly implements the Connect interface
@@ -109,6 +109,14 @@ export default function AccountPage() {
   const [clearDialogOpen, setClearDialogOpen] = useState(false);
   const [clearConfirmEmail, setClearConfirmEmail] = useState('');
   const [clearing, setClearing] = useState(false);
+  const [clearPreview, setClearPreview] = useState<{
+    token: string;
+    expenses: number;
+    incomes: number;
+    budgets: number;
+    goals: number;
+    recurring: number;
+  } | null>(null);
   const [clearMessage, setClearMessage] = useState<{
     type: 'success' | 'error';
     text: string;
@@ -391,9 +399,34 @@ export default function AccountPage() {
     }
   };
 
-  // --- Clear Data Handler ---
+  // --- Clear Data Handlers ---
+  const handleClearDialogOpenChange = async (open: boolean) => {
+    setClearDialogOpen(open);
+    setClearPreview(null);
+    if (!open || !user) return;
+
+    try {
+      const resp = await financeClient.prepareClearUserData({ userId: user.uid });
+      setClearPreview({
+        token: resp.confirmationToken,
+        expenses: Number(resp.expenseCount),
+        incomes: Number(resp.incomeCount),
+        budgets: Number(resp.budgetCount),
+        goals: Number(resp.goalCount),
+        recurring: Number(resp.recurringTransactionCount),
+      });
+    } catch (err) {
+      console.error('Failed to prepare data clear:', err);
+      setClearDialogOpen(false);
+      setClearMessage({
+        type: 'error',
+        text: err instanceof Error ? err.message : 'Failed to prepare data clear.',
+      });
+    }
+  };
+
   const handleClearData = async () => {
-    if (!user || clearConfirmEmail !== user.email) return;
+    if (!user || !clearPreview || clearConfirmEmail !== user.email) return;
     setClearing(true);
     setClearMessage(null);
 
@@ -401,6 +434,7 @@ export default function AccountPage() {
       await financeClient.clearUserData({
         userId: user.uid,
         confirm: true,
+        confirmationToken: clearPreview.token,
       });
 
       setClearMessage({
@@ -416,6 +450,7 @@ export default function AccountPage() {
     } finally {
       setClearing(false);
       setClearDialogOpen(false);
+      setClearPreview(null);
       setClearConfirmEmail('');
     }
   };
@@ -1145,7 +1180,7 @@ export default function AccountPage() {
             </div>
             <AlertDialog
               open={clearDialogOpen}
-              onOpenChange={setClearDialogOpen}
+              onOpenChange={handleClearDialogOpenChange}
             >
               <AlertDialogTrigger asChild>
                 <Button
@@ -1167,6 +1202,18 @@ export default function AccountPage() {
                     remain intact.
                   </AlertDialogDescription>
                 </AlertDialogHeader>
+                <p className="text-sm text-muted-foreground">
+                  {clearPreview ? (
+                    <>
+                      This will delete {clearPreview.expenses} expenses,{' '}
+                      {clearPreview.incomes} incomes, {clearPreview.budgets} budgets,{' '}
+                      {clearPreview.goals} goals, and {clearPreview.recurring}{' '}
+                      recurring transactions.
+                    </>
+                  ) : (
+                    'Counting your data...'
+                  )}
+                </p>
                 <div className="space-y-2 py-2">
                   <Label htmlFor="clearConfirmEmail">
                     Type <span className="font-mono font-bold">{user.email}</span>{' '}
@@ -1188,7 +1235,7 @@ export default function AccountPage() {
                   <AlertDialogAction
                     onClick={handleClearData}
                     disabled={
-                      clearing || clearConfirmEmail !== user.email
+                      clearing || !clearPreview || clearConfirmEmail !== user.email
                     }
                     className="bg-amber-600 hover:bg-amber-700 text-white"
                   >
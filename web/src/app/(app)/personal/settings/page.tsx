@@ -25,6 +25,7 @@ function NotificationPreferencesCard() {
     { key: 'goalMilestones', label: 'Goal Milestones', desc: 'Celebrate when you hit savings milestones', value: preferences?.goalMilestones ?? true },
     { key: 'billReminders', label: 'Bill Reminders', desc: 'Reminders before recurring bills are due', value: preferences?.billReminders ?? true },
     { key: 'unusualSpending', label: 'Unusual Spending', desc: 'Alerts for spending that deviates from your patterns', value: preferences?.unusualSpending ?? true },
+    { key: 'substantiationAlerts', label: 'Deduction Substantiation', desc: 'Warnings when tax deductions near their cap or need receipts', value: preferences?.substantiationAlerts ?? true },
     { key: 'subscriptionAlerts', label: 'Subscription Alerts', desc: 'Updates about your subscription status', value: preferences?.subscriptionAlerts ?? true },
     { key: 'weeklyDigest', label: 'Weekly Digest', desc: 'A weekly summary of your financial activity', value: preferences?.weeklyDigest ?? false },
   ];
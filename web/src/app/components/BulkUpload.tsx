@@ -40,11 +40,12 @@ import { useFinance } from '../context/FinanceContext';
 import { useAuth } from '../context/AuthWithAdminContext';
 import { financeClient, DocumentType } from '@/lib/financeService';
 import { ExtractionMethod, ExtractionStatus } from '@/gen/pfinance/v1/types_pb';
-import type { ExtractedTransaction, StatementMetadata } from '@/gen/pfinance/v1/types_pb';
+import type { ExtractedTransaction, ExtractionResult, StatementMetadata } from '@/gen/pfinance/v1/types_pb';
 import { ExpenseCategory } from '../types';
 import { ExpenseFrequency as ProtoExpenseFrequency } from '@/gen/pfinance/v1/types_pb';
 import { compressImage, readFileAsDataUrl, base64ToUint8Array } from '../utils/imageCompression';
 import { getCurrentAustralianFY } from '@/app/constants/taxDeductions';
+import { DEFAULT_LOW_WARNING_THRESHOLD, reviewThresholds } from '../utils/extractionThresholds';
 import { useRouter } from 'next/navigation';
 import {
   Upload,
@@ -231,6 +232,7 @@ export function BulkUploadDialog({ open, onOpenChange, useGemini, setUseGemini,
   const [sortField, setSortField] = useState<SortField>('date');
   const [sortDirection, setSortDirection] = useState<SortDirection>('desc');
   const [confidenceFilter, setConfidenceFilter] = useState<ConfidenceFilter>('all');
+  const [lowWarningThreshold, setLowWarningThreshold] = useState(DEFAULT_LOW_WARNING_THRESHOLD);
   const [searchQuery, setSearchQuery] = useState('');
 
   // Editing state
@@ -382,11 +384,13 @@ export function BulkUploadDialog({ open, onOpenChange, useGemini, setUseGemini,
 
     let resultTransactions: ExtractedTransaction[] = [];
     let effectiveMetadata = response.statementMetadata;
+    let result: ExtractionResult | undefined;
 
     if (response.status === ExtractionStatus.PROCESSING && response.jobId) {
       updateFileStatus(bf.id, 'polling');
       const pollResult = await pollExtractionJob(response.jobId);
       if (pollResult) {
+        result = pollResult;
         resultTransactions = pollResult.transactions;
         // Prefer metadata from polled result if available (async path)
         if (pollResult.statementMetadata) {
@@ -394,9 +398,14 @@ export function BulkUploadDialog({ open, onOpenChange, useGemini, setUseGemini,
         }
       }
     } else if (response.result) {
+      result = response.result;
       resultTransactions = response.result.transactions;
     }
 
+    // Warn about and pre-deselect by the user's extraction preferences
+    const thresholds = reviewThresholds(result);
+    setLowWarningThreshold(thresholds.lowWarning);
+
     // Map to BulkTransaction -- filter to debits only
     const mapped: BulkTransaction[] = resultTransactions
       .filter((tx) => tx.isDebit)
@@ -409,7 +418,7 @@ export function BulkUploadDialog({ open, onOpenChange, useGemini, setUseGemini,
           id: nextId('bt'),
           sourceFileId: bf.id,
           sourceFileName: bf.name,
-          selected: (tx.confidence || 0) >= 0.5,
+          selected: (tx.confidence || 0) >= thresholds.preDeselect,
           description: tx.normalizedMerchant || tx.description,
           amount: tx.amount,
           category,
@@ -574,7 +583,7 @@ export function BulkUploadDialog({ open, onOpenChange, useGemini, setUseGemini,
     }
   };
 
-  async function pollExtractionJob(jobId: string): Promise<{ transactions: ExtractedTransaction[]; statementMetadata?: StatementMetadata } | null> {
+  async function pollExtractionJob(jobId: string): Promise<ExtractionResult | null> {
     // Adaptive polling: start fast (500ms), ramp up to 2s after the first few polls.
     // Receipt extractions typically complete in 3-5s; bank statements in 8-15s.
     const pollIntervals = [500, 500, 750, 1000, 1250, 1500, 2000]; // ms per poll index
@@ -592,10 +601,7 @@ export function BulkUploadDialog({ open, onOpenChange, useGemini, setUseGemini,
       try {
         const jobResp = await financeClient.getExtractionJob({ jobId });
         if (jobResp.job?.status === ExtractionStatus.COMPLETED && jobResp.job.result) {
-          return {
-            transactions: jobResp.job.result.transactions,
-            statementMetadata: jobResp.job.result.statementMetadata,
-          };
+          return jobResp.job.result;
         }
         if (jobResp.job?.status === ExtractionStatus.FAILED) {
           throw new Error(jobResp.job.errorMessage || 'Extraction failed');
@@ -708,8 +714,8 @@ export function BulkUploadDialog({ open, onOpenChange, useGemini, setUseGemini,
   const isTransactionVisible = useCallback((tx: BulkTransaction): boolean => {
     // Confidence filter
     if (confidenceFilter === 'high' && tx.confidence < 0.8) return false;
-    if (confidenceFilter === 'medium' && (tx.confidence < 0.6 || tx.confidence >= 0.8)) return false;
-    if (confidenceFilter === 'low' && tx.confidence >= 0.6) return false;
+    if (confidenceFilter === 'medium' && (tx.confidence < lowWarningThreshold || tx.confidence >= 0.8)) return false;
+    if (confidenceFilter === 'low' && tx.confidence >= lowWarningThreshold) return false;
 
     // Search filter
     if (searchQuery) {
@@ -722,7 +728,7 @@ export function BulkUploadDialog({ open, onOpenChange, useGemini, setUseGemini,
     }
 
     return true;
-  }, [confidenceFilter, searchQuery]);
+  }, [confidenceFilter, searchQuery, lowWarningThreshold]);
 
   // ── Computed values ──────────────────────────────────
 
@@ -761,10 +767,10 @@ export function BulkUploadDialog({ open, onOpenChange, useGemini, setUseGemini,
     const total = transactions.reduce((s, t) => s + t.amount, 0);
     const avgConfidence = transactions.reduce((s, t) => s + t.confidence, 0) / transactions.length;
     const highConfidence = transactions.filter((t) => t.confidence >= 0.8).length;
-    const lowConfidence = transactions.filter((t) => t.confidence < 0.6).length;
+    const lowConfidence = transactions.filter((t) => t.confidence < lowWarningThreshold).length;
     const uniqueSources = new Set(transactions.map((t) => t.sourceFileName)).size;
     return { total, avgConfidence, highConfidence, lowConfidence, uniqueSources };
-  }, [transactions]);
+  }, [transactions, lowWarningThreshold]);
 
   // ── Import ───────────────────────────────────────────
 
@@ -1325,8 +1331,8 @@ export function BulkUploadDialog({ open, onOpenChange, useGemini, setUseGemini,
               <SelectContent>
                 <SelectItem value="all" className="text-xs">All confidence</SelectItem>
                 <SelectItem value="high" className="text-xs">High (80%+)</SelectItem>
-                <SelectItem value="medium" className="text-xs">Medium (60-80%)</SelectItem>
-                <SelectItem value="low" className="text-xs">Low (&lt;60%)</SelectItem>
+                <SelectItem value="medium" className="text-xs">Medium ({Math.round(lowWarningThreshold * 100)}-80%)</SelectItem>
+                <SelectItem value="low" className="text-xs">Low (&lt;{Math.round(lowWarningThreshold * 100)}%)</SelectItem>
               </SelectContent>
             </Select>
           </div>
@@ -3,27 +3,28 @@
 import { Alert, AlertDescription, AlertTitle } from '@/components/ui/alert';
 import { AlertTriangle } from 'lucide-react';
 import type { FieldConfidence } from '@/gen/pfinance/v1/types_pb';
+import { DEFAULT_LOW_WARNING_THRESHOLD } from '../utils/extractionThresholds';
 
 interface ConfidenceWarningBannerProps {
   overallConfidence?: number;
   fieldConfidences?: FieldConfidence;
   rejectedCount?: number;
+  /** Confidence below which a warning is shown; the user's low-warning preference. */
+  lowThreshold?: number;
   className?: string;
 }
 
-const LOW_THRESHOLD = 0.6;
-
-function hasLowFieldConfidence(fc: FieldConfidence): boolean {
+function hasLowFieldConfidence(fc: FieldConfidence, lowThreshold: number): boolean {
   const fields: (keyof Pick<FieldConfidence, 'amount' | 'date' | 'description' | 'merchant' | 'category'>)[] = [
     'amount', 'date', 'description', 'merchant', 'category',
   ];
   return fields.some((key) => {
     const val = fc[key];
-    return typeof val === 'number' && val < LOW_THRESHOLD;
+    return typeof val === 'number' && val < lowThreshold;
   });
 }
 
-function getLowFields(fc: FieldConfidence): string[] {
+function getLowFields(fc: FieldConfidence, lowThreshold: number): string[] {
   const fields: { key: keyof Pick<FieldConfidence, 'amount' | 'date' | 'description' | 'merchant' | 'category'>; label: string }[] = [
     { key: 'amount', label: 'amount' },
     { key: 'date', label: 'date' },
@@ -34,7 +35,7 @@ function getLowFields(fc: FieldConfidence): string[] {
   return fields
     .filter(({ key }) => {
       const val = fc[key];
-      return typeof val === 'number' && val < LOW_THRESHOLD;
+      return typeof val === 'number' && val < lowThreshold;
     })
     .map(({ label }) => label);
 }
@@ -43,11 +44,12 @@ export function ConfidenceWarningBanner({
   overallConfidence,
   fieldConfidences,
   rejectedCount,
+  lowThreshold = DEFAULT_LOW_WARNING_THRESHOLD,
   className,
 }: ConfidenceWarningBannerProps) {
-  const showOverallWarning = overallConfidence !== undefined && overallConfidence < LOW_THRESHOLD;
-  const showFieldWarning = fieldConfidences ? hasLowFieldConfidence(fieldConfidences) : false;
-  const lowFields = fieldConfidences ? getLowFields(fieldConfidences) : [];
+  const showOverallWarning = overallConfidence !== undefined && overallConfidence < lowThreshold;
+  const showFieldWarning = fieldConfidences ? hasLowFieldConfidence(fieldConfidences, lowThreshold) : false;
+  const lowFields = fieldConfidences ? getLowFields(fieldConfidences, lowThreshold) : [];
 
   if (!showOverallWarning && !showFieldWarning && !rejectedCount) {
     return null;
@@ -6,14 +6,15 @@ import { Progress } from '@/components/ui/progress';
 import { ChevronDown, AlertTriangle } from 'lucide-react';
 import type { FieldConfidence } from '@/gen/pfinance/v1/types_pb';
 import { cn } from '@/lib/utils';
+import { DEFAULT_LOW_WARNING_THRESHOLD } from '../utils/extractionThresholds';
 
 interface FieldConfidenceDetailProps {
   fieldConfidences: FieldConfidence;
+  /** Confidence below which a field is flagged; the user's low-warning preference. */
+  lowThreshold?: number;
   className?: string;
 }
 
-const LOW_THRESHOLD = 0.6;
-
 const fields: { key: keyof Omit<FieldConfidence, '$typeName' | '$unknown'>; label: string }[] = [
   { key: 'amount', label: 'Amount' },
   { key: 'date', label: 'Date' },
@@ -22,18 +23,18 @@ const fields: { key: keyof Omit<FieldConfidence, '$typeName' | '$unknown'>; labe
   { key: 'category', label: 'Category' },
 ];
 
-function getBarColor(confidence: number): string {
+function getBarColor(confidence: number, lowThreshold: number): string {
   if (confidence >= 0.8) return 'bg-green-500';
-  if (confidence >= 0.6) return 'bg-yellow-500';
+  if (confidence >= lowThreshold) return 'bg-yellow-500';
   return 'bg-red-500';
 }
 
-export function FieldConfidenceDetail({ fieldConfidences, className }: FieldConfidenceDetailProps) {
+export function FieldConfidenceDetail({ fieldConfidences, lowThreshold = DEFAULT_LOW_WARNING_THRESHOLD, className }: FieldConfidenceDetailProps) {
   const [open, setOpen] = useState(false);
 
   const hasLowField = fields.some(({ key }) => {
     const val = fieldConfidences[key];
-    return typeof val === 'number' && val < LOW_THRESHOLD;
+    return typeof val === 'number' && val < lowThreshold;
   });
 
   return (
@@ -55,7 +56,7 @@ export function FieldConfidenceDetail({ fieldConfidences, className }: FieldConf
           const value = fieldConfidences[key];
           const confidence = typeof value === 'number' ? value : 0;
           const percentage = Math.round(confidence * 100);
-          const isLow = confidence < LOW_THRESHOLD;
+          const isLow = confidence < lowThreshold;
 
           return (
             <div key={key} className="flex items-center gap-2 text-xs">
@@ -71,7 +72,7 @@ export function FieldConfidenceDetail({ fieldConfidences, className }: FieldConf
                 <div
                   className={cn(
                     'absolute inset-0 h-1.5 rounded-full transition-all',
-                    getBarColor(confidence)
+                    getBarColor(confidence, lowThreshold)
                   )}
                   style={{ width: `${percentage}%` }}
                 />
@@ -228,8 +228,8 @@ export default function SearchCommandPalette() {
         searchFilters.customEndDate
       );
 
-      const amountMin = searchFilters.amountMin ? parseFloat(searchFilters.amountMin) : 0;
-      const amountMax = searchFilters.amountMax ? parseFloat(searchFilters.amountMax) : 0;
+      const amountMin = searchFilters.amountMin !== '' ? parseFloat(searchFilters.amountMin) : undefined;
+      const amountMax = searchFilters.amountMax !== '' ? parseFloat(searchFilters.amountMax) : undefined;
 
       // Transaction search (server-side via Algolia/Store)
       const response = await financeClient.searchTransactions({
@@ -272,10 +272,10 @@ export default function SearchCommandPalette() {
         }
 
         // Apply amount filter to goals (target amount)
-        if (amountMin > 0) {
+        if (amountMin !== undefined) {
           filteredGoals = filteredGoals.filter(goal => goal.targetAmount >= amountMin);
         }
-        if (amountMax > 0) {
+        if (amountMax !== undefined) {
           filteredGoals = filteredGoals.filter(goal => goal.targetAmount <= amountMax);
         }
 
@@ -307,10 +307,10 @@ export default function SearchCommandPalette() {
         }
 
         // Apply amount filter to budgets
-        if (amountMin > 0) {
+        if (amountMin !== undefined) {
           filteredBudgets = filteredBudgets.filter(budget => budget.amount >= amountMin);
         }
-        if (amountMax > 0) {
+        if (amountMax !== undefined) {
           filteredBudgets = filteredBudgets.filter(budget => budget.amount <= amountMax);
         }
 
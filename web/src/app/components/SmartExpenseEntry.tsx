@@ -73,6 +73,7 @@ interface ParsedExpense {
   fieldConfidences?: FieldConfidence;
   fallbackFrom?: string;
   rejectedCount?: number;
+  lowWarningThreshold?: number;
 }
 
 interface MultiExpenseResult {
@@ -681,6 +682,7 @@ export default function SmartExpenseEntry() {
                 confidence: tx.confidence || 0.85,
                 fieldConfidences: tx.fieldConfidences,
                 rejectedCount: asyncResult.rejectedTransactions?.length,
+                lowWarningThreshold: asyncResult.lowWarningThreshold,
               });
               setStep(2);
               return;
@@ -715,6 +717,7 @@ export default function SmartExpenseEntry() {
                   fieldConfidences: tx.fieldConfidences,
                   rejectedCount,
                   fallbackFrom,
+                  lowWarningThreshold: response.result?.lowWarningThreshold,
                 };
               });
 
@@ -748,6 +751,7 @@ export default function SmartExpenseEntry() {
             fieldConfidences: tx.fieldConfidences,
             rejectedCount,
             fallbackFrom,
+            lowWarningThreshold: response.result.lowWarningThreshold,
           });
           setStep(2);
 
@@ -1369,9 +1373,13 @@ export default function SmartExpenseEntry() {
             overallConfidence={parsedExpense.confidence}
             fieldConfidences={parsedExpense.fieldConfidences}
             rejectedCount={parsedExpense.rejectedCount}
+            lowThreshold={parsedExpense.lowWarningThreshold}
           />
           {parsedExpense.fieldConfidences && (
-            <FieldConfidenceDetail fieldConfidences={parsedExpense.fieldConfidences} />
+            <FieldConfidenceDetail
+              fieldConfidences={parsedExpense.fieldConfidences}
+              lowThreshold={parsedExpense.lowWarningThreshold}
+            />
           )}
 
           <div className="space-y-3">
@@ -143,8 +143,8 @@ export function ChatMessage({ message, onConfirm, onCancel, isHistorical = false
         userId: '',
         query: params.query || '',
         category: params.category || '',
-        amountMin: params.amountMin || 0,
-        amountMax: params.amountMax || 0,
+        amountMin: params.amountMin,
+        amountMax: params.amountMax,
         pageSize: 20,
         pageToken,
       });
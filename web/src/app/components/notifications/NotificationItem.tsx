@@ -47,6 +47,7 @@ function formatRelativeTime(date: Date): string {
 function getNotificationIcon(type: NotificationType) {
   switch (type) {
     case NotificationType.BUDGET_THRESHOLD:
+    case NotificationType.BUDGET_PACE:
       return <AlertTriangle className="w-4 h-4 text-amber-500" />;
     case NotificationType.GOAL_MILESTONE:
       return <Target className="w-4 h-4 text-emerald-500" />;
@@ -148,6 +148,7 @@ export function NotificationProvider({ children }: { children: ReactNode }) {
         billReminders: prefs.billReminders ?? preferences?.billReminders ?? true,
         unusualSpending: prefs.unusualSpending ?? preferences?.unusualSpending ?? true,
         subscriptionAlerts: prefs.subscriptionAlerts ?? preferences?.subscriptionAlerts ?? true,
+        substantiationAlerts: prefs.substantiationAlerts ?? preferences?.substantiationAlerts ?? true,
         weeklyDigest: prefs.weeklyDigest ?? preferences?.weeklyDigest ?? false,
         billReminderDays: prefs.billReminderDays ?? preferences?.billReminderDays ?? 3,
       };
@@ -131,6 +131,8 @@ function anomalyTypeToString(t: AnomalyType): string {
       return 'Unusual Timing';
     case AnomalyType.CATEGORY_SPIKE:
       return 'Category Spike';
+    case AnomalyType.TIME_OUTLIER:
+      return 'Time Outlier';
     default:
       return 'Unknown';
   }
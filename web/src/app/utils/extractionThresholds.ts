@@ -0,0 +1,28 @@
+/**
+ * Review thresholds for extracted transactions.
+ * Used by SmartExpenseEntry and BulkUpload to warn about and pre-deselect
+ * low-confidence transactions by the user's extraction preferences.
+ */
+
+import type { ExtractionResult } from '@/gen/pfinance/v1/types_pb';
+
+/** Matches the backend default (extraction.ConfidenceLowWarning). */
+export const DEFAULT_LOW_WARNING_THRESHOLD = 0.6;
+
+/** Matches the backend default (extraction.ConfidencePreDeselect). */
+export const DEFAULT_PRE_DESELECT_THRESHOLD = 0.5;
+
+export interface ReviewThresholds {
+  /** Transactions below this confidence get a "please verify" warning. */
+  lowWarning: number;
+  /** Transactions below this confidence start unselected. */
+  preDeselect: number;
+}
+
+/** Read the thresholds from an extraction result, falling back to the defaults. */
+export function reviewThresholds(result?: ExtractionResult): ReviewThresholds {
+  return {
+    lowWarning: result?.lowWarningThreshold ?? DEFAULT_LOW_WARNING_THRESHOLD,
+    preDeselect: result?.preDeselectThreshold ?? DEFAULT_PRE_DESELECT_THRESHOLD,
+  };
+}
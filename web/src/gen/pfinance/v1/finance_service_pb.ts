@@ -6,7 +6,7 @@ import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobu
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { ApiToken, BankStatementResult, Budget, BudgetPeriod, BudgetProgress, CategoryAmount, CategoryOverride, CategorySpending, CorrectionRecord, DailyAggregate, Deduction, DetectedSubscription, DocumentType, DuplicateCandidate, Expense, ExpenseAllocation, ExpenseBreakdown, ExpenseCategory, ExpenseContribution, ExpenseFrequency, ExtractedTransaction, ExtractionEvent, ExtractionJob, ExtractionMethod, ExtractionResult, ExtractionStatus, FieldConfidence, FinanceGroup, FinancialGoal, ForecastPoint, GoalContribution, GoalProgress, GoalStatus, GoalType, Granularity, GroupInvitation, GroupInviteLink, GroupMember, GroupRole, Income, IncomeContribution, IncomeFrequency, InvitationStatus, MemberBalance, Notification, NotificationPreferences, NotificationType, PotentialDeduction, RecurringTransaction, RecurringTransactionStatus, SearchResult, SpendingAnomaly, SpendingInsight, SplitType, StatementMetadata, SubscriptionStatus, SubscriptionTier, TaxCalculation, TaxConfig, TaxDeductionCategory, TaxStatus, TaxYearComparison, TimeSeriesDataPoint, TransactionType, User, WaterfallEntry } from "./types_pb";
+import type { ActivityItem, ApiToken, AttachmentRef, BankStatementResult, Budget, BudgetPeriod, BudgetProgress, BudgetRecommendation, CategoryAmount, CategoryCap, CategoryDelta, CategoryOverride, CategorySpending, CorrectionRecord, DailyAggregate, DayOfWeek, Deduction, DetectedSubscription, DocumentType, DuplicateCandidate, Expense, ExpenseAllocation, ExpenseBreakdown, ExpenseCategory, ExpenseContribution, ExpenseFrequency, ExtractedTransaction, ExtractionEvent, ExtractionJob, ExtractionMethod, ExtractionPreferences, ExtractionResult, ExtractionStatus, FieldConfidence, FinanceGroup, FinancialGoal, ForecastPoint, GoalContribution, GoalPriority, GoalProgress, GoalStatus, GoalType, Granularity, GroupInvitation, GroupInviteLink, GroupMember, GroupRole, ImportCategoryRule, ImportColumnMapping, ImportProfile, Income, IncomeContribution, IncomeFrequency, InvitationStatus, MemberBalance, MerchantMapping, MerchantMappingSort, Notification, NotificationDayCount, NotificationPreferences, NotificationType, PotentialDeduction, RecurringBudget, RecurringPatternSuggestion, RecurringTransaction, RecurringTransactionStatus, SearchResult, SearchSortBy, SettlementTransfer, SortDirection, SortField, SpendingAnomaly, SpendingInsight, SplitType, StatementMetadata, SubscriptionStatus, SubscriptionTier, TagSpending, TaxCalculation, TaxConfig, TaxDeductionCategory, TaxDeductionChecklistItem, TaxStatus, TaxYearComparison, TimeSeriesDataPoint, TransactionType, User, WaterfallEntry } from "./types_pb";
 import { file_pfinance_v1_types } from "./types_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file pfinance/v1/finance_service.proto.
  */
 export const file_pfinance_v1_finance_service: GenFile = /*@__PURE__*/
-  fileDesc("CiFwZmluYW5jZS92MS9maW5hbmNlX3NlcnZpY2UucHJvdG8SC3BmaW5hbmNlLnYxIiEKDkdldFVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkiMgoPR2V0VXNlclJlc3BvbnNlEh8KBHVzZXIYASABKAsyES5wZmluYW5jZS52MS5Vc2VyIlwKEVVwZGF0ZVVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSFAoMZGlzcGxheV9uYW1lGAIgASgJEhEKCXBob3RvX3VybBgDIAEoCRINCgVlbWFpbBgEIAEoCSI1ChJVcGRhdGVVc2VyUmVzcG9uc2USHwoEdXNlchgBIAEoCzIRLnBmaW5hbmNlLnYxLlVzZXIiNQoRRGVsZXRlVXNlclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIPCgdjb25maXJtGAIgASgIIjgKFENsZWFyVXNlckRhdGFSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSDwoHY29uZmlybRgCIAEoCCI4ChVFeHBvcnRVc2VyRGF0YVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIOCgZmb3JtYXQYAiABKAkiTgoWRXhwb3J0VXNlckRhdGFSZXNwb25zZRIMCgRkYXRhGAEgASgMEhAKCGZpbGVuYW1lGAIgASgJEhQKDGNvbnRlbnRfdHlwZRgDIAEoCSLxBAoUQ3JlYXRlRXhwZW5zZVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIOCgZhbW91bnQYBCABKAESLgoIY2F0ZWdvcnkYBSABKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSMAoJZnJlcXVlbmN5GAYgASgOMh0ucGZpbmFuY2UudjEuRXhwZW5zZUZyZXF1ZW5jeRIoCgRkYXRlGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIXCg9wYWlkX2J5X3VzZXJfaWQYCCABKAkSKgoKc3BsaXRfdHlwZRgJIAEoDjIWLnBmaW5hbmNlLnYxLlNwbGl0VHlwZRIaChJhbGxvY2F0ZWRfdXNlcl9pZHMYCiADKAkSMwoLYWxsb2NhdGlvbnMYCyADKAsyHi5wZmluYW5jZS52MS5FeHBlbnNlQWxsb2NhdGlvbhIMCgR0YWdzGAwgAygJEhQKDGFtb3VudF9jZW50cxgNIAEoAxIZChFpc190YXhfZGVkdWN0aWJsZRgOIAEoCBJBChZ0YXhfZGVkdWN0aW9uX2NhdGVnb3J5GA8gASgOMiEucGZpbmFuY2UudjEuVGF4RGVkdWN0aW9uQ2F0ZWdvcnkSGgoSdGF4X2RlZHVjdGlvbl9ub3RlGBAgASgJEh4KFnRheF9kZWR1Y3RpYmxlX3BlcmNlbnQYESABKAESEwoLcmVjZWlwdF91cmwYEiABKAkSHAoUcmVjZWlwdF9zdG9yYWdlX3BhdGgYEyABKAkiPgoVQ3JlYXRlRXhwZW5zZVJlc3BvbnNlEiUKB2V4cGVuc2UYASABKAsyFC5wZmluYW5jZS52MS5FeHBlbnNlIicKEUdldEV4cGVuc2VSZXF1ZXN0EhIKCmV4cGVuc2VfaWQYASABKAkiOwoSR2V0RXhwZW5zZVJlc3BvbnNlEiUKB2V4cGVuc2UYASABKAsyFC5wZmluYW5jZS52MS5FeHBlbnNlIrgEChRVcGRhdGVFeHBlbnNlUmVxdWVzdBISCgpleHBlbnNlX2lkGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEg4KBmFtb3VudBgDIAEoARIuCghjYXRlZ29yeRgEIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIwCglmcmVxdWVuY3kYBSABKA4yHS5wZmluYW5jZS52MS5FeHBlbnNlRnJlcXVlbmN5EhcKD3BhaWRfYnlfdXNlcl9pZBgGIAEoCRIqCgpzcGxpdF90eXBlGAcgASgOMhYucGZpbmFuY2UudjEuU3BsaXRUeXBlEhoKEmFsbG9jYXRlZF91c2VyX2lkcxgIIAMoCRIzCgthbGxvY2F0aW9ucxgJIAMoCzIeLnBmaW5hbmNlLnYxLkV4cGVuc2VBbGxvY2F0aW9uEgwKBHRhZ3MYCiADKAkSFAoMYW1vdW50X2NlbnRzGAsgASgDEhkKEWlzX3RheF9kZWR1Y3RpYmxlGAwgASgIEkEKFnRheF9kZWR1Y3Rpb25fY2F0ZWdvcnkYDSABKA4yIS5wZmluYW5jZS52MS5UYXhEZWR1Y3Rpb25DYXRlZ29yeRIaChJ0YXhfZGVkdWN0aW9uX25vdGUYDiABKAkSHgoWdGF4X2RlZHVjdGlibGVfcGVyY2VudBgPIAEoARITCgtyZWNlaXB0X3VybBgQIAEoCRIcChRyZWNlaXB0X3N0b3JhZ2VfcGF0aBgRIAEoCSI+ChVVcGRhdGVFeHBlbnNlUmVzcG9uc2USJQoHZXhwZW5zZRgBIAEoCzIULnBmaW5hbmNlLnYxLkV4cGVuc2UiKgoURGVsZXRlRXhwZW5zZVJlcXVlc3QSEgoKZXhwZW5zZV9pZBgBIAEoCSK9AQoTTGlzdEV4cGVuc2VzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEi4KCnN0YXJ0X2RhdGUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF9kYXRlGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCglwYWdlX3NpemUYBSABKAUSEgoKcGFnZV90b2tlbhgGIAEoCSJXChRMaXN0RXhwZW5zZXNSZXNwb25zZRImCghleHBlbnNlcxgBIAMoCzIULnBmaW5hbmNlLnYxLkV4cGVuc2USFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJInQKGkJhdGNoQ3JlYXRlRXhwZW5zZXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSMwoIZXhwZW5zZXMYAyADKAsyIS5wZmluYW5jZS52MS5DcmVhdGVFeHBlbnNlUmVxdWVzdCJFChtCYXRjaENyZWF0ZUV4cGVuc2VzUmVzcG9uc2USJgoIZXhwZW5zZXMYASADKAsyFC5wZmluYW5jZS52MS5FeHBlbnNlIqECChNDcmVhdGVJbmNvbWVSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSDgoGc291cmNlGAMgASgJEg4KBmFtb3VudBgEIAEoARIvCglmcmVxdWVuY3kYBSABKA4yHC5wZmluYW5jZS52MS5JbmNvbWVGcmVxdWVuY3kSKgoKdGF4X3N0YXR1cxgGIAEoDjIWLnBmaW5hbmNlLnYxLlRheFN0YXR1cxIqCgpkZWR1Y3Rpb25zGAcgAygLMhYucGZpbmFuY2UudjEuRGVkdWN0aW9uEigKBGRhdGUYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhQKDGFtb3VudF9jZW50cxgJIAEoAyI7ChRDcmVhdGVJbmNvbWVSZXNwb25zZRIjCgZpbmNvbWUYASABKAsyEy5wZmluYW5jZS52MS5JbmNvbWUiJQoQR2V0SW5jb21lUmVxdWVzdBIRCglpbmNvbWVfaWQYASABKAkiOAoRR2V0SW5jb21lUmVzcG9uc2USIwoGaW5jb21lGAEgASgLMhMucGZpbmFuY2UudjEuSW5jb21lIucBChNVcGRhdGVJbmNvbWVSZXF1ZXN0EhEKCWluY29tZV9pZBgBIAEoCRIOCgZzb3VyY2UYAiABKAkSDgoGYW1vdW50GAMgASgBEi8KCWZyZXF1ZW5jeRgEIAEoDjIcLnBmaW5hbmNlLnYxLkluY29tZUZyZXF1ZW5jeRIqCgp0YXhfc3RhdHVzGAUgASgOMhYucGZpbmFuY2UudjEuVGF4U3RhdHVzEioKCmRlZHVjdGlvbnMYBiADKAsyFi5wZmluYW5jZS52MS5EZWR1Y3Rpb24SFAoMYW1vdW50X2NlbnRzGAcgASgDIjsKFFVwZGF0ZUluY29tZVJlc3BvbnNlEiMKBmluY29tZRgBIAEoCzITLnBmaW5hbmNlLnYxLkluY29tZSIoChNEZWxldGVJbmNvbWVSZXF1ZXN0EhEKCWluY29tZV9pZBgBIAEoCSK8AQoSTGlzdEluY29tZXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSLgoKc3RhcnRfZGF0ZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX2RhdGUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCXBhZ2Vfc2l6ZRgFIAEoBRISCgpwYWdlX3Rva2VuGAYgASgJIlQKE0xpc3RJbmNvbWVzUmVzcG9uc2USJAoHaW5jb21lcxgBIAMoCzITLnBmaW5hbmNlLnYxLkluY29tZRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiOAoTR2V0VGF4Q29uZmlnUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJIkIKFEdldFRheENvbmZpZ1Jlc3BvbnNlEioKCnRheF9jb25maWcYASABKAsyFi5wZmluYW5jZS52MS5UYXhDb25maWciZwoWVXBkYXRlVGF4Q29uZmlnUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEioKCnRheF9jb25maWcYAyABKAsyFi5wZmluYW5jZS52MS5UYXhDb25maWciRQoXVXBkYXRlVGF4Q29uZmlnUmVzcG9uc2USKgoKdGF4X2NvbmZpZxgBIAEoCzIWLnBmaW5hbmNlLnYxLlRheENvbmZpZyJJChJDcmVhdGVHcm91cFJlcXVlc3QSEAoIb3duZXJfaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCSI/ChNDcmVhdGVHcm91cFJlc3BvbnNlEigKBWdyb3VwGAEgASgLMhkucGZpbmFuY2UudjEuRmluYW5jZUdyb3VwIiMKD0dldEdyb3VwUmVxdWVzdBIQCghncm91cF9pZBgBIAEoCSI8ChBHZXRHcm91cFJlc3BvbnNlEigKBWdyb3VwGAEgASgLMhkucGZpbmFuY2UudjEuRmluYW5jZUdyb3VwIkkKElVwZGF0ZUdyb3VwUmVxdWVzdBIQCghncm91cF9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJIj8KE1VwZGF0ZUdyb3VwUmVzcG9uc2USKAoFZ3JvdXAYASABKAsyGS5wZmluYW5jZS52MS5GaW5hbmNlR3JvdXAiJgoSRGVsZXRlR3JvdXBSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgJIksKEUxpc3RHcm91cHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkiWAoSTGlzdEdyb3Vwc1Jlc3BvbnNlEikKBmdyb3VwcxgBIAMoCzIZLnBmaW5hbmNlLnYxLkZpbmFuY2VHcm91cBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkieQoUSW52aXRlVG9Hcm91cFJlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkSEgoKaW52aXRlcl9pZBgCIAEoCRIVCg1pbnZpdGVlX2VtYWlsGAMgASgJEiQKBHJvbGUYBCABKA4yFi5wZmluYW5jZS52MS5Hcm91cFJvbGUiSQoVSW52aXRlVG9Hcm91cFJlc3BvbnNlEjAKCmludml0YXRpb24YASABKAsyHC5wZmluYW5jZS52MS5Hcm91cEludml0YXRpb24iQQoXQWNjZXB0SW52aXRhdGlvblJlcXVlc3QSFQoNaW52aXRhdGlvbl9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJIkQKGEFjY2VwdEludml0YXRpb25SZXNwb25zZRIoCgVncm91cBgBIAEoCzIZLnBmaW5hbmNlLnYxLkZpbmFuY2VHcm91cCJCChhEZWNsaW5lSW52aXRhdGlvblJlcXVlc3QSFQoNaW52aXRhdGlvbl9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJIjsKFlJlbW92ZUZyb21Hcm91cFJlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSJmChdVcGRhdGVNZW1iZXJSb2xlUmVxdWVzdBIQCghncm91cF9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEigKCG5ld19yb2xlGAMgASgOMhYucGZpbmFuY2UudjEuR3JvdXBSb2xlIkQKGFVwZGF0ZU1lbWJlclJvbGVSZXNwb25zZRIoCgZtZW1iZXIYASABKAsyGC5wZmluYW5jZS52MS5Hcm91cE1lbWJlciKCAQoWTGlzdEludml0YXRpb25zUmVxdWVzdBISCgp1c2VyX2VtYWlsGAEgASgJEi0KBnN0YXR1cxgCIAEoDjIdLnBmaW5hbmNlLnYxLkludml0YXRpb25TdGF0dXMSEQoJcGFnZV9zaXplGAMgASgFEhIKCnBhZ2VfdG9rZW4YBCABKAkiZQoXTGlzdEludml0YXRpb25zUmVzcG9uc2USMQoLaW52aXRhdGlvbnMYASADKAsyHC5wZmluYW5jZS52MS5Hcm91cEludml0YXRpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIr4CChNDcmVhdGVCdWRnZXRSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRIOCgZhbW91bnQYBSABKAESKQoGcGVyaW9kGAYgASgOMhkucGZpbmFuY2UudjEuQnVkZ2V0UGVyaW9kEjIKDGNhdGVnb3J5X2lkcxgHIAMoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIuCgpzdGFydF9kYXRlGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfZGF0ZRgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMYW1vdW50X2NlbnRzGAogASgDIjsKFENyZWF0ZUJ1ZGdldFJlc3BvbnNlEiMKBmJ1ZGdldBgBIAEoCzITLnBmaW5hbmNlLnYxLkJ1ZGdldCIlChBHZXRCdWRnZXRSZXF1ZXN0EhEKCWJ1ZGdldF9pZBgBIAEoCSI4ChFHZXRCdWRnZXRSZXNwb25zZRIjCgZidWRnZXQYASABKAsyEy5wZmluYW5jZS52MS5CdWRnZXQikQIKE1VwZGF0ZUJ1ZGdldFJlcXVlc3QSEQoJYnVkZ2V0X2lkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDgoGYW1vdW50GAQgASgBEikKBnBlcmlvZBgFIAEoDjIZLnBmaW5hbmNlLnYxLkJ1ZGdldFBlcmlvZBIyCgxjYXRlZ29yeV9pZHMYBiADKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSEQoJaXNfYWN0aXZlGAcgASgIEiwKCGVuZF9kYXRlGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxhbW91bnRfY2VudHMYCSABKAMiOwoUVXBkYXRlQnVkZ2V0UmVzcG9uc2USIwoGYnVkZ2V0GAEgASgLMhMucGZpbmFuY2UudjEuQnVkZ2V0IigKE0RlbGV0ZUJ1ZGdldFJlcXVlc3QSEQoJYnVkZ2V0X2lkGAEgASgJIngKEkxpc3RCdWRnZXRzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEhgKEGluY2x1ZGVfaW5hY3RpdmUYAyABKAgSEQoJcGFnZV9zaXplGAQgASgFEhIKCnBhZ2VfdG9rZW4YBSABKAkiVAoTTGlzdEJ1ZGdldHNSZXNwb25zZRIkCgdidWRnZXRzGAEgAygLMhMucGZpbmFuY2UudjEuQnVkZ2V0EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJdChhHZXRCdWRnZXRQcm9ncmVzc1JlcXVlc3QSEQoJYnVkZ2V0X2lkGAEgASgJEi4KCmFzX29mX2RhdGUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkoKGUdldEJ1ZGdldFByb2dyZXNzUmVzcG9uc2USLQoIcHJvZ3Jlc3MYASABKAsyGy5wZmluYW5jZS52MS5CdWRnZXRQcm9ncmVzcyKbAQoYR2V0TWVtYmVyQmFsYW5jZXNSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSLgoKc3RhcnRfZGF0ZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX2RhdGUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIosBChlHZXRNZW1iZXJCYWxhbmNlc1Jlc3BvbnNlEiwKCGJhbGFuY2VzGAEgAygLMhoucGZpbmFuY2UudjEuTWVtYmVyQmFsYW5jZRIcChR0b3RhbF9ncm91cF9leHBlbnNlcxgCIAEoARIiChp0b3RhbF9ncm91cF9leHBlbnNlc19jZW50cxgDIAEoAyJhChRTZXR0bGVFeHBlbnNlUmVxdWVzdBISCgpleHBlbnNlX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSDgoGYW1vdW50GAMgASgBEhQKDGFtb3VudF9jZW50cxgEIAEoAyJ6ChVTZXR0bGVFeHBlbnNlUmVzcG9uc2USJQoHZXhwZW5zZRgBIAEoCzIULnBmaW5hbmNlLnYxLkV4cGVuc2USOgoSdXBkYXRlZF9hbGxvY2F0aW9uGAIgASgLMh4ucGZpbmFuY2UudjEuRXhwZW5zZUFsbG9jYXRpb24iiAEKFkdldEdyb3VwU3VtbWFyeVJlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkSLgoKc3RhcnRfZGF0ZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX2RhdGUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIs0CChdHZXRHcm91cFN1bW1hcnlSZXNwb25zZRIWCg50b3RhbF9leHBlbnNlcxgBIAEoARIUCgx0b3RhbF9pbmNvbWUYAiABKAESOgoTZXhwZW5zZV9ieV9jYXRlZ29yeRgDIAMoCzIdLnBmaW5hbmNlLnYxLkV4cGVuc2VCcmVha2Rvd24SMwoPbWVtYmVyX2JhbGFuY2VzGAQgAygLMhoucGZpbmFuY2UudjEuTWVtYmVyQmFsYW5jZRIfChd1bnNldHRsZWRfZXhwZW5zZV9jb3VudBgFIAEoBRIYChB1bnNldHRsZWRfYW1vdW50GAYgASgBEhwKFHRvdGFsX2V4cGVuc2VzX2NlbnRzGAcgASgDEhoKEnRvdGFsX2luY29tZV9jZW50cxgIIAEoAxIeChZ1bnNldHRsZWRfYW1vdW50X2NlbnRzGAkgASgDIpgBChdDcmVhdGVJbnZpdGVMaW5rUmVxdWVzdBIQCghncm91cF9pZBgBIAEoCRISCgpjcmVhdGVkX2J5GAIgASgJEiwKDGRlZmF1bHRfcm9sZRgDIAEoDjIWLnBmaW5hbmNlLnYxLkdyb3VwUm9sZRIQCghtYXhfdXNlcxgEIAEoBRIXCg9leHBpcmVzX2luX2RheXMYBSABKAUiTQoYQ3JlYXRlSW52aXRlTGlua1Jlc3BvbnNlEjEKC2ludml0ZV9saW5rGAEgASgLMhwucGZpbmFuY2UudjEuR3JvdXBJbnZpdGVMaW5rIioKGkdldEludml0ZUxpbmtCeUNvZGVSZXF1ZXN0EgwKBGNvZGUYASABKAkiegobR2V0SW52aXRlTGlua0J5Q29kZVJlc3BvbnNlEjEKC2ludml0ZV9saW5rGAEgASgLMhwucGZpbmFuY2UudjEuR3JvdXBJbnZpdGVMaW5rEigKBWdyb3VwGAIgASgLMhkucGZpbmFuY2UudjEuRmluYW5jZUdyb3VwImEKFkpvaW5Hcm91cEJ5TGlua1JlcXVlc3QSDAoEY29kZRgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhIKCnVzZXJfZW1haWwYAyABKAkSFAoMZGlzcGxheV9uYW1lGAQgASgJIkMKF0pvaW5Hcm91cEJ5TGlua1Jlc3BvbnNlEigKBWdyb3VwGAEgASgLMhkucGZpbmFuY2UudjEuRmluYW5jZUdyb3VwImsKFkxpc3RJbnZpdGVMaW5rc1JlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkSGAoQaW5jbHVkZV9pbmFjdGl2ZRgCIAEoCBIRCglwYWdlX3NpemUYAyABKAUSEgoKcGFnZV90b2tlbhgEIAEoCSJmChdMaXN0SW52aXRlTGlua3NSZXNwb25zZRIyCgxpbnZpdGVfbGlua3MYASADKAsyHC5wZmluYW5jZS52MS5Hcm91cEludml0ZUxpbmsSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIi4KG0RlYWN0aXZhdGVJbnZpdGVMaW5rUmVxdWVzdBIPCgdsaW5rX2lkGAEgASgJIpACCh9Db250cmlidXRlRXhwZW5zZVRvR3JvdXBSZXF1ZXN0EhkKEXNvdXJjZV9leHBlbnNlX2lkGAEgASgJEhcKD3RhcmdldF9ncm91cF9pZBgCIAEoCRIWCg5jb250cmlidXRlZF9ieRgDIAEoCRIOCgZhbW91bnQYBCABKAESKgoKc3BsaXRfdHlwZRgFIAEoDjIWLnBmaW5hbmNlLnYxLlNwbGl0VHlwZRIaChJhbGxvY2F0ZWRfdXNlcl9pZHMYBiADKAkSMwoLYWxsb2NhdGlvbnMYByADKAsyHi5wZmluYW5jZS52MS5FeHBlbnNlQWxsb2NhdGlvbhIUCgxhbW91bnRfY2VudHMYCCABKAMijwEKIENvbnRyaWJ1dGVFeHBlbnNlVG9Hcm91cFJlc3BvbnNlEjYKDGNvbnRyaWJ1dGlvbhgBIAEoCzIgLnBmaW5hbmNlLnYxLkV4cGVuc2VDb250cmlidXRpb24SMwoVY3JlYXRlZF9ncm91cF9leHBlbnNlGAIgASgLMhQucGZpbmFuY2UudjEuRXhwZW5zZSJkChhMaXN0Q29udHJpYnV0aW9uc1JlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIRCglwYWdlX3NpemUYAyABKAUSEgoKcGFnZV90b2tlbhgEIAEoCSJtChlMaXN0Q29udHJpYnV0aW9uc1Jlc3BvbnNlEjcKDWNvbnRyaWJ1dGlvbnMYASADKAsyIC5wZmluYW5jZS52MS5FeHBlbnNlQ29udHJpYnV0aW9uEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKRAQoeQ29udHJpYnV0ZUluY29tZVRvR3JvdXBSZXF1ZXN0EhgKEHNvdXJjZV9pbmNvbWVfaWQYASABKAkSFwoPdGFyZ2V0X2dyb3VwX2lkGAIgASgJEhYKDmNvbnRyaWJ1dGVkX2J5GAMgASgJEg4KBmFtb3VudBgEIAEoARIUCgxhbW91bnRfY2VudHMYBSABKAMiiwEKH0NvbnRyaWJ1dGVJbmNvbWVUb0dyb3VwUmVzcG9uc2USNQoMY29udHJpYnV0aW9uGAEgASgLMh8ucGZpbmFuY2UudjEuSW5jb21lQ29udHJpYnV0aW9uEjEKFGNyZWF0ZWRfZ3JvdXBfaW5jb21lGAIgASgLMhMucGZpbmFuY2UudjEuSW5jb21lImoKHkxpc3RJbmNvbWVDb250cmlidXRpb25zUmVxdWVzdBIQCghncm91cF9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhEKCXBhZ2Vfc2l6ZRgDIAEoBRISCgpwYWdlX3Rva2VuGAQgASgJInIKH0xpc3RJbmNvbWVDb250cmlidXRpb25zUmVzcG9uc2USNgoNY29udHJpYnV0aW9ucxgBIAMoCzIfLnBmaW5hbmNlLnYxLkluY29tZUNvbnRyaWJ1dGlvbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkinwMKEUNyZWF0ZUdvYWxSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRIoCglnb2FsX3R5cGUYBSABKA4yFS5wZmluYW5jZS52MS5Hb2FsVHlwZRIVCg10YXJnZXRfYW1vdW50GAYgASgBEhYKDmluaXRpYWxfYW1vdW50GAcgASgBEi4KCnN0YXJ0X2RhdGUYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KC3RhcmdldF9kYXRlGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIyCgxjYXRlZ29yeV9pZHMYCiADKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSDAoEaWNvbhgLIAEoCRINCgVjb2xvchgMIAEoCRIbChN0YXJnZXRfYW1vdW50X2NlbnRzGA0gASgDEhwKFGluaXRpYWxfYW1vdW50X2NlbnRzGA4gASgDIj4KEkNyZWF0ZUdvYWxSZXNwb25zZRIoCgRnb2FsGAEgASgLMhoucGZpbmFuY2UudjEuRmluYW5jaWFsR29hbCIhCg5HZXRHb2FsUmVxdWVzdBIPCgdnb2FsX2lkGAEgASgJIjsKD0dldEdvYWxSZXNwb25zZRIoCgRnb2FsGAEgASgLMhoucGZpbmFuY2UudjEuRmluYW5jaWFsR29hbCKmAgoRVXBkYXRlR29hbFJlcXVlc3QSDwoHZ29hbF9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhUKDXRhcmdldF9hbW91bnQYBCABKAESLwoLdGFyZ2V0X2RhdGUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEicKBnN0YXR1cxgGIAEoDjIXLnBmaW5hbmNlLnYxLkdvYWxTdGF0dXMSMgoMY2F0ZWdvcnlfaWRzGAcgAygOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5EgwKBGljb24YCCABKAkSDQoFY29sb3IYCSABKAkSGwoTdGFyZ2V0X2Ftb3VudF9jZW50cxgKIAEoAyI+ChJVcGRhdGVHb2FsUmVzcG9uc2USKAoEZ29hbBgBIAEoCzIaLnBmaW5hbmNlLnYxLkZpbmFuY2lhbEdvYWwiJAoRRGVsZXRlR29hbFJlcXVlc3QSDwoHZ29hbF9pZBgBIAEoCSKvAQoQTGlzdEdvYWxzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEicKBnN0YXR1cxgDIAEoDjIXLnBmaW5hbmNlLnYxLkdvYWxTdGF0dXMSKAoJZ29hbF90eXBlGAQgASgOMhUucGZpbmFuY2UudjEuR29hbFR5cGUSEQoJcGFnZV9zaXplGAUgASgFEhIKCnBhZ2VfdG9rZW4YBiABKAkiVwoRTGlzdEdvYWxzUmVzcG9uc2USKQoFZ29hbHMYASADKAsyGi5wZmluYW5jZS52MS5GaW5hbmNpYWxHb2FsEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJZChZHZXRHb2FsUHJvZ3Jlc3NSZXF1ZXN0Eg8KB2dvYWxfaWQYASABKAkSLgoKYXNfb2ZfZGF0ZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiRgoXR2V0R29hbFByb2dyZXNzUmVzcG9uc2USKwoIcHJvZ3Jlc3MYASABKAsyGS5wZmluYW5jZS52MS5Hb2FsUHJvZ3Jlc3MibwoXQ29udHJpYnV0ZVRvR29hbFJlcXVlc3QSDwoHZ29hbF9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEg4KBmFtb3VudBgDIAEoARIMCgRub3RlGAQgASgJEhQKDGFtb3VudF9jZW50cxgFIAEoAyJ5ChhDb250cmlidXRlVG9Hb2FsUmVzcG9uc2USKAoEZ29hbBgBIAEoCzIaLnBmaW5hbmNlLnYxLkZpbmFuY2lhbEdvYWwSMwoMY29udHJpYnV0aW9uGAIgASgLMh0ucGZpbmFuY2UudjEuR29hbENvbnRyaWJ1dGlvbiJWChxMaXN0R29hbENvbnRyaWJ1dGlvbnNSZXF1ZXN0Eg8KB2dvYWxfaWQYASABKAkSEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkibgodTGlzdEdvYWxDb250cmlidXRpb25zUmVzcG9uc2USNAoNY29udHJpYnV0aW9ucxgBIAMoCzIdLnBmaW5hbmNlLnYxLkdvYWxDb250cmlidXRpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIl4KGkdldFNwZW5kaW5nSW5zaWdodHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSDgoGcGVyaW9kGAMgASgJEg0KBWxpbWl0GAQgASgFIn8KG0dldFNwZW5kaW5nSW5zaWdodHNSZXNwb25zZRIuCghpbnNpZ2h0cxgBIAMoCzIcLnBmaW5hbmNlLnYxLlNwZW5kaW5nSW5zaWdodBIwCgxnZW5lcmF0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIuIBChZFeHRyYWN0RG9jdW1lbnRSZXF1ZXN0EhUKDWRvY3VtZW50X2RhdGEYASABKAwSMAoNZG9jdW1lbnRfdHlwZRgCIAEoDjIZLnBmaW5hbmNlLnYxLkRvY3VtZW50VHlwZRIQCghmaWxlbmFtZRgDIAEoCRIYChBhc3luY19wcm9jZXNzaW5nGAQgASgIEhkKEXZhbGlkYXRlX3dpdGhfYXBpGAUgASgIEjgKEWV4dHJhY3Rpb25fbWV0aG9kGAYgASgOMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvbk1ldGhvZCLfAQoXRXh0cmFjdERvY3VtZW50UmVzcG9uc2USLQoGcmVzdWx0GAEgASgLMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvblJlc3VsdBIOCgZqb2JfaWQYAiABKAkSLQoGc3RhdHVzGAMgASgOMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvblN0YXR1cxI6ChJzdGF0ZW1lbnRfbWV0YWRhdGEYBCABKAsyHi5wZmluYW5jZS52MS5TdGF0ZW1lbnRNZXRhZGF0YRIaChJkdXBsaWNhdGVfd2FybmluZ3MYBSADKAkiKQoXR2V0RXh0cmFjdGlvbkpvYlJlcXVlc3QSDgoGam9iX2lkGAEgASgJIkMKGEdldEV4dHJhY3Rpb25Kb2JSZXNwb25zZRInCgNqb2IYASABKAsyGi5wZmluYW5jZS52MS5FeHRyYWN0aW9uSm9iIt8CCiJJbXBvcnRFeHRyYWN0ZWRUcmFuc2FjdGlvbnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSNwoMdHJhbnNhY3Rpb25zGAMgAygLMiEucGZpbmFuY2UudjEuRXh0cmFjdGVkVHJhbnNhY3Rpb24SFwoPc2tpcF9kdXBsaWNhdGVzGAQgASgIEjgKEWRlZmF1bHRfZnJlcXVlbmN5GAUgASgOMh0ucGZpbmFuY2UudjEuRXhwZW5zZUZyZXF1ZW5jeRI6ChJzdGF0ZW1lbnRfbWV0YWRhdGEYBiABKAsyHi5wZmluYW5jZS52MS5TdGF0ZW1lbnRNZXRhZGF0YRIZChFvcmlnaW5hbF9maWxlbmFtZRgHIAEoCRIUCgxyZWNlaXB0X3VybHMYCCADKAkSHQoVcmVjZWlwdF9zdG9yYWdlX3BhdGhzGAkgAygJIp0BCiNJbXBvcnRFeHRyYWN0ZWRUcmFuc2FjdGlvbnNSZXNwb25zZRIuChBjcmVhdGVkX2V4cGVuc2VzGAEgAygLMhQucGZpbmFuY2UudjEuRXhwZW5zZRIWCg5pbXBvcnRlZF9jb3VudBgCIAEoBRIVCg1za2lwcGVkX2NvdW50GAMgASgFEhcKD3NraXBwZWRfcmVhc29ucxgEIAMoCSInChdQYXJzZUV4cGVuc2VUZXh0UmVxdWVzdBIMCgR0ZXh0GAEgASgJIt0CCg1QYXJzZWRFeHBlbnNlEhMKC2Rlc2NyaXB0aW9uGAEgASgJEg4KBmFtb3VudBgCIAEoARIuCghjYXRlZ29yeRgDIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIwCglmcmVxdWVuY3kYBCABKA4yHS5wZmluYW5jZS52MS5FeHBlbnNlRnJlcXVlbmN5EigKBGRhdGUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhIKCnNwbGl0X3dpdGgYBiADKAkSEgoKY29uZmlkZW5jZRgHIAEoARIRCglyYXdfaW5wdXQYCCABKAkSEQoJcmVhc29uaW5nGAkgASgJEjcKEWZpZWxkX2NvbmZpZGVuY2VzGAogASgLMhwucGZpbmFuY2UudjEuRmllbGRDb25maWRlbmNlEhQKDGFtb3VudF9jZW50cxgLIAEoAyKfAQoYUGFyc2VFeHBlbnNlVGV4dFJlc3BvbnNlEisKB2V4cGVuc2UYASABKAsyGi5wZmluYW5jZS52MS5QYXJzZWRFeHBlbnNlEi4KCmFkZGl0aW9uYWwYAiADKAsyGi5wZmluYW5jZS52MS5QYXJzZWRFeHBlbnNlEg8KB3N1Y2Nlc3MYAyABKAgSFQoNZXJyb3JfbWVzc2FnZRgEIAEoCSKMAQoZUGFyc2VCYW5rU3RhdGVtZW50UmVxdWVzdBIQCghwZGZfZGF0YRgBIAEoDBIRCgliYW5rX2hpbnQYAiABKAkSOAoRZXh0cmFjdGlvbl9tZXRob2QYAyABKA4yHS5wZmluYW5jZS52MS5FeHRyYWN0aW9uTWV0aG9kEhAKCGZpbGVuYW1lGAQgASgJImoKGlBhcnNlQmFua1N0YXRlbWVudFJlc3BvbnNlEjAKBnJlc3VsdBgBIAEoCzIgLnBmaW5hbmNlLnYxLkJhbmtTdGF0ZW1lbnRSZXN1bHQSGgoSZHVwbGljYXRlX3dhcm5pbmdzGAIgAygJIt0DCiFDcmVhdGVSZWN1cnJpbmdUcmFuc2FjdGlvblJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIOCgZhbW91bnQYBCABKAESFAoMYW1vdW50X2NlbnRzGAUgASgDEi4KCGNhdGVnb3J5GAYgASgOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5EjAKCWZyZXF1ZW5jeRgHIAEoDjIdLnBmaW5hbmNlLnYxLkV4cGVuc2VGcmVxdWVuY3kSLgoKc3RhcnRfZGF0ZRgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX2RhdGUYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhIKCmlzX2V4cGVuc2UYCiABKAgSDAoEdGFncxgLIAMoCRIXCg9wYWlkX2J5X3VzZXJfaWQYDCABKAkSKgoKc3BsaXRfdHlwZRgNIAEoDjIWLnBmaW5hbmNlLnYxLlNwbGl0VHlwZRIzCgthbGxvY2F0aW9ucxgOIAMoCzIeLnBmaW5hbmNlLnYxLkV4cGVuc2VBbGxvY2F0aW9uImYKIkNyZWF0ZVJlY3VycmluZ1RyYW5zYWN0aW9uUmVzcG9uc2USQAoVcmVjdXJyaW5nX3RyYW5zYWN0aW9uGAEgASgLMiEucGZpbmFuY2UudjEuUmVjdXJyaW5nVHJhbnNhY3Rpb24iQgoeR2V0UmVjdXJyaW5nVHJhbnNhY3Rpb25SZXF1ZXN0EiAKGHJlY3VycmluZ190cmFuc2FjdGlvbl9pZBgBIAEoCSJjCh9HZXRSZWN1cnJpbmdUcmFuc2FjdGlvblJlc3BvbnNlEkAKFXJlY3VycmluZ190cmFuc2FjdGlvbhgBIAEoCzIhLnBmaW5hbmNlLnYxLlJlY3VycmluZ1RyYW5zYWN0aW9uIqwDCiFVcGRhdGVSZWN1cnJpbmdUcmFuc2FjdGlvblJlcXVlc3QSIAoYcmVjdXJyaW5nX3RyYW5zYWN0aW9uX2lkGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEg4KBmFtb3VudBgDIAEoARIUCgxhbW91bnRfY2VudHMYBCABKAMSLgoIY2F0ZWdvcnkYBSABKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSMAoJZnJlcXVlbmN5GAYgASgOMh0ucGZpbmFuY2UudjEuRXhwZW5zZUZyZXF1ZW5jeRIsCghlbmRfZGF0ZRgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKaXNfZXhwZW5zZRgIIAEoCBIMCgR0YWdzGAkgAygJEhcKD3BhaWRfYnlfdXNlcl9pZBgKIAEoCRIqCgpzcGxpdF90eXBlGAsgASgOMhYucGZpbmFuY2UudjEuU3BsaXRUeXBlEjMKC2FsbG9jYXRpb25zGAwgAygLMh4ucGZpbmFuY2UudjEuRXhwZW5zZUFsbG9jYXRpb24iZgoiVXBkYXRlUmVjdXJyaW5nVHJhbnNhY3Rpb25SZXNwb25zZRJAChVyZWN1cnJpbmdfdHJhbnNhY3Rpb24YASABKAsyIS5wZmluYW5jZS52MS5SZWN1cnJpbmdUcmFuc2FjdGlvbiJFCiFEZWxldGVSZWN1cnJpbmdUcmFuc2FjdGlvblJlcXVlc3QSIAoYcmVjdXJyaW5nX3RyYW5zYWN0aW9uX2lkGAEgASgJItQBCiBMaXN0UmVjdXJyaW5nVHJhbnNhY3Rpb25zUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEjcKBnN0YXR1cxgDIAEoDjInLnBmaW5hbmNlLnYxLlJlY3VycmluZ1RyYW5zYWN0aW9uU3RhdHVzEhkKEWZpbHRlcl9pc19leHBlbnNlGAQgASgIEhIKCmlzX2V4cGVuc2UYBSABKAgSEQoJcGFnZV9zaXplGAYgASgFEhIKCnBhZ2VfdG9rZW4YByABKAkifwohTGlzdFJlY3VycmluZ1RyYW5zYWN0aW9uc1Jlc3BvbnNlEkEKFnJlY3VycmluZ190cmFuc2FjdGlvbnMYASADKAsyIS5wZmluYW5jZS52MS5SZWN1cnJpbmdUcmFuc2FjdGlvbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiRAogUGF1c2VSZWN1cnJpbmdUcmFuc2FjdGlvblJlcXVlc3QSIAoYcmVjdXJyaW5nX3RyYW5zYWN0aW9uX2lkGAEgASgJImUKIVBhdXNlUmVjdXJyaW5nVHJhbnNhY3Rpb25SZXNwb25zZRJAChVyZWN1cnJpbmdfdHJhbnNhY3Rpb24YASABKAsyIS5wZmluYW5jZS52MS5SZWN1cnJpbmdUcmFuc2FjdGlvbiJFCiFSZXN1bWVSZWN1cnJpbmdUcmFuc2FjdGlvblJlcXVlc3QSIAoYcmVjdXJyaW5nX3RyYW5zYWN0aW9uX2lkGAEgASgJImYKIlJlc3VtZVJlY3VycmluZ1RyYW5zYWN0aW9uUmVzcG9uc2USQAoVcmVjdXJyaW5nX3RyYW5zYWN0aW9uGAEgASgLMiEucGZpbmFuY2UudjEuUmVjdXJyaW5nVHJhbnNhY3Rpb24iXwoXR2V0VXBjb21pbmdCaWxsc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRISCgpkYXlzX2FoZWFkGAMgASgFEg0KBWxpbWl0GAQgASgFIlUKGEdldFVwY29taW5nQmlsbHNSZXNwb25zZRI5Cg51cGNvbWluZ19iaWxscxgBIAMoCzIhLnBmaW5hbmNlLnYxLlJlY3VycmluZ1RyYW5zYWN0aW9uIiUKI1Byb2Nlc3NSZWN1cnJpbmdUcmFuc2FjdGlvbnNSZXF1ZXN0IoABCiRQcm9jZXNzUmVjdXJyaW5nVHJhbnNhY3Rpb25zUmVzcG9uc2USFwoPcHJvY2Vzc2VkX2NvdW50GAEgASgFEhUKDXNraXBwZWRfY291bnQYAiABKAUSEwoLZW5kZWRfY291bnQYAyABKAUSEwoLZXJyb3JfY291bnQYBCABKAUi7AIKGVNlYXJjaFRyYW5zYWN0aW9uc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRINCgVxdWVyeRgDIAEoCRIQCghjYXRlZ29yeRgEIAEoCRISCgphbW91bnRfbWluGAUgASgBEhIKCmFtb3VudF9tYXgYBiABKAESGAoQYW1vdW50X21pbl9jZW50cxgHIAEoAxIYChBhbW91bnRfbWF4X2NlbnRzGAggASgDEi4KCnN0YXJ0X2RhdGUYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF9kYXRlGAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIqCgR0eXBlGAsgASgOMhwucGZpbmFuY2UudjEuVHJhbnNhY3Rpb25UeXBlEhEKCXBhZ2Vfc2l6ZRgMIAEoBRISCgpwYWdlX3Rva2VuGA0gASgJInYKGlNlYXJjaFRyYW5zYWN0aW9uc1Jlc3BvbnNlEioKB3Jlc3VsdHMYASADKAsyGS5wZmluYW5jZS52MS5TZWFyY2hSZXN1bHQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhMKC3RvdGFsX2NvdW50GAMgASgFIlgKGkRldGVjdFN1YnNjcmlwdGlvbnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSFwoPbG9va2JhY2tfbW9udGhzGAMgASgFIq4BChtEZXRlY3RTdWJzY3JpcHRpb25zUmVzcG9uc2USOAoNc3Vic2NyaXB0aW9ucxgBIAMoCzIhLnBmaW5hbmNlLnYxLkRldGVjdGVkU3Vic2NyaXB0aW9uEhoKEnRvdGFsX21vbnRobHlfY29zdBgCIAEoARIgChh0b3RhbF9tb250aGx5X2Nvc3RfY2VudHMYAyABKAMSFwoPZm9yZ290dGVuX2NvdW50GAQgASgFImUKGUNvbnZlcnRUb1JlY3VycmluZ1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRI3CgxzdWJzY3JpcHRpb24YAiABKAsyIS5wZmluYW5jZS52MS5EZXRlY3RlZFN1YnNjcmlwdGlvbiJeChpDb252ZXJ0VG9SZWN1cnJpbmdSZXNwb25zZRJAChVyZWN1cnJpbmdfdHJhbnNhY3Rpb24YASABKAsyIS5wZmluYW5jZS52MS5SZWN1cnJpbmdUcmFuc2FjdGlvbiKbAQoYTGlzdE5vdGlmaWNhdGlvbnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEwoLdW5yZWFkX29ubHkYAiABKAgSEQoJcGFnZV9zaXplGAMgASgFEhIKCnBhZ2VfdG9rZW4YBCABKAkSMgoLdHlwZV9maWx0ZXIYBSABKA4yHS5wZmluYW5jZS52MS5Ob3RpZmljYXRpb25UeXBlInwKGUxpc3ROb3RpZmljYXRpb25zUmVzcG9uc2USMAoNbm90aWZpY2F0aW9ucxgBIAMoCzIZLnBmaW5hbmNlLnYxLk5vdGlmaWNhdGlvbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSFAoMdG90YWxfdW5yZWFkGAMgASgFIjYKG01hcmtOb3RpZmljYXRpb25SZWFkUmVxdWVzdBIXCg9ub3RpZmljYXRpb25faWQYASABKAkiMgofTWFya0FsbE5vdGlmaWNhdGlvbnNSZWFkUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIjQKIUdldFVucmVhZE5vdGlmaWNhdGlvbkNvdW50UmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIjMKIkdldFVucmVhZE5vdGlmaWNhdGlvbkNvdW50UmVzcG9uc2USDQoFY291bnQYASABKAUiNAohR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkiXwoiR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXNwb25zZRI5CgtwcmVmZXJlbmNlcxgBIAEoCzIkLnBmaW5hbmNlLnYxLk5vdGlmaWNhdGlvblByZWZlcmVuY2VzInIKJFVwZGF0ZU5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEjkKC3ByZWZlcmVuY2VzGAIgASgLMiQucGZpbmFuY2UudjEuTm90aWZpY2F0aW9uUHJlZmVyZW5jZXMiYgolVXBkYXRlTm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXNwb25zZRI5CgtwcmVmZXJlbmNlcxgBIAEoCzIkLnBmaW5hbmNlLnYxLk5vdGlmaWNhdGlvblByZWZlcmVuY2VzIi4KG0dlbmVyYXRlV2Vla2x5RGlnZXN0UmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIk0KHEdlbmVyYXRlV2Vla2x5RGlnZXN0UmVzcG9uc2USFwoPdXNlcnNfcHJvY2Vzc2VkGAEgASgFEhQKDGRpZ2VzdHNfc2VudBgCIAEoBSLNAgoQV2Vla2x5RGlnZXN0RGF0YRIZChF0b3RhbF9zcGVudF9jZW50cxgBIAEoAxIaChJ0b3RhbF9pbmNvbWVfY2VudHMYAiABKAMSEQoJbmV0X2NlbnRzGAMgASgDEjMKDnRvcF9jYXRlZ29yaWVzGAQgAygLMhsucGZpbmFuY2UudjEuQ2F0ZWdvcnlBbW91bnQSOgoQYnVkZ2V0X3N1bW1hcmllcxgFIAMoCzIgLnBmaW5hbmNlLnYxLkRpZ2VzdEJ1ZGdldFN1bW1hcnkSNgoOZ29hbF9zdW1tYXJpZXMYBiADKAsyHi5wZmluYW5jZS52MS5EaWdlc3RHb2FsU3VtbWFyeRIcChR1cGNvbWluZ19iaWxsc19jb3VudBgHIAEoBRIUCgxwZXJpb2Rfc3RhcnQYCCABKAkSEgoKcGVyaW9kX2VuZBgJIAEoCSJnChNEaWdlc3RCdWRnZXRTdW1tYXJ5EgwKBG5hbWUYASABKAkSEwoLc3BlbnRfY2VudHMYAiABKAMSFAoMYnVkZ2V0X2NlbnRzGAMgASgDEhcKD3BlcmNlbnRhZ2VfdXNlZBgEIAEoASJrChFEaWdlc3RHb2FsU3VtbWFyeRIMCgRuYW1lGAEgASgJEhUKDWN1cnJlbnRfY2VudHMYAiABKAMSFAoMdGFyZ2V0X2NlbnRzGAMgASgDEhsKE3BlcmNlbnRhZ2VfY29tcGxldGUYBCABKAEiWAocQ3JlYXRlQ2hlY2tvdXRTZXNzaW9uUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhMKC3N1Y2Nlc3NfdXJsGAIgASgJEhIKCmNhbmNlbF91cmwYAyABKAkiSQodQ3JlYXRlQ2hlY2tvdXRTZXNzaW9uUmVzcG9uc2USFAoMY2hlY2tvdXRfdXJsGAEgASgJEhIKCnNlc3Npb25faWQYAiABKAkiLwocR2V0U3Vic2NyaXB0aW9uU3RhdHVzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJItMBCh1HZXRTdWJzY3JpcHRpb25TdGF0dXNSZXNwb25zZRIrCgR0aWVyGAEgASgOMh0ucGZpbmFuY2UudjEuU3Vic2NyaXB0aW9uVGllchIvCgZzdGF0dXMYAiABKA4yHy5wZmluYW5jZS52MS5TdWJzY3JpcHRpb25TdGF0dXMSNgoSY3VycmVudF9wZXJpb2RfZW5kGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIcChRjYW5jZWxfYXRfcGVyaW9kX2VuZBgEIAEoCCIsChlDYW5jZWxTdWJzY3JpcHRpb25SZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkiawoaQ2FuY2VsU3Vic2NyaXB0aW9uUmVzcG9uc2USLwoGc3RhdHVzGAEgASgOMh8ucGZpbmFuY2UudjEuU3Vic2NyaXB0aW9uU3RhdHVzEhwKFGNhbmNlbF9hdF9wZXJpb2RfZW5kGAIgASgIIjIKHFZlcmlmeUNoZWNrb3V0U2Vzc2lvblJlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCSLrAQodVmVyaWZ5Q2hlY2tvdXRTZXNzaW9uUmVzcG9uc2USKwoEdGllchgBIAEoDjIdLnBmaW5hbmNlLnYxLlN1YnNjcmlwdGlvblRpZXISLwoGc3RhdHVzGAIgASgOMh8ucGZpbmFuY2UudjEuU3Vic2NyaXB0aW9uU3RhdHVzEjYKEmN1cnJlbnRfcGVyaW9kX2VuZBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHAoUY2FuY2VsX2F0X3BlcmlvZF9lbmQYBCABKAgSFgoOYWxyZWFkeV9hY3RpdmUYBSABKAginAEKGUdldERhaWx5QWdncmVnYXRlc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRIuCgpzdGFydF9kYXRlGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfZGF0ZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAihwEKGkdldERhaWx5QWdncmVnYXRlc1Jlc3BvbnNlEi8KCmFnZ3JlZ2F0ZXMYASADKAsyGy5wZmluYW5jZS52MS5EYWlseUFnZ3JlZ2F0ZRIYChBtYXhfZGFpbHlfYW1vdW50GAIgASgBEh4KFm1heF9kYWlseV9hbW91bnRfY2VudHMYAyABKAMirQEKGEdldFNwZW5kaW5nVHJlbmRzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEi0KC2dyYW51bGFyaXR5GAMgASgOMhgucGZpbmFuY2UudjEuR3JhbnVsYXJpdHkSDwoHcGVyaW9kcxgEIAEoBRIuCghjYXRlZ29yeRgFIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeSK8AQoZR2V0U3BlbmRpbmdUcmVuZHNSZXNwb25zZRI4Cg5leHBlbnNlX3NlcmllcxgBIAMoCzIgLnBmaW5hbmNlLnYxLlRpbWVTZXJpZXNEYXRhUG9pbnQSNwoNaW5jb21lX3NlcmllcxgCIAMoCzIgLnBmaW5hbmNlLnYxLlRpbWVTZXJpZXNEYXRhUG9pbnQSEwoLdHJlbmRfc2xvcGUYAyABKAESFwoPdHJlbmRfcl9zcXVhcmVkGAQgASgBInIKHEdldENhdGVnb3J5Q29tcGFyaXNvblJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRIWCg5jdXJyZW50X3BlcmlvZBgDIAEoCRIXCg9pbmNsdWRlX2J1ZGdldHMYBCABKAgiUgodR2V0Q2F0ZWdvcnlDb21wYXJpc29uUmVzcG9uc2USMQoKY2F0ZWdvcmllcxgBIAMoCzIdLnBmaW5hbmNlLnYxLkNhdGVnb3J5U3BlbmRpbmciZwoWRGV0ZWN0QW5vbWFsaWVzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEhUKDWxvb2tiYWNrX2RheXMYAyABKAUSEwoLc2Vuc2l0aXZpdHkYBCABKAEixQEKF0RldGVjdEFub21hbGllc1Jlc3BvbnNlEi8KCWFub21hbGllcxgBIAMoCzIcLnBmaW5hbmNlLnYxLlNwZW5kaW5nQW5vbWFseRIXCg90b3RhbF9hbm9tYWxpZXMYAiABKAUSHQoVYW5vbWFsb3VzX3NwZW5kX3RvdGFsGAMgASgBEiMKG2Fub21hbG91c19zcGVuZF90b3RhbF9jZW50cxgEIAEoAxIcChR0b3BfYW5vbWFseV9jYXRlZ29yeRgFIAEoCSJWChpHZXRDYXNoRmxvd0ZvcmVjYXN0UmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEhUKDWZvcmVjYXN0X2RheXMYAyABKAUirwIKG0dldENhc2hGbG93Rm9yZWNhc3RSZXNwb25zZRIzCg9pbmNvbWVfZm9yZWNhc3QYASADKAsyGi5wZmluYW5jZS52MS5Gb3JlY2FzdFBvaW50EjQKEGV4cGVuc2VfZm9yZWNhc3QYAiADKAsyGi5wZmluYW5jZS52MS5Gb3JlY2FzdFBvaW50EjAKDG5ldF9mb3JlY2FzdBgDIAMoCzIaLnBmaW5hbmNlLnYxLkZvcmVjYXN0UG9pbnQSOAoOaW5jb21lX2hpc3RvcnkYBCADKAsyIC5wZmluYW5jZS52MS5UaW1lU2VyaWVzRGF0YVBvaW50EjkKD2V4cGVuc2VfaGlzdG9yeRgFIAMoCzIgLnBmaW5hbmNlLnYxLlRpbWVTZXJpZXNEYXRhUG9pbnQiTAoXR2V0V2F0ZXJmYWxsRGF0YVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRIOCgZwZXJpb2QYAyABKAkiXgoYR2V0V2F0ZXJmYWxsRGF0YVJlc3BvbnNlEiwKB2VudHJpZXMYASADKAsyGy5wZmluYW5jZS52MS5XYXRlcmZhbGxFbnRyeRIUCgxwZXJpb2RfbGFiZWwYAiABKAkiXwoYU3VibWl0Q29ycmVjdGlvbnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSMgoLY29ycmVjdGlvbnMYAiADKAsyHS5wZmluYW5jZS52MS5Db3JyZWN0aW9uUmVjb3JkIlcKGVN1Ym1pdENvcnJlY3Rpb25zUmVzcG9uc2USFwoPcHJvY2Vzc2VkX2NvdW50GAEgASgFEiEKGW1lcmNoYW50X21hcHBpbmdzX3VwZGF0ZWQYAiABKAUidAoWQ2hlY2tEdXBsaWNhdGVzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEjcKDHRyYW5zYWN0aW9ucxgDIAMoCzIhLnBmaW5hbmNlLnYxLkV4dHJhY3RlZFRyYW5zYWN0aW9uIrsBChdDaGVja0R1cGxpY2F0ZXNSZXNwb25zZRJICgpkdXBsaWNhdGVzGAEgAygLMjQucGZpbmFuY2UudjEuQ2hlY2tEdXBsaWNhdGVzUmVzcG9uc2UuRHVwbGljYXRlc0VudHJ5GlYKD0R1cGxpY2F0ZXNFbnRyeRILCgNrZXkYASABKAkSMgoFdmFsdWUYAiABKAsyIy5wZmluYW5jZS52MS5EdXBsaWNhdGVDYW5kaWRhdGVMaXN0OgI4ASJNChZEdXBsaWNhdGVDYW5kaWRhdGVMaXN0EjMKCmNhbmRpZGF0ZXMYASADKAsyHy5wZmluYW5jZS52MS5EdXBsaWNhdGVDYW5kaWRhdGUiRwodR2V0TWVyY2hhbnRTdWdnZXN0aW9uc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIVCg1tZXJjaGFudF90ZXh0GAIgASgJIpYBCh5HZXRNZXJjaGFudFN1Z2dlc3Rpb25zUmVzcG9uc2USFgoOc3VnZ2VzdGVkX25hbWUYASABKAkSOAoSc3VnZ2VzdGVkX2NhdGVnb3J5GAIgASgOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5EhIKCmNvbmZpZGVuY2UYAyABKAESDgoGc291cmNlGAQgASgJIjwKG0dldEV4dHJhY3Rpb25NZXRyaWNzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEgwKBGRheXMYAiABKAUimwQKHEdldEV4dHJhY3Rpb25NZXRyaWNzUmVzcG9uc2USGQoRdG90YWxfZXh0cmFjdGlvbnMYASABKAUSGgoSdG90YWxfdHJhbnNhY3Rpb25zGAIgASgFEhkKEXRvdGFsX2NvcnJlY3Rpb25zGAMgASgFEhcKD2NvcnJlY3Rpb25fcmF0ZRgEIAEoARIaChJhdmVyYWdlX2NvbmZpZGVuY2UYBSABKAESXwoUY29ycmVjdGlvbnNfYnlfZmllbGQYBiADKAsyQS5wZmluYW5jZS52MS5HZXRFeHRyYWN0aW9uTWV0cmljc1Jlc3BvbnNlLkNvcnJlY3Rpb25zQnlGaWVsZEVudHJ5EmUKF2NvcnJlY3Rpb25zX2J5X2NhdGVnb3J5GAcgAygLMkQucGZpbmFuY2UudjEuR2V0RXh0cmFjdGlvbk1ldHJpY3NSZXNwb25zZS5Db3JyZWN0aW9uc0J5Q2F0ZWdvcnlFbnRyeRIzCg1yZWNlbnRfZXZlbnRzGAggAygLMhwucGZpbmFuY2UudjEuRXh0cmFjdGlvbkV2ZW50GjkKF0NvcnJlY3Rpb25zQnlGaWVsZEVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEaPAoaQ29ycmVjdGlvbnNCeUNhdGVnb3J5RW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ASIuChtHZXRDYXRlZ29yeU92ZXJyaWRlc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSJQChxHZXRDYXRlZ29yeU92ZXJyaWRlc1Jlc3BvbnNlEjAKCW92ZXJyaWRlcxgBIAMoCzIdLnBmaW5hbmNlLnYxLkNhdGVnb3J5T3ZlcnJpZGUiegoaU2V0Q2F0ZWdvcnlPdmVycmlkZVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIbChNtZXJjaGFudF9ub3JtYWxpemVkGAIgASgJEi4KCGNhdGVnb3J5GAMgASgOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5Ik4KG1NldENhdGVnb3J5T3ZlcnJpZGVSZXNwb25zZRIvCghvdmVycmlkZRgBIAEoCzIdLnBmaW5hbmNlLnYxLkNhdGVnb3J5T3ZlcnJpZGUiTQodRGVsZXRlQ2F0ZWdvcnlPdmVycmlkZVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIbChNtZXJjaGFudF9ub3JtYWxpemVkGAIgASgJIiAKHkRlbGV0ZUNhdGVnb3J5T3ZlcnJpZGVSZXNwb25zZSI/ChRHZXRUYXhTdW1tYXJ5UmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhYKDmZpbmFuY2lhbF95ZWFyGAIgASgJIkkKFUdldFRheFN1bW1hcnlSZXNwb25zZRIwCgtjYWxjdWxhdGlvbhgBIAEoCzIbLnBmaW5hbmNlLnYxLlRheENhbGN1bGF0aW9uIvoBChVHZXRUYXhFc3RpbWF0ZVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIWCg5maW5hbmNpYWxfeWVhchgCIAEoCRIjChtncm9zc19pbmNvbWVfb3ZlcnJpZGVfY2VudHMYAyABKAMSHQoVZ3Jvc3NfaW5jb21lX292ZXJyaWRlGAQgASgBEiMKG2FkZGl0aW9uYWxfZGVkdWN0aW9uc19jZW50cxgFIAEoAxIdChVhZGRpdGlvbmFsX2RlZHVjdGlvbnMYBiABKAESFAoMaW5jbHVkZV9oZWxwGAcgASgIEhoKEm1lZGljYXJlX2V4ZW1wdGlvbhgIIAEoCCJKChZHZXRUYXhFc3RpbWF0ZVJlc3BvbnNlEjAKC2NhbGN1bGF0aW9uGAEgASgLMhsucGZpbmFuY2UudjEuVGF4Q2FsY3VsYXRpb24iwAEKEEV4cGVuc2VUYXhVcGRhdGUSEgoKZXhwZW5zZV9pZBgBIAEoCRIZChFpc190YXhfZGVkdWN0aWJsZRgCIAEoCBJBChZ0YXhfZGVkdWN0aW9uX2NhdGVnb3J5GAMgASgOMiEucGZpbmFuY2UudjEuVGF4RGVkdWN0aW9uQ2F0ZWdvcnkSGgoSdGF4X2RlZHVjdGlvbl9ub3RlGAQgASgJEh4KFnRheF9kZWR1Y3RpYmxlX3BlcmNlbnQYBSABKAEiZQoiQmF0Y2hVcGRhdGVFeHBlbnNlVGF4U3RhdHVzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEi4KB3VwZGF0ZXMYAiADKAsyHS5wZmluYW5jZS52MS5FeHBlbnNlVGF4VXBkYXRlIlgKI0JhdGNoVXBkYXRlRXhwZW5zZVRheFN0YXR1c1Jlc3BvbnNlEhUKDXVwZGF0ZWRfY291bnQYASABKAUSGgoSZmFpbGVkX2V4cGVuc2VfaWRzGAIgAygJIrYBCh1MaXN0RGVkdWN0aWJsZUV4cGVuc2VzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEhYKDmZpbmFuY2lhbF95ZWFyGAMgASgJEjMKCGNhdGVnb3J5GAQgASgOMiEucGZpbmFuY2UudjEuVGF4RGVkdWN0aW9uQ2F0ZWdvcnkSEQoJcGFnZV9zaXplGAUgASgFEhIKCnBhZ2VfdG9rZW4YBiABKAkimwEKHkxpc3REZWR1Y3RpYmxlRXhwZW5zZXNSZXNwb25zZRImCghleHBlbnNlcxgBIAMoCzIULnBmaW5hbmNlLnYxLkV4cGVuc2USFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEh4KFnRvdGFsX2RlZHVjdGlibGVfY2VudHMYAyABKAMSGAoQdG90YWxfZGVkdWN0aWJsZRgEIAEoASJhChNUYXhGaWVsZENvbmZpZGVuY2VzEhUKDWlzX2RlZHVjdGlibGUYASABKAESFAoMYXRvX2NhdGVnb3J5GAIgASgBEh0KFWRlZHVjdGlibGVfcGVyY2VudGFnZRgDIAEoASKlAgoXVGF4Q2xhc3NpZmljYXRpb25SZXN1bHQSEgoKZXhwZW5zZV9pZBgBIAEoCRIVCg1pc19kZWR1Y3RpYmxlGAIgASgIEjMKCGNhdGVnb3J5GAMgASgOMiEucGZpbmFuY2UudjEuVGF4RGVkdWN0aW9uQ2F0ZWdvcnkSGgoSZGVkdWN0aWJsZV9wZXJjZW50GAQgASgBEhIKCmNvbmZpZGVuY2UYBSABKAESEQoJcmVhc29uaW5nGAYgASgJEhQKDGF1dG9fYXBwbGllZBgHIAEoCBIUCgxuZWVkc19yZXZpZXcYCCABKAgSOwoRZmllbGRfY29uZmlkZW5jZXMYCSABKAsyIC5wZmluYW5jZS52MS5UYXhGaWVsZENvbmZpZGVuY2VzIloKH0NsYXNzaWZ5VGF4RGVkdWN0aWJpbGl0eVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRISCgpleHBlbnNlX2lkGAIgASgJEhIKCm9jY3VwYXRpb24YAyABKAkiWAogQ2xhc3NpZnlUYXhEZWR1Y3RpYmlsaXR5UmVzcG9uc2USNAoGcmVzdWx0GAEgASgLMiQucGZpbmFuY2UudjEuVGF4Q2xhc3NpZmljYXRpb25SZXN1bHQidwokQmF0Y2hDbGFzc2lmeVRheERlZHVjdGliaWxpdHlSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSFgoOZmluYW5jaWFsX3llYXIYAiABKAkSEgoKb2NjdXBhdGlvbhgDIAEoCRISCgphdXRvX2FwcGx5GAQgASgIIrQBCiVCYXRjaENsYXNzaWZ5VGF4RGVkdWN0aWJpbGl0eVJlc3BvbnNlEhcKD3RvdGFsX3Byb2Nlc3NlZBgBIAEoBRIUCgxhdXRvX2FwcGxpZWQYAiABKAUSFAoMbmVlZHNfcmV2aWV3GAMgASgFEg8KB3NraXBwZWQYBCABKAUSNQoHcmVzdWx0cxgFIAMoCzIkLnBmaW5hbmNlLnYxLlRheENsYXNzaWZpY2F0aW9uUmVzdWx0Im8KFkV4cG9ydFRheFJldHVyblJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIWCg5maW5hbmNpYWxfeWVhchgCIAEoCRIsCgZmb3JtYXQYAyABKA4yHC5wZmluYW5jZS52MS5UYXhFeHBvcnRGb3JtYXQigQEKF0V4cG9ydFRheFJldHVyblJlc3BvbnNlEgwKBGRhdGEYASABKAwSEAoIZmlsZW5hbWUYAiABKAkSFAoMY29udGVudF90eXBlGAMgASgJEjAKC2NhbGN1bGF0aW9uGAQgASgLMhsucGZpbmFuY2UudjEuVGF4Q2FsY3VsYXRpb24iJQoVQ3JlYXRlQXBpVG9rZW5SZXF1ZXN0EgwKBG5hbWUYASABKAkiUQoWQ3JlYXRlQXBpVG9rZW5SZXNwb25zZRINCgV0b2tlbhgBIAEoCRIoCglhcGlfdG9rZW4YAiABKAsyFS5wZmluYW5jZS52MS5BcGlUb2tlbiIWChRMaXN0QXBpVG9rZW5zUmVxdWVzdCI+ChVMaXN0QXBpVG9rZW5zUmVzcG9uc2USJQoGdG9rZW5zGAEgAygLMhUucGZpbmFuY2UudjEuQXBpVG9rZW4iKQoVUmV2b2tlQXBpVG9rZW5SZXF1ZXN0EhAKCHRva2VuX2lkGAEgASgJIhgKFlJldm9rZUFwaVRva2VuUmVzcG9uc2UiQgoaQmF0Y2hEZWxldGVFeHBlbnNlc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRITCgtleHBlbnNlX2lkcxgCIAMoCSJQChtCYXRjaERlbGV0ZUV4cGVuc2VzUmVzcG9uc2USFQoNZGVsZXRlZF9jb3VudBgBIAEoBRIaChJmYWlsZWRfZXhwZW5zZV9pZHMYAiADKAkiQAoVRXhwb3J0UmVjZWlwdHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSFgoOZmluYW5jaWFsX3llYXIYAiABKAkiZQoWRXhwb3J0UmVjZWlwdHNSZXNwb25zZRIMCgRkYXRhGAEgASgMEhAKCGZpbGVuYW1lGAIgASgJEhQKDGNvbnRlbnRfdHlwZRgDIAEoCRIVCg1yZWNlaXB0X2NvdW50GAQgASgFIl0KHkZpbmRQb3RlbnRpYWxEZWR1Y3Rpb25zUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhYKDmZpbmFuY2lhbF95ZWFyGAIgASgJEhIKCm9jY3VwYXRpb24YAyABKAkitgEKH0ZpbmRQb3RlbnRpYWxEZWR1Y3Rpb25zUmVzcG9uc2USNAoLc3VnZ2VzdGlvbnMYASADKAsyHy5wZmluYW5jZS52MS5Qb3RlbnRpYWxEZWR1Y3Rpb24SJQoddG90YWxfcG90ZW50aWFsX3NhdmluZ3NfY2VudHMYAiABKAMSHwoXdG90YWxfcG90ZW50aWFsX3NhdmluZ3MYAyABKAESFQoNc2Nhbm5lZF9jb3VudBgEIAEoBSJJChZDb21wYXJlVGF4WWVhcnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSDgoGeWVhcl9hGAIgASgJEg4KBnllYXJfYhgDIAEoCSJNChdDb21wYXJlVGF4WWVhcnNSZXNwb25zZRIyCgpjb21wYXJpc29uGAEgASgLMh4ucGZpbmFuY2UudjEuVGF4WWVhckNvbXBhcmlzb24iLQoYUmVnaXN0ZXJQdXNoVG9rZW5SZXF1ZXN0EhEKCWZjbV90b2tlbhgBIAEoCSIbChlSZWdpc3RlclB1c2hUb2tlblJlc3BvbnNlIhwKGlVucmVnaXN0ZXJQdXNoVG9rZW5SZXF1ZXN0Ih0KG1VucmVnaXN0ZXJQdXNoVG9rZW5SZXNwb25zZSJiChFSdW5UYXhFdmFsUmVxdWVzdBIUCgxkYXRhc2V0X3BhdGgYASABKAkSDgoGbWV0aG9kGAIgASgJEhIKCm9jY3VwYXRpb24YAyABKAkSEwoLY29uY3VycmVuY3kYBCABKAUiJAoSUnVuVGF4RXZhbFJlc3BvbnNlEg4KBmpvYl9pZBgBIAEoCSImChRHZXRUYXhFdmFsSm9iUmVxdWVzdBIOCgZqb2JfaWQYASABKAkiPQoVR2V0VGF4RXZhbEpvYlJlc3BvbnNlEiQKA2pvYhgBIAEoCzIXLnBmaW5hbmNlLnYxLlRheEV2YWxKb2IilQIKClRheEV2YWxKb2ISCgoCaWQYASABKAkSDgoGc3RhdHVzGAIgASgJEhMKC3RvdGFsX2ZpbGVzGAMgASgFEhcKD3Byb2Nlc3NlZF9maWxlcxgEIAEoBRIYChBwcm9ncmVzc19wZXJjZW50GAUgASgFEhUKDWVycm9yX21lc3NhZ2UYBiABKAkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMY29tcGxldGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIqCgZyZXN1bHQYCSABKAsyGi5wZmluYW5jZS52MS5UYXhFdmFsUmVzdWx0Is4ECg1UYXhFdmFsUmVzdWx0EhMKC2R1cmF0aW9uX21zGAEgASgDEhQKDGRhdGFzZXRfcGF0aBgCIAEoCRIOCgZtZXRob2QYAyABKAkSEgoKb2NjdXBhdGlvbhgEIAEoCRITCgtjb25jdXJyZW5jeRgFIAEoBRITCgt0b3RhbF9maWxlcxgGIAEoBRIYChBzdWNjZXNzZnVsX2ZpbGVzGAcgASgFEhQKDGZhaWxlZF9maWxlcxgIIAEoBRIaChJ0b3RhbF90cmFuc2FjdGlvbnMYCSABKAUSGAoQdG90YWxfZGVkdWN0aWJsZRgKIAEoBRIcChR0b3RhbF9ub25fZGVkdWN0aWJsZRgLIAEoBRIWCg5hdmdfY29uZmlkZW5jZRgMIAEoARIZChFhdmdfcHJvY2Vzc2luZ19tcxgNIAEoARIXCg90b3RhbF9hcGlfY2FsbHMYDiABKAUSGgoSZXN0aW1hdGVkX2Nvc3RfdXNkGA8gASgBEjkKCmRlZHVjdGlvbnMYECADKAsyJS5wZmluYW5jZS52MS5UYXhFdmFsRGVkdWN0aW9uQ2F0ZWdvcnkSNAoMZmlsZV9yZXN1bHRzGBEgAygLMh4ucGZpbmFuY2UudjEuVGF4RXZhbEZpbGVSZXN1bHQSFgoOdG90YWxfZXhwZW5zZXMYEiABKAESHwoXdG90YWxfZGVkdWN0aW9uc19hbW91bnQYEyABKAESLgoIYWNjdXJhY3kYFCABKAsyHC5wZmluYW5jZS52MS5UYXhFdmFsQWNjdXJhY3kipAEKGFRheEV2YWxEZWR1Y3Rpb25DYXRlZ29yeRIMCgRjb2RlGAEgASgJEgwKBG5hbWUYAiABKAkSEgoKaXRlbV9jb3VudBgDIAEoBRIUCgx0b3RhbF9hbW91bnQYBCABKAESGQoRZGVkdWN0aWJsZV9hbW91bnQYBSABKAESJwoFaXRlbXMYBiADKAsyGC5wZmluYW5jZS52MS5UYXhFdmFsSXRlbSKKAgoRVGF4RXZhbEZpbGVSZXN1bHQSEAoIZmlsZW5hbWUYASABKAkSFQoNcmVsYXRpdmVfcGF0aBgCIAEoCRIQCghjYXRlZ29yeRgDIAEoCRIXCg9maWxlX3NpemVfYnl0ZXMYBCABKAMSFQoNcHJvY2Vzc2luZ19tcxgFIAEoAxINCgVlcnJvchgGIAEoCRIZChF0cmFuc2FjdGlvbl9jb3VudBgHIAEoBRIaChJvdmVyYWxsX2NvbmZpZGVuY2UYCCABKAESFQoNZG9jdW1lbnRfdHlwZRgJIAEoCRItCgt0YXhfcmVzdWx0cxgKIAMoCzIYLnBmaW5hbmNlLnYxLlRheEV2YWxJdGVtIooCCgtUYXhFdmFsSXRlbRITCgtkZXNjcmlwdGlvbhgBIAEoCRIOCgZhbW91bnQYAiABKAESDAoEZGF0ZRgDIAEoCRIYChBleHBlbnNlX2NhdGVnb3J5GAQgASgJEhUKDWlzX2RlZHVjdGlibGUYBSABKAgSFAoMdGF4X2NhdGVnb3J5GAYgASgJEhoKEmRlZHVjdGlibGVfcGVyY2VudBgHIAEoARIZChFkZWR1Y3RpYmxlX2Ftb3VudBgIIAEoARISCgpjb25maWRlbmNlGAkgASgBEhEKCXJlYXNvbmluZxgKIAEoCRIOCgZzb3VyY2UYCyABKAkSEwoLc291cmNlX2ZpbGUYDCABKAki4gIKD1RheEV2YWxBY2N1cmFjeRIfChdmaWxlc193aXRoX2dyb3VuZF90cnV0aBgBIAEoBRIXCg9maWxlc19ldmFsdWF0ZWQYAiABKAUSOgoKZXh0cmFjdGlvbhgDIAEoCzImLnBmaW5hbmNlLnYxLlRheEV2YWxFeHRyYWN0aW9uQWNjdXJhY3kSOAoNZGVkdWN0aWJpbGl0eRgEIAEoCzIhLnBmaW5hbmNlLnYxLlRheEV2YWxDbGFzc0FjY3VyYWN5EjcKDHRheF9jYXRlZ29yeRgFIAEoCzIhLnBmaW5hbmNlLnYxLlRheEV2YWxDbGFzc0FjY3VyYWN5EjIKBmFtb3VudBgGIAEoCzIiLnBmaW5hbmNlLnYxLlRheEV2YWxBbW91bnRBY2N1cmFjeRIyCghwZXJfZmlsZRgHIAMoCzIgLnBmaW5hbmNlLnYxLlRheEV2YWxGaWxlQWNjdXJhY3kikgEKGVRheEV2YWxFeHRyYWN0aW9uQWNjdXJhY3kSFgoOZXhwZWN0ZWRfdG90YWwYASABKAUSFwoPZXh0cmFjdGVkX3RvdGFsGAIgASgFEhUKDW1hdGNoZWRfY291bnQYAyABKAUSEQoJcHJlY2lzaW9uGAQgASgBEg4KBnJlY2FsbBgFIAEoARIKCgJmMRgGIAEoASJbChRUYXhFdmFsQ2xhc3NBY2N1cmFjeRINCgV0b3RhbBgBIAEoBRIPCgdjb3JyZWN0GAIgASgFEhEKCWluY29ycmVjdBgDIAEoBRIQCghhY2N1cmFjeRgEIAEoASKEAQoVVGF4RXZhbEFtb3VudEFjY3VyYWN5Eg0KBXRvdGFsGAEgASgFEhUKDWV4YWN0X21hdGNoZXMYAiABKAUSFQoNY2xvc2VfbWF0Y2hlcxgDIAEoBRIWCg5tZWFuX2Fic19lcnJvchgEIAEoARIWCg5tZWFuX3BjdF9lcnJvchgFIAEoASKBAgoTVGF4RXZhbEZpbGVBY2N1cmFjeRIQCghmaWxlbmFtZRgBIAEoCRIVCg1yZWxhdGl2ZV9wYXRoGAIgASgJEh0KFWV4cGVjdGVkX3RyYW5zYWN0aW9ucxgDIAEoBRIeChZleHRyYWN0ZWRfdHJhbnNhY3Rpb25zGAQgASgFEg8KB21hdGNoZWQYBSABKAUSOAoNZGVkdWN0aWJpbGl0eRgGIAEoCzIhLnBmaW5hbmNlLnYxLlRheEV2YWxDbGFzc0FjY3VyYWN5EjcKDHRheF9jYXRlZ29yeRgHIAEoCzIhLnBmaW5hbmNlLnYxLlRheEV2YWxDbGFzc0FjY3VyYWN5KmsKD1RheEV4cG9ydEZvcm1hdBIhCh1UQVhfRVhQT1JUX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhkKFVRBWF9FWFBPUlRfRk9STUFUX0NTVhABEhoKFlRBWF9FWFBPUlRfRk9STUFUX0pTT04QAjKEWAoORmluYW5jZVNlcnZpY2USRAoHR2V0VXNlchIbLnBmaW5hbmNlLnYxLkdldFVzZXJSZXF1ZXN0GhwucGZpbmFuY2UudjEuR2V0VXNlclJlc3BvbnNlEk0KClVwZGF0ZVVzZXISHi5wZmluYW5jZS52MS5VcGRhdGVVc2VyUmVxdWVzdBofLnBmaW5hbmNlLnYxLlVwZGF0ZVVzZXJSZXNwb25zZRJECgpEZWxldGVVc2VyEh4ucGZpbmFuY2UudjEuRGVsZXRlVXNlclJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSSgoNQ2xlYXJVc2VyRGF0YRIhLnBmaW5hbmNlLnYxLkNsZWFyVXNlckRhdGFSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElkKDkV4cG9ydFVzZXJEYXRhEiIucGZpbmFuY2UudjEuRXhwb3J0VXNlckRhdGFSZXF1ZXN0GiMucGZpbmFuY2UudjEuRXhwb3J0VXNlckRhdGFSZXNwb25zZRJWCg1DcmVhdGVFeHBlbnNlEiEucGZpbmFuY2UudjEuQ3JlYXRlRXhwZW5zZVJlcXVlc3QaIi5wZmluYW5jZS52MS5DcmVhdGVFeHBlbnNlUmVzcG9uc2USTQoKR2V0RXhwZW5zZRIeLnBmaW5hbmNlLnYxLkdldEV4cGVuc2VSZXF1ZXN0Gh8ucGZpbmFuY2UudjEuR2V0RXhwZW5zZVJlc3BvbnNlElYKDVVwZGF0ZUV4cGVuc2USIS5wZmluYW5jZS52MS5VcGRhdGVFeHBlbnNlUmVxdWVzdBoiLnBmaW5hbmNlLnYxLlVwZGF0ZUV4cGVuc2VSZXNwb25zZRJKCg1EZWxldGVFeHBlbnNlEiEucGZpbmFuY2UudjEuRGVsZXRlRXhwZW5zZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSUwoMTGlzdEV4cGVuc2VzEiAucGZpbmFuY2UudjEuTGlzdEV4cGVuc2VzUmVxdWVzdBohLnBmaW5hbmNlLnYxLkxpc3RFeHBlbnNlc1Jlc3BvbnNlEmgKE0JhdGNoQ3JlYXRlRXhwZW5zZXMSJy5wZmluYW5jZS52MS5CYXRjaENyZWF0ZUV4cGVuc2VzUmVxdWVzdBooLnBmaW5hbmNlLnYxLkJhdGNoQ3JlYXRlRXhwZW5zZXNSZXNwb25zZRJoChNCYXRjaERlbGV0ZUV4cGVuc2VzEicucGZpbmFuY2UudjEuQmF0Y2hEZWxldGVFeHBlbnNlc1JlcXVlc3QaKC5wZmluYW5jZS52MS5CYXRjaERlbGV0ZUV4cGVuc2VzUmVzcG9uc2USUwoMQ3JlYXRlSW5jb21lEiAucGZpbmFuY2UudjEuQ3JlYXRlSW5jb21lUmVxdWVzdBohLnBmaW5hbmNlLnYxLkNyZWF0ZUluY29tZVJlc3BvbnNlEkoKCUdldEluY29tZRIdLnBmaW5hbmNlLnYxLkdldEluY29tZVJlcXVlc3QaHi5wZmluYW5jZS52MS5HZXRJbmNvbWVSZXNwb25zZRJTCgxVcGRhdGVJbmNvbWUSIC5wZmluYW5jZS52MS5VcGRhdGVJbmNvbWVSZXF1ZXN0GiEucGZpbmFuY2UudjEuVXBkYXRlSW5jb21lUmVzcG9uc2USSAoMRGVsZXRlSW5jb21lEiAucGZpbmFuY2UudjEuRGVsZXRlSW5jb21lUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJQCgtMaXN0SW5jb21lcxIfLnBmaW5hbmNlLnYxLkxpc3RJbmNvbWVzUmVxdWVzdBogLnBmaW5hbmNlLnYxLkxpc3RJbmNvbWVzUmVzcG9uc2USUwoMR2V0VGF4Q29uZmlnEiAucGZpbmFuY2UudjEuR2V0VGF4Q29uZmlnUmVxdWVzdBohLnBmaW5hbmNlLnYxLkdldFRheENvbmZpZ1Jlc3BvbnNlElwKD1VwZGF0ZVRheENvbmZpZxIjLnBmaW5hbmNlLnYxLlVwZGF0ZVRheENvbmZpZ1JlcXVlc3QaJC5wZmluYW5jZS52MS5VcGRhdGVUYXhDb25maWdSZXNwb25zZRJQCgtDcmVhdGVHcm91cBIfLnBmaW5hbmNlLnYxLkNyZWF0ZUdyb3VwUmVxdWVzdBogLnBmaW5hbmNlLnYxLkNyZWF0ZUdyb3VwUmVzcG9uc2USRwoIR2V0R3JvdXASHC5wZmluYW5jZS52MS5HZXRHcm91cFJlcXVlc3QaHS5wZmluYW5jZS52MS5HZXRHcm91cFJlc3BvbnNlElAKC1VwZGF0ZUdyb3VwEh8ucGZpbmFuY2UudjEuVXBkYXRlR3JvdXBSZXF1ZXN0GiAucGZpbmFuY2UudjEuVXBkYXRlR3JvdXBSZXNwb25zZRJGCgtEZWxldGVHcm91cBIfLnBmaW5hbmNlLnYxLkRlbGV0ZUdyb3VwUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJNCgpMaXN0R3JvdXBzEh4ucGZpbmFuY2UudjEuTGlzdEdyb3Vwc1JlcXVlc3QaHy5wZmluYW5jZS52MS5MaXN0R3JvdXBzUmVzcG9uc2USVgoNSW52aXRlVG9Hcm91cBIhLnBmaW5hbmNlLnYxLkludml0ZVRvR3JvdXBSZXF1ZXN0GiIucGZpbmFuY2UudjEuSW52aXRlVG9Hcm91cFJlc3BvbnNlEl8KEEFjY2VwdEludml0YXRpb24SJC5wZmluYW5jZS52MS5BY2NlcHRJbnZpdGF0aW9uUmVxdWVzdBolLnBmaW5hbmNlLnYxLkFjY2VwdEludml0YXRpb25SZXNwb25zZRJSChFEZWNsaW5lSW52aXRhdGlvbhIlLnBmaW5hbmNlLnYxLkRlY2xpbmVJbnZpdGF0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJOCg9SZW1vdmVGcm9tR3JvdXASIy5wZmluYW5jZS52MS5SZW1vdmVGcm9tR3JvdXBSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5El8KEFVwZGF0ZU1lbWJlclJvbGUSJC5wZmluYW5jZS52MS5VcGRhdGVNZW1iZXJSb2xlUmVxdWVzdBolLnBmaW5hbmNlLnYxLlVwZGF0ZU1lbWJlclJvbGVSZXNwb25zZRJcCg9MaXN0SW52aXRhdGlvbnMSIy5wZmluYW5jZS52MS5MaXN0SW52aXRhdGlvbnNSZXF1ZXN0GiQucGZpbmFuY2UudjEuTGlzdEludml0YXRpb25zUmVzcG9uc2USUwoMQ3JlYXRlQnVkZ2V0EiAucGZpbmFuY2UudjEuQ3JlYXRlQnVkZ2V0UmVxdWVzdBohLnBmaW5hbmNlLnYxLkNyZWF0ZUJ1ZGdldFJlc3BvbnNlEkoKCUdldEJ1ZGdldBIdLnBmaW5hbmNlLnYxLkdldEJ1ZGdldFJlcXVlc3QaHi5wZmluYW5jZS52MS5HZXRCdWRnZXRSZXNwb25zZRJTCgxVcGRhdGVCdWRnZXQSIC5wZmluYW5jZS52MS5VcGRhdGVCdWRnZXRSZXF1ZXN0GiEucGZpbmFuY2UudjEuVXBkYXRlQnVkZ2V0UmVzcG9uc2USSAoMRGVsZXRlQnVkZ2V0EiAucGZpbmFuY2UudjEuRGVsZXRlQnVkZ2V0UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJQCgtMaXN0QnVkZ2V0cxIfLnBmaW5hbmNlLnYxLkxpc3RCdWRnZXRzUmVxdWVzdBogLnBmaW5hbmNlLnYxLkxpc3RCdWRnZXRzUmVzcG9uc2USYgoRR2V0QnVkZ2V0UHJvZ3Jlc3MSJS5wZmluYW5jZS52MS5HZXRCdWRnZXRQcm9ncmVzc1JlcXVlc3QaJi5wZmluYW5jZS52MS5HZXRCdWRnZXRQcm9ncmVzc1Jlc3BvbnNlEmIKEUdldE1lbWJlckJhbGFuY2VzEiUucGZpbmFuY2UudjEuR2V0TWVtYmVyQmFsYW5jZXNSZXF1ZXN0GiYucGZpbmFuY2UudjEuR2V0TWVtYmVyQmFsYW5jZXNSZXNwb25zZRJWCg1TZXR0bGVFeHBlbnNlEiEucGZpbmFuY2UudjEuU2V0dGxlRXhwZW5zZVJlcXVlc3QaIi5wZmluYW5jZS52MS5TZXR0bGVFeHBlbnNlUmVzcG9uc2USXAoPR2V0R3JvdXBTdW1tYXJ5EiMucGZpbmFuY2UudjEuR2V0R3JvdXBTdW1tYXJ5UmVxdWVzdBokLnBmaW5hbmNlLnYxLkdldEdyb3VwU3VtbWFyeVJlc3BvbnNlEl8KEENyZWF0ZUludml0ZUxpbmsSJC5wZmluYW5jZS52MS5DcmVhdGVJbnZpdGVMaW5rUmVxdWVzdBolLnBmaW5hbmNlLnYxLkNyZWF0ZUludml0ZUxpbmtSZXNwb25zZRJoChNHZXRJbnZpdGVMaW5rQnlDb2RlEicucGZpbmFuY2UudjEuR2V0SW52aXRlTGlua0J5Q29kZVJlcXVlc3QaKC5wZmluYW5jZS52MS5HZXRJbnZpdGVMaW5rQnlDb2RlUmVzcG9uc2USXAoPSm9pbkdyb3VwQnlMaW5rEiMucGZpbmFuY2UudjEuSm9pbkdyb3VwQnlMaW5rUmVxdWVzdBokLnBmaW5hbmNlLnYxLkpvaW5Hcm91cEJ5TGlua1Jlc3BvbnNlElwKD0xpc3RJbnZpdGVMaW5rcxIjLnBmaW5hbmNlLnYxLkxpc3RJbnZpdGVMaW5rc1JlcXVlc3QaJC5wZmluYW5jZS52MS5MaXN0SW52aXRlTGlua3NSZXNwb25zZRJYChREZWFjdGl2YXRlSW52aXRlTGluaxIoLnBmaW5hbmNlLnYxLkRlYWN0aXZhdGVJbnZpdGVMaW5rUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJ3ChhDb250cmlidXRlRXhwZW5zZVRvR3JvdXASLC5wZmluYW5jZS52MS5Db250cmlidXRlRXhwZW5zZVRvR3JvdXBSZXF1ZXN0Gi0ucGZpbmFuY2UudjEuQ29udHJpYnV0ZUV4cGVuc2VUb0dyb3VwUmVzcG9uc2USdAoXQ29udHJpYnV0ZUluY29tZVRvR3JvdXASKy5wZmluYW5jZS52MS5Db250cmlidXRlSW5jb21lVG9Hcm91cFJlcXVlc3QaLC5wZmluYW5jZS52MS5Db250cmlidXRlSW5jb21lVG9Hcm91cFJlc3BvbnNlEmIKEUxpc3RDb250cmlidXRpb25zEiUucGZpbmFuY2UudjEuTGlzdENvbnRyaWJ1dGlvbnNSZXF1ZXN0GiYucGZpbmFuY2UudjEuTGlzdENvbnRyaWJ1dGlvbnNSZXNwb25zZRJ0ChdMaXN0SW5jb21lQ29udHJpYnV0aW9ucxIrLnBmaW5hbmNlLnYxLkxpc3RJbmNvbWVDb250cmlidXRpb25zUmVxdWVzdBosLnBmaW5hbmNlLnYxLkxpc3RJbmNvbWVDb250cmlidXRpb25zUmVzcG9uc2USTQoKQ3JlYXRlR29hbBIeLnBmaW5hbmNlLnYxLkNyZWF0ZUdvYWxSZXF1ZXN0Gh8ucGZpbmFuY2UudjEuQ3JlYXRlR29hbFJlc3BvbnNlEkQKB0dldEdvYWwSGy5wZmluYW5jZS52MS5HZXRHb2FsUmVxdWVzdBocLnBmaW5hbmNlLnYxLkdldEdvYWxSZXNwb25zZRJNCgpVcGRhdGVHb2FsEh4ucGZpbmFuY2UudjEuVXBkYXRlR29hbFJlcXVlc3QaHy5wZmluYW5jZS52MS5VcGRhdGVHb2FsUmVzcG9uc2USRAoKRGVsZXRlR29hbBIeLnBmaW5hbmNlLnYxLkRlbGV0ZUdvYWxSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EkoKCUxpc3RHb2FscxIdLnBmaW5hbmNlLnYxLkxpc3RHb2Fsc1JlcXVlc3QaHi5wZmluYW5jZS52MS5MaXN0R29hbHNSZXNwb25zZRJcCg9HZXRHb2FsUHJvZ3Jlc3MSIy5wZmluYW5jZS52MS5HZXRHb2FsUHJvZ3Jlc3NSZXF1ZXN0GiQucGZpbmFuY2UudjEuR2V0R29hbFByb2dyZXNzUmVzcG9uc2USXwoQQ29udHJpYnV0ZVRvR29hbBIkLnBmaW5hbmNlLnYxLkNvbnRyaWJ1dGVUb0dvYWxSZXF1ZXN0GiUucGZpbmFuY2UudjEuQ29udHJpYnV0ZVRvR29hbFJlc3BvbnNlEm4KFUxpc3RHb2FsQ29udHJpYnV0aW9ucxIpLnBmaW5hbmNlLnYxLkxpc3RHb2FsQ29udHJpYnV0aW9uc1JlcXVlc3QaKi5wZmluYW5jZS52MS5MaXN0R29hbENvbnRyaWJ1dGlvbnNSZXNwb25zZRJoChNHZXRTcGVuZGluZ0luc2lnaHRzEicucGZpbmFuY2UudjEuR2V0U3BlbmRpbmdJbnNpZ2h0c1JlcXVlc3QaKC5wZmluYW5jZS52MS5HZXRTcGVuZGluZ0luc2lnaHRzUmVzcG9uc2USXAoPRXh0cmFjdERvY3VtZW50EiMucGZpbmFuY2UudjEuRXh0cmFjdERvY3VtZW50UmVxdWVzdBokLnBmaW5hbmNlLnYxLkV4dHJhY3REb2N1bWVudFJlc3BvbnNlEl8KEEdldEV4dHJhY3Rpb25Kb2ISJC5wZmluYW5jZS52MS5HZXRFeHRyYWN0aW9uSm9iUmVxdWVzdBolLnBmaW5hbmNlLnYxLkdldEV4dHJhY3Rpb25Kb2JSZXNwb25zZRKAAQobSW1wb3J0RXh0cmFjdGVkVHJhbnNhY3Rpb25zEi8ucGZpbmFuY2UudjEuSW1wb3J0RXh0cmFjdGVkVHJhbnNhY3Rpb25zUmVxdWVzdBowLnBmaW5hbmNlLnYxLkltcG9ydEV4dHJhY3RlZFRyYW5zYWN0aW9uc1Jlc3BvbnNlEl8KEFBhcnNlRXhwZW5zZVRleHQSJC5wZmluYW5jZS52MS5QYXJzZUV4cGVuc2VUZXh0UmVxdWVzdBolLnBmaW5hbmNlLnYxLlBhcnNlRXhwZW5zZVRleHRSZXNwb25zZRJlChJQYXJzZUJhbmtTdGF0ZW1lbnQSJi5wZmluYW5jZS52MS5QYXJzZUJhbmtTdGF0ZW1lbnRSZXF1ZXN0GicucGZpbmFuY2UudjEuUGFyc2VCYW5rU3RhdGVtZW50UmVzcG9uc2USfQoaQ3JlYXRlUmVjdXJyaW5nVHJhbnNhY3Rpb24SLi5wZmluYW5jZS52MS5DcmVhdGVSZWN1cnJpbmdUcmFuc2FjdGlvblJlcXVlc3QaLy5wZmluYW5jZS52MS5DcmVhdGVSZWN1cnJpbmdUcmFuc2FjdGlvblJlc3BvbnNlEnQKF0dldFJlY3VycmluZ1RyYW5zYWN0aW9uEisucGZpbmFuY2UudjEuR2V0UmVjdXJyaW5nVHJhbnNhY3Rpb25SZXF1ZXN0GiwucGZpbmFuY2UudjEuR2V0UmVjdXJyaW5nVHJhbnNhY3Rpb25SZXNwb25zZRJ9ChpVcGRhdGVSZWN1cnJpbmdUcmFuc2FjdGlvbhIuLnBmaW5hbmNlLnYxLlVwZGF0ZVJlY3VycmluZ1RyYW5zYWN0aW9uUmVxdWVzdBovLnBmaW5hbmNlLnYxLlVwZGF0ZVJlY3VycmluZ1RyYW5zYWN0aW9uUmVzcG9uc2USZAoaRGVsZXRlUmVjdXJyaW5nVHJhbnNhY3Rpb24SLi5wZmluYW5jZS52MS5EZWxldGVSZWN1cnJpbmdUcmFuc2FjdGlvblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSegoZTGlzdFJlY3VycmluZ1RyYW5zYWN0aW9ucxItLnBmaW5hbmNlLnYxLkxpc3RSZWN1cnJpbmdUcmFuc2FjdGlvbnNSZXF1ZXN0Gi4ucGZpbmFuY2UudjEuTGlzdFJlY3VycmluZ1RyYW5zYWN0aW9uc1Jlc3BvbnNlEnoKGVBhdXNlUmVjdXJyaW5nVHJhbnNhY3Rpb24SLS5wZmluYW5jZS52MS5QYXVzZVJlY3VycmluZ1RyYW5zYWN0aW9uUmVxdWVzdBouLnBmaW5hbmNlLnYxLlBhdXNlUmVjdXJyaW5nVHJhbnNhY3Rpb25SZXNwb25zZRJ9ChpSZXN1bWVSZWN1cnJpbmdUcmFuc2FjdGlvbhIuLnBmaW5hbmNlLnYxLlJlc3VtZVJlY3VycmluZ1RyYW5zYWN0aW9uUmVxdWVzdBovLnBmaW5hbmNlLnYxLlJlc3VtZVJlY3VycmluZ1RyYW5zYWN0aW9uUmVzcG9uc2USXwoQR2V0VXBjb21pbmdCaWxscxIkLnBmaW5hbmNlLnYxLkdldFVwY29taW5nQmlsbHNSZXF1ZXN0GiUucGZpbmFuY2UudjEuR2V0VXBjb21pbmdCaWxsc1Jlc3BvbnNlEoMBChxQcm9jZXNzUmVjdXJyaW5nVHJhbnNhY3Rpb25zEjAucGZpbmFuY2UudjEuUHJvY2Vzc1JlY3VycmluZ1RyYW5zYWN0aW9uc1JlcXVlc3QaMS5wZmluYW5jZS52MS5Qcm9jZXNzUmVjdXJyaW5nVHJhbnNhY3Rpb25zUmVzcG9uc2USZQoSU2VhcmNoVHJhbnNhY3Rpb25zEiYucGZpbmFuY2UudjEuU2VhcmNoVHJhbnNhY3Rpb25zUmVxdWVzdBonLnBmaW5hbmNlLnYxLlNlYXJjaFRyYW5zYWN0aW9uc1Jlc3BvbnNlEmgKE0RldGVjdFN1YnNjcmlwdGlvbnMSJy5wZmluYW5jZS52MS5EZXRlY3RTdWJzY3JpcHRpb25zUmVxdWVzdBooLnBmaW5hbmNlLnYxLkRldGVjdFN1YnNjcmlwdGlvbnNSZXNwb25zZRJlChJDb252ZXJ0VG9SZWN1cnJpbmcSJi5wZmluYW5jZS52MS5Db252ZXJ0VG9SZWN1cnJpbmdSZXF1ZXN0GicucGZpbmFuY2UudjEuQ29udmVydFRvUmVjdXJyaW5nUmVzcG9uc2USYgoRTGlzdE5vdGlmaWNhdGlvbnMSJS5wZmluYW5jZS52MS5MaXN0Tm90aWZpY2F0aW9uc1JlcXVlc3QaJi5wZmluYW5jZS52MS5MaXN0Tm90aWZpY2F0aW9uc1Jlc3BvbnNlElgKFE1hcmtOb3RpZmljYXRpb25SZWFkEigucGZpbmFuY2UudjEuTWFya05vdGlmaWNhdGlvblJlYWRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EmAKGE1hcmtBbGxOb3RpZmljYXRpb25zUmVhZBIsLnBmaW5hbmNlLnYxLk1hcmtBbGxOb3RpZmljYXRpb25zUmVhZFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSfQoaR2V0VW5yZWFkTm90aWZpY2F0aW9uQ291bnQSLi5wZmluYW5jZS52MS5HZXRVbnJlYWROb3RpZmljYXRpb25Db3VudFJlcXVlc3QaLy5wZmluYW5jZS52MS5HZXRVbnJlYWROb3RpZmljYXRpb25Db3VudFJlc3BvbnNlEn0KGkdldE5vdGlmaWNhdGlvblByZWZlcmVuY2VzEi4ucGZpbmFuY2UudjEuR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXF1ZXN0Gi8ucGZpbmFuY2UudjEuR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXNwb25zZRKGAQodVXBkYXRlTm90aWZpY2F0aW9uUHJlZmVyZW5jZXMSMS5wZmluYW5jZS52MS5VcGRhdGVOb3RpZmljYXRpb25QcmVmZXJlbmNlc1JlcXVlc3QaMi5wZmluYW5jZS52MS5VcGRhdGVOb3RpZmljYXRpb25QcmVmZXJlbmNlc1Jlc3BvbnNlEmsKFEdlbmVyYXRlV2Vla2x5RGlnZXN0EigucGZpbmFuY2UudjEuR2VuZXJhdGVXZWVrbHlEaWdlc3RSZXF1ZXN0GikucGZpbmFuY2UudjEuR2VuZXJhdGVXZWVrbHlEaWdlc3RSZXNwb25zZRJuChVDcmVhdGVDaGVja291dFNlc3Npb24SKS5wZmluYW5jZS52MS5DcmVhdGVDaGVja291dFNlc3Npb25SZXF1ZXN0GioucGZpbmFuY2UudjEuQ3JlYXRlQ2hlY2tvdXRTZXNzaW9uUmVzcG9uc2USbgoVR2V0U3Vic2NyaXB0aW9uU3RhdHVzEikucGZpbmFuY2UudjEuR2V0U3Vic2NyaXB0aW9uU3RhdHVzUmVxdWVzdBoqLnBmaW5hbmNlLnYxLkdldFN1YnNjcmlwdGlvblN0YXR1c1Jlc3BvbnNlEmUKEkNhbmNlbFN1YnNjcmlwdGlvbhImLnBmaW5hbmNlLnYxLkNhbmNlbFN1YnNjcmlwdGlvblJlcXVlc3QaJy5wZmluYW5jZS52MS5DYW5jZWxTdWJzY3JpcHRpb25SZXNwb25zZRJuChVWZXJpZnlDaGVja291dFNlc3Npb24SKS5wZmluYW5jZS52MS5WZXJpZnlDaGVja291dFNlc3Npb25SZXF1ZXN0GioucGZpbmFuY2UudjEuVmVyaWZ5Q2hlY2tvdXRTZXNzaW9uUmVzcG9uc2USZQoSR2V0RGFpbHlBZ2dyZWdhdGVzEiYucGZpbmFuY2UudjEuR2V0RGFpbHlBZ2dyZWdhdGVzUmVxdWVzdBonLnBmaW5hbmNlLnYxLkdldERhaWx5QWdncmVnYXRlc1Jlc3BvbnNlEmIKEUdldFNwZW5kaW5nVHJlbmRzEiUucGZpbmFuY2UudjEuR2V0U3BlbmRpbmdUcmVuZHNSZXF1ZXN0GiYucGZpbmFuY2UudjEuR2V0U3BlbmRpbmdUcmVuZHNSZXNwb25zZRJuChVHZXRDYXRlZ29yeUNvbXBhcmlzb24SKS5wZmluYW5jZS52MS5HZXRDYXRlZ29yeUNvbXBhcmlzb25SZXF1ZXN0GioucGZpbmFuY2UudjEuR2V0Q2F0ZWdvcnlDb21wYXJpc29uUmVzcG9uc2USXAoPRGV0ZWN0QW5vbWFsaWVzEiMucGZpbmFuY2UudjEuRGV0ZWN0QW5vbWFsaWVzUmVxdWVzdBokLnBmaW5hbmNlLnYxLkRldGVjdEFub21hbGllc1Jlc3BvbnNlEmgKE0dldENhc2hGbG93Rm9yZWNhc3QSJy5wZmluYW5jZS52MS5HZXRDYXNoRmxvd0ZvcmVjYXN0UmVxdWVzdBooLnBmaW5hbmNlLnYxLkdldENhc2hGbG93Rm9yZWNhc3RSZXNwb25zZRJfChBHZXRXYXRlcmZhbGxEYXRhEiQucGZpbmFuY2UudjEuR2V0V2F0ZXJmYWxsRGF0YVJlcXVlc3QaJS5wZmluYW5jZS52MS5HZXRXYXRlcmZhbGxEYXRhUmVzcG9uc2USYgoRU3VibWl0Q29ycmVjdGlvbnMSJS5wZmluYW5jZS52MS5TdWJtaXRDb3JyZWN0aW9uc1JlcXVlc3QaJi5wZmluYW5jZS52MS5TdWJtaXRDb3JyZWN0aW9uc1Jlc3BvbnNlElwKD0NoZWNrRHVwbGljYXRlcxIjLnBmaW5hbmNlLnYxLkNoZWNrRHVwbGljYXRlc1JlcXVlc3QaJC5wZmluYW5jZS52MS5DaGVja0R1cGxpY2F0ZXNSZXNwb25zZRJxChZHZXRNZXJjaGFudFN1Z2dlc3Rpb25zEioucGZpbmFuY2UudjEuR2V0TWVyY2hhbnRTdWdnZXN0aW9uc1JlcXVlc3QaKy5wZmluYW5jZS52MS5HZXRNZXJjaGFudFN1Z2dlc3Rpb25zUmVzcG9uc2USawoUR2V0RXh0cmFjdGlvbk1ldHJpY3MSKC5wZmluYW5jZS52MS5HZXRFeHRyYWN0aW9uTWV0cmljc1JlcXVlc3QaKS5wZmluYW5jZS52MS5HZXRFeHRyYWN0aW9uTWV0cmljc1Jlc3BvbnNlEmsKFEdldENhdGVnb3J5T3ZlcnJpZGVzEigucGZpbmFuY2UudjEuR2V0Q2F0ZWdvcnlPdmVycmlkZXNSZXF1ZXN0GikucGZpbmFuY2UudjEuR2V0Q2F0ZWdvcnlPdmVycmlkZXNSZXNwb25zZRJoChNTZXRDYXRlZ29yeU92ZXJyaWRlEicucGZpbmFuY2UudjEuU2V0Q2F0ZWdvcnlPdmVycmlkZVJlcXVlc3QaKC5wZmluYW5jZS52MS5TZXRDYXRlZ29yeU92ZXJyaWRlUmVzcG9uc2UScQoWRGVsZXRlQ2F0ZWdvcnlPdmVycmlkZRIqLnBmaW5hbmNlLnYxLkRlbGV0ZUNhdGVnb3J5T3ZlcnJpZGVSZXF1ZXN0GisucGZpbmFuY2UudjEuRGVsZXRlQ2F0ZWdvcnlPdmVycmlkZVJlc3BvbnNlElYKDUdldFRheFN1bW1hcnkSIS5wZmluYW5jZS52MS5HZXRUYXhTdW1tYXJ5UmVxdWVzdBoiLnBmaW5hbmNlLnYxLkdldFRheFN1bW1hcnlSZXNwb25zZRJZCg5HZXRUYXhFc3RpbWF0ZRIiLnBmaW5hbmNlLnYxLkdldFRheEVzdGltYXRlUmVxdWVzdBojLnBmaW5hbmNlLnYxLkdldFRheEVzdGltYXRlUmVzcG9uc2USgAEKG0JhdGNoVXBkYXRlRXhwZW5zZVRheFN0YXR1cxIvLnBmaW5hbmNlLnYxLkJhdGNoVXBkYXRlRXhwZW5zZVRheFN0YXR1c1JlcXVlc3QaMC5wZmluYW5jZS52MS5CYXRjaFVwZGF0ZUV4cGVuc2VUYXhTdGF0dXNSZXNwb25zZRJxChZMaXN0RGVkdWN0aWJsZUV4cGVuc2VzEioucGZpbmFuY2UudjEuTGlzdERlZHVjdGlibGVFeHBlbnNlc1JlcXVlc3QaKy5wZmluYW5jZS52MS5MaXN0RGVkdWN0aWJsZUV4cGVuc2VzUmVzcG9uc2USdwoYQ2xhc3NpZnlUYXhEZWR1Y3RpYmlsaXR5EiwucGZpbmFuY2UudjEuQ2xhc3NpZnlUYXhEZWR1Y3RpYmlsaXR5UmVxdWVzdBotLnBmaW5hbmNlLnYxLkNsYXNzaWZ5VGF4RGVkdWN0aWJpbGl0eVJlc3BvbnNlEoYBCh1CYXRjaENsYXNzaWZ5VGF4RGVkdWN0aWJpbGl0eRIxLnBmaW5hbmNlLnYxLkJhdGNoQ2xhc3NpZnlUYXhEZWR1Y3RpYmlsaXR5UmVxdWVzdBoyLnBmaW5hbmNlLnYxLkJhdGNoQ2xhc3NpZnlUYXhEZWR1Y3RpYmlsaXR5UmVzcG9uc2USXAoPRXhwb3J0VGF4UmV0dXJuEiMucGZpbmFuY2UudjEuRXhwb3J0VGF4UmV0dXJuUmVxdWVzdBokLnBmaW5hbmNlLnYxLkV4cG9ydFRheFJldHVyblJlc3BvbnNlEnQKF0ZpbmRQb3RlbnRpYWxEZWR1Y3Rpb25zEisucGZpbmFuY2UudjEuRmluZFBvdGVudGlhbERlZHVjdGlvbnNSZXF1ZXN0GiwucGZpbmFuY2UudjEuRmluZFBvdGVudGlhbERlZHVjdGlvbnNSZXNwb25zZRJcCg9Db21wYXJlVGF4WWVhcnMSIy5wZmluYW5jZS52MS5Db21wYXJlVGF4WWVhcnNSZXF1ZXN0GiQucGZpbmFuY2UudjEuQ29tcGFyZVRheFllYXJzUmVzcG9uc2USTQoKUnVuVGF4RXZhbBIeLnBmaW5hbmNlLnYxLlJ1blRheEV2YWxSZXF1ZXN0Gh8ucGZpbmFuY2UudjEuUnVuVGF4RXZhbFJlc3BvbnNlElYKDUdldFRheEV2YWxKb2ISIS5wZmluYW5jZS52MS5HZXRUYXhFdmFsSm9iUmVxdWVzdBoiLnBmaW5hbmNlLnYxLkdldFRheEV2YWxKb2JSZXNwb25zZRJZCg5FeHBvcnRSZWNlaXB0cxIiLnBmaW5hbmNlLnYxLkV4cG9ydFJlY2VpcHRzUmVxdWVzdBojLnBmaW5hbmNlLnYxLkV4cG9ydFJlY2VpcHRzUmVzcG9uc2USYgoRUmVnaXN0ZXJQdXNoVG9rZW4SJS5wZmluYW5jZS52MS5SZWdpc3RlclB1c2hUb2tlblJlcXVlc3QaJi5wZmluYW5jZS52MS5SZWdpc3RlclB1c2hUb2tlblJlc3BvbnNlEmgKE1VucmVnaXN0ZXJQdXNoVG9rZW4SJy5wZmluYW5jZS52MS5VbnJlZ2lzdGVyUHVzaFRva2VuUmVxdWVzdBooLnBmaW5hbmNlLnYxLlVucmVnaXN0ZXJQdXNoVG9rZW5SZXNwb25zZRJZCg5DcmVhdGVBcGlUb2tlbhIiLnBmaW5hbmNlLnYxLkNyZWF0ZUFwaVRva2VuUmVxdWVzdBojLnBmaW5hbmNlLnYxLkNyZWF0ZUFwaVRva2VuUmVzcG9uc2USVgoNTGlzdEFwaVRva2VucxIhLnBmaW5hbmNlLnYxLkxpc3RBcGlUb2tlbnNSZXF1ZXN0GiIucGZpbmFuY2UudjEuTGlzdEFwaVRva2Vuc1Jlc3BvbnNlElkKDlJldm9rZUFwaVRva2VuEiIucGZpbmFuY2UudjEuUmV2b2tlQXBpVG9rZW5SZXF1ZXN0GiMucGZpbmFuY2UudjEuUmV2b2tlQXBpVG9rZW5SZXNwb25zZUK2AQoPY29tLnBmaW5hbmNlLnYxQhNGaW5hbmNlU2VydmljZVByb3RvUAFaQWdpdGh1Yi5jb20vY2FzdGxlbWlsay9wZmluYW5jZS9iYWNrZW5kL2dlbi9wZmluYW5jZS92MTtwZmluYW5jZXYxogIDUFhYqgILUGZpbmFuY2UuVjHKAgtQZmluYW5jZVxWMeICF1BmaW5hbmNlXFYxXEdQQk1ldGFkYXRh6gIMUGZpbmFuY2U6OlYxYgZwcm90bzM", [file_google_protobuf_empty, file_google_protobuf_timestamp, file_pfinance_v1_types]);
+  fileDesc("CiFwZmluYW5jZS92MS9maW5hbmNlX3NlcnZpY2UucHJvdG8SC3BmaW5hbmNlLnYxIiEKDkdldFVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkiMgoPR2V0VXNlclJlc3BvbnNlEh8KBHVzZXIYASABKAsyES5wZmluYW5jZS52MS5Vc2VyIlwKEVVwZGF0ZVVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSFAoMZGlzcGxheV9uYW1lGAIgASgJEhEKCXBob3RvX3VybBgDIAEoCRINCgVlbWFpbBgEIAEoCSI1ChJVcGRhdGVVc2VyUmVzcG9uc2USHwoEdXNlchgBIAEoCzIRLnBmaW5hbmNlLnYxLlVzZXIiNQoRRGVsZXRlVXNlclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIPCgdjb25maXJtGAIgASgIIi4KG1ByZXBhcmVDbGVhclVzZXJEYXRhUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIuYBChxQcmVwYXJlQ2xlYXJVc2VyRGF0YVJlc3BvbnNlEhoKEmNvbmZpcm1hdGlvbl90b2tlbhgBIAEoCRIuCgpleHBpcmVzX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIVCg1leHBlbnNlX2NvdW50GAMgASgDEhQKDGluY29tZV9jb3VudBgEIAEoAxIUCgxidWRnZXRfY291bnQYBSABKAMSEgoKZ29hbF9jb3VudBgGIAEoAxIjChtyZWN1cnJpbmdfdHJhbnNhY3Rpb25fY291bnQYByABKAMiVAoUQ2xlYXJVc2VyRGF0YVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIPCgdjb25maXJtGAIgASgIEhoKEmNvbmZpcm1hdGlvbl90b2tlbhgDIAEoCSI4ChVFeHBvcnRVc2VyRGF0YVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIOCgZmb3JtYXQYAiABKAkiTgoWRXhwb3J0VXNlckRhdGFSZXNwb25zZRIMCgRkYXRhGAEgASgMEhAKCGZpbGVuYW1lGAIgASgJEhQKDGNvbnRlbnRfdHlwZRgDIAEoCSIsChlHZXRVc2VyRGF0YVN1bW1hcnlSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkipwMKGkdldFVzZXJEYXRhU3VtbWFyeVJlc3BvbnNlEhUKDWV4cGVuc2VfY291bnQYASABKAMSFAoMaW5jb21lX2NvdW50GAIgASgDEhQKDGJ1ZGdldF9jb3VudBgDIAEoAxISCgpnb2FsX2NvdW50GAQgASgDEiMKG3JlY3VycmluZ190cmFuc2FjdGlvbl9jb3VudBgFIAEoAxIaChJub3RpZmljYXRpb25fY291bnQYBiABKAMSFwoPYXBpX3Rva2VuX2NvdW50GAcgASgDEj0KGWVhcmxpZXN0X3RyYW5zYWN0aW9uX2RhdGUYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjsKF2xhdGVzdF90cmFuc2FjdGlvbl9kYXRlGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIYChBhdHRhY2htZW50X2NvdW50GAogASgDEiUKGGF0dGFjaG1lbnRfc3RvcmFnZV9ieXRlcxgLIAEoA0gAiAEBQhsKGV9hdHRhY2htZW50X3N0b3JhZ2VfYnl0ZXMiMQoeTGlzdFJlY29yZHNNaXNzaW5nQ2VudHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkibwofTGlzdFJlY29yZHNNaXNzaW5nQ2VudHNSZXNwb25zZRImCghleHBlbnNlcxgBIAMoCzIULnBmaW5hbmNlLnYxLkV4cGVuc2USJAoHaW5jb21lcxgCIAMoCzITLnBmaW5hbmNlLnYxLkluY29tZSI7ChRFeHBvcnRBbGxEYXRhUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhIKCmJhdGNoX3NpemUYAiABKAUidwoVRXhwb3J0QWxsRGF0YVJlc3BvbnNlEgwKBGRhdGEYASABKAwSEAoIZmlsZW5hbWUYAiABKAkSFAoMY29udGVudF90eXBlGAMgASgJEhIKCmNvbGxlY3Rpb24YBCABKAkSFAoMcmVjb3JkX2NvdW50GAUgASgFIq0FChRDcmVhdGVFeHBlbnNlUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEg4KBmFtb3VudBgEIAEoARIuCghjYXRlZ29yeRgFIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIwCglmcmVxdWVuY3kYBiABKA4yHS5wZmluYW5jZS52MS5FeHBlbnNlRnJlcXVlbmN5EigKBGRhdGUYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhcKD3BhaWRfYnlfdXNlcl9pZBgIIAEoCRIqCgpzcGxpdF90eXBlGAkgASgOMhYucGZpbmFuY2UudjEuU3BsaXRUeXBlEhoKEmFsbG9jYXRlZF91c2VyX2lkcxgKIAMoCRIzCgthbGxvY2F0aW9ucxgLIAMoCzIeLnBmaW5hbmNlLnYxLkV4cGVuc2VBbGxvY2F0aW9uEgwKBHRhZ3MYDCADKAkSFAoMYW1vdW50X2NlbnRzGA0gASgDEhkKEWlzX3RheF9kZWR1Y3RpYmxlGA4gASgIEkEKFnRheF9kZWR1Y3Rpb25fY2F0ZWdvcnkYDyABKA4yIS5wZmluYW5jZS52MS5UYXhEZWR1Y3Rpb25DYXRlZ29yeRIaChJ0YXhfZGVkdWN0aW9uX25vdGUYECABKAkSHgoWdGF4X2RlZHVjdGlibGVfcGVyY2VudBgRIAEoARITCgtyZWNlaXB0X3VybBgSIAEoCRIcChRyZWNlaXB0X3N0b3JhZ2VfcGF0aBgTIAEoCRIMCgRub3RlGBQgASgJEhEKCWdzdF9jZW50cxgVIAEoAxIZChFpc19nc3RfcmVnaXN0ZXJlZBgWIAEoCCI+ChVDcmVhdGVFeHBlbnNlUmVzcG9uc2USJQoHZXhwZW5zZRgBIAEoCzIULnBmaW5hbmNlLnYxLkV4cGVuc2UiRgoRR2V0RXhwZW5zZVJlcXVlc3QSEgoKZXhwZW5zZV9pZBgBIAEoCRIdChVpbmNsdWRlX2NvbnRyaWJ1dGlvbnMYAiABKAgidAoSR2V0RXhwZW5zZVJlc3BvbnNlEiUKB2V4cGVuc2UYASABKAsyFC5wZmluYW5jZS52MS5FeHBlbnNlEjcKDWNvbnRyaWJ1dGlvbnMYAiADKAsyIC5wZmluYW5jZS52MS5FeHBlbnNlQ29udHJpYnV0aW9uIpUFChRVcGRhdGVFeHBlbnNlUmVxdWVzdBISCgpleHBlbnNlX2lkGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEg4KBmFtb3VudBgDIAEoARIuCghjYXRlZ29yeRgEIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIwCglmcmVxdWVuY3kYBSABKA4yHS5wZmluYW5jZS52MS5FeHBlbnNlRnJlcXVlbmN5EhcKD3BhaWRfYnlfdXNlcl9pZBgGIAEoCRIqCgpzcGxpdF90eXBlGAcgASgOMhYucGZpbmFuY2UudjEuU3BsaXRUeXBlEhoKEmFsbG9jYXRlZF91c2VyX2lkcxgIIAMoCRIzCgthbGxvY2F0aW9ucxgJIAMoCzIeLnBmaW5hbmNlLnYxLkV4cGVuc2VBbGxvY2F0aW9uEgwKBHRhZ3MYCiADKAkSFAoMYW1vdW50X2NlbnRzGAsgASgDEhkKEWlzX3RheF9kZWR1Y3RpYmxlGAwgASgIEkEKFnRheF9kZWR1Y3Rpb25fY2F0ZWdvcnkYDSABKA4yIS5wZmluYW5jZS52MS5UYXhEZWR1Y3Rpb25DYXRlZ29yeRIaChJ0YXhfZGVkdWN0aW9uX25vdGUYDiABKAkSHgoWdGF4X2RlZHVjdGlibGVfcGVyY2VudBgPIAEoARITCgtyZWNlaXB0X3VybBgQIAEoCRIcChRyZWNlaXB0X3N0b3JhZ2VfcGF0aBgRIAEoCRIRCgRub3RlGBIgASgJSACIAQESFgoJZ3N0X2NlbnRzGBMgASgDSAGIAQESGQoRaXNfZ3N0X3JlZ2lzdGVyZWQYFCABKAhCBwoFX25vdGVCDAoKX2dzdF9jZW50cyI+ChVVcGRhdGVFeHBlbnNlUmVzcG9uc2USJQoHZXhwZW5zZRgBIAEoCzIULnBmaW5hbmNlLnYxLkV4cGVuc2UiKgoURGVsZXRlRXhwZW5zZVJlcXVlc3QSEgoKZXhwZW5zZV9pZBgBIAEoCSKLAwoTTGlzdEV4cGVuc2VzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEi4KCnN0YXJ0X2RhdGUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF9kYXRlGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCglwYWdlX3NpemUYBSABKAUSEgoKcGFnZV90b2tlbhgGIAEoCRIzCghjYXRlZ29yeRgHIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeUgAiAEBEh4KEWlzX3RheF9kZWR1Y3RpYmxlGAggASgISAGIAQESDAoEdGFncxgJIAMoCRIWCg5tYXRjaF9hbGxfdGFncxgKIAEoCBIbCg5oYXNfYXR0YWNobWVudBgLIAEoCEgCiAEBQgsKCV9jYXRlZ29yeUIUChJfaXNfdGF4X2RlZHVjdGlibGVCEQoPX2hhc19hdHRhY2htZW50IlcKFExpc3RFeHBlbnNlc1Jlc3BvbnNlEiYKCGV4cGVuc2VzGAEgAygLMhQucGZpbmFuY2UudjEuRXhwZW5zZRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkingEKG0dldFRyYW5zYWN0aW9uQ291bnRzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEi4KCnN0YXJ0X2RhdGUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF9kYXRlGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJLChxHZXRUcmFuc2FjdGlvbkNvdW50c1Jlc3BvbnNlEhUKDWV4cGVuc2VfY291bnQYASABKAMSFAoMaW5jb21lX2NvdW50GAIgASgDIukBChVHZXRUb3BFeHBlbnNlc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRIuCgpzdGFydF9kYXRlGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfZGF0ZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFbGltaXQYBSABKAUSMwoIY2F0ZWdvcnkYBiABKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnlIAIgBAUILCglfY2F0ZWdvcnkiVQoWR2V0VG9wRXhwZW5zZXNSZXNwb25zZRImCghleHBlbnNlcxgBIAMoCzIULnBmaW5hbmNlLnYxLkV4cGVuc2USEwoLdG90YWxfY2VudHMYAiABKAMidAoaQmF0Y2hDcmVhdGVFeHBlbnNlc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRIzCghleHBlbnNlcxgDIAMoCzIhLnBmaW5hbmNlLnYxLkNyZWF0ZUV4cGVuc2VSZXF1ZXN0IkUKG0JhdGNoQ3JlYXRlRXhwZW5zZXNSZXNwb25zZRImCghleHBlbnNlcxgBIAMoCzIULnBmaW5hbmNlLnYxLkV4cGVuc2Ui4gEKH1F1aWNrQWRkUmVwZWF0aW5nRXhwZW5zZVJlcXVlc3QSMwoIdGVtcGxhdGUYASABKAsyIS5wZmluYW5jZS52MS5DcmVhdGVFeHBlbnNlUmVxdWVzdBIuCgpzdGFydF9kYXRlGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfZGF0ZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoMZGF5c19vZl93ZWVrGAQgAygOMhYucGZpbmFuY2UudjEuRGF5T2ZXZWVrIjcKIFF1aWNrQWRkUmVwZWF0aW5nRXhwZW5zZVJlc3BvbnNlEhMKC2V4cGVuc2VfaWRzGAEgAygJIs8CChNDcmVhdGVJbmNvbWVSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSDgoGc291cmNlGAMgASgJEg4KBmFtb3VudBgEIAEoARIvCglmcmVxdWVuY3kYBSABKA4yHC5wZmluYW5jZS52MS5JbmNvbWVGcmVxdWVuY3kSKgoKdGF4X3N0YXR1cxgGIAEoDjIWLnBmaW5hbmNlLnYxLlRheFN0YXR1cxIqCgpkZWR1Y3Rpb25zGAcgAygLMhYucGZpbmFuY2UudjEuRGVkdWN0aW9uEigKBGRhdGUYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhQKDGFtb3VudF9jZW50cxgJIAEoAxIRCglnc3RfY2VudHMYCiABKAMSGQoRaXNfZ3N0X3JlZ2lzdGVyZWQYCyABKAgiOwoUQ3JlYXRlSW5jb21lUmVzcG9uc2USIwoGaW5jb21lGAEgASgLMhMucGZpbmFuY2UudjEuSW5jb21lIkQKEEdldEluY29tZVJlcXVlc3QSEQoJaW5jb21lX2lkGAEgASgJEh0KFWluY2x1ZGVfY29udHJpYnV0aW9ucxgCIAEoCCJwChFHZXRJbmNvbWVSZXNwb25zZRIjCgZpbmNvbWUYASABKAsyEy5wZmluYW5jZS52MS5JbmNvbWUSNgoNY29udHJpYnV0aW9ucxgCIAMoCzIfLnBmaW5hbmNlLnYxLkluY29tZUNvbnRyaWJ1dGlvbiKoAgoTVXBkYXRlSW5jb21lUmVxdWVzdBIRCglpbmNvbWVfaWQYASABKAkSDgoGc291cmNlGAIgASgJEg4KBmFtb3VudBgDIAEoARIvCglmcmVxdWVuY3kYBCABKA4yHC5wZmluYW5jZS52MS5JbmNvbWVGcmVxdWVuY3kSKgoKdGF4X3N0YXR1cxgFIAEoDjIWLnBmaW5hbmNlLnYxLlRheFN0YXR1cxIqCgpkZWR1Y3Rpb25zGAYgAygLMhYucGZpbmFuY2UudjEuRGVkdWN0aW9uEhQKDGFtb3VudF9jZW50cxgHIAEoAxIWCglnc3RfY2VudHMYCCABKANIAIgBARIZChFpc19nc3RfcmVnaXN0ZXJlZBgJIAEoCEIMCgpfZ3N0X2NlbnRzIjsKFFVwZGF0ZUluY29tZVJlc3BvbnNlEiMKBmluY29tZRgBIAEoCzITLnBmaW5hbmNlLnYxLkluY29tZSIoChNEZWxldGVJbmNvbWVSZXF1ZXN0EhEKCWluY29tZV9pZBgBIAEoCSKsAgoSTGlzdEluY29tZXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSLgoKc3RhcnRfZGF0ZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX2RhdGUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCXBhZ2Vfc2l6ZRgFIAEoBRISCgpwYWdlX3Rva2VuGAYgASgJEg4KBnNvdXJjZRgHIAEoCRIqCgpzb3J0X2ZpZWxkGAggASgOMhYucGZpbmFuY2UudjEuU29ydEZpZWxkEjIKDnNvcnRfZGlyZWN0aW9uGAkgASgOMhoucGZpbmFuY2UudjEuU29ydERpcmVjdGlvbiJUChNMaXN0SW5jb21lc1Jlc3BvbnNlEiQKB2luY29tZXMYASADKAsyEy5wZmluYW5jZS52MS5JbmNvbWUSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIjgKE0dldFRheENvbmZpZ1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCSJCChRHZXRUYXhDb25maWdSZXNwb25zZRIqCgp0YXhfY29uZmlnGAEgASgLMhYucGZpbmFuY2UudjEuVGF4Q29uZmlnImcKFlVwZGF0ZVRheENvbmZpZ1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRIqCgp0YXhfY29uZmlnGAMgASgLMhYucGZpbmFuY2UudjEuVGF4Q29uZmlnIkUKF1VwZGF0ZVRheENvbmZpZ1Jlc3BvbnNlEioKCnRheF9jb25maWcYASABKAsyFi5wZmluYW5jZS52MS5UYXhDb25maWciSQoSQ3JlYXRlR3JvdXBSZXF1ZXN0EhAKCG93bmVyX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkiPwoTQ3JlYXRlR3JvdXBSZXNwb25zZRIoCgVncm91cBgBIAEoCzIZLnBmaW5hbmNlLnYxLkZpbmFuY2VHcm91cCIjCg9HZXRHcm91cFJlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkiPAoQR2V0R3JvdXBSZXNwb25zZRIoCgVncm91cBgBIAEoCzIZLnBmaW5hbmNlLnYxLkZpbmFuY2VHcm91cCJJChJVcGRhdGVHcm91cFJlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCSI/ChNVcGRhdGVHcm91cFJlc3BvbnNlEigKBWdyb3VwGAEgASgLMhkucGZpbmFuY2UudjEuRmluYW5jZUdyb3VwIiYKEkRlbGV0ZUdyb3VwUmVxdWVzdBIQCghncm91cF9pZBgBIAEoCSJLChFMaXN0R3JvdXBzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhEKCXBhZ2Vfc2l6ZRgCIAEoBRISCgpwYWdlX3Rva2VuGAMgASgJIlgKEkxpc3RHcm91cHNSZXNwb25zZRIpCgZncm91cHMYASADKAsyGS5wZmluYW5jZS52MS5GaW5hbmNlR3JvdXASFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJInkKFEludml0ZVRvR3JvdXBSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgJEhIKCmludml0ZXJfaWQYAiABKAkSFQoNaW52aXRlZV9lbWFpbBgDIAEoCRIkCgRyb2xlGAQgASgOMhYucGZpbmFuY2UudjEuR3JvdXBSb2xlIkkKFUludml0ZVRvR3JvdXBSZXNwb25zZRIwCgppbnZpdGF0aW9uGAEgASgLMhwucGZpbmFuY2UudjEuR3JvdXBJbnZpdGF0aW9uIkEKF0FjY2VwdEludml0YXRpb25SZXF1ZXN0EhUKDWludml0YXRpb25faWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSJEChhBY2NlcHRJbnZpdGF0aW9uUmVzcG9uc2USKAoFZ3JvdXAYASABKAsyGS5wZmluYW5jZS52MS5GaW5hbmNlR3JvdXAiQgoYRGVjbGluZUludml0YXRpb25SZXF1ZXN0EhUKDWludml0YXRpb25faWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSI7ChZSZW1vdmVGcm9tR3JvdXBSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkiZgoXVXBkYXRlTWVtYmVyUm9sZVJlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIoCghuZXdfcm9sZRgDIAEoDjIWLnBmaW5hbmNlLnYxLkdyb3VwUm9sZSJEChhVcGRhdGVNZW1iZXJSb2xlUmVzcG9uc2USKAoGbWVtYmVyGAEgASgLMhgucGZpbmFuY2UudjEuR3JvdXBNZW1iZXIiggEKFkxpc3RJbnZpdGF0aW9uc1JlcXVlc3QSEgoKdXNlcl9lbWFpbBgBIAEoCRItCgZzdGF0dXMYAiABKA4yHS5wZmluYW5jZS52MS5JbnZpdGF0aW9uU3RhdHVzEhEKCXBhZ2Vfc2l6ZRgDIAEoBRISCgpwYWdlX3Rva2VuGAQgASgJImUKF0xpc3RJbnZpdGF0aW9uc1Jlc3BvbnNlEjEKC2ludml0YXRpb25zGAEgAygLMhwucGZpbmFuY2UudjEuR3JvdXBJbnZpdGF0aW9uEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSLvAgoTQ3JlYXRlQnVkZ2V0UmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEgwKBG5hbWUYAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSDgoGYW1vdW50GAUgASgBEikKBnBlcmlvZBgGIAEoDjIZLnBmaW5hbmNlLnYxLkJ1ZGdldFBlcmlvZBIyCgxjYXRlZ29yeV9pZHMYByADKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSLgoKc3RhcnRfZGF0ZRgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX2RhdGUYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhQKDGFtb3VudF9jZW50cxgKIAEoAxIvCg1jYXRlZ29yeV9jYXBzGAsgAygLMhgucGZpbmFuY2UudjEuQ2F0ZWdvcnlDYXAiOwoUQ3JlYXRlQnVkZ2V0UmVzcG9uc2USIwoGYnVkZ2V0GAEgASgLMhMucGZpbmFuY2UudjEuQnVkZ2V0IiUKEEdldEJ1ZGdldFJlcXVlc3QSEQoJYnVkZ2V0X2lkGAEgASgJIjgKEUdldEJ1ZGdldFJlc3BvbnNlEiMKBmJ1ZGdldBgBIAEoCzITLnBmaW5hbmNlLnYxLkJ1ZGdldCLfAgoTVXBkYXRlQnVkZ2V0UmVxdWVzdBIRCglidWRnZXRfaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIOCgZhbW91bnQYBCABKAESKQoGcGVyaW9kGAUgASgOMhkucGZpbmFuY2UudjEuQnVkZ2V0UGVyaW9kEjIKDGNhdGVnb3J5X2lkcxgGIAMoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIRCglpc19hY3RpdmUYByABKAgSLAoIZW5kX2RhdGUYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhQKDGFtb3VudF9jZW50cxgJIAEoAxIvCg1jYXRlZ29yeV9jYXBzGAogAygLMhgucGZpbmFuY2UudjEuQ2F0ZWdvcnlDYXASGwoTY2xlYXJfY2F0ZWdvcnlfY2FwcxgLIAEoCCI7ChRVcGRhdGVCdWRnZXRSZXNwb25zZRIjCgZidWRnZXQYASABKAsyEy5wZmluYW5jZS52MS5CdWRnZXQiKAoTRGVsZXRlQnVkZ2V0UmVxdWVzdBIRCglidWRnZXRfaWQYASABKAkieAoSTGlzdEJ1ZGdldHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSGAoQaW5jbHVkZV9pbmFjdGl2ZRgDIAEoCBIRCglwYWdlX3NpemUYBCABKAUSEgoKcGFnZV90b2tlbhgFIAEoCSJUChNMaXN0QnVkZ2V0c1Jlc3BvbnNlEiQKB2J1ZGdldHMYASADKAsyEy5wZmluYW5jZS52MS5CdWRnZXQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIl0KGEdldEJ1ZGdldFByb2dyZXNzUmVxdWVzdBIRCglidWRnZXRfaWQYASABKAkSLgoKYXNfb2ZfZGF0ZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiSgoZR2V0QnVkZ2V0UHJvZ3Jlc3NSZXNwb25zZRItCghwcm9ncmVzcxgBIAEoCzIbLnBmaW5hbmNlLnYxLkJ1ZGdldFByb2dyZXNzInAKG0dldEFsbEJ1ZGdldFByb2dyZXNzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEi4KCmFzX29mX2RhdGUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIk0KHEdldEFsbEJ1ZGdldFByb2dyZXNzUmVzcG9uc2USLQoIcHJvZ3Jlc3MYASADKAsyGy5wZmluYW5jZS52MS5CdWRnZXRQcm9ncmVzcyKIAgobQ3JlYXRlQnVkZ2V0VGVtcGxhdGVSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRIUCgxhbW91bnRfY2VudHMYBSABKAMSKQoGcGVyaW9kGAYgASgOMhkucGZpbmFuY2UudjEuQnVkZ2V0UGVyaW9kEjIKDGNhdGVnb3J5X2lkcxgHIAMoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIuCgpzdGFydF9kYXRlGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJzChxDcmVhdGVCdWRnZXRUZW1wbGF0ZVJlc3BvbnNlEi4KCHRlbXBsYXRlGAEgASgLMhwucGZpbmFuY2UudjEuUmVjdXJyaW5nQnVkZ2V0EiMKBmJ1ZGdldBgCIAEoCzITLnBmaW5hbmNlLnYxLkJ1ZGdldCI/ChpMaXN0QnVkZ2V0VGVtcGxhdGVzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJIk4KG0xpc3RCdWRnZXRUZW1wbGF0ZXNSZXNwb25zZRIvCgl0ZW1wbGF0ZXMYASADKAsyHC5wZmluYW5jZS52MS5SZWN1cnJpbmdCdWRnZXQiuQIKG1VwZGF0ZUJ1ZGdldFRlbXBsYXRlUmVxdWVzdBITCgt0ZW1wbGF0ZV9pZBgBIAEoCRIRCgRuYW1lGAIgASgJSACIAQESGAoLZGVzY3JpcHRpb24YAyABKAlIAYgBARIZCgxhbW91bnRfY2VudHMYBCABKANIAogBARIuCgZwZXJpb2QYBSABKA4yGS5wZmluYW5jZS52MS5CdWRnZXRQZXJpb2RIA4gBARIyCgxjYXRlZ29yeV9pZHMYBiADKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSFgoJaXNfYWN0aXZlGAcgASgISASIAQFCBwoFX25hbWVCDgoMX2Rlc2NyaXB0aW9uQg8KDV9hbW91bnRfY2VudHNCCQoHX3BlcmlvZEIMCgpfaXNfYWN0aXZlIk4KHFVwZGF0ZUJ1ZGdldFRlbXBsYXRlUmVzcG9uc2USLgoIdGVtcGxhdGUYASABKAsyHC5wZmluYW5jZS52MS5SZWN1cnJpbmdCdWRnZXQiMgobRGVsZXRlQnVkZ2V0VGVtcGxhdGVSZXF1ZXN0EhMKC3RlbXBsYXRlX2lkGAEgASgJIpsBChhHZXRNZW1iZXJCYWxhbmNlc1JlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIuCgpzdGFydF9kYXRlGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfZGF0ZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiiwEKGUdldE1lbWJlckJhbGFuY2VzUmVzcG9uc2USLAoIYmFsYW5jZXMYASADKAsyGi5wZmluYW5jZS52MS5NZW1iZXJCYWxhbmNlEhwKFHRvdGFsX2dyb3VwX2V4cGVuc2VzGAIgASgBEiIKGnRvdGFsX2dyb3VwX2V4cGVuc2VzX2NlbnRzGAMgASgDImEKFFNldHRsZUV4cGVuc2VSZXF1ZXN0EhIKCmV4cGVuc2VfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIOCgZhbW91bnQYAyABKAESFAoMYW1vdW50X2NlbnRzGAQgASgDInoKFVNldHRsZUV4cGVuc2VSZXNwb25zZRIlCgdleHBlbnNlGAEgASgLMhQucGZpbmFuY2UudjEuRXhwZW5zZRI6ChJ1cGRhdGVkX2FsbG9jYXRpb24YAiABKAsyHi5wZmluYW5jZS52MS5FeHBlbnNlQWxsb2NhdGlvbiKIAQoWR2V0R3JvdXBTdW1tYXJ5UmVxdWVzdBIQCghncm91cF9pZBgBIAEoCRIuCgpzdGFydF9kYXRlGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfZGF0ZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAizQIKF0dldEdyb3VwU3VtbWFyeVJlc3BvbnNlEhYKDnRvdGFsX2V4cGVuc2VzGAEgASgBEhQKDHRvdGFsX2luY29tZRgCIAEoARI6ChNleHBlbnNlX2J5X2NhdGVnb3J5GAMgAygLMh0ucGZpbmFuY2UudjEuRXhwZW5zZUJyZWFrZG93bhIzCg9tZW1iZXJfYmFsYW5jZXMYBCADKAsyGi5wZmluYW5jZS52MS5NZW1iZXJCYWxhbmNlEh8KF3Vuc2V0dGxlZF9leHBlbnNlX2NvdW50GAUgASgFEhgKEHVuc2V0dGxlZF9hbW91bnQYBiABKAESHAoUdG90YWxfZXhwZW5zZXNfY2VudHMYByABKAMSGgoSdG90YWxfaW5jb21lX2NlbnRzGAggASgDEh4KFnVuc2V0dGxlZF9hbW91bnRfY2VudHMYCSABKAMikQIKGUdldEdyb3VwU2V0dGxlbWVudFJlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkSLgoKc3RhcnRfZGF0ZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX2RhdGUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEk8KDXNwbGl0X3dlaWdodHMYBCADKAsyOC5wZmluYW5jZS52MS5HZXRHcm91cFNldHRsZW1lbnRSZXF1ZXN0LlNwbGl0V2VpZ2h0c0VudHJ5GjMKEVNwbGl0V2VpZ2h0c0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoAToCOAEiugEKGkdldEdyb3VwU2V0dGxlbWVudFJlc3BvbnNlEiwKCGJhbGFuY2VzGAEgAygLMhoucGZpbmFuY2UudjEuTWVtYmVyQmFsYW5jZRIyCgl0cmFuc2ZlcnMYAiADKAsyHy5wZmluYW5jZS52MS5TZXR0bGVtZW50VHJhbnNmZXISGQoRdG90YWxfY29udHJpYnV0ZWQYAyABKAESHwoXdG90YWxfY29udHJpYnV0ZWRfY2VudHMYBCABKAMimAEKF0NyZWF0ZUludml0ZUxpbmtSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgJEhIKCmNyZWF0ZWRfYnkYAiABKAkSLAoMZGVmYXVsdF9yb2xlGAMgASgOMhYucGZpbmFuY2UudjEuR3JvdXBSb2xlEhAKCG1heF91c2VzGAQgASgFEhcKD2V4cGlyZXNfaW5fZGF5cxgFIAEoBSJNChhDcmVhdGVJbnZpdGVMaW5rUmVzcG9uc2USMQoLaW52aXRlX2xpbmsYASABKAsyHC5wZmluYW5jZS52MS5Hcm91cEludml0ZUxpbmsiKgoaR2V0SW52aXRlTGlua0J5Q29kZVJlcXVlc3QSDAoEY29kZRgBIAEoCSJ6ChtHZXRJbnZpdGVMaW5rQnlDb2RlUmVzcG9uc2USMQoLaW52aXRlX2xpbmsYASABKAsyHC5wZmluYW5jZS52MS5Hcm91cEludml0ZUxpbmsSKAoFZ3JvdXAYAiABKAsyGS5wZmluYW5jZS52MS5GaW5hbmNlR3JvdXAiYQoWSm9pbkdyb3VwQnlMaW5rUmVxdWVzdBIMCgRjb2RlGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSEgoKdXNlcl9lbWFpbBgDIAEoCRIUCgxkaXNwbGF5X25hbWUYBCABKAkiQwoXSm9pbkdyb3VwQnlMaW5rUmVzcG9uc2USKAoFZ3JvdXAYASABKAsyGS5wZmluYW5jZS52MS5GaW5hbmNlR3JvdXAiawoWTGlzdEludml0ZUxpbmtzUmVxdWVzdBIQCghncm91cF9pZBgBIAEoCRIYChBpbmNsdWRlX2luYWN0aXZlGAIgASgIEhEKCXBhZ2Vfc2l6ZRgDIAEoBRISCgpwYWdlX3Rva2VuGAQgASgJImYKF0xpc3RJbnZpdGVMaW5rc1Jlc3BvbnNlEjIKDGludml0ZV9saW5rcxgBIAMoCzIcLnBmaW5hbmNlLnYxLkdyb3VwSW52aXRlTGluaxIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiLgobRGVhY3RpdmF0ZUludml0ZUxpbmtSZXF1ZXN0Eg8KB2xpbmtfaWQYASABKAkiLAoZR2V0SW52aXRlTGlua1N0YXRzUmVxdWVzdBIPCgdsaW5rX2lkGAEgASgJIvcBChpHZXRJbnZpdGVMaW5rU3RhdHNSZXNwb25zZRIxCgtpbnZpdGVfbGluaxgBIAEoCzIcLnBmaW5hbmNlLnYxLkdyb3VwSW52aXRlTGluaxISCgp0b3RhbF91c2VzGAIgASgFEhsKDnJlbWFpbmluZ191c2VzGAMgASgFSACIAQESMAoMbGFzdF91c2VkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCg5qb2luZWRfbWVtYmVycxgFIAMoCzIYLnBmaW5hbmNlLnYxLkdyb3VwTWVtYmVyQhEKD19yZW1haW5pbmdfdXNlcyKQAgofQ29udHJpYnV0ZUV4cGVuc2VUb0dyb3VwUmVxdWVzdBIZChFzb3VyY2VfZXhwZW5zZV9pZBgBIAEoCRIXCg90YXJnZXRfZ3JvdXBfaWQYAiABKAkSFgoOY29udHJpYnV0ZWRfYnkYAyABKAkSDgoGYW1vdW50GAQgASgBEioKCnNwbGl0X3R5cGUYBSABKA4yFi5wZmluYW5jZS52MS5TcGxpdFR5cGUSGgoSYWxsb2NhdGVkX3VzZXJfaWRzGAYgAygJEjMKC2FsbG9jYXRpb25zGAcgAygLMh4ucGZpbmFuY2UudjEuRXhwZW5zZUFsbG9jYXRpb24SFAoMYW1vdW50X2NlbnRzGAggASgDIo8BCiBDb250cmlidXRlRXhwZW5zZVRvR3JvdXBSZXNwb25zZRI2Cgxjb250cmlidXRpb24YASABKAsyIC5wZmluYW5jZS52MS5FeHBlbnNlQ29udHJpYnV0aW9uEjMKFWNyZWF0ZWRfZ3JvdXBfZXhwZW5zZRgCIAEoCzIULnBmaW5hbmNlLnYxLkV4cGVuc2UiZAoYTGlzdENvbnRyaWJ1dGlvbnNSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSEQoJcGFnZV9zaXplGAMgASgFEhIKCnBhZ2VfdG9rZW4YBCABKAkibQoZTGlzdENvbnRyaWJ1dGlvbnNSZXNwb25zZRI3Cg1jb250cmlidXRpb25zGAEgAygLMiAucGZpbmFuY2UudjEuRXhwZW5zZUNvbnRyaWJ1dGlvbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkikQEKHkNvbnRyaWJ1dGVJbmNvbWVUb0dyb3VwUmVxdWVzdBIYChBzb3VyY2VfaW5jb21lX2lkGAEgASgJEhcKD3RhcmdldF9ncm91cF9pZBgCIAEoCRIWCg5jb250cmlidXRlZF9ieRgDIAEoCRIOCgZhbW91bnQYBCABKAESFAoMYW1vdW50X2NlbnRzGAUgASgDIosBCh9Db250cmlidXRlSW5jb21lVG9Hcm91cFJlc3BvbnNlEjUKDGNvbnRyaWJ1dGlvbhgBIAEoCzIfLnBmaW5hbmNlLnYxLkluY29tZUNvbnRyaWJ1dGlvbhIxChRjcmVhdGVkX2dyb3VwX2luY29tZRgCIAEoCzITLnBmaW5hbmNlLnYxLkluY29tZSJqCh5MaXN0SW5jb21lQ29udHJpYnV0aW9uc1JlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIRCglwYWdlX3NpemUYAyABKAUSEgoKcGFnZV90b2tlbhgEIAEoCSJyCh9MaXN0SW5jb21lQ29udHJpYnV0aW9uc1Jlc3BvbnNlEjYKDWNvbnRyaWJ1dGlvbnMYASADKAsyHy5wZmluYW5jZS52MS5JbmNvbWVDb250cmlidXRpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIswDChFDcmVhdGVHb2FsUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEgwKBG5hbWUYAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSKAoJZ29hbF90eXBlGAUgASgOMhUucGZpbmFuY2UudjEuR29hbFR5cGUSFQoNdGFyZ2V0X2Ftb3VudBgGIAEoARIWCg5pbml0aWFsX2Ftb3VudBgHIAEoARIuCgpzdGFydF9kYXRlGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgt0YXJnZXRfZGF0ZRgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMgoMY2F0ZWdvcnlfaWRzGAogAygOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5EgwKBGljb24YCyABKAkSDQoFY29sb3IYDCABKAkSGwoTdGFyZ2V0X2Ftb3VudF9jZW50cxgNIAEoAxIcChRpbml0aWFsX2Ftb3VudF9jZW50cxgOIAEoAxIrCghwcmlvcml0eRgPIAEoDjIZLnBmaW5hbmNlLnYxLkdvYWxQcmlvcml0eSI+ChJDcmVhdGVHb2FsUmVzcG9uc2USKAoEZ29hbBgBIAEoCzIaLnBmaW5hbmNlLnYxLkZpbmFuY2lhbEdvYWwiIQoOR2V0R29hbFJlcXVlc3QSDwoHZ29hbF9pZBgBIAEoCSI7Cg9HZXRHb2FsUmVzcG9uc2USKAoEZ29hbBgBIAEoCzIaLnBmaW5hbmNlLnYxLkZpbmFuY2lhbEdvYWwi0wIKEVVwZGF0ZUdvYWxSZXF1ZXN0Eg8KB2dvYWxfaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIVCg10YXJnZXRfYW1vdW50GAQgASgBEi8KC3RhcmdldF9kYXRlGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBInCgZzdGF0dXMYBiABKA4yFy5wZmluYW5jZS52MS5Hb2FsU3RhdHVzEjIKDGNhdGVnb3J5X2lkcxgHIAMoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIMCgRpY29uGAggASgJEg0KBWNvbG9yGAkgASgJEhsKE3RhcmdldF9hbW91bnRfY2VudHMYCiABKAMSKwoIcHJpb3JpdHkYCyABKA4yGS5wZmluYW5jZS52MS5Hb2FsUHJpb3JpdHkiPgoSVXBkYXRlR29hbFJlc3BvbnNlEigKBGdvYWwYASABKAsyGi5wZmluYW5jZS52MS5GaW5hbmNpYWxHb2FsIiQKEURlbGV0ZUdvYWxSZXF1ZXN0Eg8KB2dvYWxfaWQYASABKAkirwEKEExpc3RHb2Fsc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRInCgZzdGF0dXMYAyABKA4yFy5wZmluYW5jZS52MS5Hb2FsU3RhdHVzEigKCWdvYWxfdHlwZRgEIAEoDjIVLnBmaW5hbmNlLnYxLkdvYWxUeXBlEhEKCXBhZ2Vfc2l6ZRgFIAEoBRISCgpwYWdlX3Rva2VuGAYgASgJIlcKEUxpc3RHb2Fsc1Jlc3BvbnNlEikKBWdvYWxzGAEgAygLMhoucGZpbmFuY2UudjEuRmluYW5jaWFsR29hbBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiWQoWR2V0R29hbFByb2dyZXNzUmVxdWVzdBIPCgdnb2FsX2lkGAEgASgJEi4KCmFzX29mX2RhdGUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkYKF0dldEdvYWxQcm9ncmVzc1Jlc3BvbnNlEisKCHByb2dyZXNzGAEgASgLMhkucGZpbmFuY2UudjEuR29hbFByb2dyZXNzIocBChdDb250cmlidXRlVG9Hb2FsUmVxdWVzdBIPCgdnb2FsX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSDgoGYW1vdW50GAMgASgBEgwKBG5vdGUYBCABKAkSFAoMYW1vdW50X2NlbnRzGAUgASgDEhYKDmFsbG93X25lZ2F0aXZlGAYgASgIInkKGENvbnRyaWJ1dGVUb0dvYWxSZXNwb25zZRIoCgRnb2FsGAEgASgLMhoucGZpbmFuY2UudjEuRmluYW5jaWFsR29hbBIzCgxjb250cmlidXRpb24YAiABKAsyHS5wZmluYW5jZS52MS5Hb2FsQ29udHJpYnV0aW9uIlYKHExpc3RHb2FsQ29udHJpYnV0aW9uc1JlcXVlc3QSDwoHZ29hbF9pZBgBIAEoCRIRCglwYWdlX3NpemUYAiABKAUSEgoKcGFnZV90b2tlbhgDIAEoCSJuCh1MaXN0R29hbENvbnRyaWJ1dGlvbnNSZXNwb25zZRI0Cg1jb250cmlidXRpb25zGAEgAygLMh0ucGZpbmFuY2UudjEuR29hbENvbnRyaWJ1dGlvbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkikgEKH1JlY29tbWVuZEdvYWxBbGxvY2F0aW9uc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRIcChRtb250aGx5X2J1ZGdldF9jZW50cxgDIAEoAxIuCgphc19vZl9kYXRlGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCK7AQoOR29hbEFsbG9jYXRpb24SDwoHZ29hbF9pZBgBIAEoCRIRCglnb2FsX25hbWUYAiABKAkSKwoIcHJpb3JpdHkYAyABKA4yGS5wZmluYW5jZS52MS5Hb2FsUHJpb3JpdHkSFwoPcmVtYWluaW5nX2NlbnRzGAQgASgDEh4KFnJlcXVpcmVkX21vbnRobHlfY2VudHMYBSABKAMSHwoXc3VnZ2VzdGVkX21vbnRobHlfY2VudHMYBiABKAMiVAogUmVjb21tZW5kR29hbEFsbG9jYXRpb25zUmVzcG9uc2USMAoLYWxsb2NhdGlvbnMYASADKAsyGy5wZmluYW5jZS52MS5Hb2FsQWxsb2NhdGlvbiJeChpHZXRTcGVuZGluZ0luc2lnaHRzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEg4KBnBlcmlvZBgDIAEoCRINCgVsaW1pdBgEIAEoBSJ/ChtHZXRTcGVuZGluZ0luc2lnaHRzUmVzcG9uc2USLgoIaW5zaWdodHMYASADKAsyHC5wZmluYW5jZS52MS5TcGVuZGluZ0luc2lnaHQSMAoMZ2VuZXJhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLAAQoWR2V0QWN0aXZpdHlGZWVkUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEi4KCnN0YXJ0X2RhdGUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF9kYXRlGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCglwYWdlX3NpemUYBSABKAUSEgoKcGFnZV90b2tlbhgGIAEoCSJcChdHZXRBY3Rpdml0eUZlZWRSZXNwb25zZRIoCgVpdGVtcxgBIAMoCzIZLnBmaW5hbmNlLnYxLkFjdGl2aXR5SXRlbRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkioAIKFkV4dHJhY3REb2N1bWVudFJlcXVlc3QSFQoNZG9jdW1lbnRfZGF0YRgBIAEoDBIwCg1kb2N1bWVudF90eXBlGAIgASgOMhkucGZpbmFuY2UudjEuRG9jdW1lbnRUeXBlEhAKCGZpbGVuYW1lGAMgASgJEhgKEGFzeW5jX3Byb2Nlc3NpbmcYBCABKAgSGQoRdmFsaWRhdGVfd2l0aF9hcGkYBSABKAgSOAoRZXh0cmFjdGlvbl9tZXRob2QYBiABKA4yHS5wZmluYW5jZS52MS5FeHRyYWN0aW9uTWV0aG9kEiIKFWF1dG9fcmVqZWN0X3RocmVzaG9sZBgHIAEoAUgAiAEBQhgKFl9hdXRvX3JlamVjdF90aHJlc2hvbGQi3wEKF0V4dHJhY3REb2N1bWVudFJlc3BvbnNlEi0KBnJlc3VsdBgBIAEoCzIdLnBmaW5hbmNlLnYxLkV4dHJhY3Rpb25SZXN1bHQSDgoGam9iX2lkGAIgASgJEi0KBnN0YXR1cxgDIAEoDjIdLnBmaW5hbmNlLnYxLkV4dHJhY3Rpb25TdGF0dXMSOgoSc3RhdGVtZW50X21ldGFkYXRhGAQgASgLMh4ucGZpbmFuY2UudjEuU3RhdGVtZW50TWV0YWRhdGESGgoSZHVwbGljYXRlX3dhcm5pbmdzGAUgAygJIikKF0dldEV4dHJhY3Rpb25Kb2JSZXF1ZXN0Eg4KBmpvYl9pZBgBIAEoCSJDChhHZXRFeHRyYWN0aW9uSm9iUmVzcG9uc2USJwoDam9iGAEgASgLMhoucGZpbmFuY2UudjEuRXh0cmFjdGlvbkpvYiIzCiFHZXRKb2JSZWplY3RlZFRyYW5zYWN0aW9uc1JlcXVlc3QSDgoGam9iX2lkGAEgASgJIowBCiJHZXRKb2JSZWplY3RlZFRyYW5zYWN0aW9uc1Jlc3BvbnNlEjcKDHRyYW5zYWN0aW9ucxgBIAMoCzIhLnBmaW5hbmNlLnYxLkV4dHJhY3RlZFRyYW5zYWN0aW9uEi0KBnN0YXR1cxgCIAEoDjIdLnBmaW5hbmNlLnYxLkV4dHJhY3Rpb25TdGF0dXMiTQoiUmVjb3ZlclJlamVjdGVkVHJhbnNhY3Rpb25zUmVxdWVzdBIOCgZqb2JfaWQYASABKAkSFwoPdHJhbnNhY3Rpb25faWRzGAIgAygJInUKI1JlY292ZXJSZWplY3RlZFRyYW5zYWN0aW9uc1Jlc3BvbnNlEjcKDHRyYW5zYWN0aW9ucxgBIAMoCzIhLnBmaW5hbmNlLnYxLkV4dHJhY3RlZFRyYW5zYWN0aW9uEhUKDW5vdF9mb3VuZF9pZHMYAiADKAkipgMKIkltcG9ydEV4dHJhY3RlZFRyYW5zYWN0aW9uc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRI3Cgx0cmFuc2FjdGlvbnMYAyADKAsyIS5wZmluYW5jZS52MS5FeHRyYWN0ZWRUcmFuc2FjdGlvbhIXCg9za2lwX2R1cGxpY2F0ZXMYBCABKAgSOAoRZGVmYXVsdF9mcmVxdWVuY3kYBSABKA4yHS5wZmluYW5jZS52MS5FeHBlbnNlRnJlcXVlbmN5EjoKEnN0YXRlbWVudF9tZXRhZGF0YRgGIAEoCzIeLnBmaW5hbmNlLnYxLlN0YXRlbWVudE1ldGFkYXRhEhkKEW9yaWdpbmFsX2ZpbGVuYW1lGAcgASgJEhQKDHJlY2VpcHRfdXJscxgIIAMoCRIdChVyZWNlaXB0X3N0b3JhZ2VfcGF0aHMYCSADKAkSDwoHZHJ5X3J1bhgKIAEoCBI0ChBzb3VyY2Vfc3RhdGVtZW50GAsgASgLMhoucGZpbmFuY2UudjEuQXR0YWNobWVudFJlZiLkAQojSW1wb3J0RXh0cmFjdGVkVHJhbnNhY3Rpb25zUmVzcG9uc2USLgoQY3JlYXRlZF9leHBlbnNlcxgBIAMoCzIULnBmaW5hbmNlLnYxLkV4cGVuc2USFgoOaW1wb3J0ZWRfY291bnQYAiABKAUSFQoNc2tpcHBlZF9jb3VudBgDIAEoBRIXCg9za2lwcGVkX3JlYXNvbnMYBCADKAkSDwoHZHJ5X3J1bhgFIAEoCBI0CgxkaXNwb3NpdGlvbnMYBiADKAsyHi5wZmluYW5jZS52MS5JbXBvcnREaXNwb3NpdGlvbiK7AQoRSW1wb3J0RGlzcG9zaXRpb24SFgoOdHJhbnNhY3Rpb25faWQYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSNwoLZGlzcG9zaXRpb24YAyABKA4yIi5wZmluYW5jZS52MS5JbXBvcnREaXNwb3NpdGlvblR5cGUSDgoGcmVhc29uGAQgASgJEhwKFGR1cGxpY2F0ZV9leHBlbnNlX2lkGAUgASgJEhIKCmV4cGVuc2VfaWQYBiABKAkiJwoXUGFyc2VFeHBlbnNlVGV4dFJlcXVlc3QSDAoEdGV4dBgBIAEoCSLdAgoNUGFyc2VkRXhwZW5zZRITCgtkZXNjcmlwdGlvbhgBIAEoCRIOCgZhbW91bnQYAiABKAESLgoIY2F0ZWdvcnkYAyABKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSMAoJZnJlcXVlbmN5GAQgASgOMh0ucGZpbmFuY2UudjEuRXhwZW5zZUZyZXF1ZW5jeRIoCgRkYXRlGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBISCgpzcGxpdF93aXRoGAYgAygJEhIKCmNvbmZpZGVuY2UYByABKAESEQoJcmF3X2lucHV0GAggASgJEhEKCXJlYXNvbmluZxgJIAEoCRI3ChFmaWVsZF9jb25maWRlbmNlcxgKIAEoCzIcLnBmaW5hbmNlLnYxLkZpZWxkQ29uZmlkZW5jZRIUCgxhbW91bnRfY2VudHMYCyABKAMinwEKGFBhcnNlRXhwZW5zZVRleHRSZXNwb25zZRIrCgdleHBlbnNlGAEgASgLMhoucGZpbmFuY2UudjEuUGFyc2VkRXhwZW5zZRIuCgphZGRpdGlvbmFsGAIgAygLMhoucGZpbmFuY2UudjEuUGFyc2VkRXhwZW5zZRIPCgdzdWNjZXNzGAMgASgIEhUKDWVycm9yX21lc3NhZ2UYBCABKAkiuQEKGVBhcnNlQmFua1N0YXRlbWVudFJlcXVlc3QSEAoIcGRmX2RhdGEYASABKAwSEQoJYmFua19oaW50GAIgASgJEjgKEWV4dHJhY3Rpb25fbWV0aG9kGAMgASgOMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvbk1ldGhvZBIQCghmaWxlbmFtZRgEIAEoCRIZChFpbXBvcnRfcHJvZmlsZV9pZBgFIAEoCRIQCghjc3ZfZGF0YRgGIAEoDCJqChpQYXJzZUJhbmtTdGF0ZW1lbnRSZXNwb25zZRIwCgZyZXN1bHQYASABKAsyIC5wZmluYW5jZS52MS5CYW5rU3RhdGVtZW50UmVzdWx0EhoKEmR1cGxpY2F0ZV93YXJuaW5ncxgCIAMoCSLGAQoaQ3JlYXRlSW1wb3J0UHJvZmlsZVJlcXVlc3QSDAoEbmFtZRgBIAEoCRIRCgliYW5rX25hbWUYAiABKAkSEwoLZGF0ZV9mb3JtYXQYAyABKAkSOQoPY29sdW1uX21hcHBpbmdzGAQgAygLMiAucGZpbmFuY2UudjEuSW1wb3J0Q29sdW1uTWFwcGluZxI3Cg5jYXRlZ29yeV9ydWxlcxgFIAMoCzIfLnBmaW5hbmNlLnYxLkltcG9ydENhdGVnb3J5UnVsZSJKChtDcmVhdGVJbXBvcnRQcm9maWxlUmVzcG9uc2USKwoHcHJvZmlsZRgBIAEoCzIaLnBmaW5hbmNlLnYxLkltcG9ydFByb2ZpbGUiLQoXR2V0SW1wb3J0UHJvZmlsZVJlcXVlc3QSEgoKcHJvZmlsZV9pZBgBIAEoCSJHChhHZXRJbXBvcnRQcm9maWxlUmVzcG9uc2USKwoHcHJvZmlsZRgBIAEoCzIaLnBmaW5hbmNlLnYxLkltcG9ydFByb2ZpbGUi2gEKGlVwZGF0ZUltcG9ydFByb2ZpbGVSZXF1ZXN0EhIKCnByb2ZpbGVfaWQYASABKAkSDAoEbmFtZRgCIAEoCRIRCgliYW5rX25hbWUYAyABKAkSEwoLZGF0ZV9mb3JtYXQYBCABKAkSOQoPY29sdW1uX21hcHBpbmdzGAUgAygLMiAucGZpbmFuY2UudjEuSW1wb3J0Q29sdW1uTWFwcGluZxI3Cg5jYXRlZ29yeV9ydWxlcxgGIAMoCzIfLnBmaW5hbmNlLnYxLkltcG9ydENhdGVnb3J5UnVsZSJKChtVcGRhdGVJbXBvcnRQcm9maWxlUmVzcG9uc2USKwoHcHJvZmlsZRgBIAEoCzIaLnBmaW5hbmNlLnYxLkltcG9ydFByb2ZpbGUiMAoaRGVsZXRlSW1wb3J0UHJvZmlsZVJlcXVlc3QSEgoKcHJvZmlsZV9pZBgBIAEoCSIbChlMaXN0SW1wb3J0UHJvZmlsZXNSZXF1ZXN0IkoKGkxpc3RJbXBvcnRQcm9maWxlc1Jlc3BvbnNlEiwKCHByb2ZpbGVzGAEgAygLMhoucGZpbmFuY2UudjEuSW1wb3J0UHJvZmlsZSIhCh9HZXRFeHRyYWN0aW9uUHJlZmVyZW5jZXNSZXF1ZXN0IlsKIEdldEV4dHJhY3Rpb25QcmVmZXJlbmNlc1Jlc3BvbnNlEjcKC3ByZWZlcmVuY2VzGAEgASgLMiIucGZpbmFuY2UudjEuRXh0cmFjdGlvblByZWZlcmVuY2VzIl0KIlVwZGF0ZUV4dHJhY3Rpb25QcmVmZXJlbmNlc1JlcXVlc3QSNwoLcHJlZmVyZW5jZXMYASABKAsyIi5wZmluYW5jZS52MS5FeHRyYWN0aW9uUHJlZmVyZW5jZXMiXgojVXBkYXRlRXh0cmFjdGlvblByZWZlcmVuY2VzUmVzcG9uc2USNwoLcHJlZmVyZW5jZXMYASABKAsyIi5wZmluYW5jZS52MS5FeHRyYWN0aW9uUHJlZmVyZW5jZXMiJAoiRGVsZXRlRXh0cmFjdGlvblByZWZlcmVuY2VzUmVxdWVzdCKRBAohQ3JlYXRlUmVjdXJyaW5nVHJhbnNhY3Rpb25SZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDgoGYW1vdW50GAQgASgBEhQKDGFtb3VudF9jZW50cxgFIAEoAxIuCghjYXRlZ29yeRgGIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIwCglmcmVxdWVuY3kYByABKA4yHS5wZmluYW5jZS52MS5FeHBlbnNlRnJlcXVlbmN5Ei4KCnN0YXJ0X2RhdGUYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF9kYXRlGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBISCgppc19leHBlbnNlGAogASgIEgwKBHRhZ3MYCyADKAkSFwoPcGFpZF9ieV91c2VyX2lkGAwgASgJEioKCnNwbGl0X3R5cGUYDSABKA4yFi5wZmluYW5jZS52MS5TcGxpdFR5cGUSMwoLYWxsb2NhdGlvbnMYDiADKAsyHi5wZmluYW5jZS52MS5FeHBlbnNlQWxsb2NhdGlvbhIYChBtaW5fYW1vdW50X2NlbnRzGA8gASgDEhgKEG1heF9hbW91bnRfY2VudHMYECABKAMiZgoiQ3JlYXRlUmVjdXJyaW5nVHJhbnNhY3Rpb25SZXNwb25zZRJAChVyZWN1cnJpbmdfdHJhbnNhY3Rpb24YASABKAsyIS5wZmluYW5jZS52MS5SZWN1cnJpbmdUcmFuc2FjdGlvbiJCCh5HZXRSZWN1cnJpbmdUcmFuc2FjdGlvblJlcXVlc3QSIAoYcmVjdXJyaW5nX3RyYW5zYWN0aW9uX2lkGAEgASgJImMKH0dldFJlY3VycmluZ1RyYW5zYWN0aW9uUmVzcG9uc2USQAoVcmVjdXJyaW5nX3RyYW5zYWN0aW9uGAEgASgLMiEucGZpbmFuY2UudjEuUmVjdXJyaW5nVHJhbnNhY3Rpb24ilAQKIVVwZGF0ZVJlY3VycmluZ1RyYW5zYWN0aW9uUmVxdWVzdBIgChhyZWN1cnJpbmdfdHJhbnNhY3Rpb25faWQYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSDgoGYW1vdW50GAMgASgBEhQKDGFtb3VudF9jZW50cxgEIAEoAxIuCghjYXRlZ29yeRgFIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIwCglmcmVxdWVuY3kYBiABKA4yHS5wZmluYW5jZS52MS5FeHBlbnNlRnJlcXVlbmN5EiwKCGVuZF9kYXRlGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBISCgppc19leHBlbnNlGAggASgIEgwKBHRhZ3MYCSADKAkSFwoPcGFpZF9ieV91c2VyX2lkGAogASgJEioKCnNwbGl0X3R5cGUYCyABKA4yFi5wZmluYW5jZS52MS5TcGxpdFR5cGUSMwoLYWxsb2NhdGlvbnMYDCADKAsyHi5wZmluYW5jZS52MS5FeHBlbnNlQWxsb2NhdGlvbhIdChBtaW5fYW1vdW50X2NlbnRzGA0gASgDSACIAQESHQoQbWF4X2Ftb3VudF9jZW50cxgOIAEoA0gBiAEBQhMKEV9taW5fYW1vdW50X2NlbnRzQhMKEV9tYXhfYW1vdW50X2NlbnRzImYKIlVwZGF0ZVJlY3VycmluZ1RyYW5zYWN0aW9uUmVzcG9uc2USQAoVcmVjdXJyaW5nX3RyYW5zYWN0aW9uGAEgASgLMiEucGZpbmFuY2UudjEuUmVjdXJyaW5nVHJhbnNhY3Rpb24iRQohRGVsZXRlUmVjdXJyaW5nVHJhbnNhY3Rpb25SZXF1ZXN0EiAKGHJlY3VycmluZ190cmFuc2FjdGlvbl9pZBgBIAEoCSLUAQogTGlzdFJlY3VycmluZ1RyYW5zYWN0aW9uc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRI3CgZzdGF0dXMYAyABKA4yJy5wZmluYW5jZS52MS5SZWN1cnJpbmdUcmFuc2FjdGlvblN0YXR1cxIZChFmaWx0ZXJfaXNfZXhwZW5zZRgEIAEoCBISCgppc19leHBlbnNlGAUgASgIEhEKCXBhZ2Vfc2l6ZRgGIAEoBRISCgpwYWdlX3Rva2VuGAcgASgJIn8KIUxpc3RSZWN1cnJpbmdUcmFuc2FjdGlvbnNSZXNwb25zZRJBChZyZWN1cnJpbmdfdHJhbnNhY3Rpb25zGAEgAygLMiEucGZpbmFuY2UudjEuUmVjdXJyaW5nVHJhbnNhY3Rpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIkQKIFBhdXNlUmVjdXJyaW5nVHJhbnNhY3Rpb25SZXF1ZXN0EiAKGHJlY3VycmluZ190cmFuc2FjdGlvbl9pZBgBIAEoCSJlCiFQYXVzZVJlY3VycmluZ1RyYW5zYWN0aW9uUmVzcG9uc2USQAoVcmVjdXJyaW5nX3RyYW5zYWN0aW9uGAEgASgLMiEucGZpbmFuY2UudjEuUmVjdXJyaW5nVHJhbnNhY3Rpb24iRQohUmVzdW1lUmVjdXJyaW5nVHJhbnNhY3Rpb25SZXF1ZXN0EiAKGHJlY3VycmluZ190cmFuc2FjdGlvbl9pZBgBIAEoCSJmCiJSZXN1bWVSZWN1cnJpbmdUcmFuc2FjdGlvblJlc3BvbnNlEkAKFXJlY3VycmluZ190cmFuc2FjdGlvbhgBIAEoCzIhLnBmaW5hbmNlLnYxLlJlY3VycmluZ1RyYW5zYWN0aW9uIj0KGVNraXBOZXh0T2NjdXJyZW5jZVJlcXVlc3QSIAoYcmVjdXJyaW5nX3RyYW5zYWN0aW9uX2lkGAEgASgJIpYBChpTa2lwTmV4dE9jY3VycmVuY2VSZXNwb25zZRJAChVyZWN1cnJpbmdfdHJhbnNhY3Rpb24YASABKAsyIS5wZmluYW5jZS52MS5SZWN1cnJpbmdUcmFuc2FjdGlvbhI2ChJza2lwcGVkX29jY3VycmVuY2UYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIl8KF0dldFVwY29taW5nQmlsbHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSEgoKZGF5c19haGVhZBgDIAEoBRINCgVsaW1pdBgEIAEoBSJVChhHZXRVcGNvbWluZ0JpbGxzUmVzcG9uc2USOQoOdXBjb21pbmdfYmlsbHMYASADKAsyIS5wZmluYW5jZS52MS5SZWN1cnJpbmdUcmFuc2FjdGlvbiLcAQoiUHJldmlld1JlY3VycmluZ09jY3VycmVuY2VzUmVxdWVzdBIwCglmcmVxdWVuY3kYASABKA4yHS5wZmluYW5jZS52MS5FeHBlbnNlRnJlcXVlbmN5Ei4KCnN0YXJ0X2RhdGUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF9kYXRlGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIXCg9tYXhfb2NjdXJyZW5jZXMYBCABKAUSDQoFY291bnQYBSABKAUiaAojUHJldmlld1JlY3VycmluZ09jY3VycmVuY2VzUmVzcG9uc2USLwoLb2NjdXJyZW5jZXMYASADKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGhhc19tb3JlGAIgASgIIiUKI1Byb2Nlc3NSZWN1cnJpbmdUcmFuc2FjdGlvbnNSZXF1ZXN0IpcBCiRQcm9jZXNzUmVjdXJyaW5nVHJhbnNhY3Rpb25zUmVzcG9uc2USFwoPcHJvY2Vzc2VkX2NvdW50GAEgASgFEhUKDXNraXBwZWRfY291bnQYAiABKAUSEwoLZW5kZWRfY291bnQYAyABKAUSEwoLZXJyb3JfY291bnQYBCABKAUSFQoNY3JlYXRlZF9jb3VudBgFIAEoBSL0AwoZU2VhcmNoVHJhbnNhY3Rpb25zUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEg0KBXF1ZXJ5GAMgASgJEhAKCGNhdGVnb3J5GAQgASgJEhcKCmFtb3VudF9taW4YBSABKAFIAIgBARIXCgphbW91bnRfbWF4GAYgASgBSAGIAQESHQoQYW1vdW50X21pbl9jZW50cxgHIAEoA0gCiAEBEh0KEGFtb3VudF9tYXhfY2VudHMYCCABKANIA4gBARIuCgpzdGFydF9kYXRlGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfZGF0ZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKgoEdHlwZRgLIAEoDjIcLnBmaW5hbmNlLnYxLlRyYW5zYWN0aW9uVHlwZRIRCglwYWdlX3NpemUYDCABKAUSEgoKcGFnZV90b2tlbhgNIAEoCRIqCgdzb3J0X2J5GA4gASgOMhkucGZpbmFuY2UudjEuU2VhcmNoU29ydEJ5Qg0KC19hbW91bnRfbWluQg0KC19hbW91bnRfbWF4QhMKEV9hbW91bnRfbWluX2NlbnRzQhMKEV9hbW91bnRfbWF4X2NlbnRzInYKGlNlYXJjaFRyYW5zYWN0aW9uc1Jlc3BvbnNlEioKB3Jlc3VsdHMYASADKAsyGS5wZmluYW5jZS52MS5TZWFyY2hSZXN1bHQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhMKC3RvdGFsX2NvdW50GAMgASgFIlgKGkRldGVjdFN1YnNjcmlwdGlvbnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSFwoPbG9va2JhY2tfbW9udGhzGAMgASgFIq4BChtEZXRlY3RTdWJzY3JpcHRpb25zUmVzcG9uc2USOAoNc3Vic2NyaXB0aW9ucxgBIAMoCzIhLnBmaW5hbmNlLnYxLkRldGVjdGVkU3Vic2NyaXB0aW9uEhoKEnRvdGFsX21vbnRobHlfY29zdBgCIAEoARIgChh0b3RhbF9tb250aGx5X2Nvc3RfY2VudHMYAyABKAMSFwoPZm9yZ290dGVuX2NvdW50GAQgASgFImUKGUNvbnZlcnRUb1JlY3VycmluZ1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRI3CgxzdWJzY3JpcHRpb24YAiABKAsyIS5wZmluYW5jZS52MS5EZXRlY3RlZFN1YnNjcmlwdGlvbiJeChpDb252ZXJ0VG9SZWN1cnJpbmdSZXNwb25zZRJAChVyZWN1cnJpbmdfdHJhbnNhY3Rpb24YASABKAsyIS5wZmluYW5jZS52MS5SZWN1cnJpbmdUcmFuc2FjdGlvbiKBAQogQ29udmVydEV4cGVuc2VUb1JlY3VycmluZ1JlcXVlc3QSEgoKZXhwZW5zZV9pZBgBIAEoCRIwCglmcmVxdWVuY3kYAiABKA4yHS5wZmluYW5jZS52MS5FeHBlbnNlRnJlcXVlbmN5EhcKD2RlbGV0ZV9vcmlnaW5hbBgDIAEoCCJlCiFDb252ZXJ0RXhwZW5zZVRvUmVjdXJyaW5nUmVzcG9uc2USQAoVcmVjdXJyaW5nX3RyYW5zYWN0aW9uGAEgASgLMiEucGZpbmFuY2UudjEuUmVjdXJyaW5nVHJhbnNhY3Rpb24iXAoeRGV0ZWN0UmVjdXJyaW5nUGF0dGVybnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSFwoPbG9va2JhY2tfbW9udGhzGAMgASgFIl8KH0RldGVjdFJlY3VycmluZ1BhdHRlcm5zUmVzcG9uc2USPAoLc3VnZ2VzdGlvbnMYASADKAsyJy5wZmluYW5jZS52MS5SZWN1cnJpbmdQYXR0ZXJuU3VnZ2VzdGlvbiKbAQoYTGlzdE5vdGlmaWNhdGlvbnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEwoLdW5yZWFkX29ubHkYAiABKAgSEQoJcGFnZV9zaXplGAMgASgFEhIKCnBhZ2VfdG9rZW4YBCABKAkSMgoLdHlwZV9maWx0ZXIYBSABKA4yHS5wZmluYW5jZS52MS5Ob3RpZmljYXRpb25UeXBlInwKGUxpc3ROb3RpZmljYXRpb25zUmVzcG9uc2USMAoNbm90aWZpY2F0aW9ucxgBIAMoCzIZLnBmaW5hbmNlLnYxLk5vdGlmaWNhdGlvbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSFAoMdG90YWxfdW5yZWFkGAMgASgFIjYKG01hcmtOb3RpZmljYXRpb25SZWFkUmVxdWVzdBIXCg9ub3RpZmljYXRpb25faWQYASABKAkiMgofTWFya0FsbE5vdGlmaWNhdGlvbnNSZWFkUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIjQKGURlbGV0ZU5vdGlmaWNhdGlvblJlcXVlc3QSFwoPbm90aWZpY2F0aW9uX2lkGAEgASgJIjQKIURlbGV0ZUFsbFJlYWROb3RpZmljYXRpb25zUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIjsKIkRlbGV0ZUFsbFJlYWROb3RpZmljYXRpb25zUmVzcG9uc2USFQoNZGVsZXRlZF9jb3VudBgBIAEoBSI0CiFHZXRVbnJlYWROb3RpZmljYXRpb25Db3VudFJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSIzCiJHZXRVbnJlYWROb3RpZmljYXRpb25Db3VudFJlc3BvbnNlEg0KBWNvdW50GAEgASgFIowBChtHZXROb3RpZmljYXRpb25TdGF0c1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIuCgpzdGFydF9kYXRlGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfZGF0ZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiZgocR2V0Tm90aWZpY2F0aW9uU3RhdHNSZXNwb25zZRIxCgZjb3VudHMYASADKAsyIS5wZmluYW5jZS52MS5Ob3RpZmljYXRpb25EYXlDb3VudBITCgt0b3RhbF9jb3VudBgCIAEoBSI0CiFHZXROb3RpZmljYXRpb25QcmVmZXJlbmNlc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSJfCiJHZXROb3RpZmljYXRpb25QcmVmZXJlbmNlc1Jlc3BvbnNlEjkKC3ByZWZlcmVuY2VzGAEgASgLMiQucGZpbmFuY2UudjEuTm90aWZpY2F0aW9uUHJlZmVyZW5jZXMicgokVXBkYXRlTm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSOQoLcHJlZmVyZW5jZXMYAiABKAsyJC5wZmluYW5jZS52MS5Ob3RpZmljYXRpb25QcmVmZXJlbmNlcyJiCiVVcGRhdGVOb3RpZmljYXRpb25QcmVmZXJlbmNlc1Jlc3BvbnNlEjkKC3ByZWZlcmVuY2VzGAEgASgLMiQucGZpbmFuY2UudjEuTm90aWZpY2F0aW9uUHJlZmVyZW5jZXMiLgobR2VuZXJhdGVXZWVrbHlEaWdlc3RSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkiTQocR2VuZXJhdGVXZWVrbHlEaWdlc3RSZXNwb25zZRIXCg91c2Vyc19wcm9jZXNzZWQYASABKAUSFAoMZGlnZXN0c19zZW50GAIgASgFIs0CChBXZWVrbHlEaWdlc3REYXRhEhkKEXRvdGFsX3NwZW50X2NlbnRzGAEgASgDEhoKEnRvdGFsX2luY29tZV9jZW50cxgCIAEoAxIRCgluZXRfY2VudHMYAyABKAMSMwoOdG9wX2NhdGVnb3JpZXMYBCADKAsyGy5wZmluYW5jZS52MS5DYXRlZ29yeUFtb3VudBI6ChBidWRnZXRfc3VtbWFyaWVzGAUgAygLMiAucGZpbmFuY2UudjEuRGlnZXN0QnVkZ2V0U3VtbWFyeRI2Cg5nb2FsX3N1bW1hcmllcxgGIAMoCzIeLnBmaW5hbmNlLnYxLkRpZ2VzdEdvYWxTdW1tYXJ5EhwKFHVwY29taW5nX2JpbGxzX2NvdW50GAcgASgFEhQKDHBlcmlvZF9zdGFydBgIIAEoCRISCgpwZXJpb2RfZW5kGAkgASgJImcKE0RpZ2VzdEJ1ZGdldFN1bW1hcnkSDAoEbmFtZRgBIAEoCRITCgtzcGVudF9jZW50cxgCIAEoAxIUCgxidWRnZXRfY2VudHMYAyABKAMSFwoPcGVyY2VudGFnZV91c2VkGAQgASgBImsKEURpZ2VzdEdvYWxTdW1tYXJ5EgwKBG5hbWUYASABKAkSFQoNY3VycmVudF9jZW50cxgCIAEoAxIUCgx0YXJnZXRfY2VudHMYAyABKAMSGwoTcGVyY2VudGFnZV9jb21wbGV0ZRgEIAEoASJYChxDcmVhdGVDaGVja291dFNlc3Npb25SZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEwoLc3VjY2Vzc191cmwYAiABKAkSEgoKY2FuY2VsX3VybBgDIAEoCSJJCh1DcmVhdGVDaGVja291dFNlc3Npb25SZXNwb25zZRIUCgxjaGVja291dF91cmwYASABKAkSEgoKc2Vzc2lvbl9pZBgCIAEoCSIvChxHZXRTdWJzY3JpcHRpb25TdGF0dXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAki0wEKHUdldFN1YnNjcmlwdGlvblN0YXR1c1Jlc3BvbnNlEisKBHRpZXIYASABKA4yHS5wZmluYW5jZS52MS5TdWJzY3JpcHRpb25UaWVyEi8KBnN0YXR1cxgCIAEoDjIfLnBmaW5hbmNlLnYxLlN1YnNjcmlwdGlvblN0YXR1cxI2ChJjdXJyZW50X3BlcmlvZF9lbmQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhwKFGNhbmNlbF9hdF9wZXJpb2RfZW5kGAQgASgIIiwKGUNhbmNlbFN1YnNjcmlwdGlvblJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSJrChpDYW5jZWxTdWJzY3JpcHRpb25SZXNwb25zZRIvCgZzdGF0dXMYASABKA4yHy5wZmluYW5jZS52MS5TdWJzY3JpcHRpb25TdGF0dXMSHAoUY2FuY2VsX2F0X3BlcmlvZF9lbmQYAiABKAgiMgocVmVyaWZ5Q2hlY2tvdXRTZXNzaW9uUmVxdWVzdBISCgpzZXNzaW9uX2lkGAEgASgJIusBCh1WZXJpZnlDaGVja291dFNlc3Npb25SZXNwb25zZRIrCgR0aWVyGAEgASgOMh0ucGZpbmFuY2UudjEuU3Vic2NyaXB0aW9uVGllchIvCgZzdGF0dXMYAiABKA4yHy5wZmluYW5jZS52MS5TdWJzY3JpcHRpb25TdGF0dXMSNgoSY3VycmVudF9wZXJpb2RfZW5kGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIcChRjYW5jZWxfYXRfcGVyaW9kX2VuZBgEIAEoCBIWCg5hbHJlYWR5X2FjdGl2ZRgFIAEoCCK0AQoZR2V0RGFpbHlBZ2dyZWdhdGVzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEi4KCnN0YXJ0X2RhdGUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF9kYXRlGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIWCg5pbmNsdWRlX2luY29tZRgFIAEoCCLPAQoaR2V0RGFpbHlBZ2dyZWdhdGVzUmVzcG9uc2USLwoKYWdncmVnYXRlcxgBIAMoCzIbLnBmaW5hbmNlLnYxLkRhaWx5QWdncmVnYXRlEhgKEG1heF9kYWlseV9hbW91bnQYAiABKAESHgoWbWF4X2RhaWx5X2Ftb3VudF9jZW50cxgDIAEoAxIfChdtYXhfZGFpbHlfaW5jb21lX2Ftb3VudBgEIAEoARIlCh1tYXhfZGFpbHlfaW5jb21lX2Ftb3VudF9jZW50cxgFIAEoAyKXAgoYR2V0U3BlbmRpbmdUcmVuZHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSLQoLZ3JhbnVsYXJpdHkYAyABKA4yGC5wZmluYW5jZS52MS5HcmFudWxhcml0eRIPCgdwZXJpb2RzGAQgASgFEi4KCGNhdGVnb3J5GAUgASgOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5Ei4KDndlZWtfc3RhcnRzX29uGAYgASgOMhYucGZpbmFuY2UudjEuRGF5T2ZXZWVrEhgKEHNtb290aGluZ193aW5kb3cYByABKAUSHgoWY29tcGFyZV95ZWFyX292ZXJfeWVhchgIIAEoCCKnBAoZR2V0U3BlbmRpbmdUcmVuZHNSZXNwb25zZRI4Cg5leHBlbnNlX3NlcmllcxgBIAMoCzIgLnBmaW5hbmNlLnYxLlRpbWVTZXJpZXNEYXRhUG9pbnQSNwoNaW5jb21lX3NlcmllcxgCIAMoCzIgLnBmaW5hbmNlLnYxLlRpbWVTZXJpZXNEYXRhUG9pbnQSEwoLdHJlbmRfc2xvcGUYAyABKAESFwoPdHJlbmRfcl9zcXVhcmVkGAQgASgBEkEKF3Ntb290aGVkX2V4cGVuc2Vfc2VyaWVzGAUgAygLMiAucGZpbmFuY2UudjEuVGltZVNlcmllc0RhdGFQb2ludBJAChZzbW9vdGhlZF9pbmNvbWVfc2VyaWVzGAYgAygLMiAucGZpbmFuY2UudjEuVGltZVNlcmllc0RhdGFQb2ludBJDChlwcmlvcl95ZWFyX2V4cGVuc2Vfc2VyaWVzGAcgAygLMiAucGZpbmFuY2UudjEuVGltZVNlcmllc0RhdGFQb2ludBJCChhwcmlvcl95ZWFyX2luY29tZV9zZXJpZXMYCCADKAsyIC5wZmluYW5jZS52MS5UaW1lU2VyaWVzRGF0YVBvaW50Ei0KJWV4cGVuc2VfeWVhcl9vdmVyX3llYXJfY2hhbmdlX3BlcmNlbnQYCSADKAESLAokaW5jb21lX3llYXJfb3Zlcl95ZWFyX2NoYW5nZV9wZXJjZW50GAogAygBIuYBChxHZXRDYXRlZ29yeUNvbXBhcmlzb25SZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSFgoOY3VycmVudF9wZXJpb2QYAyABKAkSFwoPaW5jbHVkZV9idWRnZXRzGAQgASgIEhoKEmluY2x1ZGVfdG90YWxzX3JvdxgFIAEoCBI0ChBmb3J0bmlnaHRfYW5jaG9yGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIgChhpbmNsdWRlX3RvcF90cmFuc2FjdGlvbnMYByABKAUiUgodR2V0Q2F0ZWdvcnlDb21wYXJpc29uUmVzcG9uc2USMQoKY2F0ZWdvcmllcxgBIAMoCzIdLnBmaW5hbmNlLnYxLkNhdGVnb3J5U3BlbmRpbmciwwEKFkRldGVjdEFub21hbGllc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRIVCg1sb29rYmFja19kYXlzGAMgASgFEhMKC3NlbnNpdGl2aXR5GAQgASgBEhwKFHVzZV9zdG9yZWRfYmFzZWxpbmVzGAUgASgIEh0KFW1lcmNoYW50X2hpc3RvcnlfZGF5cxgGIAEoBRIdChVpbmNsdWRlX3RpbWVfb3V0bGllcnMYByABKAgixQEKF0RldGVjdEFub21hbGllc1Jlc3BvbnNlEi8KCWFub21hbGllcxgBIAMoCzIcLnBmaW5hbmNlLnYxLlNwZW5kaW5nQW5vbWFseRIXCg90b3RhbF9hbm9tYWxpZXMYAiABKAUSHQoVYW5vbWFsb3VzX3NwZW5kX3RvdGFsGAMgASgBEiMKG2Fub21hbG91c19zcGVuZF90b3RhbF9jZW50cxgEIAEoAxIcChR0b3BfYW5vbWFseV9jYXRlZ29yeRgFIAEoCSKmAQoaR2V0Q2FzaEZsb3dGb3JlY2FzdFJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRIVCg1mb3JlY2FzdF9kYXlzGAMgASgFEhgKEGNvbmZpZGVuY2VfbGV2ZWwYBCABKAESHgoWc3RhcnRpbmdfYmFsYW5jZV9jZW50cxgFIAEoAxIUCgxoaXN0b3J5X2RheXMYBiABKAUi/AMKG0dldENhc2hGbG93Rm9yZWNhc3RSZXNwb25zZRIzCg9pbmNvbWVfZm9yZWNhc3QYASADKAsyGi5wZmluYW5jZS52MS5Gb3JlY2FzdFBvaW50EjQKEGV4cGVuc2VfZm9yZWNhc3QYAiADKAsyGi5wZmluYW5jZS52MS5Gb3JlY2FzdFBvaW50EjAKDG5ldF9mb3JlY2FzdBgDIAMoCzIaLnBmaW5hbmNlLnYxLkZvcmVjYXN0UG9pbnQSOAoOaW5jb21lX2hpc3RvcnkYBCADKAsyIC5wZmluYW5jZS52MS5UaW1lU2VyaWVzRGF0YVBvaW50EjkKD2V4cGVuc2VfaGlzdG9yeRgFIAMoCzIgLnBmaW5hbmNlLnYxLlRpbWVTZXJpZXNEYXRhUG9pbnQSGAoQY29uZmlkZW5jZV9sZXZlbBgGIAEoARI6ChBiYWxhbmNlX2ZvcmVjYXN0GAcgAygLMiAucGZpbmFuY2UudjEuVGltZVNlcmllc0RhdGFQb2ludBITCgttaW5fYmFsYW5jZRgIIAEoARIZChFtaW5fYmFsYW5jZV9jZW50cxgJIAEoAxIYChBtaW5fYmFsYW5jZV9kYXRlGAogASgJEhUKDWdvZXNfbmVnYXRpdmUYCyABKAgSFAoMaGlzdG9yeV9kYXlzGAwgASgFIqEBChdHZXRXYXRlcmZhbGxEYXRhUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEg4KBnBlcmlvZBgDIAEoCRIQCghncm91cF9ieRgEIAEoCRIgChhwcm9qZWN0X3RvX2VuZF9vZl9wZXJpb2QYBSABKAgSHwoXaW5jbHVkZV9wcmV2aW91c19wZXJpb2QYBiABKAgisgEKGEdldFdhdGVyZmFsbERhdGFSZXNwb25zZRIsCgdlbnRyaWVzGAEgAygLMhsucGZpbmFuY2UudjEuV2F0ZXJmYWxsRW50cnkSFAoMcGVyaW9kX2xhYmVsGAIgASgJEhQKDHNhdmluZ3NfcmF0ZRgDIAEoARIiChVwcmV2aW91c19zYXZpbmdzX3JhdGUYBCABKAFIAIgBAUIYChZfcHJldmlvdXNfc2F2aW5nc19yYXRlIlUKF1JlY29tbWVuZEJ1ZGdldHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSFwoPbG9va2JhY2tfbW9udGhzGAMgASgFIm8KGFJlY29tbWVuZEJ1ZGdldHNSZXNwb25zZRI6Cg9yZWNvbW1lbmRhdGlvbnMYASADKAsyIS5wZmluYW5jZS52MS5CdWRnZXRSZWNvbW1lbmRhdGlvbhIXCg9sb29rYmFja19tb250aHMYAiABKAUiqAEKF0dldFNwZW5kaW5nQnlUYWdSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSLgoKc3RhcnRfZGF0ZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX2RhdGUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEgwKBHRhZ3MYBSADKAkikgEKGEdldFNwZW5kaW5nQnlUYWdSZXNwb25zZRImCgR0YWdzGAEgAygLMhgucGZpbmFuY2UudjEuVGFnU3BlbmRpbmcSFwoPdW50YWdnZWRfYW1vdW50GAIgASgBEh0KFXVudGFnZ2VkX2Ftb3VudF9jZW50cxgDIAEoAxIWCg51bnRhZ2dlZF9jb3VudBgEIAEoBSJfChhTdWJtaXRDb3JyZWN0aW9uc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIyCgtjb3JyZWN0aW9ucxgCIAMoCzIdLnBmaW5hbmNlLnYxLkNvcnJlY3Rpb25SZWNvcmQiVwoZU3VibWl0Q29ycmVjdGlvbnNSZXNwb25zZRIXCg9wcm9jZXNzZWRfY291bnQYASABKAUSIQoZbWVyY2hhbnRfbWFwcGluZ3NfdXBkYXRlZBgCIAEoBSJ0ChZDaGVja0R1cGxpY2F0ZXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSNwoMdHJhbnNhY3Rpb25zGAMgAygLMiEucGZpbmFuY2UudjEuRXh0cmFjdGVkVHJhbnNhY3Rpb24iuwEKF0NoZWNrRHVwbGljYXRlc1Jlc3BvbnNlEkgKCmR1cGxpY2F0ZXMYASADKAsyNC5wZmluYW5jZS52MS5DaGVja0R1cGxpY2F0ZXNSZXNwb25zZS5EdXBsaWNhdGVzRW50cnkaVgoPRHVwbGljYXRlc0VudHJ5EgsKA2tleRgBIAEoCRIyCgV2YWx1ZRgCIAEoCzIjLnBmaW5hbmNlLnYxLkR1cGxpY2F0ZUNhbmRpZGF0ZUxpc3Q6AjgBIk0KFkR1cGxpY2F0ZUNhbmRpZGF0ZUxpc3QSMwoKY2FuZGlkYXRlcxgBIAMoCzIfLnBmaW5hbmNlLnYxLkR1cGxpY2F0ZUNhbmRpZGF0ZSJtChRNZXJnZUV4cGVuc2VzUmVxdWVzdBIaChJwcmltYXJ5X2V4cGVuc2VfaWQYASABKAkSHAoUc2Vjb25kYXJ5X2V4cGVuc2VfaWQYAiABKAkSGwoTa2VlcF9wcmltYXJ5X2Ftb3VudBgDIAEoCCJxChVNZXJnZUV4cGVuc2VzUmVzcG9uc2USJQoHZXhwZW5zZRgBIAEoCzIULnBmaW5hbmNlLnYxLkV4cGVuc2USMQoKY29ycmVjdGlvbhgCIAEoCzIdLnBmaW5hbmNlLnYxLkNvcnJlY3Rpb25SZWNvcmQiRwodR2V0TWVyY2hhbnRTdWdnZXN0aW9uc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIVCg1tZXJjaGFudF90ZXh0GAIgASgJIpYBCh5HZXRNZXJjaGFudFN1Z2dlc3Rpb25zUmVzcG9uc2USFgoOc3VnZ2VzdGVkX25hbWUYASABKAkSOAoSc3VnZ2VzdGVkX2NhdGVnb3J5GAIgASgOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5EhIKCmNvbmZpZGVuY2UYAyABKAESDgoGc291cmNlGAQgASgJIpcBChtMaXN0TWVyY2hhbnRNYXBwaW5nc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRINCgVxdWVyeRgCIAEoCRIxCgdzb3J0X2J5GAMgASgOMiAucGZpbmFuY2UudjEuTWVyY2hhbnRNYXBwaW5nU29ydBIRCglwYWdlX3NpemUYBCABKAUSEgoKcGFnZV90b2tlbhgFIAEoCSJnChxMaXN0TWVyY2hhbnRNYXBwaW5nc1Jlc3BvbnNlEi4KCG1hcHBpbmdzGAEgAygLMhwucGZpbmFuY2UudjEuTWVyY2hhbnRNYXBwaW5nEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJEChxEZWxldGVNZXJjaGFudE1hcHBpbmdSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEwoLcmF3X3BhdHRlcm4YAiABKAkiHwodRGVsZXRlTWVyY2hhbnRNYXBwaW5nUmVzcG9uc2UiZQoiQmF0Y2hVcHNlcnRNZXJjaGFudE1hcHBpbmdzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEi4KCG1hcHBpbmdzGAIgAygLMhwucGZpbmFuY2UudjEuTWVyY2hhbnRNYXBwaW5nIk0KF1JlamVjdGVkTWVyY2hhbnRNYXBwaW5nEg0KBWluZGV4GAEgASgFEhMKC3Jhd19wYXR0ZXJuGAIgASgJEg4KBnJlYXNvbhgDIAEoCSKLAQojQmF0Y2hVcHNlcnRNZXJjaGFudE1hcHBpbmdzUmVzcG9uc2USFQoNY3JlYXRlZF9jb3VudBgBIAEoBRIVCg11cGRhdGVkX2NvdW50GAIgASgFEjYKCHJlamVjdGVkGAMgAygLMiQucGZpbmFuY2UudjEuUmVqZWN0ZWRNZXJjaGFudE1hcHBpbmciPAobR2V0RXh0cmFjdGlvbk1ldHJpY3NSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSDAoEZGF5cxgCIAEoBSKbBAocR2V0RXh0cmFjdGlvbk1ldHJpY3NSZXNwb25zZRIZChF0b3RhbF9leHRyYWN0aW9ucxgBIAEoBRIaChJ0b3RhbF90cmFuc2FjdGlvbnMYAiABKAUSGQoRdG90YWxfY29ycmVjdGlvbnMYAyABKAUSFwoPY29ycmVjdGlvbl9yYXRlGAQgASgBEhoKEmF2ZXJhZ2VfY29uZmlkZW5jZRgFIAEoARJfChRjb3JyZWN0aW9uc19ieV9maWVsZBgGIAMoCzJBLnBmaW5hbmNlLnYxLkdldEV4dHJhY3Rpb25NZXRyaWNzUmVzcG9uc2UuQ29ycmVjdGlvbnNCeUZpZWxkRW50cnkSZQoXY29ycmVjdGlvbnNfYnlfY2F0ZWdvcnkYByADKAsyRC5wZmluYW5jZS52MS5HZXRFeHRyYWN0aW9uTWV0cmljc1Jlc3BvbnNlLkNvcnJlY3Rpb25zQnlDYXRlZ29yeUVudHJ5EjMKDXJlY2VudF9ldmVudHMYCCADKAsyHC5wZmluYW5jZS52MS5FeHRyYWN0aW9uRXZlbnQaOQoXQ29ycmVjdGlvbnNCeUZpZWxkRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ARo8ChpDb3JyZWN0aW9uc0J5Q2F0ZWdvcnlFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAU6AjgBIi4KG0dldENhdGVnb3J5T3ZlcnJpZGVzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIlAKHEdldENhdGVnb3J5T3ZlcnJpZGVzUmVzcG9uc2USMAoJb3ZlcnJpZGVzGAEgAygLMh0ucGZpbmFuY2UudjEuQ2F0ZWdvcnlPdmVycmlkZSJ6ChpTZXRDYXRlZ29yeU92ZXJyaWRlUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhsKE21lcmNoYW50X25vcm1hbGl6ZWQYAiABKAkSLgoIY2F0ZWdvcnkYAyABKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkiTgobU2V0Q2F0ZWdvcnlPdmVycmlkZVJlc3BvbnNlEi8KCG92ZXJyaWRlGAEgASgLMh0ucGZpbmFuY2UudjEuQ2F0ZWdvcnlPdmVycmlkZSJNCh1EZWxldGVDYXRlZ29yeU92ZXJyaWRlUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhsKE21lcmNoYW50X25vcm1hbGl6ZWQYAiABKAkiIAoeRGVsZXRlQ2F0ZWdvcnlPdmVycmlkZVJlc3BvbnNlIncKFEdldFRheFN1bW1hcnlSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSFgoOZmluYW5jaWFsX3llYXIYAiABKAkSHQoVcHJpb3JfeWVhcl9sb3NzX2NlbnRzGAMgASgDEhcKD2lzX25vbl9yZXNpZGVudBgEIAEoCCJJChVHZXRUYXhTdW1tYXJ5UmVzcG9uc2USMAoLY2FsY3VsYXRpb24YASABKAsyGy5wZmluYW5jZS52MS5UYXhDYWxjdWxhdGlvbiL4AgoVR2V0VGF4RXN0aW1hdGVSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSFgoOZmluYW5jaWFsX3llYXIYAiABKAkSIwobZ3Jvc3NfaW5jb21lX292ZXJyaWRlX2NlbnRzGAMgASgDEh0KFWdyb3NzX2luY29tZV9vdmVycmlkZRgEIAEoARIjChthZGRpdGlvbmFsX2RlZHVjdGlvbnNfY2VudHMYBSABKAMSHQoVYWRkaXRpb25hbF9kZWR1Y3Rpb25zGAYgASgBEhQKDGluY2x1ZGVfaGVscBgHIAEoCBIaChJtZWRpY2FyZV9leGVtcHRpb24YCCABKAgSHQoVcHJpb3JfeWVhcl9sb3NzX2NlbnRzGAkgASgDEhcKD2lzX25vbl9yZXNpZGVudBgKIAEoCBIbChNjYXBpdGFsX2dhaW5zX2NlbnRzGAsgASgDEicKH2NhcGl0YWxfZ2FpbnNfZGlzY291bnRfZWxpZ2libGUYDCABKAgiSgoWR2V0VGF4RXN0aW1hdGVSZXNwb25zZRIwCgtjYWxjdWxhdGlvbhgBIAEoCzIbLnBmaW5hbmNlLnYxLlRheENhbGN1bGF0aW9uIsABChBFeHBlbnNlVGF4VXBkYXRlEhIKCmV4cGVuc2VfaWQYASABKAkSGQoRaXNfdGF4X2RlZHVjdGlibGUYAiABKAgSQQoWdGF4X2RlZHVjdGlvbl9jYXRlZ29yeRgDIAEoDjIhLnBmaW5hbmNlLnYxLlRheERlZHVjdGlvbkNhdGVnb3J5EhoKEnRheF9kZWR1Y3Rpb25fbm90ZRgEIAEoCRIeChZ0YXhfZGVkdWN0aWJsZV9wZXJjZW50GAUgASgBImUKIkJhdGNoVXBkYXRlRXhwZW5zZVRheFN0YXR1c1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIuCgd1cGRhdGVzGAIgAygLMh0ucGZpbmFuY2UudjEuRXhwZW5zZVRheFVwZGF0ZSJYCiNCYXRjaFVwZGF0ZUV4cGVuc2VUYXhTdGF0dXNSZXNwb25zZRIVCg11cGRhdGVkX2NvdW50GAEgASgFEhoKEmZhaWxlZF9leHBlbnNlX2lkcxgCIAMoCSK2AQodTGlzdERlZHVjdGlibGVFeHBlbnNlc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRIWCg5maW5hbmNpYWxfeWVhchgDIAEoCRIzCghjYXRlZ29yeRgEIAEoDjIhLnBmaW5hbmNlLnYxLlRheERlZHVjdGlvbkNhdGVnb3J5EhEKCXBhZ2Vfc2l6ZRgFIAEoBRISCgpwYWdlX3Rva2VuGAYgASgJIpsBCh5MaXN0RGVkdWN0aWJsZUV4cGVuc2VzUmVzcG9uc2USJgoIZXhwZW5zZXMYASADKAsyFC5wZmluYW5jZS52MS5FeHBlbnNlEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRIeChZ0b3RhbF9kZWR1Y3RpYmxlX2NlbnRzGAMgASgDEhgKEHRvdGFsX2RlZHVjdGlibGUYBCABKAEiYQoTVGF4RmllbGRDb25maWRlbmNlcxIVCg1pc19kZWR1Y3RpYmxlGAEgASgBEhQKDGF0b19jYXRlZ29yeRgCIAEoARIdChVkZWR1Y3RpYmxlX3BlcmNlbnRhZ2UYAyABKAEipQIKF1RheENsYXNzaWZpY2F0aW9uUmVzdWx0EhIKCmV4cGVuc2VfaWQYASABKAkSFQoNaXNfZGVkdWN0aWJsZRgCIAEoCBIzCghjYXRlZ29yeRgDIAEoDjIhLnBmaW5hbmNlLnYxLlRheERlZHVjdGlvbkNhdGVnb3J5EhoKEmRlZHVjdGlibGVfcGVyY2VudBgEIAEoARISCgpjb25maWRlbmNlGAUgASgBEhEKCXJlYXNvbmluZxgGIAEoCRIUCgxhdXRvX2FwcGxpZWQYByABKAgSFAoMbmVlZHNfcmV2aWV3GAggASgIEjsKEWZpZWxkX2NvbmZpZGVuY2VzGAkgASgLMiAucGZpbmFuY2UudjEuVGF4RmllbGRDb25maWRlbmNlcyLKAQofQ2xhc3NpZnlUYXhEZWR1Y3RpYmlsaXR5UmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhIKCmV4cGVuc2VfaWQYAiABKAkSEgoKb2NjdXBhdGlvbhgDIAEoCRIhChRhdXRvX2FwcGx5X3RocmVzaG9sZBgEIAEoAUgAiAEBEh0KEHJldmlld190aHJlc2hvbGQYBSABKAFIAYgBAUIXChVfYXV0b19hcHBseV90aHJlc2hvbGRCEwoRX3Jldmlld190aHJlc2hvbGQiWAogQ2xhc3NpZnlUYXhEZWR1Y3RpYmlsaXR5UmVzcG9uc2USNAoGcmVzdWx0GAEgASgLMiQucGZpbmFuY2UudjEuVGF4Q2xhc3NpZmljYXRpb25SZXN1bHQi5wEKJEJhdGNoQ2xhc3NpZnlUYXhEZWR1Y3RpYmlsaXR5UmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhYKDmZpbmFuY2lhbF95ZWFyGAIgASgJEhIKCm9jY3VwYXRpb24YAyABKAkSEgoKYXV0b19hcHBseRgEIAEoCBIhChRhdXRvX2FwcGx5X3RocmVzaG9sZBgFIAEoAUgAiAEBEh0KEHJldmlld190aHJlc2hvbGQYBiABKAFIAYgBAUIXChVfYXV0b19hcHBseV90aHJlc2hvbGRCEwoRX3Jldmlld190aHJlc2hvbGQitAEKJUJhdGNoQ2xhc3NpZnlUYXhEZWR1Y3RpYmlsaXR5UmVzcG9uc2USFwoPdG90YWxfcHJvY2Vzc2VkGAEgASgFEhQKDGF1dG9fYXBwbGllZBgCIAEoBRIUCgxuZWVkc19yZXZpZXcYAyABKAUSDwoHc2tpcHBlZBgEIAEoBRI1CgdyZXN1bHRzGAUgAygLMiQucGZpbmFuY2UudjEuVGF4Q2xhc3NpZmljYXRpb25SZXN1bHQitQEKFkV4cG9ydFRheFJldHVyblJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIWCg5maW5hbmNpYWxfeWVhchgCIAEoCRIsCgZmb3JtYXQYAyABKA4yHC5wZmluYW5jZS52MS5UYXhFeHBvcnRGb3JtYXQSFwoPaXNfbm9uX3Jlc2lkZW50GAQgASgIEisKB29wdGlvbnMYBSABKAsyGi5wZmluYW5jZS52MS5FeHBvcnRPcHRpb25zIigKDUV4cG9ydE9wdGlvbnMSFwoPcm91bmRfdG9fZG9sbGFyGAEgASgIIoEBChdFeHBvcnRUYXhSZXR1cm5SZXNwb25zZRIMCgRkYXRhGAEgASgMEhAKCGZpbGVuYW1lGAIgASgJEhQKDGNvbnRlbnRfdHlwZRgDIAEoCRIwCgtjYWxjdWxhdGlvbhgEIAEoCzIbLnBmaW5hbmNlLnYxLlRheENhbGN1bGF0aW9uIncKH0V4cG9ydFRyYW5zYWN0aW9uc1N0cmVhbVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIWCg5maW5hbmNpYWxfeWVhchgCIAEoCRIXCg9kZWR1Y3RpYmxlX29ubHkYAyABKAgSEgoKYmF0Y2hfc2l6ZRgEIAEoBSJrCiBFeHBvcnRUcmFuc2FjdGlvbnNTdHJlYW1SZXNwb25zZRIMCgRkYXRhGAEgASgMEhAKCGZpbGVuYW1lGAIgASgJEhQKDGNvbnRlbnRfdHlwZRgDIAEoCRIRCglyb3dfY291bnQYBCABKAUiOQoVQ3JlYXRlQXBpVG9rZW5SZXF1ZXN0EgwKBG5hbWUYASABKAkSEgoKcmF0ZV9saW1pdBgCIAEoBSJRChZDcmVhdGVBcGlUb2tlblJlc3BvbnNlEg0KBXRva2VuGAEgASgJEigKCWFwaV90b2tlbhgCIAEoCzIVLnBmaW5hbmNlLnYxLkFwaVRva2VuIhYKFExpc3RBcGlUb2tlbnNSZXF1ZXN0Ij4KFUxpc3RBcGlUb2tlbnNSZXNwb25zZRIlCgZ0b2tlbnMYASADKAsyFS5wZmluYW5jZS52MS5BcGlUb2tlbiIpChVSZXZva2VBcGlUb2tlblJlcXVlc3QSEAoIdG9rZW5faWQYASABKAkiGAoWUmV2b2tlQXBpVG9rZW5SZXNwb25zZSJCChpCYXRjaERlbGV0ZUV4cGVuc2VzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhMKC2V4cGVuc2VfaWRzGAIgAygJIlAKG0JhdGNoRGVsZXRlRXhwZW5zZXNSZXNwb25zZRIVCg1kZWxldGVkX2NvdW50GAEgASgFEhoKEmZhaWxlZF9leHBlbnNlX2lkcxgCIAMoCSJAChlCYXRjaERlbGV0ZUluY29tZXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEgoKaW5jb21lX2lkcxgCIAMoCSJOChpCYXRjaERlbGV0ZUluY29tZXNSZXNwb25zZRIVCg1kZWxldGVkX2NvdW50GAEgASgFEhkKEWZhaWxlZF9pbmNvbWVfaWRzGAIgAygJImEKG0FkZEV4cGVuc2VBdHRhY2htZW50UmVxdWVzdBISCgpleHBlbnNlX2lkGAEgASgJEi4KCmF0dGFjaG1lbnQYAiABKAsyGi5wZmluYW5jZS52MS5BdHRhY2htZW50UmVmIkUKHEFkZEV4cGVuc2VBdHRhY2htZW50UmVzcG9uc2USJQoHZXhwZW5zZRgBIAEoCzIULnBmaW5hbmNlLnYxLkV4cGVuc2UiSgoeUmVtb3ZlRXhwZW5zZUF0dGFjaG1lbnRSZXF1ZXN0EhIKCmV4cGVuc2VfaWQYASABKAkSFAoMc3RvcmFnZV9wYXRoGAIgASgJIkgKH1JlbW92ZUV4cGVuc2VBdHRhY2htZW50UmVzcG9uc2USJQoHZXhwZW5zZRgBIAEoCzIULnBmaW5hbmNlLnYxLkV4cGVuc2UiQAoVRXhwb3J0UmVjZWlwdHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSFgoOZmluYW5jaWFsX3llYXIYAiABKAkiZQoWRXhwb3J0UmVjZWlwdHNSZXNwb25zZRIMCgRkYXRhGAEgASgMEhAKCGZpbGVuYW1lGAIgASgJEhQKDGNvbnRlbnRfdHlwZRgDIAEoCRIVCg1yZWNlaXB0X2NvdW50GAQgASgFIl0KHkZpbmRQb3RlbnRpYWxEZWR1Y3Rpb25zUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhYKDmZpbmFuY2lhbF95ZWFyGAIgASgJEhIKCm9jY3VwYXRpb24YAyABKAkitgEKH0ZpbmRQb3RlbnRpYWxEZWR1Y3Rpb25zUmVzcG9uc2USNAoLc3VnZ2VzdGlvbnMYASADKAsyHy5wZmluYW5jZS52MS5Qb3RlbnRpYWxEZWR1Y3Rpb24SJQoddG90YWxfcG90ZW50aWFsX3NhdmluZ3NfY2VudHMYAiABKAMSHwoXdG90YWxfcG90ZW50aWFsX3NhdmluZ3MYAyABKAESFQoNc2Nhbm5lZF9jb3VudBgEIAEoBSJNCh9HZXRUYXhEZWR1Y3Rpb25DaGVja2xpc3RSZXF1ZXN0EhYKDmZpbmFuY2lhbF95ZWFyGAEgASgJEhIKCm9jY3VwYXRpb24YAiABKAkipAEKIEdldFRheERlZHVjdGlvbkNoZWNrbGlzdFJlc3BvbnNlEjUKBWl0ZW1zGAEgAygLMiYucGZpbmFuY2UudjEuVGF4RGVkdWN0aW9uQ2hlY2tsaXN0SXRlbRIaChJtYXRjaGVkX29jY3VwYXRpb24YAiABKAkSFgoOZmluYW5jaWFsX3llYXIYAyABKAkSFQoNbWlzc2luZ19jb3VudBgEIAEoBSJiChZDb21wYXJlVGF4WWVhcnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSDgoGeWVhcl9hGAIgASgJEg4KBnllYXJfYhgDIAEoCRIXCg9pc19ub25fcmVzaWRlbnQYBCABKAgiTQoXQ29tcGFyZVRheFllYXJzUmVzcG9uc2USMgoKY29tcGFyaXNvbhgBIAEoCzIeLnBmaW5hbmNlLnYxLlRheFllYXJDb21wYXJpc29uIkYKG0dldERlZHVjdGlvblByb2dyZXNzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhYKDmZpbmFuY2lhbF95ZWFyGAIgASgJIosCChxHZXREZWR1Y3Rpb25Qcm9ncmVzc1Jlc3BvbnNlEhYKDmZpbmFuY2lhbF95ZWFyGAEgASgJEhwKFHByaW9yX2ZpbmFuY2lhbF95ZWFyGAIgASgJEhsKE2N1cnJlbnRfdG90YWxfY2VudHMYAyABKAMSGQoRcHJpb3JfdG90YWxfY2VudHMYBCABKAMSFAoMY2hhbmdlX2NlbnRzGAUgASgDEhYKDmNoYW5nZV9wZXJjZW50GAYgASgBEjMKD2NhdGVnb3J5X2RlbHRhcxgHIAMoCzIaLnBmaW5hbmNlLnYxLkNhdGVnb3J5RGVsdGESGgoSbmVlZHNfcmV2aWV3X2NvdW50GAggASgFIlMKGExvY2tGaW5hbmNpYWxZZWFyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhYKDmZpbmFuY2lhbF95ZWFyGAIgASgJEg4KBnJlYXNvbhgDIAEoCSIxChlMb2NrRmluYW5jaWFsWWVhclJlc3BvbnNlEhQKDGxvY2tlZF9jb3VudBgBIAEoBSJFChpVbmxvY2tGaW5hbmNpYWxZZWFyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhYKDmZpbmFuY2lhbF95ZWFyGAIgASgJIjUKG1VubG9ja0ZpbmFuY2lhbFllYXJSZXNwb25zZRIWCg51bmxvY2tlZF9jb3VudBgBIAEoBSJpChRHZXRHc3RTdW1tYXJ5UmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEi4KCmFzX29mX2RhdGUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIogCChVHZXRHc3RTdW1tYXJ5UmVzcG9uc2USMAoMcGVyaW9kX3N0YXJ0GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpwZXJpb2RfZW5kGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxwZXJpb2RfbGFiZWwYAyABKAkSGwoTZ3N0X2NvbGxlY3RlZF9jZW50cxgEIAEoAxIWCg5nc3RfcGFpZF9jZW50cxgFIAEoAxIVCg1uZXRfZ3N0X2NlbnRzGAYgASgDEhQKDGluY29tZV9jb3VudBgHIAEoBRIVCg1leHBlbnNlX2NvdW50GAggASgFIi0KGFJlZ2lzdGVyUHVzaFRva2VuUmVxdWVzdBIRCglmY21fdG9rZW4YASABKAkiGwoZUmVnaXN0ZXJQdXNoVG9rZW5SZXNwb25zZSIcChpVbnJlZ2lzdGVyUHVzaFRva2VuUmVxdWVzdCIdChtVbnJlZ2lzdGVyUHVzaFRva2VuUmVzcG9uc2UiYgoRUnVuVGF4RXZhbFJlcXVlc3QSFAoMZGF0YXNldF9wYXRoGAEgASgJEg4KBm1ldGhvZBgCIAEoCRISCgpvY2N1cGF0aW9uGAMgASgJEhMKC2NvbmN1cnJlbmN5GAQgASgFIiQKElJ1blRheEV2YWxSZXNwb25zZRIOCgZqb2JfaWQYASABKAkiJgoUR2V0VGF4RXZhbEpvYlJlcXVlc3QSDgoGam9iX2lkGAEgASgJIj0KFUdldFRheEV2YWxKb2JSZXNwb25zZRIkCgNqb2IYASABKAsyFy5wZmluYW5jZS52MS5UYXhFdmFsSm9iIpUCCgpUYXhFdmFsSm9iEgoKAmlkGAEgASgJEg4KBnN0YXR1cxgCIAEoCRITCgt0b3RhbF9maWxlcxgDIAEoBRIXCg9wcm9jZXNzZWRfZmlsZXMYBCABKAUSGAoQcHJvZ3Jlc3NfcGVyY2VudBgFIAEoBRIVCg1lcnJvcl9tZXNzYWdlGAYgASgJEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDGNvbXBsZXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKgoGcmVzdWx0GAkgASgLMhoucGZpbmFuY2UudjEuVGF4RXZhbFJlc3VsdCLOBAoNVGF4RXZhbFJlc3VsdBITCgtkdXJhdGlvbl9tcxgBIAEoAxIUCgxkYXRhc2V0X3BhdGgYAiABKAkSDgoGbWV0aG9kGAMgASgJEhIKCm9jY3VwYXRpb24YBCABKAkSEwoLY29uY3VycmVuY3kYBSABKAUSEwoLdG90YWxfZmlsZXMYBiABKAUSGAoQc3VjY2Vzc2Z1bF9maWxlcxgHIAEoBRIUCgxmYWlsZWRfZmlsZXMYCCABKAUSGgoSdG90YWxfdHJhbnNhY3Rpb25zGAkgASgFEhgKEHRvdGFsX2RlZHVjdGlibGUYCiABKAUSHAoUdG90YWxfbm9uX2RlZHVjdGlibGUYCyABKAUSFgoOYXZnX2NvbmZpZGVuY2UYDCABKAESGQoRYXZnX3Byb2Nlc3NpbmdfbXMYDSABKAESFwoPdG90YWxfYXBpX2NhbGxzGA4gASgFEhoKEmVzdGltYXRlZF9jb3N0X3VzZBgPIAEoARI5CgpkZWR1Y3Rpb25zGBAgAygLMiUucGZpbmFuY2UudjEuVGF4RXZhbERlZHVjdGlvbkNhdGVnb3J5EjQKDGZpbGVfcmVzdWx0cxgRIAMoCzIeLnBmaW5hbmNlLnYxLlRheEV2YWxGaWxlUmVzdWx0EhYKDnRvdGFsX2V4cGVuc2VzGBIgASgBEh8KF3RvdGFsX2RlZHVjdGlvbnNfYW1vdW50GBMgASgBEi4KCGFjY3VyYWN5GBQgASgLMhwucGZpbmFuY2UudjEuVGF4RXZhbEFjY3VyYWN5IqQBChhUYXhFdmFsRGVkdWN0aW9uQ2F0ZWdvcnkSDAoEY29kZRgBIAEoCRIMCgRuYW1lGAIgASgJEhIKCml0ZW1fY291bnQYAyABKAUSFAoMdG90YWxfYW1vdW50GAQgASgBEhkKEWRlZHVjdGlibGVfYW1vdW50GAUgASgBEicKBWl0ZW1zGAYgAygLMhgucGZpbmFuY2UudjEuVGF4RXZhbEl0ZW0iigIKEVRheEV2YWxGaWxlUmVzdWx0EhAKCGZpbGVuYW1lGAEgASgJEhUKDXJlbGF0aXZlX3BhdGgYAiABKAkSEAoIY2F0ZWdvcnkYAyABKAkSFwoPZmlsZV9zaXplX2J5dGVzGAQgASgDEhUKDXByb2Nlc3NpbmdfbXMYBSABKAMSDQoFZXJyb3IYBiABKAkSGQoRdHJhbnNhY3Rpb25fY291bnQYByABKAUSGgoSb3ZlcmFsbF9jb25maWRlbmNlGAggASgBEhUKDWRvY3VtZW50X3R5cGUYCSABKAkSLQoLdGF4X3Jlc3VsdHMYCiADKAsyGC5wZmluYW5jZS52MS5UYXhFdmFsSXRlbSKKAgoLVGF4RXZhbEl0ZW0SEwoLZGVzY3JpcHRpb24YASABKAkSDgoGYW1vdW50GAIgASgBEgwKBGRhdGUYAyABKAkSGAoQZXhwZW5zZV9jYXRlZ29yeRgEIAEoCRIVCg1pc19kZWR1Y3RpYmxlGAUgASgIEhQKDHRheF9jYXRlZ29yeRgGIAEoCRIaChJkZWR1Y3RpYmxlX3BlcmNlbnQYByABKAESGQoRZGVkdWN0aWJsZV9hbW91bnQYCCABKAESEgoKY29uZmlkZW5jZRgJIAEoARIRCglyZWFzb25pbmcYCiABKAkSDgoGc291cmNlGAsgASgJEhMKC3NvdXJjZV9maWxlGAwgASgJIuICCg9UYXhFdmFsQWNjdXJhY3kSHwoXZmlsZXNfd2l0aF9ncm91bmRfdHJ1dGgYASABKAUSFwoPZmlsZXNfZXZhbHVhdGVkGAIgASgFEjoKCmV4dHJhY3Rpb24YAyABKAsyJi5wZmluYW5jZS52MS5UYXhFdmFsRXh0cmFjdGlvbkFjY3VyYWN5EjgKDWRlZHVjdGliaWxpdHkYBCABKAsyIS5wZmluYW5jZS52MS5UYXhFdmFsQ2xhc3NBY2N1cmFjeRI3Cgx0YXhfY2F0ZWdvcnkYBSABKAsyIS5wZmluYW5jZS52MS5UYXhFdmFsQ2xhc3NBY2N1cmFjeRIyCgZhbW91bnQYBiABKAsyIi5wZmluYW5jZS52MS5UYXhFdmFsQW1vdW50QWNjdXJhY3kSMgoIcGVyX2ZpbGUYByADKAsyIC5wZmluYW5jZS52MS5UYXhFdmFsRmlsZUFjY3VyYWN5IpIBChlUYXhFdmFsRXh0cmFjdGlvbkFjY3VyYWN5EhYKDmV4cGVjdGVkX3RvdGFsGAEgASgFEhcKD2V4dHJhY3RlZF90b3RhbBgCIAEoBRIVCg1tYXRjaGVkX2NvdW50GAMgASgFEhEKCXByZWNpc2lvbhgEIAEoARIOCgZyZWNhbGwYBSABKAESCgoCZjEYBiABKAEiWwoUVGF4RXZhbENsYXNzQWNjdXJhY3kSDQoFdG90YWwYASABKAUSDwoHY29ycmVjdBgCIAEoBRIRCglpbmNvcnJlY3QYAyABKAUSEAoIYWNjdXJhY3kYBCABKAEihAEKFVRheEV2YWxBbW91bnRBY2N1cmFjeRINCgV0b3RhbBgBIAEoBRIVCg1leGFjdF9tYXRjaGVzGAIgASgFEhUKDWNsb3NlX21hdGNoZXMYAyABKAUSFgoObWVhbl9hYnNfZXJyb3IYBCABKAESFgoObWVhbl9wY3RfZXJyb3IYBSABKAEigQIKE1RheEV2YWxGaWxlQWNjdXJhY3kSEAoIZmlsZW5hbWUYASABKAkSFQoNcmVsYXRpdmVfcGF0aBgCIAEoCRIdChVleHBlY3RlZF90cmFuc2FjdGlvbnMYAyABKAUSHgoWZXh0cmFjdGVkX3RyYW5zYWN0aW9ucxgEIAEoBRIPCgdtYXRjaGVkGAUgASgFEjgKDWRlZHVjdGliaWxpdHkYBiABKAsyIS5wZmluYW5jZS52MS5UYXhFdmFsQ2xhc3NBY2N1cmFjeRI3Cgx0YXhfY2F0ZWdvcnkYByABKAsyIS5wZmluYW5jZS52MS5UYXhFdmFsQ2xhc3NBY2N1cmFjeSrqAQoVSW1wb3J0RGlzcG9zaXRpb25UeXBlEicKI0lNUE9SVF9ESVNQT1NJVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASIgoeSU1QT1JUX0RJU1BPU0lUSU9OX1RZUEVfQ1JFQVRFEAESJwojSU1QT1JUX0RJU1BPU0lUSU9OX1RZUEVfU0tJUF9DUkVESVQQAhIvCitJTVBPUlRfRElTUE9TSVRJT05fVFlQRV9TS0lQX0xPV19DT05GSURFTkNFEAMSKgomSU1QT1JUX0RJU1BPU0lUSU9OX1RZUEVfU0tJUF9EVVBMSUNBVEUQBCprCg9UYXhFeHBvcnRGb3JtYXQSIQodVEFYX0VYUE9SVF9GT1JNQVRfVU5TUEVDSUZJRUQQABIZChVUQVhfRVhQT1JUX0ZPUk1BVF9DU1YQARIaChZUQVhfRVhQT1JUX0ZPUk1BVF9KU09OEAIy3IABCg5GaW5hbmNlU2VydmljZRJECgdHZXRVc2VyEhsucGZpbmFuY2UudjEuR2V0VXNlclJlcXVlc3QaHC5wZmluYW5jZS52MS5HZXRVc2VyUmVzcG9uc2USTQoKVXBkYXRlVXNlchIeLnBmaW5hbmNlLnYxLlVwZGF0ZVVzZXJSZXF1ZXN0Gh8ucGZpbmFuY2UudjEuVXBkYXRlVXNlclJlc3BvbnNlEkQKCkRlbGV0ZVVzZXISHi5wZmluYW5jZS52MS5EZWxldGVVc2VyUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJrChRQcmVwYXJlQ2xlYXJVc2VyRGF0YRIoLnBmaW5hbmNlLnYxLlByZXBhcmVDbGVhclVzZXJEYXRhUmVxdWVzdBopLnBmaW5hbmNlLnYxLlByZXBhcmVDbGVhclVzZXJEYXRhUmVzcG9uc2USSgoNQ2xlYXJVc2VyRGF0YRIhLnBmaW5hbmNlLnYxLkNsZWFyVXNlckRhdGFSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElkKDkV4cG9ydFVzZXJEYXRhEiIucGZpbmFuY2UudjEuRXhwb3J0VXNlckRhdGFSZXF1ZXN0GiMucGZpbmFuY2UudjEuRXhwb3J0VXNlckRhdGFSZXNwb25zZRJlChJHZXRVc2VyRGF0YVN1bW1hcnkSJi5wZmluYW5jZS52MS5HZXRVc2VyRGF0YVN1bW1hcnlSZXF1ZXN0GicucGZpbmFuY2UudjEuR2V0VXNlckRhdGFTdW1tYXJ5UmVzcG9uc2USWAoNRXhwb3J0QWxsRGF0YRIhLnBmaW5hbmNlLnYxLkV4cG9ydEFsbERhdGFSZXF1ZXN0GiIucGZpbmFuY2UudjEuRXhwb3J0QWxsRGF0YVJlc3BvbnNlMAESdAoXTGlzdFJlY29yZHNNaXNzaW5nQ2VudHMSKy5wZmluYW5jZS52MS5MaXN0UmVjb3Jkc01pc3NpbmdDZW50c1JlcXVlc3QaLC5wZmluYW5jZS52MS5MaXN0UmVjb3Jkc01pc3NpbmdDZW50c1Jlc3BvbnNlElYKDUNyZWF0ZUV4cGVuc2USIS5wZmluYW5jZS52MS5DcmVhdGVFeHBlbnNlUmVxdWVzdBoiLnBmaW5hbmNlLnYxLkNyZWF0ZUV4cGVuc2VSZXNwb25zZRJNCgpHZXRFeHBlbnNlEh4ucGZpbmFuY2UudjEuR2V0RXhwZW5zZVJlcXVlc3QaHy5wZmluYW5jZS52MS5HZXRFeHBlbnNlUmVzcG9uc2USVgoNVXBkYXRlRXhwZW5zZRIhLnBmaW5hbmNlLnYxLlVwZGF0ZUV4cGVuc2VSZXF1ZXN0GiIucGZpbmFuY2UudjEuVXBkYXRlRXhwZW5zZVJlc3BvbnNlEkoKDURlbGV0ZUV4cGVuc2USIS5wZmluYW5jZS52MS5EZWxldGVFeHBlbnNlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJTCgxMaXN0RXhwZW5zZXMSIC5wZmluYW5jZS52MS5MaXN0RXhwZW5zZXNSZXF1ZXN0GiEucGZpbmFuY2UudjEuTGlzdEV4cGVuc2VzUmVzcG9uc2USawoUR2V0VHJhbnNhY3Rpb25Db3VudHMSKC5wZmluYW5jZS52MS5HZXRUcmFuc2FjdGlvbkNvdW50c1JlcXVlc3QaKS5wZmluYW5jZS52MS5HZXRUcmFuc2FjdGlvbkNvdW50c1Jlc3BvbnNlElkKDkdldFRvcEV4cGVuc2VzEiIucGZpbmFuY2UudjEuR2V0VG9wRXhwZW5zZXNSZXF1ZXN0GiMucGZpbmFuY2UudjEuR2V0VG9wRXhwZW5zZXNSZXNwb25zZRJoChNCYXRjaENyZWF0ZUV4cGVuc2VzEicucGZpbmFuY2UudjEuQmF0Y2hDcmVhdGVFeHBlbnNlc1JlcXVlc3QaKC5wZmluYW5jZS52MS5CYXRjaENyZWF0ZUV4cGVuc2VzUmVzcG9uc2USdwoYUXVpY2tBZGRSZXBlYXRpbmdFeHBlbnNlEiwucGZpbmFuY2UudjEuUXVpY2tBZGRSZXBlYXRpbmdFeHBlbnNlUmVxdWVzdBotLnBmaW5hbmNlLnYxLlF1aWNrQWRkUmVwZWF0aW5nRXhwZW5zZVJlc3BvbnNlEmgKE0JhdGNoRGVsZXRlRXhwZW5zZXMSJy5wZmluYW5jZS52MS5CYXRjaERlbGV0ZUV4cGVuc2VzUmVxdWVzdBooLnBmaW5hbmNlLnYxLkJhdGNoRGVsZXRlRXhwZW5zZXNSZXNwb25zZRJrChRBZGRFeHBlbnNlQXR0YWNobWVudBIoLnBmaW5hbmNlLnYxLkFkZEV4cGVuc2VBdHRhY2htZW50UmVxdWVzdBopLnBmaW5hbmNlLnYxLkFkZEV4cGVuc2VBdHRhY2htZW50UmVzcG9uc2USdAoXUmVtb3ZlRXhwZW5zZUF0dGFjaG1lbnQSKy5wZmluYW5jZS52MS5SZW1vdmVFeHBlbnNlQXR0YWNobWVudFJlcXVlc3QaLC5wZmluYW5jZS52MS5SZW1vdmVFeHBlbnNlQXR0YWNobWVudFJlc3BvbnNlElMKDENyZWF0ZUluY29tZRIgLnBmaW5hbmNlLnYxLkNyZWF0ZUluY29tZVJlcXVlc3QaIS5wZmluYW5jZS52MS5DcmVhdGVJbmNvbWVSZXNwb25zZRJKCglHZXRJbmNvbWUSHS5wZmluYW5jZS52MS5HZXRJbmNvbWVSZXF1ZXN0Gh4ucGZpbmFuY2UudjEuR2V0SW5jb21lUmVzcG9uc2USUwoMVXBkYXRlSW5jb21lEiAucGZpbmFuY2UudjEuVXBkYXRlSW5jb21lUmVxdWVzdBohLnBmaW5hbmNlLnYxLlVwZGF0ZUluY29tZVJlc3BvbnNlEkgKDERlbGV0ZUluY29tZRIgLnBmaW5hbmNlLnYxLkRlbGV0ZUluY29tZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSZQoSQmF0Y2hEZWxldGVJbmNvbWVzEiYucGZpbmFuY2UudjEuQmF0Y2hEZWxldGVJbmNvbWVzUmVxdWVzdBonLnBmaW5hbmNlLnYxLkJhdGNoRGVsZXRlSW5jb21lc1Jlc3BvbnNlElAKC0xpc3RJbmNvbWVzEh8ucGZpbmFuY2UudjEuTGlzdEluY29tZXNSZXF1ZXN0GiAucGZpbmFuY2UudjEuTGlzdEluY29tZXNSZXNwb25zZRJTCgxHZXRUYXhDb25maWcSIC5wZmluYW5jZS52MS5HZXRUYXhDb25maWdSZXF1ZXN0GiEucGZpbmFuY2UudjEuR2V0VGF4Q29uZmlnUmVzcG9uc2USXAoPVXBkYXRlVGF4Q29uZmlnEiMucGZpbmFuY2UudjEuVXBkYXRlVGF4Q29uZmlnUmVxdWVzdBokLnBmaW5hbmNlLnYxLlVwZGF0ZVRheENvbmZpZ1Jlc3BvbnNlElAKC0NyZWF0ZUdyb3VwEh8ucGZpbmFuY2UudjEuQ3JlYXRlR3JvdXBSZXF1ZXN0GiAucGZpbmFuY2UudjEuQ3JlYXRlR3JvdXBSZXNwb25zZRJHCghHZXRHcm91cBIcLnBmaW5hbmNlLnYxLkdldEdyb3VwUmVxdWVzdBodLnBmaW5hbmNlLnYxLkdldEdyb3VwUmVzcG9uc2USUAoLVXBkYXRlR3JvdXASHy5wZmluYW5jZS52MS5VcGRhdGVHcm91cFJlcXVlc3QaIC5wZmluYW5jZS52MS5VcGRhdGVHcm91cFJlc3BvbnNlEkYKC0RlbGV0ZUdyb3VwEh8ucGZpbmFuY2UudjEuRGVsZXRlR3JvdXBSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Ek0KCkxpc3RHcm91cHMSHi5wZmluYW5jZS52MS5MaXN0R3JvdXBzUmVxdWVzdBofLnBmaW5hbmNlLnYxLkxpc3RHcm91cHNSZXNwb25zZRJWCg1JbnZpdGVUb0dyb3VwEiEucGZpbmFuY2UudjEuSW52aXRlVG9Hcm91cFJlcXVlc3QaIi5wZmluYW5jZS52MS5JbnZpdGVUb0dyb3VwUmVzcG9uc2USXwoQQWNjZXB0SW52aXRhdGlvbhIkLnBmaW5hbmNlLnYxLkFjY2VwdEludml0YXRpb25SZXF1ZXN0GiUucGZpbmFuY2UudjEuQWNjZXB0SW52aXRhdGlvblJlc3BvbnNlElIKEURlY2xpbmVJbnZpdGF0aW9uEiUucGZpbmFuY2UudjEuRGVjbGluZUludml0YXRpb25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Ek4KD1JlbW92ZUZyb21Hcm91cBIjLnBmaW5hbmNlLnYxLlJlbW92ZUZyb21Hcm91cFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSXwoQVXBkYXRlTWVtYmVyUm9sZRIkLnBmaW5hbmNlLnYxLlVwZGF0ZU1lbWJlclJvbGVSZXF1ZXN0GiUucGZpbmFuY2UudjEuVXBkYXRlTWVtYmVyUm9sZVJlc3BvbnNlElwKD0xpc3RJbnZpdGF0aW9ucxIjLnBmaW5hbmNlLnYxLkxpc3RJbnZpdGF0aW9uc1JlcXVlc3QaJC5wZmluYW5jZS52MS5MaXN0SW52aXRhdGlvbnNSZXNwb25zZRJTCgxDcmVhdGVCdWRnZXQSIC5wZmluYW5jZS52MS5DcmVhdGVCdWRnZXRSZXF1ZXN0GiEucGZpbmFuY2UudjEuQ3JlYXRlQnVkZ2V0UmVzcG9uc2USSgoJR2V0QnVkZ2V0Eh0ucGZpbmFuY2UudjEuR2V0QnVkZ2V0UmVxdWVzdBoeLnBmaW5hbmNlLnYxLkdldEJ1ZGdldFJlc3BvbnNlElMKDFVwZGF0ZUJ1ZGdldBIgLnBmaW5hbmNlLnYxLlVwZGF0ZUJ1ZGdldFJlcXVlc3QaIS5wZmluYW5jZS52MS5VcGRhdGVCdWRnZXRSZXNwb25zZRJICgxEZWxldGVCdWRnZXQSIC5wZmluYW5jZS52MS5EZWxldGVCdWRnZXRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElAKC0xpc3RCdWRnZXRzEh8ucGZpbmFuY2UudjEuTGlzdEJ1ZGdldHNSZXF1ZXN0GiAucGZpbmFuY2UudjEuTGlzdEJ1ZGdldHNSZXNwb25zZRJiChFHZXRCdWRnZXRQcm9ncmVzcxIlLnBmaW5hbmNlLnYxLkdldEJ1ZGdldFByb2dyZXNzUmVxdWVzdBomLnBmaW5hbmNlLnYxLkdldEJ1ZGdldFByb2dyZXNzUmVzcG9uc2USawoUR2V0QWxsQnVkZ2V0UHJvZ3Jlc3MSKC5wZmluYW5jZS52MS5HZXRBbGxCdWRnZXRQcm9ncmVzc1JlcXVlc3QaKS5wZmluYW5jZS52MS5HZXRBbGxCdWRnZXRQcm9ncmVzc1Jlc3BvbnNlEmsKFENyZWF0ZUJ1ZGdldFRlbXBsYXRlEigucGZpbmFuY2UudjEuQ3JlYXRlQnVkZ2V0VGVtcGxhdGVSZXF1ZXN0GikucGZpbmFuY2UudjEuQ3JlYXRlQnVkZ2V0VGVtcGxhdGVSZXNwb25zZRJoChNMaXN0QnVkZ2V0VGVtcGxhdGVzEicucGZpbmFuY2UudjEuTGlzdEJ1ZGdldFRlbXBsYXRlc1JlcXVlc3QaKC5wZmluYW5jZS52MS5MaXN0QnVkZ2V0VGVtcGxhdGVzUmVzcG9uc2USawoUVXBkYXRlQnVkZ2V0VGVtcGxhdGUSKC5wZmluYW5jZS52MS5VcGRhdGVCdWRnZXRUZW1wbGF0ZVJlcXVlc3QaKS5wZmluYW5jZS52MS5VcGRhdGVCdWRnZXRUZW1wbGF0ZVJlc3BvbnNlElgKFERlbGV0ZUJ1ZGdldFRlbXBsYXRlEigucGZpbmFuY2UudjEuRGVsZXRlQnVkZ2V0VGVtcGxhdGVSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EmIKEUdldE1lbWJlckJhbGFuY2VzEiUucGZpbmFuY2UudjEuR2V0TWVtYmVyQmFsYW5jZXNSZXF1ZXN0GiYucGZpbmFuY2UudjEuR2V0TWVtYmVyQmFsYW5jZXNSZXNwb25zZRJWCg1TZXR0bGVFeHBlbnNlEiEucGZpbmFuY2UudjEuU2V0dGxlRXhwZW5zZVJlcXVlc3QaIi5wZmluYW5jZS52MS5TZXR0bGVFeHBlbnNlUmVzcG9uc2USXAoPR2V0R3JvdXBTdW1tYXJ5EiMucGZpbmFuY2UudjEuR2V0R3JvdXBTdW1tYXJ5UmVxdWVzdBokLnBmaW5hbmNlLnYxLkdldEdyb3VwU3VtbWFyeVJlc3BvbnNlEmUKEkdldEdyb3VwU2V0dGxlbWVudBImLnBmaW5hbmNlLnYxLkdldEdyb3VwU2V0dGxlbWVudFJlcXVlc3QaJy5wZmluYW5jZS52MS5HZXRHcm91cFNldHRsZW1lbnRSZXNwb25zZRJfChBDcmVhdGVJbnZpdGVMaW5rEiQucGZpbmFuY2UudjEuQ3JlYXRlSW52aXRlTGlua1JlcXVlc3QaJS5wZmluYW5jZS52MS5DcmVhdGVJbnZpdGVMaW5rUmVzcG9uc2USaAoTR2V0SW52aXRlTGlua0J5Q29kZRInLnBmaW5hbmNlLnYxLkdldEludml0ZUxpbmtCeUNvZGVSZXF1ZXN0GigucGZpbmFuY2UudjEuR2V0SW52aXRlTGlua0J5Q29kZVJlc3BvbnNlElwKD0pvaW5Hcm91cEJ5TGluaxIjLnBmaW5hbmNlLnYxLkpvaW5Hcm91cEJ5TGlua1JlcXVlc3QaJC5wZmluYW5jZS52MS5Kb2luR3JvdXBCeUxpbmtSZXNwb25zZRJcCg9MaXN0SW52aXRlTGlua3MSIy5wZmluYW5jZS52MS5MaXN0SW52aXRlTGlua3NSZXF1ZXN0GiQucGZpbmFuY2UudjEuTGlzdEludml0ZUxpbmtzUmVzcG9uc2USWAoURGVhY3RpdmF0ZUludml0ZUxpbmsSKC5wZmluYW5jZS52MS5EZWFjdGl2YXRlSW52aXRlTGlua1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSZQoSR2V0SW52aXRlTGlua1N0YXRzEiYucGZpbmFuY2UudjEuR2V0SW52aXRlTGlua1N0YXRzUmVxdWVzdBonLnBmaW5hbmNlLnYxLkdldEludml0ZUxpbmtTdGF0c1Jlc3BvbnNlEncKGENvbnRyaWJ1dGVFeHBlbnNlVG9Hcm91cBIsLnBmaW5hbmNlLnYxLkNvbnRyaWJ1dGVFeHBlbnNlVG9Hcm91cFJlcXVlc3QaLS5wZmluYW5jZS52MS5Db250cmlidXRlRXhwZW5zZVRvR3JvdXBSZXNwb25zZRJ0ChdDb250cmlidXRlSW5jb21lVG9Hcm91cBIrLnBmaW5hbmNlLnYxLkNvbnRyaWJ1dGVJbmNvbWVUb0dyb3VwUmVxdWVzdBosLnBmaW5hbmNlLnYxLkNvbnRyaWJ1dGVJbmNvbWVUb0dyb3VwUmVzcG9uc2USYgoRTGlzdENvbnRyaWJ1dGlvbnMSJS5wZmluYW5jZS52MS5MaXN0Q29udHJpYnV0aW9uc1JlcXVlc3QaJi5wZmluYW5jZS52MS5MaXN0Q29udHJpYnV0aW9uc1Jlc3BvbnNlEnQKF0xpc3RJbmNvbWVDb250cmlidXRpb25zEisucGZpbmFuY2UudjEuTGlzdEluY29tZUNvbnRyaWJ1dGlvbnNSZXF1ZXN0GiwucGZpbmFuY2UudjEuTGlzdEluY29tZUNvbnRyaWJ1dGlvbnNSZXNwb25zZRJNCgpDcmVhdGVHb2FsEh4ucGZpbmFuY2UudjEuQ3JlYXRlR29hbFJlcXVlc3QaHy5wZmluYW5jZS52MS5DcmVhdGVHb2FsUmVzcG9uc2USRAoHR2V0R29hbBIbLnBmaW5hbmNlLnYxLkdldEdvYWxSZXF1ZXN0GhwucGZpbmFuY2UudjEuR2V0R29hbFJlc3BvbnNlEk0KClVwZGF0ZUdvYWwSHi5wZmluYW5jZS52MS5VcGRhdGVHb2FsUmVxdWVzdBofLnBmaW5hbmNlLnYxLlVwZGF0ZUdvYWxSZXNwb25zZRJECgpEZWxldGVHb2FsEh4ucGZpbmFuY2UudjEuRGVsZXRlR29hbFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSSgoJTGlzdEdvYWxzEh0ucGZpbmFuY2UudjEuTGlzdEdvYWxzUmVxdWVzdBoeLnBmaW5hbmNlLnYxLkxpc3RHb2Fsc1Jlc3BvbnNlElwKD0dldEdvYWxQcm9ncmVzcxIjLnBmaW5hbmNlLnYxLkdldEdvYWxQcm9ncmVzc1JlcXVlc3QaJC5wZmluYW5jZS52MS5HZXRHb2FsUHJvZ3Jlc3NSZXNwb25zZRJfChBDb250cmlidXRlVG9Hb2FsEiQucGZpbmFuY2UudjEuQ29udHJpYnV0ZVRvR29hbFJlcXVlc3QaJS5wZmluYW5jZS52MS5Db250cmlidXRlVG9Hb2FsUmVzcG9uc2USbgoVTGlzdEdvYWxDb250cmlidXRpb25zEikucGZpbmFuY2UudjEuTGlzdEdvYWxDb250cmlidXRpb25zUmVxdWVzdBoqLnBmaW5hbmNlLnYxLkxpc3RHb2FsQ29udHJpYnV0aW9uc1Jlc3BvbnNlEncKGFJlY29tbWVuZEdvYWxBbGxvY2F0aW9ucxIsLnBmaW5hbmNlLnYxLlJlY29tbWVuZEdvYWxBbGxvY2F0aW9uc1JlcXVlc3QaLS5wZmluYW5jZS52MS5SZWNvbW1lbmRHb2FsQWxsb2NhdGlvbnNSZXNwb25zZRJoChNHZXRTcGVuZGluZ0luc2lnaHRzEicucGZpbmFuY2UudjEuR2V0U3BlbmRpbmdJbnNpZ2h0c1JlcXVlc3QaKC5wZmluYW5jZS52MS5HZXRTcGVuZGluZ0luc2lnaHRzUmVzcG9uc2USXAoPR2V0QWN0aXZpdHlGZWVkEiMucGZpbmFuY2UudjEuR2V0QWN0aXZpdHlGZWVkUmVxdWVzdBokLnBmaW5hbmNlLnYxLkdldEFjdGl2aXR5RmVlZFJlc3BvbnNlElwKD0V4dHJhY3REb2N1bWVudBIjLnBmaW5hbmNlLnYxLkV4dHJhY3REb2N1bWVudFJlcXVlc3QaJC5wZmluYW5jZS52MS5FeHRyYWN0RG9jdW1lbnRSZXNwb25zZRJfChBHZXRFeHRyYWN0aW9uSm9iEiQucGZpbmFuY2UudjEuR2V0RXh0cmFjdGlvbkpvYlJlcXVlc3QaJS5wZmluYW5jZS52MS5HZXRFeHRyYWN0aW9uSm9iUmVzcG9uc2USgAEKG0ltcG9ydEV4dHJhY3RlZFRyYW5zYWN0aW9ucxIvLnBmaW5hbmNlLnYxLkltcG9ydEV4dHJhY3RlZFRyYW5zYWN0aW9uc1JlcXVlc3QaMC5wZmluYW5jZS52MS5JbXBvcnRFeHRyYWN0ZWRUcmFuc2FjdGlvbnNSZXNwb25zZRJ9ChpHZXRKb2JSZWplY3RlZFRyYW5zYWN0aW9ucxIuLnBmaW5hbmNlLnYxLkdldEpvYlJlamVjdGVkVHJhbnNhY3Rpb25zUmVxdWVzdBovLnBmaW5hbmNlLnYxLkdldEpvYlJlamVjdGVkVHJhbnNhY3Rpb25zUmVzcG9uc2USgAEKG1JlY292ZXJSZWplY3RlZFRyYW5zYWN0aW9ucxIvLnBmaW5hbmNlLnYxLlJlY292ZXJSZWplY3RlZFRyYW5zYWN0aW9uc1JlcXVlc3QaMC5wZmluYW5jZS52MS5SZWNvdmVyUmVqZWN0ZWRUcmFuc2FjdGlvbnNSZXNwb25zZRJfChBQYXJzZUV4cGVuc2VUZXh0EiQucGZpbmFuY2UudjEuUGFyc2VFeHBlbnNlVGV4dFJlcXVlc3QaJS5wZmluYW5jZS52MS5QYXJzZUV4cGVuc2VUZXh0UmVzcG9uc2USZQoSUGFyc2VCYW5rU3RhdGVtZW50EiYucGZpbmFuY2UudjEuUGFyc2VCYW5rU3RhdGVtZW50UmVxdWVzdBonLnBmaW5hbmNlLnYxLlBhcnNlQmFua1N0YXRlbWVudFJlc3BvbnNlEmgKE0NyZWF0ZUltcG9ydFByb2ZpbGUSJy5wZmluYW5jZS52MS5DcmVhdGVJbXBvcnRQcm9maWxlUmVxdWVzdBooLnBmaW5hbmNlLnYxLkNyZWF0ZUltcG9ydFByb2ZpbGVSZXNwb25zZRJfChBHZXRJbXBvcnRQcm9maWxlEiQucGZpbmFuY2UudjEuR2V0SW1wb3J0UHJvZmlsZVJlcXVlc3QaJS5wZmluYW5jZS52MS5HZXRJbXBvcnRQcm9maWxlUmVzcG9uc2USaAoTVXBkYXRlSW1wb3J0UHJvZmlsZRInLnBmaW5hbmNlLnYxLlVwZGF0ZUltcG9ydFByb2ZpbGVSZXF1ZXN0GigucGZpbmFuY2UudjEuVXBkYXRlSW1wb3J0UHJvZmlsZVJlc3BvbnNlElYKE0RlbGV0ZUltcG9ydFByb2ZpbGUSJy5wZmluYW5jZS52MS5EZWxldGVJbXBvcnRQcm9maWxlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJlChJMaXN0SW1wb3J0UHJvZmlsZXMSJi5wZmluYW5jZS52MS5MaXN0SW1wb3J0UHJvZmlsZXNSZXF1ZXN0GicucGZpbmFuY2UudjEuTGlzdEltcG9ydFByb2ZpbGVzUmVzcG9uc2USdwoYR2V0RXh0cmFjdGlvblByZWZlcmVuY2VzEiwucGZpbmFuY2UudjEuR2V0RXh0cmFjdGlvblByZWZlcmVuY2VzUmVxdWVzdBotLnBmaW5hbmNlLnYxLkdldEV4dHJhY3Rpb25QcmVmZXJlbmNlc1Jlc3BvbnNlEoABChtVcGRhdGVFeHRyYWN0aW9uUHJlZmVyZW5jZXMSLy5wZmluYW5jZS52MS5VcGRhdGVFeHRyYWN0aW9uUHJlZmVyZW5jZXNSZXF1ZXN0GjAucGZpbmFuY2UudjEuVXBkYXRlRXh0cmFjdGlvblByZWZlcmVuY2VzUmVzcG9uc2USZgobRGVsZXRlRXh0cmFjdGlvblByZWZlcmVuY2VzEi8ucGZpbmFuY2UudjEuRGVsZXRlRXh0cmFjdGlvblByZWZlcmVuY2VzUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJ9ChpDcmVhdGVSZWN1cnJpbmdUcmFuc2FjdGlvbhIuLnBmaW5hbmNlLnYxLkNyZWF0ZVJlY3VycmluZ1RyYW5zYWN0aW9uUmVxdWVzdBovLnBmaW5hbmNlLnYxLkNyZWF0ZVJlY3VycmluZ1RyYW5zYWN0aW9uUmVzcG9uc2USdAoXR2V0UmVjdXJyaW5nVHJhbnNhY3Rpb24SKy5wZmluYW5jZS52MS5HZXRSZWN1cnJpbmdUcmFuc2FjdGlvblJlcXVlc3QaLC5wZmluYW5jZS52MS5HZXRSZWN1cnJpbmdUcmFuc2FjdGlvblJlc3BvbnNlEn0KGlVwZGF0ZVJlY3VycmluZ1RyYW5zYWN0aW9uEi4ucGZpbmFuY2UudjEuVXBkYXRlUmVjdXJyaW5nVHJhbnNhY3Rpb25SZXF1ZXN0Gi8ucGZpbmFuY2UudjEuVXBkYXRlUmVjdXJyaW5nVHJhbnNhY3Rpb25SZXNwb25zZRJkChpEZWxldGVSZWN1cnJpbmdUcmFuc2FjdGlvbhIuLnBmaW5hbmNlLnYxLkRlbGV0ZVJlY3VycmluZ1RyYW5zYWN0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJ6ChlMaXN0UmVjdXJyaW5nVHJhbnNhY3Rpb25zEi0ucGZpbmFuY2UudjEuTGlzdFJlY3VycmluZ1RyYW5zYWN0aW9uc1JlcXVlc3QaLi5wZmluYW5jZS52MS5MaXN0UmVjdXJyaW5nVHJhbnNhY3Rpb25zUmVzcG9uc2USegoZUGF1c2VSZWN1cnJpbmdUcmFuc2FjdGlvbhItLnBmaW5hbmNlLnYxLlBhdXNlUmVjdXJyaW5nVHJhbnNhY3Rpb25SZXF1ZXN0Gi4ucGZpbmFuY2UudjEuUGF1c2VSZWN1cnJpbmdUcmFuc2FjdGlvblJlc3BvbnNlEn0KGlJlc3VtZVJlY3VycmluZ1RyYW5zYWN0aW9uEi4ucGZpbmFuY2UudjEuUmVzdW1lUmVjdXJyaW5nVHJhbnNhY3Rpb25SZXF1ZXN0Gi8ucGZpbmFuY2UudjEuUmVzdW1lUmVjdXJyaW5nVHJhbnNhY3Rpb25SZXNwb25zZRJlChJTa2lwTmV4dE9jY3VycmVuY2USJi5wZmluYW5jZS52MS5Ta2lwTmV4dE9jY3VycmVuY2VSZXF1ZXN0GicucGZpbmFuY2UudjEuU2tpcE5leHRPY2N1cnJlbmNlUmVzcG9uc2USXwoQR2V0VXBjb21pbmdCaWxscxIkLnBmaW5hbmNlLnYxLkdldFVwY29taW5nQmlsbHNSZXF1ZXN0GiUucGZpbmFuY2UudjEuR2V0VXBjb21pbmdCaWxsc1Jlc3BvbnNlEoABChtQcmV2aWV3UmVjdXJyaW5nT2NjdXJyZW5jZXMSLy5wZmluYW5jZS52MS5QcmV2aWV3UmVjdXJyaW5nT2NjdXJyZW5jZXNSZXF1ZXN0GjAucGZpbmFuY2UudjEuUHJldmlld1JlY3VycmluZ09jY3VycmVuY2VzUmVzcG9uc2USgwEKHFByb2Nlc3NSZWN1cnJpbmdUcmFuc2FjdGlvbnMSMC5wZmluYW5jZS52MS5Qcm9jZXNzUmVjdXJyaW5nVHJhbnNhY3Rpb25zUmVxdWVzdBoxLnBmaW5hbmNlLnYxLlByb2Nlc3NSZWN1cnJpbmdUcmFuc2FjdGlvbnNSZXNwb25zZRJlChJTZWFyY2hUcmFuc2FjdGlvbnMSJi5wZmluYW5jZS52MS5TZWFyY2hUcmFuc2FjdGlvbnNSZXF1ZXN0GicucGZpbmFuY2UudjEuU2VhcmNoVHJhbnNhY3Rpb25zUmVzcG9uc2USaAoTRGV0ZWN0U3Vic2NyaXB0aW9ucxInLnBmaW5hbmNlLnYxLkRldGVjdFN1YnNjcmlwdGlvbnNSZXF1ZXN0GigucGZpbmFuY2UudjEuRGV0ZWN0U3Vic2NyaXB0aW9uc1Jlc3BvbnNlEmUKEkNvbnZlcnRUb1JlY3VycmluZxImLnBmaW5hbmNlLnYxLkNvbnZlcnRUb1JlY3VycmluZ1JlcXVlc3QaJy5wZmluYW5jZS52MS5Db252ZXJ0VG9SZWN1cnJpbmdSZXNwb25zZRJ6ChlDb252ZXJ0RXhwZW5zZVRvUmVjdXJyaW5nEi0ucGZpbmFuY2UudjEuQ29udmVydEV4cGVuc2VUb1JlY3VycmluZ1JlcXVlc3QaLi5wZmluYW5jZS52MS5Db252ZXJ0RXhwZW5zZVRvUmVjdXJyaW5nUmVzcG9uc2USdAoXRGV0ZWN0UmVjdXJyaW5nUGF0dGVybnMSKy5wZmluYW5jZS52MS5EZXRlY3RSZWN1cnJpbmdQYXR0ZXJuc1JlcXVlc3QaLC5wZmluYW5jZS52MS5EZXRlY3RSZWN1cnJpbmdQYXR0ZXJuc1Jlc3BvbnNlEmIKEUxpc3ROb3RpZmljYXRpb25zEiUucGZpbmFuY2UudjEuTGlzdE5vdGlmaWNhdGlvbnNSZXF1ZXN0GiYucGZpbmFuY2UudjEuTGlzdE5vdGlmaWNhdGlvbnNSZXNwb25zZRJYChRNYXJrTm90aWZpY2F0aW9uUmVhZBIoLnBmaW5hbmNlLnYxLk1hcmtOb3RpZmljYXRpb25SZWFkUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJgChhNYXJrQWxsTm90aWZpY2F0aW9uc1JlYWQSLC5wZmluYW5jZS52MS5NYXJrQWxsTm90aWZpY2F0aW9uc1JlYWRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElQKEkRlbGV0ZU5vdGlmaWNhdGlvbhImLnBmaW5hbmNlLnYxLkRlbGV0ZU5vdGlmaWNhdGlvblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSfQoaRGVsZXRlQWxsUmVhZE5vdGlmaWNhdGlvbnMSLi5wZmluYW5jZS52MS5EZWxldGVBbGxSZWFkTm90aWZpY2F0aW9uc1JlcXVlc3QaLy5wZmluYW5jZS52MS5EZWxldGVBbGxSZWFkTm90aWZpY2F0aW9uc1Jlc3BvbnNlEn0KGkdldFVucmVhZE5vdGlmaWNhdGlvbkNvdW50Ei4ucGZpbmFuY2UudjEuR2V0VW5yZWFkTm90aWZpY2F0aW9uQ291bnRSZXF1ZXN0Gi8ucGZpbmFuY2UudjEuR2V0VW5yZWFkTm90aWZpY2F0aW9uQ291bnRSZXNwb25zZRJrChRHZXROb3RpZmljYXRpb25TdGF0cxIoLnBmaW5hbmNlLnYxLkdldE5vdGlmaWNhdGlvblN0YXRzUmVxdWVzdBopLnBmaW5hbmNlLnYxLkdldE5vdGlmaWNhdGlvblN0YXRzUmVzcG9uc2USfQoaR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXMSLi5wZmluYW5jZS52MS5HZXROb3RpZmljYXRpb25QcmVmZXJlbmNlc1JlcXVlc3QaLy5wZmluYW5jZS52MS5HZXROb3RpZmljYXRpb25QcmVmZXJlbmNlc1Jlc3BvbnNlEoYBCh1VcGRhdGVOb3RpZmljYXRpb25QcmVmZXJlbmNlcxIxLnBmaW5hbmNlLnYxLlVwZGF0ZU5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVxdWVzdBoyLnBmaW5hbmNlLnYxLlVwZGF0ZU5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVzcG9uc2USawoUR2VuZXJhdGVXZWVrbHlEaWdlc3QSKC5wZmluYW5jZS52MS5HZW5lcmF0ZVdlZWtseURpZ2VzdFJlcXVlc3QaKS5wZmluYW5jZS52MS5HZW5lcmF0ZVdlZWtseURpZ2VzdFJlc3BvbnNlEm4KFUNyZWF0ZUNoZWNrb3V0U2Vzc2lvbhIpLnBmaW5hbmNlLnYxLkNyZWF0ZUNoZWNrb3V0U2Vzc2lvblJlcXVlc3QaKi5wZmluYW5jZS52MS5DcmVhdGVDaGVja291dFNlc3Npb25SZXNwb25zZRJuChVHZXRTdWJzY3JpcHRpb25TdGF0dXMSKS5wZmluYW5jZS52MS5HZXRTdWJzY3JpcHRpb25TdGF0dXNSZXF1ZXN0GioucGZpbmFuY2UudjEuR2V0U3Vic2NyaXB0aW9uU3RhdHVzUmVzcG9uc2USZQoSQ2FuY2VsU3Vic2NyaXB0aW9uEiYucGZpbmFuY2UudjEuQ2FuY2VsU3Vic2NyaXB0aW9uUmVxdWVzdBonLnBmaW5hbmNlLnYxLkNhbmNlbFN1YnNjcmlwdGlvblJlc3BvbnNlEm4KFVZlcmlmeUNoZWNrb3V0U2Vzc2lvbhIpLnBmaW5hbmNlLnYxLlZlcmlmeUNoZWNrb3V0U2Vzc2lvblJlcXVlc3QaKi5wZmluYW5jZS52MS5WZXJpZnlDaGVja291dFNlc3Npb25SZXNwb25zZRJlChJHZXREYWlseUFnZ3JlZ2F0ZXMSJi5wZmluYW5jZS52MS5HZXREYWlseUFnZ3JlZ2F0ZXNSZXF1ZXN0GicucGZpbmFuY2UudjEuR2V0RGFpbHlBZ2dyZWdhdGVzUmVzcG9uc2USYgoRR2V0U3BlbmRpbmdUcmVuZHMSJS5wZmluYW5jZS52MS5HZXRTcGVuZGluZ1RyZW5kc1JlcXVlc3QaJi5wZmluYW5jZS52MS5HZXRTcGVuZGluZ1RyZW5kc1Jlc3BvbnNlEm4KFUdldENhdGVnb3J5Q29tcGFyaXNvbhIpLnBmaW5hbmNlLnYxLkdldENhdGVnb3J5Q29tcGFyaXNvblJlcXVlc3QaKi5wZmluYW5jZS52MS5HZXRDYXRlZ29yeUNvbXBhcmlzb25SZXNwb25zZRJcCg9EZXRlY3RBbm9tYWxpZXMSIy5wZmluYW5jZS52MS5EZXRlY3RBbm9tYWxpZXNSZXF1ZXN0GiQucGZpbmFuY2UudjEuRGV0ZWN0QW5vbWFsaWVzUmVzcG9uc2USaAoTR2V0Q2FzaEZsb3dGb3JlY2FzdBInLnBmaW5hbmNlLnYxLkdldENhc2hGbG93Rm9yZWNhc3RSZXF1ZXN0GigucGZpbmFuY2UudjEuR2V0Q2FzaEZsb3dGb3JlY2FzdFJlc3BvbnNlEl8KEEdldFdhdGVyZmFsbERhdGESJC5wZmluYW5jZS52MS5HZXRXYXRlcmZhbGxEYXRhUmVxdWVzdBolLnBmaW5hbmNlLnYxLkdldFdhdGVyZmFsbERhdGFSZXNwb25zZRJfChBSZWNvbW1lbmRCdWRnZXRzEiQucGZpbmFuY2UudjEuUmVjb21tZW5kQnVkZ2V0c1JlcXVlc3QaJS5wZmluYW5jZS52MS5SZWNvbW1lbmRCdWRnZXRzUmVzcG9uc2USXwoQR2V0U3BlbmRpbmdCeVRhZxIkLnBmaW5hbmNlLnYxLkdldFNwZW5kaW5nQnlUYWdSZXF1ZXN0GiUucGZpbmFuY2UudjEuR2V0U3BlbmRpbmdCeVRhZ1Jlc3BvbnNlEmIKEVN1Ym1pdENvcnJlY3Rpb25zEiUucGZpbmFuY2UudjEuU3VibWl0Q29ycmVjdGlvbnNSZXF1ZXN0GiYucGZpbmFuY2UudjEuU3VibWl0Q29ycmVjdGlvbnNSZXNwb25zZRJcCg9DaGVja0R1cGxpY2F0ZXMSIy5wZmluYW5jZS52MS5DaGVja0R1cGxpY2F0ZXNSZXF1ZXN0GiQucGZpbmFuY2UudjEuQ2hlY2tEdXBsaWNhdGVzUmVzcG9uc2USVgoNTWVyZ2VFeHBlbnNlcxIhLnBmaW5hbmNlLnYxLk1lcmdlRXhwZW5zZXNSZXF1ZXN0GiIucGZpbmFuY2UudjEuTWVyZ2VFeHBlbnNlc1Jlc3BvbnNlEnEKFkdldE1lcmNoYW50U3VnZ2VzdGlvbnMSKi5wZmluYW5jZS52MS5HZXRNZXJjaGFudFN1Z2dlc3Rpb25zUmVxdWVzdBorLnBmaW5hbmNlLnYxLkdldE1lcmNoYW50U3VnZ2VzdGlvbnNSZXNwb25zZRJrChRMaXN0TWVyY2hhbnRNYXBwaW5ncxIoLnBmaW5hbmNlLnYxLkxpc3RNZXJjaGFudE1hcHBpbmdzUmVxdWVzdBopLnBmaW5hbmNlLnYxLkxpc3RNZXJjaGFudE1hcHBpbmdzUmVzcG9uc2USbgoVRGVsZXRlTWVyY2hhbnRNYXBwaW5nEikucGZpbmFuY2UudjEuRGVsZXRlTWVyY2hhbnRNYXBwaW5nUmVxdWVzdBoqLnBmaW5hbmNlLnYxLkRlbGV0ZU1lcmNoYW50TWFwcGluZ1Jlc3BvbnNlEoABChtCYXRjaFVwc2VydE1lcmNoYW50TWFwcGluZ3MSLy5wZmluYW5jZS52MS5CYXRjaFVwc2VydE1lcmNoYW50TWFwcGluZ3NSZXF1ZXN0GjAucGZpbmFuY2UudjEuQmF0Y2hVcHNlcnRNZXJjaGFudE1hcHBpbmdzUmVzcG9uc2USawoUR2V0RXh0cmFjdGlvbk1ldHJpY3MSKC5wZmluYW5jZS52MS5HZXRFeHRyYWN0aW9uTWV0cmljc1JlcXVlc3QaKS5wZmluYW5jZS52MS5HZXRFeHRyYWN0aW9uTWV0cmljc1Jlc3BvbnNlEmsKFEdldENhdGVnb3J5T3ZlcnJpZGVzEigucGZpbmFuY2UudjEuR2V0Q2F0ZWdvcnlPdmVycmlkZXNSZXF1ZXN0GikucGZpbmFuY2UudjEuR2V0Q2F0ZWdvcnlPdmVycmlkZXNSZXNwb25zZRJoChNTZXRDYXRlZ29yeU92ZXJyaWRlEicucGZpbmFuY2UudjEuU2V0Q2F0ZWdvcnlPdmVycmlkZVJlcXVlc3QaKC5wZmluYW5jZS52MS5TZXRDYXRlZ29yeU92ZXJyaWRlUmVzcG9uc2UScQoWRGVsZXRlQ2F0ZWdvcnlPdmVycmlkZRIqLnBmaW5hbmNlLnYxLkRlbGV0ZUNhdGVnb3J5T3ZlcnJpZGVSZXF1ZXN0GisucGZpbmFuY2UudjEuRGVsZXRlQ2F0ZWdvcnlPdmVycmlkZVJlc3BvbnNlElYKDUdldFRheFN1bW1hcnkSIS5wZmluYW5jZS52MS5HZXRUYXhTdW1tYXJ5UmVxdWVzdBoiLnBmaW5hbmNlLnYxLkdldFRheFN1bW1hcnlSZXNwb25zZRJZCg5HZXRUYXhFc3RpbWF0ZRIiLnBmaW5hbmNlLnYxLkdldFRheEVzdGltYXRlUmVxdWVzdBojLnBmaW5hbmNlLnYxLkdldFRheEVzdGltYXRlUmVzcG9uc2USgAEKG0JhdGNoVXBkYXRlRXhwZW5zZVRheFN0YXR1cxIvLnBmaW5hbmNlLnYxLkJhdGNoVXBkYXRlRXhwZW5zZVRheFN0YXR1c1JlcXVlc3QaMC5wZmluYW5jZS52MS5CYXRjaFVwZGF0ZUV4cGVuc2VUYXhTdGF0dXNSZXNwb25zZRJxChZMaXN0RGVkdWN0aWJsZUV4cGVuc2VzEioucGZpbmFuY2UudjEuTGlzdERlZHVjdGlibGVFeHBlbnNlc1JlcXVlc3QaKy5wZmluYW5jZS52MS5MaXN0RGVkdWN0aWJsZUV4cGVuc2VzUmVzcG9uc2USdwoYQ2xhc3NpZnlUYXhEZWR1Y3RpYmlsaXR5EiwucGZpbmFuY2UudjEuQ2xhc3NpZnlUYXhEZWR1Y3RpYmlsaXR5UmVxdWVzdBotLnBmaW5hbmNlLnYxLkNsYXNzaWZ5VGF4RGVkdWN0aWJpbGl0eVJlc3BvbnNlEoYBCh1CYXRjaENsYXNzaWZ5VGF4RGVkdWN0aWJpbGl0eRIxLnBmaW5hbmNlLnYxLkJhdGNoQ2xhc3NpZnlUYXhEZWR1Y3RpYmlsaXR5UmVxdWVzdBoyLnBmaW5hbmNlLnYxLkJhdGNoQ2xhc3NpZnlUYXhEZWR1Y3RpYmlsaXR5UmVzcG9uc2USXAoPRXhwb3J0VGF4UmV0dXJuEiMucGZpbmFuY2UudjEuRXhwb3J0VGF4UmV0dXJuUmVxdWVzdBokLnBmaW5hbmNlLnYxLkV4cG9ydFRheFJldHVyblJlc3BvbnNlEnkKGEV4cG9ydFRyYW5zYWN0aW9uc1N0cmVhbRIsLnBmaW5hbmNlLnYxLkV4cG9ydFRyYW5zYWN0aW9uc1N0cmVhbVJlcXVlc3QaLS5wZmluYW5jZS52MS5FeHBvcnRUcmFuc2FjdGlvbnNTdHJlYW1SZXNwb25zZTABEnQKF0ZpbmRQb3RlbnRpYWxEZWR1Y3Rpb25zEisucGZpbmFuY2UudjEuRmluZFBvdGVudGlhbERlZHVjdGlvbnNSZXF1ZXN0GiwucGZpbmFuY2UudjEuRmluZFBvdGVudGlhbERlZHVjdGlvbnNSZXNwb25zZRJ3ChhHZXRUYXhEZWR1Y3Rpb25DaGVja2xpc3QSLC5wZmluYW5jZS52MS5HZXRUYXhEZWR1Y3Rpb25DaGVja2xpc3RSZXF1ZXN0Gi0ucGZpbmFuY2UudjEuR2V0VGF4RGVkdWN0aW9uQ2hlY2tsaXN0UmVzcG9uc2USXAoPQ29tcGFyZVRheFllYXJzEiMucGZpbmFuY2UudjEuQ29tcGFyZVRheFllYXJzUmVxdWVzdBokLnBmaW5hbmNlLnYxLkNvbXBhcmVUYXhZZWFyc1Jlc3BvbnNlEmsKFEdldERlZHVjdGlvblByb2dyZXNzEigucGZpbmFuY2UudjEuR2V0RGVkdWN0aW9uUHJvZ3Jlc3NSZXF1ZXN0GikucGZpbmFuY2UudjEuR2V0RGVkdWN0aW9uUHJvZ3Jlc3NSZXNwb25zZRJiChFMb2NrRmluYW5jaWFsWWVhchIlLnBmaW5hbmNlLnYxLkxvY2tGaW5hbmNpYWxZZWFyUmVxdWVzdBomLnBmaW5hbmNlLnYxLkxvY2tGaW5hbmNpYWxZZWFyUmVzcG9uc2USaAoTVW5sb2NrRmluYW5jaWFsWWVhchInLnBmaW5hbmNlLnYxLlVubG9ja0ZpbmFuY2lhbFllYXJSZXF1ZXN0GigucGZpbmFuY2UudjEuVW5sb2NrRmluYW5jaWFsWWVhclJlc3BvbnNlElYKDUdldEdzdFN1bW1hcnkSIS5wZmluYW5jZS52MS5HZXRHc3RTdW1tYXJ5UmVxdWVzdBoiLnBmaW5hbmNlLnYxLkdldEdzdFN1bW1hcnlSZXNwb25zZRJNCgpSdW5UYXhFdmFsEh4ucGZpbmFuY2UudjEuUnVuVGF4RXZhbFJlcXVlc3QaHy5wZmluYW5jZS52MS5SdW5UYXhFdmFsUmVzcG9uc2USVgoNR2V0VGF4RXZhbEpvYhIhLnBmaW5hbmNlLnYxLkdldFRheEV2YWxKb2JSZXF1ZXN0GiIucGZpbmFuY2UudjEuR2V0VGF4RXZhbEpvYlJlc3BvbnNlElkKDkV4cG9ydFJlY2VpcHRzEiIucGZpbmFuY2UudjEuRXhwb3J0UmVjZWlwdHNSZXF1ZXN0GiMucGZpbmFuY2UudjEuRXhwb3J0UmVjZWlwdHNSZXNwb25zZRJiChFSZWdpc3RlclB1c2hUb2tlbhIlLnBmaW5hbmNlLnYxLlJlZ2lzdGVyUHVzaFRva2VuUmVxdWVzdBomLnBmaW5hbmNlLnYxLlJlZ2lzdGVyUHVzaFRva2VuUmVzcG9uc2USaAoTVW5yZWdpc3RlclB1c2hUb2tlbhInLnBmaW5hbmNlLnYxLlVucmVnaXN0ZXJQdXNoVG9rZW5SZXF1ZXN0GigucGZpbmFuY2UudjEuVW5yZWdpc3RlclB1c2hUb2tlblJlc3BvbnNlElkKDkNyZWF0ZUFwaVRva2VuEiIucGZpbmFuY2UudjEuQ3JlYXRlQXBpVG9rZW5SZXF1ZXN0GiMucGZpbmFuY2UudjEuQ3JlYXRlQXBpVG9rZW5SZXNwb25zZRJWCg1MaXN0QXBpVG9rZW5zEiEucGZpbmFuY2UudjEuTGlzdEFwaVRva2Vuc1JlcXVlc3QaIi5wZmluYW5jZS52MS5MaXN0QXBpVG9rZW5zUmVzcG9uc2USWQoOUmV2b2tlQXBpVG9rZW4SIi5wZmluYW5jZS52MS5SZXZva2VBcGlUb2tlblJlcXVlc3QaIy5wZmluYW5jZS52MS5SZXZva2VBcGlUb2tlblJlc3BvbnNlQrYBCg9jb20ucGZpbmFuY2UudjFCE0ZpbmFuY2VTZXJ2aWNlUHJvdG9QAVpBZ2l0aHViLmNvbS9jYXN0bGVtaWxrL3BmaW5hbmNlL2JhY2tlbmQvZ2VuL3BmaW5hbmNlL3YxO3BmaW5hbmNldjGiAgNQWFiqAgtQZmluYW5jZS5WMcoCC1BmaW5hbmNlXFYx4gIXUGZpbmFuY2VcVjFcR1BCTWV0YWRhdGHqAgxQZmluYW5jZTo6VjFiBnByb3RvMw", [file_google_protobuf_empty, file_google_protobuf_timestamp, file_pfinance_v1_types]);
 
 /**
  * User operations
@@ -125,6 +125,72 @@ export type DeleteUserRequest = Message<"pfinance.v1.DeleteUserRequest"> & {
 export const DeleteUserRequestSchema: GenMessage<DeleteUserRequest> = /*@__PURE__*/
   messageDesc(file_pfinance_v1_finance_service, 4);
 
+/**
+ * @generated from message pfinance.v1.PrepareClearUserDataRequest
+ */
+export type PrepareClearUserDataRequest = Message<"pfinance.v1.PrepareClearUserDataRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message pfinance.v1.PrepareClearUserDataRequest.
+ * Use `create(PrepareClearUserDataRequestSchema)` to create a new message.
+ */
+export const PrepareClearUserDataRequestSchema: GenMessage<PrepareClearUserDataRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 5);
+
+/**
+ * @generated from message pfinance.v1.PrepareClearUserDataResponse
+ */
+export type PrepareClearUserDataResponse = Message<"pfinance.v1.PrepareClearUserDataResponse"> & {
+  /**
+   * Echo back in ClearUserDataRequest
+   *
+   * @generated from field: string confirmation_token = 1;
+   */
+  confirmationToken: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 2;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: int64 expense_count = 3;
+   */
+  expenseCount: bigint;
+
+  /**
+   * @generated from field: int64 income_count = 4;
+   */
+  incomeCount: bigint;
+
+  /**
+   * @generated from field: int64 budget_count = 5;
+   */
+  budgetCount: bigint;
+
+  /**
+   * @generated from field: int64 goal_count = 6;
+   */
+  goalCount: bigint;
+
+  /**
+   * @generated from field: int64 recurring_transaction_count = 7;
+   */
+  recurringTransactionCount: bigint;
+};
+
+/**
+ * Describes the message pfinance.v1.PrepareClearUserDataResponse.
+ * Use `create(PrepareClearUserDataResponseSchema)` to create a new message.
+ */
+export const PrepareClearUserDataResponseSchema: GenMessage<PrepareClearUserDataResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 6);
+
 /**
  * @generated from message pfinance.v1.ClearUserDataRequest
  */
@@ -140,6 +206,13 @@ export type ClearUserDataRequest = Message<"pfinance.v1.ClearUserDataRequest"> &
    * @generated from field: bool confirm = 2;
    */
   confirm: boolean;
+
+  /**
+   * From PrepareClearUserData; rejected if expired or the data changed since
+   *
+   * @generated from field: string confirmation_token = 3;
+   */
+  confirmationToken: string;
 };
 
 /**
@@ -147,7 +220,7 @@ export type ClearUserDataRequest = Message<"pfinance.v1.ClearUserDataRequest"> &
  * Use `create(ClearUserDataRequestSchema)` to create a new message.
  */
 export const ClearUserDataRequestSchema: GenMessage<ClearUserDataRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 5);
+  messageDesc(file_pfinance_v1_finance_service, 7);
 
 /**
  * @generated from message pfinance.v1.ExportUserDataRequest
@@ -171,7 +244,7 @@ export type ExportUserDataRequest = Message<"pfinance.v1.ExportUserDataRequest">
  * Use `create(ExportUserDataRequestSchema)` to create a new message.
  */
 export const ExportUserDataRequestSchema: GenMessage<ExportUserDataRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 6);
+  messageDesc(file_pfinance_v1_finance_service, 8);
 
 /**
  * @generated from message pfinance.v1.ExportUserDataResponse
@@ -198,7 +271,221 @@ export type ExportUserDataResponse = Message<"pfinance.v1.ExportUserDataResponse
  * Use `create(ExportUserDataResponseSchema)` to create a new message.
  */
 export const ExportUserDataResponseSchema: GenMessage<ExportUserDataResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 7);
+  messageDesc(file_pfinance_v1_finance_service, 9);
+
+/**
+ * @generated from message pfinance.v1.GetUserDataSummaryRequest
+ */
+export type GetUserDataSummaryRequest = Message<"pfinance.v1.GetUserDataSummaryRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message pfinance.v1.GetUserDataSummaryRequest.
+ * Use `create(GetUserDataSummaryRequestSchema)` to create a new message.
+ */
+export const GetUserDataSummaryRequestSchema: GenMessage<GetUserDataSummaryRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 10);
+
+/**
+ * Overview of the data held for a user, e.g. before exporting or clearing it.
+ *
+ * @generated from message pfinance.v1.GetUserDataSummaryResponse
+ */
+export type GetUserDataSummaryResponse = Message<"pfinance.v1.GetUserDataSummaryResponse"> & {
+  /**
+   * @generated from field: int64 expense_count = 1;
+   */
+  expenseCount: bigint;
+
+  /**
+   * @generated from field: int64 income_count = 2;
+   */
+  incomeCount: bigint;
+
+  /**
+   * @generated from field: int64 budget_count = 3;
+   */
+  budgetCount: bigint;
+
+  /**
+   * @generated from field: int64 goal_count = 4;
+   */
+  goalCount: bigint;
+
+  /**
+   * @generated from field: int64 recurring_transaction_count = 5;
+   */
+  recurringTransactionCount: bigint;
+
+  /**
+   * @generated from field: int64 notification_count = 6;
+   */
+  notificationCount: bigint;
+
+  /**
+   * Active (unrevoked) tokens only
+   *
+   * @generated from field: int64 api_token_count = 7;
+   */
+  apiTokenCount: bigint;
+
+  /**
+   * Unset when there are no dated transactions
+   *
+   * @generated from field: google.protobuf.Timestamp earliest_transaction_date = 8;
+   */
+  earliestTransactionDate?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp latest_transaction_date = 9;
+   */
+  latestTransactionDate?: Timestamp;
+
+  /**
+   * Receipts and attachments on the user's expenses
+   *
+   * @generated from field: int64 attachment_count = 10;
+   */
+  attachmentCount: bigint;
+
+  /**
+   * Unset when file storage is not configured
+   *
+   * @generated from field: optional int64 attachment_storage_bytes = 11;
+   */
+  attachmentStorageBytes?: bigint;
+};
+
+/**
+ * Describes the message pfinance.v1.GetUserDataSummaryResponse.
+ * Use `create(GetUserDataSummaryResponseSchema)` to create a new message.
+ */
+export const GetUserDataSummaryResponseSchema: GenMessage<GetUserDataSummaryResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 11);
+
+/**
+ * @generated from message pfinance.v1.ListRecordsMissingCentsRequest
+ */
+export type ListRecordsMissingCentsRequest = Message<"pfinance.v1.ListRecordsMissingCentsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message pfinance.v1.ListRecordsMissingCentsRequest.
+ * Use `create(ListRecordsMissingCentsRequestSchema)` to create a new message.
+ */
+export const ListRecordsMissingCentsRequestSchema: GenMessage<ListRecordsMissingCentsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 12);
+
+/**
+ * ListRecordsMissingCentsResponse lists records with a dollar amount but no
+ * amount_cents, which the cents backfill has yet to reach
+ *
+ * @generated from message pfinance.v1.ListRecordsMissingCentsResponse
+ */
+export type ListRecordsMissingCentsResponse = Message<"pfinance.v1.ListRecordsMissingCentsResponse"> & {
+  /**
+   * @generated from field: repeated pfinance.v1.Expense expenses = 1;
+   */
+  expenses: Expense[];
+
+  /**
+   * @generated from field: repeated pfinance.v1.Income incomes = 2;
+   */
+  incomes: Income[];
+};
+
+/**
+ * Describes the message pfinance.v1.ListRecordsMissingCentsResponse.
+ * Use `create(ListRecordsMissingCentsResponseSchema)` to create a new message.
+ */
+export const ListRecordsMissingCentsResponseSchema: GenMessage<ListRecordsMissingCentsResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 13);
+
+/**
+ * @generated from message pfinance.v1.ExportAllDataRequest
+ */
+export type ExportAllDataRequest = Message<"pfinance.v1.ExportAllDataRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Records per message (default 500, max 1000)
+   *
+   * @generated from field: int32 batch_size = 2;
+   */
+  batchSize: number;
+};
+
+/**
+ * Describes the message pfinance.v1.ExportAllDataRequest.
+ * Use `create(ExportAllDataRequestSchema)` to create a new message.
+ */
+export const ExportAllDataRequestSchema: GenMessage<ExportAllDataRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 14);
+
+/**
+ * Streams a JSON archive of everything held for the user. Concatenating the
+ * data of every message in order yields one JSON document:
+ *   {"schema_version": 1, "exported_at": ..., "user_id": ...,
+ *    "collections": {"expenses": [...], "incomes": [...], ...},
+ *    "manifest": {"collections": [{"name": "expenses", "record_count": 3}, ...]}}
+ * Records use the proto JSON mapping with snake_case field names.
+ *
+ * @generated from message pfinance.v1.ExportAllDataResponse
+ */
+export type ExportAllDataResponse = Message<"pfinance.v1.ExportAllDataResponse"> & {
+  /**
+   * Next chunk of the archive
+   *
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * Set on the first message only
+   *
+   * @generated from field: string filename = 2;
+   */
+  filename: string;
+
+  /**
+   * Set on the first message only
+   *
+   * @generated from field: string content_type = 3;
+   */
+  contentType: string;
+
+  /**
+   * Collection this chunk's records belong to
+   *
+   * @generated from field: string collection = 4;
+   */
+  collection: string;
+
+  /**
+   * Records in this chunk
+   *
+   * @generated from field: int32 record_count = 5;
+   */
+  recordCount: number;
+};
+
+/**
+ * Describes the message pfinance.v1.ExportAllDataResponse.
+ * Use `create(ExportAllDataResponseSchema)` to create a new message.
+ */
+export const ExportAllDataResponseSchema: GenMessage<ExportAllDataResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 15);
 
 /**
  * Expense operations
@@ -322,6 +609,27 @@ export type CreateExpenseRequest = Message<"pfinance.v1.CreateExpenseRequest"> &
    * @generated from field: string receipt_storage_path = 19;
    */
   receiptStoragePath: string;
+
+  /**
+   * Optional free-form note
+   *
+   * @generated from field: string note = 20;
+   */
+  note: string;
+
+  /**
+   * Optional - GST component of the amount
+   *
+   * @generated from field: int64 gst_cents = 21;
+   */
+  gstCents: bigint;
+
+  /**
+   * Computes gst_cents as 1/11th of the amount when not given
+   *
+   * @generated from field: bool is_gst_registered = 22;
+   */
+  isGstRegistered: boolean;
 };
 
 /**
@@ -329,7 +637,7 @@ export type CreateExpenseRequest = Message<"pfinance.v1.CreateExpenseRequest"> &
  * Use `create(CreateExpenseRequestSchema)` to create a new message.
  */
 export const CreateExpenseRequestSchema: GenMessage<CreateExpenseRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 8);
+  messageDesc(file_pfinance_v1_finance_service, 16);
 
 /**
  * @generated from message pfinance.v1.CreateExpenseResponse
@@ -346,7 +654,7 @@ export type CreateExpenseResponse = Message<"pfinance.v1.CreateExpenseResponse">
  * Use `create(CreateExpenseResponseSchema)` to create a new message.
  */
 export const CreateExpenseResponseSchema: GenMessage<CreateExpenseResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 9);
+  messageDesc(file_pfinance_v1_finance_service, 17);
 
 /**
  * @generated from message pfinance.v1.GetExpenseRequest
@@ -356,6 +664,13 @@ export type GetExpenseRequest = Message<"pfinance.v1.GetExpenseRequest"> & {
    * @generated from field: string expense_id = 1;
    */
   expenseId: string;
+
+  /**
+   * Also return contributions sourced from or creating this expense
+   *
+   * @generated from field: bool include_contributions = 2;
+   */
+  includeContributions: boolean;
 };
 
 /**
@@ -363,7 +678,7 @@ export type GetExpenseRequest = Message<"pfinance.v1.GetExpenseRequest"> & {
  * Use `create(GetExpenseRequestSchema)` to create a new message.
  */
 export const GetExpenseRequestSchema: GenMessage<GetExpenseRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 10);
+  messageDesc(file_pfinance_v1_finance_service, 18);
 
 /**
  * @generated from message pfinance.v1.GetExpenseResponse
@@ -373,6 +688,13 @@ export type GetExpenseResponse = Message<"pfinance.v1.GetExpenseResponse"> & {
    * @generated from field: pfinance.v1.Expense expense = 1;
    */
   expense?: Expense;
+
+  /**
+   * Set when include_contributions is true
+   *
+   * @generated from field: repeated pfinance.v1.ExpenseContribution contributions = 2;
+   */
+  contributions: ExpenseContribution[];
 };
 
 /**
@@ -380,7 +702,7 @@ export type GetExpenseResponse = Message<"pfinance.v1.GetExpenseResponse"> & {
  * Use `create(GetExpenseResponseSchema)` to create a new message.
  */
 export const GetExpenseResponseSchema: GenMessage<GetExpenseResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 11);
+  messageDesc(file_pfinance_v1_finance_service, 19);
 
 /**
  * @generated from message pfinance.v1.UpdateExpenseRequest
@@ -480,6 +802,27 @@ export type UpdateExpenseRequest = Message<"pfinance.v1.UpdateExpenseRequest"> &
    * @generated from field: string receipt_storage_path = 17;
    */
   receiptStoragePath: string;
+
+  /**
+   * Replaces the note when set; empty clears it
+   *
+   * @generated from field: optional string note = 18;
+   */
+  note?: string;
+
+  /**
+   * Replaces the GST component when set
+   *
+   * @generated from field: optional int64 gst_cents = 19;
+   */
+  gstCents?: bigint;
+
+  /**
+   * Recomputes gst_cents from the amount when not given
+   *
+   * @generated from field: bool is_gst_registered = 20;
+   */
+  isGstRegistered: boolean;
 };
 
 /**
@@ -487,7 +830,7 @@ export type UpdateExpenseRequest = Message<"pfinance.v1.UpdateExpenseRequest"> &
  * Use `create(UpdateExpenseRequestSchema)` to create a new message.
  */
 export const UpdateExpenseRequestSchema: GenMessage<UpdateExpenseRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 12);
+  messageDesc(file_pfinance_v1_finance_service, 20);
 
 /**
  * @generated from message pfinance.v1.UpdateExpenseResponse
@@ -504,7 +847,7 @@ export type UpdateExpenseResponse = Message<"pfinance.v1.UpdateExpenseResponse">
  * Use `create(UpdateExpenseResponseSchema)` to create a new message.
  */
 export const UpdateExpenseResponseSchema: GenMessage<UpdateExpenseResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 13);
+  messageDesc(file_pfinance_v1_finance_service, 21);
 
 /**
  * @generated from message pfinance.v1.DeleteExpenseRequest
@@ -521,7 +864,7 @@ export type DeleteExpenseRequest = Message<"pfinance.v1.DeleteExpenseRequest"> &
  * Use `create(DeleteExpenseRequestSchema)` to create a new message.
  */
 export const DeleteExpenseRequestSchema: GenMessage<DeleteExpenseRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 14);
+  messageDesc(file_pfinance_v1_finance_service, 22);
 
 /**
  * @generated from message pfinance.v1.ListExpensesRequest
@@ -558,36 +901,201 @@ export type ListExpensesRequest = Message<"pfinance.v1.ListExpensesRequest"> & {
    * @generated from field: string page_token = 6;
    */
   pageToken: string;
-};
-
-/**
- * Describes the message pfinance.v1.ListExpensesRequest.
- * Use `create(ListExpensesRequestSchema)` to create a new message.
- */
-export const ListExpensesRequestSchema: GenMessage<ListExpensesRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 15);
 
-/**
- * @generated from message pfinance.v1.ListExpensesResponse
- */
-export type ListExpensesResponse = Message<"pfinance.v1.ListExpensesResponse"> & {
   /**
-   * @generated from field: repeated pfinance.v1.Expense expenses = 1;
+   * Optional - filter by category
+   *
+   * @generated from field: optional pfinance.v1.ExpenseCategory category = 7;
    */
-  expenses: Expense[];
+  category?: ExpenseCategory;
 
   /**
-   * @generated from field: string next_page_token = 2;
+   * Optional - filter by tax-deductible flag
+   *
+   * @generated from field: optional bool is_tax_deductible = 8;
    */
-  nextPageToken: string;
-};
+  isTaxDeductible?: boolean;
 
-/**
- * Describes the message pfinance.v1.ListExpensesResponse.
+  /**
+   * Optional - filter by tags (case-insensitive, max 30)
+   *
+   * @generated from field: repeated string tags = 9;
+   */
+  tags: string[];
+
+  /**
+   * Require every tag rather than any of them
+   *
+   * @generated from field: bool match_all_tags = 10;
+   */
+  matchAllTags: boolean;
+
+  /**
+   * Optional - only expenses with (true) or without (false) a receipt or attachment
+   *
+   * @generated from field: optional bool has_attachment = 11;
+   */
+  hasAttachment?: boolean;
+};
+
+/**
+ * Describes the message pfinance.v1.ListExpensesRequest.
+ * Use `create(ListExpensesRequestSchema)` to create a new message.
+ */
+export const ListExpensesRequestSchema: GenMessage<ListExpensesRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 23);
+
+/**
+ * @generated from message pfinance.v1.ListExpensesResponse
+ */
+export type ListExpensesResponse = Message<"pfinance.v1.ListExpensesResponse"> & {
+  /**
+   * @generated from field: repeated pfinance.v1.Expense expenses = 1;
+   */
+  expenses: Expense[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message pfinance.v1.ListExpensesResponse.
  * Use `create(ListExpensesResponseSchema)` to create a new message.
  */
 export const ListExpensesResponseSchema: GenMessage<ListExpensesResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 16);
+  messageDesc(file_pfinance_v1_finance_service, 24);
+
+/**
+ * @generated from message pfinance.v1.GetTransactionCountsRequest
+ */
+export type GetTransactionCountsRequest = Message<"pfinance.v1.GetTransactionCountsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Optional - count group transactions instead
+   *
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_date = 3;
+   */
+  startDate?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_date = 4;
+   */
+  endDate?: Timestamp;
+};
+
+/**
+ * Describes the message pfinance.v1.GetTransactionCountsRequest.
+ * Use `create(GetTransactionCountsRequestSchema)` to create a new message.
+ */
+export const GetTransactionCountsRequestSchema: GenMessage<GetTransactionCountsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 25);
+
+/**
+ * @generated from message pfinance.v1.GetTransactionCountsResponse
+ */
+export type GetTransactionCountsResponse = Message<"pfinance.v1.GetTransactionCountsResponse"> & {
+  /**
+   * @generated from field: int64 expense_count = 1;
+   */
+  expenseCount: bigint;
+
+  /**
+   * @generated from field: int64 income_count = 2;
+   */
+  incomeCount: bigint;
+};
+
+/**
+ * Describes the message pfinance.v1.GetTransactionCountsResponse.
+ * Use `create(GetTransactionCountsResponseSchema)` to create a new message.
+ */
+export const GetTransactionCountsResponseSchema: GenMessage<GetTransactionCountsResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 26);
+
+/**
+ * @generated from message pfinance.v1.GetTopExpensesRequest
+ */
+export type GetTopExpensesRequest = Message<"pfinance.v1.GetTopExpensesRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Optional - rank group expenses instead
+   *
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_date = 3;
+   */
+  startDate?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_date = 4;
+   */
+  endDate?: Timestamp;
+
+  /**
+   * Defaults to 10, max 100
+   *
+   * @generated from field: int32 limit = 5;
+   */
+  limit: number;
+
+  /**
+   * Optional - only rank this category
+   *
+   * @generated from field: optional pfinance.v1.ExpenseCategory category = 6;
+   */
+  category?: ExpenseCategory;
+};
+
+/**
+ * Describes the message pfinance.v1.GetTopExpensesRequest.
+ * Use `create(GetTopExpensesRequestSchema)` to create a new message.
+ */
+export const GetTopExpensesRequestSchema: GenMessage<GetTopExpensesRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 27);
+
+/**
+ * @generated from message pfinance.v1.GetTopExpensesResponse
+ */
+export type GetTopExpensesResponse = Message<"pfinance.v1.GetTopExpensesResponse"> & {
+  /**
+   * Largest first, with amount_cents always set
+   *
+   * @generated from field: repeated pfinance.v1.Expense expenses = 1;
+   */
+  expenses: Expense[];
+
+  /**
+   * Sum of the returned expenses
+   *
+   * @generated from field: int64 total_cents = 2;
+   */
+  totalCents: bigint;
+};
+
+/**
+ * Describes the message pfinance.v1.GetTopExpensesResponse.
+ * Use `create(GetTopExpensesResponseSchema)` to create a new message.
+ */
+export const GetTopExpensesResponseSchema: GenMessage<GetTopExpensesResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 28);
 
 /**
  * @generated from message pfinance.v1.BatchCreateExpensesRequest
@@ -616,7 +1124,7 @@ export type BatchCreateExpensesRequest = Message<"pfinance.v1.BatchCreateExpense
  * Use `create(BatchCreateExpensesRequestSchema)` to create a new message.
  */
 export const BatchCreateExpensesRequestSchema: GenMessage<BatchCreateExpensesRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 17);
+  messageDesc(file_pfinance_v1_finance_service, 29);
 
 /**
  * @generated from message pfinance.v1.BatchCreateExpensesResponse
@@ -633,7 +1141,68 @@ export type BatchCreateExpensesResponse = Message<"pfinance.v1.BatchCreateExpens
  * Use `create(BatchCreateExpensesResponseSchema)` to create a new message.
  */
 export const BatchCreateExpensesResponseSchema: GenMessage<BatchCreateExpensesResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 18);
+  messageDesc(file_pfinance_v1_finance_service, 30);
+
+/**
+ * Creates a copy of template on every matching day in [start_date, end_date].
+ *
+ * @generated from message pfinance.v1.QuickAddRepeatingExpenseRequest
+ */
+export type QuickAddRepeatingExpenseRequest = Message<"pfinance.v1.QuickAddRepeatingExpenseRequest"> & {
+  /**
+   * Expense to repeat; its date is ignored
+   *
+   * @generated from field: pfinance.v1.CreateExpenseRequest template = 1;
+   */
+  template?: CreateExpenseRequest;
+
+  /**
+   * First day, inclusive
+   *
+   * @generated from field: google.protobuf.Timestamp start_date = 2;
+   */
+  startDate?: Timestamp;
+
+  /**
+   * Last day, inclusive; at most 366 days after start_date
+   *
+   * @generated from field: google.protobuf.Timestamp end_date = 3;
+   */
+  endDate?: Timestamp;
+
+  /**
+   * Only add on these days; empty means every day
+   *
+   * @generated from field: repeated pfinance.v1.DayOfWeek days_of_week = 4;
+   */
+  daysOfWeek: DayOfWeek[];
+};
+
+/**
+ * Describes the message pfinance.v1.QuickAddRepeatingExpenseRequest.
+ * Use `create(QuickAddRepeatingExpenseRequestSchema)` to create a new message.
+ */
+export const QuickAddRepeatingExpenseRequestSchema: GenMessage<QuickAddRepeatingExpenseRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 31);
+
+/**
+ * @generated from message pfinance.v1.QuickAddRepeatingExpenseResponse
+ */
+export type QuickAddRepeatingExpenseResponse = Message<"pfinance.v1.QuickAddRepeatingExpenseResponse"> & {
+  /**
+   * In date order
+   *
+   * @generated from field: repeated string expense_ids = 1;
+   */
+  expenseIds: string[];
+};
+
+/**
+ * Describes the message pfinance.v1.QuickAddRepeatingExpenseResponse.
+ * Use `create(QuickAddRepeatingExpenseResponseSchema)` to create a new message.
+ */
+export const QuickAddRepeatingExpenseResponseSchema: GenMessage<QuickAddRepeatingExpenseResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 32);
 
 /**
  * Income operations
@@ -689,6 +1258,20 @@ export type CreateIncomeRequest = Message<"pfinance.v1.CreateIncomeRequest"> & {
    * @generated from field: int64 amount_cents = 9;
    */
   amountCents: bigint;
+
+  /**
+   * Optional - GST component of the amount
+   *
+   * @generated from field: int64 gst_cents = 10;
+   */
+  gstCents: bigint;
+
+  /**
+   * Computes gst_cents as 1/11th of the amount when not given
+   *
+   * @generated from field: bool is_gst_registered = 11;
+   */
+  isGstRegistered: boolean;
 };
 
 /**
@@ -696,7 +1279,7 @@ export type CreateIncomeRequest = Message<"pfinance.v1.CreateIncomeRequest"> & {
  * Use `create(CreateIncomeRequestSchema)` to create a new message.
  */
 export const CreateIncomeRequestSchema: GenMessage<CreateIncomeRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 19);
+  messageDesc(file_pfinance_v1_finance_service, 33);
 
 /**
  * @generated from message pfinance.v1.CreateIncomeResponse
@@ -713,7 +1296,7 @@ export type CreateIncomeResponse = Message<"pfinance.v1.CreateIncomeResponse"> &
  * Use `create(CreateIncomeResponseSchema)` to create a new message.
  */
 export const CreateIncomeResponseSchema: GenMessage<CreateIncomeResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 20);
+  messageDesc(file_pfinance_v1_finance_service, 34);
 
 /**
  * @generated from message pfinance.v1.GetIncomeRequest
@@ -723,6 +1306,13 @@ export type GetIncomeRequest = Message<"pfinance.v1.GetIncomeRequest"> & {
    * @generated from field: string income_id = 1;
    */
   incomeId: string;
+
+  /**
+   * Also return contributions sourced from or creating this income
+   *
+   * @generated from field: bool include_contributions = 2;
+   */
+  includeContributions: boolean;
 };
 
 /**
@@ -730,7 +1320,7 @@ export type GetIncomeRequest = Message<"pfinance.v1.GetIncomeRequest"> & {
  * Use `create(GetIncomeRequestSchema)` to create a new message.
  */
 export const GetIncomeRequestSchema: GenMessage<GetIncomeRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 21);
+  messageDesc(file_pfinance_v1_finance_service, 35);
 
 /**
  * @generated from message pfinance.v1.GetIncomeResponse
@@ -740,6 +1330,13 @@ export type GetIncomeResponse = Message<"pfinance.v1.GetIncomeResponse"> & {
    * @generated from field: pfinance.v1.Income income = 1;
    */
   income?: Income;
+
+  /**
+   * Set when include_contributions is true
+   *
+   * @generated from field: repeated pfinance.v1.IncomeContribution contributions = 2;
+   */
+  contributions: IncomeContribution[];
 };
 
 /**
@@ -747,7 +1344,7 @@ export type GetIncomeResponse = Message<"pfinance.v1.GetIncomeResponse"> & {
  * Use `create(GetIncomeResponseSchema)` to create a new message.
  */
 export const GetIncomeResponseSchema: GenMessage<GetIncomeResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 22);
+  messageDesc(file_pfinance_v1_finance_service, 36);
 
 /**
  * @generated from message pfinance.v1.UpdateIncomeRequest
@@ -789,6 +1386,20 @@ export type UpdateIncomeRequest = Message<"pfinance.v1.UpdateIncomeRequest"> & {
    * @generated from field: int64 amount_cents = 7;
    */
   amountCents: bigint;
+
+  /**
+   * Replaces the GST component when set
+   *
+   * @generated from field: optional int64 gst_cents = 8;
+   */
+  gstCents?: bigint;
+
+  /**
+   * Recomputes gst_cents from the amount when not given
+   *
+   * @generated from field: bool is_gst_registered = 9;
+   */
+  isGstRegistered: boolean;
 };
 
 /**
@@ -796,7 +1407,7 @@ export type UpdateIncomeRequest = Message<"pfinance.v1.UpdateIncomeRequest"> & {
  * Use `create(UpdateIncomeRequestSchema)` to create a new message.
  */
 export const UpdateIncomeRequestSchema: GenMessage<UpdateIncomeRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 23);
+  messageDesc(file_pfinance_v1_finance_service, 37);
 
 /**
  * @generated from message pfinance.v1.UpdateIncomeResponse
@@ -813,7 +1424,7 @@ export type UpdateIncomeResponse = Message<"pfinance.v1.UpdateIncomeResponse"> &
  * Use `create(UpdateIncomeResponseSchema)` to create a new message.
  */
 export const UpdateIncomeResponseSchema: GenMessage<UpdateIncomeResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 24);
+  messageDesc(file_pfinance_v1_finance_service, 38);
 
 /**
  * @generated from message pfinance.v1.DeleteIncomeRequest
@@ -830,7 +1441,7 @@ export type DeleteIncomeRequest = Message<"pfinance.v1.DeleteIncomeRequest"> & {
  * Use `create(DeleteIncomeRequestSchema)` to create a new message.
  */
 export const DeleteIncomeRequestSchema: GenMessage<DeleteIncomeRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 25);
+  messageDesc(file_pfinance_v1_finance_service, 39);
 
 /**
  * @generated from message pfinance.v1.ListIncomesRequest
@@ -867,6 +1478,23 @@ export type ListIncomesRequest = Message<"pfinance.v1.ListIncomesRequest"> & {
    * @generated from field: string page_token = 6;
    */
   pageToken: string;
+
+  /**
+   * Optional case-insensitive substring filter on source
+   *
+   * @generated from field: string source = 7;
+   */
+  source: string;
+
+  /**
+   * @generated from field: pfinance.v1.SortField sort_field = 8;
+   */
+  sortField: SortField;
+
+  /**
+   * @generated from field: pfinance.v1.SortDirection sort_direction = 9;
+   */
+  sortDirection: SortDirection;
 };
 
 /**
@@ -874,7 +1502,7 @@ export type ListIncomesRequest = Message<"pfinance.v1.ListIncomesRequest"> & {
  * Use `create(ListIncomesRequestSchema)` to create a new message.
  */
 export const ListIncomesRequestSchema: GenMessage<ListIncomesRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 26);
+  messageDesc(file_pfinance_v1_finance_service, 40);
 
 /**
  * @generated from message pfinance.v1.ListIncomesResponse
@@ -896,7 +1524,7 @@ export type ListIncomesResponse = Message<"pfinance.v1.ListIncomesResponse"> & {
  * Use `create(ListIncomesResponseSchema)` to create a new message.
  */
 export const ListIncomesResponseSchema: GenMessage<ListIncomesResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 27);
+  messageDesc(file_pfinance_v1_finance_service, 41);
 
 /**
  * Tax configuration
@@ -922,7 +1550,7 @@ export type GetTaxConfigRequest = Message<"pfinance.v1.GetTaxConfigRequest"> & {
  * Use `create(GetTaxConfigRequestSchema)` to create a new message.
  */
 export const GetTaxConfigRequestSchema: GenMessage<GetTaxConfigRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 28);
+  messageDesc(file_pfinance_v1_finance_service, 42);
 
 /**
  * @generated from message pfinance.v1.GetTaxConfigResponse
@@ -939,7 +1567,7 @@ export type GetTaxConfigResponse = Message<"pfinance.v1.GetTaxConfigResponse"> &
  * Use `create(GetTaxConfigResponseSchema)` to create a new message.
  */
 export const GetTaxConfigResponseSchema: GenMessage<GetTaxConfigResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 29);
+  messageDesc(file_pfinance_v1_finance_service, 43);
 
 /**
  * @generated from message pfinance.v1.UpdateTaxConfigRequest
@@ -968,7 +1596,7 @@ export type UpdateTaxConfigRequest = Message<"pfinance.v1.UpdateTaxConfigRequest
  * Use `create(UpdateTaxConfigRequestSchema)` to create a new message.
  */
 export const UpdateTaxConfigRequestSchema: GenMessage<UpdateTaxConfigRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 30);
+  messageDesc(file_pfinance_v1_finance_service, 44);
 
 /**
  * @generated from message pfinance.v1.UpdateTaxConfigResponse
@@ -985,7 +1613,7 @@ export type UpdateTaxConfigResponse = Message<"pfinance.v1.UpdateTaxConfigRespon
  * Use `create(UpdateTaxConfigResponseSchema)` to create a new message.
  */
 export const UpdateTaxConfigResponseSchema: GenMessage<UpdateTaxConfigResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 31);
+  messageDesc(file_pfinance_v1_finance_service, 45);
 
 /**
  * Group operations
@@ -1014,7 +1642,7 @@ export type CreateGroupRequest = Message<"pfinance.v1.CreateGroupRequest"> & {
  * Use `create(CreateGroupRequestSchema)` to create a new message.
  */
 export const CreateGroupRequestSchema: GenMessage<CreateGroupRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 32);
+  messageDesc(file_pfinance_v1_finance_service, 46);
 
 /**
  * @generated from message pfinance.v1.CreateGroupResponse
@@ -1031,7 +1659,7 @@ export type CreateGroupResponse = Message<"pfinance.v1.CreateGroupResponse"> & {
  * Use `create(CreateGroupResponseSchema)` to create a new message.
  */
 export const CreateGroupResponseSchema: GenMessage<CreateGroupResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 33);
+  messageDesc(file_pfinance_v1_finance_service, 47);
 
 /**
  * @generated from message pfinance.v1.GetGroupRequest
@@ -1048,7 +1676,7 @@ export type GetGroupRequest = Message<"pfinance.v1.GetGroupRequest"> & {
  * Use `create(GetGroupRequestSchema)` to create a new message.
  */
 export const GetGroupRequestSchema: GenMessage<GetGroupRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 34);
+  messageDesc(file_pfinance_v1_finance_service, 48);
 
 /**
  * @generated from message pfinance.v1.GetGroupResponse
@@ -1065,7 +1693,7 @@ export type GetGroupResponse = Message<"pfinance.v1.GetGroupResponse"> & {
  * Use `create(GetGroupResponseSchema)` to create a new message.
  */
 export const GetGroupResponseSchema: GenMessage<GetGroupResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 35);
+  messageDesc(file_pfinance_v1_finance_service, 49);
 
 /**
  * @generated from message pfinance.v1.UpdateGroupRequest
@@ -1092,7 +1720,7 @@ export type UpdateGroupRequest = Message<"pfinance.v1.UpdateGroupRequest"> & {
  * Use `create(UpdateGroupRequestSchema)` to create a new message.
  */
 export const UpdateGroupRequestSchema: GenMessage<UpdateGroupRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 36);
+  messageDesc(file_pfinance_v1_finance_service, 50);
 
 /**
  * @generated from message pfinance.v1.UpdateGroupResponse
@@ -1109,7 +1737,7 @@ export type UpdateGroupResponse = Message<"pfinance.v1.UpdateGroupResponse"> & {
  * Use `create(UpdateGroupResponseSchema)` to create a new message.
  */
 export const UpdateGroupResponseSchema: GenMessage<UpdateGroupResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 37);
+  messageDesc(file_pfinance_v1_finance_service, 51);
 
 /**
  * @generated from message pfinance.v1.DeleteGroupRequest
@@ -1126,7 +1754,7 @@ export type DeleteGroupRequest = Message<"pfinance.v1.DeleteGroupRequest"> & {
  * Use `create(DeleteGroupRequestSchema)` to create a new message.
  */
 export const DeleteGroupRequestSchema: GenMessage<DeleteGroupRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 38);
+  messageDesc(file_pfinance_v1_finance_service, 52);
 
 /**
  * @generated from message pfinance.v1.ListGroupsRequest
@@ -1153,7 +1781,7 @@ export type ListGroupsRequest = Message<"pfinance.v1.ListGroupsRequest"> & {
  * Use `create(ListGroupsRequestSchema)` to create a new message.
  */
 export const ListGroupsRequestSchema: GenMessage<ListGroupsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 39);
+  messageDesc(file_pfinance_v1_finance_service, 53);
 
 /**
  * @generated from message pfinance.v1.ListGroupsResponse
@@ -1175,7 +1803,7 @@ export type ListGroupsResponse = Message<"pfinance.v1.ListGroupsResponse"> & {
  * Use `create(ListGroupsResponseSchema)` to create a new message.
  */
 export const ListGroupsResponseSchema: GenMessage<ListGroupsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 40);
+  messageDesc(file_pfinance_v1_finance_service, 54);
 
 /**
  * Group member operations
@@ -1209,7 +1837,7 @@ export type InviteToGroupRequest = Message<"pfinance.v1.InviteToGroupRequest"> &
  * Use `create(InviteToGroupRequestSchema)` to create a new message.
  */
 export const InviteToGroupRequestSchema: GenMessage<InviteToGroupRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 41);
+  messageDesc(file_pfinance_v1_finance_service, 55);
 
 /**
  * @generated from message pfinance.v1.InviteToGroupResponse
@@ -1226,7 +1854,7 @@ export type InviteToGroupResponse = Message<"pfinance.v1.InviteToGroupResponse">
  * Use `create(InviteToGroupResponseSchema)` to create a new message.
  */
 export const InviteToGroupResponseSchema: GenMessage<InviteToGroupResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 42);
+  messageDesc(file_pfinance_v1_finance_service, 56);
 
 /**
  * @generated from message pfinance.v1.AcceptInvitationRequest
@@ -1248,7 +1876,7 @@ export type AcceptInvitationRequest = Message<"pfinance.v1.AcceptInvitationReque
  * Use `create(AcceptInvitationRequestSchema)` to create a new message.
  */
 export const AcceptInvitationRequestSchema: GenMessage<AcceptInvitationRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 43);
+  messageDesc(file_pfinance_v1_finance_service, 57);
 
 /**
  * @generated from message pfinance.v1.AcceptInvitationResponse
@@ -1265,7 +1893,7 @@ export type AcceptInvitationResponse = Message<"pfinance.v1.AcceptInvitationResp
  * Use `create(AcceptInvitationResponseSchema)` to create a new message.
  */
 export const AcceptInvitationResponseSchema: GenMessage<AcceptInvitationResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 44);
+  messageDesc(file_pfinance_v1_finance_service, 58);
 
 /**
  * @generated from message pfinance.v1.DeclineInvitationRequest
@@ -1287,7 +1915,7 @@ export type DeclineInvitationRequest = Message<"pfinance.v1.DeclineInvitationReq
  * Use `create(DeclineInvitationRequestSchema)` to create a new message.
  */
 export const DeclineInvitationRequestSchema: GenMessage<DeclineInvitationRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 45);
+  messageDesc(file_pfinance_v1_finance_service, 59);
 
 /**
  * @generated from message pfinance.v1.RemoveFromGroupRequest
@@ -1309,7 +1937,7 @@ export type RemoveFromGroupRequest = Message<"pfinance.v1.RemoveFromGroupRequest
  * Use `create(RemoveFromGroupRequestSchema)` to create a new message.
  */
 export const RemoveFromGroupRequestSchema: GenMessage<RemoveFromGroupRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 46);
+  messageDesc(file_pfinance_v1_finance_service, 60);
 
 /**
  * @generated from message pfinance.v1.UpdateMemberRoleRequest
@@ -1336,7 +1964,7 @@ export type UpdateMemberRoleRequest = Message<"pfinance.v1.UpdateMemberRoleReque
  * Use `create(UpdateMemberRoleRequestSchema)` to create a new message.
  */
 export const UpdateMemberRoleRequestSchema: GenMessage<UpdateMemberRoleRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 47);
+  messageDesc(file_pfinance_v1_finance_service, 61);
 
 /**
  * @generated from message pfinance.v1.UpdateMemberRoleResponse
@@ -1353,7 +1981,7 @@ export type UpdateMemberRoleResponse = Message<"pfinance.v1.UpdateMemberRoleResp
  * Use `create(UpdateMemberRoleResponseSchema)` to create a new message.
  */
 export const UpdateMemberRoleResponseSchema: GenMessage<UpdateMemberRoleResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 48);
+  messageDesc(file_pfinance_v1_finance_service, 62);
 
 /**
  * Invitation operations
@@ -1389,7 +2017,7 @@ export type ListInvitationsRequest = Message<"pfinance.v1.ListInvitationsRequest
  * Use `create(ListInvitationsRequestSchema)` to create a new message.
  */
 export const ListInvitationsRequestSchema: GenMessage<ListInvitationsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 49);
+  messageDesc(file_pfinance_v1_finance_service, 63);
 
 /**
  * @generated from message pfinance.v1.ListInvitationsResponse
@@ -1411,7 +2039,7 @@ export type ListInvitationsResponse = Message<"pfinance.v1.ListInvitationsRespon
  * Use `create(ListInvitationsResponseSchema)` to create a new message.
  */
 export const ListInvitationsResponseSchema: GenMessage<ListInvitationsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 50);
+  messageDesc(file_pfinance_v1_finance_service, 64);
 
 /**
  * Budget operations
@@ -1474,6 +2102,13 @@ export type CreateBudgetRequest = Message<"pfinance.v1.CreateBudgetRequest"> & {
    * @generated from field: int64 amount_cents = 10;
    */
   amountCents: bigint;
+
+  /**
+   * Optional; must not exceed the total
+   *
+   * @generated from field: repeated pfinance.v1.CategoryCap category_caps = 11;
+   */
+  categoryCaps: CategoryCap[];
 };
 
 /**
@@ -1481,7 +2116,7 @@ export type CreateBudgetRequest = Message<"pfinance.v1.CreateBudgetRequest"> & {
  * Use `create(CreateBudgetRequestSchema)` to create a new message.
  */
 export const CreateBudgetRequestSchema: GenMessage<CreateBudgetRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 51);
+  messageDesc(file_pfinance_v1_finance_service, 65);
 
 /**
  * @generated from message pfinance.v1.CreateBudgetResponse
@@ -1498,7 +2133,7 @@ export type CreateBudgetResponse = Message<"pfinance.v1.CreateBudgetResponse"> &
  * Use `create(CreateBudgetResponseSchema)` to create a new message.
  */
 export const CreateBudgetResponseSchema: GenMessage<CreateBudgetResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 52);
+  messageDesc(file_pfinance_v1_finance_service, 66);
 
 /**
  * @generated from message pfinance.v1.GetBudgetRequest
@@ -1515,7 +2150,7 @@ export type GetBudgetRequest = Message<"pfinance.v1.GetBudgetRequest"> & {
  * Use `create(GetBudgetRequestSchema)` to create a new message.
  */
 export const GetBudgetRequestSchema: GenMessage<GetBudgetRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 53);
+  messageDesc(file_pfinance_v1_finance_service, 67);
 
 /**
  * @generated from message pfinance.v1.GetBudgetResponse
@@ -1532,7 +2167,7 @@ export type GetBudgetResponse = Message<"pfinance.v1.GetBudgetResponse"> & {
  * Use `create(GetBudgetResponseSchema)` to create a new message.
  */
 export const GetBudgetResponseSchema: GenMessage<GetBudgetResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 54);
+  messageDesc(file_pfinance_v1_finance_service, 68);
 
 /**
  * @generated from message pfinance.v1.UpdateBudgetRequest
@@ -1586,6 +2221,20 @@ export type UpdateBudgetRequest = Message<"pfinance.v1.UpdateBudgetRequest"> & {
    * @generated from field: int64 amount_cents = 9;
    */
   amountCents: bigint;
+
+  /**
+   * Replaces the existing caps when non-empty
+   *
+   * @generated from field: repeated pfinance.v1.CategoryCap category_caps = 10;
+   */
+  categoryCaps: CategoryCap[];
+
+  /**
+   * Removes the existing caps
+   *
+   * @generated from field: bool clear_category_caps = 11;
+   */
+  clearCategoryCaps: boolean;
 };
 
 /**
@@ -1593,7 +2242,7 @@ export type UpdateBudgetRequest = Message<"pfinance.v1.UpdateBudgetRequest"> & {
  * Use `create(UpdateBudgetRequestSchema)` to create a new message.
  */
 export const UpdateBudgetRequestSchema: GenMessage<UpdateBudgetRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 55);
+  messageDesc(file_pfinance_v1_finance_service, 69);
 
 /**
  * @generated from message pfinance.v1.UpdateBudgetResponse
@@ -1610,7 +2259,7 @@ export type UpdateBudgetResponse = Message<"pfinance.v1.UpdateBudgetResponse"> &
  * Use `create(UpdateBudgetResponseSchema)` to create a new message.
  */
 export const UpdateBudgetResponseSchema: GenMessage<UpdateBudgetResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 56);
+  messageDesc(file_pfinance_v1_finance_service, 70);
 
 /**
  * @generated from message pfinance.v1.DeleteBudgetRequest
@@ -1627,7 +2276,7 @@ export type DeleteBudgetRequest = Message<"pfinance.v1.DeleteBudgetRequest"> & {
  * Use `create(DeleteBudgetRequestSchema)` to create a new message.
  */
 export const DeleteBudgetRequestSchema: GenMessage<DeleteBudgetRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 57);
+  messageDesc(file_pfinance_v1_finance_service, 71);
 
 /**
  * @generated from message pfinance.v1.ListBudgetsRequest
@@ -1666,7 +2315,7 @@ export type ListBudgetsRequest = Message<"pfinance.v1.ListBudgetsRequest"> & {
  * Use `create(ListBudgetsRequestSchema)` to create a new message.
  */
 export const ListBudgetsRequestSchema: GenMessage<ListBudgetsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 58);
+  messageDesc(file_pfinance_v1_finance_service, 72);
 
 /**
  * @generated from message pfinance.v1.ListBudgetsResponse
@@ -1688,7 +2337,7 @@ export type ListBudgetsResponse = Message<"pfinance.v1.ListBudgetsResponse"> & {
  * Use `create(ListBudgetsResponseSchema)` to create a new message.
  */
 export const ListBudgetsResponseSchema: GenMessage<ListBudgetsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 59);
+  messageDesc(file_pfinance_v1_finance_service, 73);
 
 /**
  * @generated from message pfinance.v1.GetBudgetProgressRequest
@@ -1712,7 +2361,7 @@ export type GetBudgetProgressRequest = Message<"pfinance.v1.GetBudgetProgressReq
  * Use `create(GetBudgetProgressRequestSchema)` to create a new message.
  */
 export const GetBudgetProgressRequestSchema: GenMessage<GetBudgetProgressRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 60);
+  messageDesc(file_pfinance_v1_finance_service, 74);
 
 /**
  * @generated from message pfinance.v1.GetBudgetProgressResponse
@@ -1729,7 +2378,267 @@ export type GetBudgetProgressResponse = Message<"pfinance.v1.GetBudgetProgressRe
  * Use `create(GetBudgetProgressResponseSchema)` to create a new message.
  */
 export const GetBudgetProgressResponseSchema: GenMessage<GetBudgetProgressResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 61);
+  messageDesc(file_pfinance_v1_finance_service, 75);
+
+/**
+ * @generated from message pfinance.v1.GetAllBudgetProgressRequest
+ */
+export type GetAllBudgetProgressRequest = Message<"pfinance.v1.GetAllBudgetProgressRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Optional - group budgets instead of personal
+   *
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+
+  /**
+   * Optional - defaults to current date
+   *
+   * @generated from field: google.protobuf.Timestamp as_of_date = 3;
+   */
+  asOfDate?: Timestamp;
+};
+
+/**
+ * Describes the message pfinance.v1.GetAllBudgetProgressRequest.
+ * Use `create(GetAllBudgetProgressRequestSchema)` to create a new message.
+ */
+export const GetAllBudgetProgressRequestSchema: GenMessage<GetAllBudgetProgressRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 76);
+
+/**
+ * @generated from message pfinance.v1.GetAllBudgetProgressResponse
+ */
+export type GetAllBudgetProgressResponse = Message<"pfinance.v1.GetAllBudgetProgressResponse"> & {
+  /**
+   * One entry per active budget, ordered by budget_id
+   *
+   * @generated from field: repeated pfinance.v1.BudgetProgress progress = 1;
+   */
+  progress: BudgetProgress[];
+};
+
+/**
+ * Describes the message pfinance.v1.GetAllBudgetProgressResponse.
+ * Use `create(GetAllBudgetProgressResponseSchema)` to create a new message.
+ */
+export const GetAllBudgetProgressResponseSchema: GenMessage<GetAllBudgetProgressResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 77);
+
+/**
+ * @generated from message pfinance.v1.CreateBudgetTemplateRequest
+ */
+export type CreateBudgetTemplateRequest = Message<"pfinance.v1.CreateBudgetTemplateRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Optional
+   *
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 4;
+   */
+  description: string;
+
+  /**
+   * @generated from field: int64 amount_cents = 5;
+   */
+  amountCents: bigint;
+
+  /**
+   * @generated from field: pfinance.v1.BudgetPeriod period = 6;
+   */
+  period: BudgetPeriod;
+
+  /**
+   * @generated from field: repeated pfinance.v1.ExpenseCategory category_ids = 7;
+   */
+  categoryIds: ExpenseCategory[];
+
+  /**
+   * Optional - defaults to now
+   *
+   * @generated from field: google.protobuf.Timestamp start_date = 8;
+   */
+  startDate?: Timestamp;
+};
+
+/**
+ * Describes the message pfinance.v1.CreateBudgetTemplateRequest.
+ * Use `create(CreateBudgetTemplateRequestSchema)` to create a new message.
+ */
+export const CreateBudgetTemplateRequestSchema: GenMessage<CreateBudgetTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 78);
+
+/**
+ * @generated from message pfinance.v1.CreateBudgetTemplateResponse
+ */
+export type CreateBudgetTemplateResponse = Message<"pfinance.v1.CreateBudgetTemplateResponse"> & {
+  /**
+   * @generated from field: pfinance.v1.RecurringBudget template = 1;
+   */
+  template?: RecurringBudget;
+
+  /**
+   * The budget for the current period
+   *
+   * @generated from field: pfinance.v1.Budget budget = 2;
+   */
+  budget?: Budget;
+};
+
+/**
+ * Describes the message pfinance.v1.CreateBudgetTemplateResponse.
+ * Use `create(CreateBudgetTemplateResponseSchema)` to create a new message.
+ */
+export const CreateBudgetTemplateResponseSchema: GenMessage<CreateBudgetTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 79);
+
+/**
+ * @generated from message pfinance.v1.ListBudgetTemplatesRequest
+ */
+export type ListBudgetTemplatesRequest = Message<"pfinance.v1.ListBudgetTemplatesRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Optional - group templates instead of personal
+   *
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+};
+
+/**
+ * Describes the message pfinance.v1.ListBudgetTemplatesRequest.
+ * Use `create(ListBudgetTemplatesRequestSchema)` to create a new message.
+ */
+export const ListBudgetTemplatesRequestSchema: GenMessage<ListBudgetTemplatesRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 80);
+
+/**
+ * @generated from message pfinance.v1.ListBudgetTemplatesResponse
+ */
+export type ListBudgetTemplatesResponse = Message<"pfinance.v1.ListBudgetTemplatesResponse"> & {
+  /**
+   * @generated from field: repeated pfinance.v1.RecurringBudget templates = 1;
+   */
+  templates: RecurringBudget[];
+};
+
+/**
+ * Describes the message pfinance.v1.ListBudgetTemplatesResponse.
+ * Use `create(ListBudgetTemplatesResponseSchema)` to create a new message.
+ */
+export const ListBudgetTemplatesResponseSchema: GenMessage<ListBudgetTemplatesResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 81);
+
+/**
+ * Unset fields are left unchanged. Changes apply from the next rolled budget.
+ *
+ * @generated from message pfinance.v1.UpdateBudgetTemplateRequest
+ */
+export type UpdateBudgetTemplateRequest = Message<"pfinance.v1.UpdateBudgetTemplateRequest"> & {
+  /**
+   * @generated from field: string template_id = 1;
+   */
+  templateId: string;
+
+  /**
+   * @generated from field: optional string name = 2;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: optional string description = 3;
+   */
+  description?: string;
+
+  /**
+   * @generated from field: optional int64 amount_cents = 4;
+   */
+  amountCents?: bigint;
+
+  /**
+   * @generated from field: optional pfinance.v1.BudgetPeriod period = 5;
+   */
+  period?: BudgetPeriod;
+
+  /**
+   * Replaces the categories when non-empty
+   *
+   * @generated from field: repeated pfinance.v1.ExpenseCategory category_ids = 6;
+   */
+  categoryIds: ExpenseCategory[];
+
+  /**
+   * Inactive templates stop rolling new budgets
+   *
+   * @generated from field: optional bool is_active = 7;
+   */
+  isActive?: boolean;
+};
+
+/**
+ * Describes the message pfinance.v1.UpdateBudgetTemplateRequest.
+ * Use `create(UpdateBudgetTemplateRequestSchema)` to create a new message.
+ */
+export const UpdateBudgetTemplateRequestSchema: GenMessage<UpdateBudgetTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 82);
+
+/**
+ * @generated from message pfinance.v1.UpdateBudgetTemplateResponse
+ */
+export type UpdateBudgetTemplateResponse = Message<"pfinance.v1.UpdateBudgetTemplateResponse"> & {
+  /**
+   * @generated from field: pfinance.v1.RecurringBudget template = 1;
+   */
+  template?: RecurringBudget;
+};
+
+/**
+ * Describes the message pfinance.v1.UpdateBudgetTemplateResponse.
+ * Use `create(UpdateBudgetTemplateResponseSchema)` to create a new message.
+ */
+export const UpdateBudgetTemplateResponseSchema: GenMessage<UpdateBudgetTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 83);
+
+/**
+ * Deletes the template; budgets already rolled from it are kept.
+ *
+ * @generated from message pfinance.v1.DeleteBudgetTemplateRequest
+ */
+export type DeleteBudgetTemplateRequest = Message<"pfinance.v1.DeleteBudgetTemplateRequest"> & {
+  /**
+   * @generated from field: string template_id = 1;
+   */
+  templateId: string;
+};
+
+/**
+ * Describes the message pfinance.v1.DeleteBudgetTemplateRequest.
+ * Use `create(DeleteBudgetTemplateRequestSchema)` to create a new message.
+ */
+export const DeleteBudgetTemplateRequestSchema: GenMessage<DeleteBudgetTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 84);
 
 /**
  * Expense allocation operations
@@ -1769,7 +2678,7 @@ export type GetMemberBalancesRequest = Message<"pfinance.v1.GetMemberBalancesReq
  * Use `create(GetMemberBalancesRequestSchema)` to create a new message.
  */
 export const GetMemberBalancesRequestSchema: GenMessage<GetMemberBalancesRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 62);
+  messageDesc(file_pfinance_v1_finance_service, 85);
 
 /**
  * @generated from message pfinance.v1.GetMemberBalancesResponse
@@ -1798,7 +2707,7 @@ export type GetMemberBalancesResponse = Message<"pfinance.v1.GetMemberBalancesRe
  * Use `create(GetMemberBalancesResponseSchema)` to create a new message.
  */
 export const GetMemberBalancesResponseSchema: GenMessage<GetMemberBalancesResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 63);
+  messageDesc(file_pfinance_v1_finance_service, 86);
 
 /**
  * @generated from message pfinance.v1.SettleExpenseRequest
@@ -1836,7 +2745,7 @@ export type SettleExpenseRequest = Message<"pfinance.v1.SettleExpenseRequest"> &
  * Use `create(SettleExpenseRequestSchema)` to create a new message.
  */
 export const SettleExpenseRequestSchema: GenMessage<SettleExpenseRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 64);
+  messageDesc(file_pfinance_v1_finance_service, 87);
 
 /**
  * @generated from message pfinance.v1.SettleExpenseResponse
@@ -1858,7 +2767,7 @@ export type SettleExpenseResponse = Message<"pfinance.v1.SettleExpenseResponse">
  * Use `create(SettleExpenseResponseSchema)` to create a new message.
  */
 export const SettleExpenseResponseSchema: GenMessage<SettleExpenseResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 65);
+  messageDesc(file_pfinance_v1_finance_service, 88);
 
 /**
  * @generated from message pfinance.v1.GetGroupSummaryRequest
@@ -1889,7 +2798,7 @@ export type GetGroupSummaryRequest = Message<"pfinance.v1.GetGroupSummaryRequest
  * Use `create(GetGroupSummaryRequestSchema)` to create a new message.
  */
 export const GetGroupSummaryRequestSchema: GenMessage<GetGroupSummaryRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 66);
+  messageDesc(file_pfinance_v1_finance_service, 89);
 
 /**
  * @generated from message pfinance.v1.GetGroupSummaryResponse
@@ -1952,14 +2861,88 @@ export type GetGroupSummaryResponse = Message<"pfinance.v1.GetGroupSummaryRespon
  * Use `create(GetGroupSummaryResponseSchema)` to create a new message.
  */
 export const GetGroupSummaryResponseSchema: GenMessage<GetGroupSummaryResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 67);
+  messageDesc(file_pfinance_v1_finance_service, 90);
 
 /**
- * Invite link operations
- *
- * @generated from message pfinance.v1.CreateInviteLinkRequest
+ * @generated from message pfinance.v1.GetGroupSettlementRequest
  */
-export type CreateInviteLinkRequest = Message<"pfinance.v1.CreateInviteLinkRequest"> & {
+export type GetGroupSettlementRequest = Message<"pfinance.v1.GetGroupSettlementRequest"> & {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId: string;
+
+  /**
+   * Optional - filters contributions by contributed_at
+   *
+   * @generated from field: google.protobuf.Timestamp start_date = 2;
+   */
+  startDate?: Timestamp;
+
+  /**
+   * Optional
+   *
+   * @generated from field: google.protobuf.Timestamp end_date = 3;
+   */
+  endDate?: Timestamp;
+
+  /**
+   * Optional - user_id to share weight; members left out owe nothing. Empty splits equally
+   *
+   * @generated from field: map<string, double> split_weights = 4;
+   */
+  splitWeights: { [key: string]: number };
+};
+
+/**
+ * Describes the message pfinance.v1.GetGroupSettlementRequest.
+ * Use `create(GetGroupSettlementRequestSchema)` to create a new message.
+ */
+export const GetGroupSettlementRequestSchema: GenMessage<GetGroupSettlementRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 91);
+
+/**
+ * @generated from message pfinance.v1.GetGroupSettlementResponse
+ */
+export type GetGroupSettlementResponse = Message<"pfinance.v1.GetGroupSettlementResponse"> & {
+  /**
+   * total_paid = contributed, total_owed = fair share
+   *
+   * @generated from field: repeated pfinance.v1.MemberBalance balances = 1;
+   */
+  balances: MemberBalance[];
+
+  /**
+   * Transfers that zero every balance
+   *
+   * @generated from field: repeated pfinance.v1.SettlementTransfer transfers = 2;
+   */
+  transfers: SettlementTransfer[];
+
+  /**
+   * @generated from field: double total_contributed = 3;
+   */
+  totalContributed: number;
+
+  /**
+   * @generated from field: int64 total_contributed_cents = 4;
+   */
+  totalContributedCents: bigint;
+};
+
+/**
+ * Describes the message pfinance.v1.GetGroupSettlementResponse.
+ * Use `create(GetGroupSettlementResponseSchema)` to create a new message.
+ */
+export const GetGroupSettlementResponseSchema: GenMessage<GetGroupSettlementResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 92);
+
+/**
+ * Invite link operations
+ *
+ * @generated from message pfinance.v1.CreateInviteLinkRequest
+ */
+export type CreateInviteLinkRequest = Message<"pfinance.v1.CreateInviteLinkRequest"> & {
   /**
    * @generated from field: string group_id = 1;
    */
@@ -1995,7 +2978,7 @@ export type CreateInviteLinkRequest = Message<"pfinance.v1.CreateInviteLinkReque
  * Use `create(CreateInviteLinkRequestSchema)` to create a new message.
  */
 export const CreateInviteLinkRequestSchema: GenMessage<CreateInviteLinkRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 68);
+  messageDesc(file_pfinance_v1_finance_service, 93);
 
 /**
  * @generated from message pfinance.v1.CreateInviteLinkResponse
@@ -2012,7 +2995,7 @@ export type CreateInviteLinkResponse = Message<"pfinance.v1.CreateInviteLinkResp
  * Use `create(CreateInviteLinkResponseSchema)` to create a new message.
  */
 export const CreateInviteLinkResponseSchema: GenMessage<CreateInviteLinkResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 69);
+  messageDesc(file_pfinance_v1_finance_service, 94);
 
 /**
  * @generated from message pfinance.v1.GetInviteLinkByCodeRequest
@@ -2029,7 +3012,7 @@ export type GetInviteLinkByCodeRequest = Message<"pfinance.v1.GetInviteLinkByCod
  * Use `create(GetInviteLinkByCodeRequestSchema)` to create a new message.
  */
 export const GetInviteLinkByCodeRequestSchema: GenMessage<GetInviteLinkByCodeRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 70);
+  messageDesc(file_pfinance_v1_finance_service, 95);
 
 /**
  * @generated from message pfinance.v1.GetInviteLinkByCodeResponse
@@ -2053,7 +3036,7 @@ export type GetInviteLinkByCodeResponse = Message<"pfinance.v1.GetInviteLinkByCo
  * Use `create(GetInviteLinkByCodeResponseSchema)` to create a new message.
  */
 export const GetInviteLinkByCodeResponseSchema: GenMessage<GetInviteLinkByCodeResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 71);
+  messageDesc(file_pfinance_v1_finance_service, 96);
 
 /**
  * @generated from message pfinance.v1.JoinGroupByLinkRequest
@@ -2085,7 +3068,7 @@ export type JoinGroupByLinkRequest = Message<"pfinance.v1.JoinGroupByLinkRequest
  * Use `create(JoinGroupByLinkRequestSchema)` to create a new message.
  */
 export const JoinGroupByLinkRequestSchema: GenMessage<JoinGroupByLinkRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 72);
+  messageDesc(file_pfinance_v1_finance_service, 97);
 
 /**
  * @generated from message pfinance.v1.JoinGroupByLinkResponse
@@ -2102,7 +3085,7 @@ export type JoinGroupByLinkResponse = Message<"pfinance.v1.JoinGroupByLinkRespon
  * Use `create(JoinGroupByLinkResponseSchema)` to create a new message.
  */
 export const JoinGroupByLinkResponseSchema: GenMessage<JoinGroupByLinkResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 73);
+  messageDesc(file_pfinance_v1_finance_service, 98);
 
 /**
  * @generated from message pfinance.v1.ListInviteLinksRequest
@@ -2134,7 +3117,7 @@ export type ListInviteLinksRequest = Message<"pfinance.v1.ListInviteLinksRequest
  * Use `create(ListInviteLinksRequestSchema)` to create a new message.
  */
 export const ListInviteLinksRequestSchema: GenMessage<ListInviteLinksRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 74);
+  messageDesc(file_pfinance_v1_finance_service, 99);
 
 /**
  * @generated from message pfinance.v1.ListInviteLinksResponse
@@ -2156,7 +3139,7 @@ export type ListInviteLinksResponse = Message<"pfinance.v1.ListInviteLinksRespon
  * Use `create(ListInviteLinksResponseSchema)` to create a new message.
  */
 export const ListInviteLinksResponseSchema: GenMessage<ListInviteLinksResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 75);
+  messageDesc(file_pfinance_v1_finance_service, 100);
 
 /**
  * @generated from message pfinance.v1.DeactivateInviteLinkRequest
@@ -2173,7 +3156,65 @@ export type DeactivateInviteLinkRequest = Message<"pfinance.v1.DeactivateInviteL
  * Use `create(DeactivateInviteLinkRequestSchema)` to create a new message.
  */
 export const DeactivateInviteLinkRequestSchema: GenMessage<DeactivateInviteLinkRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 76);
+  messageDesc(file_pfinance_v1_finance_service, 101);
+
+/**
+ * @generated from message pfinance.v1.GetInviteLinkStatsRequest
+ */
+export type GetInviteLinkStatsRequest = Message<"pfinance.v1.GetInviteLinkStatsRequest"> & {
+  /**
+   * @generated from field: string link_id = 1;
+   */
+  linkId: string;
+};
+
+/**
+ * Describes the message pfinance.v1.GetInviteLinkStatsRequest.
+ * Use `create(GetInviteLinkStatsRequestSchema)` to create a new message.
+ */
+export const GetInviteLinkStatsRequestSchema: GenMessage<GetInviteLinkStatsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 102);
+
+/**
+ * @generated from message pfinance.v1.GetInviteLinkStatsResponse
+ */
+export type GetInviteLinkStatsResponse = Message<"pfinance.v1.GetInviteLinkStatsResponse"> & {
+  /**
+   * @generated from field: pfinance.v1.GroupInviteLink invite_link = 1;
+   */
+  inviteLink?: GroupInviteLink;
+
+  /**
+   * @generated from field: int32 total_uses = 2;
+   */
+  totalUses: number;
+
+  /**
+   * Unset when the link has no max_uses cap
+   *
+   * @generated from field: optional int32 remaining_uses = 3;
+   */
+  remainingUses?: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_used_at = 4;
+   */
+  lastUsedAt?: Timestamp;
+
+  /**
+   * Members who joined via this link
+   *
+   * @generated from field: repeated pfinance.v1.GroupMember joined_members = 5;
+   */
+  joinedMembers: GroupMember[];
+};
+
+/**
+ * Describes the message pfinance.v1.GetInviteLinkStatsResponse.
+ * Use `create(GetInviteLinkStatsResponseSchema)` to create a new message.
+ */
+export const GetInviteLinkStatsResponseSchema: GenMessage<GetInviteLinkStatsResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 103);
 
 /**
  * Contribution operations
@@ -2233,7 +3274,7 @@ export type ContributeExpenseToGroupRequest = Message<"pfinance.v1.ContributeExp
  * Use `create(ContributeExpenseToGroupRequestSchema)` to create a new message.
  */
 export const ContributeExpenseToGroupRequestSchema: GenMessage<ContributeExpenseToGroupRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 77);
+  messageDesc(file_pfinance_v1_finance_service, 104);
 
 /**
  * @generated from message pfinance.v1.ContributeExpenseToGroupResponse
@@ -2255,7 +3296,7 @@ export type ContributeExpenseToGroupResponse = Message<"pfinance.v1.ContributeEx
  * Use `create(ContributeExpenseToGroupResponseSchema)` to create a new message.
  */
 export const ContributeExpenseToGroupResponseSchema: GenMessage<ContributeExpenseToGroupResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 78);
+  messageDesc(file_pfinance_v1_finance_service, 105);
 
 /**
  * @generated from message pfinance.v1.ListContributionsRequest
@@ -2291,7 +3332,7 @@ export type ListContributionsRequest = Message<"pfinance.v1.ListContributionsReq
  * Use `create(ListContributionsRequestSchema)` to create a new message.
  */
 export const ListContributionsRequestSchema: GenMessage<ListContributionsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 79);
+  messageDesc(file_pfinance_v1_finance_service, 106);
 
 /**
  * @generated from message pfinance.v1.ListContributionsResponse
@@ -2313,7 +3354,7 @@ export type ListContributionsResponse = Message<"pfinance.v1.ListContributionsRe
  * Use `create(ListContributionsResponseSchema)` to create a new message.
  */
 export const ListContributionsResponseSchema: GenMessage<ListContributionsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 80);
+  messageDesc(file_pfinance_v1_finance_service, 107);
 
 /**
  * Income contribution operations
@@ -2356,7 +3397,7 @@ export type ContributeIncomeToGroupRequest = Message<"pfinance.v1.ContributeInco
  * Use `create(ContributeIncomeToGroupRequestSchema)` to create a new message.
  */
 export const ContributeIncomeToGroupRequestSchema: GenMessage<ContributeIncomeToGroupRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 81);
+  messageDesc(file_pfinance_v1_finance_service, 108);
 
 /**
  * @generated from message pfinance.v1.ContributeIncomeToGroupResponse
@@ -2378,7 +3419,7 @@ export type ContributeIncomeToGroupResponse = Message<"pfinance.v1.ContributeInc
  * Use `create(ContributeIncomeToGroupResponseSchema)` to create a new message.
  */
 export const ContributeIncomeToGroupResponseSchema: GenMessage<ContributeIncomeToGroupResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 82);
+  messageDesc(file_pfinance_v1_finance_service, 109);
 
 /**
  * @generated from message pfinance.v1.ListIncomeContributionsRequest
@@ -2414,7 +3455,7 @@ export type ListIncomeContributionsRequest = Message<"pfinance.v1.ListIncomeCont
  * Use `create(ListIncomeContributionsRequestSchema)` to create a new message.
  */
 export const ListIncomeContributionsRequestSchema: GenMessage<ListIncomeContributionsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 83);
+  messageDesc(file_pfinance_v1_finance_service, 110);
 
 /**
  * @generated from message pfinance.v1.ListIncomeContributionsResponse
@@ -2436,7 +3477,7 @@ export type ListIncomeContributionsResponse = Message<"pfinance.v1.ListIncomeCon
  * Use `create(ListIncomeContributionsResponseSchema)` to create a new message.
  */
 export const ListIncomeContributionsResponseSchema: GenMessage<ListIncomeContributionsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 84);
+  messageDesc(file_pfinance_v1_finance_service, 111);
 
 /**
  * @generated from message pfinance.v1.CreateGoalRequest
@@ -2525,6 +3566,11 @@ export type CreateGoalRequest = Message<"pfinance.v1.CreateGoalRequest"> & {
    * @generated from field: int64 initial_amount_cents = 14;
    */
   initialAmountCents: bigint;
+
+  /**
+   * @generated from field: pfinance.v1.GoalPriority priority = 15;
+   */
+  priority: GoalPriority;
 };
 
 /**
@@ -2532,7 +3578,7 @@ export type CreateGoalRequest = Message<"pfinance.v1.CreateGoalRequest"> & {
  * Use `create(CreateGoalRequestSchema)` to create a new message.
  */
 export const CreateGoalRequestSchema: GenMessage<CreateGoalRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 85);
+  messageDesc(file_pfinance_v1_finance_service, 112);
 
 /**
  * @generated from message pfinance.v1.CreateGoalResponse
@@ -2549,7 +3595,7 @@ export type CreateGoalResponse = Message<"pfinance.v1.CreateGoalResponse"> & {
  * Use `create(CreateGoalResponseSchema)` to create a new message.
  */
 export const CreateGoalResponseSchema: GenMessage<CreateGoalResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 86);
+  messageDesc(file_pfinance_v1_finance_service, 113);
 
 /**
  * @generated from message pfinance.v1.GetGoalRequest
@@ -2566,7 +3612,7 @@ export type GetGoalRequest = Message<"pfinance.v1.GetGoalRequest"> & {
  * Use `create(GetGoalRequestSchema)` to create a new message.
  */
 export const GetGoalRequestSchema: GenMessage<GetGoalRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 87);
+  messageDesc(file_pfinance_v1_finance_service, 114);
 
 /**
  * @generated from message pfinance.v1.GetGoalResponse
@@ -2583,7 +3629,7 @@ export type GetGoalResponse = Message<"pfinance.v1.GetGoalResponse"> & {
  * Use `create(GetGoalResponseSchema)` to create a new message.
  */
 export const GetGoalResponseSchema: GenMessage<GetGoalResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 88);
+  messageDesc(file_pfinance_v1_finance_service, 115);
 
 /**
  * @generated from message pfinance.v1.UpdateGoalRequest
@@ -2640,6 +3686,13 @@ export type UpdateGoalRequest = Message<"pfinance.v1.UpdateGoalRequest"> & {
    * @generated from field: int64 target_amount_cents = 10;
    */
   targetAmountCents: bigint;
+
+  /**
+   * Unchanged when unspecified
+   *
+   * @generated from field: pfinance.v1.GoalPriority priority = 11;
+   */
+  priority: GoalPriority;
 };
 
 /**
@@ -2647,7 +3700,7 @@ export type UpdateGoalRequest = Message<"pfinance.v1.UpdateGoalRequest"> & {
  * Use `create(UpdateGoalRequestSchema)` to create a new message.
  */
 export const UpdateGoalRequestSchema: GenMessage<UpdateGoalRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 89);
+  messageDesc(file_pfinance_v1_finance_service, 116);
 
 /**
  * @generated from message pfinance.v1.UpdateGoalResponse
@@ -2664,7 +3717,7 @@ export type UpdateGoalResponse = Message<"pfinance.v1.UpdateGoalResponse"> & {
  * Use `create(UpdateGoalResponseSchema)` to create a new message.
  */
 export const UpdateGoalResponseSchema: GenMessage<UpdateGoalResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 90);
+  messageDesc(file_pfinance_v1_finance_service, 117);
 
 /**
  * @generated from message pfinance.v1.DeleteGoalRequest
@@ -2681,7 +3734,7 @@ export type DeleteGoalRequest = Message<"pfinance.v1.DeleteGoalRequest"> & {
  * Use `create(DeleteGoalRequestSchema)` to create a new message.
  */
 export const DeleteGoalRequestSchema: GenMessage<DeleteGoalRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 91);
+  messageDesc(file_pfinance_v1_finance_service, 118);
 
 /**
  * @generated from message pfinance.v1.ListGoalsRequest
@@ -2729,7 +3782,7 @@ export type ListGoalsRequest = Message<"pfinance.v1.ListGoalsRequest"> & {
  * Use `create(ListGoalsRequestSchema)` to create a new message.
  */
 export const ListGoalsRequestSchema: GenMessage<ListGoalsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 92);
+  messageDesc(file_pfinance_v1_finance_service, 119);
 
 /**
  * @generated from message pfinance.v1.ListGoalsResponse
@@ -2751,7 +3804,7 @@ export type ListGoalsResponse = Message<"pfinance.v1.ListGoalsResponse"> & {
  * Use `create(ListGoalsResponseSchema)` to create a new message.
  */
 export const ListGoalsResponseSchema: GenMessage<ListGoalsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 93);
+  messageDesc(file_pfinance_v1_finance_service, 120);
 
 /**
  * @generated from message pfinance.v1.GetGoalProgressRequest
@@ -2775,7 +3828,7 @@ export type GetGoalProgressRequest = Message<"pfinance.v1.GetGoalProgressRequest
  * Use `create(GetGoalProgressRequestSchema)` to create a new message.
  */
 export const GetGoalProgressRequestSchema: GenMessage<GetGoalProgressRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 94);
+  messageDesc(file_pfinance_v1_finance_service, 121);
 
 /**
  * @generated from message pfinance.v1.GetGoalProgressResponse
@@ -2792,7 +3845,7 @@ export type GetGoalProgressResponse = Message<"pfinance.v1.GetGoalProgressRespon
  * Use `create(GetGoalProgressResponseSchema)` to create a new message.
  */
 export const GetGoalProgressResponseSchema: GenMessage<GetGoalProgressResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 95);
+  messageDesc(file_pfinance_v1_finance_service, 122);
 
 /**
  * @generated from message pfinance.v1.ContributeToGoalRequest
@@ -2821,11 +3874,18 @@ export type ContributeToGoalRequest = Message<"pfinance.v1.ContributeToGoalReque
   note: string;
 
   /**
-   * Amount in cents (preferred over amount)
+   * Amount in cents (preferred over amount); negative for a withdrawal
    *
    * @generated from field: int64 amount_cents = 5;
    */
   amountCents: bigint;
+
+  /**
+   * Allow a withdrawal larger than the current balance (balance floors at zero)
+   *
+   * @generated from field: bool allow_negative = 6;
+   */
+  allowNegative: boolean;
 };
 
 /**
@@ -2833,7 +3893,7 @@ export type ContributeToGoalRequest = Message<"pfinance.v1.ContributeToGoalReque
  * Use `create(ContributeToGoalRequestSchema)` to create a new message.
  */
 export const ContributeToGoalRequestSchema: GenMessage<ContributeToGoalRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 96);
+  messageDesc(file_pfinance_v1_finance_service, 123);
 
 /**
  * @generated from message pfinance.v1.ContributeToGoalResponse
@@ -2855,7 +3915,7 @@ export type ContributeToGoalResponse = Message<"pfinance.v1.ContributeToGoalResp
  * Use `create(ContributeToGoalResponseSchema)` to create a new message.
  */
 export const ContributeToGoalResponseSchema: GenMessage<ContributeToGoalResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 97);
+  messageDesc(file_pfinance_v1_finance_service, 124);
 
 /**
  * @generated from message pfinance.v1.ListGoalContributionsRequest
@@ -2882,7 +3942,7 @@ export type ListGoalContributionsRequest = Message<"pfinance.v1.ListGoalContribu
  * Use `create(ListGoalContributionsRequestSchema)` to create a new message.
  */
 export const ListGoalContributionsRequestSchema: GenMessage<ListGoalContributionsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 98);
+  messageDesc(file_pfinance_v1_finance_service, 125);
 
 /**
  * @generated from message pfinance.v1.ListGoalContributionsResponse
@@ -2904,7 +3964,108 @@ export type ListGoalContributionsResponse = Message<"pfinance.v1.ListGoalContrib
  * Use `create(ListGoalContributionsResponseSchema)` to create a new message.
  */
 export const ListGoalContributionsResponseSchema: GenMessage<ListGoalContributionsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 99);
+  messageDesc(file_pfinance_v1_finance_service, 126);
+
+/**
+ * @generated from message pfinance.v1.RecommendGoalAllocationsRequest
+ */
+export type RecommendGoalAllocationsRequest = Message<"pfinance.v1.RecommendGoalAllocationsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Optional: split across group goals
+   *
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+
+  /**
+   * Monthly savings to split
+   *
+   * @generated from field: int64 monthly_budget_cents = 3;
+   */
+  monthlyBudgetCents: bigint;
+
+  /**
+   * Optional: defaults to current date
+   *
+   * @generated from field: google.protobuf.Timestamp as_of_date = 4;
+   */
+  asOfDate?: Timestamp;
+};
+
+/**
+ * Describes the message pfinance.v1.RecommendGoalAllocationsRequest.
+ * Use `create(RecommendGoalAllocationsRequestSchema)` to create a new message.
+ */
+export const RecommendGoalAllocationsRequestSchema: GenMessage<RecommendGoalAllocationsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 127);
+
+/**
+ * @generated from message pfinance.v1.GoalAllocation
+ */
+export type GoalAllocation = Message<"pfinance.v1.GoalAllocation"> & {
+  /**
+   * @generated from field: string goal_id = 1;
+   */
+  goalId: string;
+
+  /**
+   * @generated from field: string goal_name = 2;
+   */
+  goalName: string;
+
+  /**
+   * @generated from field: pfinance.v1.GoalPriority priority = 3;
+   */
+  priority: GoalPriority;
+
+  /**
+   * @generated from field: int64 remaining_cents = 4;
+   */
+  remainingCents: bigint;
+
+  /**
+   * Needed to reach the target by its date; 0 without one
+   *
+   * @generated from field: int64 required_monthly_cents = 5;
+   */
+  requiredMonthlyCents: bigint;
+
+  /**
+   * @generated from field: int64 suggested_monthly_cents = 6;
+   */
+  suggestedMonthlyCents: bigint;
+};
+
+/**
+ * Describes the message pfinance.v1.GoalAllocation.
+ * Use `create(GoalAllocationSchema)` to create a new message.
+ */
+export const GoalAllocationSchema: GenMessage<GoalAllocation> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 128);
+
+/**
+ * @generated from message pfinance.v1.RecommendGoalAllocationsResponse
+ */
+export type RecommendGoalAllocationsResponse = Message<"pfinance.v1.RecommendGoalAllocationsResponse"> & {
+  /**
+   * Suggestions sum to monthly_budget_cents
+   *
+   * @generated from field: repeated pfinance.v1.GoalAllocation allocations = 1;
+   */
+  allocations: GoalAllocation[];
+};
+
+/**
+ * Describes the message pfinance.v1.RecommendGoalAllocationsResponse.
+ * Use `create(RecommendGoalAllocationsResponseSchema)` to create a new message.
+ */
+export const RecommendGoalAllocationsResponseSchema: GenMessage<RecommendGoalAllocationsResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 129);
 
 /**
  * @generated from message pfinance.v1.GetSpendingInsightsRequest
@@ -2942,7 +4103,7 @@ export type GetSpendingInsightsRequest = Message<"pfinance.v1.GetSpendingInsight
  * Use `create(GetSpendingInsightsRequestSchema)` to create a new message.
  */
 export const GetSpendingInsightsRequestSchema: GenMessage<GetSpendingInsightsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 100);
+  messageDesc(file_pfinance_v1_finance_service, 130);
 
 /**
  * @generated from message pfinance.v1.GetSpendingInsightsResponse
@@ -2964,30 +4125,102 @@ export type GetSpendingInsightsResponse = Message<"pfinance.v1.GetSpendingInsigh
  * Use `create(GetSpendingInsightsResponseSchema)` to create a new message.
  */
 export const GetSpendingInsightsResponseSchema: GenMessage<GetSpendingInsightsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 101);
+  messageDesc(file_pfinance_v1_finance_service, 131);
 
 /**
- * @generated from message pfinance.v1.ExtractDocumentRequest
+ * @generated from message pfinance.v1.GetActivityFeedRequest
  */
-export type ExtractDocumentRequest = Message<"pfinance.v1.ExtractDocumentRequest"> & {
+export type GetActivityFeedRequest = Message<"pfinance.v1.GetActivityFeedRequest"> & {
   /**
-   * Raw document bytes (image or PDF)
-   *
-   * @generated from field: bytes document_data = 1;
+   * @generated from field: string user_id = 1;
    */
-  documentData: Uint8Array;
+  userId: string;
 
   /**
-   * Type of document
+   * Optional: group activity instead of personal
    *
-   * @generated from field: pfinance.v1.DocumentType document_type = 2;
+   * @generated from field: string group_id = 2;
    */
-  documentType: DocumentType;
+  groupId: string;
 
   /**
-   * Original filename for mime type detection
+   * Optional: defaults to 90 days before end_date
    *
-   * @generated from field: string filename = 3;
+   * @generated from field: google.protobuf.Timestamp start_date = 3;
+   */
+  startDate?: Timestamp;
+
+  /**
+   * Optional: defaults to now
+   *
+   * @generated from field: google.protobuf.Timestamp end_date = 4;
+   */
+  endDate?: Timestamp;
+
+  /**
+   * @generated from field: int32 page_size = 5;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 6;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message pfinance.v1.GetActivityFeedRequest.
+ * Use `create(GetActivityFeedRequestSchema)` to create a new message.
+ */
+export const GetActivityFeedRequestSchema: GenMessage<GetActivityFeedRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 132);
+
+/**
+ * @generated from message pfinance.v1.GetActivityFeedResponse
+ */
+export type GetActivityFeedResponse = Message<"pfinance.v1.GetActivityFeedResponse"> & {
+  /**
+   * Newest first
+   *
+   * @generated from field: repeated pfinance.v1.ActivityItem items = 1;
+   */
+  items: ActivityItem[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message pfinance.v1.GetActivityFeedResponse.
+ * Use `create(GetActivityFeedResponseSchema)` to create a new message.
+ */
+export const GetActivityFeedResponseSchema: GenMessage<GetActivityFeedResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 133);
+
+/**
+ * @generated from message pfinance.v1.ExtractDocumentRequest
+ */
+export type ExtractDocumentRequest = Message<"pfinance.v1.ExtractDocumentRequest"> & {
+  /**
+   * Raw document bytes (image or PDF)
+   *
+   * @generated from field: bytes document_data = 1;
+   */
+  documentData: Uint8Array;
+
+  /**
+   * Type of document
+   *
+   * @generated from field: pfinance.v1.DocumentType document_type = 2;
+   */
+  documentType: DocumentType;
+
+  /**
+   * Original filename for mime type detection
+   *
+   * @generated from field: string filename = 3;
    */
   filename: string;
 
@@ -3011,6 +4244,13 @@ export type ExtractDocumentRequest = Message<"pfinance.v1.ExtractDocumentRequest
    * @generated from field: pfinance.v1.ExtractionMethod extraction_method = 6;
    */
   extractionMethod: ExtractionMethod;
+
+  /**
+   * 0.0-1.0, default from ExtractionPreferences; 0 keeps every transaction
+   *
+   * @generated from field: optional double auto_reject_threshold = 7;
+   */
+  autoRejectThreshold?: number;
 };
 
 /**
@@ -3018,7 +4258,7 @@ export type ExtractDocumentRequest = Message<"pfinance.v1.ExtractDocumentRequest
  * Use `create(ExtractDocumentRequestSchema)` to create a new message.
  */
 export const ExtractDocumentRequestSchema: GenMessage<ExtractDocumentRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 102);
+  messageDesc(file_pfinance_v1_finance_service, 134);
 
 /**
  * @generated from message pfinance.v1.ExtractDocumentResponse
@@ -3063,7 +4303,7 @@ export type ExtractDocumentResponse = Message<"pfinance.v1.ExtractDocumentRespon
  * Use `create(ExtractDocumentResponseSchema)` to create a new message.
  */
 export const ExtractDocumentResponseSchema: GenMessage<ExtractDocumentResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 103);
+  messageDesc(file_pfinance_v1_finance_service, 135);
 
 /**
  * @generated from message pfinance.v1.GetExtractionJobRequest
@@ -3080,7 +4320,7 @@ export type GetExtractionJobRequest = Message<"pfinance.v1.GetExtractionJobReque
  * Use `create(GetExtractionJobRequestSchema)` to create a new message.
  */
 export const GetExtractionJobRequestSchema: GenMessage<GetExtractionJobRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 104);
+  messageDesc(file_pfinance_v1_finance_service, 136);
 
 /**
  * @generated from message pfinance.v1.GetExtractionJobResponse
@@ -3097,7 +4337,100 @@ export type GetExtractionJobResponse = Message<"pfinance.v1.GetExtractionJobResp
  * Use `create(GetExtractionJobResponseSchema)` to create a new message.
  */
 export const GetExtractionJobResponseSchema: GenMessage<GetExtractionJobResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 105);
+  messageDesc(file_pfinance_v1_finance_service, 137);
+
+/**
+ * @generated from message pfinance.v1.GetJobRejectedTransactionsRequest
+ */
+export type GetJobRejectedTransactionsRequest = Message<"pfinance.v1.GetJobRejectedTransactionsRequest"> & {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message pfinance.v1.GetJobRejectedTransactionsRequest.
+ * Use `create(GetJobRejectedTransactionsRequestSchema)` to create a new message.
+ */
+export const GetJobRejectedTransactionsRequestSchema: GenMessage<GetJobRejectedTransactionsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 138);
+
+/**
+ * @generated from message pfinance.v1.GetJobRejectedTransactionsResponse
+ */
+export type GetJobRejectedTransactionsResponse = Message<"pfinance.v1.GetJobRejectedTransactionsResponse"> & {
+  /**
+   * Below the job's auto-reject threshold
+   *
+   * @generated from field: repeated pfinance.v1.ExtractedTransaction transactions = 1;
+   */
+  transactions: ExtractedTransaction[];
+
+  /**
+   * Rejections are final once COMPLETED
+   *
+   * @generated from field: pfinance.v1.ExtractionStatus status = 2;
+   */
+  status: ExtractionStatus;
+};
+
+/**
+ * Describes the message pfinance.v1.GetJobRejectedTransactionsResponse.
+ * Use `create(GetJobRejectedTransactionsResponseSchema)` to create a new message.
+ */
+export const GetJobRejectedTransactionsResponseSchema: GenMessage<GetJobRejectedTransactionsResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 139);
+
+/**
+ * @generated from message pfinance.v1.RecoverRejectedTransactionsRequest
+ */
+export type RecoverRejectedTransactionsRequest = Message<"pfinance.v1.RecoverRejectedTransactionsRequest"> & {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId: string;
+
+  /**
+   * Rejected transactions to promote
+   *
+   * @generated from field: repeated string transaction_ids = 2;
+   */
+  transactionIds: string[];
+};
+
+/**
+ * Describes the message pfinance.v1.RecoverRejectedTransactionsRequest.
+ * Use `create(RecoverRejectedTransactionsRequestSchema)` to create a new message.
+ */
+export const RecoverRejectedTransactionsRequestSchema: GenMessage<RecoverRejectedTransactionsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 140);
+
+/**
+ * @generated from message pfinance.v1.RecoverRejectedTransactionsResponse
+ */
+export type RecoverRejectedTransactionsResponse = Message<"pfinance.v1.RecoverRejectedTransactionsResponse"> & {
+  /**
+   * Marked user_confirmed, ready for ImportExtractedTransactions
+   *
+   * @generated from field: repeated pfinance.v1.ExtractedTransaction transactions = 1;
+   */
+  transactions: ExtractedTransaction[];
+
+  /**
+   * Requested IDs that weren't rejected in this job
+   *
+   * @generated from field: repeated string not_found_ids = 2;
+   */
+  notFoundIds: string[];
+};
+
+/**
+ * Describes the message pfinance.v1.RecoverRejectedTransactionsResponse.
+ * Use `create(RecoverRejectedTransactionsResponseSchema)` to create a new message.
+ */
+export const RecoverRejectedTransactionsResponseSchema: GenMessage<RecoverRejectedTransactionsResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 141);
 
 /**
  * @generated from message pfinance.v1.ImportExtractedTransactionsRequest
@@ -3161,6 +4494,20 @@ export type ImportExtractedTransactionsRequest = Message<"pfinance.v1.ImportExtr
    * @generated from field: repeated string receipt_storage_paths = 9;
    */
   receiptStoragePaths: string[];
+
+  /**
+   * Preview the import without persisting anything
+   *
+   * @generated from field: bool dry_run = 10;
+   */
+  dryRun: boolean;
+
+  /**
+   * Attached to every created expense for traceability
+   *
+   * @generated from field: pfinance.v1.AttachmentRef source_statement = 11;
+   */
+  sourceStatement?: AttachmentRef;
 };
 
 /**
@@ -3168,13 +4515,15 @@ export type ImportExtractedTransactionsRequest = Message<"pfinance.v1.ImportExtr
  * Use `create(ImportExtractedTransactionsRequestSchema)` to create a new message.
  */
 export const ImportExtractedTransactionsRequestSchema: GenMessage<ImportExtractedTransactionsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 106);
+  messageDesc(file_pfinance_v1_finance_service, 142);
 
 /**
  * @generated from message pfinance.v1.ImportExtractedTransactionsResponse
  */
 export type ImportExtractedTransactionsResponse = Message<"pfinance.v1.ImportExtractedTransactionsResponse"> & {
   /**
+   * Would-be expenses when dry_run is set
+   *
    * @generated from field: repeated pfinance.v1.Expense created_expenses = 1;
    */
   createdExpenses: Expense[];
@@ -3195,6 +4544,20 @@ export type ImportExtractedTransactionsResponse = Message<"pfinance.v1.ImportExt
    * @generated from field: repeated string skipped_reasons = 4;
    */
   skippedReasons: string[];
+
+  /**
+   * True if nothing was persisted
+   *
+   * @generated from field: bool dry_run = 5;
+   */
+  dryRun: boolean;
+
+  /**
+   * Per-transaction outcome (dry run only)
+   *
+   * @generated from field: repeated pfinance.v1.ImportDisposition dispositions = 6;
+   */
+  dispositions: ImportDisposition[];
 };
 
 /**
@@ -3202,7 +4565,57 @@ export type ImportExtractedTransactionsResponse = Message<"pfinance.v1.ImportExt
  * Use `create(ImportExtractedTransactionsResponseSchema)` to create a new message.
  */
 export const ImportExtractedTransactionsResponseSchema: GenMessage<ImportExtractedTransactionsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 107);
+  messageDesc(file_pfinance_v1_finance_service, 143);
+
+/**
+ * ImportDisposition is the outcome of a single transaction in an import preview
+ *
+ * @generated from message pfinance.v1.ImportDisposition
+ */
+export type ImportDisposition = Message<"pfinance.v1.ImportDisposition"> & {
+  /**
+   * @generated from field: string transaction_id = 1;
+   */
+  transactionId: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * @generated from field: pfinance.v1.ImportDispositionType disposition = 3;
+   */
+  disposition: ImportDispositionType;
+
+  /**
+   * Human-readable skip reason (empty when created)
+   *
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+
+  /**
+   * Matching existing expense, if any
+   *
+   * @generated from field: string duplicate_expense_id = 5;
+   */
+  duplicateExpenseId: string;
+
+  /**
+   * Would-be expense ID when created
+   *
+   * @generated from field: string expense_id = 6;
+   */
+  expenseId: string;
+};
+
+/**
+ * Describes the message pfinance.v1.ImportDisposition.
+ * Use `create(ImportDispositionSchema)` to create a new message.
+ */
+export const ImportDispositionSchema: GenMessage<ImportDisposition> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 144);
 
 /**
  * Smart text parsing request
@@ -3223,7 +4636,7 @@ export type ParseExpenseTextRequest = Message<"pfinance.v1.ParseExpenseTextReque
  * Use `create(ParseExpenseTextRequestSchema)` to create a new message.
  */
 export const ParseExpenseTextRequestSchema: GenMessage<ParseExpenseTextRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 108);
+  messageDesc(file_pfinance_v1_finance_service, 145);
 
 /**
  * Parsed expense from natural language
@@ -3314,7 +4727,7 @@ export type ParsedExpense = Message<"pfinance.v1.ParsedExpense"> & {
  * Use `create(ParsedExpenseSchema)` to create a new message.
  */
 export const ParsedExpenseSchema: GenMessage<ParsedExpense> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 109);
+  messageDesc(file_pfinance_v1_finance_service, 146);
 
 /**
  * Smart text parsing response
@@ -3356,7 +4769,7 @@ export type ParseExpenseTextResponse = Message<"pfinance.v1.ParseExpenseTextResp
  * Use `create(ParseExpenseTextResponseSchema)` to create a new message.
  */
 export const ParseExpenseTextResponseSchema: GenMessage<ParseExpenseTextResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 110);
+  messageDesc(file_pfinance_v1_finance_service, 147);
 
 /**
  * @generated from message pfinance.v1.ParseBankStatementRequest
@@ -3389,6 +4802,20 @@ export type ParseBankStatementRequest = Message<"pfinance.v1.ParseBankStatementR
    * @generated from field: string filename = 4;
    */
   filename: string;
+
+  /**
+   * Optional saved profile: supplies the bank hint, CSV column mappings, date format and category rules
+   *
+   * @generated from field: string import_profile_id = 5;
+   */
+  importProfileId: string;
+
+  /**
+   * CSV export to parse instead of a PDF
+   *
+   * @generated from field: bytes csv_data = 6;
+   */
+  csvData: Uint8Array;
 };
 
 /**
@@ -3396,7 +4823,7 @@ export type ParseBankStatementRequest = Message<"pfinance.v1.ParseBankStatementR
  * Use `create(ParseBankStatementRequestSchema)` to create a new message.
  */
 export const ParseBankStatementRequestSchema: GenMessage<ParseBankStatementRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 111);
+  messageDesc(file_pfinance_v1_finance_service, 148);
 
 /**
  * @generated from message pfinance.v1.ParseBankStatementResponse
@@ -3420,59 +4847,340 @@ export type ParseBankStatementResponse = Message<"pfinance.v1.ParseBankStatement
  * Use `create(ParseBankStatementResponseSchema)` to create a new message.
  */
 export const ParseBankStatementResponseSchema: GenMessage<ParseBankStatementResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 112);
+  messageDesc(file_pfinance_v1_finance_service, 149);
 
 /**
- * @generated from message pfinance.v1.CreateRecurringTransactionRequest
+ * @generated from message pfinance.v1.CreateImportProfileRequest
  */
-export type CreateRecurringTransactionRequest = Message<"pfinance.v1.CreateRecurringTransactionRequest"> & {
+export type CreateImportProfileRequest = Message<"pfinance.v1.CreateImportProfileRequest"> & {
   /**
-   * @generated from field: string user_id = 1;
+   * @generated from field: string name = 1;
    */
-  userId: string;
+  name: string;
 
   /**
-   * Optional: for group recurring transactions
-   *
-   * @generated from field: string group_id = 2;
+   * @generated from field: string bank_name = 2;
    */
-  groupId: string;
+  bankName: string;
 
   /**
-   * @generated from field: string description = 3;
+   * @generated from field: string date_format = 3;
    */
-  description: string;
+  dateFormat: string;
 
   /**
-   * @generated from field: double amount = 4;
+   * @generated from field: repeated pfinance.v1.ImportColumnMapping column_mappings = 4;
    */
-  amount: number;
+  columnMappings: ImportColumnMapping[];
 
   /**
-   * Amount in cents (preferred over amount)
-   *
-   * @generated from field: int64 amount_cents = 5;
+   * @generated from field: repeated pfinance.v1.ImportCategoryRule category_rules = 5;
    */
-  amountCents: bigint;
+  categoryRules: ImportCategoryRule[];
+};
+
+/**
+ * Describes the message pfinance.v1.CreateImportProfileRequest.
+ * Use `create(CreateImportProfileRequestSchema)` to create a new message.
+ */
+export const CreateImportProfileRequestSchema: GenMessage<CreateImportProfileRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 150);
 
+/**
+ * @generated from message pfinance.v1.CreateImportProfileResponse
+ */
+export type CreateImportProfileResponse = Message<"pfinance.v1.CreateImportProfileResponse"> & {
   /**
-   * @generated from field: pfinance.v1.ExpenseCategory category = 6;
+   * @generated from field: pfinance.v1.ImportProfile profile = 1;
    */
-  category: ExpenseCategory;
+  profile?: ImportProfile;
+};
+
+/**
+ * Describes the message pfinance.v1.CreateImportProfileResponse.
+ * Use `create(CreateImportProfileResponseSchema)` to create a new message.
+ */
+export const CreateImportProfileResponseSchema: GenMessage<CreateImportProfileResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 151);
 
+/**
+ * @generated from message pfinance.v1.GetImportProfileRequest
+ */
+export type GetImportProfileRequest = Message<"pfinance.v1.GetImportProfileRequest"> & {
   /**
-   * Must not be ONCE
-   *
-   * @generated from field: pfinance.v1.ExpenseFrequency frequency = 7;
+   * @generated from field: string profile_id = 1;
    */
-  frequency: ExpenseFrequency;
+  profileId: string;
+};
+
+/**
+ * Describes the message pfinance.v1.GetImportProfileRequest.
+ * Use `create(GetImportProfileRequestSchema)` to create a new message.
+ */
+export const GetImportProfileRequestSchema: GenMessage<GetImportProfileRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 152);
 
+/**
+ * @generated from message pfinance.v1.GetImportProfileResponse
+ */
+export type GetImportProfileResponse = Message<"pfinance.v1.GetImportProfileResponse"> & {
   /**
-   * @generated from field: google.protobuf.Timestamp start_date = 8;
+   * @generated from field: pfinance.v1.ImportProfile profile = 1;
    */
-  startDate?: Timestamp;
+  profile?: ImportProfile;
+};
 
-  /**
+/**
+ * Describes the message pfinance.v1.GetImportProfileResponse.
+ * Use `create(GetImportProfileResponseSchema)` to create a new message.
+ */
+export const GetImportProfileResponseSchema: GenMessage<GetImportProfileResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 153);
+
+/**
+ * @generated from message pfinance.v1.UpdateImportProfileRequest
+ */
+export type UpdateImportProfileRequest = Message<"pfinance.v1.UpdateImportProfileRequest"> & {
+  /**
+   * @generated from field: string profile_id = 1;
+   */
+  profileId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string bank_name = 3;
+   */
+  bankName: string;
+
+  /**
+   * @generated from field: string date_format = 4;
+   */
+  dateFormat: string;
+
+  /**
+   * Replaces the saved mappings
+   *
+   * @generated from field: repeated pfinance.v1.ImportColumnMapping column_mappings = 5;
+   */
+  columnMappings: ImportColumnMapping[];
+
+  /**
+   * Replaces the saved rules
+   *
+   * @generated from field: repeated pfinance.v1.ImportCategoryRule category_rules = 6;
+   */
+  categoryRules: ImportCategoryRule[];
+};
+
+/**
+ * Describes the message pfinance.v1.UpdateImportProfileRequest.
+ * Use `create(UpdateImportProfileRequestSchema)` to create a new message.
+ */
+export const UpdateImportProfileRequestSchema: GenMessage<UpdateImportProfileRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 154);
+
+/**
+ * @generated from message pfinance.v1.UpdateImportProfileResponse
+ */
+export type UpdateImportProfileResponse = Message<"pfinance.v1.UpdateImportProfileResponse"> & {
+  /**
+   * @generated from field: pfinance.v1.ImportProfile profile = 1;
+   */
+  profile?: ImportProfile;
+};
+
+/**
+ * Describes the message pfinance.v1.UpdateImportProfileResponse.
+ * Use `create(UpdateImportProfileResponseSchema)` to create a new message.
+ */
+export const UpdateImportProfileResponseSchema: GenMessage<UpdateImportProfileResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 155);
+
+/**
+ * @generated from message pfinance.v1.DeleteImportProfileRequest
+ */
+export type DeleteImportProfileRequest = Message<"pfinance.v1.DeleteImportProfileRequest"> & {
+  /**
+   * @generated from field: string profile_id = 1;
+   */
+  profileId: string;
+};
+
+/**
+ * Describes the message pfinance.v1.DeleteImportProfileRequest.
+ * Use `create(DeleteImportProfileRequestSchema)` to create a new message.
+ */
+export const DeleteImportProfileRequestSchema: GenMessage<DeleteImportProfileRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 156);
+
+/**
+ * @generated from message pfinance.v1.ListImportProfilesRequest
+ */
+export type ListImportProfilesRequest = Message<"pfinance.v1.ListImportProfilesRequest"> & {
+};
+
+/**
+ * Describes the message pfinance.v1.ListImportProfilesRequest.
+ * Use `create(ListImportProfilesRequestSchema)` to create a new message.
+ */
+export const ListImportProfilesRequestSchema: GenMessage<ListImportProfilesRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 157);
+
+/**
+ * @generated from message pfinance.v1.ListImportProfilesResponse
+ */
+export type ListImportProfilesResponse = Message<"pfinance.v1.ListImportProfilesResponse"> & {
+  /**
+   * @generated from field: repeated pfinance.v1.ImportProfile profiles = 1;
+   */
+  profiles: ImportProfile[];
+};
+
+/**
+ * Describes the message pfinance.v1.ListImportProfilesResponse.
+ * Use `create(ListImportProfilesResponseSchema)` to create a new message.
+ */
+export const ListImportProfilesResponseSchema: GenMessage<ListImportProfilesResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 158);
+
+/**
+ * @generated from message pfinance.v1.GetExtractionPreferencesRequest
+ */
+export type GetExtractionPreferencesRequest = Message<"pfinance.v1.GetExtractionPreferencesRequest"> & {
+};
+
+/**
+ * Describes the message pfinance.v1.GetExtractionPreferencesRequest.
+ * Use `create(GetExtractionPreferencesRequestSchema)` to create a new message.
+ */
+export const GetExtractionPreferencesRequestSchema: GenMessage<GetExtractionPreferencesRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 159);
+
+/**
+ * @generated from message pfinance.v1.GetExtractionPreferencesResponse
+ */
+export type GetExtractionPreferencesResponse = Message<"pfinance.v1.GetExtractionPreferencesResponse"> & {
+  /**
+   * Every threshold is set, with defaults filled in
+   *
+   * @generated from field: pfinance.v1.ExtractionPreferences preferences = 1;
+   */
+  preferences?: ExtractionPreferences;
+};
+
+/**
+ * Describes the message pfinance.v1.GetExtractionPreferencesResponse.
+ * Use `create(GetExtractionPreferencesResponseSchema)` to create a new message.
+ */
+export const GetExtractionPreferencesResponseSchema: GenMessage<GetExtractionPreferencesResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 160);
+
+/**
+ * @generated from message pfinance.v1.UpdateExtractionPreferencesRequest
+ */
+export type UpdateExtractionPreferencesRequest = Message<"pfinance.v1.UpdateExtractionPreferencesRequest"> & {
+  /**
+   * Unset thresholds revert to their defaults
+   *
+   * @generated from field: pfinance.v1.ExtractionPreferences preferences = 1;
+   */
+  preferences?: ExtractionPreferences;
+};
+
+/**
+ * Describes the message pfinance.v1.UpdateExtractionPreferencesRequest.
+ * Use `create(UpdateExtractionPreferencesRequestSchema)` to create a new message.
+ */
+export const UpdateExtractionPreferencesRequestSchema: GenMessage<UpdateExtractionPreferencesRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 161);
+
+/**
+ * @generated from message pfinance.v1.UpdateExtractionPreferencesResponse
+ */
+export type UpdateExtractionPreferencesResponse = Message<"pfinance.v1.UpdateExtractionPreferencesResponse"> & {
+  /**
+   * @generated from field: pfinance.v1.ExtractionPreferences preferences = 1;
+   */
+  preferences?: ExtractionPreferences;
+};
+
+/**
+ * Describes the message pfinance.v1.UpdateExtractionPreferencesResponse.
+ * Use `create(UpdateExtractionPreferencesResponseSchema)` to create a new message.
+ */
+export const UpdateExtractionPreferencesResponseSchema: GenMessage<UpdateExtractionPreferencesResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 162);
+
+/**
+ * DeleteExtractionPreferencesRequest resets the caller's thresholds to the defaults.
+ *
+ * @generated from message pfinance.v1.DeleteExtractionPreferencesRequest
+ */
+export type DeleteExtractionPreferencesRequest = Message<"pfinance.v1.DeleteExtractionPreferencesRequest"> & {
+};
+
+/**
+ * Describes the message pfinance.v1.DeleteExtractionPreferencesRequest.
+ * Use `create(DeleteExtractionPreferencesRequestSchema)` to create a new message.
+ */
+export const DeleteExtractionPreferencesRequestSchema: GenMessage<DeleteExtractionPreferencesRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 163);
+
+/**
+ * @generated from message pfinance.v1.CreateRecurringTransactionRequest
+ */
+export type CreateRecurringTransactionRequest = Message<"pfinance.v1.CreateRecurringTransactionRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Optional: for group recurring transactions
+   *
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: double amount = 4;
+   */
+  amount: number;
+
+  /**
+   * Amount in cents (preferred over amount)
+   *
+   * @generated from field: int64 amount_cents = 5;
+   */
+  amountCents: bigint;
+
+  /**
+   * @generated from field: pfinance.v1.ExpenseCategory category = 6;
+   */
+  category: ExpenseCategory;
+
+  /**
+   * Must not be ONCE
+   *
+   * @generated from field: pfinance.v1.ExpenseFrequency frequency = 7;
+   */
+  frequency: ExpenseFrequency;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_date = 8;
+   */
+  startDate?: Timestamp;
+
+  /**
    * Optional: when to stop
    *
    * @generated from field: google.protobuf.Timestamp end_date = 9;
@@ -3511,6 +5219,18 @@ export type CreateRecurringTransactionRequest = Message<"pfinance.v1.CreateRecur
    * @generated from field: repeated pfinance.v1.ExpenseAllocation allocations = 14;
    */
   allocations: ExpenseAllocation[];
+
+  /**
+   * Optional: amount range for variable bills; amount defaults to its midpoint
+   *
+   * @generated from field: int64 min_amount_cents = 15;
+   */
+  minAmountCents: bigint;
+
+  /**
+   * @generated from field: int64 max_amount_cents = 16;
+   */
+  maxAmountCents: bigint;
 };
 
 /**
@@ -3518,7 +5238,7 @@ export type CreateRecurringTransactionRequest = Message<"pfinance.v1.CreateRecur
  * Use `create(CreateRecurringTransactionRequestSchema)` to create a new message.
  */
 export const CreateRecurringTransactionRequestSchema: GenMessage<CreateRecurringTransactionRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 113);
+  messageDesc(file_pfinance_v1_finance_service, 164);
 
 /**
  * @generated from message pfinance.v1.CreateRecurringTransactionResponse
@@ -3535,7 +5255,7 @@ export type CreateRecurringTransactionResponse = Message<"pfinance.v1.CreateRecu
  * Use `create(CreateRecurringTransactionResponseSchema)` to create a new message.
  */
 export const CreateRecurringTransactionResponseSchema: GenMessage<CreateRecurringTransactionResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 114);
+  messageDesc(file_pfinance_v1_finance_service, 165);
 
 /**
  * @generated from message pfinance.v1.GetRecurringTransactionRequest
@@ -3552,7 +5272,7 @@ export type GetRecurringTransactionRequest = Message<"pfinance.v1.GetRecurringTr
  * Use `create(GetRecurringTransactionRequestSchema)` to create a new message.
  */
 export const GetRecurringTransactionRequestSchema: GenMessage<GetRecurringTransactionRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 115);
+  messageDesc(file_pfinance_v1_finance_service, 166);
 
 /**
  * @generated from message pfinance.v1.GetRecurringTransactionResponse
@@ -3569,7 +5289,7 @@ export type GetRecurringTransactionResponse = Message<"pfinance.v1.GetRecurringT
  * Use `create(GetRecurringTransactionResponseSchema)` to create a new message.
  */
 export const GetRecurringTransactionResponseSchema: GenMessage<GetRecurringTransactionResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 116);
+  messageDesc(file_pfinance_v1_finance_service, 167);
 
 /**
  * @generated from message pfinance.v1.UpdateRecurringTransactionRequest
@@ -3640,6 +5360,18 @@ export type UpdateRecurringTransactionRequest = Message<"pfinance.v1.UpdateRecur
    * @generated from field: repeated pfinance.v1.ExpenseAllocation allocations = 12;
    */
   allocations: ExpenseAllocation[];
+
+  /**
+   * Set both to 0 to make the amount fixed again
+   *
+   * @generated from field: optional int64 min_amount_cents = 13;
+   */
+  minAmountCents?: bigint;
+
+  /**
+   * @generated from field: optional int64 max_amount_cents = 14;
+   */
+  maxAmountCents?: bigint;
 };
 
 /**
@@ -3647,7 +5379,7 @@ export type UpdateRecurringTransactionRequest = Message<"pfinance.v1.UpdateRecur
  * Use `create(UpdateRecurringTransactionRequestSchema)` to create a new message.
  */
 export const UpdateRecurringTransactionRequestSchema: GenMessage<UpdateRecurringTransactionRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 117);
+  messageDesc(file_pfinance_v1_finance_service, 168);
 
 /**
  * @generated from message pfinance.v1.UpdateRecurringTransactionResponse
@@ -3664,7 +5396,7 @@ export type UpdateRecurringTransactionResponse = Message<"pfinance.v1.UpdateRecu
  * Use `create(UpdateRecurringTransactionResponseSchema)` to create a new message.
  */
 export const UpdateRecurringTransactionResponseSchema: GenMessage<UpdateRecurringTransactionResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 118);
+  messageDesc(file_pfinance_v1_finance_service, 169);
 
 /**
  * @generated from message pfinance.v1.DeleteRecurringTransactionRequest
@@ -3681,7 +5413,7 @@ export type DeleteRecurringTransactionRequest = Message<"pfinance.v1.DeleteRecur
  * Use `create(DeleteRecurringTransactionRequestSchema)` to create a new message.
  */
 export const DeleteRecurringTransactionRequestSchema: GenMessage<DeleteRecurringTransactionRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 119);
+  messageDesc(file_pfinance_v1_finance_service, 170);
 
 /**
  * @generated from message pfinance.v1.ListRecurringTransactionsRequest
@@ -3736,7 +5468,7 @@ export type ListRecurringTransactionsRequest = Message<"pfinance.v1.ListRecurrin
  * Use `create(ListRecurringTransactionsRequestSchema)` to create a new message.
  */
 export const ListRecurringTransactionsRequestSchema: GenMessage<ListRecurringTransactionsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 120);
+  messageDesc(file_pfinance_v1_finance_service, 171);
 
 /**
  * @generated from message pfinance.v1.ListRecurringTransactionsResponse
@@ -3758,7 +5490,7 @@ export type ListRecurringTransactionsResponse = Message<"pfinance.v1.ListRecurri
  * Use `create(ListRecurringTransactionsResponseSchema)` to create a new message.
  */
 export const ListRecurringTransactionsResponseSchema: GenMessage<ListRecurringTransactionsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 121);
+  messageDesc(file_pfinance_v1_finance_service, 172);
 
 /**
  * @generated from message pfinance.v1.PauseRecurringTransactionRequest
@@ -3775,7 +5507,7 @@ export type PauseRecurringTransactionRequest = Message<"pfinance.v1.PauseRecurri
  * Use `create(PauseRecurringTransactionRequestSchema)` to create a new message.
  */
 export const PauseRecurringTransactionRequestSchema: GenMessage<PauseRecurringTransactionRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 122);
+  messageDesc(file_pfinance_v1_finance_service, 173);
 
 /**
  * @generated from message pfinance.v1.PauseRecurringTransactionResponse
@@ -3792,7 +5524,7 @@ export type PauseRecurringTransactionResponse = Message<"pfinance.v1.PauseRecurr
  * Use `create(PauseRecurringTransactionResponseSchema)` to create a new message.
  */
 export const PauseRecurringTransactionResponseSchema: GenMessage<PauseRecurringTransactionResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 123);
+  messageDesc(file_pfinance_v1_finance_service, 174);
 
 /**
  * @generated from message pfinance.v1.ResumeRecurringTransactionRequest
@@ -3809,7 +5541,7 @@ export type ResumeRecurringTransactionRequest = Message<"pfinance.v1.ResumeRecur
  * Use `create(ResumeRecurringTransactionRequestSchema)` to create a new message.
  */
 export const ResumeRecurringTransactionRequestSchema: GenMessage<ResumeRecurringTransactionRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 124);
+  messageDesc(file_pfinance_v1_finance_service, 175);
 
 /**
  * @generated from message pfinance.v1.ResumeRecurringTransactionResponse
@@ -3826,7 +5558,46 @@ export type ResumeRecurringTransactionResponse = Message<"pfinance.v1.ResumeRecu
  * Use `create(ResumeRecurringTransactionResponseSchema)` to create a new message.
  */
 export const ResumeRecurringTransactionResponseSchema: GenMessage<ResumeRecurringTransactionResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 125);
+  messageDesc(file_pfinance_v1_finance_service, 176);
+
+/**
+ * @generated from message pfinance.v1.SkipNextOccurrenceRequest
+ */
+export type SkipNextOccurrenceRequest = Message<"pfinance.v1.SkipNextOccurrenceRequest"> & {
+  /**
+   * @generated from field: string recurring_transaction_id = 1;
+   */
+  recurringTransactionId: string;
+};
+
+/**
+ * Describes the message pfinance.v1.SkipNextOccurrenceRequest.
+ * Use `create(SkipNextOccurrenceRequestSchema)` to create a new message.
+ */
+export const SkipNextOccurrenceRequestSchema: GenMessage<SkipNextOccurrenceRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 177);
+
+/**
+ * @generated from message pfinance.v1.SkipNextOccurrenceResponse
+ */
+export type SkipNextOccurrenceResponse = Message<"pfinance.v1.SkipNextOccurrenceResponse"> & {
+  /**
+   * @generated from field: pfinance.v1.RecurringTransaction recurring_transaction = 1;
+   */
+  recurringTransaction?: RecurringTransaction;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp skipped_occurrence = 2;
+   */
+  skippedOccurrence?: Timestamp;
+};
+
+/**
+ * Describes the message pfinance.v1.SkipNextOccurrenceResponse.
+ * Use `create(SkipNextOccurrenceResponseSchema)` to create a new message.
+ */
+export const SkipNextOccurrenceResponseSchema: GenMessage<SkipNextOccurrenceResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 178);
 
 /**
  * @generated from message pfinance.v1.GetUpcomingBillsRequest
@@ -3864,7 +5635,7 @@ export type GetUpcomingBillsRequest = Message<"pfinance.v1.GetUpcomingBillsReque
  * Use `create(GetUpcomingBillsRequestSchema)` to create a new message.
  */
 export const GetUpcomingBillsRequestSchema: GenMessage<GetUpcomingBillsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 126);
+  messageDesc(file_pfinance_v1_finance_service, 179);
 
 /**
  * @generated from message pfinance.v1.GetUpcomingBillsResponse
@@ -3881,23 +5652,93 @@ export type GetUpcomingBillsResponse = Message<"pfinance.v1.GetUpcomingBillsResp
  * Use `create(GetUpcomingBillsResponseSchema)` to create a new message.
  */
 export const GetUpcomingBillsResponseSchema: GenMessage<GetUpcomingBillsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 127);
+  messageDesc(file_pfinance_v1_finance_service, 180);
 
 /**
- * ProcessRecurringTransactions is called by Cloud Scheduler to create
- * expenses/incomes for all recurring transactions that are due.
+ * PreviewRecurringOccurrences projects the dates a recurring transaction with
+ * these parameters would generate, without creating it.
  *
- * @generated from message pfinance.v1.ProcessRecurringTransactionsRequest
+ * @generated from message pfinance.v1.PreviewRecurringOccurrencesRequest
  */
-export type ProcessRecurringTransactionsRequest = Message<"pfinance.v1.ProcessRecurringTransactionsRequest"> & {
-};
+export type PreviewRecurringOccurrencesRequest = Message<"pfinance.v1.PreviewRecurringOccurrencesRequest"> & {
+  /**
+   * @generated from field: pfinance.v1.ExpenseFrequency frequency = 1;
+   */
+  frequency: ExpenseFrequency;
 
-/**
- * Describes the message pfinance.v1.ProcessRecurringTransactionsRequest.
- * Use `create(ProcessRecurringTransactionsRequestSchema)` to create a new message.
- */
-export const ProcessRecurringTransactionsRequestSchema: GenMessage<ProcessRecurringTransactionsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 128);
+  /**
+   * @generated from field: google.protobuf.Timestamp start_date = 2;
+   */
+  startDate?: Timestamp;
+
+  /**
+   * Optional: no occurrences after this
+   *
+   * @generated from field: google.protobuf.Timestamp end_date = 3;
+   */
+  endDate?: Timestamp;
+
+  /**
+   * Optional: total occurrences in the series, counted from start_date
+   *
+   * @generated from field: int32 max_occurrences = 4;
+   */
+  maxOccurrences: number;
+
+  /**
+   * How many upcoming dates to return (default 10, max 100)
+   *
+   * @generated from field: int32 count = 5;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message pfinance.v1.PreviewRecurringOccurrencesRequest.
+ * Use `create(PreviewRecurringOccurrencesRequestSchema)` to create a new message.
+ */
+export const PreviewRecurringOccurrencesRequestSchema: GenMessage<PreviewRecurringOccurrencesRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 181);
+
+/**
+ * @generated from message pfinance.v1.PreviewRecurringOccurrencesResponse
+ */
+export type PreviewRecurringOccurrencesResponse = Message<"pfinance.v1.PreviewRecurringOccurrencesResponse"> & {
+  /**
+   * @generated from field: repeated google.protobuf.Timestamp occurrences = 1;
+   */
+  occurrences: Timestamp[];
+
+  /**
+   * More occurrences follow the returned ones
+   *
+   * @generated from field: bool has_more = 2;
+   */
+  hasMore: boolean;
+};
+
+/**
+ * Describes the message pfinance.v1.PreviewRecurringOccurrencesResponse.
+ * Use `create(PreviewRecurringOccurrencesResponseSchema)` to create a new message.
+ */
+export const PreviewRecurringOccurrencesResponseSchema: GenMessage<PreviewRecurringOccurrencesResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 182);
+
+/**
+ * ProcessRecurringTransactions is called by Cloud Scheduler to create
+ * expenses/incomes for all recurring transactions that are due.
+ *
+ * @generated from message pfinance.v1.ProcessRecurringTransactionsRequest
+ */
+export type ProcessRecurringTransactionsRequest = Message<"pfinance.v1.ProcessRecurringTransactionsRequest"> & {
+};
+
+/**
+ * Describes the message pfinance.v1.ProcessRecurringTransactionsRequest.
+ * Use `create(ProcessRecurringTransactionsRequestSchema)` to create a new message.
+ */
+export const ProcessRecurringTransactionsRequestSchema: GenMessage<ProcessRecurringTransactionsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 183);
 
 /**
  * @generated from message pfinance.v1.ProcessRecurringTransactionsResponse
@@ -3930,6 +5771,13 @@ export type ProcessRecurringTransactionsResponse = Message<"pfinance.v1.ProcessR
    * @generated from field: int32 error_count = 4;
    */
   errorCount: number;
+
+  /**
+   * Expenses/incomes created, including missed occurrences caught up
+   *
+   * @generated from field: int32 created_count = 5;
+   */
+  createdCount: number;
 };
 
 /**
@@ -3937,7 +5785,7 @@ export type ProcessRecurringTransactionsResponse = Message<"pfinance.v1.ProcessR
  * Use `create(ProcessRecurringTransactionsResponseSchema)` to create a new message.
  */
 export const ProcessRecurringTransactionsResponseSchema: GenMessage<ProcessRecurringTransactionsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 129);
+  messageDesc(file_pfinance_v1_finance_service, 184);
 
 /**
  * @generated from message pfinance.v1.SearchTransactionsRequest
@@ -3970,32 +5818,32 @@ export type SearchTransactionsRequest = Message<"pfinance.v1.SearchTransactionsR
   category: string;
 
   /**
-   * Optional: minimum amount
+   * Optional: inclusive minimum amount; unset means no lower bound
    *
-   * @generated from field: double amount_min = 5;
+   * @generated from field: optional double amount_min = 5;
    */
-  amountMin: number;
+  amountMin?: number;
 
   /**
-   * Optional: maximum amount
+   * Optional: inclusive maximum amount; unset means no upper bound
    *
-   * @generated from field: double amount_max = 6;
+   * @generated from field: optional double amount_max = 6;
    */
-  amountMax: number;
+  amountMax?: number;
 
   /**
-   * Optional: minimum amount in cents
+   * Optional: minimum amount in cents (preferred over amount_min)
    *
-   * @generated from field: int64 amount_min_cents = 7;
+   * @generated from field: optional int64 amount_min_cents = 7;
    */
-  amountMinCents: bigint;
+  amountMinCents?: bigint;
 
   /**
-   * Optional: maximum amount in cents
+   * Optional: maximum amount in cents (preferred over amount_max)
    *
-   * @generated from field: int64 amount_max_cents = 8;
+   * @generated from field: optional int64 amount_max_cents = 8;
    */
-  amountMaxCents: bigint;
+  amountMaxCents?: bigint;
 
   /**
    * Optional: date range start
@@ -4027,6 +5875,13 @@ export type SearchTransactionsRequest = Message<"pfinance.v1.SearchTransactionsR
    * @generated from field: string page_token = 13;
    */
   pageToken: string;
+
+  /**
+   * Optional: result order; defaults to date
+   *
+   * @generated from field: pfinance.v1.SearchSortBy sort_by = 14;
+   */
+  sortBy: SearchSortBy;
 };
 
 /**
@@ -4034,7 +5889,7 @@ export type SearchTransactionsRequest = Message<"pfinance.v1.SearchTransactionsR
  * Use `create(SearchTransactionsRequestSchema)` to create a new message.
  */
 export const SearchTransactionsRequestSchema: GenMessage<SearchTransactionsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 130);
+  messageDesc(file_pfinance_v1_finance_service, 185);
 
 /**
  * @generated from message pfinance.v1.SearchTransactionsResponse
@@ -4061,7 +5916,7 @@ export type SearchTransactionsResponse = Message<"pfinance.v1.SearchTransactions
  * Use `create(SearchTransactionsResponseSchema)` to create a new message.
  */
 export const SearchTransactionsResponseSchema: GenMessage<SearchTransactionsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 131);
+  messageDesc(file_pfinance_v1_finance_service, 186);
 
 /**
  * @generated from message pfinance.v1.DetectSubscriptionsRequest
@@ -4092,7 +5947,7 @@ export type DetectSubscriptionsRequest = Message<"pfinance.v1.DetectSubscription
  * Use `create(DetectSubscriptionsRequestSchema)` to create a new message.
  */
 export const DetectSubscriptionsRequestSchema: GenMessage<DetectSubscriptionsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 132);
+  messageDesc(file_pfinance_v1_finance_service, 187);
 
 /**
  * @generated from message pfinance.v1.DetectSubscriptionsResponse
@@ -4126,7 +5981,7 @@ export type DetectSubscriptionsResponse = Message<"pfinance.v1.DetectSubscriptio
  * Use `create(DetectSubscriptionsResponseSchema)` to create a new message.
  */
 export const DetectSubscriptionsResponseSchema: GenMessage<DetectSubscriptionsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 133);
+  messageDesc(file_pfinance_v1_finance_service, 188);
 
 /**
  * @generated from message pfinance.v1.ConvertToRecurringRequest
@@ -4148,7 +6003,7 @@ export type ConvertToRecurringRequest = Message<"pfinance.v1.ConvertToRecurringR
  * Use `create(ConvertToRecurringRequestSchema)` to create a new message.
  */
 export const ConvertToRecurringRequestSchema: GenMessage<ConvertToRecurringRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 134);
+  messageDesc(file_pfinance_v1_finance_service, 189);
 
 /**
  * @generated from message pfinance.v1.ConvertToRecurringResponse
@@ -4165,7 +6020,105 @@ export type ConvertToRecurringResponse = Message<"pfinance.v1.ConvertToRecurring
  * Use `create(ConvertToRecurringResponseSchema)` to create a new message.
  */
 export const ConvertToRecurringResponseSchema: GenMessage<ConvertToRecurringResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 135);
+  messageDesc(file_pfinance_v1_finance_service, 190);
+
+/**
+ * @generated from message pfinance.v1.ConvertExpenseToRecurringRequest
+ */
+export type ConvertExpenseToRecurringRequest = Message<"pfinance.v1.ConvertExpenseToRecurringRequest"> & {
+  /**
+   * @generated from field: string expense_id = 1;
+   */
+  expenseId: string;
+
+  /**
+   * Must not be once or unspecified
+   *
+   * @generated from field: pfinance.v1.ExpenseFrequency frequency = 2;
+   */
+  frequency: ExpenseFrequency;
+
+  /**
+   * Delete the expense once the recurring transaction exists
+   *
+   * @generated from field: bool delete_original = 3;
+   */
+  deleteOriginal: boolean;
+};
+
+/**
+ * Describes the message pfinance.v1.ConvertExpenseToRecurringRequest.
+ * Use `create(ConvertExpenseToRecurringRequestSchema)` to create a new message.
+ */
+export const ConvertExpenseToRecurringRequestSchema: GenMessage<ConvertExpenseToRecurringRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 191);
+
+/**
+ * @generated from message pfinance.v1.ConvertExpenseToRecurringResponse
+ */
+export type ConvertExpenseToRecurringResponse = Message<"pfinance.v1.ConvertExpenseToRecurringResponse"> & {
+  /**
+   * @generated from field: pfinance.v1.RecurringTransaction recurring_transaction = 1;
+   */
+  recurringTransaction?: RecurringTransaction;
+};
+
+/**
+ * Describes the message pfinance.v1.ConvertExpenseToRecurringResponse.
+ * Use `create(ConvertExpenseToRecurringResponseSchema)` to create a new message.
+ */
+export const ConvertExpenseToRecurringResponseSchema: GenMessage<ConvertExpenseToRecurringResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 192);
+
+/**
+ * @generated from message pfinance.v1.DetectRecurringPatternsRequest
+ */
+export type DetectRecurringPatternsRequest = Message<"pfinance.v1.DetectRecurringPatternsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Optional: detect within a group
+   *
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+
+  /**
+   * How many months to analyze (default 6)
+   *
+   * @generated from field: int32 lookback_months = 3;
+   */
+  lookbackMonths: number;
+};
+
+/**
+ * Describes the message pfinance.v1.DetectRecurringPatternsRequest.
+ * Use `create(DetectRecurringPatternsRequestSchema)` to create a new message.
+ */
+export const DetectRecurringPatternsRequestSchema: GenMessage<DetectRecurringPatternsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 193);
+
+/**
+ * @generated from message pfinance.v1.DetectRecurringPatternsResponse
+ */
+export type DetectRecurringPatternsResponse = Message<"pfinance.v1.DetectRecurringPatternsResponse"> & {
+  /**
+   * Untracked patterns, most confident first
+   *
+   * @generated from field: repeated pfinance.v1.RecurringPatternSuggestion suggestions = 1;
+   */
+  suggestions: RecurringPatternSuggestion[];
+};
+
+/**
+ * Describes the message pfinance.v1.DetectRecurringPatternsResponse.
+ * Use `create(DetectRecurringPatternsResponseSchema)` to create a new message.
+ */
+export const DetectRecurringPatternsResponseSchema: GenMessage<DetectRecurringPatternsResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 194);
 
 /**
  * @generated from message pfinance.v1.ListNotificationsRequest
@@ -4206,7 +6159,7 @@ export type ListNotificationsRequest = Message<"pfinance.v1.ListNotificationsReq
  * Use `create(ListNotificationsRequestSchema)` to create a new message.
  */
 export const ListNotificationsRequestSchema: GenMessage<ListNotificationsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 136);
+  messageDesc(file_pfinance_v1_finance_service, 195);
 
 /**
  * @generated from message pfinance.v1.ListNotificationsResponse
@@ -4233,7 +6186,7 @@ export type ListNotificationsResponse = Message<"pfinance.v1.ListNotificationsRe
  * Use `create(ListNotificationsResponseSchema)` to create a new message.
  */
 export const ListNotificationsResponseSchema: GenMessage<ListNotificationsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 137);
+  messageDesc(file_pfinance_v1_finance_service, 196);
 
 /**
  * @generated from message pfinance.v1.MarkNotificationReadRequest
@@ -4250,7 +6203,7 @@ export type MarkNotificationReadRequest = Message<"pfinance.v1.MarkNotificationR
  * Use `create(MarkNotificationReadRequestSchema)` to create a new message.
  */
 export const MarkNotificationReadRequestSchema: GenMessage<MarkNotificationReadRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 138);
+  messageDesc(file_pfinance_v1_finance_service, 197);
 
 /**
  * @generated from message pfinance.v1.MarkAllNotificationsReadRequest
@@ -4267,7 +6220,58 @@ export type MarkAllNotificationsReadRequest = Message<"pfinance.v1.MarkAllNotifi
  * Use `create(MarkAllNotificationsReadRequestSchema)` to create a new message.
  */
 export const MarkAllNotificationsReadRequestSchema: GenMessage<MarkAllNotificationsReadRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 139);
+  messageDesc(file_pfinance_v1_finance_service, 198);
+
+/**
+ * @generated from message pfinance.v1.DeleteNotificationRequest
+ */
+export type DeleteNotificationRequest = Message<"pfinance.v1.DeleteNotificationRequest"> & {
+  /**
+   * @generated from field: string notification_id = 1;
+   */
+  notificationId: string;
+};
+
+/**
+ * Describes the message pfinance.v1.DeleteNotificationRequest.
+ * Use `create(DeleteNotificationRequestSchema)` to create a new message.
+ */
+export const DeleteNotificationRequestSchema: GenMessage<DeleteNotificationRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 199);
+
+/**
+ * @generated from message pfinance.v1.DeleteAllReadNotificationsRequest
+ */
+export type DeleteAllReadNotificationsRequest = Message<"pfinance.v1.DeleteAllReadNotificationsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message pfinance.v1.DeleteAllReadNotificationsRequest.
+ * Use `create(DeleteAllReadNotificationsRequestSchema)` to create a new message.
+ */
+export const DeleteAllReadNotificationsRequestSchema: GenMessage<DeleteAllReadNotificationsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 200);
+
+/**
+ * @generated from message pfinance.v1.DeleteAllReadNotificationsResponse
+ */
+export type DeleteAllReadNotificationsResponse = Message<"pfinance.v1.DeleteAllReadNotificationsResponse"> & {
+  /**
+   * @generated from field: int32 deleted_count = 1;
+   */
+  deletedCount: number;
+};
+
+/**
+ * Describes the message pfinance.v1.DeleteAllReadNotificationsResponse.
+ * Use `create(DeleteAllReadNotificationsResponseSchema)` to create a new message.
+ */
+export const DeleteAllReadNotificationsResponseSchema: GenMessage<DeleteAllReadNotificationsResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 201);
 
 /**
  * @generated from message pfinance.v1.GetUnreadNotificationCountRequest
@@ -4284,7 +6288,7 @@ export type GetUnreadNotificationCountRequest = Message<"pfinance.v1.GetUnreadNo
  * Use `create(GetUnreadNotificationCountRequestSchema)` to create a new message.
  */
 export const GetUnreadNotificationCountRequestSchema: GenMessage<GetUnreadNotificationCountRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 140);
+  messageDesc(file_pfinance_v1_finance_service, 202);
 
 /**
  * @generated from message pfinance.v1.GetUnreadNotificationCountResponse
@@ -4301,7 +6305,60 @@ export type GetUnreadNotificationCountResponse = Message<"pfinance.v1.GetUnreadN
  * Use `create(GetUnreadNotificationCountResponseSchema)` to create a new message.
  */
 export const GetUnreadNotificationCountResponseSchema: GenMessage<GetUnreadNotificationCountResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 141);
+  messageDesc(file_pfinance_v1_finance_service, 203);
+
+/**
+ * @generated from message pfinance.v1.GetNotificationStatsRequest
+ */
+export type GetNotificationStatsRequest = Message<"pfinance.v1.GetNotificationStatsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_date = 2;
+   */
+  startDate?: Timestamp;
+
+  /**
+   * At most 366 days after start_date
+   *
+   * @generated from field: google.protobuf.Timestamp end_date = 3;
+   */
+  endDate?: Timestamp;
+};
+
+/**
+ * Describes the message pfinance.v1.GetNotificationStatsRequest.
+ * Use `create(GetNotificationStatsRequestSchema)` to create a new message.
+ */
+export const GetNotificationStatsRequestSchema: GenMessage<GetNotificationStatsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 204);
+
+/**
+ * @generated from message pfinance.v1.GetNotificationStatsResponse
+ */
+export type GetNotificationStatsResponse = Message<"pfinance.v1.GetNotificationStatsResponse"> & {
+  /**
+   * Sorted by date, then type
+   *
+   * @generated from field: repeated pfinance.v1.NotificationDayCount counts = 1;
+   */
+  counts: NotificationDayCount[];
+
+  /**
+   * @generated from field: int32 total_count = 2;
+   */
+  totalCount: number;
+};
+
+/**
+ * Describes the message pfinance.v1.GetNotificationStatsResponse.
+ * Use `create(GetNotificationStatsResponseSchema)` to create a new message.
+ */
+export const GetNotificationStatsResponseSchema: GenMessage<GetNotificationStatsResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 205);
 
 /**
  * @generated from message pfinance.v1.GetNotificationPreferencesRequest
@@ -4318,7 +6375,7 @@ export type GetNotificationPreferencesRequest = Message<"pfinance.v1.GetNotifica
  * Use `create(GetNotificationPreferencesRequestSchema)` to create a new message.
  */
 export const GetNotificationPreferencesRequestSchema: GenMessage<GetNotificationPreferencesRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 142);
+  messageDesc(file_pfinance_v1_finance_service, 206);
 
 /**
  * @generated from message pfinance.v1.GetNotificationPreferencesResponse
@@ -4335,7 +6392,7 @@ export type GetNotificationPreferencesResponse = Message<"pfinance.v1.GetNotific
  * Use `create(GetNotificationPreferencesResponseSchema)` to create a new message.
  */
 export const GetNotificationPreferencesResponseSchema: GenMessage<GetNotificationPreferencesResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 143);
+  messageDesc(file_pfinance_v1_finance_service, 207);
 
 /**
  * @generated from message pfinance.v1.UpdateNotificationPreferencesRequest
@@ -4357,7 +6414,7 @@ export type UpdateNotificationPreferencesRequest = Message<"pfinance.v1.UpdateNo
  * Use `create(UpdateNotificationPreferencesRequestSchema)` to create a new message.
  */
 export const UpdateNotificationPreferencesRequestSchema: GenMessage<UpdateNotificationPreferencesRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 144);
+  messageDesc(file_pfinance_v1_finance_service, 208);
 
 /**
  * @generated from message pfinance.v1.UpdateNotificationPreferencesResponse
@@ -4374,7 +6431,7 @@ export type UpdateNotificationPreferencesResponse = Message<"pfinance.v1.UpdateN
  * Use `create(UpdateNotificationPreferencesResponseSchema)` to create a new message.
  */
 export const UpdateNotificationPreferencesResponseSchema: GenMessage<UpdateNotificationPreferencesResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 145);
+  messageDesc(file_pfinance_v1_finance_service, 209);
 
 /**
  * @generated from message pfinance.v1.GenerateWeeklyDigestRequest
@@ -4393,7 +6450,7 @@ export type GenerateWeeklyDigestRequest = Message<"pfinance.v1.GenerateWeeklyDig
  * Use `create(GenerateWeeklyDigestRequestSchema)` to create a new message.
  */
 export const GenerateWeeklyDigestRequestSchema: GenMessage<GenerateWeeklyDigestRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 146);
+  messageDesc(file_pfinance_v1_finance_service, 210);
 
 /**
  * @generated from message pfinance.v1.GenerateWeeklyDigestResponse
@@ -4415,7 +6472,7 @@ export type GenerateWeeklyDigestResponse = Message<"pfinance.v1.GenerateWeeklyDi
  * Use `create(GenerateWeeklyDigestResponseSchema)` to create a new message.
  */
 export const GenerateWeeklyDigestResponseSchema: GenMessage<GenerateWeeklyDigestResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 147);
+  messageDesc(file_pfinance_v1_finance_service, 211);
 
 /**
  * WeeklyDigestData is serialized as JSON in notification metadata
@@ -4478,7 +6535,7 @@ export type WeeklyDigestData = Message<"pfinance.v1.WeeklyDigestData"> & {
  * Use `create(WeeklyDigestDataSchema)` to create a new message.
  */
 export const WeeklyDigestDataSchema: GenMessage<WeeklyDigestData> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 148);
+  messageDesc(file_pfinance_v1_finance_service, 212);
 
 /**
  * @generated from message pfinance.v1.DigestBudgetSummary
@@ -4510,7 +6567,7 @@ export type DigestBudgetSummary = Message<"pfinance.v1.DigestBudgetSummary"> & {
  * Use `create(DigestBudgetSummarySchema)` to create a new message.
  */
 export const DigestBudgetSummarySchema: GenMessage<DigestBudgetSummary> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 149);
+  messageDesc(file_pfinance_v1_finance_service, 213);
 
 /**
  * @generated from message pfinance.v1.DigestGoalSummary
@@ -4542,7 +6599,7 @@ export type DigestGoalSummary = Message<"pfinance.v1.DigestGoalSummary"> & {
  * Use `create(DigestGoalSummarySchema)` to create a new message.
  */
 export const DigestGoalSummarySchema: GenMessage<DigestGoalSummary> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 150);
+  messageDesc(file_pfinance_v1_finance_service, 214);
 
 /**
  * @generated from message pfinance.v1.CreateCheckoutSessionRequest
@@ -4569,7 +6626,7 @@ export type CreateCheckoutSessionRequest = Message<"pfinance.v1.CreateCheckoutSe
  * Use `create(CreateCheckoutSessionRequestSchema)` to create a new message.
  */
 export const CreateCheckoutSessionRequestSchema: GenMessage<CreateCheckoutSessionRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 151);
+  messageDesc(file_pfinance_v1_finance_service, 215);
 
 /**
  * @generated from message pfinance.v1.CreateCheckoutSessionResponse
@@ -4591,7 +6648,7 @@ export type CreateCheckoutSessionResponse = Message<"pfinance.v1.CreateCheckoutS
  * Use `create(CreateCheckoutSessionResponseSchema)` to create a new message.
  */
 export const CreateCheckoutSessionResponseSchema: GenMessage<CreateCheckoutSessionResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 152);
+  messageDesc(file_pfinance_v1_finance_service, 216);
 
 /**
  * @generated from message pfinance.v1.GetSubscriptionStatusRequest
@@ -4608,7 +6665,7 @@ export type GetSubscriptionStatusRequest = Message<"pfinance.v1.GetSubscriptionS
  * Use `create(GetSubscriptionStatusRequestSchema)` to create a new message.
  */
 export const GetSubscriptionStatusRequestSchema: GenMessage<GetSubscriptionStatusRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 153);
+  messageDesc(file_pfinance_v1_finance_service, 217);
 
 /**
  * @generated from message pfinance.v1.GetSubscriptionStatusResponse
@@ -4640,7 +6697,7 @@ export type GetSubscriptionStatusResponse = Message<"pfinance.v1.GetSubscription
  * Use `create(GetSubscriptionStatusResponseSchema)` to create a new message.
  */
 export const GetSubscriptionStatusResponseSchema: GenMessage<GetSubscriptionStatusResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 154);
+  messageDesc(file_pfinance_v1_finance_service, 218);
 
 /**
  * @generated from message pfinance.v1.CancelSubscriptionRequest
@@ -4657,7 +6714,7 @@ export type CancelSubscriptionRequest = Message<"pfinance.v1.CancelSubscriptionR
  * Use `create(CancelSubscriptionRequestSchema)` to create a new message.
  */
 export const CancelSubscriptionRequestSchema: GenMessage<CancelSubscriptionRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 155);
+  messageDesc(file_pfinance_v1_finance_service, 219);
 
 /**
  * @generated from message pfinance.v1.CancelSubscriptionResponse
@@ -4679,7 +6736,7 @@ export type CancelSubscriptionResponse = Message<"pfinance.v1.CancelSubscription
  * Use `create(CancelSubscriptionResponseSchema)` to create a new message.
  */
 export const CancelSubscriptionResponseSchema: GenMessage<CancelSubscriptionResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 156);
+  messageDesc(file_pfinance_v1_finance_service, 220);
 
 /**
  * @generated from message pfinance.v1.VerifyCheckoutSessionRequest
@@ -4696,7 +6753,7 @@ export type VerifyCheckoutSessionRequest = Message<"pfinance.v1.VerifyCheckoutSe
  * Use `create(VerifyCheckoutSessionRequestSchema)` to create a new message.
  */
 export const VerifyCheckoutSessionRequestSchema: GenMessage<VerifyCheckoutSessionRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 157);
+  messageDesc(file_pfinance_v1_finance_service, 221);
 
 /**
  * @generated from message pfinance.v1.VerifyCheckoutSessionResponse
@@ -4733,7 +6790,7 @@ export type VerifyCheckoutSessionResponse = Message<"pfinance.v1.VerifyCheckoutS
  * Use `create(VerifyCheckoutSessionResponseSchema)` to create a new message.
  */
 export const VerifyCheckoutSessionResponseSchema: GenMessage<VerifyCheckoutSessionResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 158);
+  messageDesc(file_pfinance_v1_finance_service, 222);
 
 /**
  * @generated from message pfinance.v1.GetDailyAggregatesRequest
@@ -4760,6 +6817,13 @@ export type GetDailyAggregatesRequest = Message<"pfinance.v1.GetDailyAggregatesR
    * @generated from field: google.protobuf.Timestamp end_date = 4;
    */
   endDate?: Timestamp;
+
+  /**
+   * Also total income per day; days with only income are included
+   *
+   * @generated from field: bool include_income = 5;
+   */
+  includeIncome: boolean;
 };
 
 /**
@@ -4767,7 +6831,7 @@ export type GetDailyAggregatesRequest = Message<"pfinance.v1.GetDailyAggregatesR
  * Use `create(GetDailyAggregatesRequestSchema)` to create a new message.
  */
 export const GetDailyAggregatesRequestSchema: GenMessage<GetDailyAggregatesRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 159);
+  messageDesc(file_pfinance_v1_finance_service, 223);
 
 /**
  * @generated from message pfinance.v1.GetDailyAggregatesResponse
@@ -4779,6 +6843,9 @@ export type GetDailyAggregatesResponse = Message<"pfinance.v1.GetDailyAggregates
   aggregates: DailyAggregate[];
 
   /**
+   * Largest daily expense total. Income never counts towards it, even with
+   * include_income; see max_daily_income_amount for the income scale.
+   *
    * @generated from field: double max_daily_amount = 2;
    */
   maxDailyAmount: number;
@@ -4787,6 +6854,20 @@ export type GetDailyAggregatesResponse = Message<"pfinance.v1.GetDailyAggregates
    * @generated from field: int64 max_daily_amount_cents = 3;
    */
   maxDailyAmountCents: bigint;
+
+  /**
+   * Only set with include_income
+   *
+   * @generated from field: double max_daily_income_amount = 4;
+   */
+  maxDailyIncomeAmount: number;
+
+  /**
+   * Only set with include_income
+   *
+   * @generated from field: int64 max_daily_income_amount_cents = 5;
+   */
+  maxDailyIncomeAmountCents: bigint;
 };
 
 /**
@@ -4794,7 +6875,7 @@ export type GetDailyAggregatesResponse = Message<"pfinance.v1.GetDailyAggregates
  * Use `create(GetDailyAggregatesResponseSchema)` to create a new message.
  */
 export const GetDailyAggregatesResponseSchema: GenMessage<GetDailyAggregatesResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 160);
+  messageDesc(file_pfinance_v1_finance_service, 224);
 
 /**
  * @generated from message pfinance.v1.GetSpendingTrendsRequest
@@ -4830,6 +6911,27 @@ export type GetSpendingTrendsRequest = Message<"pfinance.v1.GetSpendingTrendsReq
    * @generated from field: pfinance.v1.ExpenseCategory category = 5;
    */
   category: ExpenseCategory;
+
+  /**
+   * First day of GRANULARITY_WEEK buckets, default Sunday
+   *
+   * @generated from field: pfinance.v1.DayOfWeek week_starts_on = 6;
+   */
+  weekStartsOn: DayOfWeek;
+
+  /**
+   * Centered moving-average window in periods; 0 or 1 disables
+   *
+   * @generated from field: int32 smoothing_window = 7;
+   */
+  smoothingWindow: number;
+
+  /**
+   * Also return each period's equivalent one year earlier
+   *
+   * @generated from field: bool compare_year_over_year = 8;
+   */
+  compareYearOverYear: boolean;
 };
 
 /**
@@ -4837,7 +6939,7 @@ export type GetSpendingTrendsRequest = Message<"pfinance.v1.GetSpendingTrendsReq
  * Use `create(GetSpendingTrendsRequestSchema)` to create a new message.
  */
 export const GetSpendingTrendsRequestSchema: GenMessage<GetSpendingTrendsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 161);
+  messageDesc(file_pfinance_v1_finance_service, 225);
 
 /**
  * @generated from message pfinance.v1.GetSpendingTrendsResponse
@@ -4854,7 +6956,7 @@ export type GetSpendingTrendsResponse = Message<"pfinance.v1.GetSpendingTrendsRe
   incomeSeries: TimeSeriesDataPoint[];
 
   /**
-   * Linear regression slope
+   * Linear regression slope (always on raw values)
    *
    * @generated from field: double trend_slope = 3;
    */
@@ -4866,6 +6968,46 @@ export type GetSpendingTrendsResponse = Message<"pfinance.v1.GetSpendingTrendsRe
    * @generated from field: double trend_r_squared = 4;
    */
   trendRSquared: number;
+
+  /**
+   * Set when smoothing_window > 1
+   *
+   * @generated from field: repeated pfinance.v1.TimeSeriesDataPoint smoothed_expense_series = 5;
+   */
+  smoothedExpenseSeries: TimeSeriesDataPoint[];
+
+  /**
+   * Set when smoothing_window > 1
+   *
+   * @generated from field: repeated pfinance.v1.TimeSeriesDataPoint smoothed_income_series = 6;
+   */
+  smoothedIncomeSeries: TimeSeriesDataPoint[];
+
+  /**
+   * Set when compare_year_over_year is true; parallel to the series above
+   *
+   * @generated from field: repeated pfinance.v1.TimeSeriesDataPoint prior_year_expense_series = 7;
+   */
+  priorYearExpenseSeries: TimeSeriesDataPoint[];
+
+  /**
+   * @generated from field: repeated pfinance.v1.TimeSeriesDataPoint prior_year_income_series = 8;
+   */
+  priorYearIncomeSeries: TimeSeriesDataPoint[];
+
+  /**
+   * 0 where the prior year had no spending
+   *
+   * @generated from field: repeated double expense_year_over_year_change_percent = 9;
+   */
+  expenseYearOverYearChangePercent: number[];
+
+  /**
+   * 0 where the prior year had no income
+   *
+   * @generated from field: repeated double income_year_over_year_change_percent = 10;
+   */
+  incomeYearOverYearChangePercent: number[];
 };
 
 /**
@@ -4873,7 +7015,7 @@ export type GetSpendingTrendsResponse = Message<"pfinance.v1.GetSpendingTrendsRe
  * Use `create(GetSpendingTrendsResponseSchema)` to create a new message.
  */
 export const GetSpendingTrendsResponseSchema: GenMessage<GetSpendingTrendsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 162);
+  messageDesc(file_pfinance_v1_finance_service, 226);
 
 /**
  * @generated from message pfinance.v1.GetCategoryComparisonRequest
@@ -4892,7 +7034,7 @@ export type GetCategoryComparisonRequest = Message<"pfinance.v1.GetCategoryCompa
   groupId: string;
 
   /**
-   * "week", "month", "quarter"
+   * "week", "fortnight", "month", "quarter"
    *
    * @generated from field: string current_period = 3;
    */
@@ -4902,6 +7044,27 @@ export type GetCategoryComparisonRequest = Message<"pfinance.v1.GetCategoryCompa
    * @generated from field: bool include_budgets = 4;
    */
   includeBudgets: boolean;
+
+  /**
+   * Roll unspecified/other into "Uncategorized" and append a total row
+   *
+   * @generated from field: bool include_totals_row = 5;
+   */
+  includeTotalsRow: boolean;
+
+  /**
+   * Any day a fortnight starts on (default: most recent Monday)
+   *
+   * @generated from field: google.protobuf.Timestamp fortnight_anchor = 6;
+   */
+  fortnightAnchor?: Timestamp;
+
+  /**
+   * Attach each category's K largest current-period expenses (max 50); 0 attaches none
+   *
+   * @generated from field: int32 include_top_transactions = 7;
+   */
+  includeTopTransactions: number;
 };
 
 /**
@@ -4909,7 +7072,7 @@ export type GetCategoryComparisonRequest = Message<"pfinance.v1.GetCategoryCompa
  * Use `create(GetCategoryComparisonRequestSchema)` to create a new message.
  */
 export const GetCategoryComparisonRequestSchema: GenMessage<GetCategoryComparisonRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 163);
+  messageDesc(file_pfinance_v1_finance_service, 227);
 
 /**
  * @generated from message pfinance.v1.GetCategoryComparisonResponse
@@ -4926,7 +7089,7 @@ export type GetCategoryComparisonResponse = Message<"pfinance.v1.GetCategoryComp
  * Use `create(GetCategoryComparisonResponseSchema)` to create a new message.
  */
 export const GetCategoryComparisonResponseSchema: GenMessage<GetCategoryComparisonResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 164);
+  messageDesc(file_pfinance_v1_finance_service, 228);
 
 /**
  * @generated from message pfinance.v1.DetectAnomaliesRequest
@@ -4957,6 +7120,27 @@ export type DetectAnomaliesRequest = Message<"pfinance.v1.DetectAnomaliesRequest
    * @generated from field: double sensitivity = 4;
    */
   sensitivity: number;
+
+  /**
+   * Score against persisted per-category median/MAD baselines (personal only)
+   *
+   * @generated from field: bool use_stored_baselines = 5;
+   */
+  useStoredBaselines: boolean;
+
+  /**
+   * Optional - days before the lookback to search for a merchant before flagging it as new; 0 only checks the lookback
+   *
+   * @generated from field: int32 merchant_history_days = 6;
+   */
+  merchantHistoryDays: number;
+
+  /**
+   * Also flag expenses far outside the category's usual hours; date-only expenses are skipped
+   *
+   * @generated from field: bool include_time_outliers = 7;
+   */
+  includeTimeOutliers: boolean;
 };
 
 /**
@@ -4964,7 +7148,7 @@ export type DetectAnomaliesRequest = Message<"pfinance.v1.DetectAnomaliesRequest
  * Use `create(DetectAnomaliesRequestSchema)` to create a new message.
  */
 export const DetectAnomaliesRequestSchema: GenMessage<DetectAnomaliesRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 165);
+  messageDesc(file_pfinance_v1_finance_service, 229);
 
 /**
  * @generated from message pfinance.v1.DetectAnomaliesResponse
@@ -5001,7 +7185,7 @@ export type DetectAnomaliesResponse = Message<"pfinance.v1.DetectAnomaliesRespon
  * Use `create(DetectAnomaliesResponseSchema)` to create a new message.
  */
 export const DetectAnomaliesResponseSchema: GenMessage<DetectAnomaliesResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 166);
+  messageDesc(file_pfinance_v1_finance_service, 230);
 
 /**
  * @generated from message pfinance.v1.GetCashFlowForecastRequest
@@ -5025,6 +7209,27 @@ export type GetCashFlowForecastRequest = Message<"pfinance.v1.GetCashFlowForecas
    * @generated from field: int32 forecast_days = 3;
    */
   forecastDays: number;
+
+  /**
+   * Bound width: 0.80, 0.90, 0.95 or 0.99 (default 0.90, others snap to nearest)
+   *
+   * @generated from field: double confidence_level = 4;
+   */
+  confidenceLevel: number;
+
+  /**
+   * Current account balance the running balance starts from
+   *
+   * @generated from field: int64 starting_balance_cents = 5;
+   */
+  startingBalanceCents: bigint;
+
+  /**
+   * Lookback for the historical averages and history series; default 90, max 730
+   *
+   * @generated from field: int32 history_days = 6;
+   */
+  historyDays: number;
 };
 
 /**
@@ -5032,7 +7237,7 @@ export type GetCashFlowForecastRequest = Message<"pfinance.v1.GetCashFlowForecas
  * Use `create(GetCashFlowForecastRequestSchema)` to create a new message.
  */
 export const GetCashFlowForecastRequestSchema: GenMessage<GetCashFlowForecastRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 167);
+  messageDesc(file_pfinance_v1_finance_service, 231);
 
 /**
  * @generated from message pfinance.v1.GetCashFlowForecastResponse
@@ -5062,6 +7267,53 @@ export type GetCashFlowForecastResponse = Message<"pfinance.v1.GetCashFlowForeca
    * @generated from field: repeated pfinance.v1.TimeSeriesDataPoint expense_history = 5;
    */
   expenseHistory: TimeSeriesDataPoint[];
+
+  /**
+   * Confidence level applied to the bounds
+   *
+   * @generated from field: double confidence_level = 6;
+   */
+  confidenceLevel: number;
+
+  /**
+   * Starting balance plus cumulative predicted net, per day
+   *
+   * @generated from field: repeated pfinance.v1.TimeSeriesDataPoint balance_forecast = 7;
+   */
+  balanceForecast: TimeSeriesDataPoint[];
+
+  /**
+   * Lowest projected balance in the forecast window
+   *
+   * @generated from field: double min_balance = 8;
+   */
+  minBalance: number;
+
+  /**
+   * @generated from field: int64 min_balance_cents = 9;
+   */
+  minBalanceCents: bigint;
+
+  /**
+   * YYYY-MM-DD of the first day at the minimum
+   *
+   * @generated from field: string min_balance_date = 10;
+   */
+  minBalanceDate: string;
+
+  /**
+   * True if the projected balance drops below zero
+   *
+   * @generated from field: bool goes_negative = 11;
+   */
+  goesNegative: boolean;
+
+  /**
+   * Lookback applied after defaulting and clamping
+   *
+   * @generated from field: int32 history_days = 12;
+   */
+  historyDays: number;
 };
 
 /**
@@ -5069,7 +7321,7 @@ export type GetCashFlowForecastResponse = Message<"pfinance.v1.GetCashFlowForeca
  * Use `create(GetCashFlowForecastResponseSchema)` to create a new message.
  */
 export const GetCashFlowForecastResponseSchema: GenMessage<GetCashFlowForecastResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 168);
+  messageDesc(file_pfinance_v1_finance_service, 232);
 
 /**
  * @generated from message pfinance.v1.GetWaterfallDataRequest
@@ -5093,6 +7345,27 @@ export type GetWaterfallDataRequest = Message<"pfinance.v1.GetWaterfallDataReque
    * @generated from field: string period = 3;
    */
   period: string;
+
+  /**
+   * "category" (default) or "member" (group only)
+   *
+   * @generated from field: string group_by = 4;
+   */
+  groupBy: string;
+
+  /**
+   * Extrapolate spending to period end at the current run-rate
+   *
+   * @generated from field: bool project_to_end_of_period = 5;
+   */
+  projectToEndOfPeriod: boolean;
+
+  /**
+   * Also compute the previous period's savings rate
+   *
+   * @generated from field: bool include_previous_period = 6;
+   */
+  includePreviousPeriod: boolean;
 };
 
 /**
@@ -5100,7 +7373,7 @@ export type GetWaterfallDataRequest = Message<"pfinance.v1.GetWaterfallDataReque
  * Use `create(GetWaterfallDataRequestSchema)` to create a new message.
  */
 export const GetWaterfallDataRequestSchema: GenMessage<GetWaterfallDataRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 169);
+  messageDesc(file_pfinance_v1_finance_service, 233);
 
 /**
  * @generated from message pfinance.v1.GetWaterfallDataResponse
@@ -5115,6 +7388,20 @@ export type GetWaterfallDataResponse = Message<"pfinance.v1.GetWaterfallDataResp
    * @generated from field: string period_label = 2;
    */
   periodLabel: string;
+
+  /**
+   * Net savings / gross income; 0 when there is no income
+   *
+   * @generated from field: double savings_rate = 3;
+   */
+  savingsRate: number;
+
+  /**
+   * Set when include_previous_period is requested
+   *
+   * @generated from field: optional double previous_savings_rate = 4;
+   */
+  previousSavingsRate?: number;
 };
 
 /**
@@ -5122,136 +7409,323 @@ export type GetWaterfallDataResponse = Message<"pfinance.v1.GetWaterfallDataResp
  * Use `create(GetWaterfallDataResponseSchema)` to create a new message.
  */
 export const GetWaterfallDataResponseSchema: GenMessage<GetWaterfallDataResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 170);
+  messageDesc(file_pfinance_v1_finance_service, 234);
 
 /**
- * @generated from message pfinance.v1.SubmitCorrectionsRequest
+ * @generated from message pfinance.v1.RecommendBudgetsRequest
  */
-export type SubmitCorrectionsRequest = Message<"pfinance.v1.SubmitCorrectionsRequest"> & {
+export type RecommendBudgetsRequest = Message<"pfinance.v1.RecommendBudgetsRequest"> & {
   /**
    * @generated from field: string user_id = 1;
    */
   userId: string;
 
   /**
-   * @generated from field: repeated pfinance.v1.CorrectionRecord corrections = 2;
+   * Optional
+   *
+   * @generated from field: string group_id = 2;
    */
-  corrections: CorrectionRecord[];
+  groupId: string;
+
+  /**
+   * Complete months to analyze, default 6, max 24
+   *
+   * @generated from field: int32 lookback_months = 3;
+   */
+  lookbackMonths: number;
 };
 
 /**
- * Describes the message pfinance.v1.SubmitCorrectionsRequest.
- * Use `create(SubmitCorrectionsRequestSchema)` to create a new message.
+ * Describes the message pfinance.v1.RecommendBudgetsRequest.
+ * Use `create(RecommendBudgetsRequestSchema)` to create a new message.
  */
-export const SubmitCorrectionsRequestSchema: GenMessage<SubmitCorrectionsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 171);
+export const RecommendBudgetsRequestSchema: GenMessage<RecommendBudgetsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 235);
 
 /**
- * @generated from message pfinance.v1.SubmitCorrectionsResponse
+ * @generated from message pfinance.v1.RecommendBudgetsResponse
  */
-export type SubmitCorrectionsResponse = Message<"pfinance.v1.SubmitCorrectionsResponse"> & {
+export type RecommendBudgetsResponse = Message<"pfinance.v1.RecommendBudgetsResponse"> & {
   /**
-   * @generated from field: int32 processed_count = 1;
+   * @generated from field: repeated pfinance.v1.BudgetRecommendation recommendations = 1;
    */
-  processedCount: number;
+  recommendations: BudgetRecommendation[];
 
   /**
-   * @generated from field: int32 merchant_mappings_updated = 2;
+   * @generated from field: int32 lookback_months = 2;
    */
-  merchantMappingsUpdated: number;
+  lookbackMonths: number;
 };
 
 /**
- * Describes the message pfinance.v1.SubmitCorrectionsResponse.
- * Use `create(SubmitCorrectionsResponseSchema)` to create a new message.
+ * Describes the message pfinance.v1.RecommendBudgetsResponse.
+ * Use `create(RecommendBudgetsResponseSchema)` to create a new message.
  */
-export const SubmitCorrectionsResponseSchema: GenMessage<SubmitCorrectionsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 172);
+export const RecommendBudgetsResponseSchema: GenMessage<RecommendBudgetsResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 236);
 
 /**
- * @generated from message pfinance.v1.CheckDuplicatesRequest
+ * @generated from message pfinance.v1.GetSpendingByTagRequest
  */
-export type CheckDuplicatesRequest = Message<"pfinance.v1.CheckDuplicatesRequest"> & {
+export type GetSpendingByTagRequest = Message<"pfinance.v1.GetSpendingByTagRequest"> & {
   /**
    * @generated from field: string user_id = 1;
    */
   userId: string;
 
   /**
+   * Optional
+   *
    * @generated from field: string group_id = 2;
    */
   groupId: string;
 
   /**
-   * @generated from field: repeated pfinance.v1.ExtractedTransaction transactions = 3;
+   * @generated from field: google.protobuf.Timestamp start_date = 3;
    */
-  transactions: ExtractedTransaction[];
-};
+  startDate?: Timestamp;
 
-/**
- * Describes the message pfinance.v1.CheckDuplicatesRequest.
- * Use `create(CheckDuplicatesRequestSchema)` to create a new message.
- */
-export const CheckDuplicatesRequestSchema: GenMessage<CheckDuplicatesRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 173);
+  /**
+   * @generated from field: google.protobuf.Timestamp end_date = 4;
+   */
+  endDate?: Timestamp;
 
-/**
- * @generated from message pfinance.v1.CheckDuplicatesResponse
- */
-export type CheckDuplicatesResponse = Message<"pfinance.v1.CheckDuplicatesResponse"> & {
   /**
-   * tx_id -> candidates
+   * Optional - only report these tags
    *
-   * @generated from field: map<string, pfinance.v1.DuplicateCandidateList> duplicates = 1;
+   * @generated from field: repeated string tags = 5;
    */
-  duplicates: { [key: string]: DuplicateCandidateList };
+  tags: string[];
 };
 
 /**
- * Describes the message pfinance.v1.CheckDuplicatesResponse.
- * Use `create(CheckDuplicatesResponseSchema)` to create a new message.
+ * Describes the message pfinance.v1.GetSpendingByTagRequest.
+ * Use `create(GetSpendingByTagRequestSchema)` to create a new message.
  */
-export const CheckDuplicatesResponseSchema: GenMessage<CheckDuplicatesResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 174);
+export const GetSpendingByTagRequestSchema: GenMessage<GetSpendingByTagRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 237);
 
 /**
- * @generated from message pfinance.v1.DuplicateCandidateList
+ * @generated from message pfinance.v1.GetSpendingByTagResponse
  */
-export type DuplicateCandidateList = Message<"pfinance.v1.DuplicateCandidateList"> & {
+export type GetSpendingByTagResponse = Message<"pfinance.v1.GetSpendingByTagResponse"> & {
   /**
-   * @generated from field: repeated pfinance.v1.DuplicateCandidate candidates = 1;
+   * Sorted by amount, highest first
+   *
+   * @generated from field: repeated pfinance.v1.TagSpending tags = 1;
    */
-  candidates: DuplicateCandidate[];
+  tags: TagSpending[];
+
+  /**
+   * @generated from field: double untagged_amount = 2;
+   */
+  untaggedAmount: number;
+
+  /**
+   * @generated from field: int64 untagged_amount_cents = 3;
+   */
+  untaggedAmountCents: bigint;
+
+  /**
+   * @generated from field: int32 untagged_count = 4;
+   */
+  untaggedCount: number;
 };
 
 /**
- * Describes the message pfinance.v1.DuplicateCandidateList.
- * Use `create(DuplicateCandidateListSchema)` to create a new message.
+ * Describes the message pfinance.v1.GetSpendingByTagResponse.
+ * Use `create(GetSpendingByTagResponseSchema)` to create a new message.
  */
-export const DuplicateCandidateListSchema: GenMessage<DuplicateCandidateList> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 175);
+export const GetSpendingByTagResponseSchema: GenMessage<GetSpendingByTagResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 238);
 
 /**
- * @generated from message pfinance.v1.GetMerchantSuggestionsRequest
+ * @generated from message pfinance.v1.SubmitCorrectionsRequest
  */
-export type GetMerchantSuggestionsRequest = Message<"pfinance.v1.GetMerchantSuggestionsRequest"> & {
+export type SubmitCorrectionsRequest = Message<"pfinance.v1.SubmitCorrectionsRequest"> & {
   /**
    * @generated from field: string user_id = 1;
    */
   userId: string;
 
   /**
-   * @generated from field: string merchant_text = 2;
+   * @generated from field: repeated pfinance.v1.CorrectionRecord corrections = 2;
    */
-  merchantText: string;
+  corrections: CorrectionRecord[];
 };
 
 /**
- * Describes the message pfinance.v1.GetMerchantSuggestionsRequest.
- * Use `create(GetMerchantSuggestionsRequestSchema)` to create a new message.
+ * Describes the message pfinance.v1.SubmitCorrectionsRequest.
+ * Use `create(SubmitCorrectionsRequestSchema)` to create a new message.
  */
-export const GetMerchantSuggestionsRequestSchema: GenMessage<GetMerchantSuggestionsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 176);
+export const SubmitCorrectionsRequestSchema: GenMessage<SubmitCorrectionsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 239);
+
+/**
+ * @generated from message pfinance.v1.SubmitCorrectionsResponse
+ */
+export type SubmitCorrectionsResponse = Message<"pfinance.v1.SubmitCorrectionsResponse"> & {
+  /**
+   * @generated from field: int32 processed_count = 1;
+   */
+  processedCount: number;
+
+  /**
+   * @generated from field: int32 merchant_mappings_updated = 2;
+   */
+  merchantMappingsUpdated: number;
+};
+
+/**
+ * Describes the message pfinance.v1.SubmitCorrectionsResponse.
+ * Use `create(SubmitCorrectionsResponseSchema)` to create a new message.
+ */
+export const SubmitCorrectionsResponseSchema: GenMessage<SubmitCorrectionsResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 240);
+
+/**
+ * @generated from message pfinance.v1.CheckDuplicatesRequest
+ */
+export type CheckDuplicatesRequest = Message<"pfinance.v1.CheckDuplicatesRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+
+  /**
+   * @generated from field: repeated pfinance.v1.ExtractedTransaction transactions = 3;
+   */
+  transactions: ExtractedTransaction[];
+};
+
+/**
+ * Describes the message pfinance.v1.CheckDuplicatesRequest.
+ * Use `create(CheckDuplicatesRequestSchema)` to create a new message.
+ */
+export const CheckDuplicatesRequestSchema: GenMessage<CheckDuplicatesRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 241);
+
+/**
+ * @generated from message pfinance.v1.CheckDuplicatesResponse
+ */
+export type CheckDuplicatesResponse = Message<"pfinance.v1.CheckDuplicatesResponse"> & {
+  /**
+   * tx_id -> candidates
+   *
+   * @generated from field: map<string, pfinance.v1.DuplicateCandidateList> duplicates = 1;
+   */
+  duplicates: { [key: string]: DuplicateCandidateList };
+};
+
+/**
+ * Describes the message pfinance.v1.CheckDuplicatesResponse.
+ * Use `create(CheckDuplicatesResponseSchema)` to create a new message.
+ */
+export const CheckDuplicatesResponseSchema: GenMessage<CheckDuplicatesResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 242);
+
+/**
+ * @generated from message pfinance.v1.DuplicateCandidateList
+ */
+export type DuplicateCandidateList = Message<"pfinance.v1.DuplicateCandidateList"> & {
+  /**
+   * @generated from field: repeated pfinance.v1.DuplicateCandidate candidates = 1;
+   */
+  candidates: DuplicateCandidate[];
+};
+
+/**
+ * Describes the message pfinance.v1.DuplicateCandidateList.
+ * Use `create(DuplicateCandidateListSchema)` to create a new message.
+ */
+export const DuplicateCandidateListSchema: GenMessage<DuplicateCandidateList> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 243);
+
+/**
+ * @generated from message pfinance.v1.MergeExpensesRequest
+ */
+export type MergeExpensesRequest = Message<"pfinance.v1.MergeExpensesRequest"> & {
+  /**
+   * Kept; its fields win where set
+   *
+   * @generated from field: string primary_expense_id = 1;
+   */
+  primaryExpenseId: string;
+
+  /**
+   * Folded into the primary, then deleted
+   *
+   * @generated from field: string secondary_expense_id = 2;
+   */
+  secondaryExpenseId: string;
+
+  /**
+   * Merge even when the amounts differ
+   *
+   * @generated from field: bool keep_primary_amount = 3;
+   */
+  keepPrimaryAmount: boolean;
+};
+
+/**
+ * Describes the message pfinance.v1.MergeExpensesRequest.
+ * Use `create(MergeExpensesRequestSchema)` to create a new message.
+ */
+export const MergeExpensesRequestSchema: GenMessage<MergeExpensesRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 244);
+
+/**
+ * @generated from message pfinance.v1.MergeExpensesResponse
+ */
+export type MergeExpensesResponse = Message<"pfinance.v1.MergeExpensesResponse"> & {
+  /**
+   * The merged primary expense
+   *
+   * @generated from field: pfinance.v1.Expense expense = 1;
+   */
+  expense?: Expense;
+
+  /**
+   * What the merge changed relative to the secondary
+   *
+   * @generated from field: pfinance.v1.CorrectionRecord correction = 2;
+   */
+  correction?: CorrectionRecord;
+};
+
+/**
+ * Describes the message pfinance.v1.MergeExpensesResponse.
+ * Use `create(MergeExpensesResponseSchema)` to create a new message.
+ */
+export const MergeExpensesResponseSchema: GenMessage<MergeExpensesResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 245);
+
+/**
+ * @generated from message pfinance.v1.GetMerchantSuggestionsRequest
+ */
+export type GetMerchantSuggestionsRequest = Message<"pfinance.v1.GetMerchantSuggestionsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string merchant_text = 2;
+   */
+  merchantText: string;
+};
+
+/**
+ * Describes the message pfinance.v1.GetMerchantSuggestionsRequest.
+ * Use `create(GetMerchantSuggestionsRequestSchema)` to create a new message.
+ */
+export const GetMerchantSuggestionsRequestSchema: GenMessage<GetMerchantSuggestionsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 246);
 
 /**
  * @generated from message pfinance.v1.GetMerchantSuggestionsResponse
@@ -5285,7 +7759,187 @@ export type GetMerchantSuggestionsResponse = Message<"pfinance.v1.GetMerchantSug
  * Use `create(GetMerchantSuggestionsResponseSchema)` to create a new message.
  */
 export const GetMerchantSuggestionsResponseSchema: GenMessage<GetMerchantSuggestionsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 177);
+  messageDesc(file_pfinance_v1_finance_service, 247);
+
+/**
+ * @generated from message pfinance.v1.ListMerchantMappingsRequest
+ */
+export type ListMerchantMappingsRequest = Message<"pfinance.v1.ListMerchantMappingsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Optional - substring of the raw pattern
+   *
+   * @generated from field: string query = 2;
+   */
+  query: string;
+
+  /**
+   * @generated from field: pfinance.v1.MerchantMappingSort sort_by = 3;
+   */
+  sortBy: MerchantMappingSort;
+
+  /**
+   * @generated from field: int32 page_size = 4;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 5;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message pfinance.v1.ListMerchantMappingsRequest.
+ * Use `create(ListMerchantMappingsRequestSchema)` to create a new message.
+ */
+export const ListMerchantMappingsRequestSchema: GenMessage<ListMerchantMappingsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 248);
+
+/**
+ * @generated from message pfinance.v1.ListMerchantMappingsResponse
+ */
+export type ListMerchantMappingsResponse = Message<"pfinance.v1.ListMerchantMappingsResponse"> & {
+  /**
+   * @generated from field: repeated pfinance.v1.MerchantMapping mappings = 1;
+   */
+  mappings: MerchantMapping[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message pfinance.v1.ListMerchantMappingsResponse.
+ * Use `create(ListMerchantMappingsResponseSchema)` to create a new message.
+ */
+export const ListMerchantMappingsResponseSchema: GenMessage<ListMerchantMappingsResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 249);
+
+/**
+ * @generated from message pfinance.v1.DeleteMerchantMappingRequest
+ */
+export type DeleteMerchantMappingRequest = Message<"pfinance.v1.DeleteMerchantMappingRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string raw_pattern = 2;
+   */
+  rawPattern: string;
+};
+
+/**
+ * Describes the message pfinance.v1.DeleteMerchantMappingRequest.
+ * Use `create(DeleteMerchantMappingRequestSchema)` to create a new message.
+ */
+export const DeleteMerchantMappingRequestSchema: GenMessage<DeleteMerchantMappingRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 250);
+
+/**
+ * @generated from message pfinance.v1.DeleteMerchantMappingResponse
+ */
+export type DeleteMerchantMappingResponse = Message<"pfinance.v1.DeleteMerchantMappingResponse"> & {
+};
+
+/**
+ * Describes the message pfinance.v1.DeleteMerchantMappingResponse.
+ * Use `create(DeleteMerchantMappingResponseSchema)` to create a new message.
+ */
+export const DeleteMerchantMappingResponseSchema: GenMessage<DeleteMerchantMappingResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 251);
+
+/**
+ * @generated from message pfinance.v1.BatchUpsertMerchantMappingsRequest
+ */
+export type BatchUpsertMerchantMappingsRequest = Message<"pfinance.v1.BatchUpsertMerchantMappingsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * raw_pattern, normalized_name and category are used; at most 500
+   *
+   * @generated from field: repeated pfinance.v1.MerchantMapping mappings = 2;
+   */
+  mappings: MerchantMapping[];
+};
+
+/**
+ * Describes the message pfinance.v1.BatchUpsertMerchantMappingsRequest.
+ * Use `create(BatchUpsertMerchantMappingsRequestSchema)` to create a new message.
+ */
+export const BatchUpsertMerchantMappingsRequestSchema: GenMessage<BatchUpsertMerchantMappingsRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 252);
+
+/**
+ * RejectedMerchantMapping is a BatchUpsertMerchantMappings entry that was not saved
+ *
+ * @generated from message pfinance.v1.RejectedMerchantMapping
+ */
+export type RejectedMerchantMapping = Message<"pfinance.v1.RejectedMerchantMapping"> & {
+  /**
+   * Position in the request's mappings
+   *
+   * @generated from field: int32 index = 1;
+   */
+  index: number;
+
+  /**
+   * @generated from field: string raw_pattern = 2;
+   */
+  rawPattern: string;
+
+  /**
+   * @generated from field: string reason = 3;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message pfinance.v1.RejectedMerchantMapping.
+ * Use `create(RejectedMerchantMappingSchema)` to create a new message.
+ */
+export const RejectedMerchantMappingSchema: GenMessage<RejectedMerchantMapping> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 253);
+
+/**
+ * @generated from message pfinance.v1.BatchUpsertMerchantMappingsResponse
+ */
+export type BatchUpsertMerchantMappingsResponse = Message<"pfinance.v1.BatchUpsertMerchantMappingsResponse"> & {
+  /**
+   * @generated from field: int32 created_count = 1;
+   */
+  createdCount: number;
+
+  /**
+   * Entries merged into an existing mapping with the same pattern
+   *
+   * @generated from field: int32 updated_count = 2;
+   */
+  updatedCount: number;
+
+  /**
+   * @generated from field: repeated pfinance.v1.RejectedMerchantMapping rejected = 3;
+   */
+  rejected: RejectedMerchantMapping[];
+};
+
+/**
+ * Describes the message pfinance.v1.BatchUpsertMerchantMappingsResponse.
+ * Use `create(BatchUpsertMerchantMappingsResponseSchema)` to create a new message.
+ */
+export const BatchUpsertMerchantMappingsResponseSchema: GenMessage<BatchUpsertMerchantMappingsResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 254);
 
 /**
  * @generated from message pfinance.v1.GetExtractionMetricsRequest
@@ -5309,7 +7963,7 @@ export type GetExtractionMetricsRequest = Message<"pfinance.v1.GetExtractionMetr
  * Use `create(GetExtractionMetricsRequestSchema)` to create a new message.
  */
 export const GetExtractionMetricsRequestSchema: GenMessage<GetExtractionMetricsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 178);
+  messageDesc(file_pfinance_v1_finance_service, 255);
 
 /**
  * @generated from message pfinance.v1.GetExtractionMetricsResponse
@@ -5365,7 +8019,7 @@ export type GetExtractionMetricsResponse = Message<"pfinance.v1.GetExtractionMet
  * Use `create(GetExtractionMetricsResponseSchema)` to create a new message.
  */
 export const GetExtractionMetricsResponseSchema: GenMessage<GetExtractionMetricsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 179);
+  messageDesc(file_pfinance_v1_finance_service, 256);
 
 /**
  * @generated from message pfinance.v1.GetCategoryOverridesRequest
@@ -5382,7 +8036,7 @@ export type GetCategoryOverridesRequest = Message<"pfinance.v1.GetCategoryOverri
  * Use `create(GetCategoryOverridesRequestSchema)` to create a new message.
  */
 export const GetCategoryOverridesRequestSchema: GenMessage<GetCategoryOverridesRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 180);
+  messageDesc(file_pfinance_v1_finance_service, 257);
 
 /**
  * @generated from message pfinance.v1.GetCategoryOverridesResponse
@@ -5399,7 +8053,7 @@ export type GetCategoryOverridesResponse = Message<"pfinance.v1.GetCategoryOverr
  * Use `create(GetCategoryOverridesResponseSchema)` to create a new message.
  */
 export const GetCategoryOverridesResponseSchema: GenMessage<GetCategoryOverridesResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 181);
+  messageDesc(file_pfinance_v1_finance_service, 258);
 
 /**
  * @generated from message pfinance.v1.SetCategoryOverrideRequest
@@ -5426,7 +8080,7 @@ export type SetCategoryOverrideRequest = Message<"pfinance.v1.SetCategoryOverrid
  * Use `create(SetCategoryOverrideRequestSchema)` to create a new message.
  */
 export const SetCategoryOverrideRequestSchema: GenMessage<SetCategoryOverrideRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 182);
+  messageDesc(file_pfinance_v1_finance_service, 259);
 
 /**
  * @generated from message pfinance.v1.SetCategoryOverrideResponse
@@ -5443,7 +8097,7 @@ export type SetCategoryOverrideResponse = Message<"pfinance.v1.SetCategoryOverri
  * Use `create(SetCategoryOverrideResponseSchema)` to create a new message.
  */
 export const SetCategoryOverrideResponseSchema: GenMessage<SetCategoryOverrideResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 183);
+  messageDesc(file_pfinance_v1_finance_service, 260);
 
 /**
  * @generated from message pfinance.v1.DeleteCategoryOverrideRequest
@@ -5465,7 +8119,7 @@ export type DeleteCategoryOverrideRequest = Message<"pfinance.v1.DeleteCategoryO
  * Use `create(DeleteCategoryOverrideRequestSchema)` to create a new message.
  */
 export const DeleteCategoryOverrideRequestSchema: GenMessage<DeleteCategoryOverrideRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 184);
+  messageDesc(file_pfinance_v1_finance_service, 261);
 
 /**
  * @generated from message pfinance.v1.DeleteCategoryOverrideResponse
@@ -5478,7 +8132,7 @@ export type DeleteCategoryOverrideResponse = Message<"pfinance.v1.DeleteCategory
  * Use `create(DeleteCategoryOverrideResponseSchema)` to create a new message.
  */
 export const DeleteCategoryOverrideResponseSchema: GenMessage<DeleteCategoryOverrideResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 185);
+  messageDesc(file_pfinance_v1_finance_service, 262);
 
 /**
  * @generated from message pfinance.v1.GetTaxSummaryRequest
@@ -5495,6 +8149,20 @@ export type GetTaxSummaryRequest = Message<"pfinance.v1.GetTaxSummaryRequest"> &
    * @generated from field: string financial_year = 2;
    */
   financialYear: string;
+
+  /**
+   * Carried-forward loss from prior years (reduces taxable income)
+   *
+   * @generated from field: int64 prior_year_loss_cents = 3;
+   */
+  priorYearLossCents: bigint;
+
+  /**
+   * Foreign resident: no tax-free threshold, LITO or Medicare levy
+   *
+   * @generated from field: bool is_non_resident = 4;
+   */
+  isNonResident: boolean;
 };
 
 /**
@@ -5502,7 +8170,7 @@ export type GetTaxSummaryRequest = Message<"pfinance.v1.GetTaxSummaryRequest"> &
  * Use `create(GetTaxSummaryRequestSchema)` to create a new message.
  */
 export const GetTaxSummaryRequestSchema: GenMessage<GetTaxSummaryRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 186);
+  messageDesc(file_pfinance_v1_finance_service, 263);
 
 /**
  * @generated from message pfinance.v1.GetTaxSummaryResponse
@@ -5519,7 +8187,7 @@ export type GetTaxSummaryResponse = Message<"pfinance.v1.GetTaxSummaryResponse">
  * Use `create(GetTaxSummaryResponseSchema)` to create a new message.
  */
 export const GetTaxSummaryResponseSchema: GenMessage<GetTaxSummaryResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 187);
+  messageDesc(file_pfinance_v1_finance_service, 264);
 
 /**
  * @generated from message pfinance.v1.GetTaxEstimateRequest
@@ -5572,6 +8240,34 @@ export type GetTaxEstimateRequest = Message<"pfinance.v1.GetTaxEstimateRequest">
    * @generated from field: bool medicare_exemption = 8;
    */
   medicareExemption: boolean;
+
+  /**
+   * Carried-forward loss from prior years (reduces taxable income)
+   *
+   * @generated from field: int64 prior_year_loss_cents = 9;
+   */
+  priorYearLossCents: bigint;
+
+  /**
+   * Foreign resident: no tax-free threshold, LITO or Medicare levy
+   *
+   * @generated from field: bool is_non_resident = 10;
+   */
+  isNonResident: boolean;
+
+  /**
+   * Capital gains for the FY, before any discount
+   *
+   * @generated from field: int64 capital_gains_cents = 11;
+   */
+  capitalGainsCents: bigint;
+
+  /**
+   * Assets held over 12 months: gains are halved (residents only)
+   *
+   * @generated from field: bool capital_gains_discount_eligible = 12;
+   */
+  capitalGainsDiscountEligible: boolean;
 };
 
 /**
@@ -5579,7 +8275,7 @@ export type GetTaxEstimateRequest = Message<"pfinance.v1.GetTaxEstimateRequest">
  * Use `create(GetTaxEstimateRequestSchema)` to create a new message.
  */
 export const GetTaxEstimateRequestSchema: GenMessage<GetTaxEstimateRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 188);
+  messageDesc(file_pfinance_v1_finance_service, 265);
 
 /**
  * @generated from message pfinance.v1.GetTaxEstimateResponse
@@ -5596,7 +8292,7 @@ export type GetTaxEstimateResponse = Message<"pfinance.v1.GetTaxEstimateResponse
  * Use `create(GetTaxEstimateResponseSchema)` to create a new message.
  */
 export const GetTaxEstimateResponseSchema: GenMessage<GetTaxEstimateResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 189);
+  messageDesc(file_pfinance_v1_finance_service, 266);
 
 /**
  * ExpenseTaxUpdate represents a single expense tax status update
@@ -5637,7 +8333,7 @@ export type ExpenseTaxUpdate = Message<"pfinance.v1.ExpenseTaxUpdate"> & {
  * Use `create(ExpenseTaxUpdateSchema)` to create a new message.
  */
 export const ExpenseTaxUpdateSchema: GenMessage<ExpenseTaxUpdate> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 190);
+  messageDesc(file_pfinance_v1_finance_service, 267);
 
 /**
  * @generated from message pfinance.v1.BatchUpdateExpenseTaxStatusRequest
@@ -5661,7 +8357,7 @@ export type BatchUpdateExpenseTaxStatusRequest = Message<"pfinance.v1.BatchUpdat
  * Use `create(BatchUpdateExpenseTaxStatusRequestSchema)` to create a new message.
  */
 export const BatchUpdateExpenseTaxStatusRequestSchema: GenMessage<BatchUpdateExpenseTaxStatusRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 191);
+  messageDesc(file_pfinance_v1_finance_service, 268);
 
 /**
  * @generated from message pfinance.v1.BatchUpdateExpenseTaxStatusResponse
@@ -5683,7 +8379,7 @@ export type BatchUpdateExpenseTaxStatusResponse = Message<"pfinance.v1.BatchUpda
  * Use `create(BatchUpdateExpenseTaxStatusResponseSchema)` to create a new message.
  */
 export const BatchUpdateExpenseTaxStatusResponseSchema: GenMessage<BatchUpdateExpenseTaxStatusResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 192);
+  messageDesc(file_pfinance_v1_finance_service, 269);
 
 /**
  * @generated from message pfinance.v1.ListDeductibleExpensesRequest
@@ -5731,7 +8427,7 @@ export type ListDeductibleExpensesRequest = Message<"pfinance.v1.ListDeductibleE
  * Use `create(ListDeductibleExpensesRequestSchema)` to create a new message.
  */
 export const ListDeductibleExpensesRequestSchema: GenMessage<ListDeductibleExpensesRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 193);
+  messageDesc(file_pfinance_v1_finance_service, 270);
 
 /**
  * @generated from message pfinance.v1.ListDeductibleExpensesResponse
@@ -5763,7 +8459,7 @@ export type ListDeductibleExpensesResponse = Message<"pfinance.v1.ListDeductible
  * Use `create(ListDeductibleExpensesResponseSchema)` to create a new message.
  */
 export const ListDeductibleExpensesResponseSchema: GenMessage<ListDeductibleExpensesResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 194);
+  messageDesc(file_pfinance_v1_finance_service, 271);
 
 /**
  * TaxFieldConfidences represents per-field confidence scores for tax classification
@@ -5798,7 +8494,7 @@ export type TaxFieldConfidences = Message<"pfinance.v1.TaxFieldConfidences"> & {
  * Use `create(TaxFieldConfidencesSchema)` to create a new message.
  */
 export const TaxFieldConfidencesSchema: GenMessage<TaxFieldConfidences> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 195);
+  messageDesc(file_pfinance_v1_finance_service, 272);
 
 /**
  * TaxClassificationResult represents AI classification for a single expense
@@ -5869,7 +8565,7 @@ export type TaxClassificationResult = Message<"pfinance.v1.TaxClassificationResu
  * Use `create(TaxClassificationResultSchema)` to create a new message.
  */
 export const TaxClassificationResultSchema: GenMessage<TaxClassificationResult> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 196);
+  messageDesc(file_pfinance_v1_finance_service, 273);
 
 /**
  * @generated from message pfinance.v1.ClassifyTaxDeductibilityRequest
@@ -5891,6 +8587,20 @@ export type ClassifyTaxDeductibilityRequest = Message<"pfinance.v1.ClassifyTaxDe
    * @generated from field: string occupation = 3;
    */
   occupation: string;
+
+  /**
+   * Confidence to auto-apply (default 0.85)
+   *
+   * @generated from field: optional double auto_apply_threshold = 4;
+   */
+  autoApplyThreshold?: number;
+
+  /**
+   * Confidence to flag for review (default 0.60); 0 flags everything below auto-apply
+   *
+   * @generated from field: optional double review_threshold = 5;
+   */
+  reviewThreshold?: number;
 };
 
 /**
@@ -5898,7 +8608,7 @@ export type ClassifyTaxDeductibilityRequest = Message<"pfinance.v1.ClassifyTaxDe
  * Use `create(ClassifyTaxDeductibilityRequestSchema)` to create a new message.
  */
 export const ClassifyTaxDeductibilityRequestSchema: GenMessage<ClassifyTaxDeductibilityRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 197);
+  messageDesc(file_pfinance_v1_finance_service, 274);
 
 /**
  * @generated from message pfinance.v1.ClassifyTaxDeductibilityResponse
@@ -5915,7 +8625,7 @@ export type ClassifyTaxDeductibilityResponse = Message<"pfinance.v1.ClassifyTaxD
  * Use `create(ClassifyTaxDeductibilityResponseSchema)` to create a new message.
  */
 export const ClassifyTaxDeductibilityResponseSchema: GenMessage<ClassifyTaxDeductibilityResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 198);
+  messageDesc(file_pfinance_v1_finance_service, 275);
 
 /**
  * @generated from message pfinance.v1.BatchClassifyTaxDeductibilityRequest
@@ -5946,6 +8656,20 @@ export type BatchClassifyTaxDeductibilityRequest = Message<"pfinance.v1.BatchCla
    * @generated from field: bool auto_apply = 4;
    */
   autoApply: boolean;
+
+  /**
+   * Confidence to auto-apply (default 0.85)
+   *
+   * @generated from field: optional double auto_apply_threshold = 5;
+   */
+  autoApplyThreshold?: number;
+
+  /**
+   * Confidence to flag for review (default 0.60); 0 flags everything below auto-apply
+   *
+   * @generated from field: optional double review_threshold = 6;
+   */
+  reviewThreshold?: number;
 };
 
 /**
@@ -5953,7 +8677,7 @@ export type BatchClassifyTaxDeductibilityRequest = Message<"pfinance.v1.BatchCla
  * Use `create(BatchClassifyTaxDeductibilityRequestSchema)` to create a new message.
  */
 export const BatchClassifyTaxDeductibilityRequestSchema: GenMessage<BatchClassifyTaxDeductibilityRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 199);
+  messageDesc(file_pfinance_v1_finance_service, 276);
 
 /**
  * @generated from message pfinance.v1.BatchClassifyTaxDeductibilityResponse
@@ -5996,7 +8720,7 @@ export type BatchClassifyTaxDeductibilityResponse = Message<"pfinance.v1.BatchCl
  * Use `create(BatchClassifyTaxDeductibilityResponseSchema)` to create a new message.
  */
 export const BatchClassifyTaxDeductibilityResponseSchema: GenMessage<BatchClassifyTaxDeductibilityResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 200);
+  messageDesc(file_pfinance_v1_finance_service, 277);
 
 /**
  * @generated from message pfinance.v1.ExportTaxReturnRequest
@@ -6018,6 +8742,20 @@ export type ExportTaxReturnRequest = Message<"pfinance.v1.ExportTaxReturnRequest
    * @generated from field: pfinance.v1.TaxExportFormat format = 3;
    */
   format: TaxExportFormat;
+
+  /**
+   * Foreign resident: no tax-free threshold, LITO or Medicare levy
+   *
+   * @generated from field: bool is_non_resident = 4;
+   */
+  isNonResident: boolean;
+
+  /**
+   * Optional - formatting of the exported data
+   *
+   * @generated from field: pfinance.v1.ExportOptions options = 5;
+   */
+  options?: ExportOptions;
 };
 
 /**
@@ -6025,7 +8763,29 @@ export type ExportTaxReturnRequest = Message<"pfinance.v1.ExportTaxReturnRequest
  * Use `create(ExportTaxReturnRequestSchema)` to create a new message.
  */
 export const ExportTaxReturnRequestSchema: GenMessage<ExportTaxReturnRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 201);
+  messageDesc(file_pfinance_v1_finance_service, 278);
+
+/**
+ * ExportOptions controls how ExportTaxReturn formats the exported data. The
+ * returned calculation is always precise.
+ *
+ * @generated from message pfinance.v1.ExportOptions
+ */
+export type ExportOptions = Message<"pfinance.v1.ExportOptions"> & {
+  /**
+   * Round CSV amounts to the nearest whole dollar, as the ATO does
+   *
+   * @generated from field: bool round_to_dollar = 1;
+   */
+  roundToDollar: boolean;
+};
+
+/**
+ * Describes the message pfinance.v1.ExportOptions.
+ * Use `create(ExportOptionsSchema)` to create a new message.
+ */
+export const ExportOptionsSchema: GenMessage<ExportOptions> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 279);
 
 /**
  * @generated from message pfinance.v1.ExportTaxReturnResponse
@@ -6059,7 +8819,88 @@ export type ExportTaxReturnResponse = Message<"pfinance.v1.ExportTaxReturnRespon
  * Use `create(ExportTaxReturnResponseSchema)` to create a new message.
  */
 export const ExportTaxReturnResponseSchema: GenMessage<ExportTaxReturnResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 202);
+  messageDesc(file_pfinance_v1_finance_service, 280);
+
+/**
+ * Streams the FY's transactions as CSV in batches. The first message carries
+ * filename and content_type; every message carries a chunk of CSV rows.
+ *
+ * @generated from message pfinance.v1.ExportTransactionsStreamRequest
+ */
+export type ExportTransactionsStreamRequest = Message<"pfinance.v1.ExportTransactionsStreamRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * e.g., "2025-26"
+   *
+   * @generated from field: string financial_year = 2;
+   */
+  financialYear: string;
+
+  /**
+   * Only export tax-deductible expenses
+   *
+   * @generated from field: bool deductible_only = 3;
+   */
+  deductibleOnly: boolean;
+
+  /**
+   * Rows per message (default 500, max 1000)
+   *
+   * @generated from field: int32 batch_size = 4;
+   */
+  batchSize: number;
+};
+
+/**
+ * Describes the message pfinance.v1.ExportTransactionsStreamRequest.
+ * Use `create(ExportTransactionsStreamRequestSchema)` to create a new message.
+ */
+export const ExportTransactionsStreamRequestSchema: GenMessage<ExportTransactionsStreamRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 281);
+
+/**
+ * @generated from message pfinance.v1.ExportTransactionsStreamResponse
+ */
+export type ExportTransactionsStreamResponse = Message<"pfinance.v1.ExportTransactionsStreamResponse"> & {
+  /**
+   * CSV chunk (header row included in the first message)
+   *
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * Set on the first message only
+   *
+   * @generated from field: string filename = 2;
+   */
+  filename: string;
+
+  /**
+   * Set on the first message only
+   *
+   * @generated from field: string content_type = 3;
+   */
+  contentType: string;
+
+  /**
+   * Data rows in this chunk
+   *
+   * @generated from field: int32 row_count = 4;
+   */
+  rowCount: number;
+};
+
+/**
+ * Describes the message pfinance.v1.ExportTransactionsStreamResponse.
+ * Use `create(ExportTransactionsStreamResponseSchema)` to create a new message.
+ */
+export const ExportTransactionsStreamResponseSchema: GenMessage<ExportTransactionsStreamResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 282);
 
 /**
  * @generated from message pfinance.v1.CreateApiTokenRequest
@@ -6071,6 +8912,13 @@ export type CreateApiTokenRequest = Message<"pfinance.v1.CreateApiTokenRequest">
    * @generated from field: string name = 1;
    */
   name: string;
+
+  /**
+   * Requests per minute; 0 uses the server default
+   *
+   * @generated from field: int32 rate_limit = 2;
+   */
+  rateLimit: number;
 };
 
 /**
@@ -6078,7 +8926,7 @@ export type CreateApiTokenRequest = Message<"pfinance.v1.CreateApiTokenRequest">
  * Use `create(CreateApiTokenRequestSchema)` to create a new message.
  */
 export const CreateApiTokenRequestSchema: GenMessage<CreateApiTokenRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 203);
+  messageDesc(file_pfinance_v1_finance_service, 283);
 
 /**
  * @generated from message pfinance.v1.CreateApiTokenResponse
@@ -6104,7 +8952,7 @@ export type CreateApiTokenResponse = Message<"pfinance.v1.CreateApiTokenResponse
  * Use `create(CreateApiTokenResponseSchema)` to create a new message.
  */
 export const CreateApiTokenResponseSchema: GenMessage<CreateApiTokenResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 204);
+  messageDesc(file_pfinance_v1_finance_service, 284);
 
 /**
  * @generated from message pfinance.v1.ListApiTokensRequest
@@ -6117,7 +8965,7 @@ export type ListApiTokensRequest = Message<"pfinance.v1.ListApiTokensRequest"> &
  * Use `create(ListApiTokensRequestSchema)` to create a new message.
  */
 export const ListApiTokensRequestSchema: GenMessage<ListApiTokensRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 205);
+  messageDesc(file_pfinance_v1_finance_service, 285);
 
 /**
  * @generated from message pfinance.v1.ListApiTokensResponse
@@ -6134,7 +8982,7 @@ export type ListApiTokensResponse = Message<"pfinance.v1.ListApiTokensResponse">
  * Use `create(ListApiTokensResponseSchema)` to create a new message.
  */
 export const ListApiTokensResponseSchema: GenMessage<ListApiTokensResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 206);
+  messageDesc(file_pfinance_v1_finance_service, 286);
 
 /**
  * @generated from message pfinance.v1.RevokeApiTokenRequest
@@ -6151,7 +8999,7 @@ export type RevokeApiTokenRequest = Message<"pfinance.v1.RevokeApiTokenRequest">
  * Use `create(RevokeApiTokenRequestSchema)` to create a new message.
  */
 export const RevokeApiTokenRequestSchema: GenMessage<RevokeApiTokenRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 207);
+  messageDesc(file_pfinance_v1_finance_service, 287);
 
 /**
  * @generated from message pfinance.v1.RevokeApiTokenResponse
@@ -6160,57 +9008,183 @@ export type RevokeApiTokenResponse = Message<"pfinance.v1.RevokeApiTokenResponse
 };
 
 /**
- * Describes the message pfinance.v1.RevokeApiTokenResponse.
- * Use `create(RevokeApiTokenResponseSchema)` to create a new message.
+ * Describes the message pfinance.v1.RevokeApiTokenResponse.
+ * Use `create(RevokeApiTokenResponseSchema)` to create a new message.
+ */
+export const RevokeApiTokenResponseSchema: GenMessage<RevokeApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 288);
+
+/**
+ * @generated from message pfinance.v1.BatchDeleteExpensesRequest
+ */
+export type BatchDeleteExpensesRequest = Message<"pfinance.v1.BatchDeleteExpensesRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Max 100
+   *
+   * @generated from field: repeated string expense_ids = 2;
+   */
+  expenseIds: string[];
+};
+
+/**
+ * Describes the message pfinance.v1.BatchDeleteExpensesRequest.
+ * Use `create(BatchDeleteExpensesRequestSchema)` to create a new message.
+ */
+export const BatchDeleteExpensesRequestSchema: GenMessage<BatchDeleteExpensesRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 289);
+
+/**
+ * @generated from message pfinance.v1.BatchDeleteExpensesResponse
+ */
+export type BatchDeleteExpensesResponse = Message<"pfinance.v1.BatchDeleteExpensesResponse"> & {
+  /**
+   * @generated from field: int32 deleted_count = 1;
+   */
+  deletedCount: number;
+
+  /**
+   * @generated from field: repeated string failed_expense_ids = 2;
+   */
+  failedExpenseIds: string[];
+};
+
+/**
+ * Describes the message pfinance.v1.BatchDeleteExpensesResponse.
+ * Use `create(BatchDeleteExpensesResponseSchema)` to create a new message.
+ */
+export const BatchDeleteExpensesResponseSchema: GenMessage<BatchDeleteExpensesResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 290);
+
+/**
+ * @generated from message pfinance.v1.BatchDeleteIncomesRequest
+ */
+export type BatchDeleteIncomesRequest = Message<"pfinance.v1.BatchDeleteIncomesRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Max 100
+   *
+   * @generated from field: repeated string income_ids = 2;
+   */
+  incomeIds: string[];
+};
+
+/**
+ * Describes the message pfinance.v1.BatchDeleteIncomesRequest.
+ * Use `create(BatchDeleteIncomesRequestSchema)` to create a new message.
+ */
+export const BatchDeleteIncomesRequestSchema: GenMessage<BatchDeleteIncomesRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 291);
+
+/**
+ * @generated from message pfinance.v1.BatchDeleteIncomesResponse
+ */
+export type BatchDeleteIncomesResponse = Message<"pfinance.v1.BatchDeleteIncomesResponse"> & {
+  /**
+   * @generated from field: int32 deleted_count = 1;
+   */
+  deletedCount: number;
+
+  /**
+   * @generated from field: repeated string failed_income_ids = 2;
+   */
+  failedIncomeIds: string[];
+};
+
+/**
+ * Describes the message pfinance.v1.BatchDeleteIncomesResponse.
+ * Use `create(BatchDeleteIncomesResponseSchema)` to create a new message.
+ */
+export const BatchDeleteIncomesResponseSchema: GenMessage<BatchDeleteIncomesResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 292);
+
+/**
+ * @generated from message pfinance.v1.AddExpenseAttachmentRequest
+ */
+export type AddExpenseAttachmentRequest = Message<"pfinance.v1.AddExpenseAttachmentRequest"> & {
+  /**
+   * @generated from field: string expense_id = 1;
+   */
+  expenseId: string;
+
+  /**
+   * uploaded_at defaults to now
+   *
+   * @generated from field: pfinance.v1.AttachmentRef attachment = 2;
+   */
+  attachment?: AttachmentRef;
+};
+
+/**
+ * Describes the message pfinance.v1.AddExpenseAttachmentRequest.
+ * Use `create(AddExpenseAttachmentRequestSchema)` to create a new message.
+ */
+export const AddExpenseAttachmentRequestSchema: GenMessage<AddExpenseAttachmentRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 293);
+
+/**
+ * @generated from message pfinance.v1.AddExpenseAttachmentResponse
+ */
+export type AddExpenseAttachmentResponse = Message<"pfinance.v1.AddExpenseAttachmentResponse"> & {
+  /**
+   * @generated from field: pfinance.v1.Expense expense = 1;
+   */
+  expense?: Expense;
+};
+
+/**
+ * Describes the message pfinance.v1.AddExpenseAttachmentResponse.
+ * Use `create(AddExpenseAttachmentResponseSchema)` to create a new message.
  */
-export const RevokeApiTokenResponseSchema: GenMessage<RevokeApiTokenResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 208);
+export const AddExpenseAttachmentResponseSchema: GenMessage<AddExpenseAttachmentResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 294);
 
 /**
- * @generated from message pfinance.v1.BatchDeleteExpensesRequest
+ * @generated from message pfinance.v1.RemoveExpenseAttachmentRequest
  */
-export type BatchDeleteExpensesRequest = Message<"pfinance.v1.BatchDeleteExpensesRequest"> & {
+export type RemoveExpenseAttachmentRequest = Message<"pfinance.v1.RemoveExpenseAttachmentRequest"> & {
   /**
-   * @generated from field: string user_id = 1;
+   * @generated from field: string expense_id = 1;
    */
-  userId: string;
+  expenseId: string;
 
   /**
-   * Max 100
-   *
-   * @generated from field: repeated string expense_ids = 2;
+   * @generated from field: string storage_path = 2;
    */
-  expenseIds: string[];
+  storagePath: string;
 };
 
 /**
- * Describes the message pfinance.v1.BatchDeleteExpensesRequest.
- * Use `create(BatchDeleteExpensesRequestSchema)` to create a new message.
+ * Describes the message pfinance.v1.RemoveExpenseAttachmentRequest.
+ * Use `create(RemoveExpenseAttachmentRequestSchema)` to create a new message.
  */
-export const BatchDeleteExpensesRequestSchema: GenMessage<BatchDeleteExpensesRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 209);
+export const RemoveExpenseAttachmentRequestSchema: GenMessage<RemoveExpenseAttachmentRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 295);
 
 /**
- * @generated from message pfinance.v1.BatchDeleteExpensesResponse
+ * @generated from message pfinance.v1.RemoveExpenseAttachmentResponse
  */
-export type BatchDeleteExpensesResponse = Message<"pfinance.v1.BatchDeleteExpensesResponse"> & {
-  /**
-   * @generated from field: int32 deleted_count = 1;
-   */
-  deletedCount: number;
-
+export type RemoveExpenseAttachmentResponse = Message<"pfinance.v1.RemoveExpenseAttachmentResponse"> & {
   /**
-   * @generated from field: repeated string failed_expense_ids = 2;
+   * @generated from field: pfinance.v1.Expense expense = 1;
    */
-  failedExpenseIds: string[];
+  expense?: Expense;
 };
 
 /**
- * Describes the message pfinance.v1.BatchDeleteExpensesResponse.
- * Use `create(BatchDeleteExpensesResponseSchema)` to create a new message.
+ * Describes the message pfinance.v1.RemoveExpenseAttachmentResponse.
+ * Use `create(RemoveExpenseAttachmentResponseSchema)` to create a new message.
  */
-export const BatchDeleteExpensesResponseSchema: GenMessage<BatchDeleteExpensesResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 210);
+export const RemoveExpenseAttachmentResponseSchema: GenMessage<RemoveExpenseAttachmentResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 296);
 
 /**
  * @generated from message pfinance.v1.ExportReceiptsRequest
@@ -6234,7 +9208,7 @@ export type ExportReceiptsRequest = Message<"pfinance.v1.ExportReceiptsRequest">
  * Use `create(ExportReceiptsRequestSchema)` to create a new message.
  */
 export const ExportReceiptsRequestSchema: GenMessage<ExportReceiptsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 211);
+  messageDesc(file_pfinance_v1_finance_service, 297);
 
 /**
  * @generated from message pfinance.v1.ExportReceiptsResponse
@@ -6268,7 +9242,7 @@ export type ExportReceiptsResponse = Message<"pfinance.v1.ExportReceiptsResponse
  * Use `create(ExportReceiptsResponseSchema)` to create a new message.
  */
 export const ExportReceiptsResponseSchema: GenMessage<ExportReceiptsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 212);
+  messageDesc(file_pfinance_v1_finance_service, 298);
 
 /**
  * @generated from message pfinance.v1.FindPotentialDeductionsRequest
@@ -6299,7 +9273,7 @@ export type FindPotentialDeductionsRequest = Message<"pfinance.v1.FindPotentialD
  * Use `create(FindPotentialDeductionsRequestSchema)` to create a new message.
  */
 export const FindPotentialDeductionsRequestSchema: GenMessage<FindPotentialDeductionsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 213);
+  messageDesc(file_pfinance_v1_finance_service, 299);
 
 /**
  * @generated from message pfinance.v1.FindPotentialDeductionsResponse
@@ -6333,7 +9307,71 @@ export type FindPotentialDeductionsResponse = Message<"pfinance.v1.FindPotential
  * Use `create(FindPotentialDeductionsResponseSchema)` to create a new message.
  */
 export const FindPotentialDeductionsResponseSchema: GenMessage<FindPotentialDeductionsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 214);
+  messageDesc(file_pfinance_v1_finance_service, 300);
+
+/**
+ * @generated from message pfinance.v1.GetTaxDeductionChecklistRequest
+ */
+export type GetTaxDeductionChecklistRequest = Message<"pfinance.v1.GetTaxDeductionChecklistRequest"> & {
+  /**
+   * e.g., "2025-26"; defaults to the current FY
+   *
+   * @generated from field: string financial_year = 1;
+   */
+  financialYear: string;
+
+  /**
+   * Free text, matched to an occupation group
+   *
+   * @generated from field: string occupation = 2;
+   */
+  occupation: string;
+};
+
+/**
+ * Describes the message pfinance.v1.GetTaxDeductionChecklistRequest.
+ * Use `create(GetTaxDeductionChecklistRequestSchema)` to create a new message.
+ */
+export const GetTaxDeductionChecklistRequestSchema: GenMessage<GetTaxDeductionChecklistRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 301);
+
+/**
+ * @generated from message pfinance.v1.GetTaxDeductionChecklistResponse
+ */
+export type GetTaxDeductionChecklistResponse = Message<"pfinance.v1.GetTaxDeductionChecklistResponse"> & {
+  /**
+   * Occupation-specific items first, then common ones
+   *
+   * @generated from field: repeated pfinance.v1.TaxDeductionChecklistItem items = 1;
+   */
+  items: TaxDeductionChecklistItem[];
+
+  /**
+   * Occupation group the checklist is for; empty if unrecognized
+   *
+   * @generated from field: string matched_occupation = 2;
+   */
+  matchedOccupation: string;
+
+  /**
+   * @generated from field: string financial_year = 3;
+   */
+  financialYear: string;
+
+  /**
+   * Items with no deductible expenses this FY
+   *
+   * @generated from field: int32 missing_count = 4;
+   */
+  missingCount: number;
+};
+
+/**
+ * Describes the message pfinance.v1.GetTaxDeductionChecklistResponse.
+ * Use `create(GetTaxDeductionChecklistResponseSchema)` to create a new message.
+ */
+export const GetTaxDeductionChecklistResponseSchema: GenMessage<GetTaxDeductionChecklistResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 302);
 
 /**
  * @generated from message pfinance.v1.CompareTaxYearsRequest
@@ -6357,6 +9395,13 @@ export type CompareTaxYearsRequest = Message<"pfinance.v1.CompareTaxYearsRequest
    * @generated from field: string year_b = 3;
    */
   yearB: string;
+
+  /**
+   * Foreign resident: no tax-free threshold, LITO or Medicare levy
+   *
+   * @generated from field: bool is_non_resident = 4;
+   */
+  isNonResident: boolean;
 };
 
 /**
@@ -6364,24 +9409,296 @@ export type CompareTaxYearsRequest = Message<"pfinance.v1.CompareTaxYearsRequest
  * Use `create(CompareTaxYearsRequestSchema)` to create a new message.
  */
 export const CompareTaxYearsRequestSchema: GenMessage<CompareTaxYearsRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 215);
+  messageDesc(file_pfinance_v1_finance_service, 303);
+
+/**
+ * @generated from message pfinance.v1.CompareTaxYearsResponse
+ */
+export type CompareTaxYearsResponse = Message<"pfinance.v1.CompareTaxYearsResponse"> & {
+  /**
+   * @generated from field: pfinance.v1.TaxYearComparison comparison = 1;
+   */
+  comparison?: TaxYearComparison;
+};
+
+/**
+ * Describes the message pfinance.v1.CompareTaxYearsResponse.
+ * Use `create(CompareTaxYearsResponseSchema)` to create a new message.
+ */
+export const CompareTaxYearsResponseSchema: GenMessage<CompareTaxYearsResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 304);
+
+/**
+ * @generated from message pfinance.v1.GetDeductionProgressRequest
+ */
+export type GetDeductionProgressRequest = Message<"pfinance.v1.GetDeductionProgressRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Optional - defaults to the current FY
+   *
+   * @generated from field: string financial_year = 2;
+   */
+  financialYear: string;
+};
+
+/**
+ * Describes the message pfinance.v1.GetDeductionProgressRequest.
+ * Use `create(GetDeductionProgressRequestSchema)` to create a new message.
+ */
+export const GetDeductionProgressRequestSchema: GenMessage<GetDeductionProgressRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 305);
+
+/**
+ * @generated from message pfinance.v1.GetDeductionProgressResponse
+ */
+export type GetDeductionProgressResponse = Message<"pfinance.v1.GetDeductionProgressResponse"> & {
+  /**
+   * @generated from field: string financial_year = 1;
+   */
+  financialYear: string;
+
+  /**
+   * @generated from field: string prior_financial_year = 2;
+   */
+  priorFinancialYear: string;
+
+  /**
+   * Deductions captured so far this FY
+   *
+   * @generated from field: int64 current_total_cents = 3;
+   */
+  currentTotalCents: bigint;
+
+  /**
+   * Deductions for the whole prior FY
+   *
+   * @generated from field: int64 prior_total_cents = 4;
+   */
+  priorTotalCents: bigint;
+
+  /**
+   * @generated from field: int64 change_cents = 5;
+   */
+  changeCents: bigint;
+
+  /**
+   * 0 when the prior FY had no deductions
+   *
+   * @generated from field: double change_percent = 6;
+   */
+  changePercent: number;
+
+  /**
+   * year_a is the prior FY, year_b this FY
+   *
+   * @generated from field: repeated pfinance.v1.CategoryDelta category_deltas = 7;
+   */
+  categoryDeltas: CategoryDelta[];
+
+  /**
+   * This FY's expenses still flagged for review by batch classification
+   *
+   * @generated from field: int32 needs_review_count = 8;
+   */
+  needsReviewCount: number;
+};
+
+/**
+ * Describes the message pfinance.v1.GetDeductionProgressResponse.
+ * Use `create(GetDeductionProgressResponseSchema)` to create a new message.
+ */
+export const GetDeductionProgressResponseSchema: GenMessage<GetDeductionProgressResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 306);
+
+/**
+ * @generated from message pfinance.v1.LockFinancialYearRequest
+ */
+export type LockFinancialYearRequest = Message<"pfinance.v1.LockFinancialYearRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * e.g., "2024-25"
+   *
+   * @generated from field: string financial_year = 2;
+   */
+  financialYear: string;
+
+  /**
+   * Optional - defaults to noting the FY's return was filed
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message pfinance.v1.LockFinancialYearRequest.
+ * Use `create(LockFinancialYearRequestSchema)` to create a new message.
+ */
+export const LockFinancialYearRequestSchema: GenMessage<LockFinancialYearRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 307);
+
+/**
+ * @generated from message pfinance.v1.LockFinancialYearResponse
+ */
+export type LockFinancialYearResponse = Message<"pfinance.v1.LockFinancialYearResponse"> & {
+  /**
+   * Expenses newly locked; already-locked ones are left as is
+   *
+   * @generated from field: int32 locked_count = 1;
+   */
+  lockedCount: number;
+};
+
+/**
+ * Describes the message pfinance.v1.LockFinancialYearResponse.
+ * Use `create(LockFinancialYearResponseSchema)` to create a new message.
+ */
+export const LockFinancialYearResponseSchema: GenMessage<LockFinancialYearResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 308);
+
+/**
+ * @generated from message pfinance.v1.UnlockFinancialYearRequest
+ */
+export type UnlockFinancialYearRequest = Message<"pfinance.v1.UnlockFinancialYearRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * e.g., "2024-25"
+   *
+   * @generated from field: string financial_year = 2;
+   */
+  financialYear: string;
+};
+
+/**
+ * Describes the message pfinance.v1.UnlockFinancialYearRequest.
+ * Use `create(UnlockFinancialYearRequestSchema)` to create a new message.
+ */
+export const UnlockFinancialYearRequestSchema: GenMessage<UnlockFinancialYearRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 309);
+
+/**
+ * @generated from message pfinance.v1.UnlockFinancialYearResponse
+ */
+export type UnlockFinancialYearResponse = Message<"pfinance.v1.UnlockFinancialYearResponse"> & {
+  /**
+   * @generated from field: int32 unlocked_count = 1;
+   */
+  unlockedCount: number;
+};
+
+/**
+ * Describes the message pfinance.v1.UnlockFinancialYearResponse.
+ * Use `create(UnlockFinancialYearResponseSchema)` to create a new message.
+ */
+export const UnlockFinancialYearResponseSchema: GenMessage<UnlockFinancialYearResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 310);
+
+/**
+ * @generated from message pfinance.v1.GetGstSummaryRequest
+ */
+export type GetGstSummaryRequest = Message<"pfinance.v1.GetGstSummaryRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Optional - group GST instead of personal
+   *
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+
+  /**
+   * Optional - any date in the BAS quarter; defaults to now
+   *
+   * @generated from field: google.protobuf.Timestamp as_of_date = 3;
+   */
+  asOfDate?: Timestamp;
+};
+
+/**
+ * Describes the message pfinance.v1.GetGstSummaryRequest.
+ * Use `create(GetGstSummaryRequestSchema)` to create a new message.
+ */
+export const GetGstSummaryRequestSchema: GenMessage<GetGstSummaryRequest> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 311);
+
+/**
+ * @generated from message pfinance.v1.GetGstSummaryResponse
+ */
+export type GetGstSummaryResponse = Message<"pfinance.v1.GetGstSummaryResponse"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp period_start = 1;
+   */
+  periodStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp period_end = 2;
+   */
+  periodEnd?: Timestamp;
+
+  /**
+   * e.g. "Q3 2025"
+   *
+   * @generated from field: string period_label = 3;
+   */
+  periodLabel: string;
+
+  /**
+   * GST on income
+   *
+   * @generated from field: int64 gst_collected_cents = 4;
+   */
+  gstCollectedCents: bigint;
+
+  /**
+   * GST on expenses
+   *
+   * @generated from field: int64 gst_paid_cents = 5;
+   */
+  gstPaidCents: bigint;
+
+  /**
+   * collected - paid; negative is a refund
+   *
+   * @generated from field: int64 net_gst_cents = 6;
+   */
+  netGstCents: bigint;
 
-/**
- * @generated from message pfinance.v1.CompareTaxYearsResponse
- */
-export type CompareTaxYearsResponse = Message<"pfinance.v1.CompareTaxYearsResponse"> & {
   /**
-   * @generated from field: pfinance.v1.TaxYearComparison comparison = 1;
+   * Incomes carrying GST
+   *
+   * @generated from field: int32 income_count = 7;
    */
-  comparison?: TaxYearComparison;
+  incomeCount: number;
+
+  /**
+   * Expenses carrying GST
+   *
+   * @generated from field: int32 expense_count = 8;
+   */
+  expenseCount: number;
 };
 
 /**
- * Describes the message pfinance.v1.CompareTaxYearsResponse.
- * Use `create(CompareTaxYearsResponseSchema)` to create a new message.
+ * Describes the message pfinance.v1.GetGstSummaryResponse.
+ * Use `create(GetGstSummaryResponseSchema)` to create a new message.
  */
-export const CompareTaxYearsResponseSchema: GenMessage<CompareTaxYearsResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 216);
+export const GetGstSummaryResponseSchema: GenMessage<GetGstSummaryResponse> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_finance_service, 312);
 
 /**
  * @generated from message pfinance.v1.RegisterPushTokenRequest
@@ -6398,7 +9715,7 @@ export type RegisterPushTokenRequest = Message<"pfinance.v1.RegisterPushTokenReq
  * Use `create(RegisterPushTokenRequestSchema)` to create a new message.
  */
 export const RegisterPushTokenRequestSchema: GenMessage<RegisterPushTokenRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 217);
+  messageDesc(file_pfinance_v1_finance_service, 313);
 
 /**
  * @generated from message pfinance.v1.RegisterPushTokenResponse
@@ -6411,7 +9728,7 @@ export type RegisterPushTokenResponse = Message<"pfinance.v1.RegisterPushTokenRe
  * Use `create(RegisterPushTokenResponseSchema)` to create a new message.
  */
 export const RegisterPushTokenResponseSchema: GenMessage<RegisterPushTokenResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 218);
+  messageDesc(file_pfinance_v1_finance_service, 314);
 
 /**
  * @generated from message pfinance.v1.UnregisterPushTokenRequest
@@ -6424,7 +9741,7 @@ export type UnregisterPushTokenRequest = Message<"pfinance.v1.UnregisterPushToke
  * Use `create(UnregisterPushTokenRequestSchema)` to create a new message.
  */
 export const UnregisterPushTokenRequestSchema: GenMessage<UnregisterPushTokenRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 219);
+  messageDesc(file_pfinance_v1_finance_service, 315);
 
 /**
  * @generated from message pfinance.v1.UnregisterPushTokenResponse
@@ -6437,7 +9754,7 @@ export type UnregisterPushTokenResponse = Message<"pfinance.v1.UnregisterPushTok
  * Use `create(UnregisterPushTokenResponseSchema)` to create a new message.
  */
 export const UnregisterPushTokenResponseSchema: GenMessage<UnregisterPushTokenResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 220);
+  messageDesc(file_pfinance_v1_finance_service, 316);
 
 /**
  * @generated from message pfinance.v1.RunTaxEvalRequest
@@ -6477,7 +9794,7 @@ export type RunTaxEvalRequest = Message<"pfinance.v1.RunTaxEvalRequest"> & {
  * Use `create(RunTaxEvalRequestSchema)` to create a new message.
  */
 export const RunTaxEvalRequestSchema: GenMessage<RunTaxEvalRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 221);
+  messageDesc(file_pfinance_v1_finance_service, 317);
 
 /**
  * @generated from message pfinance.v1.RunTaxEvalResponse
@@ -6496,7 +9813,7 @@ export type RunTaxEvalResponse = Message<"pfinance.v1.RunTaxEvalResponse"> & {
  * Use `create(RunTaxEvalResponseSchema)` to create a new message.
  */
 export const RunTaxEvalResponseSchema: GenMessage<RunTaxEvalResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 222);
+  messageDesc(file_pfinance_v1_finance_service, 318);
 
 /**
  * @generated from message pfinance.v1.GetTaxEvalJobRequest
@@ -6513,7 +9830,7 @@ export type GetTaxEvalJobRequest = Message<"pfinance.v1.GetTaxEvalJobRequest"> &
  * Use `create(GetTaxEvalJobRequestSchema)` to create a new message.
  */
 export const GetTaxEvalJobRequestSchema: GenMessage<GetTaxEvalJobRequest> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 223);
+  messageDesc(file_pfinance_v1_finance_service, 319);
 
 /**
  * @generated from message pfinance.v1.GetTaxEvalJobResponse
@@ -6530,7 +9847,7 @@ export type GetTaxEvalJobResponse = Message<"pfinance.v1.GetTaxEvalJobResponse">
  * Use `create(GetTaxEvalJobResponseSchema)` to create a new message.
  */
 export const GetTaxEvalJobResponseSchema: GenMessage<GetTaxEvalJobResponse> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 224);
+  messageDesc(file_pfinance_v1_finance_service, 320);
 
 /**
  * @generated from message pfinance.v1.TaxEvalJob
@@ -6591,7 +9908,7 @@ export type TaxEvalJob = Message<"pfinance.v1.TaxEvalJob"> & {
  * Use `create(TaxEvalJobSchema)` to create a new message.
  */
 export const TaxEvalJobSchema: GenMessage<TaxEvalJob> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 225);
+  messageDesc(file_pfinance_v1_finance_service, 321);
 
 /**
  * @generated from message pfinance.v1.TaxEvalResult
@@ -6715,7 +10032,7 @@ export type TaxEvalResult = Message<"pfinance.v1.TaxEvalResult"> & {
  * Use `create(TaxEvalResultSchema)` to create a new message.
  */
 export const TaxEvalResultSchema: GenMessage<TaxEvalResult> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 226);
+  messageDesc(file_pfinance_v1_finance_service, 322);
 
 /**
  * @generated from message pfinance.v1.TaxEvalDeductionCategory
@@ -6761,7 +10078,7 @@ export type TaxEvalDeductionCategory = Message<"pfinance.v1.TaxEvalDeductionCate
  * Use `create(TaxEvalDeductionCategorySchema)` to create a new message.
  */
 export const TaxEvalDeductionCategorySchema: GenMessage<TaxEvalDeductionCategory> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 227);
+  messageDesc(file_pfinance_v1_finance_service, 323);
 
 /**
  * @generated from message pfinance.v1.TaxEvalFileResult
@@ -6825,7 +10142,7 @@ export type TaxEvalFileResult = Message<"pfinance.v1.TaxEvalFileResult"> & {
  * Use `create(TaxEvalFileResultSchema)` to create a new message.
  */
 export const TaxEvalFileResultSchema: GenMessage<TaxEvalFileResult> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 228);
+  messageDesc(file_pfinance_v1_finance_service, 324);
 
 /**
  * @generated from message pfinance.v1.TaxEvalItem
@@ -6901,7 +10218,7 @@ export type TaxEvalItem = Message<"pfinance.v1.TaxEvalItem"> & {
  * Use `create(TaxEvalItemSchema)` to create a new message.
  */
 export const TaxEvalItemSchema: GenMessage<TaxEvalItem> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 229);
+  messageDesc(file_pfinance_v1_finance_service, 325);
 
 /**
  * Accuracy metrics when ground truth is available
@@ -6950,7 +10267,7 @@ export type TaxEvalAccuracy = Message<"pfinance.v1.TaxEvalAccuracy"> & {
  * Use `create(TaxEvalAccuracySchema)` to create a new message.
  */
 export const TaxEvalAccuracySchema: GenMessage<TaxEvalAccuracy> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 230);
+  messageDesc(file_pfinance_v1_finance_service, 326);
 
 /**
  * @generated from message pfinance.v1.TaxEvalExtractionAccuracy
@@ -6992,7 +10309,7 @@ export type TaxEvalExtractionAccuracy = Message<"pfinance.v1.TaxEvalExtractionAc
  * Use `create(TaxEvalExtractionAccuracySchema)` to create a new message.
  */
 export const TaxEvalExtractionAccuracySchema: GenMessage<TaxEvalExtractionAccuracy> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 231);
+  messageDesc(file_pfinance_v1_finance_service, 327);
 
 /**
  * @generated from message pfinance.v1.TaxEvalClassAccuracy
@@ -7024,7 +10341,7 @@ export type TaxEvalClassAccuracy = Message<"pfinance.v1.TaxEvalClassAccuracy"> &
  * Use `create(TaxEvalClassAccuracySchema)` to create a new message.
  */
 export const TaxEvalClassAccuracySchema: GenMessage<TaxEvalClassAccuracy> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 232);
+  messageDesc(file_pfinance_v1_finance_service, 328);
 
 /**
  * @generated from message pfinance.v1.TaxEvalAmountAccuracy
@@ -7065,7 +10382,7 @@ export type TaxEvalAmountAccuracy = Message<"pfinance.v1.TaxEvalAmountAccuracy">
  * Use `create(TaxEvalAmountAccuracySchema)` to create a new message.
  */
 export const TaxEvalAmountAccuracySchema: GenMessage<TaxEvalAmountAccuracy> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 233);
+  messageDesc(file_pfinance_v1_finance_service, 329);
 
 /**
  * @generated from message pfinance.v1.TaxEvalFileAccuracy
@@ -7112,7 +10429,45 @@ export type TaxEvalFileAccuracy = Message<"pfinance.v1.TaxEvalFileAccuracy"> & {
  * Use `create(TaxEvalFileAccuracySchema)` to create a new message.
  */
 export const TaxEvalFileAccuracySchema: GenMessage<TaxEvalFileAccuracy> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_finance_service, 234);
+  messageDesc(file_pfinance_v1_finance_service, 330);
+
+/**
+ * ImportDispositionType describes what an import would do with a transaction
+ *
+ * @generated from enum pfinance.v1.ImportDispositionType
+ */
+export enum ImportDispositionType {
+  /**
+   * @generated from enum value: IMPORT_DISPOSITION_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: IMPORT_DISPOSITION_TYPE_CREATE = 1;
+   */
+  CREATE = 1,
+
+  /**
+   * @generated from enum value: IMPORT_DISPOSITION_TYPE_SKIP_CREDIT = 2;
+   */
+  SKIP_CREDIT = 2,
+
+  /**
+   * @generated from enum value: IMPORT_DISPOSITION_TYPE_SKIP_LOW_CONFIDENCE = 3;
+   */
+  SKIP_LOW_CONFIDENCE = 3,
+
+  /**
+   * @generated from enum value: IMPORT_DISPOSITION_TYPE_SKIP_DUPLICATE = 4;
+   */
+  SKIP_DUPLICATE = 4,
+}
+
+/**
+ * Describes the enum pfinance.v1.ImportDispositionType.
+ */
+export const ImportDispositionTypeSchema: GenEnum<ImportDispositionType> = /*@__PURE__*/
+  enumDesc(file_pfinance_v1_finance_service, 0);
 
 /**
  * ExportFormat for tax return export
@@ -7140,7 +10495,7 @@ export enum TaxExportFormat {
  * Describes the enum pfinance.v1.TaxExportFormat.
  */
 export const TaxExportFormatSchema: GenEnum<TaxExportFormat> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_finance_service, 0);
+  enumDesc(file_pfinance_v1_finance_service, 1);
 
 /**
  * FinanceService handles all finance-related operations
@@ -7174,6 +10529,14 @@ export const FinanceService: GenService<{
     input: typeof DeleteUserRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.PrepareClearUserData
+   */
+  prepareClearUserData: {
+    methodKind: "unary";
+    input: typeof PrepareClearUserDataRequestSchema;
+    output: typeof PrepareClearUserDataResponseSchema;
+  },
   /**
    * @generated from rpc pfinance.v1.FinanceService.ClearUserData
    */
@@ -7190,6 +10553,30 @@ export const FinanceService: GenService<{
     input: typeof ExportUserDataRequestSchema;
     output: typeof ExportUserDataResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.GetUserDataSummary
+   */
+  getUserDataSummary: {
+    methodKind: "unary";
+    input: typeof GetUserDataSummaryRequestSchema;
+    output: typeof GetUserDataSummaryResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.ExportAllData
+   */
+  exportAllData: {
+    methodKind: "server_streaming";
+    input: typeof ExportAllDataRequestSchema;
+    output: typeof ExportAllDataResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.ListRecordsMissingCents
+   */
+  listRecordsMissingCents: {
+    methodKind: "unary";
+    input: typeof ListRecordsMissingCentsRequestSchema;
+    output: typeof ListRecordsMissingCentsResponseSchema;
+  },
   /**
    * Expense operations
    *
@@ -7232,6 +10619,22 @@ export const FinanceService: GenService<{
     input: typeof ListExpensesRequestSchema;
     output: typeof ListExpensesResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.GetTransactionCounts
+   */
+  getTransactionCounts: {
+    methodKind: "unary";
+    input: typeof GetTransactionCountsRequestSchema;
+    output: typeof GetTransactionCountsResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.GetTopExpenses
+   */
+  getTopExpenses: {
+    methodKind: "unary";
+    input: typeof GetTopExpensesRequestSchema;
+    output: typeof GetTopExpensesResponseSchema;
+  },
   /**
    * @generated from rpc pfinance.v1.FinanceService.BatchCreateExpenses
    */
@@ -7240,6 +10643,14 @@ export const FinanceService: GenService<{
     input: typeof BatchCreateExpensesRequestSchema;
     output: typeof BatchCreateExpensesResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.QuickAddRepeatingExpense
+   */
+  quickAddRepeatingExpense: {
+    methodKind: "unary";
+    input: typeof QuickAddRepeatingExpenseRequestSchema;
+    output: typeof QuickAddRepeatingExpenseResponseSchema;
+  },
   /**
    * @generated from rpc pfinance.v1.FinanceService.BatchDeleteExpenses
    */
@@ -7248,6 +10659,22 @@ export const FinanceService: GenService<{
     input: typeof BatchDeleteExpensesRequestSchema;
     output: typeof BatchDeleteExpensesResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.AddExpenseAttachment
+   */
+  addExpenseAttachment: {
+    methodKind: "unary";
+    input: typeof AddExpenseAttachmentRequestSchema;
+    output: typeof AddExpenseAttachmentResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.RemoveExpenseAttachment
+   */
+  removeExpenseAttachment: {
+    methodKind: "unary";
+    input: typeof RemoveExpenseAttachmentRequestSchema;
+    output: typeof RemoveExpenseAttachmentResponseSchema;
+  },
   /**
    * Income operations
    *
@@ -7282,6 +10709,14 @@ export const FinanceService: GenService<{
     input: typeof DeleteIncomeRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.BatchDeleteIncomes
+   */
+  batchDeleteIncomes: {
+    methodKind: "unary";
+    input: typeof BatchDeleteIncomesRequestSchema;
+    output: typeof BatchDeleteIncomesResponseSchema;
+  },
   /**
    * @generated from rpc pfinance.v1.FinanceService.ListIncomes
    */
@@ -7452,6 +10887,46 @@ export const FinanceService: GenService<{
     input: typeof GetBudgetProgressRequestSchema;
     output: typeof GetBudgetProgressResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.GetAllBudgetProgress
+   */
+  getAllBudgetProgress: {
+    methodKind: "unary";
+    input: typeof GetAllBudgetProgressRequestSchema;
+    output: typeof GetAllBudgetProgressResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.CreateBudgetTemplate
+   */
+  createBudgetTemplate: {
+    methodKind: "unary";
+    input: typeof CreateBudgetTemplateRequestSchema;
+    output: typeof CreateBudgetTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.ListBudgetTemplates
+   */
+  listBudgetTemplates: {
+    methodKind: "unary";
+    input: typeof ListBudgetTemplatesRequestSchema;
+    output: typeof ListBudgetTemplatesResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.UpdateBudgetTemplate
+   */
+  updateBudgetTemplate: {
+    methodKind: "unary";
+    input: typeof UpdateBudgetTemplateRequestSchema;
+    output: typeof UpdateBudgetTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.DeleteBudgetTemplate
+   */
+  deleteBudgetTemplate: {
+    methodKind: "unary";
+    input: typeof DeleteBudgetTemplateRequestSchema;
+    output: typeof EmptySchema;
+  },
   /**
    * Expense allocation operations
    *
@@ -7478,6 +10953,14 @@ export const FinanceService: GenService<{
     input: typeof GetGroupSummaryRequestSchema;
     output: typeof GetGroupSummaryResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.GetGroupSettlement
+   */
+  getGroupSettlement: {
+    methodKind: "unary";
+    input: typeof GetGroupSettlementRequestSchema;
+    output: typeof GetGroupSettlementResponseSchema;
+  },
   /**
    * Invite link operations
    *
@@ -7520,6 +11003,14 @@ export const FinanceService: GenService<{
     input: typeof DeactivateInviteLinkRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.GetInviteLinkStats
+   */
+  getInviteLinkStats: {
+    methodKind: "unary";
+    input: typeof GetInviteLinkStatsRequestSchema;
+    output: typeof GetInviteLinkStatsResponseSchema;
+  },
   /**
    * Contribution operations
    *
@@ -7620,6 +11111,14 @@ export const FinanceService: GenService<{
     input: typeof ListGoalContributionsRequestSchema;
     output: typeof ListGoalContributionsResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.RecommendGoalAllocations
+   */
+  recommendGoalAllocations: {
+    methodKind: "unary";
+    input: typeof RecommendGoalAllocationsRequestSchema;
+    output: typeof RecommendGoalAllocationsResponseSchema;
+  },
   /**
    * Spending insights operations
    *
@@ -7630,6 +11129,16 @@ export const FinanceService: GenService<{
     input: typeof GetSpendingInsightsRequestSchema;
     output: typeof GetSpendingInsightsResponseSchema;
   },
+  /**
+   * Activity feed operations
+   *
+   * @generated from rpc pfinance.v1.FinanceService.GetActivityFeed
+   */
+  getActivityFeed: {
+    methodKind: "unary";
+    input: typeof GetActivityFeedRequestSchema;
+    output: typeof GetActivityFeedResponseSchema;
+  },
   /**
    * Document extraction operations
    *
@@ -7656,6 +11165,22 @@ export const FinanceService: GenService<{
     input: typeof ImportExtractedTransactionsRequestSchema;
     output: typeof ImportExtractedTransactionsResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.GetJobRejectedTransactions
+   */
+  getJobRejectedTransactions: {
+    methodKind: "unary";
+    input: typeof GetJobRejectedTransactionsRequestSchema;
+    output: typeof GetJobRejectedTransactionsResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.RecoverRejectedTransactions
+   */
+  recoverRejectedTransactions: {
+    methodKind: "unary";
+    input: typeof RecoverRejectedTransactionsRequestSchema;
+    output: typeof RecoverRejectedTransactionsResponseSchema;
+  },
   /**
    * Smart text parsing (uses AI to parse natural language expense descriptions)
    *
@@ -7676,6 +11201,74 @@ export const FinanceService: GenService<{
     input: typeof ParseBankStatementRequestSchema;
     output: typeof ParseBankStatementResponseSchema;
   },
+  /**
+   * Import profile operations (saved per-bank import mappings)
+   *
+   * @generated from rpc pfinance.v1.FinanceService.CreateImportProfile
+   */
+  createImportProfile: {
+    methodKind: "unary";
+    input: typeof CreateImportProfileRequestSchema;
+    output: typeof CreateImportProfileResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.GetImportProfile
+   */
+  getImportProfile: {
+    methodKind: "unary";
+    input: typeof GetImportProfileRequestSchema;
+    output: typeof GetImportProfileResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.UpdateImportProfile
+   */
+  updateImportProfile: {
+    methodKind: "unary";
+    input: typeof UpdateImportProfileRequestSchema;
+    output: typeof UpdateImportProfileResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.DeleteImportProfile
+   */
+  deleteImportProfile: {
+    methodKind: "unary";
+    input: typeof DeleteImportProfileRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.ListImportProfiles
+   */
+  listImportProfiles: {
+    methodKind: "unary";
+    input: typeof ListImportProfilesRequestSchema;
+    output: typeof ListImportProfilesResponseSchema;
+  },
+  /**
+   * Extraction preference operations (per-user confidence thresholds)
+   *
+   * @generated from rpc pfinance.v1.FinanceService.GetExtractionPreferences
+   */
+  getExtractionPreferences: {
+    methodKind: "unary";
+    input: typeof GetExtractionPreferencesRequestSchema;
+    output: typeof GetExtractionPreferencesResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.UpdateExtractionPreferences
+   */
+  updateExtractionPreferences: {
+    methodKind: "unary";
+    input: typeof UpdateExtractionPreferencesRequestSchema;
+    output: typeof UpdateExtractionPreferencesResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.DeleteExtractionPreferences
+   */
+  deleteExtractionPreferences: {
+    methodKind: "unary";
+    input: typeof DeleteExtractionPreferencesRequestSchema;
+    output: typeof EmptySchema;
+  },
   /**
    * Recurring transaction operations
    *
@@ -7734,6 +11327,14 @@ export const FinanceService: GenService<{
     input: typeof ResumeRecurringTransactionRequestSchema;
     output: typeof ResumeRecurringTransactionResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.SkipNextOccurrence
+   */
+  skipNextOccurrence: {
+    methodKind: "unary";
+    input: typeof SkipNextOccurrenceRequestSchema;
+    output: typeof SkipNextOccurrenceResponseSchema;
+  },
   /**
    * @generated from rpc pfinance.v1.FinanceService.GetUpcomingBills
    */
@@ -7742,6 +11343,14 @@ export const FinanceService: GenService<{
     input: typeof GetUpcomingBillsRequestSchema;
     output: typeof GetUpcomingBillsResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.PreviewRecurringOccurrences
+   */
+  previewRecurringOccurrences: {
+    methodKind: "unary";
+    input: typeof PreviewRecurringOccurrencesRequestSchema;
+    output: typeof PreviewRecurringOccurrencesResponseSchema;
+  },
   /**
    * @generated from rpc pfinance.v1.FinanceService.ProcessRecurringTransactions
    */
@@ -7778,6 +11387,22 @@ export const FinanceService: GenService<{
     input: typeof ConvertToRecurringRequestSchema;
     output: typeof ConvertToRecurringResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.ConvertExpenseToRecurring
+   */
+  convertExpenseToRecurring: {
+    methodKind: "unary";
+    input: typeof ConvertExpenseToRecurringRequestSchema;
+    output: typeof ConvertExpenseToRecurringResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.DetectRecurringPatterns
+   */
+  detectRecurringPatterns: {
+    methodKind: "unary";
+    input: typeof DetectRecurringPatternsRequestSchema;
+    output: typeof DetectRecurringPatternsResponseSchema;
+  },
   /**
    * Notification operations
    *
@@ -7804,6 +11429,22 @@ export const FinanceService: GenService<{
     input: typeof MarkAllNotificationsReadRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.DeleteNotification
+   */
+  deleteNotification: {
+    methodKind: "unary";
+    input: typeof DeleteNotificationRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.DeleteAllReadNotifications
+   */
+  deleteAllReadNotifications: {
+    methodKind: "unary";
+    input: typeof DeleteAllReadNotificationsRequestSchema;
+    output: typeof DeleteAllReadNotificationsResponseSchema;
+  },
   /**
    * @generated from rpc pfinance.v1.FinanceService.GetUnreadNotificationCount
    */
@@ -7812,6 +11453,14 @@ export const FinanceService: GenService<{
     input: typeof GetUnreadNotificationCountRequestSchema;
     output: typeof GetUnreadNotificationCountResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.GetNotificationStats
+   */
+  getNotificationStats: {
+    methodKind: "unary";
+    input: typeof GetNotificationStatsRequestSchema;
+    output: typeof GetNotificationStatsResponseSchema;
+  },
   /**
    * @generated from rpc pfinance.v1.FinanceService.GetNotificationPreferences
    */
@@ -7920,6 +11569,22 @@ export const FinanceService: GenService<{
     input: typeof GetWaterfallDataRequestSchema;
     output: typeof GetWaterfallDataResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.RecommendBudgets
+   */
+  recommendBudgets: {
+    methodKind: "unary";
+    input: typeof RecommendBudgetsRequestSchema;
+    output: typeof RecommendBudgetsResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.GetSpendingByTag
+   */
+  getSpendingByTag: {
+    methodKind: "unary";
+    input: typeof GetSpendingByTagRequestSchema;
+    output: typeof GetSpendingByTagResponseSchema;
+  },
   /**
    * ML Feedback operations
    *
@@ -7938,6 +11603,14 @@ export const FinanceService: GenService<{
     input: typeof CheckDuplicatesRequestSchema;
     output: typeof CheckDuplicatesResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.MergeExpenses
+   */
+  mergeExpenses: {
+    methodKind: "unary";
+    input: typeof MergeExpensesRequestSchema;
+    output: typeof MergeExpensesResponseSchema;
+  },
   /**
    * @generated from rpc pfinance.v1.FinanceService.GetMerchantSuggestions
    */
@@ -7946,6 +11619,30 @@ export const FinanceService: GenService<{
     input: typeof GetMerchantSuggestionsRequestSchema;
     output: typeof GetMerchantSuggestionsResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.ListMerchantMappings
+   */
+  listMerchantMappings: {
+    methodKind: "unary";
+    input: typeof ListMerchantMappingsRequestSchema;
+    output: typeof ListMerchantMappingsResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.DeleteMerchantMapping
+   */
+  deleteMerchantMapping: {
+    methodKind: "unary";
+    input: typeof DeleteMerchantMappingRequestSchema;
+    output: typeof DeleteMerchantMappingResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.BatchUpsertMerchantMappings
+   */
+  batchUpsertMerchantMappings: {
+    methodKind: "unary";
+    input: typeof BatchUpsertMerchantMappingsRequestSchema;
+    output: typeof BatchUpsertMerchantMappingsResponseSchema;
+  },
   /**
    * @generated from rpc pfinance.v1.FinanceService.GetExtractionMetrics
    */
@@ -8038,6 +11735,14 @@ export const FinanceService: GenService<{
     input: typeof ExportTaxReturnRequestSchema;
     output: typeof ExportTaxReturnResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.ExportTransactionsStream
+   */
+  exportTransactionsStream: {
+    methodKind: "server_streaming";
+    input: typeof ExportTransactionsStreamRequestSchema;
+    output: typeof ExportTransactionsStreamResponseSchema;
+  },
   /**
    * @generated from rpc pfinance.v1.FinanceService.FindPotentialDeductions
    */
@@ -8046,6 +11751,14 @@ export const FinanceService: GenService<{
     input: typeof FindPotentialDeductionsRequestSchema;
     output: typeof FindPotentialDeductionsResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.GetTaxDeductionChecklist
+   */
+  getTaxDeductionChecklist: {
+    methodKind: "unary";
+    input: typeof GetTaxDeductionChecklistRequestSchema;
+    output: typeof GetTaxDeductionChecklistResponseSchema;
+  },
   /**
    * @generated from rpc pfinance.v1.FinanceService.CompareTaxYears
    */
@@ -8054,6 +11767,38 @@ export const FinanceService: GenService<{
     input: typeof CompareTaxYearsRequestSchema;
     output: typeof CompareTaxYearsResponseSchema;
   },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.GetDeductionProgress
+   */
+  getDeductionProgress: {
+    methodKind: "unary";
+    input: typeof GetDeductionProgressRequestSchema;
+    output: typeof GetDeductionProgressResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.LockFinancialYear
+   */
+  lockFinancialYear: {
+    methodKind: "unary";
+    input: typeof LockFinancialYearRequestSchema;
+    output: typeof LockFinancialYearResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.UnlockFinancialYear
+   */
+  unlockFinancialYear: {
+    methodKind: "unary";
+    input: typeof UnlockFinancialYearRequestSchema;
+    output: typeof UnlockFinancialYearResponseSchema;
+  },
+  /**
+   * @generated from rpc pfinance.v1.FinanceService.GetGstSummary
+   */
+  getGstSummary: {
+    methodKind: "unary";
+    input: typeof GetGstSummaryRequestSchema;
+    output: typeof GetGstSummaryResponseSchema;
+  },
   /**
    * Tax eval operations (Pro tier)
    *
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file pfinance/v1/types.proto.
  */
 export const file_pfinance_v1_types: GenFile = /*@__PURE__*/
-  fileDesc("ChdwZmluYW5jZS92MS90eXBlcy5wcm90bxILcGZpbmFuY2UudjEi3gIKBFVzZXISCgoCaWQYASABKAkSDQoFZW1haWwYAiABKAkSFAoMZGlzcGxheV9uYW1lGAMgASgJEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCXBob3RvX3VybBgGIAEoCRI4ChFzdWJzY3JpcHRpb25fdGllchgHIAEoDjIdLnBmaW5hbmNlLnYxLlN1YnNjcmlwdGlvblRpZXISPAoTc3Vic2NyaXB0aW9uX3N0YXR1cxgIIAEoDjIfLnBmaW5hbmNlLnYxLlN1YnNjcmlwdGlvblN0YXR1cxIaChJzdHJpcGVfY3VzdG9tZXJfaWQYCSABKAkSHgoWc3RyaXBlX3N1YnNjcmlwdGlvbl9pZBgKIAEoCSKFAgoIQXBpVG9rZW4SCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEhQKDHRva2VuX3ByZWZpeBgEIAEoCRISCgp0b2tlbl9oYXNoGAUgASgJEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDGxhc3RfdXNlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZXhwaXJlc19hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKaXNfcmV2b2tlZBgJIAEoCCKsAQoRRXhwZW5zZUFsbG9jYXRpb24SDwoHdXNlcl9pZBgBIAEoCRIOCgZhbW91bnQYAiABKAESEgoKcGVyY2VudGFnZRgDIAEoARIOCgZzaGFyZXMYBCABKAESDwoHaXNfcGFpZBgFIAEoCBIrCgdwYWlkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxhbW91bnRfY2VudHMYByABKAMiggYKB0V4cGVuc2USCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIQCghncm91cF9pZBgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRIOCgZhbW91bnQYBSABKAESLgoIY2F0ZWdvcnkYBiABKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSMAoJZnJlcXVlbmN5GAcgASgOMh0ucGZpbmFuY2UudjEuRXhwZW5zZUZyZXF1ZW5jeRIoCgRkYXRlGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIXCg9wYWlkX2J5X3VzZXJfaWQYCyABKAkSKgoKc3BsaXRfdHlwZRgMIAEoDjIWLnBmaW5hbmNlLnYxLlNwbGl0VHlwZRIzCgthbGxvY2F0aW9ucxgNIAMoCzIeLnBmaW5hbmNlLnYxLkV4cGVuc2VBbGxvY2F0aW9uEhIKCmlzX3NldHRsZWQYDiABKAgSDAoEdGFncxgPIAMoCRIUCgxhbW91bnRfY2VudHMYECABKAMSOAoRZXh0cmFjdGlvbl9tZXRob2QYESABKA4yHS5wZmluYW5jZS52MS5FeHRyYWN0aW9uTWV0aG9kEhkKEWlzX3RheF9kZWR1Y3RpYmxlGBIgASgIEkEKFnRheF9kZWR1Y3Rpb25fY2F0ZWdvcnkYEyABKA4yIS5wZmluYW5jZS52MS5UYXhEZWR1Y3Rpb25DYXRlZ29yeRIaChJ0YXhfZGVkdWN0aW9uX25vdGUYFCABKAkSHgoWdGF4X2RlZHVjdGlibGVfcGVyY2VudBgVIAEoARITCgtyZWNlaXB0X3VybBgWIAEoCRIcChRyZWNlaXB0X3N0b3JhZ2VfcGF0aBgXIAEoCSKAAwoGSW5jb21lEgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSEAoIZ3JvdXBfaWQYAyABKAkSDgoGc291cmNlGAQgASgJEg4KBmFtb3VudBgFIAEoARIvCglmcmVxdWVuY3kYBiABKA4yHC5wZmluYW5jZS52MS5JbmNvbWVGcmVxdWVuY3kSKgoKdGF4X3N0YXR1cxgHIAEoDjIWLnBmaW5hbmNlLnYxLlRheFN0YXR1cxIqCgpkZWR1Y3Rpb25zGAggAygLMhYucGZpbmFuY2UudjEuRGVkdWN0aW9uEigKBGRhdGUYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhQKDGFtb3VudF9jZW50cxgMIAEoAyJmCglEZWR1Y3Rpb24SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIOCgZhbW91bnQYAyABKAESGQoRaXNfdGF4X2RlZHVjdGlibGUYBCABKAgSFAoMYW1vdW50X2NlbnRzGAUgASgDIsMCCgtUYXhTZXR0aW5ncxIVCg1pbmNsdWRlX3N1cGVyGAEgASgIEhIKCnN1cGVyX3JhdGUYAiABKAESGAoQaW5jbHVkZV9tZWRpY2FyZRgDIAEoCBIaChJtZWRpY2FyZV9leGVtcHRpb24YBCABKAgSHQoVaW5jbHVkZV9zZW5pb3Jfb2Zmc2V0GAUgASgIEhwKFGluY2x1ZGVfc3R1ZGVudF9sb2FuGAYgASgIEhkKEXN0dWRlbnRfbG9hbl9yYXRlGAcgASgBEiIKGmluY2x1ZGVfZGVwZW5kZW50X2NoaWxkcmVuGAggASgIEhYKDmluY2x1ZGVfc3BvdXNlGAkgASgIEh4KFmluY2x1ZGVfcHJpdmF0ZV9oZWFsdGgYCiABKAgSHwoXaW5jbHVkZV92b2x1bnRhcnlfc3VwZXIYCyABKAgioAEKCVRheENvbmZpZxIPCgdlbmFibGVkGAEgASgIEigKB2NvdW50cnkYAiABKA4yFy5wZmluYW5jZS52MS5UYXhDb3VudHJ5EhAKCHRheF9yYXRlGAMgASgBEhoKEmluY2x1ZGVfZGVkdWN0aW9ucxgEIAEoCBIqCghzZXR0aW5ncxgFIAEoCzIYLnBmaW5hbmNlLnYxLlRheFNldHRpbmdzIu4BCgxGaW5hbmNlR3JvdXASCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIQCghvd25lcl9pZBgEIAEoCRISCgptZW1iZXJfaWRzGAUgAygJEikKB21lbWJlcnMYBiADKAsyGC5wZmluYW5jZS52MS5Hcm91cE1lbWJlchIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKYAQoLR3JvdXBNZW1iZXISDwoHdXNlcl9pZBgBIAEoCRINCgVlbWFpbBgCIAEoCRIUCgxkaXNwbGF5X25hbWUYAyABKAkSJAoEcm9sZRgEIAEoDjIWLnBmaW5hbmNlLnYxLkdyb3VwUm9sZRItCglqb2luZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIo8CCg9Hcm91cEludml0YXRpb24SCgoCaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSEgoKaW52aXRlcl9pZBgDIAEoCRIVCg1pbnZpdGVlX2VtYWlsGAQgASgJEiQKBHJvbGUYBSABKA4yFi5wZmluYW5jZS52MS5Hcm91cFJvbGUSLQoGc3RhdHVzGAYgASgOMh0ucGZpbmFuY2UudjEuSW52aXRhdGlvblN0YXR1cxIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpleHBpcmVzX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKwAwoGQnVkZ2V0EgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSEAoIZ3JvdXBfaWQYAyABKAkSDAoEbmFtZRgEIAEoCRITCgtkZXNjcmlwdGlvbhgFIAEoCRIOCgZhbW91bnQYBiABKAESKQoGcGVyaW9kGAcgASgOMhkucGZpbmFuY2UudjEuQnVkZ2V0UGVyaW9kEjIKDGNhdGVnb3J5X2lkcxgIIAMoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIRCglpc19hY3RpdmUYCSABKAgSLgoKc3RhcnRfZGF0ZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX2RhdGUYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhQKDGFtb3VudF9jZW50cxgOIAEoAyKVAQoLQnVkZ2V0QWxlcnQSCgoCaWQYASABKAkSEQoJYnVkZ2V0X2lkGAIgASgJEhwKFHRocmVzaG9sZF9wZXJjZW50YWdlGAMgASgBEhIKCmlzX2VuYWJsZWQYBCABKAgSNQoRbGFzdF90cmlnZ2VyZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIpcDCg5CdWRnZXRQcm9ncmVzcxIRCglidWRnZXRfaWQYASABKAkSGAoQYWxsb2NhdGVkX2Ftb3VudBgCIAEoARIUCgxzcGVudF9hbW91bnQYAyABKAESGAoQcmVtYWluaW5nX2Ftb3VudBgEIAEoARIXCg9wZXJjZW50YWdlX3VzZWQYBSABKAESFgoOZGF5c19yZW1haW5pbmcYBiABKAUSMAoMcGVyaW9kX3N0YXJ0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpwZXJpb2RfZW5kGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI5ChJjYXRlZ29yeV9icmVha2Rvd24YCSADKAsyHS5wZmluYW5jZS52MS5FeHBlbnNlQnJlYWtkb3duEh4KFmFsbG9jYXRlZF9hbW91bnRfY2VudHMYCiABKAMSGgoSc3BlbnRfYW1vdW50X2NlbnRzGAsgASgDEh4KFnJlbWFpbmluZ19hbW91bnRfY2VudHMYDCABKAMifAoQRXhwZW5zZUJyZWFrZG93bhIuCghjYXRlZ29yeRgBIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIOCgZhbW91bnQYAiABKAESEgoKcGVyY2VudGFnZRgDIAEoARIUCgxhbW91bnRfY2VudHMYBCABKAMi3gEKDU1lbWJlckJhbGFuY2USDwoHdXNlcl9pZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRISCgp0b3RhbF9wYWlkGAMgASgBEhIKCnRvdGFsX293ZWQYBCABKAESDwoHYmFsYW5jZRgFIAEoARImCgVkZWJ0cxgGIAMoCzIXLnBmaW5hbmNlLnYxLk1lbWJlckRlYnQSGAoQdG90YWxfcGFpZF9jZW50cxgHIAEoAxIYChB0b3RhbF9vd2VkX2NlbnRzGAggASgDEhUKDWJhbGFuY2VfY2VudHMYCSABKAMicwoKTWVtYmVyRGVidBIUCgxmcm9tX3VzZXJfaWQYASABKAkSEgoKdG9fdXNlcl9pZBgCIAEoCRIOCgZhbW91bnQYAyABKAESFQoNZXhwZW5zZV9jb3VudBgEIAEoBRIUCgxhbW91bnRfY2VudHMYBSABKAMimgIKD0dyb3VwSW52aXRlTGluaxIKCgJpZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRIMCgRjb2RlGAMgASgJEhIKCmNyZWF0ZWRfYnkYBCABKAkSLAoMZGVmYXVsdF9yb2xlGAUgASgOMhYucGZpbmFuY2UudjEuR3JvdXBSb2xlEhAKCG1heF91c2VzGAYgASgFEhQKDGN1cnJlbnRfdXNlcxgHIAEoBRIuCgpleHBpcmVzX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCglpc19hY3RpdmUYCSABKAgSLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiygIKE0V4cGVuc2VDb250cmlidXRpb24SCgoCaWQYASABKAkSGQoRc291cmNlX2V4cGVuc2VfaWQYAiABKAkSFwoPdGFyZ2V0X2dyb3VwX2lkGAMgASgJEhYKDmNvbnRyaWJ1dGVkX2J5GAQgASgJEg4KBmFtb3VudBgFIAEoARIqCgpzcGxpdF90eXBlGAYgASgOMhYucGZpbmFuY2UudjEuU3BsaXRUeXBlEjMKC2FsbG9jYXRpb25zGAcgAygLMh4ucGZpbmFuY2UudjEuRXhwZW5zZUFsbG9jYXRpb24SIAoYY3JlYXRlZF9ncm91cF9leHBlbnNlX2lkGAggASgJEjIKDmNvbnRyaWJ1dGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxhbW91bnRfY2VudHMYCiABKAMi5gEKEkluY29tZUNvbnRyaWJ1dGlvbhIKCgJpZBgBIAEoCRIYChBzb3VyY2VfaW5jb21lX2lkGAIgASgJEhcKD3RhcmdldF9ncm91cF9pZBgDIAEoCRIWCg5jb250cmlidXRlZF9ieRgEIAEoCRIOCgZhbW91bnQYBSABKAESHwoXY3JlYXRlZF9ncm91cF9pbmNvbWVfaWQYBiABKAkSMgoOY29udHJpYnV0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhQKDGFtb3VudF9jZW50cxgIIAEoAyKKAQoNR29hbE1pbGVzdG9uZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhkKEXRhcmdldF9wZXJjZW50YWdlGAMgASgBEhMKC2lzX2FjaGlldmVkGAQgASgIEi8KC2FjaGlldmVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLgBAoNRmluYW5jaWFsR29hbBIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhAKCGdyb3VwX2lkGAMgASgJEgwKBG5hbWUYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSKAoJZ29hbF90eXBlGAYgASgOMhUucGZpbmFuY2UudjEuR29hbFR5cGUSFQoNdGFyZ2V0X2Ftb3VudBgHIAEoARIWCg5jdXJyZW50X2Ftb3VudBgIIAEoARIuCgpzdGFydF9kYXRlGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgt0YXJnZXRfZGF0ZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASJwoGc3RhdHVzGAsgASgOMhcucGZpbmFuY2UudjEuR29hbFN0YXR1cxIyCgxjYXRlZ29yeV9pZHMYDCADKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSDAoEaWNvbhgNIAEoCRINCgVjb2xvchgOIAEoCRIuCgptaWxlc3RvbmVzGA8gAygLMhoucGZpbmFuY2UudjEuR29hbE1pbGVzdG9uZRIuCgpjcmVhdGVkX2F0GBAgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GBEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIbChN0YXJnZXRfYW1vdW50X2NlbnRzGBIgASgDEhwKFGN1cnJlbnRfYW1vdW50X2NlbnRzGBMgASgDIrkDCgxHb2FsUHJvZ3Jlc3MSDwoHZ29hbF9pZBgBIAEoCRIWCg5jdXJyZW50X2Ftb3VudBgCIAEoARIVCg10YXJnZXRfYW1vdW50GAMgASgBEhsKE3BlcmNlbnRhZ2VfY29tcGxldGUYBCABKAESFgoOZGF5c19yZW1haW5pbmcYBSABKAUSGwoTcmVxdWlyZWRfZGFpbHlfcmF0ZRgGIAEoARIZChFhY3R1YWxfZGFpbHlfcmF0ZRgHIAEoARIQCghvbl90cmFjaxgIIAEoCBI3ChNhY2hpZXZlZF9taWxlc3RvbmVzGAkgAygLMhoucGZpbmFuY2UudjEuR29hbE1pbGVzdG9uZRIyCg5uZXh0X21pbGVzdG9uZRgKIAEoCzIaLnBmaW5hbmNlLnYxLkdvYWxNaWxlc3RvbmUSHAoUY3VycmVudF9hbW91bnRfY2VudHMYCyABKAMSGwoTdGFyZ2V0X2Ftb3VudF9jZW50cxgMIAEoAxIhChlyZXF1aXJlZF9kYWlseV9yYXRlX2NlbnRzGA0gASgDEh8KF2FjdHVhbF9kYWlseV9yYXRlX2NlbnRzGA4gASgDIqgBChBHb2FsQ29udHJpYnV0aW9uEgoKAmlkGAEgASgJEg8KB2dvYWxfaWQYAiABKAkSDwoHdXNlcl9pZBgDIAEoCRIOCgZhbW91bnQYBCABKAESDAoEbm90ZRgFIAEoCRIyCg5jb250cmlidXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMYW1vdW50X2NlbnRzGAcgASgDIqoFChRSZWN1cnJpbmdUcmFuc2FjdGlvbhIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhAKCGdyb3VwX2lkGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEg4KBmFtb3VudBgFIAEoARIUCgxhbW91bnRfY2VudHMYBiABKAMSLgoIY2F0ZWdvcnkYByABKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSMAoJZnJlcXVlbmN5GAggASgOMh0ucGZpbmFuY2UudjEuRXhwZW5zZUZyZXF1ZW5jeRIuCgpzdGFydF9kYXRlGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIzCg9uZXh0X29jY3VycmVuY2UYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF9kYXRlGAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI3CgZzdGF0dXMYDCABKA4yJy5wZmluYW5jZS52MS5SZWN1cnJpbmdUcmFuc2FjdGlvblN0YXR1cxISCgppc19leHBlbnNlGA0gASgIEi4KCmNyZWF0ZWRfYXQYDiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEgwKBHRhZ3MYECADKAkSFwoPcGFpZF9ieV91c2VyX2lkGBEgASgJEioKCnNwbGl0X3R5cGUYEiABKA4yFi5wZmluYW5jZS52MS5TcGxpdFR5cGUSMwoLYWxsb2NhdGlvbnMYEyADKAsyHi5wZmluYW5jZS52MS5FeHBlbnNlQWxsb2NhdGlvbiKcAgoPU3BlbmRpbmdJbnNpZ2h0EgoKAmlkGAEgASgJEiYKBHR5cGUYAiABKA4yGC5wZmluYW5jZS52MS5JbnNpZ2h0VHlwZRINCgV0aXRsZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRIQCghjYXRlZ29yeRgFIAEoCRIOCgZhbW91bnQYBiABKAESFgoOY2hhbmdlX3BlcmNlbnQYByABKAESDgoGcGVyaW9kGAggASgJEgwKBGljb24YCSABKAkSEwoLaXNfcG9zaXRpdmUYCiABKAgSLgoKY3JlYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMYW1vdW50X2NlbnRzGAwgASgDIs8BCgxTZWFyY2hSZXN1bHQSCgoCaWQYASABKAkSKgoEdHlwZRgCIAEoDjIcLnBmaW5hbmNlLnYxLlRyYW5zYWN0aW9uVHlwZRITCgtkZXNjcmlwdGlvbhgDIAEoCRIQCghjYXRlZ29yeRgEIAEoCRIOCgZhbW91bnQYBSABKAESFAoMYW1vdW50X2NlbnRzGAYgASgDEigKBGRhdGUYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGdyb3VwX2lkGAggASgJIpgDChREZXRlY3RlZFN1YnNjcmlwdGlvbhIVCg1tZXJjaGFudF9uYW1lGAEgASgJEhcKD25vcm1hbGl6ZWRfbmFtZRgCIAEoCRIQCghjYXRlZ29yeRgDIAEoCRIWCg5hdmVyYWdlX2Ftb3VudBgEIAEoARIcChRhdmVyYWdlX2Ftb3VudF9jZW50cxgFIAEoAxI5ChJkZXRlY3RlZF9mcmVxdWVuY3kYBiABKA4yHS5wZmluYW5jZS52MS5FeHBlbnNlRnJlcXVlbmN5EhgKEGNvbmZpZGVuY2Vfc2NvcmUYByABKAESGAoQb2NjdXJyZW5jZV9jb3VudBgIIAEoBRItCglsYXN0X3NlZW4YCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjEKDWV4cGVjdGVkX25leHQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhoKEmlzX2FscmVhZHlfdHJhY2tlZBgLIAEoCBIbChNtYXRjaGVkX2V4cGVuc2VfaWRzGAwgAygJIpQDCgxOb3RpZmljYXRpb24SCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIrCgR0eXBlGAMgASgOMh0ucGZpbmFuY2UudjEuTm90aWZpY2F0aW9uVHlwZRINCgV0aXRsZRgEIAEoCRIPCgdtZXNzYWdlGAUgASgJEg8KB2lzX3JlYWQYBiABKAgSEgoKYWN0aW9uX3VybBgHIAEoCRIUCgxyZWZlcmVuY2VfaWQYCCABKAkSFgoOcmVmZXJlbmNlX3R5cGUYCSABKAkSLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKwoHcmVhZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOQoIbWV0YWRhdGEYDCADKAsyJy5wZmluYW5jZS52MS5Ob3RpZmljYXRpb24uTWV0YWRhdGFFbnRyeRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEihQIKF05vdGlmaWNhdGlvblByZWZlcmVuY2VzEg8KB3VzZXJfaWQYASABKAkSFQoNYnVkZ2V0X2FsZXJ0cxgCIAEoCBIXCg9nb2FsX21pbGVzdG9uZXMYAyABKAgSFgoOYmlsbF9yZW1pbmRlcnMYBCABKAgSGAoQdW51c3VhbF9zcGVuZGluZxgFIAEoCBIbChNzdWJzY3JpcHRpb25fYWxlcnRzGAYgASgIEhUKDXdlZWtseV9kaWdlc3QYByABKAgSGgoSYmlsbF9yZW1pbmRlcl9kYXlzGAggASgFEhQKDHB1c2hfZW5hYmxlZBgJIAEoCBIRCglmY21fdG9rZW4YCiABKAki6AIKFEV4dHJhY3RlZFRyYW5zYWN0aW9uEgoKAmlkGAEgASgJEgwKBGRhdGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSGwoTbm9ybWFsaXplZF9tZXJjaGFudBgEIAEoCRIOCgZhbW91bnQYBSABKAESOAoSc3VnZ2VzdGVkX2NhdGVnb3J5GAYgASgOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5EhIKCmNvbmZpZGVuY2UYByABKAESEAoIaXNfZGViaXQYCCABKAgSEQoJcmVmZXJlbmNlGAkgASgJEjIKCmxpbmVfaXRlbXMYCiADKAsyHi5wZmluYW5jZS52MS5FeHRyYWN0ZWRMaW5lSXRlbRIUCgxhbW91bnRfY2VudHMYCyABKAMSNwoRZmllbGRfY29uZmlkZW5jZXMYDCABKAsyHC5wZmluYW5jZS52MS5GaWVsZENvbmZpZGVuY2UikAEKEUV4dHJhY3RlZExpbmVJdGVtEhMKC2Rlc2NyaXB0aW9uGAEgASgJEg4KBmFtb3VudBgCIAEoARIQCghxdWFudGl0eRgDIAEoBRIuCghjYXRlZ29yeRgEIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIUCgxhbW91bnRfY2VudHMYBSABKAMiaAoPRmllbGRDb25maWRlbmNlEg4KBmFtb3VudBgBIAEoARIMCgRkYXRlGAIgASgBEhMKC2Rlc2NyaXB0aW9uGAMgASgBEhAKCG1lcmNoYW50GAQgASgBEhAKCGNhdGVnb3J5GAUgASgBIpkBChVFeHRyYWN0aW9uRXJyb3JEZXRhaWwSDAoEY29kZRgBIAEoCRIPCgdtZXNzYWdlGAIgASgJEhEKCXJldHJ5YWJsZRgDIAEoCBIYChBzdWdnZXN0ZWRfYWN0aW9uGAQgASgJEjQKDWZhaWxlZF9tZXRob2QYBSABKA4yHS5wZmluYW5jZS52MS5FeHRyYWN0aW9uTWV0aG9kItcDChBFeHRyYWN0aW9uUmVzdWx0EjcKDHRyYW5zYWN0aW9ucxgBIAMoCzIhLnBmaW5hbmNlLnYxLkV4dHJhY3RlZFRyYW5zYWN0aW9uEhoKEm92ZXJhbGxfY29uZmlkZW5jZRgCIAEoARISCgptb2RlbF91c2VkGAMgASgJEhoKEnByb2Nlc3NpbmdfdGltZV9tcxgEIAEoBRIQCgh3YXJuaW5ncxgFIAMoCRIwCg1kb2N1bWVudF90eXBlGAYgASgOMhkucGZpbmFuY2UudjEuRG9jdW1lbnRUeXBlEhIKCnBhZ2VfY291bnQYByABKAUSQAoVcmVqZWN0ZWRfdHJhbnNhY3Rpb25zGAggAygLMiEucGZpbmFuY2UudjEuRXh0cmFjdGVkVHJhbnNhY3Rpb24SMgoLbWV0aG9kX3VzZWQYCSABKA4yHS5wZmluYW5jZS52MS5FeHRyYWN0aW9uTWV0aG9kEjQKDWZhbGxiYWNrX2Zyb20YCiABKA4yHS5wZmluYW5jZS52MS5FeHRyYWN0aW9uTWV0aG9kEjoKEnN0YXRlbWVudF9tZXRhZGF0YRgLIAEoCzIeLnBmaW5hbmNlLnYxLlN0YXRlbWVudE1ldGFkYXRhIq4BChFTdGF0ZW1lbnRNZXRhZGF0YRIRCgliYW5rX25hbWUYASABKAkSGgoSYWNjb3VudF9pZGVudGlmaWVyGAIgASgJEhQKDHBlcmlvZF9zdGFydBgDIAEoCRISCgpwZXJpb2RfZW5kGAQgASgJEhkKEXRyYW5zYWN0aW9uX2NvdW50GAUgASgFEhAKCGN1cnJlbmN5GAYgASgJEhMKC2ZpbmdlcnByaW50GAcgASgJIsMCChJQcm9jZXNzZWRTdGF0ZW1lbnQSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRITCgtmaW5nZXJwcmludBgDIAEoCRIRCgliYW5rX25hbWUYBCABKAkSGgoSYWNjb3VudF9pZGVudGlmaWVyGAUgASgJEhQKDHBlcmlvZF9zdGFydBgGIAEoCRISCgpwZXJpb2RfZW5kGAcgASgJEhYKDmltcG9ydGVkX2NvdW50GAggASgFEjAKDHByb2Nlc3NlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGQoRb3JpZ2luYWxfZmlsZW5hbWUYCiABKAkSHQoVc3RhdGVtZW50X3N0b3JhZ2VfdXJsGAsgASgJEh4KFnN0YXRlbWVudF9zdG9yYWdlX3BhdGgYDCABKAki3QMKDUV4dHJhY3Rpb25Kb2ISCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRItCgZzdGF0dXMYAyABKA4yHS5wZmluYW5jZS52MS5FeHRyYWN0aW9uU3RhdHVzEjAKDWRvY3VtZW50X3R5cGUYBCABKA4yGS5wZmluYW5jZS52MS5Eb2N1bWVudFR5cGUSGQoRb3JpZ2luYWxfZmlsZW5hbWUYBSABKAkSLQoGcmVzdWx0GAYgASgLMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvblJlc3VsdBIVCg1lcnJvcl9tZXNzYWdlGAcgASgJEi4KCmNyZWF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDGNvbXBsZXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEwoLdG90YWxfcGFnZXMYCiABKAUSFwoPcHJvY2Vzc2VkX3BhZ2VzGAsgASgFEhQKDGN1cnJlbnRfcGFnZRgMIAEoBRIYChBwcm9ncmVzc19wZXJjZW50GA0gASgBEi0KBm1ldGhvZBgOIAEoDjIdLnBmaW5hbmNlLnYxLkV4dHJhY3Rpb25NZXRob2QipwEKEFZhbGlkYXRpb25SZXN1bHQSEAoIYWNjdXJhY3kYASABKAESOQoNZGlzY3JlcGFuY2llcxgCIAMoCzIiLnBmaW5hbmNlLnYxLlZhbGlkYXRpb25EaXNjcmVwYW5jeRIUCgx2YWxpZGF0ZWRfYnkYAyABKAkSMAoMdmFsaWRhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJwChVWYWxpZGF0aW9uRGlzY3JlcGFuY3kSDQoFZmllbGQYASABKAkSFwoPZXh0cmFjdGVkX3ZhbHVlGAIgASgJEhcKD3ZhbGlkYXRlZF92YWx1ZRgDIAEoCRIWCg50cmFuc2FjdGlvbl9pZBgEIAEoCSKiAQoORGFpbHlBZ2dyZWdhdGUSDAoEZGF0ZRgBIAEoCRIUCgx0b3RhbF9hbW91bnQYAiABKAESGgoSdG90YWxfYW1vdW50X2NlbnRzGAMgASgDEhkKEXRyYW5zYWN0aW9uX2NvdW50GAQgASgFEjUKEGNhdGVnb3J5X2Ftb3VudHMYBSADKAsyGy5wZmluYW5jZS52MS5DYXRlZ29yeUFtb3VudCJ1Cg5DYXRlZ29yeUFtb3VudBIuCghjYXRlZ29yeRgBIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIOCgZhbW91bnQYAiABKAESFAoMYW1vdW50X2NlbnRzGAMgASgDEg0KBWNvdW50GAQgASgFIlYKE1RpbWVTZXJpZXNEYXRhUG9pbnQSDAoEZGF0ZRgBIAEoCRINCgV2YWx1ZRgCIAEoARITCgt2YWx1ZV9jZW50cxgDIAEoAxINCgVsYWJlbBgEIAEoCSL8AQoQQ2F0ZWdvcnlTcGVuZGluZxIuCghjYXRlZ29yeRgBIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIWCg5jdXJyZW50X2Ftb3VudBgCIAEoARIcChRjdXJyZW50X2Ftb3VudF9jZW50cxgDIAEoAxIXCg9wcmV2aW91c19hbW91bnQYBCABKAESHQoVcHJldmlvdXNfYW1vdW50X2NlbnRzGAUgASgDEhUKDWJ1ZGdldF9hbW91bnQYBiABKAESGwoTYnVkZ2V0X2Ftb3VudF9jZW50cxgHIAEoAxIWCg5jaGFuZ2VfcGVyY2VudBgIIAEoASLvAgoPU3BlbmRpbmdBbm9tYWx5EgoKAmlkGAEgASgJEhIKCmV4cGVuc2VfaWQYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDgoGYW1vdW50GAQgASgBEhQKDGFtb3VudF9jZW50cxgFIAEoAxIuCghjYXRlZ29yeRgGIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIoCgRkYXRlGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgd6X3Njb3JlGAggASgBEhcKD2V4cGVjdGVkX2Ftb3VudBgJIAEoARIdChVleHBlY3RlZF9hbW91bnRfY2VudHMYCiABKAMSLgoMYW5vbWFseV90eXBlGAsgASgOMhgucGZpbmFuY2UudjEuQW5vbWFseVR5cGUSLgoIc2V2ZXJpdHkYDCABKA4yHC5wZmluYW5jZS52MS5Bbm9tYWx5U2V2ZXJpdHkivwEKDUZvcmVjYXN0UG9pbnQSDAoEZGF0ZRgBIAEoCRIRCglwcmVkaWN0ZWQYAiABKAESFwoPcHJlZGljdGVkX2NlbnRzGAMgASgDEhMKC2xvd2VyX2JvdW5kGAQgASgBEhkKEWxvd2VyX2JvdW5kX2NlbnRzGAUgASgDEhMKC3VwcGVyX2JvdW5kGAYgASgBEhkKEXVwcGVyX2JvdW5kX2NlbnRzGAcgASgDEhQKDGlzX3JlY3VycmluZxgIIAEoCCKuAQoOV2F0ZXJmYWxsRW50cnkSDQoFbGFiZWwYASABKAkSDgoGYW1vdW50GAIgASgBEhQKDGFtb3VudF9jZW50cxgDIAEoAxIzCgplbnRyeV90eXBlGAQgASgOMh8ucGZpbmFuY2UudjEuV2F0ZXJmYWxsRW50cnlUeXBlEhUKDXJ1bm5pbmdfdG90YWwYBSABKAESGwoTcnVubmluZ190b3RhbF9jZW50cxgGIAEoAyJzCg9GaWVsZENvcnJlY3Rpb24SLwoFZmllbGQYASABKA4yIC5wZmluYW5jZS52MS5Db3JyZWN0aW9uRmllbGRUeXBlEhYKDm9yaWdpbmFsX3ZhbHVlGAIgASgJEhcKD2NvcnJlY3RlZF92YWx1ZRgDIAEoCSLCAwoQQ29ycmVjdGlvblJlY29yZBIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhUKDWV4dHJhY3Rpb25faWQYAyABKAkSFgoOdHJhbnNhY3Rpb25faWQYBCABKAkSMQoLY29ycmVjdGlvbnMYBSADKAsyHC5wZmluYW5jZS52MS5GaWVsZENvcnJlY3Rpb24SGQoRb3JpZ2luYWxfbWVyY2hhbnQYBiABKAkSGgoSY29ycmVjdGVkX21lcmNoYW50GAcgASgJEjcKEW9yaWdpbmFsX2NhdGVnb3J5GAggASgOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5EjgKEmNvcnJlY3RlZF9jYXRlZ29yeRgJIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIbChNvcmlnaW5hbF9jb25maWRlbmNlGAogASgBEi4KCmNyZWF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjgKEWV4dHJhY3Rpb25fbWV0aG9kGAwgASgOMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvbk1ldGhvZCKZAgoPTWVyY2hhbnRNYXBwaW5nEgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSEwoLcmF3X3BhdHRlcm4YAyABKAkSFwoPbm9ybWFsaXplZF9uYW1lGAQgASgJEi4KCGNhdGVnb3J5GAUgASgOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5EhgKEGNvcnJlY3Rpb25fY291bnQYBiABKAUSEgoKY29uZmlkZW5jZRgHIAEoARItCglsYXN0X3VzZWQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wItsCCg9FeHRyYWN0aW9uRXZlbnQSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRItCgZtZXRob2QYAyABKA4yHS5wZmluYW5jZS52MS5FeHRyYWN0aW9uTWV0aG9kEhkKEXRyYW5zYWN0aW9uX2NvdW50GAQgASgFEhYKDmFjY2VwdGVkX2NvdW50GAUgASgFEhYKDnJlamVjdGVkX2NvdW50GAYgASgFEhcKD2NvcnJlY3RlZF9jb3VudBgHIAEoBRIaChJvdmVyYWxsX2NvbmZpZGVuY2UYCCABKAESGgoScHJvY2Vzc2luZ190aW1lX21zGAkgASgFEjAKDWRvY3VtZW50X3R5cGUYCiABKA4yGS5wZmluYW5jZS52MS5Eb2N1bWVudFR5cGUSLgoKY3JlYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi1QEKEkR1cGxpY2F0ZUNhbmRpZGF0ZRIbChNleGlzdGluZ19leHBlbnNlX2lkGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEg4KBmFtb3VudBgDIAEoARIUCgxhbW91bnRfY2VudHMYBCABKAMSDAoEZGF0ZRgFIAEoCRIuCghjYXRlZ29yeRgGIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRITCgttYXRjaF9zY29yZRgHIAEoARIUCgxtYXRjaF9yZWFzb24YCCABKAkijAEKE1RheERlZHVjdGlvblN1bW1hcnkSMwoIY2F0ZWdvcnkYASABKA4yIS5wZmluYW5jZS52MS5UYXhEZWR1Y3Rpb25DYXRlZ29yeRITCgt0b3RhbF9jZW50cxgCIAEoAxIUCgx0b3RhbF9hbW91bnQYAyABKAESFQoNZXhwZW5zZV9jb3VudBgEIAEoBSLiBAoOVGF4Q2FsY3VsYXRpb24SFgoOZmluYW5jaWFsX3llYXIYASABKAkSGgoSZ3Jvc3NfaW5jb21lX2NlbnRzGAIgASgDEhQKDGdyb3NzX2luY29tZRgDIAEoARI0CgpkZWR1Y3Rpb25zGAQgAygLMiAucGZpbmFuY2UudjEuVGF4RGVkdWN0aW9uU3VtbWFyeRIeChZ0b3RhbF9kZWR1Y3Rpb25zX2NlbnRzGAUgASgDEhgKEHRvdGFsX2RlZHVjdGlvbnMYBiABKAESHAoUdGF4YWJsZV9pbmNvbWVfY2VudHMYByABKAMSFgoOdGF4YWJsZV9pbmNvbWUYCCABKAESFgoOYmFzZV90YXhfY2VudHMYCSABKAMSEAoIYmFzZV90YXgYCiABKAESGwoTbWVkaWNhcmVfbGV2eV9jZW50cxgLIAEoAxIVCg1tZWRpY2FyZV9sZXZ5GAwgASgBEhwKFGhlbHBfcmVwYXltZW50X2NlbnRzGA0gASgDEhYKDmhlbHBfcmVwYXltZW50GA4gASgBEhIKCmxpdG9fY2VudHMYDyABKAMSDAoEbGl0bxgQIAEoARIXCg90b3RhbF90YXhfY2VudHMYESABKAMSEQoJdG90YWxfdGF4GBIgASgBEhYKDmVmZmVjdGl2ZV9yYXRlGBMgASgBEhwKFHJlZnVuZF9vcl9vd2VkX2NlbnRzGBQgASgDEhYKDnJlZnVuZF9vcl9vd2VkGBUgASgBEhoKEnRheF93aXRoaGVsZF9jZW50cxgWIAEoAxIUCgx0YXhfd2l0aGhlbGQYFyABKAEi/wEKEENhdGVnb3J5T3ZlcnJpZGUSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIbChNtZXJjaGFudF9ub3JtYWxpemVkGAMgASgJEjMKDXVzZXJfY2F0ZWdvcnkYBCABKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSGAoQY29ycmVjdGlvbl9jb3VudBgFIAEoBRIyCg5sYXN0X2NvcnJlY3RlZBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiugIKF1RheERlZHVjdGliaWxpdHlNYXBwaW5nEgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSGAoQbWVyY2hhbnRfcGF0dGVybhgDIAEoCRI9ChJkZWR1Y3Rpb25fY2F0ZWdvcnkYBCABKA4yIS5wZmluYW5jZS52MS5UYXhEZWR1Y3Rpb25DYXRlZ29yeRIaChJkZWR1Y3RpYmxlX3BlcmNlbnQYBSABKAESGgoSY29uZmlybWF0aW9uX2NvdW50GAYgASgFEhIKCmNvbmZpZGVuY2UYByABKAESLQoJbGFzdF91c2VkGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKFAwoSUG90ZW50aWFsRGVkdWN0aW9uEhIKCmV4cGVuc2VfaWQYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSDgoGYW1vdW50GAMgASgBEhQKDGFtb3VudF9jZW50cxgEIAEoAxIoCgRkYXRlGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCghjYXRlZ29yeRgGIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRJHChxzdWdnZXN0ZWRfZGVkdWN0aW9uX2NhdGVnb3J5GAcgASgOMiEucGZpbmFuY2UudjEuVGF4RGVkdWN0aW9uQ2F0ZWdvcnkSEgoKY29uZmlkZW5jZRgIIAEoARIRCglyZWFzb25pbmcYCSABKAkSGgoSZGVkdWN0aWJsZV9wZXJjZW50GAogASgBEh8KF3BvdGVudGlhbF9zYXZpbmdzX2NlbnRzGAsgASgDEhkKEXBvdGVudGlhbF9zYXZpbmdzGAwgASgBIqcCChFUYXhZZWFyQ29tcGFyaXNvbhIOCgZ5ZWFyX2EYASABKAkSDgoGeWVhcl9iGAIgASgJEjIKDWNhbGN1bGF0aW9uX2EYAyABKAsyGy5wZmluYW5jZS52MS5UYXhDYWxjdWxhdGlvbhIyCg1jYWxjdWxhdGlvbl9iGAQgASgLMhsucGZpbmFuY2UudjEuVGF4Q2FsY3VsYXRpb24SMwoPY2F0ZWdvcnlfZGVsdGFzGAUgAygLMhoucGZpbmFuY2UudjEuQ2F0ZWdvcnlEZWx0YRIbChNpbmNvbWVfY2hhbmdlX2NlbnRzGAYgASgDEh4KFmRlZHVjdGlvbl9jaGFuZ2VfY2VudHMYByABKAMSGAoQdGF4X2NoYW5nZV9jZW50cxgIIAEoAyKeAQoNQ2F0ZWdvcnlEZWx0YRIzCghjYXRlZ29yeRgBIAEoDjIhLnBmaW5hbmNlLnYxLlRheERlZHVjdGlvbkNhdGVnb3J5EhQKDHllYXJfYV9jZW50cxgCIAEoAxIUCgx5ZWFyX2JfY2VudHMYAyABKAMSFAoMY2hhbmdlX2NlbnRzGAQgASgDEhYKDmNoYW5nZV9wZXJjZW50GAUgASgBIuQBCg9CYW5rVHJhbnNhY3Rpb24SCgoCaWQYASABKAkSDAoEZGF0ZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIOCgZhbW91bnQYBCABKAESEAoIaXNfZGViaXQYBSABKAgSDwoHYmFsYW5jZRgGIAEoARISCgpjb25maWRlbmNlGAcgASgBEgwKBHBhZ2UYCCABKAUSNwoRZmllbGRfY29uZmlkZW5jZXMYCSABKAsyHC5wZmluYW5jZS52MS5GaWVsZENvbmZpZGVuY2USFAoMYW1vdW50X2NlbnRzGAogASgDIvgCChNCYW5rU3RhdGVtZW50UmVzdWx0EjIKDHRyYW5zYWN0aW9ucxgBIAMoCzIcLnBmaW5hbmNlLnYxLkJhbmtUcmFuc2FjdGlvbhIVCg1iYW5rX2RldGVjdGVkGAIgASgJEhIKCnBhZ2VfY291bnQYAyABKAUSEgoKY29uZmlkZW5jZRgEIAEoARIaChJiYWxhbmNlX3JlY29uY2lsZWQYBSABKAgSGgoScHJvY2Vzc2luZ190aW1lX21zGAYgASgFEhAKCHdhcm5pbmdzGAcgAygJEjoKEnN0YXRlbWVudF9tZXRhZGF0YRgIIAEoCzIeLnBmaW5hbmNlLnYxLlN0YXRlbWVudE1ldGFkYXRhEjIKC21ldGhvZF91c2VkGAkgASgOMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvbk1ldGhvZBI0Cg1mYWxsYmFja19mcm9tGAogASgOMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvbk1ldGhvZCruAgoPRXhwZW5zZUNhdGVnb3J5EiAKHEVYUEVOU0VfQ0FURUdPUllfVU5TUEVDSUZJRUQQABIZChVFWFBFTlNFX0NBVEVHT1JZX0ZPT0QQARIcChhFWFBFTlNFX0NBVEVHT1JZX0hPVVNJTkcQAhIjCh9FWFBFTlNFX0NBVEVHT1JZX1RSQU5TUE9SVEFUSU9OEAMSIgoeRVhQRU5TRV9DQVRFR09SWV9FTlRFUlRBSU5NRU5UEAQSHwobRVhQRU5TRV9DQVRFR09SWV9IRUFMVEhDQVJFEAUSHgoaRVhQRU5TRV9DQVRFR09SWV9VVElMSVRJRVMQBhIdChlFWFBFTlNFX0NBVEVHT1JZX1NIT1BQSU5HEAcSHgoaRVhQRU5TRV9DQVRFR09SWV9FRFVDQVRJT04QCBIbChdFWFBFTlNFX0NBVEVHT1JZX1RSQVZFTBAJEhoKFkVYUEVOU0VfQ0FURUdPUllfT1RIRVIQCiqPAgoQRXhwZW5zZUZyZXF1ZW5jeRIhCh1FWFBFTlNFX0ZSRVFVRU5DWV9VTlNQRUNJRklFRBAAEhoKFkVYUEVOU0VfRlJFUVVFTkNZX09OQ0UQARIbChdFWFBFTlNFX0ZSRVFVRU5DWV9EQUlMWRACEhwKGEVYUEVOU0VfRlJFUVVFTkNZX1dFRUtMWRADEiEKHUVYUEVOU0VfRlJFUVVFTkNZX0ZPUlROSUdIVExZEAQSHQoZRVhQRU5TRV9GUkVRVUVOQ1lfTU9OVEhMWRAFEh8KG0VYUEVOU0VfRlJFUVVFTkNZX1FVQVJURVJMWRAGEh4KGkVYUEVOU0VfRlJFUVVFTkNZX0FOTlVBTExZEAcqrwEKD0luY29tZUZyZXF1ZW5jeRIgChxJTkNPTUVfRlJFUVVFTkNZX1VOU1BFQ0lGSUVEEAASGwoXSU5DT01FX0ZSRVFVRU5DWV9XRUVLTFkQARIgChxJTkNPTUVfRlJFUVVFTkNZX0ZPUlROSUdIVExZEAISHAoYSU5DT01FX0ZSRVFVRU5DWV9NT05USExZEAMSHQoZSU5DT01FX0ZSRVFVRU5DWV9BTk5VQUxMWRAEKlgKCVRheFN0YXR1cxIaChZUQVhfU1RBVFVTX1VOU1BFQ0lGSUVEEAASFgoSVEFYX1NUQVRVU19QUkVfVEFYEAESFwoTVEFYX1NUQVRVU19QT1NUX1RBWBACKnAKClRheENvdW50cnkSGwoXVEFYX0NPVU5UUllfVU5TUEVDSUZJRUQQABIZChVUQVhfQ09VTlRSWV9BVVNUUkFMSUEQARISCg5UQVhfQ09VTlRSWV9VSxACEhYKElRBWF9DT1VOVFJZX1NJTVBMRRADKsYDChRUYXhEZWR1Y3Rpb25DYXRlZ29yeRImCiJUQVhfREVEVUNUSU9OX0NBVEVHT1JZX1VOU1BFQ0lGSUVEEAASJgoiVEFYX0RFRFVDVElPTl9DQVRFR09SWV9XT1JLX1RSQVZFTBABEiIKHlRBWF9ERURVQ1RJT05fQ0FURUdPUllfVU5JRk9STRACEikKJVRBWF9ERURVQ1RJT05fQ0FURUdPUllfU0VMRl9FRFVDQVRJT04QAxIlCiFUQVhfREVEVUNUSU9OX0NBVEVHT1JZX09USEVSX1dPUksQBBImCiJUQVhfREVEVUNUSU9OX0NBVEVHT1JZX0hPTUVfT0ZGSUNFEAUSIgoeVEFYX0RFRFVDVElPTl9DQVRFR09SWV9WRUhJQ0xFEAYSJAogVEFYX0RFRFVDVElPTl9DQVRFR09SWV9ET05BVElPTlMQBxImCiJUQVhfREVEVUNUSU9OX0NBVEVHT1JZX1RBWF9BRkZBSVJTEAgSLAooVEFYX0RFRFVDVElPTl9DQVRFR09SWV9JTkNPTUVfUFJPVEVDVElPThAJEiAKHFRBWF9ERURVQ1RJT05fQ0FURUdPUllfT1RIRVIQCipsChBTdWJzY3JpcHRpb25UaWVyEiEKHVNVQlNDUklQVElPTl9USUVSX1VOU1BFQ0lGSUVEEAASGgoWU1VCU0NSSVBUSU9OX1RJRVJfRlJFRRABEhkKFVNVQlNDUklQVElPTl9USUVSX1BSTxACKr8BChJTdWJzY3JpcHRpb25TdGF0dXMSIwofU1VCU0NSSVBUSU9OX1NUQVRVU19VTlNQRUNJRklFRBAAEh4KGlNVQlNDUklQVElPTl9TVEFUVVNfQUNUSVZFEAESIAocU1VCU0NSSVBUSU9OX1NUQVRVU19QQVNUX0RVRRACEiAKHFNVQlNDUklQVElPTl9TVEFUVVNfQ0FOQ0VMRUQQAxIgChxTVUJTQ1JJUFRJT05fU1RBVFVTX1RSSUFMSU5HEAQqhgEKCVNwbGl0VHlwZRIaChZTUExJVF9UWVBFX1VOU1BFQ0lGSUVEEAASFAoQU1BMSVRfVFlQRV9FUVVBTBABEhkKFVNQTElUX1RZUEVfUEVSQ0VOVEFHRRACEhUKEVNQTElUX1RZUEVfQU1PVU5UEAMSFQoRU1BMSVRfVFlQRV9TSEFSRVMQBCqBAQoJR3JvdXBSb2xlEhoKFkdST1VQX1JPTEVfVU5TUEVDSUZJRUQQABIVChFHUk9VUF9ST0xFX1ZJRVdFUhABEhUKEUdST1VQX1JPTEVfTUVNQkVSEAISFAoQR1JPVVBfUk9MRV9BRE1JThADEhQKEEdST1VQX1JPTEVfT1dORVIQBCqzAQoQSW52aXRhdGlvblN0YXR1cxIhCh1JTlZJVEFUSU9OX1NUQVRVU19VTlNQRUNJRklFRBAAEh0KGUlOVklUQVRJT05fU1RBVFVTX1BFTkRJTkcQARIeChpJTlZJVEFUSU9OX1NUQVRVU19BQ0NFUFRFRBACEh4KGklOVklUQVRJT05fU1RBVFVTX0RFQ0xJTkVEEAMSHQoZSU5WSVRBVElPTl9TVEFUVVNfRVhQSVJFRBAEKrgBCgxCdWRnZXRQZXJpb2QSHQoZQlVER0VUX1BFUklPRF9VTlNQRUNJRklFRBAAEhgKFEJVREdFVF9QRVJJT0RfV0VFS0xZEAESHQoZQlVER0VUX1BFUklPRF9GT1JUTklHSFRMWRACEhkKFUJVREdFVF9QRVJJT0RfTU9OVEhMWRADEhsKF0JVREdFVF9QRVJJT0RfUVVBUlRFUkxZEAQSGAoUQlVER0VUX1BFUklPRF9ZRUFSTFkQBSp1CghHb2FsVHlwZRIZChVHT0FMX1RZUEVfVU5TUEVDSUZJRUQQABIVChFHT0FMX1RZUEVfU0FWSU5HUxABEhkKFUdPQUxfVFlQRV9ERUJUX1BBWU9GRhACEhwKGEdPQUxfVFlQRV9TUEVORElOR19MSU1JVBADKo8BCgpHb2FsU3RhdHVzEhsKF0dPQUxfU1RBVFVTX1VOU1BFQ0lGSUVEEAASFgoSR09BTF9TVEFUVVNfQUNUSVZFEAESFgoSR09BTF9TVEFUVVNfUEFVU0VEEAISGQoVR09BTF9TVEFUVVNfQ09NUExFVEVEEAMSGQoVR09BTF9TVEFUVVNfQ0FOQ0VMTEVEEAQqxAEKGlJlY3VycmluZ1RyYW5zYWN0aW9uU3RhdHVzEiwKKFJFQ1VSUklOR19UUkFOU0FDVElPTl9TVEFUVVNfVU5TUEVDSUZJRUQQABInCiNSRUNVUlJJTkdfVFJBTlNBQ1RJT05fU1RBVFVTX0FDVElWRRABEicKI1JFQ1VSUklOR19UUkFOU0FDVElPTl9TVEFUVVNfUEFVU0VEEAISJgoiUkVDVVJSSU5HX1RSQU5TQUNUSU9OX1NUQVRVU19FTkRFRBADKpkCCgtJbnNpZ2h0VHlwZRIcChhJTlNJR0hUX1RZUEVfVU5TUEVDSUZJRUQQABIiCh5JTlNJR0hUX1RZUEVfU1BFTkRJTkdfSU5DUkVBU0UQARIiCh5JTlNJR0hUX1RZUEVfU1BFTkRJTkdfREVDUkVBU0UQAhIkCiBJTlNJR0hUX1RZUEVfVU5VU1VBTF9UUkFOU0FDVElPThADEh8KG0lOU0lHSFRfVFlQRV9DQVRFR09SWV9UUkVORBAEEhwKGElOU0lHSFRfVFlQRV9TQVZJTkdTX1RJUBAFEh8KG0lOU0lHSFRfVFlQRV9CVURHRVRfV0FSTklORxAGEh4KGklOU0lHSFRfVFlQRV9HT0FMX1BST0dSRVNTEAcqbgoPVHJhbnNhY3Rpb25UeXBlEiAKHFRSQU5TQUNUSU9OX1RZUEVfVU5TUEVDSUZJRUQQABIcChhUUkFOU0FDVElPTl9UWVBFX0VYUEVOU0UQARIbChdUUkFOU0FDVElPTl9UWVBFX0lOQ09NRRACKrEDChBOb3RpZmljYXRpb25UeXBlEiEKHU5PVElGSUNBVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASJgoiTk9USUZJQ0FUSU9OX1RZUEVfQlVER0VUX1RIUkVTSE9MRBABEiQKIE5PVElGSUNBVElPTl9UWVBFX0dPQUxfTUlMRVNUT05FEAISIwofTk9USUZJQ0FUSU9OX1RZUEVfQklMTF9SRU1JTkRFUhADEiYKIk5PVElGSUNBVElPTl9UWVBFX1VOVVNVQUxfU1BFTkRJTkcQBBIoCiROT1RJRklDQVRJT05fVFlQRV9TVUJTQ1JJUFRJT05fQUxFUlQQBRIcChhOT1RJRklDQVRJT05fVFlQRV9TWVNURU0QBhIpCiVOT1RJRklDQVRJT05fVFlQRV9FWFRSQUNUSU9OX0NPTVBMRVRFEAcSJAogTk9USUZJQ0FUSU9OX1RZUEVfR1JPVVBfQUNUSVZJVFkQCBIjCh9OT1RJRklDQVRJT05fVFlQRV9XRUVLTFlfRElHRVNUEAkSIQodTk9USUZJQ0FUSU9OX1RZUEVfVEFYX1NBVklOR1MQCiqFAQoMRG9jdW1lbnRUeXBlEh0KGURPQ1VNRU5UX1RZUEVfVU5TUEVDSUZJRUQQABIZChVET0NVTUVOVF9UWVBFX1JFQ0VJUFQQARIgChxET0NVTUVOVF9UWVBFX0JBTktfU1RBVEVNRU5UEAISGQoVRE9DVU1FTlRfVFlQRV9JTlZPSUNFEAMq4AEKEEV4dHJhY3Rpb25TdGF0dXMSIQodRVhUUkFDVElPTl9TVEFUVVNfVU5TUEVDSUZJRUQQABIdChlFWFRSQUNUSU9OX1NUQVRVU19QRU5ESU5HEAESIAocRVhUUkFDVElPTl9TVEFUVVNfUFJPQ0VTU0lORxACEh8KG0VYVFJBQ1RJT05fU1RBVFVTX0NPTVBMRVRFRBADEhwKGEVYVFJBQ1RJT05fU1RBVFVTX0ZBSUxFRBAEEikKJUVYVFJBQ1RJT05fU1RBVFVTX1ZBTElEQVRJT05fUkVRVUlSRUQQBSp2ChBFeHRyYWN0aW9uTWV0aG9kEiEKHUVYVFJBQ1RJT05fTUVUSE9EX1VOU1BFQ0lGSUVEEAASIQodRVhUUkFDVElPTl9NRVRIT0RfU0VMRl9IT1NURUQQARIcChhFWFRSQUNUSU9OX01FVEhPRF9HRU1JTkkQAipsCgtHcmFudWxhcml0eRIbChdHUkFOVUxBUklUWV9VTlNQRUNJRklFRBAAEhMKD0dSQU5VTEFSSVRZX0RBWRABEhQKEEdSQU5VTEFSSVRZX1dFRUsQAhIVChFHUkFOVUxBUklUWV9NT05USBADKq0BCgtBbm9tYWx5VHlwZRIcChhBTk9NQUxZX1RZUEVfVU5TUEVDSUZJRUQQABIfChtBTk9NQUxZX1RZUEVfQU1PVU5UX09VVExJRVIQARIdChlBTk9NQUxZX1RZUEVfTkVXX01FUkNIQU5UEAISHwobQU5PTUFMWV9UWVBFX1VOVVNVQUxfVElNSU5HEAMSHwobQU5PTUFMWV9UWVBFX0NBVEVHT1JZX1NQSUtFEAQqhQEKD0Fub21hbHlTZXZlcml0eRIgChxBTk9NQUxZX1NFVkVSSVRZX1VOU1BFQ0lGSUVEEAASGAoUQU5PTUFMWV9TRVZFUklUWV9MT1cQARIbChdBTk9NQUxZX1NFVkVSSVRZX01FRElVTRACEhkKFUFOT01BTFlfU0VWRVJJVFlfSElHSBADKuABChJXYXRlcmZhbGxFbnRyeVR5cGUSJAogV0FURVJGQUxMX0VOVFJZX1RZUEVfVU5TUEVDSUZJRUQQABIfChtXQVRFUkZBTExfRU5UUllfVFlQRV9JTkNPTUUQARIgChxXQVRFUkZBTExfRU5UUllfVFlQRV9FWFBFTlNFEAISHAoYV0FURVJGQUxMX0VOVFJZX1RZUEVfVEFYEAMSIAocV0FURVJGQUxMX0VOVFJZX1RZUEVfU0FWSU5HUxAEEiEKHVdBVEVSRkFMTF9FTlRSWV9UWVBFX1NVQlRPVEFMEAUq7QEKE0NvcnJlY3Rpb25GaWVsZFR5cGUSJQohQ09SUkVDVElPTl9GSUVMRF9UWVBFX1VOU1BFQ0lGSUVEEAASIAocQ09SUkVDVElPTl9GSUVMRF9UWVBFX0FNT1VOVBABEiIKHkNPUlJFQ1RJT05fRklFTERfVFlQRV9DQVRFR09SWRACEiUKIUNPUlJFQ1RJT05fRklFTERfVFlQRV9ERVNDUklQVElPThADEh4KGkNPUlJFQ1RJT05fRklFTERfVFlQRV9EQVRFEAQSIgoeQ09SUkVDVElPTl9GSUVMRF9UWVBFX01FUkNIQU5UEAVCrQEKD2NvbS5wZmluYW5jZS52MUIKVHlwZXNQcm90b1ABWkFnaXRodWIuY29tL2Nhc3RsZW1pbGsvcGZpbmFuY2UvYmFja2VuZC9nZW4vcGZpbmFuY2UvdjE7cGZpbmFuY2V2MaICA1BYWKoCC1BmaW5hbmNlLlYxygILUGZpbmFuY2VcVjHiAhdQZmluYW5jZVxWMVxHUEJNZXRhZGF0YeoCDFBmaW5hbmNlOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
+  fileDesc("ChdwZmluYW5jZS92MS90eXBlcy5wcm90bxILcGZpbmFuY2UudjEi3gIKBFVzZXISCgoCaWQYASABKAkSDQoFZW1haWwYAiABKAkSFAoMZGlzcGxheV9uYW1lGAMgASgJEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCXBob3RvX3VybBgGIAEoCRI4ChFzdWJzY3JpcHRpb25fdGllchgHIAEoDjIdLnBmaW5hbmNlLnYxLlN1YnNjcmlwdGlvblRpZXISPAoTc3Vic2NyaXB0aW9uX3N0YXR1cxgIIAEoDjIfLnBmaW5hbmNlLnYxLlN1YnNjcmlwdGlvblN0YXR1cxIaChJzdHJpcGVfY3VzdG9tZXJfaWQYCSABKAkSHgoWc3RyaXBlX3N1YnNjcmlwdGlvbl9pZBgKIAEoCSKZAgoIQXBpVG9rZW4SCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEhQKDHRva2VuX3ByZWZpeBgEIAEoCRISCgp0b2tlbl9oYXNoGAUgASgJEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDGxhc3RfdXNlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZXhwaXJlc19hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKaXNfcmV2b2tlZBgJIAEoCBISCgpyYXRlX2xpbWl0GAogASgFImwKDUF0dGFjaG1lbnRSZWYSFAoMc3RvcmFnZV9wYXRoGAEgASgJEhQKDGNvbnRlbnRfdHlwZRgCIAEoCRIvCgt1cGxvYWRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAirAEKEUV4cGVuc2VBbGxvY2F0aW9uEg8KB3VzZXJfaWQYASABKAkSDgoGYW1vdW50GAIgASgBEhIKCnBlcmNlbnRhZ2UYAyABKAESDgoGc2hhcmVzGAQgASgBEg8KB2lzX3BhaWQYBSABKAgSKwoHcGFpZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMYW1vdW50X2NlbnRzGAcgASgDIpUHCgdFeHBlbnNlEgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSEAoIZ3JvdXBfaWQYAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSDgoGYW1vdW50GAUgASgBEi4KCGNhdGVnb3J5GAYgASgOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5EjAKCWZyZXF1ZW5jeRgHIAEoDjIdLnBmaW5hbmNlLnYxLkV4cGVuc2VGcmVxdWVuY3kSKAoEZGF0ZRgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFwoPcGFpZF9ieV91c2VyX2lkGAsgASgJEioKCnNwbGl0X3R5cGUYDCABKA4yFi5wZmluYW5jZS52MS5TcGxpdFR5cGUSMwoLYWxsb2NhdGlvbnMYDSADKAsyHi5wZmluYW5jZS52MS5FeHBlbnNlQWxsb2NhdGlvbhISCgppc19zZXR0bGVkGA4gASgIEgwKBHRhZ3MYDyADKAkSFAoMYW1vdW50X2NlbnRzGBAgASgDEjgKEWV4dHJhY3Rpb25fbWV0aG9kGBEgASgOMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvbk1ldGhvZBIZChFpc190YXhfZGVkdWN0aWJsZRgSIAEoCBJBChZ0YXhfZGVkdWN0aW9uX2NhdGVnb3J5GBMgASgOMiEucGZpbmFuY2UudjEuVGF4RGVkdWN0aW9uQ2F0ZWdvcnkSGgoSdGF4X2RlZHVjdGlvbl9ub3RlGBQgASgJEh4KFnRheF9kZWR1Y3RpYmxlX3BlcmNlbnQYFSABKAESEwoLcmVjZWlwdF91cmwYFiABKAkSHAoUcmVjZWlwdF9zdG9yYWdlX3BhdGgYFyABKAkSLwoLYXR0YWNobWVudHMYGCADKAsyGi5wZmluYW5jZS52MS5BdHRhY2htZW50UmVmEgwKBG5vdGUYGSABKAkSEQoJZ3N0X2NlbnRzGBogASgDEg4KBmxvY2tlZBgbIAEoCBIVCg1sb2NrZWRfcmVhc29uGBwgASgJEhgKEHRheF9uZWVkc19yZXZpZXcYHSABKAgikwMKBkluY29tZRIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhAKCGdyb3VwX2lkGAMgASgJEg4KBnNvdXJjZRgEIAEoCRIOCgZhbW91bnQYBSABKAESLwoJZnJlcXVlbmN5GAYgASgOMhwucGZpbmFuY2UudjEuSW5jb21lRnJlcXVlbmN5EioKCnRheF9zdGF0dXMYByABKA4yFi5wZmluYW5jZS52MS5UYXhTdGF0dXMSKgoKZGVkdWN0aW9ucxgIIAMoCzIWLnBmaW5hbmNlLnYxLkRlZHVjdGlvbhIoCgRkYXRlGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxhbW91bnRfY2VudHMYDCABKAMSEQoJZ3N0X2NlbnRzGA0gASgDImYKCURlZHVjdGlvbhIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEg4KBmFtb3VudBgDIAEoARIZChFpc190YXhfZGVkdWN0aWJsZRgEIAEoCBIUCgxhbW91bnRfY2VudHMYBSABKAMiwwIKC1RheFNldHRpbmdzEhUKDWluY2x1ZGVfc3VwZXIYASABKAgSEgoKc3VwZXJfcmF0ZRgCIAEoARIYChBpbmNsdWRlX21lZGljYXJlGAMgASgIEhoKEm1lZGljYXJlX2V4ZW1wdGlvbhgEIAEoCBIdChVpbmNsdWRlX3Nlbmlvcl9vZmZzZXQYBSABKAgSHAoUaW5jbHVkZV9zdHVkZW50X2xvYW4YBiABKAgSGQoRc3R1ZGVudF9sb2FuX3JhdGUYByABKAESIgoaaW5jbHVkZV9kZXBlbmRlbnRfY2hpbGRyZW4YCCABKAgSFgoOaW5jbHVkZV9zcG91c2UYCSABKAgSHgoWaW5jbHVkZV9wcml2YXRlX2hlYWx0aBgKIAEoCBIfChdpbmNsdWRlX3ZvbHVudGFyeV9zdXBlchgLIAEoCCLBAQoJVGF4Q29uZmlnEg8KB2VuYWJsZWQYASABKAgSKAoHY291bnRyeRgCIAEoDjIXLnBmaW5hbmNlLnYxLlRheENvdW50cnkSEAoIdGF4X3JhdGUYAyABKAESGgoSaW5jbHVkZV9kZWR1Y3Rpb25zGAQgASgIEioKCHNldHRpbmdzGAUgASgLMhgucGZpbmFuY2UudjEuVGF4U2V0dGluZ3MSHwoXZmlzY2FsX3llYXJfc3RhcnRfbW9udGgYBiABKAUi7gEKDEZpbmFuY2VHcm91cBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhAKCG93bmVyX2lkGAQgASgJEhIKCm1lbWJlcl9pZHMYBSADKAkSKQoHbWVtYmVycxgGIAMoCzIYLnBmaW5hbmNlLnYxLkdyb3VwTWVtYmVyEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIrABCgtHcm91cE1lbWJlchIPCgd1c2VyX2lkGAEgASgJEg0KBWVtYWlsGAIgASgJEhQKDGRpc3BsYXlfbmFtZRgDIAEoCRIkCgRyb2xlGAQgASgOMhYucGZpbmFuY2UudjEuR3JvdXBSb2xlEi0KCWpvaW5lZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFgoOaW52aXRlX2xpbmtfaWQYBiABKAkijwIKD0dyb3VwSW52aXRhdGlvbhIKCgJpZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRISCgppbnZpdGVyX2lkGAMgASgJEhUKDWludml0ZWVfZW1haWwYBCABKAkSJAoEcm9sZRgFIAEoDjIWLnBmaW5hbmNlLnYxLkdyb3VwUm9sZRItCgZzdGF0dXMYBiABKA4yHS5wZmluYW5jZS52MS5JbnZpdGF0aW9uU3RhdHVzEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmV4cGlyZXNfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIvYDCgZCdWRnZXQSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIQCghncm91cF9pZBgDIAEoCRIMCgRuYW1lGAQgASgJEhMKC2Rlc2NyaXB0aW9uGAUgASgJEg4KBmFtb3VudBgGIAEoARIpCgZwZXJpb2QYByABKA4yGS5wZmluYW5jZS52MS5CdWRnZXRQZXJpb2QSMgoMY2F0ZWdvcnlfaWRzGAggAygOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5EhEKCWlzX2FjdGl2ZRgJIAEoCBIuCgpzdGFydF9kYXRlGAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfZGF0ZRgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMYW1vdW50X2NlbnRzGA4gASgDEhMKC3RlbXBsYXRlX2lkGA8gASgJEi8KDWNhdGVnb3J5X2NhcHMYECADKAsyGC5wZmluYW5jZS52MS5DYXRlZ29yeUNhcCJQCgtDYXRlZ29yeUNhcBIuCghjYXRlZ29yeRgBIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIRCgljYXBfY2VudHMYAiABKAMi+wIKD1JlY3VycmluZ0J1ZGdldBIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhAKCGdyb3VwX2lkGAMgASgJEgwKBG5hbWUYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSFAoMYW1vdW50X2NlbnRzGAYgASgDEikKBnBlcmlvZBgHIAEoDjIZLnBmaW5hbmNlLnYxLkJ1ZGdldFBlcmlvZBIyCgxjYXRlZ29yeV9pZHMYCCADKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSEQoJaXNfYWN0aXZlGAkgASgIEi4KCnN0YXJ0X2RhdGUYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIpUBCgtCdWRnZXRBbGVydBIKCgJpZBgBIAEoCRIRCglidWRnZXRfaWQYAiABKAkSHAoUdGhyZXNob2xkX3BlcmNlbnRhZ2UYAyABKAESEgoKaXNfZW5hYmxlZBgEIAEoCBI1ChFsYXN0X3RyaWdnZXJlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi0AMKDkJ1ZGdldFByb2dyZXNzEhEKCWJ1ZGdldF9pZBgBIAEoCRIYChBhbGxvY2F0ZWRfYW1vdW50GAIgASgBEhQKDHNwZW50X2Ftb3VudBgDIAEoARIYChByZW1haW5pbmdfYW1vdW50GAQgASgBEhcKD3BlcmNlbnRhZ2VfdXNlZBgFIAEoARIWCg5kYXlzX3JlbWFpbmluZxgGIAEoBRIwCgxwZXJpb2Rfc3RhcnQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnBlcmlvZF9lbmQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjkKEmNhdGVnb3J5X2JyZWFrZG93bhgJIAMoCzIdLnBmaW5hbmNlLnYxLkV4cGVuc2VCcmVha2Rvd24SHgoWYWxsb2NhdGVkX2Ftb3VudF9jZW50cxgKIAEoAxIaChJzcGVudF9hbW91bnRfY2VudHMYCyABKAMSHgoWcmVtYWluaW5nX2Ftb3VudF9jZW50cxgMIAEoAxI3Cg1jYXRlZ29yeV9jYXBzGA0gAygLMiAucGZpbmFuY2UudjEuQ2F0ZWdvcnlDYXBQcm9ncmVzcyKfAQoTQ2F0ZWdvcnlDYXBQcm9ncmVzcxIuCghjYXRlZ29yeRgBIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIRCgljYXBfY2VudHMYAiABKAMSEwoLc3BlbnRfY2VudHMYAyABKAMSFwoPcmVtYWluaW5nX2NlbnRzGAQgASgDEhcKD3BlcmNlbnRhZ2VfdXNlZBgFIAEoASJ8ChBFeHBlbnNlQnJlYWtkb3duEi4KCGNhdGVnb3J5GAEgASgOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5Eg4KBmFtb3VudBgCIAEoARISCgpwZXJjZW50YWdlGAMgASgBEhQKDGFtb3VudF9jZW50cxgEIAEoAyLeAQoNTWVtYmVyQmFsYW5jZRIPCgd1c2VyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEhIKCnRvdGFsX3BhaWQYAyABKAESEgoKdG90YWxfb3dlZBgEIAEoARIPCgdiYWxhbmNlGAUgASgBEiYKBWRlYnRzGAYgAygLMhcucGZpbmFuY2UudjEuTWVtYmVyRGVidBIYChB0b3RhbF9wYWlkX2NlbnRzGAcgASgDEhgKEHRvdGFsX293ZWRfY2VudHMYCCABKAMSFQoNYmFsYW5jZV9jZW50cxgJIAEoAyJzCgpNZW1iZXJEZWJ0EhQKDGZyb21fdXNlcl9pZBgBIAEoCRISCgp0b191c2VyX2lkGAIgASgJEg4KBmFtb3VudBgDIAEoARIVCg1leHBlbnNlX2NvdW50GAQgASgFEhQKDGFtb3VudF9jZW50cxgFIAEoAyJkChJTZXR0bGVtZW50VHJhbnNmZXISFAoMZnJvbV91c2VyX2lkGAEgASgJEhIKCnRvX3VzZXJfaWQYAiABKAkSDgoGYW1vdW50GAMgASgBEhQKDGFtb3VudF9jZW50cxgEIAEoAyLMAgoPR3JvdXBJbnZpdGVMaW5rEgoKAmlkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEgwKBGNvZGUYAyABKAkSEgoKY3JlYXRlZF9ieRgEIAEoCRIsCgxkZWZhdWx0X3JvbGUYBSABKA4yFi5wZmluYW5jZS52MS5Hcm91cFJvbGUSEAoIbWF4X3VzZXMYBiABKAUSFAoMY3VycmVudF91c2VzGAcgASgFEi4KCmV4cGlyZXNfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCWlzX2FjdGl2ZRgJIAEoCBIuCgpjcmVhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxsYXN0X3VzZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIsoCChNFeHBlbnNlQ29udHJpYnV0aW9uEgoKAmlkGAEgASgJEhkKEXNvdXJjZV9leHBlbnNlX2lkGAIgASgJEhcKD3RhcmdldF9ncm91cF9pZBgDIAEoCRIWCg5jb250cmlidXRlZF9ieRgEIAEoCRIOCgZhbW91bnQYBSABKAESKgoKc3BsaXRfdHlwZRgGIAEoDjIWLnBmaW5hbmNlLnYxLlNwbGl0VHlwZRIzCgthbGxvY2F0aW9ucxgHIAMoCzIeLnBmaW5hbmNlLnYxLkV4cGVuc2VBbGxvY2F0aW9uEiAKGGNyZWF0ZWRfZ3JvdXBfZXhwZW5zZV9pZBgIIAEoCRIyCg5jb250cmlidXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMYW1vdW50X2NlbnRzGAogASgDIuYBChJJbmNvbWVDb250cmlidXRpb24SCgoCaWQYASABKAkSGAoQc291cmNlX2luY29tZV9pZBgCIAEoCRIXCg90YXJnZXRfZ3JvdXBfaWQYAyABKAkSFgoOY29udHJpYnV0ZWRfYnkYBCABKAkSDgoGYW1vdW50GAUgASgBEh8KF2NyZWF0ZWRfZ3JvdXBfaW5jb21lX2lkGAYgASgJEjIKDmNvbnRyaWJ1dGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxhbW91bnRfY2VudHMYCCABKAMiigEKDUdvYWxNaWxlc3RvbmUSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIZChF0YXJnZXRfcGVyY2VudGFnZRgDIAEoARITCgtpc19hY2hpZXZlZBgEIAEoCBIvCgthY2hpZXZlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAijQUKDUZpbmFuY2lhbEdvYWwSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIQCghncm91cF9pZBgDIAEoCRIMCgRuYW1lGAQgASgJEhMKC2Rlc2NyaXB0aW9uGAUgASgJEigKCWdvYWxfdHlwZRgGIAEoDjIVLnBmaW5hbmNlLnYxLkdvYWxUeXBlEhUKDXRhcmdldF9hbW91bnQYByABKAESFgoOY3VycmVudF9hbW91bnQYCCABKAESLgoKc3RhcnRfZGF0ZRgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLwoLdGFyZ2V0X2RhdGUYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEicKBnN0YXR1cxgLIAEoDjIXLnBmaW5hbmNlLnYxLkdvYWxTdGF0dXMSMgoMY2F0ZWdvcnlfaWRzGAwgAygOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5EgwKBGljb24YDSABKAkSDQoFY29sb3IYDiABKAkSLgoKbWlsZXN0b25lcxgPIAMoCzIaLnBmaW5hbmNlLnYxLkdvYWxNaWxlc3RvbmUSLgoKY3JlYXRlZF9hdBgQIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgRIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGwoTdGFyZ2V0X2Ftb3VudF9jZW50cxgSIAEoAxIcChRjdXJyZW50X2Ftb3VudF9jZW50cxgTIAEoAxIrCghwcmlvcml0eRgUIAEoDjIZLnBmaW5hbmNlLnYxLkdvYWxQcmlvcml0eSL4AwoMR29hbFByb2dyZXNzEg8KB2dvYWxfaWQYASABKAkSFgoOY3VycmVudF9hbW91bnQYAiABKAESFQoNdGFyZ2V0X2Ftb3VudBgDIAEoARIbChNwZXJjZW50YWdlX2NvbXBsZXRlGAQgASgBEhYKDmRheXNfcmVtYWluaW5nGAUgASgFEhsKE3JlcXVpcmVkX2RhaWx5X3JhdGUYBiABKAESGQoRYWN0dWFsX2RhaWx5X3JhdGUYByABKAESEAoIb25fdHJhY2sYCCABKAgSNwoTYWNoaWV2ZWRfbWlsZXN0b25lcxgJIAMoCzIaLnBmaW5hbmNlLnYxLkdvYWxNaWxlc3RvbmUSMgoObmV4dF9taWxlc3RvbmUYCiABKAsyGi5wZmluYW5jZS52MS5Hb2FsTWlsZXN0b25lEhwKFGN1cnJlbnRfYW1vdW50X2NlbnRzGAsgASgDEhsKE3RhcmdldF9hbW91bnRfY2VudHMYDCABKAMSIQoZcmVxdWlyZWRfZGFpbHlfcmF0ZV9jZW50cxgNIAEoAxIfChdhY3R1YWxfZGFpbHlfcmF0ZV9jZW50cxgOIAEoAxI9ChJjYXRjaF91cF9zY2VuYXJpb3MYDyABKAsyIS5wZmluYW5jZS52MS5Hb2FsQ2F0Y2hVcFNjZW5hcmlvcyLvAQoUR29hbENhdGNoVXBTY2VuYXJpb3MSFwoPcmVtYWluaW5nX2NlbnRzGAEgASgDEhwKFHJlcXVpcmVkX2RhaWx5X2NlbnRzGAIgASgDEh0KFXJlcXVpcmVkX3dlZWtseV9jZW50cxgDIAEoAxIeChZyZXF1aXJlZF9tb250aGx5X2NlbnRzGAQgASgDEj0KGXByb2plY3RlZF9jb21wbGV0aW9uX2RhdGUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCWRheXNfbGF0ZRgGIAEoBRIPCgdvdmVyZHVlGAcgASgIIqgBChBHb2FsQ29udHJpYnV0aW9uEgoKAmlkGAEgASgJEg8KB2dvYWxfaWQYAiABKAkSDwoHdXNlcl9pZBgDIAEoCRIOCgZhbW91bnQYBCABKAESDAoEbm90ZRgFIAEoCRIyCg5jb250cmlidXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMYW1vdW50X2NlbnRzGAcgASgDIrQCCgxBY3Rpdml0eUl0ZW0SCgoCaWQYASABKAkSJwoEa2luZBgCIAEoDjIZLnBmaW5hbmNlLnYxLkFjdGl2aXR5S2luZBItCgl0aW1lc3RhbXAYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB3VzZXJfaWQYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSFAoMYW1vdW50X2NlbnRzGAYgASgDEiUKB2V4cGVuc2UYByABKAsyFC5wZmluYW5jZS52MS5FeHBlbnNlEiMKBmluY29tZRgIIAEoCzITLnBmaW5hbmNlLnYxLkluY29tZRI4ChFnb2FsX2NvbnRyaWJ1dGlvbhgJIAEoCzIdLnBmaW5hbmNlLnYxLkdvYWxDb250cmlidXRpb24ilwYKFFJlY3VycmluZ1RyYW5zYWN0aW9uEgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSEAoIZ3JvdXBfaWQYAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSDgoGYW1vdW50GAUgASgBEhQKDGFtb3VudF9jZW50cxgGIAEoAxIuCghjYXRlZ29yeRgHIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIwCglmcmVxdWVuY3kYCCABKA4yHS5wZmluYW5jZS52MS5FeHBlbnNlRnJlcXVlbmN5Ei4KCnN0YXJ0X2RhdGUYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjMKD25leHRfb2NjdXJyZW5jZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX2RhdGUYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjcKBnN0YXR1cxgMIAEoDjInLnBmaW5hbmNlLnYxLlJlY3VycmluZ1RyYW5zYWN0aW9uU3RhdHVzEhIKCmlzX2V4cGVuc2UYDSABKAgSLgoKY3JlYXRlZF9hdBgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgPIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDAoEdGFncxgQIAMoCRIXCg9wYWlkX2J5X3VzZXJfaWQYESABKAkSKgoKc3BsaXRfdHlwZRgSIAEoDjIWLnBmaW5hbmNlLnYxLlNwbGl0VHlwZRIzCgthbGxvY2F0aW9ucxgTIAMoCzIeLnBmaW5hbmNlLnYxLkV4cGVuc2VBbGxvY2F0aW9uEjcKE3NraXBwZWRfb2NjdXJyZW5jZXMYFCADKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhgKEG1pbl9hbW91bnRfY2VudHMYFSABKAMSGAoQbWF4X2Ftb3VudF9jZW50cxgWIAEoAyKcAgoPU3BlbmRpbmdJbnNpZ2h0EgoKAmlkGAEgASgJEiYKBHR5cGUYAiABKA4yGC5wZmluYW5jZS52MS5JbnNpZ2h0VHlwZRINCgV0aXRsZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRIQCghjYXRlZ29yeRgFIAEoCRIOCgZhbW91bnQYBiABKAESFgoOY2hhbmdlX3BlcmNlbnQYByABKAESDgoGcGVyaW9kGAggASgJEgwKBGljb24YCSABKAkSEwoLaXNfcG9zaXRpdmUYCiABKAgSLgoKY3JlYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMYW1vdW50X2NlbnRzGAwgASgDIs8BCgxTZWFyY2hSZXN1bHQSCgoCaWQYASABKAkSKgoEdHlwZRgCIAEoDjIcLnBmaW5hbmNlLnYxLlRyYW5zYWN0aW9uVHlwZRITCgtkZXNjcmlwdGlvbhgDIAEoCRIQCghjYXRlZ29yeRgEIAEoCRIOCgZhbW91bnQYBSABKAESFAoMYW1vdW50X2NlbnRzGAYgASgDEigKBGRhdGUYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGdyb3VwX2lkGAggASgJIpgDChREZXRlY3RlZFN1YnNjcmlwdGlvbhIVCg1tZXJjaGFudF9uYW1lGAEgASgJEhcKD25vcm1hbGl6ZWRfbmFtZRgCIAEoCRIQCghjYXRlZ29yeRgDIAEoCRIWCg5hdmVyYWdlX2Ftb3VudBgEIAEoARIcChRhdmVyYWdlX2Ftb3VudF9jZW50cxgFIAEoAxI5ChJkZXRlY3RlZF9mcmVxdWVuY3kYBiABKA4yHS5wZmluYW5jZS52MS5FeHBlbnNlRnJlcXVlbmN5EhgKEGNvbmZpZGVuY2Vfc2NvcmUYByABKAESGAoQb2NjdXJyZW5jZV9jb3VudBgIIAEoBRItCglsYXN0X3NlZW4YCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjEKDWV4cGVjdGVkX25leHQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhoKEmlzX2FscmVhZHlfdHJhY2tlZBgLIAEoCBIbChNtYXRjaGVkX2V4cGVuc2VfaWRzGAwgAygJIrICChpSZWN1cnJpbmdQYXR0ZXJuU3VnZ2VzdGlvbhJAChVyZWN1cnJpbmdfdHJhbnNhY3Rpb24YASABKAsyIS5wZmluYW5jZS52MS5SZWN1cnJpbmdUcmFuc2FjdGlvbhIwCglmcmVxdWVuY3kYAiABKA4yHS5wZmluYW5jZS52MS5FeHBlbnNlRnJlcXVlbmN5EhwKFHR5cGljYWxfYW1vdW50X2NlbnRzGAMgASgDEjcKE25leHRfcHJlZGljdGVkX2RhdGUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhIKCmNvbmZpZGVuY2UYBSABKAESGAoQb2NjdXJyZW5jZV9jb3VudBgGIAEoBRIbChNtYXRjaGVkX2V4cGVuc2VfaWRzGAcgAygJIqcDCgxOb3RpZmljYXRpb24SCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIrCgR0eXBlGAMgASgOMh0ucGZpbmFuY2UudjEuTm90aWZpY2F0aW9uVHlwZRINCgV0aXRsZRgEIAEoCRIPCgdtZXNzYWdlGAUgASgJEg8KB2lzX3JlYWQYBiABKAgSEgoKYWN0aW9uX3VybBgHIAEoCRIUCgxyZWZlcmVuY2VfaWQYCCABKAkSFgoOcmVmZXJlbmNlX3R5cGUYCSABKAkSLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKwoHcmVhZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOQoIbWV0YWRhdGEYDCADKAsyJy5wZmluYW5jZS52MS5Ob3RpZmljYXRpb24uTWV0YWRhdGFFbnRyeRIRCglkZWR1cF9rZXkYDSABKAkaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBImAKFE5vdGlmaWNhdGlvbkRheUNvdW50EgwKBGRhdGUYASABKAkSKwoEdHlwZRgCIAEoDjIdLnBmaW5hbmNlLnYxLk5vdGlmaWNhdGlvblR5cGUSDQoFY291bnQYAyABKAUihAMKF05vdGlmaWNhdGlvblByZWZlcmVuY2VzEg8KB3VzZXJfaWQYASABKAkSFQoNYnVkZ2V0X2FsZXJ0cxgCIAEoCBIXCg9nb2FsX21pbGVzdG9uZXMYAyABKAgSFgoOYmlsbF9yZW1pbmRlcnMYBCABKAgSGAoQdW51c3VhbF9zcGVuZGluZxgFIAEoCBIbChNzdWJzY3JpcHRpb25fYWxlcnRzGAYgASgIEhUKDXdlZWtseV9kaWdlc3QYByABKAgSGgoSYmlsbF9yZW1pbmRlcl9kYXlzGAggASgFEhQKDHB1c2hfZW5hYmxlZBgJIAEoCBIRCglmY21fdG9rZW4YCiABKAkSHwoXbW9udGhseV9zcGVuZF9jYXBfY2VudHMYCyABKAMSHgoWYnVkZ2V0X3BhY2VfbWFyZ2luX3BjdBgMIAEoARIiChVzdWJzdGFudGlhdGlvbl9hbGVydHMYDSABKAhIAIgBAUIYChZfc3Vic3RhbnRpYXRpb25fYWxlcnRzIoADChRFeHRyYWN0ZWRUcmFuc2FjdGlvbhIKCgJpZBgBIAEoCRIMCgRkYXRlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhsKE25vcm1hbGl6ZWRfbWVyY2hhbnQYBCABKAkSDgoGYW1vdW50GAUgASgBEjgKEnN1Z2dlc3RlZF9jYXRlZ29yeRgGIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRISCgpjb25maWRlbmNlGAcgASgBEhAKCGlzX2RlYml0GAggASgIEhEKCXJlZmVyZW5jZRgJIAEoCRIyCgpsaW5lX2l0ZW1zGAogAygLMh4ucGZpbmFuY2UudjEuRXh0cmFjdGVkTGluZUl0ZW0SFAoMYW1vdW50X2NlbnRzGAsgASgDEjcKEWZpZWxkX2NvbmZpZGVuY2VzGAwgASgLMhwucGZpbmFuY2UudjEuRmllbGRDb25maWRlbmNlEhYKDnVzZXJfY29uZmlybWVkGA0gASgIIpABChFFeHRyYWN0ZWRMaW5lSXRlbRITCgtkZXNjcmlwdGlvbhgBIAEoCRIOCgZhbW91bnQYAiABKAESEAoIcXVhbnRpdHkYAyABKAUSLgoIY2F0ZWdvcnkYBCABKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSFAoMYW1vdW50X2NlbnRzGAUgASgDImgKD0ZpZWxkQ29uZmlkZW5jZRIOCgZhbW91bnQYASABKAESDAoEZGF0ZRgCIAEoARITCgtkZXNjcmlwdGlvbhgDIAEoARIQCghtZXJjaGFudBgEIAEoARIQCghjYXRlZ29yeRgFIAEoASKZAQoVRXh0cmFjdGlvbkVycm9yRGV0YWlsEgwKBGNvZGUYASABKAkSDwoHbWVzc2FnZRgCIAEoCRIRCglyZXRyeWFibGUYAyABKAgSGAoQc3VnZ2VzdGVkX2FjdGlvbhgEIAEoCRI0Cg1mYWlsZWRfbWV0aG9kGAUgASgOMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvbk1ldGhvZCLVBAoQRXh0cmFjdGlvblJlc3VsdBI3Cgx0cmFuc2FjdGlvbnMYASADKAsyIS5wZmluYW5jZS52MS5FeHRyYWN0ZWRUcmFuc2FjdGlvbhIaChJvdmVyYWxsX2NvbmZpZGVuY2UYAiABKAESEgoKbW9kZWxfdXNlZBgDIAEoCRIaChJwcm9jZXNzaW5nX3RpbWVfbXMYBCABKAUSEAoId2FybmluZ3MYBSADKAkSMAoNZG9jdW1lbnRfdHlwZRgGIAEoDjIZLnBmaW5hbmNlLnYxLkRvY3VtZW50VHlwZRISCgpwYWdlX2NvdW50GAcgASgFEkAKFXJlamVjdGVkX3RyYW5zYWN0aW9ucxgIIAMoCzIhLnBmaW5hbmNlLnYxLkV4dHJhY3RlZFRyYW5zYWN0aW9uEjIKC21ldGhvZF91c2VkGAkgASgOMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvbk1ldGhvZBI0Cg1mYWxsYmFja19mcm9tGAogASgOMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvbk1ldGhvZBI6ChJzdGF0ZW1lbnRfbWV0YWRhdGEYCyABKAsyHi5wZmluYW5jZS52MS5TdGF0ZW1lbnRNZXRhZGF0YRIiChVsb3dfd2FybmluZ190aHJlc2hvbGQYDCABKAFIAIgBARIjChZwcmVfZGVzZWxlY3RfdGhyZXNob2xkGA0gASgBSAGIAQFCGAoWX2xvd193YXJuaW5nX3RocmVzaG9sZEIZChdfcHJlX2Rlc2VsZWN0X3RocmVzaG9sZCKuAQoRU3RhdGVtZW50TWV0YWRhdGESEQoJYmFua19uYW1lGAEgASgJEhoKEmFjY291bnRfaWRlbnRpZmllchgCIAEoCRIUCgxwZXJpb2Rfc3RhcnQYAyABKAkSEgoKcGVyaW9kX2VuZBgEIAEoCRIZChF0cmFuc2FjdGlvbl9jb3VudBgFIAEoBRIQCghjdXJyZW5jeRgGIAEoCRITCgtmaW5nZXJwcmludBgHIAEoCSLDAgoSUHJvY2Vzc2VkU3RhdGVtZW50EgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSEwoLZmluZ2VycHJpbnQYAyABKAkSEQoJYmFua19uYW1lGAQgASgJEhoKEmFjY291bnRfaWRlbnRpZmllchgFIAEoCRIUCgxwZXJpb2Rfc3RhcnQYBiABKAkSEgoKcGVyaW9kX2VuZBgHIAEoCRIWCg5pbXBvcnRlZF9jb3VudBgIIAEoBRIwCgxwcm9jZXNzZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhkKEW9yaWdpbmFsX2ZpbGVuYW1lGAogASgJEh0KFXN0YXRlbWVudF9zdG9yYWdlX3VybBgLIAEoCRIeChZzdGF0ZW1lbnRfc3RvcmFnZV9wYXRoGAwgASgJIt0DCg1FeHRyYWN0aW9uSm9iEgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSLQoGc3RhdHVzGAMgASgOMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvblN0YXR1cxIwCg1kb2N1bWVudF90eXBlGAQgASgOMhkucGZpbmFuY2UudjEuRG9jdW1lbnRUeXBlEhkKEW9yaWdpbmFsX2ZpbGVuYW1lGAUgASgJEi0KBnJlc3VsdBgGIAEoCzIdLnBmaW5hbmNlLnYxLkV4dHJhY3Rpb25SZXN1bHQSFQoNZXJyb3JfbWVzc2FnZRgHIAEoCRIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxjb21wbGV0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhMKC3RvdGFsX3BhZ2VzGAogASgFEhcKD3Byb2Nlc3NlZF9wYWdlcxgLIAEoBRIUCgxjdXJyZW50X3BhZ2UYDCABKAUSGAoQcHJvZ3Jlc3NfcGVyY2VudBgNIAEoARItCgZtZXRob2QYDiABKA4yHS5wZmluYW5jZS52MS5FeHRyYWN0aW9uTWV0aG9kIqcBChBWYWxpZGF0aW9uUmVzdWx0EhAKCGFjY3VyYWN5GAEgASgBEjkKDWRpc2NyZXBhbmNpZXMYAiADKAsyIi5wZmluYW5jZS52MS5WYWxpZGF0aW9uRGlzY3JlcGFuY3kSFAoMdmFsaWRhdGVkX2J5GAMgASgJEjAKDHZhbGlkYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAicAoVVmFsaWRhdGlvbkRpc2NyZXBhbmN5Eg0KBWZpZWxkGAEgASgJEhcKD2V4dHJhY3RlZF92YWx1ZRgCIAEoCRIXCg92YWxpZGF0ZWRfdmFsdWUYAyABKAkSFgoOdHJhbnNhY3Rpb25faWQYBCABKAki7AEKDkRhaWx5QWdncmVnYXRlEgwKBGRhdGUYASABKAkSFAoMdG90YWxfYW1vdW50GAIgASgBEhoKEnRvdGFsX2Ftb3VudF9jZW50cxgDIAEoAxIZChF0cmFuc2FjdGlvbl9jb3VudBgEIAEoBRI1ChBjYXRlZ29yeV9hbW91bnRzGAUgAygLMhsucGZpbmFuY2UudjEuQ2F0ZWdvcnlBbW91bnQSFQoNaW5jb21lX2Ftb3VudBgGIAEoARIbChNpbmNvbWVfYW1vdW50X2NlbnRzGAcgASgDEhQKDGluY29tZV9jb3VudBgIIAEoBSJ1Cg5DYXRlZ29yeUFtb3VudBIuCghjYXRlZ29yeRgBIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIOCgZhbW91bnQYAiABKAESFAoMYW1vdW50X2NlbnRzGAMgASgDEg0KBWNvdW50GAQgASgFIlYKE1RpbWVTZXJpZXNEYXRhUG9pbnQSDAoEZGF0ZRgBIAEoCRINCgV2YWx1ZRgCIAEoARITCgt2YWx1ZV9jZW50cxgDIAEoAxINCgVsYWJlbBgEIAEoCSLNAgoQQ2F0ZWdvcnlTcGVuZGluZxIuCghjYXRlZ29yeRgBIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIWCg5jdXJyZW50X2Ftb3VudBgCIAEoARIcChRjdXJyZW50X2Ftb3VudF9jZW50cxgDIAEoAxIXCg9wcmV2aW91c19hbW91bnQYBCABKAESHQoVcHJldmlvdXNfYW1vdW50X2NlbnRzGAUgASgDEhUKDWJ1ZGdldF9hbW91bnQYBiABKAESGwoTYnVkZ2V0X2Ftb3VudF9jZW50cxgHIAEoAxIWCg5jaGFuZ2VfcGVyY2VudBgIIAEoARINCgVsYWJlbBgJIAEoCRIQCghpc190b3RhbBgKIAEoCBIuChB0b3BfdHJhbnNhY3Rpb25zGAsgAygLMhQucGZpbmFuY2UudjEuRXhwZW5zZSK4AwoPU3BlbmRpbmdBbm9tYWx5EgoKAmlkGAEgASgJEhIKCmV4cGVuc2VfaWQYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDgoGYW1vdW50GAQgASgBEhQKDGFtb3VudF9jZW50cxgFIAEoAxIuCghjYXRlZ29yeRgGIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIoCgRkYXRlGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgd6X3Njb3JlGAggASgBEhcKD2V4cGVjdGVkX2Ftb3VudBgJIAEoARIdChVleHBlY3RlZF9hbW91bnRfY2VudHMYCiABKAMSLgoMYW5vbWFseV90eXBlGAsgASgOMhgucGZpbmFuY2UudjEuQW5vbWFseVR5cGUSLgoIc2V2ZXJpdHkYDCABKA4yHC5wZmluYW5jZS52MS5Bbm9tYWx5U2V2ZXJpdHkSEwoLZXhwbGFuYXRpb24YDSABKAkSMgoKY29tcGFyaXNvbhgOIAEoCzIeLnBmaW5hbmNlLnYxLkFub21hbHlDb21wYXJpc29uIqcBChFBbm9tYWx5Q29tcGFyaXNvbhIWCg50eXBpY2FsX2Ftb3VudBgBIAEoARIcChR0eXBpY2FsX2Ftb3VudF9jZW50cxgCIAEoAxIOCgZhbW91bnQYAyABKAESFAoMYW1vdW50X2NlbnRzGAQgASgDEhIKCnBlcmNlbnRpbGUYBSABKAESEAoIc3RkX2RldnMYBiABKAESEAoIbXVsdGlwbGUYByABKAEipwIKEENhdGVnb3J5QmFzZWxpbmUSDwoHdXNlcl9pZBgBIAEoCRIuCghjYXRlZ29yeRgCIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIOCgZtZWRpYW4YAyABKAESIQoZbWVkaWFuX2Fic29sdXRlX2RldmlhdGlvbhgEIAEoARIUCgxzYW1wbGVfY291bnQYBSABKAUSHAoUcmVjZW50X2Ftb3VudHNfY2VudHMYBiADKAMSOwoXbGFzdF9leHBlbnNlX2NyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIr8BCg1Gb3JlY2FzdFBvaW50EgwKBGRhdGUYASABKAkSEQoJcHJlZGljdGVkGAIgASgBEhcKD3ByZWRpY3RlZF9jZW50cxgDIAEoAxITCgtsb3dlcl9ib3VuZBgEIAEoARIZChFsb3dlcl9ib3VuZF9jZW50cxgFIAEoAxITCgt1cHBlcl9ib3VuZBgGIAEoARIZChF1cHBlcl9ib3VuZF9jZW50cxgHIAEoAxIUCgxpc19yZWN1cnJpbmcYCCABKAgi3AEKDldhdGVyZmFsbEVudHJ5Eg0KBWxhYmVsGAEgASgJEg4KBmFtb3VudBgCIAEoARIUCgxhbW91bnRfY2VudHMYAyABKAMSMwoKZW50cnlfdHlwZRgEIAEoDjIfLnBmaW5hbmNlLnYxLldhdGVyZmFsbEVudHJ5VHlwZRIVCg1ydW5uaW5nX3RvdGFsGAUgASgBEhsKE3J1bm5pbmdfdG90YWxfY2VudHMYBiABKAMSFgoObWVtYmVyX3VzZXJfaWQYByABKAkSFAoMaXNfcHJvamVjdGVkGAggASgIIpICChRCdWRnZXRSZWNvbW1lbmRhdGlvbhIuCghjYXRlZ29yeRgBIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIYChBzdWdnZXN0ZWRfYW1vdW50GAIgASgBEh4KFnN1Z2dlc3RlZF9hbW91bnRfY2VudHMYAyABKAMSHgoWYXZlcmFnZV9tb250aGx5X2Ftb3VudBgEIAEoARIkChxhdmVyYWdlX21vbnRobHlfYW1vdW50X2NlbnRzGAUgASgDEhwKFG1vbnRoc193aXRoX3NwZW5kaW5nGAYgASgFEhkKEWV4Y2x1ZGVkX291dGxpZXJzGAcgASgFEhEKCXJhdGlvbmFsZRgIIAEoCSJXCgtUYWdTcGVuZGluZxILCgN0YWcYASABKAkSDgoGYW1vdW50GAIgASgBEhQKDGFtb3VudF9jZW50cxgDIAEoAxIVCg1leHBlbnNlX2NvdW50GAQgASgFInMKD0ZpZWxkQ29ycmVjdGlvbhIvCgVmaWVsZBgBIAEoDjIgLnBmaW5hbmNlLnYxLkNvcnJlY3Rpb25GaWVsZFR5cGUSFgoOb3JpZ2luYWxfdmFsdWUYAiABKAkSFwoPY29ycmVjdGVkX3ZhbHVlGAMgASgJIsIDChBDb3JyZWN0aW9uUmVjb3JkEgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSFQoNZXh0cmFjdGlvbl9pZBgDIAEoCRIWCg50cmFuc2FjdGlvbl9pZBgEIAEoCRIxCgtjb3JyZWN0aW9ucxgFIAMoCzIcLnBmaW5hbmNlLnYxLkZpZWxkQ29ycmVjdGlvbhIZChFvcmlnaW5hbF9tZXJjaGFudBgGIAEoCRIaChJjb3JyZWN0ZWRfbWVyY2hhbnQYByABKAkSNwoRb3JpZ2luYWxfY2F0ZWdvcnkYCCABKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSOAoSY29ycmVjdGVkX2NhdGVnb3J5GAkgASgOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5EhsKE29yaWdpbmFsX2NvbmZpZGVuY2UYCiABKAESLgoKY3JlYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOAoRZXh0cmFjdGlvbl9tZXRob2QYDCABKA4yHS5wZmluYW5jZS52MS5FeHRyYWN0aW9uTWV0aG9kItoBCg9EYXRhQ2xlYXJSZWNvcmQSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIuCgpjbGVhcmVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIVCg1leHBlbnNlX2NvdW50GAQgASgDEhQKDGluY29tZV9jb3VudBgFIAEoAxIUCgxidWRnZXRfY291bnQYBiABKAMSEgoKZ29hbF9jb3VudBgHIAEoAxIjChtyZWN1cnJpbmdfdHJhbnNhY3Rpb25fY291bnQYCCABKAMimQIKD01lcmNoYW50TWFwcGluZxIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhMKC3Jhd19wYXR0ZXJuGAMgASgJEhcKD25vcm1hbGl6ZWRfbmFtZRgEIAEoCRIuCghjYXRlZ29yeRgFIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIYChBjb3JyZWN0aW9uX2NvdW50GAYgASgFEhIKCmNvbmZpZGVuY2UYByABKAESLQoJbGFzdF91c2VkGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLbAgoPRXh0cmFjdGlvbkV2ZW50EgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSLQoGbWV0aG9kGAMgASgOMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvbk1ldGhvZBIZChF0cmFuc2FjdGlvbl9jb3VudBgEIAEoBRIWCg5hY2NlcHRlZF9jb3VudBgFIAEoBRIWCg5yZWplY3RlZF9jb3VudBgGIAEoBRIXCg9jb3JyZWN0ZWRfY291bnQYByABKAUSGgoSb3ZlcmFsbF9jb25maWRlbmNlGAggASgBEhoKEnByb2Nlc3NpbmdfdGltZV9tcxgJIAEoBRIwCg1kb2N1bWVudF90eXBlGAogASgOMhkucGZpbmFuY2UudjEuRG9jdW1lbnRUeXBlEi4KCmNyZWF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wItUBChJEdXBsaWNhdGVDYW5kaWRhdGUSGwoTZXhpc3RpbmdfZXhwZW5zZV9pZBgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRIOCgZhbW91bnQYAyABKAESFAoMYW1vdW50X2NlbnRzGAQgASgDEgwKBGRhdGUYBSABKAkSLgoIY2F0ZWdvcnkYBiABKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSEwoLbWF0Y2hfc2NvcmUYByABKAESFAoMbWF0Y2hfcmVhc29uGAggASgJIowBChNUYXhEZWR1Y3Rpb25TdW1tYXJ5EjMKCGNhdGVnb3J5GAEgASgOMiEucGZpbmFuY2UudjEuVGF4RGVkdWN0aW9uQ2F0ZWdvcnkSEwoLdG90YWxfY2VudHMYAiABKAMSFAoMdG90YWxfYW1vdW50GAMgASgBEhUKDWV4cGVuc2VfY291bnQYBCABKAUi5wYKDlRheENhbGN1bGF0aW9uEhYKDmZpbmFuY2lhbF95ZWFyGAEgASgJEhoKEmdyb3NzX2luY29tZV9jZW50cxgCIAEoAxIUCgxncm9zc19pbmNvbWUYAyABKAESNAoKZGVkdWN0aW9ucxgEIAMoCzIgLnBmaW5hbmNlLnYxLlRheERlZHVjdGlvblN1bW1hcnkSHgoWdG90YWxfZGVkdWN0aW9uc19jZW50cxgFIAEoAxIYChB0b3RhbF9kZWR1Y3Rpb25zGAYgASgBEhwKFHRheGFibGVfaW5jb21lX2NlbnRzGAcgASgDEhYKDnRheGFibGVfaW5jb21lGAggASgBEhYKDmJhc2VfdGF4X2NlbnRzGAkgASgDEhAKCGJhc2VfdGF4GAogASgBEhsKE21lZGljYXJlX2xldnlfY2VudHMYCyABKAMSFQoNbWVkaWNhcmVfbGV2eRgMIAEoARIcChRoZWxwX3JlcGF5bWVudF9jZW50cxgNIAEoAxIWCg5oZWxwX3JlcGF5bWVudBgOIAEoARISCgpsaXRvX2NlbnRzGA8gASgDEgwKBGxpdG8YECABKAESFwoPdG90YWxfdGF4X2NlbnRzGBEgASgDEhEKCXRvdGFsX3RheBgSIAEoARIWCg5lZmZlY3RpdmVfcmF0ZRgTIAEoARIcChRyZWZ1bmRfb3Jfb3dlZF9jZW50cxgUIAEoAxIWCg5yZWZ1bmRfb3Jfb3dlZBgVIAEoARIaChJ0YXhfd2l0aGhlbGRfY2VudHMYFiABKAMSFAoMdGF4X3dpdGhoZWxkGBcgASgBEiIKGmxvc3NfY2FycmllZF9mb3J3YXJkX2NlbnRzGBggASgDEhwKFGxvc3NfY2FycmllZF9mb3J3YXJkGBkgASgBEhkKEXVudXNlZF9sb3NzX2NlbnRzGBogASgDEhMKC3VudXNlZF9sb3NzGBsgASgBEjwKEndpdGhoZWxkX2J5X3NvdXJjZRgcIAMoCzIgLnBmaW5hbmNlLnYxLldpdGhoZWxkVGF4QnlTb3VyY2USFwoPaXNfbm9uX3Jlc2lkZW50GB0gASgIEh8KF25ldF9jYXBpdGFsX2dhaW5zX2NlbnRzGB4gASgDEhkKEW5ldF9jYXBpdGFsX2dhaW5zGB8gASgBImUKE1dpdGhoZWxkVGF4QnlTb3VyY2USDgoGc291cmNlGAEgASgJEhYKDndpdGhoZWxkX2NlbnRzGAIgASgDEhAKCHdpdGhoZWxkGAMgASgBEhQKDGluY29tZV9jb3VudBgEIAEoBSL/AQoQQ2F0ZWdvcnlPdmVycmlkZRIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhsKE21lcmNoYW50X25vcm1hbGl6ZWQYAyABKAkSMwoNdXNlcl9jYXRlZ29yeRgEIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIYChBjb3JyZWN0aW9uX2NvdW50GAUgASgFEjIKDmxhc3RfY29ycmVjdGVkGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCK6AgoXVGF4RGVkdWN0aWJpbGl0eU1hcHBpbmcSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIYChBtZXJjaGFudF9wYXR0ZXJuGAMgASgJEj0KEmRlZHVjdGlvbl9jYXRlZ29yeRgEIAEoDjIhLnBmaW5hbmNlLnYxLlRheERlZHVjdGlvbkNhdGVnb3J5EhoKEmRlZHVjdGlibGVfcGVyY2VudBgFIAEoARIaChJjb25maXJtYXRpb25fY291bnQYBiABKAUSEgoKY29uZmlkZW5jZRgHIAEoARItCglsYXN0X3VzZWQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIukBChlUYXhEZWR1Y3Rpb25DaGVja2xpc3RJdGVtEjMKCGNhdGVnb3J5GAEgASgOMiEucGZpbmFuY2UudjEuVGF4RGVkdWN0aW9uQ2F0ZWdvcnkSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSGwoTb2NjdXBhdGlvbl9zcGVjaWZpYxgEIAEoCBIUCgxoYXNfZXhwZW5zZXMYBSABKAgSFQoNZXhwZW5zZV9jb3VudBgGIAEoBRITCgt0b3RhbF9jZW50cxgHIAEoAxIUCgx0b3RhbF9hbW91bnQYCCABKAEihQMKElBvdGVudGlhbERlZHVjdGlvbhISCgpleHBlbnNlX2lkGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEg4KBmFtb3VudBgDIAEoARIUCgxhbW91bnRfY2VudHMYBCABKAMSKAoEZGF0ZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoIY2F0ZWdvcnkYBiABKA4yHC5wZmluYW5jZS52MS5FeHBlbnNlQ2F0ZWdvcnkSRwocc3VnZ2VzdGVkX2RlZHVjdGlvbl9jYXRlZ29yeRgHIAEoDjIhLnBmaW5hbmNlLnYxLlRheERlZHVjdGlvbkNhdGVnb3J5EhIKCmNvbmZpZGVuY2UYCCABKAESEQoJcmVhc29uaW5nGAkgASgJEhoKEmRlZHVjdGlibGVfcGVyY2VudBgKIAEoARIfChdwb3RlbnRpYWxfc2F2aW5nc19jZW50cxgLIAEoAxIZChFwb3RlbnRpYWxfc2F2aW5ncxgMIAEoASLrAgoRVGF4WWVhckNvbXBhcmlzb24SDgoGeWVhcl9hGAEgASgJEg4KBnllYXJfYhgCIAEoCRIyCg1jYWxjdWxhdGlvbl9hGAMgASgLMhsucGZpbmFuY2UudjEuVGF4Q2FsY3VsYXRpb24SMgoNY2FsY3VsYXRpb25fYhgEIAEoCzIbLnBmaW5hbmNlLnYxLlRheENhbGN1bGF0aW9uEjMKD2NhdGVnb3J5X2RlbHRhcxgFIAMoCzIaLnBmaW5hbmNlLnYxLkNhdGVnb3J5RGVsdGESGwoTaW5jb21lX2NoYW5nZV9jZW50cxgGIAEoAxIeChZkZWR1Y3Rpb25fY2hhbmdlX2NlbnRzGAcgASgDEhgKEHRheF9jaGFuZ2VfY2VudHMYCCABKAMSIwobdGF4YWJsZV9pbmNvbWVfY2hhbmdlX2NlbnRzGAkgASgDEh0KFWVmZmVjdGl2ZV9yYXRlX2NoYW5nZRgKIAEoASKeAQoNQ2F0ZWdvcnlEZWx0YRIzCghjYXRlZ29yeRgBIAEoDjIhLnBmaW5hbmNlLnYxLlRheERlZHVjdGlvbkNhdGVnb3J5EhQKDHllYXJfYV9jZW50cxgCIAEoAxIUCgx5ZWFyX2JfY2VudHMYAyABKAMSFAoMY2hhbmdlX2NlbnRzGAQgASgDEhYKDmNoYW5nZV9wZXJjZW50GAUgASgBIrsCCg9CYW5rVHJhbnNhY3Rpb24SCgoCaWQYASABKAkSDAoEZGF0ZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIOCgZhbW91bnQYBCABKAESEAoIaXNfZGViaXQYBSABKAgSDwoHYmFsYW5jZRgGIAEoARISCgpjb25maWRlbmNlGAcgASgBEgwKBHBhZ2UYCCABKAUSNwoRZmllbGRfY29uZmlkZW5jZXMYCSABKAsyHC5wZmluYW5jZS52MS5GaWVsZENvbmZpZGVuY2USFAoMYW1vdW50X2NlbnRzGAogASgDEjgKEnN1Z2dlc3RlZF9jYXRlZ29yeRgLIAEoDjIcLnBmaW5hbmNlLnYxLkV4cGVuc2VDYXRlZ29yeRIbChNub3JtYWxpemVkX21lcmNoYW50GAwgASgJIvgCChNCYW5rU3RhdGVtZW50UmVzdWx0EjIKDHRyYW5zYWN0aW9ucxgBIAMoCzIcLnBmaW5hbmNlLnYxLkJhbmtUcmFuc2FjdGlvbhIVCg1iYW5rX2RldGVjdGVkGAIgASgJEhIKCnBhZ2VfY291bnQYAyABKAUSEgoKY29uZmlkZW5jZRgEIAEoARIaChJiYWxhbmNlX3JlY29uY2lsZWQYBSABKAgSGgoScHJvY2Vzc2luZ190aW1lX21zGAYgASgFEhAKCHdhcm5pbmdzGAcgAygJEjoKEnN0YXRlbWVudF9tZXRhZGF0YRgIIAEoCzIeLnBmaW5hbmNlLnYxLlN0YXRlbWVudE1ldGFkYXRhEjIKC21ldGhvZF91c2VkGAkgASgOMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvbk1ldGhvZBI0Cg1mYWxsYmFja19mcm9tGAogASgOMh0ucGZpbmFuY2UudjEuRXh0cmFjdGlvbk1ldGhvZCJOChNJbXBvcnRDb2x1bW5NYXBwaW5nEg4KBmNvbHVtbhgBIAEoCRInCgVmaWVsZBgCIAEoDjIYLnBmaW5hbmNlLnYxLkltcG9ydEZpZWxkInIKEkltcG9ydENhdGVnb3J5UnVsZRIPCgdwYXR0ZXJuGAEgASgJEi4KCGNhdGVnb3J5GAIgASgOMhwucGZpbmFuY2UudjEuRXhwZW5zZUNhdGVnb3J5EhsKE25vcm1hbGl6ZWRfbWVyY2hhbnQYAyABKAkitgIKDUltcG9ydFByb2ZpbGUSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEhEKCWJhbmtfbmFtZRgEIAEoCRITCgtkYXRlX2Zvcm1hdBgFIAEoCRI5Cg9jb2x1bW5fbWFwcGluZ3MYBiADKAsyIC5wZmluYW5jZS52MS5JbXBvcnRDb2x1bW5NYXBwaW5nEjcKDmNhdGVnb3J5X3J1bGVzGAcgAygLMh8ucGZpbmFuY2UudjEuSW1wb3J0Q2F0ZWdvcnlSdWxlEi4KCmNyZWF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIpQCChVFeHRyYWN0aW9uUHJlZmVyZW5jZXMSDwoHdXNlcl9pZBgBIAEoCRIiChVhdXRvX3JlamVjdF90aHJlc2hvbGQYAiABKAFIAIgBARIiChVsb3dfd2FybmluZ190aHJlc2hvbGQYAyABKAFIAYgBARIjChZwcmVfZGVzZWxlY3RfdGhyZXNob2xkGAQgASgBSAKIAQESLgoKdXBkYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCGAoWX2F1dG9fcmVqZWN0X3RocmVzaG9sZEIYChZfbG93X3dhcm5pbmdfdGhyZXNob2xkQhkKF19wcmVfZGVzZWxlY3RfdGhyZXNob2xkKu4CCg9FeHBlbnNlQ2F0ZWdvcnkSIAocRVhQRU5TRV9DQVRFR09SWV9VTlNQRUNJRklFRBAAEhkKFUVYUEVOU0VfQ0FURUdPUllfRk9PRBABEhwKGEVYUEVOU0VfQ0FURUdPUllfSE9VU0lORxACEiMKH0VYUEVOU0VfQ0FURUdPUllfVFJBTlNQT1JUQVRJT04QAxIiCh5FWFBFTlNFX0NBVEVHT1JZX0VOVEVSVEFJTk1FTlQQBBIfChtFWFBFTlNFX0NBVEVHT1JZX0hFQUxUSENBUkUQBRIeChpFWFBFTlNFX0NBVEVHT1JZX1VUSUxJVElFUxAGEh0KGUVYUEVOU0VfQ0FURUdPUllfU0hPUFBJTkcQBxIeChpFWFBFTlNFX0NBVEVHT1JZX0VEVUNBVElPThAIEhsKF0VYUEVOU0VfQ0FURUdPUllfVFJBVkVMEAkSGgoWRVhQRU5TRV9DQVRFR09SWV9PVEhFUhAKKo8CChBFeHBlbnNlRnJlcXVlbmN5EiEKHUVYUEVOU0VfRlJFUVVFTkNZX1VOU1BFQ0lGSUVEEAASGgoWRVhQRU5TRV9GUkVRVUVOQ1lfT05DRRABEhsKF0VYUEVOU0VfRlJFUVVFTkNZX0RBSUxZEAISHAoYRVhQRU5TRV9GUkVRVUVOQ1lfV0VFS0xZEAMSIQodRVhQRU5TRV9GUkVRVUVOQ1lfRk9SVE5JR0hUTFkQBBIdChlFWFBFTlNFX0ZSRVFVRU5DWV9NT05USExZEAUSHwobRVhQRU5TRV9GUkVRVUVOQ1lfUVVBUlRFUkxZEAYSHgoaRVhQRU5TRV9GUkVRVUVOQ1lfQU5OVUFMTFkQByqvAQoPSW5jb21lRnJlcXVlbmN5EiAKHElOQ09NRV9GUkVRVUVOQ1lfVU5TUEVDSUZJRUQQABIbChdJTkNPTUVfRlJFUVVFTkNZX1dFRUtMWRABEiAKHElOQ09NRV9GUkVRVUVOQ1lfRk9SVE5JR0hUTFkQAhIcChhJTkNPTUVfRlJFUVVFTkNZX01PTlRITFkQAxIdChlJTkNPTUVfRlJFUVVFTkNZX0FOTlVBTExZEAQqWAoJVGF4U3RhdHVzEhoKFlRBWF9TVEFUVVNfVU5TUEVDSUZJRUQQABIWChJUQVhfU1RBVFVTX1BSRV9UQVgQARIXChNUQVhfU1RBVFVTX1BPU1RfVEFYEAIqcAoKVGF4Q291bnRyeRIbChdUQVhfQ09VTlRSWV9VTlNQRUNJRklFRBAAEhkKFVRBWF9DT1VOVFJZX0FVU1RSQUxJQRABEhIKDlRBWF9DT1VOVFJZX1VLEAISFgoSVEFYX0NPVU5UUllfU0lNUExFEAMqxgMKFFRheERlZHVjdGlvbkNhdGVnb3J5EiYKIlRBWF9ERURVQ1RJT05fQ0FURUdPUllfVU5TUEVDSUZJRUQQABImCiJUQVhfREVEVUNUSU9OX0NBVEVHT1JZX1dPUktfVFJBVkVMEAESIgoeVEFYX0RFRFVDVElPTl9DQVRFR09SWV9VTklGT1JNEAISKQolVEFYX0RFRFVDVElPTl9DQVRFR09SWV9TRUxGX0VEVUNBVElPThADEiUKIVRBWF9ERURVQ1RJT05fQ0FURUdPUllfT1RIRVJfV09SSxAEEiYKIlRBWF9ERURVQ1RJT05fQ0FURUdPUllfSE9NRV9PRkZJQ0UQBRIiCh5UQVhfREVEVUNUSU9OX0NBVEVHT1JZX1ZFSElDTEUQBhIkCiBUQVhfREVEVUNUSU9OX0NBVEVHT1JZX0RPTkFUSU9OUxAHEiYKIlRBWF9ERURVQ1RJT05fQ0FURUdPUllfVEFYX0FGRkFJUlMQCBIsCihUQVhfREVEVUNUSU9OX0NBVEVHT1JZX0lOQ09NRV9QUk9URUNUSU9OEAkSIAocVEFYX0RFRFVDVElPTl9DQVRFR09SWV9PVEhFUhAKKmwKEFN1YnNjcmlwdGlvblRpZXISIQodU1VCU0NSSVBUSU9OX1RJRVJfVU5TUEVDSUZJRUQQABIaChZTVUJTQ1JJUFRJT05fVElFUl9GUkVFEAESGQoVU1VCU0NSSVBUSU9OX1RJRVJfUFJPEAIqvwEKElN1YnNjcmlwdGlvblN0YXR1cxIjCh9TVUJTQ1JJUFRJT05fU1RBVFVTX1VOU1BFQ0lGSUVEEAASHgoaU1VCU0NSSVBUSU9OX1NUQVRVU19BQ1RJVkUQARIgChxTVUJTQ1JJUFRJT05fU1RBVFVTX1BBU1RfRFVFEAISIAocU1VCU0NSSVBUSU9OX1NUQVRVU19DQU5DRUxFRBADEiAKHFNVQlNDUklQVElPTl9TVEFUVVNfVFJJQUxJTkcQBCqGAQoJU3BsaXRUeXBlEhoKFlNQTElUX1RZUEVfVU5TUEVDSUZJRUQQABIUChBTUExJVF9UWVBFX0VRVUFMEAESGQoVU1BMSVRfVFlQRV9QRVJDRU5UQUdFEAISFQoRU1BMSVRfVFlQRV9BTU9VTlQQAxIVChFTUExJVF9UWVBFX1NIQVJFUxAEKlMKCVNvcnRGaWVsZBIaChZTT1JUX0ZJRUxEX1VOU1BFQ0lGSUVEEAASEwoPU09SVF9GSUVMRF9EQVRFEAESFQoRU09SVF9GSUVMRF9BTU9VTlQQAipgCg1Tb3J0RGlyZWN0aW9uEh4KGlNPUlRfRElSRUNUSU9OX1VOU1BFQ0lGSUVEEAASFgoSU09SVF9ESVJFQ1RJT05fQVNDEAESFwoTU09SVF9ESVJFQ1RJT05fREVTQxACKoEBCglHcm91cFJvbGUSGgoWR1JPVVBfUk9MRV9VTlNQRUNJRklFRBAAEhUKEUdST1VQX1JPTEVfVklFV0VSEAESFQoRR1JPVVBfUk9MRV9NRU1CRVIQAhIUChBHUk9VUF9ST0xFX0FETUlOEAMSFAoQR1JPVVBfUk9MRV9PV05FUhAEKrMBChBJbnZpdGF0aW9uU3RhdHVzEiEKHUlOVklUQVRJT05fU1RBVFVTX1VOU1BFQ0lGSUVEEAASHQoZSU5WSVRBVElPTl9TVEFUVVNfUEVORElORxABEh4KGklOVklUQVRJT05fU1RBVFVTX0FDQ0VQVEVEEAISHgoaSU5WSVRBVElPTl9TVEFUVVNfREVDTElORUQQAxIdChlJTlZJVEFUSU9OX1NUQVRVU19FWFBJUkVEEAQquAEKDEJ1ZGdldFBlcmlvZBIdChlCVURHRVRfUEVSSU9EX1VOU1BFQ0lGSUVEEAASGAoUQlVER0VUX1BFUklPRF9XRUVLTFkQARIdChlCVURHRVRfUEVSSU9EX0ZPUlROSUdIVExZEAISGQoVQlVER0VUX1BFUklPRF9NT05USExZEAMSGwoXQlVER0VUX1BFUklPRF9RVUFSVEVSTFkQBBIYChRCVURHRVRfUEVSSU9EX1lFQVJMWRAFKnUKCEdvYWxUeXBlEhkKFUdPQUxfVFlQRV9VTlNQRUNJRklFRBAAEhUKEUdPQUxfVFlQRV9TQVZJTkdTEAESGQoVR09BTF9UWVBFX0RFQlRfUEFZT0ZGEAISHAoYR09BTF9UWVBFX1NQRU5ESU5HX0xJTUlUEAMqjwEKCkdvYWxTdGF0dXMSGwoXR09BTF9TVEFUVVNfVU5TUEVDSUZJRUQQABIWChJHT0FMX1NUQVRVU19BQ1RJVkUQARIWChJHT0FMX1NUQVRVU19QQVVTRUQQAhIZChVHT0FMX1NUQVRVU19DT01QTEVURUQQAxIZChVHT0FMX1NUQVRVU19DQU5DRUxMRUQQBCp2CgxHb2FsUHJpb3JpdHkSHQoZR09BTF9QUklPUklUWV9VTlNQRUNJRklFRBAAEhUKEUdPQUxfUFJJT1JJVFlfTE9XEAESGAoUR09BTF9QUklPUklUWV9NRURJVU0QAhIWChJHT0FMX1BSSU9SSVRZX0hJR0gQAyqHAQoMQWN0aXZpdHlLaW5kEh0KGUFDVElWSVRZX0tJTkRfVU5TUEVDSUZJRUQQABIZChVBQ1RJVklUWV9LSU5EX0VYUEVOU0UQARIYChRBQ1RJVklUWV9LSU5EX0lOQ09NRRACEiMKH0FDVElWSVRZX0tJTkRfR09BTF9DT05UUklCVVRJT04QAyrEAQoaUmVjdXJyaW5nVHJhbnNhY3Rpb25TdGF0dXMSLAooUkVDVVJSSU5HX1RSQU5TQUNUSU9OX1NUQVRVU19VTlNQRUNJRklFRBAAEicKI1JFQ1VSUklOR19UUkFOU0FDVElPTl9TVEFUVVNfQUNUSVZFEAESJwojUkVDVVJSSU5HX1RSQU5TQUNUSU9OX1NUQVRVU19QQVVTRUQQAhImCiJSRUNVUlJJTkdfVFJBTlNBQ1RJT05fU1RBVFVTX0VOREVEEAMqmQIKC0luc2lnaHRUeXBlEhwKGElOU0lHSFRfVFlQRV9VTlNQRUNJRklFRBAAEiIKHklOU0lHSFRfVFlQRV9TUEVORElOR19JTkNSRUFTRRABEiIKHklOU0lHSFRfVFlQRV9TUEVORElOR19ERUNSRUFTRRACEiQKIElOU0lHSFRfVFlQRV9VTlVTVUFMX1RSQU5TQUNUSU9OEAMSHwobSU5TSUdIVF9UWVBFX0NBVEVHT1JZX1RSRU5EEAQSHAoYSU5TSUdIVF9UWVBFX1NBVklOR1NfVElQEAUSHwobSU5TSUdIVF9UWVBFX0JVREdFVF9XQVJOSU5HEAYSHgoaSU5TSUdIVF9UWVBFX0dPQUxfUFJPR1JFU1MQBypuCg9UcmFuc2FjdGlvblR5cGUSIAocVFJBTlNBQ1RJT05fVFlQRV9VTlNQRUNJRklFRBAAEhwKGFRSQU5TQUNUSU9OX1RZUEVfRVhQRU5TRRABEhsKF1RSQU5TQUNUSU9OX1RZUEVfSU5DT01FEAIqZQoMU2VhcmNoU29ydEJ5Eh4KGlNFQVJDSF9TT1JUX0JZX1VOU1BFQ0lGSUVEEAASFwoTU0VBUkNIX1NPUlRfQllfREFURRABEhwKGFNFQVJDSF9TT1JUX0JZX1JFTEVWQU5DRRACKp8EChBOb3RpZmljYXRpb25UeXBlEiEKHU5PVElGSUNBVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASJgoiTk9USUZJQ0FUSU9OX1RZUEVfQlVER0VUX1RIUkVTSE9MRBABEiQKIE5PVElGSUNBVElPTl9UWVBFX0dPQUxfTUlMRVNUT05FEAISIwofTk9USUZJQ0FUSU9OX1RZUEVfQklMTF9SRU1JTkRFUhADEiYKIk5PVElGSUNBVElPTl9UWVBFX1VOVVNVQUxfU1BFTkRJTkcQBBIoCiROT1RJRklDQVRJT05fVFlQRV9TVUJTQ1JJUFRJT05fQUxFUlQQBRIcChhOT1RJRklDQVRJT05fVFlQRV9TWVNURU0QBhIpCiVOT1RJRklDQVRJT05fVFlQRV9FWFRSQUNUSU9OX0NPTVBMRVRFEAcSJAogTk9USUZJQ0FUSU9OX1RZUEVfR1JPVVBfQUNUSVZJVFkQCBIjCh9OT1RJRklDQVRJT05fVFlQRV9XRUVLTFlfRElHRVNUEAkSIQodTk9USUZJQ0FUSU9OX1RZUEVfVEFYX1NBVklOR1MQChIfChtOT1RJRklDQVRJT05fVFlQRV9TUEVORF9DQVAQCxIhCh1OT1RJRklDQVRJT05fVFlQRV9CVURHRVRfUEFDRRAMEigKJE5PVElGSUNBVElPTl9UWVBFX1RBWF9TVUJTVEFOVElBVElPThANKoUBCgxEb2N1bWVudFR5cGUSHQoZRE9DVU1FTlRfVFlQRV9VTlNQRUNJRklFRBAAEhkKFURPQ1VNRU5UX1RZUEVfUkVDRUlQVBABEiAKHERPQ1VNRU5UX1RZUEVfQkFOS19TVEFURU1FTlQQAhIZChVET0NVTUVOVF9UWVBFX0lOVk9JQ0UQAyrgAQoQRXh0cmFjdGlvblN0YXR1cxIhCh1FWFRSQUNUSU9OX1NUQVRVU19VTlNQRUNJRklFRBAAEh0KGUVYVFJBQ1RJT05fU1RBVFVTX1BFTkRJTkcQARIgChxFWFRSQUNUSU9OX1NUQVRVU19QUk9DRVNTSU5HEAISHwobRVhUUkFDVElPTl9TVEFUVVNfQ09NUExFVEVEEAMSHAoYRVhUUkFDVElPTl9TVEFUVVNfRkFJTEVEEAQSKQolRVhUUkFDVElPTl9TVEFUVVNfVkFMSURBVElPTl9SRVFVSVJFRBAFKnYKEEV4dHJhY3Rpb25NZXRob2QSIQodRVhUUkFDVElPTl9NRVRIT0RfVU5TUEVDSUZJRUQQABIhCh1FWFRSQUNUSU9OX01FVEhPRF9TRUxGX0hPU1RFRBABEhwKGEVYVFJBQ1RJT05fTUVUSE9EX0dFTUlOSRACKmwKC0dyYW51bGFyaXR5EhsKF0dSQU5VTEFSSVRZX1VOU1BFQ0lGSUVEEAASEwoPR1JBTlVMQVJJVFlfREFZEAESFAoQR1JBTlVMQVJJVFlfV0VFSxACEhUKEUdSQU5VTEFSSVRZX01PTlRIEAMq2AEKCURheU9mV2VlaxIbChdEQVlfT0ZfV0VFS19VTlNQRUNJRklFRBAAEhYKEkRBWV9PRl9XRUVLX1NVTkRBWRABEhYKEkRBWV9PRl9XRUVLX01PTkRBWRACEhcKE0RBWV9PRl9XRUVLX1RVRVNEQVkQAxIZChVEQVlfT0ZfV0VFS19XRURORVNEQVkQBBIYChREQVlfT0ZfV0VFS19USFVSU0RBWRAFEhYKEkRBWV9PRl9XRUVLX0ZSSURBWRAGEhgKFERBWV9PRl9XRUVLX1NBVFVSREFZEAcqzAEKC0Fub21hbHlUeXBlEhwKGEFOT01BTFlfVFlQRV9VTlNQRUNJRklFRBAAEh8KG0FOT01BTFlfVFlQRV9BTU9VTlRfT1VUTElFUhABEh0KGUFOT01BTFlfVFlQRV9ORVdfTUVSQ0hBTlQQAhIfChtBTk9NQUxZX1RZUEVfVU5VU1VBTF9USU1JTkcQAxIfChtBTk9NQUxZX1RZUEVfQ0FURUdPUllfU1BJS0UQBBIdChlBTk9NQUxZX1RZUEVfVElNRV9PVVRMSUVSEAUqhQEKD0Fub21hbHlTZXZlcml0eRIgChxBTk9NQUxZX1NFVkVSSVRZX1VOU1BFQ0lGSUVEEAASGAoUQU5PTUFMWV9TRVZFUklUWV9MT1cQARIbChdBTk9NQUxZX1NFVkVSSVRZX01FRElVTRACEhkKFUFOT01BTFlfU0VWRVJJVFlfSElHSBADKuABChJXYXRlcmZhbGxFbnRyeVR5cGUSJAogV0FURVJGQUxMX0VOVFJZX1RZUEVfVU5TUEVDSUZJRUQQABIfChtXQVRFUkZBTExfRU5UUllfVFlQRV9JTkNPTUUQARIgChxXQVRFUkZBTExfRU5UUllfVFlQRV9FWFBFTlNFEAISHAoYV0FURVJGQUxMX0VOVFJZX1RZUEVfVEFYEAMSIAocV0FURVJGQUxMX0VOVFJZX1RZUEVfU0FWSU5HUxAEEiEKHVdBVEVSRkFMTF9FTlRSWV9UWVBFX1NVQlRPVEFMEAUq7QEKE0NvcnJlY3Rpb25GaWVsZFR5cGUSJQohQ09SUkVDVElPTl9GSUVMRF9UWVBFX1VOU1BFQ0lGSUVEEAASIAocQ09SUkVDVElPTl9GSUVMRF9UWVBFX0FNT1VOVBABEiIKHkNPUlJFQ1RJT05fRklFTERfVFlQRV9DQVRFR09SWRACEiUKIUNPUlJFQ1RJT05fRklFTERfVFlQRV9ERVNDUklQVElPThADEh4KGkNPUlJFQ1RJT05fRklFTERfVFlQRV9EQVRFEAQSIgoeQ09SUkVDVElPTl9GSUVMRF9UWVBFX01FUkNIQU5UEAUqhwEKE01lcmNoYW50TWFwcGluZ1NvcnQSJQohTUVSQ0hBTlRfTUFQUElOR19TT1JUX1VOU1BFQ0lGSUVEEAASJAogTUVSQ0hBTlRfTUFQUElOR19TT1JUX0NPTkZJREVOQ0UQARIjCh9NRVJDSEFOVF9NQVBQSU5HX1NPUlRfTEFTVF9VU0VEEAIq4AEKC0ltcG9ydEZpZWxkEhwKGElNUE9SVF9GSUVMRF9VTlNQRUNJRklFRBAAEhUKEUlNUE9SVF9GSUVMRF9EQVRFEAESHAoYSU1QT1JUX0ZJRUxEX0RFU0NSSVBUSU9OEAISFwoTSU1QT1JUX0ZJRUxEX0FNT1VOVBADEhYKEklNUE9SVF9GSUVMRF9ERUJJVBAEEhcKE0lNUE9SVF9GSUVMRF9DUkVESVQQBRIYChRJTVBPUlRfRklFTERfQkFMQU5DRRAGEhoKFklNUE9SVF9GSUVMRF9SRUZFUkVOQ0UQB0KtAQoPY29tLnBmaW5hbmNlLnYxQgpUeXBlc1Byb3RvUAFaQWdpdGh1Yi5jb20vY2FzdGxlbWlsay9wZmluYW5jZS9iYWNrZW5kL2dlbi9wZmluYW5jZS92MTtwZmluYW5jZXYxogIDUFhYqgILUGZpbmFuY2UuVjHKAgtQZmluYW5jZVxWMeICF1BmaW5hbmNlXFYxXEdQQk1ldGFkYXRh6gIMUGZpbmFuY2U6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
 
 /**
  * User represents a user in the system
@@ -136,6 +136,13 @@ export type ApiToken = Message<"pfinance.v1.ApiToken"> & {
    * @generated from field: bool is_revoked = 9;
    */
   isRevoked: boolean;
+
+  /**
+   * Requests per minute; 0 uses the server default
+   *
+   * @generated from field: int32 rate_limit = 10;
+   */
+  rateLimit: number;
 };
 
 /**
@@ -145,6 +152,40 @@ export type ApiToken = Message<"pfinance.v1.ApiToken"> & {
 export const ApiTokenSchema: GenMessage<ApiToken> = /*@__PURE__*/
   messageDesc(file_pfinance_v1_types, 1);
 
+/**
+ * AttachmentRef references a file in storage attached to an expense, such as a
+ * photo receipt or the statement PDF a transaction was imported from.
+ *
+ * @generated from message pfinance.v1.AttachmentRef
+ */
+export type AttachmentRef = Message<"pfinance.v1.AttachmentRef"> & {
+  /**
+   * Firebase Storage path, unique per expense
+   *
+   * @generated from field: string storage_path = 1;
+   */
+  storagePath: string;
+
+  /**
+   * MIME type, e.g. "application/pdf"
+   *
+   * @generated from field: string content_type = 2;
+   */
+  contentType: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp uploaded_at = 3;
+   */
+  uploadedAt?: Timestamp;
+};
+
+/**
+ * Describes the message pfinance.v1.AttachmentRef.
+ * Use `create(AttachmentRefSchema)` to create a new message.
+ */
+export const AttachmentRefSchema: GenMessage<AttachmentRef> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 2);
+
 /**
  * ExpenseAllocation represents how much a specific member owes for an expense
  *
@@ -202,7 +243,7 @@ export type ExpenseAllocation = Message<"pfinance.v1.ExpenseAllocation"> & {
  * Use `create(ExpenseAllocationSchema)` to create a new message.
  */
 export const ExpenseAllocationSchema: GenMessage<ExpenseAllocation> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 2);
+  messageDesc(file_pfinance_v1_types, 3);
 
 /**
  * Expense represents a single expense entry
@@ -356,6 +397,48 @@ export type Expense = Message<"pfinance.v1.Expense"> & {
    * @generated from field: string receipt_storage_path = 23;
    */
   receiptStoragePath: string;
+
+  /**
+   * Additional receipts and source documents
+   *
+   * @generated from field: repeated pfinance.v1.AttachmentRef attachments = 24;
+   */
+  attachments: AttachmentRef[];
+
+  /**
+   * Free-form note, matched by SearchTransactions
+   *
+   * @generated from field: string note = 25;
+   */
+  note: string;
+
+  /**
+   * GST component of amount_cents (GST paid)
+   *
+   * @generated from field: int64 gst_cents = 26;
+   */
+  gstCents: bigint;
+
+  /**
+   * Set by LockFinancialYear once the FY's tax return is filed. Locked
+   * expenses cannot be updated or deleted until UnlockFinancialYear.
+   *
+   * @generated from field: bool locked = 27;
+   */
+  locked: boolean;
+
+  /**
+   * @generated from field: string locked_reason = 28;
+   */
+  lockedReason: string;
+
+  /**
+   * Flagged by BatchClassifyTaxDeductibility when its classification needs the
+   * user's review; cleared once the user sets the expense's tax status.
+   *
+   * @generated from field: bool tax_needs_review = 29;
+   */
+  taxNeedsReview: boolean;
 };
 
 /**
@@ -363,7 +446,7 @@ export type Expense = Message<"pfinance.v1.Expense"> & {
  * Use `create(ExpenseSchema)` to create a new message.
  */
 export const ExpenseSchema: GenMessage<Expense> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 3);
+  messageDesc(file_pfinance_v1_types, 4);
 
 /**
  * Income represents a single income entry
@@ -434,6 +517,13 @@ export type Income = Message<"pfinance.v1.Income"> & {
    * @generated from field: int64 amount_cents = 12;
    */
   amountCents: bigint;
+
+  /**
+   * GST component of amount_cents (GST collected)
+   *
+   * @generated from field: int64 gst_cents = 13;
+   */
+  gstCents: bigint;
 };
 
 /**
@@ -441,7 +531,7 @@ export type Income = Message<"pfinance.v1.Income"> & {
  * Use `create(IncomeSchema)` to create a new message.
  */
 export const IncomeSchema: GenMessage<Income> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 4);
+  messageDesc(file_pfinance_v1_types, 5);
 
 /**
  * Deduction represents a tax deduction
@@ -482,7 +572,7 @@ export type Deduction = Message<"pfinance.v1.Deduction"> & {
  * Use `create(DeductionSchema)` to create a new message.
  */
 export const DeductionSchema: GenMessage<Deduction> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 5);
+  messageDesc(file_pfinance_v1_types, 6);
 
 /**
  * TaxSettings represents user's tax configuration
@@ -551,7 +641,7 @@ export type TaxSettings = Message<"pfinance.v1.TaxSettings"> & {
  * Use `create(TaxSettingsSchema)` to create a new message.
  */
 export const TaxSettingsSchema: GenMessage<TaxSettings> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 6);
+  messageDesc(file_pfinance_v1_types, 7);
 
 /**
  * TaxConfig represents the tax configuration for a user or group
@@ -583,6 +673,13 @@ export type TaxConfig = Message<"pfinance.v1.TaxConfig"> & {
    * @generated from field: pfinance.v1.TaxSettings settings = 5;
    */
   settings?: TaxSettings;
+
+  /**
+   * 1-12; 0 = July (Australian FY)
+   *
+   * @generated from field: int32 fiscal_year_start_month = 6;
+   */
+  fiscalYearStartMonth: number;
 };
 
 /**
@@ -590,7 +687,7 @@ export type TaxConfig = Message<"pfinance.v1.TaxConfig"> & {
  * Use `create(TaxConfigSchema)` to create a new message.
  */
 export const TaxConfigSchema: GenMessage<TaxConfig> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 7);
+  messageDesc(file_pfinance_v1_types, 8);
 
 /**
  * FinanceGroup represents a shared finance tracking group
@@ -644,7 +741,7 @@ export type FinanceGroup = Message<"pfinance.v1.FinanceGroup"> & {
  * Use `create(FinanceGroupSchema)` to create a new message.
  */
 export const FinanceGroupSchema: GenMessage<FinanceGroup> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 8);
+  messageDesc(file_pfinance_v1_types, 9);
 
 /**
  * GroupMember represents a member of a finance group
@@ -676,6 +773,13 @@ export type GroupMember = Message<"pfinance.v1.GroupMember"> & {
    * @generated from field: google.protobuf.Timestamp joined_at = 5;
    */
   joinedAt?: Timestamp;
+
+  /**
+   * Invite link used to join, if any
+   *
+   * @generated from field: string invite_link_id = 6;
+   */
+  inviteLinkId: string;
 };
 
 /**
@@ -683,7 +787,7 @@ export type GroupMember = Message<"pfinance.v1.GroupMember"> & {
  * Use `create(GroupMemberSchema)` to create a new message.
  */
 export const GroupMemberSchema: GenMessage<GroupMember> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 9);
+  messageDesc(file_pfinance_v1_types, 10);
 
 /**
  * GroupInvitation represents an invitation to join a group
@@ -737,7 +841,7 @@ export type GroupInvitation = Message<"pfinance.v1.GroupInvitation"> & {
  * Use `create(GroupInvitationSchema)` to create a new message.
  */
 export const GroupInvitationSchema: GenMessage<GroupInvitation> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 10);
+  messageDesc(file_pfinance_v1_types, 11);
 
 /**
  * Budget represents a budget configuration
@@ -822,6 +926,20 @@ export type Budget = Message<"pfinance.v1.Budget"> & {
    * @generated from field: int64 amount_cents = 14;
    */
   amountCents: bigint;
+
+  /**
+   * Set when the budget was rolled from a RecurringBudget
+   *
+   * @generated from field: string template_id = 15;
+   */
+  templateId: string;
+
+  /**
+   * Optional sub-limits within the total
+   *
+   * @generated from field: repeated pfinance.v1.CategoryCap category_caps = 16;
+   */
+  categoryCaps: CategoryCap[];
 };
 
 /**
@@ -829,7 +947,110 @@ export type Budget = Message<"pfinance.v1.Budget"> & {
  * Use `create(BudgetSchema)` to create a new message.
  */
 export const BudgetSchema: GenMessage<Budget> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 11);
+  messageDesc(file_pfinance_v1_types, 12);
+
+/**
+ * CategoryCap limits spending on one category within a budget.
+ *
+ * @generated from message pfinance.v1.CategoryCap
+ */
+export type CategoryCap = Message<"pfinance.v1.CategoryCap"> & {
+  /**
+   * @generated from field: pfinance.v1.ExpenseCategory category = 1;
+   */
+  category: ExpenseCategory;
+
+  /**
+   * @generated from field: int64 cap_cents = 2;
+   */
+  capCents: bigint;
+};
+
+/**
+ * Describes the message pfinance.v1.CategoryCap.
+ * Use `create(CategoryCapSchema)` to create a new message.
+ */
+export const CategoryCapSchema: GenMessage<CategoryCap> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 13);
+
+/**
+ * RecurringBudget is a budget template. Each time the budget for one period
+ * ends, the next period's budget is created from the template.
+ *
+ * @generated from message pfinance.v1.RecurringBudget
+ */
+export type RecurringBudget = Message<"pfinance.v1.RecurringBudget"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * Optional - if part of a group
+   *
+   * @generated from field: string group_id = 3;
+   */
+  groupId: string;
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 5;
+   */
+  description: string;
+
+  /**
+   * @generated from field: int64 amount_cents = 6;
+   */
+  amountCents: bigint;
+
+  /**
+   * @generated from field: pfinance.v1.BudgetPeriod period = 7;
+   */
+  period: BudgetPeriod;
+
+  /**
+   * @generated from field: repeated pfinance.v1.ExpenseCategory category_ids = 8;
+   */
+  categoryIds: ExpenseCategory[];
+
+  /**
+   * @generated from field: bool is_active = 9;
+   */
+  isActive: boolean;
+
+  /**
+   * Anchors fortnightly periods
+   *
+   * @generated from field: google.protobuf.Timestamp start_date = 10;
+   */
+  startDate?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 11;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 12;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message pfinance.v1.RecurringBudget.
+ * Use `create(RecurringBudgetSchema)` to create a new message.
+ */
+export const RecurringBudgetSchema: GenMessage<RecurringBudget> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 14);
 
 /**
  * BudgetAlert represents an alert configuration for a budget
@@ -870,7 +1091,7 @@ export type BudgetAlert = Message<"pfinance.v1.BudgetAlert"> & {
  * Use `create(BudgetAlertSchema)` to create a new message.
  */
 export const BudgetAlertSchema: GenMessage<BudgetAlert> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 12);
+  messageDesc(file_pfinance_v1_types, 15);
 
 /**
  * BudgetProgress represents the current progress of a budget
@@ -943,6 +1164,13 @@ export type BudgetProgress = Message<"pfinance.v1.BudgetProgress"> & {
    * @generated from field: int64 remaining_amount_cents = 12;
    */
   remainingAmountCents: bigint;
+
+  /**
+   * One entry per cap on the budget
+   *
+   * @generated from field: repeated pfinance.v1.CategoryCapProgress category_caps = 13;
+   */
+  categoryCaps: CategoryCapProgress[];
 };
 
 /**
@@ -950,7 +1178,48 @@ export type BudgetProgress = Message<"pfinance.v1.BudgetProgress"> & {
  * Use `create(BudgetProgressSchema)` to create a new message.
  */
 export const BudgetProgressSchema: GenMessage<BudgetProgress> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 13);
+  messageDesc(file_pfinance_v1_types, 16);
+
+/**
+ * CategoryCapProgress is the spend against one category cap of a budget.
+ *
+ * @generated from message pfinance.v1.CategoryCapProgress
+ */
+export type CategoryCapProgress = Message<"pfinance.v1.CategoryCapProgress"> & {
+  /**
+   * @generated from field: pfinance.v1.ExpenseCategory category = 1;
+   */
+  category: ExpenseCategory;
+
+  /**
+   * @generated from field: int64 cap_cents = 2;
+   */
+  capCents: bigint;
+
+  /**
+   * @generated from field: int64 spent_cents = 3;
+   */
+  spentCents: bigint;
+
+  /**
+   * Negative once the cap is exceeded
+   *
+   * @generated from field: int64 remaining_cents = 4;
+   */
+  remainingCents: bigint;
+
+  /**
+   * @generated from field: double percentage_used = 5;
+   */
+  percentageUsed: number;
+};
+
+/**
+ * Describes the message pfinance.v1.CategoryCapProgress.
+ * Use `create(CategoryCapProgressSchema)` to create a new message.
+ */
+export const CategoryCapProgressSchema: GenMessage<CategoryCapProgress> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 17);
 
 /**
  * ExpenseBreakdown represents spending breakdown by category
@@ -986,7 +1255,7 @@ export type ExpenseBreakdown = Message<"pfinance.v1.ExpenseBreakdown"> & {
  * Use `create(ExpenseBreakdownSchema)` to create a new message.
  */
 export const ExpenseBreakdownSchema: GenMessage<ExpenseBreakdown> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 14);
+  messageDesc(file_pfinance_v1_types, 18);
 
 /**
  * MemberBalance represents a member's balance in a group
@@ -1059,7 +1328,7 @@ export type MemberBalance = Message<"pfinance.v1.MemberBalance"> & {
  * Use `create(MemberBalanceSchema)` to create a new message.
  */
 export const MemberBalanceSchema: GenMessage<MemberBalance> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 15);
+  messageDesc(file_pfinance_v1_types, 19);
 
 /**
  * MemberDebt represents money owed between two members
@@ -1106,7 +1375,45 @@ export type MemberDebt = Message<"pfinance.v1.MemberDebt"> & {
  * Use `create(MemberDebtSchema)` to create a new message.
  */
 export const MemberDebtSchema: GenMessage<MemberDebt> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 16);
+  messageDesc(file_pfinance_v1_types, 20);
+
+/**
+ * SettlementTransfer is a single payment that settles group balances
+ *
+ * @generated from message pfinance.v1.SettlementTransfer
+ */
+export type SettlementTransfer = Message<"pfinance.v1.SettlementTransfer"> & {
+  /**
+   * Member who pays
+   *
+   * @generated from field: string from_user_id = 1;
+   */
+  fromUserId: string;
+
+  /**
+   * Member who receives
+   *
+   * @generated from field: string to_user_id = 2;
+   */
+  toUserId: string;
+
+  /**
+   * @generated from field: double amount = 3;
+   */
+  amount: number;
+
+  /**
+   * @generated from field: int64 amount_cents = 4;
+   */
+  amountCents: bigint;
+};
+
+/**
+ * Describes the message pfinance.v1.SettlementTransfer.
+ * Use `create(SettlementTransferSchema)` to create a new message.
+ */
+export const SettlementTransferSchema: GenMessage<SettlementTransfer> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 21);
 
 /**
  * GroupInviteLink represents a shareable link to join a group
@@ -1165,6 +1472,11 @@ export type GroupInviteLink = Message<"pfinance.v1.GroupInviteLink"> & {
    * @generated from field: google.protobuf.Timestamp created_at = 10;
    */
   createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_used_at = 11;
+   */
+  lastUsedAt?: Timestamp;
 };
 
 /**
@@ -1172,7 +1484,7 @@ export type GroupInviteLink = Message<"pfinance.v1.GroupInviteLink"> & {
  * Use `create(GroupInviteLinkSchema)` to create a new message.
  */
 export const GroupInviteLinkSchema: GenMessage<GroupInviteLink> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 17);
+  messageDesc(file_pfinance_v1_types, 22);
 
 /**
  * ExpenseContribution represents a personal expense contributed to a group
@@ -1244,7 +1556,7 @@ export type ExpenseContribution = Message<"pfinance.v1.ExpenseContribution"> & {
  * Use `create(ExpenseContributionSchema)` to create a new message.
  */
 export const ExpenseContributionSchema: GenMessage<ExpenseContribution> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 18);
+  messageDesc(file_pfinance_v1_types, 23);
 
 /**
  * IncomeContribution represents personal income contributed to a group
@@ -1306,7 +1618,7 @@ export type IncomeContribution = Message<"pfinance.v1.IncomeContribution"> & {
  * Use `create(IncomeContributionSchema)` to create a new message.
  */
 export const IncomeContributionSchema: GenMessage<IncomeContribution> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 19);
+  messageDesc(file_pfinance_v1_types, 24);
 
 /**
  * GoalMilestone represents a milestone within a goal
@@ -1347,7 +1659,7 @@ export type GoalMilestone = Message<"pfinance.v1.GoalMilestone"> & {
  * Use `create(GoalMilestoneSchema)` to create a new message.
  */
 export const GoalMilestoneSchema: GenMessage<GoalMilestone> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 20);
+  messageDesc(file_pfinance_v1_types, 25);
 
 /**
  * FinancialGoal represents a financial goal for saving, debt payoff, or spending limits
@@ -1463,6 +1775,11 @@ export type FinancialGoal = Message<"pfinance.v1.FinancialGoal"> & {
    * @generated from field: int64 current_amount_cents = 19;
    */
   currentAmountCents: bigint;
+
+  /**
+   * @generated from field: pfinance.v1.GoalPriority priority = 20;
+   */
+  priority: GoalPriority;
 };
 
 /**
@@ -1470,7 +1787,7 @@ export type FinancialGoal = Message<"pfinance.v1.FinancialGoal"> & {
  * Use `create(FinancialGoalSchema)` to create a new message.
  */
 export const FinancialGoalSchema: GenMessage<FinancialGoal> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 21);
+  messageDesc(file_pfinance_v1_types, 26);
 
 /**
  * GoalProgress represents the current progress towards a goal
@@ -1559,6 +1876,13 @@ export type GoalProgress = Message<"pfinance.v1.GoalProgress"> & {
    * @generated from field: int64 actual_daily_rate_cents = 14;
    */
   actualDailyRateCents: bigint;
+
+  /**
+   * Unset once the goal is reached
+   *
+   * @generated from field: pfinance.v1.GoalCatchUpScenarios catch_up_scenarios = 15;
+   */
+  catchUpScenarios?: GoalCatchUpScenarios;
 };
 
 /**
@@ -1566,7 +1890,71 @@ export type GoalProgress = Message<"pfinance.v1.GoalProgress"> & {
  * Use `create(GoalProgressSchema)` to create a new message.
  */
 export const GoalProgressSchema: GenMessage<GoalProgress> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 22);
+  messageDesc(file_pfinance_v1_types, 27);
+
+/**
+ * GoalCatchUpScenarios shows what it takes to still reach a goal by its target
+ * date, and when it will be reached at the current pace instead
+ *
+ * @generated from message pfinance.v1.GoalCatchUpScenarios
+ */
+export type GoalCatchUpScenarios = Message<"pfinance.v1.GoalCatchUpScenarios"> & {
+  /**
+   * Amount still needed
+   *
+   * @generated from field: int64 remaining_cents = 1;
+   */
+  remainingCents: bigint;
+
+  /**
+   * Contribution per day to finish on time
+   *
+   * @generated from field: int64 required_daily_cents = 2;
+   */
+  requiredDailyCents: bigint;
+
+  /**
+   * Contribution per week to finish on time
+   *
+   * @generated from field: int64 required_weekly_cents = 3;
+   */
+  requiredWeeklyCents: bigint;
+
+  /**
+   * Contribution per month to finish on time
+   *
+   * @generated from field: int64 required_monthly_cents = 4;
+   */
+  requiredMonthlyCents: bigint;
+
+  /**
+   * At the actual daily rate; unset without progress
+   *
+   * @generated from field: google.protobuf.Timestamp projected_completion_date = 5;
+   */
+  projectedCompletionDate?: Timestamp;
+
+  /**
+   * Days the projection lands after the target date
+   *
+   * @generated from field: int32 days_late = 6;
+   */
+  daysLate: number;
+
+  /**
+   * Target date has passed; required amounts are the full remainder
+   *
+   * @generated from field: bool overdue = 7;
+   */
+  overdue: boolean;
+};
+
+/**
+ * Describes the message pfinance.v1.GoalCatchUpScenarios.
+ * Use `create(GoalCatchUpScenariosSchema)` to create a new message.
+ */
+export const GoalCatchUpScenariosSchema: GenMessage<GoalCatchUpScenarios> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 28);
 
 /**
  * GoalContribution represents a contribution to a goal
@@ -1605,7 +1993,7 @@ export type GoalContribution = Message<"pfinance.v1.GoalContribution"> & {
   contributedAt?: Timestamp;
 
   /**
-   * Amount in cents (preferred over amount)
+   * Amount in cents (preferred over amount); negative for a withdrawal
    *
    * @generated from field: int64 amount_cents = 7;
    */
@@ -1617,7 +2005,69 @@ export type GoalContribution = Message<"pfinance.v1.GoalContribution"> & {
  * Use `create(GoalContributionSchema)` to create a new message.
  */
 export const GoalContributionSchema: GenMessage<GoalContribution> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 23);
+  messageDesc(file_pfinance_v1_types, 29);
+
+/**
+ * ActivityItem is one entry of the activity feed. Exactly one of expense,
+ * income or goal_contribution is set, matching kind.
+ *
+ * @generated from message pfinance.v1.ActivityItem
+ */
+export type ActivityItem = Message<"pfinance.v1.ActivityItem"> & {
+  /**
+   * ID of the underlying record
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: pfinance.v1.ActivityKind kind = 2;
+   */
+  kind: ActivityKind;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 3;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * @generated from field: string user_id = 4;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string description = 5;
+   */
+  description: string;
+
+  /**
+   * @generated from field: int64 amount_cents = 6;
+   */
+  amountCents: bigint;
+
+  /**
+   * @generated from field: pfinance.v1.Expense expense = 7;
+   */
+  expense?: Expense;
+
+  /**
+   * @generated from field: pfinance.v1.Income income = 8;
+   */
+  income?: Income;
+
+  /**
+   * @generated from field: pfinance.v1.GoalContribution goal_contribution = 9;
+   */
+  goalContribution?: GoalContribution;
+};
+
+/**
+ * Describes the message pfinance.v1.ActivityItem.
+ * Use `create(ActivityItemSchema)` to create a new message.
+ */
+export const ActivityItemSchema: GenMessage<ActivityItem> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 30);
 
 /**
  * RecurringTransaction represents a recurring expense or income
@@ -1735,6 +2185,27 @@ export type RecurringTransaction = Message<"pfinance.v1.RecurringTransaction"> &
    * @generated from field: repeated pfinance.v1.ExpenseAllocation allocations = 19;
    */
   allocations: ExpenseAllocation[];
+
+  /**
+   * Occurrences skipped without creating a transaction
+   *
+   * @generated from field: repeated google.protobuf.Timestamp skipped_occurrences = 20;
+   */
+  skippedOccurrences: Timestamp[];
+
+  /**
+   * Optional: lowest expected amount for variable bills (0 = fixed amount)
+   *
+   * @generated from field: int64 min_amount_cents = 21;
+   */
+  minAmountCents: bigint;
+
+  /**
+   * Optional: highest expected amount; set together with min_amount_cents
+   *
+   * @generated from field: int64 max_amount_cents = 22;
+   */
+  maxAmountCents: bigint;
 };
 
 /**
@@ -1742,7 +2213,7 @@ export type RecurringTransaction = Message<"pfinance.v1.RecurringTransaction"> &
  * Use `create(RecurringTransactionSchema)` to create a new message.
  */
 export const RecurringTransactionSchema: GenMessage<RecurringTransaction> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 24);
+  messageDesc(file_pfinance_v1_types, 31);
 
 /**
  * SpendingInsight represents an AI-generated spending insight
@@ -1822,7 +2293,7 @@ export type SpendingInsight = Message<"pfinance.v1.SpendingInsight"> & {
  * Use `create(SpendingInsightSchema)` to create a new message.
  */
 export const SpendingInsightSchema: GenMessage<SpendingInsight> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 25);
+  messageDesc(file_pfinance_v1_types, 32);
 
 /**
  * SearchResult represents a single search result (expense or income)
@@ -1876,7 +2347,7 @@ export type SearchResult = Message<"pfinance.v1.SearchResult"> & {
  * Use `create(SearchResultSchema)` to create a new message.
  */
 export const SearchResultSchema: GenMessage<SearchResult> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 26);
+  messageDesc(file_pfinance_v1_types, 33);
 
 /**
  * DetectedSubscription represents a subscription pattern found in expense history
@@ -1952,7 +2423,61 @@ export type DetectedSubscription = Message<"pfinance.v1.DetectedSubscription"> &
  * Use `create(DetectedSubscriptionSchema)` to create a new message.
  */
 export const DetectedSubscriptionSchema: GenMessage<DetectedSubscription> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 27);
+  messageDesc(file_pfinance_v1_types, 34);
+
+/**
+ * RecurringPatternSuggestion is a recurring transaction inferred from expense
+ * history that the user can accept with CreateRecurringTransaction.
+ *
+ * @generated from message pfinance.v1.RecurringPatternSuggestion
+ */
+export type RecurringPatternSuggestion = Message<"pfinance.v1.RecurringPatternSuggestion"> & {
+  /**
+   * Suggested record; not yet saved
+   *
+   * @generated from field: pfinance.v1.RecurringTransaction recurring_transaction = 1;
+   */
+  recurringTransaction?: RecurringTransaction;
+
+  /**
+   * @generated from field: pfinance.v1.ExpenseFrequency frequency = 2;
+   */
+  frequency: ExpenseFrequency;
+
+  /**
+   * @generated from field: int64 typical_amount_cents = 3;
+   */
+  typicalAmountCents: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp next_predicted_date = 4;
+   */
+  nextPredictedDate?: Timestamp;
+
+  /**
+   * 0.0-1.0
+   *
+   * @generated from field: double confidence = 5;
+   */
+  confidence: number;
+
+  /**
+   * @generated from field: int32 occurrence_count = 6;
+   */
+  occurrenceCount: number;
+
+  /**
+   * @generated from field: repeated string matched_expense_ids = 7;
+   */
+  matchedExpenseIds: string[];
+};
+
+/**
+ * Describes the message pfinance.v1.RecurringPatternSuggestion.
+ * Use `create(RecurringPatternSuggestionSchema)` to create a new message.
+ */
+export const RecurringPatternSuggestionSchema: GenMessage<RecurringPatternSuggestion> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 35);
 
 /**
  * Notification represents an in-app notification
@@ -2027,6 +2552,13 @@ export type Notification = Message<"pfinance.v1.Notification"> & {
    * @generated from field: map<string, string> metadata = 12;
    */
   metadata: { [key: string]: string };
+
+  /**
+   * Optional - at most one notification per user and key
+   *
+   * @generated from field: string dedup_key = 13;
+   */
+  dedupKey: string;
 };
 
 /**
@@ -2034,7 +2566,38 @@ export type Notification = Message<"pfinance.v1.Notification"> & {
  * Use `create(NotificationSchema)` to create a new message.
  */
 export const NotificationSchema: GenMessage<Notification> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 28);
+  messageDesc(file_pfinance_v1_types, 36);
+
+/**
+ * NotificationDayCount is the number of notifications of one type received on a day
+ *
+ * @generated from message pfinance.v1.NotificationDayCount
+ */
+export type NotificationDayCount = Message<"pfinance.v1.NotificationDayCount"> & {
+  /**
+   * ISO date string (UTC)
+   *
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: pfinance.v1.NotificationType type = 2;
+   */
+  type: NotificationType;
+
+  /**
+   * @generated from field: int32 count = 3;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message pfinance.v1.NotificationDayCount.
+ * Use `create(NotificationDayCountSchema)` to create a new message.
+ */
+export const NotificationDayCountSchema: GenMessage<NotificationDayCount> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 37);
 
 /**
  * NotificationPreferences represents a user's notification settings
@@ -2109,6 +2672,27 @@ export type NotificationPreferences = Message<"pfinance.v1.NotificationPreferenc
    * @generated from field: string fcm_token = 10;
    */
   fcmToken: string;
+
+  /**
+   * Overall monthly spending ceiling (0 = disabled)
+   *
+   * @generated from field: int64 monthly_spend_cap_cents = 11;
+   */
+  monthlySpendCapCents: bigint;
+
+  /**
+   * Points budget spend may run ahead of the elapsed period before a pace alert (0 = default 20)
+   *
+   * @generated from field: double budget_pace_margin_pct = 12;
+   */
+  budgetPaceMarginPct: number;
+
+  /**
+   * Tax deduction cap and receipt warnings (unset = true)
+   *
+   * @generated from field: optional bool substantiation_alerts = 13;
+   */
+  substantiationAlerts?: boolean;
 };
 
 /**
@@ -2116,7 +2700,7 @@ export type NotificationPreferences = Message<"pfinance.v1.NotificationPreferenc
  * Use `create(NotificationPreferencesSchema)` to create a new message.
  */
 export const NotificationPreferencesSchema: GenMessage<NotificationPreferences> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 29);
+  messageDesc(file_pfinance_v1_types, 38);
 
 /**
  * ExtractedTransaction represents a single transaction extracted from a document
@@ -2205,6 +2789,13 @@ export type ExtractedTransaction = Message<"pfinance.v1.ExtractedTransaction"> &
    * @generated from field: pfinance.v1.FieldConfidence field_confidences = 12;
    */
   fieldConfidences?: FieldConfidence;
+
+  /**
+   * Recovered from rejected by the user; imported regardless of confidence
+   *
+   * @generated from field: bool user_confirmed = 13;
+   */
+  userConfirmed: boolean;
 };
 
 /**
@@ -2212,7 +2803,7 @@ export type ExtractedTransaction = Message<"pfinance.v1.ExtractedTransaction"> &
  * Use `create(ExtractedTransactionSchema)` to create a new message.
  */
 export const ExtractedTransactionSchema: GenMessage<ExtractedTransaction> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 30);
+  messageDesc(file_pfinance_v1_types, 39);
 
 /**
  * ExtractedLineItem represents a single line item from a receipt
@@ -2253,7 +2844,7 @@ export type ExtractedLineItem = Message<"pfinance.v1.ExtractedLineItem"> & {
  * Use `create(ExtractedLineItemSchema)` to create a new message.
  */
 export const ExtractedLineItemSchema: GenMessage<ExtractedLineItem> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 31);
+  messageDesc(file_pfinance_v1_types, 40);
 
 /**
  * FieldConfidence represents per-field extraction confidence scores
@@ -2292,7 +2883,7 @@ export type FieldConfidence = Message<"pfinance.v1.FieldConfidence"> & {
  * Use `create(FieldConfidenceSchema)` to create a new message.
  */
 export const FieldConfidenceSchema: GenMessage<FieldConfidence> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 32);
+  messageDesc(file_pfinance_v1_types, 41);
 
 /**
  * ExtractionErrorDetail represents structured error information from extraction
@@ -2331,7 +2922,7 @@ export type ExtractionErrorDetail = Message<"pfinance.v1.ExtractionErrorDetail">
  * Use `create(ExtractionErrorDetailSchema)` to create a new message.
  */
 export const ExtractionErrorDetailSchema: GenMessage<ExtractionErrorDetail> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 33);
+  messageDesc(file_pfinance_v1_types, 42);
 
 /**
  * ExtractionResult represents the result of document extraction
@@ -2395,6 +2986,20 @@ export type ExtractionResult = Message<"pfinance.v1.ExtractionResult"> & {
    * @generated from field: pfinance.v1.StatementMetadata statement_metadata = 11;
    */
   statementMetadata?: StatementMetadata;
+
+  /**
+   * Review UI warns below this confidence; from ExtractionPreferences
+   *
+   * @generated from field: optional double low_warning_threshold = 12;
+   */
+  lowWarningThreshold?: number;
+
+  /**
+   * Review UI leaves transactions below this confidence unselected
+   *
+   * @generated from field: optional double pre_deselect_threshold = 13;
+   */
+  preDeselectThreshold?: number;
 };
 
 /**
@@ -2402,7 +3007,7 @@ export type ExtractionResult = Message<"pfinance.v1.ExtractionResult"> & {
  * Use `create(ExtractionResultSchema)` to create a new message.
  */
 export const ExtractionResultSchema: GenMessage<ExtractionResult> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 34);
+  messageDesc(file_pfinance_v1_types, 43);
 
 /**
  * StatementMetadata contains identifying information extracted from a bank statement
@@ -2465,7 +3070,7 @@ export type StatementMetadata = Message<"pfinance.v1.StatementMetadata"> & {
  * Use `create(StatementMetadataSchema)` to create a new message.
  */
 export const StatementMetadataSchema: GenMessage<StatementMetadata> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 35);
+  messageDesc(file_pfinance_v1_types, 44);
 
 /**
  * ProcessedStatement tracks which statements have been imported to prevent duplicates
@@ -2543,7 +3148,7 @@ export type ProcessedStatement = Message<"pfinance.v1.ProcessedStatement"> & {
  * Use `create(ProcessedStatementSchema)` to create a new message.
  */
 export const ProcessedStatementSchema: GenMessage<ProcessedStatement> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 36);
+  messageDesc(file_pfinance_v1_types, 45);
 
 /**
  * ExtractionJob represents an async extraction job
@@ -2627,7 +3232,7 @@ export type ExtractionJob = Message<"pfinance.v1.ExtractionJob"> & {
  * Use `create(ExtractionJobSchema)` to create a new message.
  */
 export const ExtractionJobSchema: GenMessage<ExtractionJob> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 37);
+  messageDesc(file_pfinance_v1_types, 46);
 
 /**
  * ValidationResult represents the result of validating an extraction
@@ -2663,7 +3268,7 @@ export type ValidationResult = Message<"pfinance.v1.ValidationResult"> & {
  * Use `create(ValidationResultSchema)` to create a new message.
  */
 export const ValidationResultSchema: GenMessage<ValidationResult> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 38);
+  messageDesc(file_pfinance_v1_types, 47);
 
 /**
  * ValidationDiscrepancy represents a difference found during validation
@@ -2699,7 +3304,7 @@ export type ValidationDiscrepancy = Message<"pfinance.v1.ValidationDiscrepancy">
  * Use `create(ValidationDiscrepancySchema)` to create a new message.
  */
 export const ValidationDiscrepancySchema: GenMessage<ValidationDiscrepancy> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 39);
+  messageDesc(file_pfinance_v1_types, 48);
 
 /**
  * DailyAggregate represents spending aggregated for a single day
@@ -2733,6 +3338,24 @@ export type DailyAggregate = Message<"pfinance.v1.DailyAggregate"> & {
    * @generated from field: repeated pfinance.v1.CategoryAmount category_amounts = 5;
    */
   categoryAmounts: CategoryAmount[];
+
+  /**
+   * Income received on the day; only set when include_income is requested.
+   * total_amount, transaction_count and category_amounts stay expense-only.
+   *
+   * @generated from field: double income_amount = 6;
+   */
+  incomeAmount: number;
+
+  /**
+   * @generated from field: int64 income_amount_cents = 7;
+   */
+  incomeAmountCents: bigint;
+
+  /**
+   * @generated from field: int32 income_count = 8;
+   */
+  incomeCount: number;
 };
 
 /**
@@ -2740,7 +3363,7 @@ export type DailyAggregate = Message<"pfinance.v1.DailyAggregate"> & {
  * Use `create(DailyAggregateSchema)` to create a new message.
  */
 export const DailyAggregateSchema: GenMessage<DailyAggregate> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 40);
+  messageDesc(file_pfinance_v1_types, 49);
 
 /**
  * CategoryAmount represents spending in a single category
@@ -2774,7 +3397,7 @@ export type CategoryAmount = Message<"pfinance.v1.CategoryAmount"> & {
  * Use `create(CategoryAmountSchema)` to create a new message.
  */
 export const CategoryAmountSchema: GenMessage<CategoryAmount> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 41);
+  messageDesc(file_pfinance_v1_types, 50);
 
 /**
  * TimeSeriesDataPoint represents a single point in a time series
@@ -2812,7 +3435,7 @@ export type TimeSeriesDataPoint = Message<"pfinance.v1.TimeSeriesDataPoint"> & {
  * Use `create(TimeSeriesDataPointSchema)` to create a new message.
  */
 export const TimeSeriesDataPointSchema: GenMessage<TimeSeriesDataPoint> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 42);
+  messageDesc(file_pfinance_v1_types, 51);
 
 /**
  * CategorySpending represents a category's spending for comparison
@@ -2859,6 +3482,27 @@ export type CategorySpending = Message<"pfinance.v1.CategorySpending"> & {
    * @generated from field: double change_percent = 8;
    */
   changePercent: number;
+
+  /**
+   * Display label for synthetic rows ("Uncategorized", "Total")
+   *
+   * @generated from field: string label = 9;
+   */
+  label: string;
+
+  /**
+   * True for the synthetic totals row
+   *
+   * @generated from field: bool is_total = 10;
+   */
+  isTotal: boolean;
+
+  /**
+   * Largest current-period expenses, when requested
+   *
+   * @generated from field: repeated pfinance.v1.Expense top_transactions = 11;
+   */
+  topTransactions: Expense[];
 };
 
 /**
@@ -2866,7 +3510,7 @@ export type CategorySpending = Message<"pfinance.v1.CategorySpending"> & {
  * Use `create(CategorySpendingSchema)` to create a new message.
  */
 export const CategorySpendingSchema: GenMessage<CategorySpending> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 43);
+  messageDesc(file_pfinance_v1_types, 52);
 
 /**
  * SpendingAnomaly represents a detected spending anomaly
@@ -2933,14 +3577,145 @@ export type SpendingAnomaly = Message<"pfinance.v1.SpendingAnomaly"> & {
    * @generated from field: pfinance.v1.AnomalySeverity severity = 12;
    */
   severity: AnomalySeverity;
-};
+
+  /**
+   * Human-readable reason, e.g. "12.5× your typical $40.00 dining spend"
+   *
+   * @generated from field: string explanation = 13;
+   */
+  explanation: string;
+
+  /**
+   * Set for amount outliers
+   *
+   * @generated from field: pfinance.v1.AnomalyComparison comparison = 14;
+   */
+  comparison?: AnomalyComparison;
+};
 
 /**
  * Describes the message pfinance.v1.SpendingAnomaly.
  * Use `create(SpendingAnomalySchema)` to create a new message.
  */
 export const SpendingAnomalySchema: GenMessage<SpendingAnomaly> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 44);
+  messageDesc(file_pfinance_v1_types, 53);
+
+/**
+ * AnomalyComparison puts an anomalous amount in the context of the category's
+ * typical spending.
+ *
+ * @generated from message pfinance.v1.AnomalyComparison
+ */
+export type AnomalyComparison = Message<"pfinance.v1.AnomalyComparison"> & {
+  /**
+   * Category mean (or stored baseline median)
+   *
+   * @generated from field: double typical_amount = 1;
+   */
+  typicalAmount: number;
+
+  /**
+   * @generated from field: int64 typical_amount_cents = 2;
+   */
+  typicalAmountCents: bigint;
+
+  /**
+   * @generated from field: double amount = 3;
+   */
+  amount: number;
+
+  /**
+   * @generated from field: int64 amount_cents = 4;
+   */
+  amountCents: bigint;
+
+  /**
+   * Share of the category's expenses at or below this amount (0-100)
+   *
+   * @generated from field: double percentile = 5;
+   */
+  percentile: number;
+
+  /**
+   * Signed distance from the typical amount in standard deviations
+   *
+   * @generated from field: double std_devs = 6;
+   */
+  stdDevs: number;
+
+  /**
+   * amount / typical_amount; 0 when the typical amount is 0
+   *
+   * @generated from field: double multiple = 7;
+   */
+  multiple: number;
+};
+
+/**
+ * Describes the message pfinance.v1.AnomalyComparison.
+ * Use `create(AnomalyComparisonSchema)` to create a new message.
+ */
+export const AnomalyComparisonSchema: GenMessage<AnomalyComparison> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 54);
+
+/**
+ * CategoryBaseline is a persisted robust spending baseline for one user's category,
+ * used by DetectAnomalies so a single outlier cannot inflate the expected amount
+ *
+ * @generated from message pfinance.v1.CategoryBaseline
+ */
+export type CategoryBaseline = Message<"pfinance.v1.CategoryBaseline"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: pfinance.v1.ExpenseCategory category = 2;
+   */
+  category: ExpenseCategory;
+
+  /**
+   * @generated from field: double median = 3;
+   */
+  median: number;
+
+  /**
+   * @generated from field: double median_absolute_deviation = 4;
+   */
+  medianAbsoluteDeviation: number;
+
+  /**
+   * @generated from field: int32 sample_count = 5;
+   */
+  sampleCount: number;
+
+  /**
+   * Most recent samples the estimate is computed from (bounded)
+   *
+   * @generated from field: repeated int64 recent_amounts_cents = 6;
+   */
+  recentAmountsCents: bigint[];
+
+  /**
+   * Watermark: expenses created after this are not yet folded in
+   *
+   * @generated from field: google.protobuf.Timestamp last_expense_created_at = 7;
+   */
+  lastExpenseCreatedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message pfinance.v1.CategoryBaseline.
+ * Use `create(CategoryBaselineSchema)` to create a new message.
+ */
+export const CategoryBaselineSchema: GenMessage<CategoryBaseline> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 55);
 
 /**
  * ForecastPoint represents a single forecast data point
@@ -2998,7 +3773,7 @@ export type ForecastPoint = Message<"pfinance.v1.ForecastPoint"> & {
  * Use `create(ForecastPointSchema)` to create a new message.
  */
 export const ForecastPointSchema: GenMessage<ForecastPoint> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 45);
+  messageDesc(file_pfinance_v1_types, 56);
 
 /**
  * WaterfallEntry represents a single bar in a waterfall chart
@@ -3035,6 +3810,20 @@ export type WaterfallEntry = Message<"pfinance.v1.WaterfallEntry"> & {
    * @generated from field: int64 running_total_cents = 6;
    */
   runningTotalCents: bigint;
+
+  /**
+   * Set for expense entries grouped by member
+   *
+   * @generated from field: string member_user_id = 7;
+   */
+  memberUserId: string;
+
+  /**
+   * Estimated rather than actual; render dashed
+   *
+   * @generated from field: bool is_projected = 8;
+   */
+  isProjected: boolean;
 };
 
 /**
@@ -3042,7 +3831,100 @@ export type WaterfallEntry = Message<"pfinance.v1.WaterfallEntry"> & {
  * Use `create(WaterfallEntrySchema)` to create a new message.
  */
 export const WaterfallEntrySchema: GenMessage<WaterfallEntry> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 46);
+  messageDesc(file_pfinance_v1_types, 57);
+
+/**
+ * BudgetRecommendation is a suggested monthly budget for one expense category
+ *
+ * @generated from message pfinance.v1.BudgetRecommendation
+ */
+export type BudgetRecommendation = Message<"pfinance.v1.BudgetRecommendation"> & {
+  /**
+   * @generated from field: pfinance.v1.ExpenseCategory category = 1;
+   */
+  category: ExpenseCategory;
+
+  /**
+   * @generated from field: double suggested_amount = 2;
+   */
+  suggestedAmount: number;
+
+  /**
+   * @generated from field: int64 suggested_amount_cents = 3;
+   */
+  suggestedAmountCents: bigint;
+
+  /**
+   * Trimmed monthly mean before rounding
+   *
+   * @generated from field: double average_monthly_amount = 4;
+   */
+  averageMonthlyAmount: number;
+
+  /**
+   * @generated from field: int64 average_monthly_amount_cents = 5;
+   */
+  averageMonthlyAmountCents: bigint;
+
+  /**
+   * @generated from field: int32 months_with_spending = 6;
+   */
+  monthsWithSpending: number;
+
+  /**
+   * Expenses dropped as anomalous spikes
+   *
+   * @generated from field: int32 excluded_outliers = 7;
+   */
+  excludedOutliers: number;
+
+  /**
+   * @generated from field: string rationale = 8;
+   */
+  rationale: string;
+};
+
+/**
+ * Describes the message pfinance.v1.BudgetRecommendation.
+ * Use `create(BudgetRecommendationSchema)` to create a new message.
+ */
+export const BudgetRecommendationSchema: GenMessage<BudgetRecommendation> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 58);
+
+/**
+ * TagSpending totals the expenses carrying a tag. An expense with several tags
+ * counts toward each of them, so tag totals can exceed overall spending.
+ *
+ * @generated from message pfinance.v1.TagSpending
+ */
+export type TagSpending = Message<"pfinance.v1.TagSpending"> & {
+  /**
+   * @generated from field: string tag = 1;
+   */
+  tag: string;
+
+  /**
+   * @generated from field: double amount = 2;
+   */
+  amount: number;
+
+  /**
+   * @generated from field: int64 amount_cents = 3;
+   */
+  amountCents: bigint;
+
+  /**
+   * @generated from field: int32 expense_count = 4;
+   */
+  expenseCount: number;
+};
+
+/**
+ * Describes the message pfinance.v1.TagSpending.
+ * Use `create(TagSpendingSchema)` to create a new message.
+ */
+export const TagSpendingSchema: GenMessage<TagSpending> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 59);
 
 /**
  * FieldCorrection represents a single field-level correction
@@ -3071,7 +3953,7 @@ export type FieldCorrection = Message<"pfinance.v1.FieldCorrection"> & {
  * Use `create(FieldCorrectionSchema)` to create a new message.
  */
 export const FieldCorrectionSchema: GenMessage<FieldCorrection> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 47);
+  messageDesc(file_pfinance_v1_types, 60);
 
 /**
  * CorrectionRecord captures user corrections to extracted data
@@ -3149,7 +4031,61 @@ export type CorrectionRecord = Message<"pfinance.v1.CorrectionRecord"> & {
  * Use `create(CorrectionRecordSchema)` to create a new message.
  */
 export const CorrectionRecordSchema: GenMessage<CorrectionRecord> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 48);
+  messageDesc(file_pfinance_v1_types, 61);
+
+/**
+ * DataClearRecord is the audit trail left when a user clears their financial data
+ *
+ * @generated from message pfinance.v1.DataClearRecord
+ */
+export type DataClearRecord = Message<"pfinance.v1.DataClearRecord"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp cleared_at = 3;
+   */
+  clearedAt?: Timestamp;
+
+  /**
+   * @generated from field: int64 expense_count = 4;
+   */
+  expenseCount: bigint;
+
+  /**
+   * @generated from field: int64 income_count = 5;
+   */
+  incomeCount: bigint;
+
+  /**
+   * @generated from field: int64 budget_count = 6;
+   */
+  budgetCount: bigint;
+
+  /**
+   * @generated from field: int64 goal_count = 7;
+   */
+  goalCount: bigint;
+
+  /**
+   * @generated from field: int64 recurring_transaction_count = 8;
+   */
+  recurringTransactionCount: bigint;
+};
+
+/**
+ * Describes the message pfinance.v1.DataClearRecord.
+ * Use `create(DataClearRecordSchema)` to create a new message.
+ */
+export const DataClearRecordSchema: GenMessage<DataClearRecord> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 62);
 
 /**
  * MerchantMapping stores learned merchant->category associations from user corrections
@@ -3216,7 +4152,7 @@ export type MerchantMapping = Message<"pfinance.v1.MerchantMapping"> & {
  * Use `create(MerchantMappingSchema)` to create a new message.
  */
 export const MerchantMappingSchema: GenMessage<MerchantMapping> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 49);
+  messageDesc(file_pfinance_v1_types, 63);
 
 /**
  * ExtractionEvent tracks extraction quality metrics over time
@@ -3285,7 +4221,7 @@ export type ExtractionEvent = Message<"pfinance.v1.ExtractionEvent"> & {
  * Use `create(ExtractionEventSchema)` to create a new message.
  */
 export const ExtractionEventSchema: GenMessage<ExtractionEvent> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 50);
+  messageDesc(file_pfinance_v1_types, 64);
 
 /**
  * DuplicateCandidate represents a potential duplicate of an extracted transaction
@@ -3343,7 +4279,7 @@ export type DuplicateCandidate = Message<"pfinance.v1.DuplicateCandidate"> & {
  * Use `create(DuplicateCandidateSchema)` to create a new message.
  */
 export const DuplicateCandidateSchema: GenMessage<DuplicateCandidate> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 51);
+  messageDesc(file_pfinance_v1_types, 65);
 
 /**
  * TaxDeductionSummary represents aggregated deductions for a single category
@@ -3377,7 +4313,7 @@ export type TaxDeductionSummary = Message<"pfinance.v1.TaxDeductionSummary"> & {
  * Use `create(TaxDeductionSummarySchema)` to create a new message.
  */
 export const TaxDeductionSummarySchema: GenMessage<TaxDeductionSummary> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 52);
+  messageDesc(file_pfinance_v1_types, 66);
 
 /**
  * TaxCalculation represents a full Australian tax estimate
@@ -3509,6 +4445,56 @@ export type TaxCalculation = Message<"pfinance.v1.TaxCalculation"> & {
    * @generated from field: double tax_withheld = 23;
    */
   taxWithheld: number;
+
+  /**
+   * Prior-year loss applied against taxable income
+   *
+   * @generated from field: int64 loss_carried_forward_cents = 24;
+   */
+  lossCarriedForwardCents: bigint;
+
+  /**
+   * @generated from field: double loss_carried_forward = 25;
+   */
+  lossCarriedForward: number;
+
+  /**
+   * Loss remaining to carry into the next FY
+   *
+   * @generated from field: int64 unused_loss_cents = 26;
+   */
+  unusedLossCents: bigint;
+
+  /**
+   * @generated from field: double unused_loss = 27;
+   */
+  unusedLoss: number;
+
+  /**
+   * Breakdown of tax_withheld per income source
+   *
+   * @generated from field: repeated pfinance.v1.WithheldTaxBySource withheld_by_source = 28;
+   */
+  withheldBySource: WithheldTaxBySource[];
+
+  /**
+   * Calculated with foreign resident rates
+   *
+   * @generated from field: bool is_non_resident = 29;
+   */
+  isNonResident: boolean;
+
+  /**
+   * Capital gains after any discount, included in taxable income
+   *
+   * @generated from field: int64 net_capital_gains_cents = 30;
+   */
+  netCapitalGainsCents: bigint;
+
+  /**
+   * @generated from field: double net_capital_gains = 31;
+   */
+  netCapitalGains: number;
 };
 
 /**
@@ -3516,7 +4502,43 @@ export type TaxCalculation = Message<"pfinance.v1.TaxCalculation"> & {
  * Use `create(TaxCalculationSchema)` to create a new message.
  */
 export const TaxCalculationSchema: GenMessage<TaxCalculation> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 53);
+  messageDesc(file_pfinance_v1_types, 67);
+
+/**
+ * WithheldTaxBySource is the tax withheld across incomes sharing a source name
+ *
+ * @generated from message pfinance.v1.WithheldTaxBySource
+ */
+export type WithheldTaxBySource = Message<"pfinance.v1.WithheldTaxBySource"> & {
+  /**
+   * @generated from field: string source = 1;
+   */
+  source: string;
+
+  /**
+   * @generated from field: int64 withheld_cents = 2;
+   */
+  withheldCents: bigint;
+
+  /**
+   * @generated from field: double withheld = 3;
+   */
+  withheld: number;
+
+  /**
+   * Incomes from this source with tax withheld
+   *
+   * @generated from field: int32 income_count = 4;
+   */
+  incomeCount: number;
+};
+
+/**
+ * Describes the message pfinance.v1.WithheldTaxBySource.
+ * Use `create(WithheldTaxBySourceSchema)` to create a new message.
+ */
+export const WithheldTaxBySourceSchema: GenMessage<WithheldTaxBySource> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 68);
 
 /**
  * CategoryOverride stores a per-user merchant→category override learned from corrections
@@ -3571,7 +4593,7 @@ export type CategoryOverride = Message<"pfinance.v1.CategoryOverride"> & {
  * Use `create(CategoryOverrideSchema)` to create a new message.
  */
 export const CategoryOverrideSchema: GenMessage<CategoryOverride> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 54);
+  messageDesc(file_pfinance_v1_types, 69);
 
 /**
  * TaxDeductibilityMapping stores learned merchant->deduction patterns
@@ -3638,7 +4660,68 @@ export type TaxDeductibilityMapping = Message<"pfinance.v1.TaxDeductibilityMappi
  * Use `create(TaxDeductibilityMappingSchema)` to create a new message.
  */
 export const TaxDeductibilityMappingSchema: GenMessage<TaxDeductibilityMapping> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 55);
+  messageDesc(file_pfinance_v1_types, 70);
+
+/**
+ * TaxDeductionChecklistItem is a deduction category worth checking for, with
+ * what the user has already claimed in it for the financial year
+ *
+ * @generated from message pfinance.v1.TaxDeductionChecklistItem
+ */
+export type TaxDeductionChecklistItem = Message<"pfinance.v1.TaxDeductionChecklistItem"> & {
+  /**
+   * @generated from field: pfinance.v1.TaxDeductionCategory category = 1;
+   */
+  category: TaxDeductionCategory;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * What typically qualifies for the occupation
+   *
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * False for deductions common to every taxpayer
+   *
+   * @generated from field: bool occupation_specific = 4;
+   */
+  occupationSpecific: boolean;
+
+  /**
+   * Whether any deductible expenses are in this category
+   *
+   * @generated from field: bool has_expenses = 5;
+   */
+  hasExpenses: boolean;
+
+  /**
+   * @generated from field: int32 expense_count = 6;
+   */
+  expenseCount: number;
+
+  /**
+   * @generated from field: int64 total_cents = 7;
+   */
+  totalCents: bigint;
+
+  /**
+   * @generated from field: double total_amount = 8;
+   */
+  totalAmount: number;
+};
+
+/**
+ * Describes the message pfinance.v1.TaxDeductionChecklistItem.
+ * Use `create(TaxDeductionChecklistItemSchema)` to create a new message.
+ */
+export const TaxDeductionChecklistItemSchema: GenMessage<TaxDeductionChecklistItem> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 71);
 
 /**
  * PotentialDeduction represents a suggested tax deduction found by the deduction finder
@@ -3720,7 +4803,7 @@ export type PotentialDeduction = Message<"pfinance.v1.PotentialDeduction"> & {
  * Use `create(PotentialDeductionSchema)` to create a new message.
  */
 export const PotentialDeductionSchema: GenMessage<PotentialDeduction> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 56);
+  messageDesc(file_pfinance_v1_types, 72);
 
 /**
  * TaxYearComparison represents a comparison between two financial years
@@ -3771,6 +4854,18 @@ export type TaxYearComparison = Message<"pfinance.v1.TaxYearComparison"> & {
    * @generated from field: int64 tax_change_cents = 8;
    */
   taxChangeCents: bigint;
+
+  /**
+   * @generated from field: int64 taxable_income_change_cents = 9;
+   */
+  taxableIncomeChangeCents: bigint;
+
+  /**
+   * Change in effective_rate (a fraction), year_b minus year_a
+   *
+   * @generated from field: double effective_rate_change = 10;
+   */
+  effectiveRateChange: number;
 };
 
 /**
@@ -3778,7 +4873,7 @@ export type TaxYearComparison = Message<"pfinance.v1.TaxYearComparison"> & {
  * Use `create(TaxYearComparisonSchema)` to create a new message.
  */
 export const TaxYearComparisonSchema: GenMessage<TaxYearComparison> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 57);
+  messageDesc(file_pfinance_v1_types, 73);
 
 /**
  * CategoryDelta represents the change in deductions for a category between two years
@@ -3817,7 +4912,7 @@ export type CategoryDelta = Message<"pfinance.v1.CategoryDelta"> & {
  * Use `create(CategoryDeltaSchema)` to create a new message.
  */
 export const CategoryDeltaSchema: GenMessage<CategoryDelta> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 58);
+  messageDesc(file_pfinance_v1_types, 74);
 
 /**
  * BankTransaction represents a single parsed transaction from a bank statement
@@ -3892,6 +4987,20 @@ export type BankTransaction = Message<"pfinance.v1.BankTransaction"> & {
    * @generated from field: int64 amount_cents = 10;
    */
   amountCents: bigint;
+
+  /**
+   * Set by a matching import profile category rule
+   *
+   * @generated from field: pfinance.v1.ExpenseCategory suggested_category = 11;
+   */
+  suggestedCategory: ExpenseCategory;
+
+  /**
+   * Set by a matching import profile category rule
+   *
+   * @generated from field: string normalized_merchant = 12;
+   */
+  normalizedMerchant: string;
 };
 
 /**
@@ -3899,7 +5008,7 @@ export type BankTransaction = Message<"pfinance.v1.BankTransaction"> & {
  * Use `create(BankTransactionSchema)` to create a new message.
  */
 export const BankTransactionSchema: GenMessage<BankTransaction> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 59);
+  messageDesc(file_pfinance_v1_types, 75);
 
 /**
  * BankStatementResult represents the full result of bank statement parsing
@@ -3950,38 +5059,209 @@ export type BankStatementResult = Message<"pfinance.v1.BankStatementResult"> & {
   /**
    * Any warnings during parsing
    *
-   * @generated from field: repeated string warnings = 7;
+   * @generated from field: repeated string warnings = 7;
+   */
+  warnings: string[];
+
+  /**
+   * Statement metadata for dedup
+   *
+   * @generated from field: pfinance.v1.StatementMetadata statement_metadata = 8;
+   */
+  statementMetadata?: StatementMetadata;
+
+  /**
+   * Which method was used
+   *
+   * @generated from field: pfinance.v1.ExtractionMethod method_used = 9;
+   */
+  methodUsed: ExtractionMethod;
+
+  /**
+   * If fallback was used, the original method
+   *
+   * @generated from field: pfinance.v1.ExtractionMethod fallback_from = 10;
+   */
+  fallbackFrom: ExtractionMethod;
+};
+
+/**
+ * Describes the message pfinance.v1.BankStatementResult.
+ * Use `create(BankStatementResultSchema)` to create a new message.
+ */
+export const BankStatementResultSchema: GenMessage<BankStatementResult> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 76);
+
+/**
+ * ImportColumnMapping maps one source column to a transaction field
+ *
+ * @generated from message pfinance.v1.ImportColumnMapping
+ */
+export type ImportColumnMapping = Message<"pfinance.v1.ImportColumnMapping"> & {
+  /**
+   * Column header as it appears in the file
+   *
+   * @generated from field: string column = 1;
+   */
+  column: string;
+
+  /**
+   * @generated from field: pfinance.v1.ImportField field = 2;
+   */
+  field: ImportField;
+};
+
+/**
+ * Describes the message pfinance.v1.ImportColumnMapping.
+ * Use `create(ImportColumnMappingSchema)` to create a new message.
+ */
+export const ImportColumnMappingSchema: GenMessage<ImportColumnMapping> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 77);
+
+/**
+ * ImportCategoryRule categorizes transactions whose description matches a pattern
+ *
+ * @generated from message pfinance.v1.ImportCategoryRule
+ */
+export type ImportCategoryRule = Message<"pfinance.v1.ImportCategoryRule"> & {
+  /**
+   * Case-insensitive substring of the description
+   *
+   * @generated from field: string pattern = 1;
+   */
+  pattern: string;
+
+  /**
+   * @generated from field: pfinance.v1.ExpenseCategory category = 2;
+   */
+  category: ExpenseCategory;
+
+  /**
+   * Optional clean merchant name to apply
+   *
+   * @generated from field: string normalized_merchant = 3;
+   */
+  normalizedMerchant: string;
+};
+
+/**
+ * Describes the message pfinance.v1.ImportCategoryRule.
+ * Use `create(ImportCategoryRuleSchema)` to create a new message.
+ */
+export const ImportCategoryRuleSchema: GenMessage<ImportCategoryRule> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 78);
+
+/**
+ * ImportProfile saves how a user's statements from one bank are read, so
+ * repeat imports reuse the same column mappings and category rules
+ *
+ * @generated from message pfinance.v1.ImportProfile
+ */
+export type ImportProfile = Message<"pfinance.v1.ImportProfile"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * Passed to the statement parser as its bank hint
+   *
+   * @generated from field: string bank_name = 4;
+   */
+  bankName: string;
+
+  /**
+   * Go reference layout for date columns (e.g. "02/01/2006")
+   *
+   * @generated from field: string date_format = 5;
+   */
+  dateFormat: string;
+
+  /**
+   * @generated from field: repeated pfinance.v1.ImportColumnMapping column_mappings = 6;
+   */
+  columnMappings: ImportColumnMapping[];
+
+  /**
+   * Evaluated in order; the first match wins
+   *
+   * @generated from field: repeated pfinance.v1.ImportCategoryRule category_rules = 7;
+   */
+  categoryRules: ImportCategoryRule[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 9;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message pfinance.v1.ImportProfile.
+ * Use `create(ImportProfileSchema)` to create a new message.
+ */
+export const ImportProfileSchema: GenMessage<ImportProfile> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 79);
+
+/**
+ * ExtractionPreferences holds a user's confidence thresholds for document
+ * extraction. An unset threshold uses the default noted beside it.
+ *
+ * @generated from message pfinance.v1.ExtractionPreferences
+ */
+export type ExtractionPreferences = Message<"pfinance.v1.ExtractionPreferences"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Below this, transactions are rejected (default 0.3)
+   *
+   * @generated from field: optional double auto_reject_threshold = 2;
    */
-  warnings: string[];
+  autoRejectThreshold?: number;
 
   /**
-   * Statement metadata for dedup
+   * Below this, transactions get a "please verify" badge (default 0.6)
    *
-   * @generated from field: pfinance.v1.StatementMetadata statement_metadata = 8;
+   * @generated from field: optional double low_warning_threshold = 3;
    */
-  statementMetadata?: StatementMetadata;
+  lowWarningThreshold?: number;
 
   /**
-   * Which method was used
+   * Below this, transactions start unchecked in import (default 0.5)
    *
-   * @generated from field: pfinance.v1.ExtractionMethod method_used = 9;
+   * @generated from field: optional double pre_deselect_threshold = 4;
    */
-  methodUsed: ExtractionMethod;
+  preDeselectThreshold?: number;
 
   /**
-   * If fallback was used, the original method
-   *
-   * @generated from field: pfinance.v1.ExtractionMethod fallback_from = 10;
+   * @generated from field: google.protobuf.Timestamp updated_at = 5;
    */
-  fallbackFrom: ExtractionMethod;
+  updatedAt?: Timestamp;
 };
 
 /**
- * Describes the message pfinance.v1.BankStatementResult.
- * Use `create(BankStatementResultSchema)` to create a new message.
+ * Describes the message pfinance.v1.ExtractionPreferences.
+ * Use `create(ExtractionPreferencesSchema)` to create a new message.
  */
-export const BankStatementResultSchema: GenMessage<BankStatementResult> = /*@__PURE__*/
-  messageDesc(file_pfinance_v1_types, 60);
+export const ExtractionPreferencesSchema: GenMessage<ExtractionPreferences> = /*@__PURE__*/
+  messageDesc(file_pfinance_v1_types, 80);
 
 /**
  * ExpenseCategory represents the category of an expense
@@ -4401,6 +5681,66 @@ export enum SplitType {
 export const SplitTypeSchema: GenEnum<SplitType> = /*@__PURE__*/
   enumDesc(file_pfinance_v1_types, 8);
 
+/**
+ * SortField selects the field list results are ordered by
+ *
+ * @generated from enum pfinance.v1.SortField
+ */
+export enum SortField {
+  /**
+   * Store default ordering
+   *
+   * @generated from enum value: SORT_FIELD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SORT_FIELD_DATE = 1;
+   */
+  DATE = 1,
+
+  /**
+   * @generated from enum value: SORT_FIELD_AMOUNT = 2;
+   */
+  AMOUNT = 2,
+}
+
+/**
+ * Describes the enum pfinance.v1.SortField.
+ */
+export const SortFieldSchema: GenEnum<SortField> = /*@__PURE__*/
+  enumDesc(file_pfinance_v1_types, 9);
+
+/**
+ * SortDirection selects ascending or descending order
+ *
+ * @generated from enum pfinance.v1.SortDirection
+ */
+export enum SortDirection {
+  /**
+   * Defaults to descending
+   *
+   * @generated from enum value: SORT_DIRECTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SORT_DIRECTION_ASC = 1;
+   */
+  ASC = 1,
+
+  /**
+   * @generated from enum value: SORT_DIRECTION_DESC = 2;
+   */
+  DESC = 2,
+}
+
+/**
+ * Describes the enum pfinance.v1.SortDirection.
+ */
+export const SortDirectionSchema: GenEnum<SortDirection> = /*@__PURE__*/
+  enumDesc(file_pfinance_v1_types, 10);
+
 /**
  * GroupRole represents the role of a member in a group
  *
@@ -4437,7 +5777,7 @@ export enum GroupRole {
  * Describes the enum pfinance.v1.GroupRole.
  */
 export const GroupRoleSchema: GenEnum<GroupRole> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 9);
+  enumDesc(file_pfinance_v1_types, 11);
 
 /**
  * InvitationStatus represents the status of an invitation
@@ -4475,7 +5815,7 @@ export enum InvitationStatus {
  * Describes the enum pfinance.v1.InvitationStatus.
  */
 export const InvitationStatusSchema: GenEnum<InvitationStatus> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 10);
+  enumDesc(file_pfinance_v1_types, 12);
 
 /**
  * BudgetPeriod represents the time period for a budget
@@ -4518,7 +5858,7 @@ export enum BudgetPeriod {
  * Describes the enum pfinance.v1.BudgetPeriod.
  */
 export const BudgetPeriodSchema: GenEnum<BudgetPeriod> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 11);
+  enumDesc(file_pfinance_v1_types, 13);
 
 /**
  * GoalType represents the type of financial goal
@@ -4557,7 +5897,7 @@ export enum GoalType {
  * Describes the enum pfinance.v1.GoalType.
  */
 export const GoalTypeSchema: GenEnum<GoalType> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 12);
+  enumDesc(file_pfinance_v1_types, 14);
 
 /**
  * GoalStatus represents the current status of a goal
@@ -4595,7 +5935,75 @@ export enum GoalStatus {
  * Describes the enum pfinance.v1.GoalStatus.
  */
 export const GoalStatusSchema: GenEnum<GoalStatus> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 13);
+  enumDesc(file_pfinance_v1_types, 15);
+
+/**
+ * GoalPriority weights a goal when splitting savings across goals
+ *
+ * @generated from enum pfinance.v1.GoalPriority
+ */
+export enum GoalPriority {
+  /**
+   * Treated as MEDIUM
+   *
+   * @generated from enum value: GOAL_PRIORITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: GOAL_PRIORITY_LOW = 1;
+   */
+  LOW = 1,
+
+  /**
+   * @generated from enum value: GOAL_PRIORITY_MEDIUM = 2;
+   */
+  MEDIUM = 2,
+
+  /**
+   * @generated from enum value: GOAL_PRIORITY_HIGH = 3;
+   */
+  HIGH = 3,
+}
+
+/**
+ * Describes the enum pfinance.v1.GoalPriority.
+ */
+export const GoalPrioritySchema: GenEnum<GoalPriority> = /*@__PURE__*/
+  enumDesc(file_pfinance_v1_types, 16);
+
+/**
+ * ActivityKind identifies the record behind an activity feed item
+ *
+ * @generated from enum pfinance.v1.ActivityKind
+ */
+export enum ActivityKind {
+  /**
+   * @generated from enum value: ACTIVITY_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ACTIVITY_KIND_EXPENSE = 1;
+   */
+  EXPENSE = 1,
+
+  /**
+   * @generated from enum value: ACTIVITY_KIND_INCOME = 2;
+   */
+  INCOME = 2,
+
+  /**
+   * @generated from enum value: ACTIVITY_KIND_GOAL_CONTRIBUTION = 3;
+   */
+  GOAL_CONTRIBUTION = 3,
+}
+
+/**
+ * Describes the enum pfinance.v1.ActivityKind.
+ */
+export const ActivityKindSchema: GenEnum<ActivityKind> = /*@__PURE__*/
+  enumDesc(file_pfinance_v1_types, 17);
 
 /**
  * RecurringTransactionStatus represents the status of a recurring transaction
@@ -4628,7 +6036,7 @@ export enum RecurringTransactionStatus {
  * Describes the enum pfinance.v1.RecurringTransactionStatus.
  */
 export const RecurringTransactionStatusSchema: GenEnum<RecurringTransactionStatus> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 14);
+  enumDesc(file_pfinance_v1_types, 18);
 
 /**
  * InsightType represents the type of spending insight
@@ -4681,7 +6089,7 @@ export enum InsightType {
  * Describes the enum pfinance.v1.InsightType.
  */
 export const InsightTypeSchema: GenEnum<InsightType> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 15);
+  enumDesc(file_pfinance_v1_types, 19);
 
 /**
  * TransactionType for search filtering
@@ -4709,7 +6117,41 @@ export enum TransactionType {
  * Describes the enum pfinance.v1.TransactionType.
  */
 export const TransactionTypeSchema: GenEnum<TransactionType> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 16);
+  enumDesc(file_pfinance_v1_types, 20);
+
+/**
+ * SearchSortBy selects how search results are ordered
+ *
+ * @generated from enum pfinance.v1.SearchSortBy
+ */
+export enum SearchSortBy {
+  /**
+   * Defaults to date
+   *
+   * @generated from enum value: SEARCH_SORT_BY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Newest first
+   *
+   * @generated from enum value: SEARCH_SORT_BY_DATE = 1;
+   */
+  DATE = 1,
+
+  /**
+   * Best match to the query first, then newest
+   *
+   * @generated from enum value: SEARCH_SORT_BY_RELEVANCE = 2;
+   */
+  RELEVANCE = 2,
+}
+
+/**
+ * Describes the enum pfinance.v1.SearchSortBy.
+ */
+export const SearchSortBySchema: GenEnum<SearchSortBy> = /*@__PURE__*/
+  enumDesc(file_pfinance_v1_types, 21);
 
 /**
  * NotificationType represents the type of notification
@@ -4791,13 +6233,34 @@ export enum NotificationType {
    * @generated from enum value: NOTIFICATION_TYPE_TAX_SAVINGS = 10;
    */
   TAX_SAVINGS = 10,
+
+  /**
+   * Monthly spending at 80% or 100% of the cap
+   *
+   * @generated from enum value: NOTIFICATION_TYPE_SPEND_CAP = 11;
+   */
+  SPEND_CAP = 11,
+
+  /**
+   * Budget spending running ahead of the period
+   *
+   * @generated from enum value: NOTIFICATION_TYPE_BUDGET_PACE = 12;
+   */
+  BUDGET_PACE = 12,
+
+  /**
+   * Deductions nearing a claim cap or needing receipts
+   *
+   * @generated from enum value: NOTIFICATION_TYPE_TAX_SUBSTANTIATION = 13;
+   */
+  TAX_SUBSTANTIATION = 13,
 }
 
 /**
  * Describes the enum pfinance.v1.NotificationType.
  */
 export const NotificationTypeSchema: GenEnum<NotificationType> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 17);
+  enumDesc(file_pfinance_v1_types, 22);
 
 /**
  * DocumentType represents the type of document being processed
@@ -4830,7 +6293,7 @@ export enum DocumentType {
  * Describes the enum pfinance.v1.DocumentType.
  */
 export const DocumentTypeSchema: GenEnum<DocumentType> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 18);
+  enumDesc(file_pfinance_v1_types, 23);
 
 /**
  * ExtractionStatus represents the status of an extraction job
@@ -4873,7 +6336,7 @@ export enum ExtractionStatus {
  * Describes the enum pfinance.v1.ExtractionStatus.
  */
 export const ExtractionStatusSchema: GenEnum<ExtractionStatus> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 19);
+  enumDesc(file_pfinance_v1_types, 24);
 
 /**
  * ExtractionMethod represents which ML model/service to use for extraction
@@ -4907,7 +6370,7 @@ export enum ExtractionMethod {
  * Describes the enum pfinance.v1.ExtractionMethod.
  */
 export const ExtractionMethodSchema: GenEnum<ExtractionMethod> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 20);
+  enumDesc(file_pfinance_v1_types, 25);
 
 /**
  * Granularity for time series aggregation
@@ -4940,7 +6403,60 @@ export enum Granularity {
  * Describes the enum pfinance.v1.Granularity.
  */
 export const GranularitySchema: GenEnum<Granularity> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 21);
+  enumDesc(file_pfinance_v1_types, 26);
+
+/**
+ * DayOfWeek identifies a day, e.g. the first day of a week bucket
+ *
+ * @generated from enum pfinance.v1.DayOfWeek
+ */
+export enum DayOfWeek {
+  /**
+   * @generated from enum value: DAY_OF_WEEK_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DAY_OF_WEEK_SUNDAY = 1;
+   */
+  SUNDAY = 1,
+
+  /**
+   * @generated from enum value: DAY_OF_WEEK_MONDAY = 2;
+   */
+  MONDAY = 2,
+
+  /**
+   * @generated from enum value: DAY_OF_WEEK_TUESDAY = 3;
+   */
+  TUESDAY = 3,
+
+  /**
+   * @generated from enum value: DAY_OF_WEEK_WEDNESDAY = 4;
+   */
+  WEDNESDAY = 4,
+
+  /**
+   * @generated from enum value: DAY_OF_WEEK_THURSDAY = 5;
+   */
+  THURSDAY = 5,
+
+  /**
+   * @generated from enum value: DAY_OF_WEEK_FRIDAY = 6;
+   */
+  FRIDAY = 6,
+
+  /**
+   * @generated from enum value: DAY_OF_WEEK_SATURDAY = 7;
+   */
+  SATURDAY = 7,
+}
+
+/**
+ * Describes the enum pfinance.v1.DayOfWeek.
+ */
+export const DayOfWeekSchema: GenEnum<DayOfWeek> = /*@__PURE__*/
+  enumDesc(file_pfinance_v1_types, 27);
 
 /**
  * AnomalyType categorizes the kind of spending anomaly
@@ -4980,13 +6496,20 @@ export enum AnomalyType {
    * @generated from enum value: ANOMALY_TYPE_CATEGORY_SPIKE = 4;
    */
   CATEGORY_SPIKE = 4,
+
+  /**
+   * Far outside the usual spending hours for its category
+   *
+   * @generated from enum value: ANOMALY_TYPE_TIME_OUTLIER = 5;
+   */
+  TIME_OUTLIER = 5,
 }
 
 /**
  * Describes the enum pfinance.v1.AnomalyType.
  */
 export const AnomalyTypeSchema: GenEnum<AnomalyType> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 22);
+  enumDesc(file_pfinance_v1_types, 28);
 
 /**
  * AnomalySeverity indicates how unusual the anomaly is
@@ -5019,7 +6542,7 @@ export enum AnomalySeverity {
  * Describes the enum pfinance.v1.AnomalySeverity.
  */
 export const AnomalySeveritySchema: GenEnum<AnomalySeverity> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 23);
+  enumDesc(file_pfinance_v1_types, 29);
 
 /**
  * WaterfallEntryType categorizes waterfall chart entries
@@ -5062,7 +6585,7 @@ export enum WaterfallEntryType {
  * Describes the enum pfinance.v1.WaterfallEntryType.
  */
 export const WaterfallEntryTypeSchema: GenEnum<WaterfallEntryType> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 24);
+  enumDesc(file_pfinance_v1_types, 30);
 
 /**
  * CorrectionFieldType represents which field was corrected
@@ -5105,5 +6628,94 @@ export enum CorrectionFieldType {
  * Describes the enum pfinance.v1.CorrectionFieldType.
  */
 export const CorrectionFieldTypeSchema: GenEnum<CorrectionFieldType> = /*@__PURE__*/
-  enumDesc(file_pfinance_v1_types, 25);
+  enumDesc(file_pfinance_v1_types, 31);
+
+/**
+ * MerchantMappingSort selects how merchant mappings are listed, highest first
+ *
+ * @generated from enum pfinance.v1.MerchantMappingSort
+ */
+export enum MerchantMappingSort {
+  /**
+   * Defaults to confidence
+   *
+   * @generated from enum value: MERCHANT_MAPPING_SORT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MERCHANT_MAPPING_SORT_CONFIDENCE = 1;
+   */
+  CONFIDENCE = 1,
+
+  /**
+   * @generated from enum value: MERCHANT_MAPPING_SORT_LAST_USED = 2;
+   */
+  LAST_USED = 2,
+}
+
+/**
+ * Describes the enum pfinance.v1.MerchantMappingSort.
+ */
+export const MerchantMappingSortSchema: GenEnum<MerchantMappingSort> = /*@__PURE__*/
+  enumDesc(file_pfinance_v1_types, 32);
+
+/**
+ * ImportField is a transaction field an imported column can map to
+ *
+ * @generated from enum pfinance.v1.ImportField
+ */
+export enum ImportField {
+  /**
+   * @generated from enum value: IMPORT_FIELD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: IMPORT_FIELD_DATE = 1;
+   */
+  DATE = 1,
+
+  /**
+   * @generated from enum value: IMPORT_FIELD_DESCRIPTION = 2;
+   */
+  DESCRIPTION = 2,
+
+  /**
+   * Signed amount, negative for debits
+   *
+   * @generated from enum value: IMPORT_FIELD_AMOUNT = 3;
+   */
+  AMOUNT = 3,
+
+  /**
+   * Unsigned money out
+   *
+   * @generated from enum value: IMPORT_FIELD_DEBIT = 4;
+   */
+  DEBIT = 4,
+
+  /**
+   * Unsigned money in
+   *
+   * @generated from enum value: IMPORT_FIELD_CREDIT = 5;
+   */
+  CREDIT = 5,
+
+  /**
+   * @generated from enum value: IMPORT_FIELD_BALANCE = 6;
+   */
+  BALANCE = 6,
+
+  /**
+   * @generated from enum value: IMPORT_FIELD_REFERENCE = 7;
+   */
+  REFERENCE = 7,
+}
+
+/**
+ * Describes the enum pfinance.v1.ImportField.
+ */
+export const ImportFieldSchema: GenEnum<ImportField> = /*@__PURE__*/
+  enumDesc(file_pfinance_v1_types, 33);
 
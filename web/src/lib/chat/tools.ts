@@ -117,8 +117,8 @@ export function createTools(client: BackendClient, userId: string, isPro: boolea
             userId,
             query: args.query,
             category: args.category || '',
-            amountMin: args.amountMin || 0,
-            amountMax: args.amountMax || 0,
+            amountMin: args.amountMin,
+            amountMax: args.amountMax,
             pageSize: 20,
           });
           const results = res.results.map(r => ({